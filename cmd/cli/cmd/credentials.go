@@ -89,26 +89,32 @@ func extractServerBase(fullURL string) (string, error) {
 // extractWorldID extracts the world ID from a worlds API URL
 // e.g., "http://localhost:8080/api/v1/worlds/Desert" -> "Desert"
 func extractWorldID(worldURL string) (string, error) {
-	u, err := url.Parse(worldURL)
+	return extractResourceID(worldURL, "worlds")
+}
+
+// extractResourceID extracts the ID following /api/v1/<collection>/ in a URL
+// e.g., ("http://localhost:8080/api/v1/games/abc123", "games") -> "abc123"
+func extractResourceID(resourceURL, collection string) (string, error) {
+	u, err := url.Parse(resourceURL)
 	if err != nil {
 		return "", err
 	}
 
-	// Look for /api/v1/worlds/<id> pattern
+	// Look for /api/v1/<collection>/<id> pattern
 	path := u.Path
-	const prefix = "/api/v1/worlds/"
+	prefix := "/api/v1/" + collection + "/"
 	idx := strings.Index(path, prefix)
 	if idx >= 0 {
 		remainder := path[idx+len(prefix):]
 		// Remove trailing slash if present
 		remainder = strings.TrimSuffix(remainder, "/")
 		if remainder == "" {
-			return "", fmt.Errorf("no world ID found in URL: %s", worldURL)
+			return "", fmt.Errorf("no ID found in URL: %s", resourceURL)
 		}
 		return remainder, nil
 	}
 
-	return "", fmt.Errorf("URL does not match /api/v1/worlds/<id> pattern: %s", worldURL)
+	return "", fmt.Errorf("URL does not match %s<id> pattern: %s", prefix, resourceURL)
 }
 
 // GetAPIEndpoint returns the API endpoint URL for a given host.
@@ -189,3 +195,53 @@ func parseWorldSpec(spec string) (*WorldSpec, error) {
 		Token:       token,
 	}, nil
 }
+
+// GameSpec identifies a game on a server, parsed like a WorldSpec
+type GameSpec struct {
+	Host        string
+	GameID      string
+	ProfileName string // Set if parsed from profile:gameId format
+	Token       string // Auth token for this server
+}
+
+// APIEndpoint returns the full API endpoint URL for this game spec
+func (g *GameSpec) APIEndpoint() string {
+	return GetAPIEndpoint(g.Host)
+}
+
+// parseGameSpec parses a game specification which can be either:
+// - Full URL: http://localhost:8080/api/v1/games/abc123
+// - Profile shorthand: profile:gameId (e.g., fsbe:abc123)
+func parseGameSpec(spec string) (*GameSpec, error) {
+	if strings.HasPrefix(spec, "http://") || strings.HasPrefix(spec, "https://") {
+		host, err := extractServerBase(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid URL: %w", err)
+		}
+		gameID, err := extractResourceID(spec, "games")
+		if err != nil {
+			return nil, fmt.Errorf("invalid URL: %w", err)
+		}
+		return &GameSpec{
+			Host:   host,
+			GameID: gameID,
+			Token:  GetTokenForServer(host),
+		}, nil
+	}
+
+	if parts := strings.SplitN(spec, ":", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format: expected 'profile:gameId' or full URL, got: %s", spec)
+	}
+
+	// Profile shorthand resolves the same way for worlds and games
+	ws, err := parseWorldSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &GameSpec{
+		Host:        ws.Host,
+		GameID:      ws.WorldID,
+		ProfileName: ws.ProfileName,
+		Token:       ws.Token,
+	}, nil
+}
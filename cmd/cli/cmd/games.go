@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/connectclient"
+)
+
+// gamesCmd is the parent command for working with games
+var gamesCmd = &cobra.Command{
+	Use:   "games",
+	Short: "List, export and import games on a server",
+	Long: `List, export and import games on a configured server profile.
+
+Examples:
+  ww games mine                   # games you are a player in
+  ww games mine --my-turn         # only games waiting on you
+  ww games export abc123          # download abc123.zip
+  ww games import abc123.zip      # upload it to another server`,
+}
+
+// gamesMineCmd lists the caller's games
+var gamesMineCmd = &cobra.Command{
+	Use:   "mine",
+	Short: "List the games you are a player in",
+	Long: `List the games you hold a player slot in along with whose turn it is.
+Games waiting on you are listed first, then the most recently updated.
+Requires being logged in to a server profile.
+
+Examples:
+  ww games mine
+  ww games mine --my-turn
+  ww games mine --status ended
+  ww games mine --json`,
+	RunE: runGamesMine,
+}
+
+var (
+	gamesMineMyTurn bool
+	gamesMineStatus string
+)
+
+func init() {
+	rootCmd.AddCommand(gamesCmd)
+	gamesCmd.AddCommand(gamesMineCmd)
+
+	gamesMineCmd.Flags().BoolVar(&gamesMineMyTurn, "my-turn", false, "Only games where it is your turn")
+	gamesMineCmd.Flags().StringVar(&gamesMineStatus, "status", "", "Only games in this status (playing, paused, ended)")
+}
+
+// parseGameStatus parses a status flag value such as "playing"
+func parseGameStatus(s string) (v1.GameStatus, error) {
+	if s == "" {
+		return v1.GameStatus_GAME_STATUS_UNSPECIFIED, nil
+	}
+	value, ok := v1.GameStatus_value["GAME_STATUS_"+strings.ToUpper(s)]
+	if !ok {
+		return 0, fmt.Errorf("unknown status %q: must be playing, paused or ended", s)
+	}
+	return v1.GameStatus(value), nil
+}
+
+// gameStatusName is the short lower case name of a status ("-" if unset)
+func gameStatusName(s v1.GameStatus) string {
+	if s == v1.GameStatus_GAME_STATUS_UNSPECIFIED {
+		return "-"
+	}
+	return strings.ToLower(strings.TrimPrefix(s.String(), "GAME_STATUS_"))
+}
+
+func runGamesMine(cmd *cobra.Command, args []string) error {
+	status, err := parseGameStatus(gamesMineStatus)
+	if err != nil {
+		return err
+	}
+
+	serverURL := getServerURL()
+	if serverURL == "" {
+		return fmt.Errorf("no server configured (set --profile, --server, or LILBATTLE_SERVER)")
+	}
+	token := GetTokenForProfile(getProfileName())
+	if token == "" {
+		return fmt.Errorf("not logged in (run 'ww login' first)")
+	}
+	client := connectclient.NewConnectGamesClientWithAuth(GetAPIEndpoint(serverURL), token)
+
+	// Follow pages until every game is listed
+	ctx := context.Background()
+	req := &v1.ListMyGamesRequest{
+		OnlyMyTurn: gamesMineMyTurn,
+		Status:     status,
+		Pagination: &v1.Pagination{},
+	}
+	var games []*v1.UserGame
+	for {
+		resp, err := client.ListMyGames(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to list games: %w", err)
+		}
+		games = append(games, resp.Items...)
+		if !resp.GetPagination().GetHasMore() {
+			break
+		}
+		req.Pagination.PageOffset = resp.Pagination.NextPageOffset
+	}
+
+	formatter := NewOutputFormatter()
+
+	if formatter.JSON {
+		items := []map[string]any{}
+		for _, g := range games {
+			items = append(items, map[string]any{
+				"id":             g.GameId,
+				"name":           g.GameName,
+				"world_id":       g.WorldId,
+				"status":         gameStatusName(g.Status),
+				"turn":           g.TurnCounter,
+				"current_player": g.CurrentPlayer,
+				"my_players":     g.PlayerIds,
+				"my_turn":        g.IsMyTurn,
+			})
+		}
+		return formatter.PrintJSON(map[string]any{
+			"games": items,
+			"total": len(items),
+		})
+	}
+
+	if len(games) == 0 {
+		fmt.Println("No games found.")
+		return nil
+	}
+
+	// Table-style output
+	fmt.Printf("%-20s %-30s %-8s %-6s %-8s %-8s\n", "ID", "NAME", "STATUS", "TURN", "PLAYER", "WAITING")
+	fmt.Println(strings.Repeat("-", 86))
+	myTurn := 0
+	for _, g := range games {
+		waiting := ""
+		if g.IsMyTurn {
+			waiting = "you"
+			myTurn++
+		}
+		fmt.Printf("%-20s %-30s %-8s %-6d %-8d %-8s\n",
+			truncate(g.GameId, 20),
+			truncate(g.GameName, 30),
+			gameStatusName(g.Status),
+			g.TurnCounter,
+			g.CurrentPlayer,
+			waiting,
+		)
+	}
+	fmt.Printf("\n%d game(s), %d waiting on you\n", len(games), myTurn)
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/connectclient"
+)
+
+// gamesExportCmd downloads a game archive
+var gamesExportCmd = &cobra.Command{
+	Use:   "export <gameId>",
+	Short: "Export a game as a portable archive",
+	Long: `Download a game as a single archive file holding the game, its state,
+its move history, the world revision it started from and the rules it was
+played with.  The server replays the game before exporting it, so a game
+created before worlds kept revisions cannot be exported once its world has
+been edited.
+
+Examples:
+  ww games export abc123                  # writes abc123.zip
+  ww games export abc123 -o backup.zip`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGamesExport,
+}
+
+// gamesImportCmd uploads a game archive
+var gamesImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import a game archive",
+	Long: `Upload a game archive written by 'ww games export'.  The server checks
+the archive's hashes and replays its history with the archived rules
+before storing it, and you become the game's creator.  The game keeps its
+ID unless --id is given.
+
+Examples:
+  ww games import backup.zip
+  ww games import backup.zip --id abc123-copy`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGamesImport,
+}
+
+var (
+	gamesExportOutput string
+	gamesImportID     string
+)
+
+func init() {
+	gamesCmd.AddCommand(gamesExportCmd)
+	gamesCmd.AddCommand(gamesImportCmd)
+
+	gamesExportCmd.Flags().StringVarP(&gamesExportOutput, "output", "o", "", "File to write the archive to (default <gameId>.zip)")
+	gamesImportCmd.Flags().StringVar(&gamesImportID, "id", "", "Import the game under this ID")
+}
+
+// gamesClient returns a client for the configured server, authenticated if
+// the user is logged in
+func gamesClient() (*connectclient.ConnectGamesClient, error) {
+	serverURL := getServerURL()
+	if serverURL == "" {
+		return nil, fmt.Errorf("no server configured (set --profile, --server, or LILBATTLE_SERVER)")
+	}
+	token := GetTokenForProfile(getProfileName())
+	return connectclient.NewConnectGamesClientWithAuth(GetAPIEndpoint(serverURL), token), nil
+}
+
+func runGamesExport(cmd *cobra.Command, args []string) error {
+	gameID := args[0]
+	client, err := gamesClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.ExportGame(context.Background(), &v1.ExportGameRequest{GameId: gameID})
+	if err != nil {
+		return fmt.Errorf("failed to export game: %w", err)
+	}
+
+	output := gamesExportOutput
+	if output == "" {
+		output = gameID + ".zip"
+	}
+	if err := os.WriteFile(output, resp.Archive, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"game_id":      gameID,
+			"file":         output,
+			"bytes":        len(resp.Archive),
+			"content_hash": resp.ContentHash,
+		})
+	}
+	fmt.Printf("Exported game '%s' to %s (%d bytes)\n", gameID, output, len(resp.Archive))
+	fmt.Printf("Content hash: %s\n", resp.ContentHash)
+	return nil
+}
+
+func runGamesImport(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	if GetTokenForProfile(getProfileName()) == "" {
+		return fmt.Errorf("not logged in (run 'ww login' first)")
+	}
+	client, err := gamesClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.ImportGame(context.Background(), &v1.ImportGameRequest{Archive: data, GameId: gamesImportID})
+	if err != nil {
+		return fmt.Errorf("failed to import game: %w", err)
+	}
+	if suggested := resp.FieldErrors["id"]; suggested != "" {
+		return fmt.Errorf("a game with this ID already exists (try --id %s)", suggested)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"game_id":         resp.Game.Id,
+			"content_hash":    resp.ContentHash,
+			"replayed_groups": resp.ReplayedGroups,
+			"rules_match":     resp.RulesMatch,
+		})
+	}
+	fmt.Printf("Imported game '%s' (%d move groups replayed)\n", resp.Game.Id, resp.ReplayedGroups)
+	fmt.Printf("Content hash: %s\n", resp.ContentHash)
+	if !resp.RulesMatch {
+		fmt.Println("Note: the game was played with different rules than this server's - it goes on being played with the archived rules")
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/connectclient"
+)
+
+// jobsCmd is the parent of the background job commands
+var jobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "Inspect and retry a server's background jobs",
+	Long: `Inspect the background jobs queue of a server (screenshots,
+notifications, cleanup) and retry jobs that failed.
+
+The server only allows operators (LILBATTLE_OPERATORS) to use these.`,
+}
+
+var jobsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List background jobs",
+	Long: `List background jobs in the order they are due.
+
+Examples:
+  ww jobs list
+  ww jobs list --status failed
+  ww jobs list --type janitor --json`,
+	Args: cobra.NoArgs,
+	RunE: runJobsList,
+}
+
+var jobsGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Show a job and its recent runs",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runJobsGet,
+}
+
+var jobsRetryCmd = &cobra.Command{
+	Use:   "retry <id>",
+	Short: "Run a job again now",
+	Long: `Make a job due now with its retries reset - typically one that has
+failed after using up its retries.
+
+Examples:
+  ww jobs retry notify:games:abc123`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJobsRetry,
+}
+
+func init() {
+	rootCmd.AddCommand(jobsCmd)
+	jobsCmd.AddCommand(jobsListCmd)
+	jobsCmd.AddCommand(jobsGetCmd)
+	jobsCmd.AddCommand(jobsRetryCmd)
+
+	jobsListCmd.Flags().String("type", "", "Only list jobs of this type")
+	jobsListCmd.Flags().String("status", "", "Only list jobs with this status (pending, running, succeeded, failed)")
+	jobsGetCmd.Flags().Int32("runs", 10, "How many recent runs to show")
+}
+
+// getJobsClient returns a jobs client for the active profile
+func getJobsClient() (*connectclient.ConnectJobsClient, error) {
+	serverURL := getServerURL()
+	if serverURL == "" {
+		return nil, fmt.Errorf("no server configured (set --profile, --server, or LILBATTLE_SERVER)")
+	}
+	token := GetTokenForProfile(getProfileName())
+	apiURL := GetAPIEndpoint(serverURL)
+	if isVerbose() {
+		fmt.Printf("[VERBOSE] Connecting to: %s (auth: %v)\n", apiURL, token != "")
+	}
+	return connectclient.NewConnectJobsClientWithAuth(apiURL, token), nil
+}
+
+// parseJobStatus parses a --status value such as "failed"
+func parseJobStatus(value string) (v1.JobStatus, error) {
+	if value == "" {
+		return v1.JobStatus_JOB_STATUS_UNSPECIFIED, nil
+	}
+	status, ok := v1.JobStatus_value["JOB_STATUS_"+strings.ToUpper(value)]
+	if !ok || status == 0 {
+		return 0, fmt.Errorf("invalid status %q (use pending, running, succeeded or failed)", value)
+	}
+	return v1.JobStatus(status), nil
+}
+
+// jobStatusName returns the short lower case name of a status
+func jobStatusName(status v1.JobStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "JOB_STATUS_"))
+}
+
+func jobJSON(job *v1.Job) map[string]any {
+	item := map[string]any{
+		"id":          job.Id,
+		"job_type":    job.JobType,
+		"entity_type": job.EntityType,
+		"entity_id":   job.EntityId,
+		"status":      jobStatusName(job.Status),
+		"attempts":    job.Attempts,
+		"last_error":  job.LastError,
+		"last_run_id": job.LastRunId,
+	}
+	if job.RunAfter != nil {
+		item["run_after"] = job.RunAfter.AsTime()
+	}
+	if job.UpdatedAt != nil {
+		item["updated_at"] = job.UpdatedAt.AsTime()
+	}
+	return item
+}
+
+func runJobsList(cmd *cobra.Command, args []string) error {
+	jobType, _ := cmd.Flags().GetString("type")
+	statusFlag, _ := cmd.Flags().GetString("status")
+	status, err := parseJobStatus(statusFlag)
+	if err != nil {
+		return err
+	}
+	client, err := getJobsClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	var jobs []*v1.Job
+	req := &v1.ListJobsRequest{JobType: jobType, Status: status, Pagination: &v1.Pagination{}}
+	for {
+		resp, err := client.ListJobs(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to list jobs: %w", err)
+		}
+		jobs = append(jobs, resp.Items...)
+		if !resp.Pagination.GetHasMore() {
+			break
+		}
+		req.Pagination.PageOffset = resp.Pagination.NextPageOffset
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		items := []map[string]any{}
+		for _, job := range jobs {
+			items = append(items, jobJSON(job))
+		}
+		return formatter.PrintJSON(map[string]any{"jobs": items})
+	}
+
+	fmt.Printf("%-40s %-10s %-17s %8s  %s\n", "ID", "STATUS", "RUN AFTER", "ATTEMPTS", "LAST ERROR")
+	fmt.Println(strings.Repeat("-", 100))
+	for _, job := range jobs {
+		runAfter := "-"
+		if job.RunAfter != nil {
+			runAfter = job.RunAfter.AsTime().Local().Format("2006-01-02 15:04")
+		}
+		fmt.Printf("%-40s %-10s %-17s %8d  %s\n", truncate(job.Id, 40), jobStatusName(job.Status), runAfter, job.Attempts, truncate(job.LastError, 40))
+	}
+	fmt.Printf("\n%d job(s)\n", len(jobs))
+	return nil
+}
+
+func runJobsGet(cmd *cobra.Command, args []string) error {
+	runLimit, _ := cmd.Flags().GetInt32("runs")
+	client, err := getJobsClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetJob(context.Background(), &v1.GetJobRequest{Id: args[0], RunLimit: runLimit})
+	if err != nil {
+		return fmt.Errorf("failed to get job: %w", err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		runs := []map[string]any{}
+		for _, run := range resp.Runs {
+			item := map[string]any{
+				"run_id":      run.RunId,
+				"state":       strings.ToLower(strings.TrimPrefix(run.State.String(), "RUN_STATE_")),
+				"retry_count": run.RetryCount,
+				"last_error":  run.LastError,
+			}
+			if run.StartedAt != nil {
+				item["started_at"] = run.StartedAt.AsTime()
+			}
+			if run.FinishedAt != nil {
+				item["finished_at"] = run.FinishedAt.AsTime()
+			}
+			runs = append(runs, item)
+		}
+		item := jobJSON(resp.Job)
+		item["runs"] = runs
+		return formatter.PrintJSON(item)
+	}
+
+	job := resp.Job
+	fmt.Printf("Job:      %s\n", job.Id)
+	fmt.Printf("Status:   %s (%d failed attempts)\n", jobStatusName(job.Status), job.Attempts)
+	if job.RunAfter != nil {
+		fmt.Printf("Due:      %s\n", job.RunAfter.AsTime().Local().Format("2006-01-02 15:04:05"))
+	}
+	if job.LastError != "" {
+		fmt.Printf("Error:    %s\n", job.LastError)
+	}
+	fmt.Printf("\n%-24s %-9s %-20s %s\n", "RUN", "STATE", "STARTED", "ERROR")
+	for _, run := range resp.Runs {
+		started := "-"
+		if run.StartedAt != nil {
+			started = run.StartedAt.AsTime().Local().Format("2006-01-02 15:04:05")
+		}
+		state := strings.ToLower(strings.TrimPrefix(run.State.String(), "RUN_STATE_"))
+		fmt.Printf("%-24s %-9s %-20s %s\n", run.RunId, state, started, truncate(run.LastError, 40))
+	}
+	return nil
+}
+
+func runJobsRetry(cmd *cobra.Command, args []string) error {
+	client, err := getJobsClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.RetryJob(context.Background(), &v1.RetryJobRequest{Id: args[0]})
+	if err != nil {
+		return fmt.Errorf("failed to retry job: %w", err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(jobJSON(resp.Job))
+	}
+	fmt.Printf("Job '%s' will run again shortly\n", resp.Job.Id)
+	return nil
+}
@@ -22,7 +22,12 @@ Examples:
   ww map --labels         # Show unit labels (Shortcut:MP/Health)
   ww map --no-labels      # Hide unit labels
   ww map --tile-labels    # Show tile labels (Shortcut)
-  ww map -o map.png       # Save to file instead of displaying`,
+  ww map -o map.png       # Save to file instead of displaying
+
+Tactical overlays:
+  ww map --threat 1       # Hexes player 1's enemies can attack next turn
+  ww map --reach A1       # Where unit A1 can move
+  ww map --influence      # Who controls each area`,
 	RunE: runMap,
 }
 
@@ -30,12 +35,18 @@ var (
 	showLabels     bool
 	showTileLabels bool
 	outputFile     string
+	mapThreat      int32
+	mapReach       string
+	mapInfluence   bool
 )
 
 func init() {
 	rootCmd.AddCommand(mapCmd)
 	mapCmd.Flags().BoolVar(&showLabels, "labels", true, "Show unit labels (Shortcut:MP/Health)")
 	mapCmd.Flags().BoolVar(&showTileLabels, "tile-labels", true, "Show tile labels (Shortcut)")
+	mapCmd.Flags().Int32Var(&mapThreat, "threat", 0, "Shade hexes this player's enemies can attack next turn")
+	mapCmd.Flags().StringVar(&mapReach, "reach", "", "Shade where the unit at this position can move")
+	mapCmd.Flags().BoolVar(&mapInfluence, "influence", false, "Shade each area in the colour of the player controlling it")
 
 	// Default to environment variable if set
 	defaultOutput := os.Getenv("LILBATTLE_MAP_OUTPUT")
@@ -64,10 +75,13 @@ func runMap(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create renderer: %w", err)
 	}
 
-	// Set up render options
-	options := lib.DefaultRenderOptions()
+	// Set up render options, with crossings and unit state as players see them
+	options := gc.RTGame.RenderOptions()
 	options.ShowUnitLabels = showLabels
 	options.ShowTileLabels = showTileLabels
+	if options.Heatmap, err = mapHeatmap(gc.RTGame); err != nil {
+		return err
+	}
 
 	// Render the map
 	pngData, _, err := renderer.Render(state.WorldData.TilesMap, state.WorldData.UnitsMap, options)
@@ -93,3 +107,30 @@ func runMap(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// mapHeatmap computes the tactical overlays asked for, influence first so
+// threats and reach are shaded over it
+func mapHeatmap(game *lib.Game) (cells []lib.HeatmapCell, err error) {
+	if mapInfluence {
+		cells = append(cells, game.InfluenceMap()...)
+	}
+	if mapThreat > 0 {
+		threats, err := game.ThreatMap(mapThreat)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute threats: %w", err)
+		}
+		cells = append(cells, threats...)
+	}
+	if mapReach != "" {
+		target, err := lib.ParsePositionOrUnit(game, mapReach)
+		if err != nil {
+			return nil, fmt.Errorf("invalid position %s: %w", mapReach, err)
+		}
+		reach, err := game.ReachMap(target.GetCoordinate())
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute reach: %w", err)
+		}
+		cells = append(cells, reach...)
+	}
+	return cells, nil
+}
@@ -30,6 +30,8 @@ Authentication:
   Uses stored credentials from profiles. You can also provide tokens
   directly via --source-token and --dest-token flags.
 
+To migrate a game (with its state, history and world) use 'ww migrate game'.
+
 Examples:
   # Migrate using profile shorthand (recommended)
   ww migrate fsbe:01bdc3ce prod:arube
@@ -49,8 +51,8 @@ Examples:
 
 func init() {
 	rootCmd.AddCommand(migrateCmd)
-	migrateCmd.Flags().StringVar(&sourceToken, "source-token", "", "Auth token for source server (overrides stored credentials)")
-	migrateCmd.Flags().StringVar(&destToken, "dest-token", "", "Auth token for destination server (overrides stored credentials)")
+	migrateCmd.PersistentFlags().StringVar(&sourceToken, "source-token", "", "Auth token for source server (overrides stored credentials)")
+	migrateCmd.PersistentFlags().StringVar(&destToken, "dest-token", "", "Auth token for destination server (overrides stored credentials)")
 }
 
 func runMigrate(cmd *cobra.Command, args []string) error {
@@ -220,12 +222,12 @@ func findExistingWorld(ctx context.Context, client *connectclient.ConnectWorldsC
 	}
 
 	// Search by name in the world listing
-	listResp, err := client.ListWorlds(ctx, &v1.ListWorldsRequest{})
+	worlds, err := client.ListAllWorlds(ctx, &v1.ListWorldsRequest{})
 	if err != nil {
 		return "", 0
 	}
 
-	for _, w := range listResp.Items {
+	for _, w := range worlds {
 		if strings.EqualFold(w.Name, worldName) {
 			if !formatter.JSON {
 				fmt.Printf("Found world by name '%s' (id: %s)\n", worldName, w.Id)
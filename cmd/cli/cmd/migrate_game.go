@@ -0,0 +1,395 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/connectclient"
+	"google.golang.org/protobuf/proto"
+)
+
+// migrateGameCmd migrates a whole game between servers
+var migrateGameCmd = &cobra.Command{
+	Use:   "game <source> <dest>",
+	Short: "Migrate a game from one server to another",
+	Long: `Migrate a complete game - metadata, configuration, current state and
+full move history - from one LilBattle server to another.  The world the
+game was created from is migrated too unless the destination already has it.
+
+Source and destination can be specified as either:
+  - Profile shorthand: profile:gameId (e.g., fsbe:abc123, prod:abc123)
+  - Full URL: http://localhost:8080/api/v1/games/abc123
+
+Players' user IDs usually differ between servers - remap them with
+--map-user (repeatable).  Unmapped IDs are kept as they are.
+
+When the destination already has a game with the ID, --on-conflict decides:
+  fail       stop without changing anything (default)
+  skip       leave the destination game alone
+  overwrite  replace the destination game's state and history
+  rename     create the game under the ID the destination suggests
+
+Examples:
+  # Preview what would change
+  ww migrate game fsbe:abc123 prod:abc123 --dry-run
+
+  # Migrate, remapping the dev user IDs to production ones
+  ww migrate game fsbe:abc123 prod:abc123 \
+      --map-user alice=u_8f2c --map-user bob=u_19d4
+
+  # Replace an earlier migration of the same game
+  ww migrate game fsbe:abc123 prod:abc123 --on-conflict overwrite`,
+	Args: cobra.ExactArgs(2),
+	RunE: runMigrateGame,
+}
+
+var (
+	migrateUserMap    map[string]string
+	migrateOnConflict string
+	migrateDryRun     bool
+)
+
+func init() {
+	migrateCmd.AddCommand(migrateGameCmd)
+	migrateGameCmd.Flags().StringToStringVar(&migrateUserMap, "map-user", nil, "Remap a player's user ID (source=dest, repeatable)")
+	migrateGameCmd.Flags().StringVar(&migrateOnConflict, "on-conflict", "fail", "What to do if the game ID exists on the destination (fail, skip, overwrite, rename)")
+	migrateGameCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false, "Show what would be migrated without changing the destination")
+}
+
+// gameMigration is the plan for migrating one game, built before anything
+// is written so --dry-run can show it
+type gameMigration struct {
+	Source      *v1.GetGameResponse
+	Existing    *v1.GetGameResponse // destination game with the same ID, if any
+	DestGameID  string
+	WorldID     string
+	WorldAction string // "exists" or "create"
+	GameAction  string // "create", "overwrite", "rename" or "skip"
+	UserChanges []string
+	Unmapped    []string // player user IDs --map-user left alone
+}
+
+// remapGameUsers rewrites the creator and players' user IDs in game using
+// userMap, returning a description of each change
+func remapGameUsers(game *v1.Game, userMap map[string]string) []string {
+	var changes []string
+	if dest, ok := userMap[game.CreatorId]; ok && game.CreatorId != "" {
+		changes = append(changes, fmt.Sprintf("creator: %s -> %s", game.CreatorId, dest))
+		game.CreatorId = dest
+	}
+	for _, player := range game.GetConfig().GetPlayers() {
+		if dest, ok := userMap[player.UserId]; ok && player.UserId != "" {
+			changes = append(changes, fmt.Sprintf("player %d: %s -> %s", player.PlayerId, player.UserId, dest))
+			player.UserId = dest
+		}
+	}
+	return changes
+}
+
+// unmappedUsers lists the user IDs in game that userMap does not remap
+func unmappedUsers(game *v1.Game, userMap map[string]string) []string {
+	seen := map[string]bool{}
+	for _, player := range game.GetConfig().GetPlayers() {
+		if player.UserId != "" && userMap[player.UserId] == "" {
+			seen[player.UserId] = true
+		}
+	}
+	var users []string
+	for user := range seen {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+	return users
+}
+
+// countMoves returns the number of move groups and moves in a history
+func countMoves(history *v1.GameMoveHistory) (groups, moves int) {
+	for _, group := range history.GetGroups() {
+		groups++
+		moves += len(group.Moves)
+	}
+	return groups, moves
+}
+
+// diffGames describes how the destination game differs from the one being
+// migrated over it, one line per field ("field: dest -> source")
+func diffGames(dest, source *v1.GetGameResponse) []string {
+	var diffs []string
+	add := func(field string, from, to any) {
+		if fmt.Sprint(from) != fmt.Sprint(to) {
+			diffs = append(diffs, fmt.Sprintf("%s: %v -> %v", field, from, to))
+		}
+	}
+	add("name", dest.Game.GetName(), source.Game.GetName())
+	add("world", dest.Game.GetWorldId(), source.Game.GetWorldId())
+	add("status", gameStatusName(dest.State.GetStatus()), gameStatusName(source.State.GetStatus()))
+	add("turn", dest.State.GetTurnCounter(), source.State.GetTurnCounter())
+	add("current player", dest.State.GetCurrentPlayer(), source.State.GetCurrentPlayer())
+	add("units", len(dest.State.GetWorldData().GetUnitsMap()), len(source.State.GetWorldData().GetUnitsMap()))
+
+	destGroups, destMoves := countMoves(dest.History)
+	sourceGroups, sourceMoves := countMoves(source.History)
+	add("move groups", destGroups, sourceGroups)
+	add("moves", destMoves, sourceMoves)
+
+	destPlayers := map[int32]string{}
+	for _, player := range dest.Game.GetConfig().GetPlayers() {
+		destPlayers[player.PlayerId] = player.UserId
+	}
+	for _, player := range source.Game.GetConfig().GetPlayers() {
+		add(fmt.Sprintf("player %d user", player.PlayerId), destPlayers[player.PlayerId], player.UserId)
+	}
+	return diffs
+}
+
+func runMigrateGame(cmd *cobra.Command, args []string) error {
+	switch migrateOnConflict {
+	case "fail", "skip", "overwrite", "rename":
+	default:
+		return fmt.Errorf("invalid --on-conflict %q: must be fail, skip, overwrite or rename", migrateOnConflict)
+	}
+
+	source, err := parseGameSpec(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid source: %w", err)
+	}
+	dest, err := parseGameSpec(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid destination: %w", err)
+	}
+
+	srcToken := sourceToken
+	if srcToken == "" {
+		srcToken = source.Token
+	}
+	dstToken := destToken
+	if dstToken == "" {
+		dstToken = dest.Token
+	}
+
+	if isVerbose() {
+		fmt.Printf("[VERBOSE] Source: %s (game: %s, auth: %v)\n", source.Host, source.GameID, srcToken != "")
+		fmt.Printf("[VERBOSE] Dest: %s (game: %s, auth: %v)\n", dest.Host, dest.GameID, dstToken != "")
+	}
+
+	ctx := context.Background()
+	formatter := NewOutputFormatter()
+	sourceGames := connectclient.NewConnectGamesClientWithAuth(source.APIEndpoint(), srcToken)
+	sourceWorlds := connectclient.NewConnectWorldsClientWithAuth(source.APIEndpoint(), srcToken)
+	destGames := connectclient.NewConnectGamesClientWithAuth(dest.APIEndpoint(), dstToken)
+	destWorlds := connectclient.NewConnectWorldsClientWithAuth(dest.APIEndpoint(), dstToken)
+
+	if !formatter.JSON {
+		fmt.Printf("Fetching game '%s' from %s...\n", source.GameID, source.Host)
+	}
+	srcResp, err := sourceGames.GetGame(ctx, &v1.GetGameRequest{Id: source.GameID})
+	if err != nil {
+		return fmt.Errorf("failed to fetch source game: %w", err)
+	}
+	if srcResp.Game == nil || srcResp.State == nil {
+		return fmt.Errorf("source game not found: %s", source.GameID)
+	}
+	if srcResp.History == nil {
+		srcResp.History = &v1.GameMoveHistory{}
+	}
+
+	plan := &gameMigration{
+		Source:     srcResp,
+		DestGameID: dest.GameID,
+		WorldID:    srcResp.Game.WorldId,
+	}
+	plan.Unmapped = unmappedUsers(srcResp.Game, migrateUserMap)
+	plan.UserChanges = remapGameUsers(srcResp.Game, migrateUserMap)
+
+	// The world must exist before the game can be created from it
+	plan.WorldAction = "exists"
+	if resp, err := destWorlds.GetWorld(ctx, &v1.GetWorldRequest{Id: plan.WorldID}); err != nil || resp.World == nil {
+		plan.WorldAction = "create"
+	}
+
+	plan.GameAction = "create"
+	if existing, err := destGames.GetGame(ctx, &v1.GetGameRequest{Id: plan.DestGameID}); err == nil && existing.Game != nil {
+		plan.Existing = existing
+		plan.GameAction = migrateOnConflict
+		if migrateOnConflict == "fail" && !migrateDryRun {
+			return fmt.Errorf("game '%s' already exists on %s - use --on-conflict skip, overwrite or rename", plan.DestGameID, dest.Host)
+		}
+	}
+
+	if migrateDryRun {
+		return printGameMigration(formatter, plan, source, dest)
+	}
+	if plan.GameAction == "skip" {
+		return printGameMigrationResult(formatter, plan, source, dest)
+	}
+
+	if plan.WorldAction == "create" {
+		if !formatter.JSON {
+			fmt.Printf("Migrating world '%s'...\n", plan.WorldID)
+		}
+		if err := copyWorld(ctx, sourceWorlds, destWorlds, plan.WorldID); err != nil {
+			return err
+		}
+	}
+
+	if !formatter.JSON {
+		fmt.Printf("Migrating game to %s...\n", dest.Host)
+	}
+	if err := applyGameMigration(ctx, destGames, plan); err != nil {
+		return err
+	}
+	return printGameMigrationResult(formatter, plan, source, dest)
+}
+
+// copyWorld creates the source world on the destination under the same ID
+func copyWorld(ctx context.Context, source, dest *connectclient.ConnectWorldsClient, worldID string) error {
+	resp, err := source.GetWorld(ctx, &v1.GetWorldRequest{Id: worldID})
+	if err != nil {
+		return fmt.Errorf("failed to fetch source world: %w", err)
+	}
+	if resp.World == nil {
+		return fmt.Errorf("source world not found: %s", worldID)
+	}
+	created, err := dest.CreateWorld(ctx, &v1.CreateWorldRequest{World: resp.World, WorldData: resp.WorldData})
+	if err != nil {
+		return fmt.Errorf("failed to create destination world: %w", err)
+	}
+	if len(created.FieldErrors) > 0 {
+		return fmt.Errorf("destination refused world '%s': %v", worldID, created.FieldErrors)
+	}
+	return nil
+}
+
+// applyGameMigration creates (or reuses) the destination game then replaces
+// its state and history with the source's
+func applyGameMigration(ctx context.Context, client *connectclient.ConnectGamesClient, plan *gameMigration) error {
+	game := proto.Clone(plan.Source.Game).(*v1.Game)
+
+	if plan.GameAction == "create" || plan.GameAction == "rename" {
+		game.Id = plan.DestGameID
+		resp, err := client.CreateGame(ctx, &v1.CreateGameRequest{Game: game})
+		if err == nil && plan.GameAction == "rename" && resp.FieldErrors["id"] != "" {
+			// Taken - retry under the ID the destination suggested
+			game.Id = resp.FieldErrors["id"]
+			resp, err = client.CreateGame(ctx, &v1.CreateGameRequest{Game: game})
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create destination game: %w", err)
+		}
+		if len(resp.FieldErrors) > 0 {
+			return fmt.Errorf("destination refused game '%s': %v", game.Id, resp.FieldErrors)
+		}
+		plan.DestGameID = resp.Game.Id
+	}
+
+	state := proto.Clone(plan.Source.State).(*v1.GameState)
+	state.GameId = plan.DestGameID
+	history := proto.Clone(plan.Source.History).(*v1.GameMoveHistory)
+	history.GameId = plan.DestGameID
+
+	// Sent together so the destination saves the state as is rather than
+	// topping up its units
+	req := &v1.UpdateGameRequest{
+		GameId:     plan.DestGameID,
+		NewState:   state,
+		NewHistory: history,
+	}
+	if plan.GameAction == "overwrite" {
+		req.NewGame = game
+	}
+	if _, err := client.UpdateGame(ctx, req); err != nil {
+		return fmt.Errorf("failed to save game state and history: %w", err)
+	}
+	return nil
+}
+
+func printGameMigration(formatter *OutputFormatter, plan *gameMigration, source, dest *GameSpec) error {
+	groups, moves := countMoves(plan.Source.History)
+	var diffs []string
+	if plan.Existing != nil {
+		diffs = diffGames(plan.Existing, plan.Source)
+	}
+
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"dry_run":        true,
+			"source_server":  source.Host,
+			"source_game":    source.GameID,
+			"dest_server":    dest.Host,
+			"dest_game":      plan.DestGameID,
+			"world":          plan.WorldID,
+			"world_action":   plan.WorldAction,
+			"game_action":    plan.GameAction,
+			"user_changes":   plan.UserChanges,
+			"unmapped_users": plan.Unmapped,
+			"diff":           diffs,
+			"move_groups":    groups,
+			"moves":          moves,
+		})
+	}
+
+	fmt.Printf("Game: %s (%s) -> %s as '%s'\n", plan.Source.Game.Name, source.GameID, dest.Host, plan.DestGameID)
+	fmt.Printf("  Turn %d, %d move groups, %d moves\n", plan.Source.State.TurnCounter, groups, moves)
+	if plan.WorldAction == "create" {
+		fmt.Printf("  World '%s' will be created\n", plan.WorldID)
+	} else {
+		fmt.Printf("  World '%s' already exists\n", plan.WorldID)
+	}
+	for _, change := range plan.UserChanges {
+		fmt.Printf("  Remap %s\n", change)
+	}
+	if len(plan.Unmapped) > 0 {
+		fmt.Printf("  Unmapped users (kept as is): %s\n", strings.Join(plan.Unmapped, ", "))
+	}
+
+	switch plan.GameAction {
+	case "create":
+		fmt.Println("  Game will be created")
+	case "fail":
+		fmt.Println("  Game already exists - the migration would fail (see --on-conflict)")
+	case "skip":
+		fmt.Println("  Game already exists and will be skipped")
+	case "rename":
+		fmt.Println("  Game already exists - it will be created under a new ID")
+	case "overwrite":
+		fmt.Println("  Game already exists and will be overwritten:")
+		if len(diffs) == 0 {
+			fmt.Println("    (no differences)")
+		}
+		for _, diff := range diffs {
+			fmt.Printf("    %s\n", diff)
+		}
+	}
+	fmt.Println("Dry run - nothing was changed.")
+	return nil
+}
+
+func printGameMigrationResult(formatter *OutputFormatter, plan *gameMigration, source, dest *GameSpec) error {
+	groups, moves := countMoves(plan.Source.History)
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"source_server": source.Host,
+			"source_game":   source.GameID,
+			"dest_server":   dest.Host,
+			"dest_game":     plan.DestGameID,
+			"world":         plan.WorldID,
+			"world_action":  plan.WorldAction,
+			"game_action":   plan.GameAction,
+			"user_changes":  plan.UserChanges,
+			"move_groups":   groups,
+			"moves":         moves,
+		})
+	}
+
+	if plan.GameAction == "skip" {
+		fmt.Printf("Game '%s' already exists on %s - skipped.\n", plan.DestGameID, dest.Host)
+		return nil
+	}
+	fmt.Printf("Game migrated (%s, dest id: %s) with %d move groups.\n", plan.GameAction, plan.DestGameID, groups)
+	fmt.Println("Migration complete!")
+	return nil
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+func migrationTestGame() *v1.Game {
+	return &v1.Game{
+		Id:        "g1",
+		CreatorId: "alice",
+		WorldId:   "desert",
+		Config: &v1.GameConfiguration{Players: []*v1.GamePlayer{
+			{PlayerId: 1, UserId: "alice"},
+			{PlayerId: 2, UserId: "bob"},
+			{PlayerId: 3, PlayerType: "ai"},
+		}},
+	}
+}
+
+func TestRemapGameUsers(t *testing.T) {
+	game := migrationTestGame()
+	userMap := map[string]string{"alice": "u_1", "carol": "u_3"}
+
+	if got := unmappedUsers(game, userMap); fmt.Sprint(got) != "[bob]" {
+		t.Errorf("Expected bob to be unmapped, got %v", got)
+	}
+	changes := remapGameUsers(game, userMap)
+	if len(changes) != 2 {
+		t.Errorf("Expected the creator and player 1 to be remapped, got %v", changes)
+	}
+	players := game.Config.Players
+	if game.CreatorId != "u_1" || players[0].UserId != "u_1" || players[1].UserId != "bob" || players[2].UserId != "" {
+		t.Errorf("Unexpected remapped game: creator %s, players %v", game.CreatorId, players)
+	}
+}
+
+func TestDiffGames(t *testing.T) {
+	dest := &v1.GetGameResponse{
+		Game:    migrationTestGame(),
+		State:   &v1.GameState{TurnCounter: 3, CurrentPlayer: 1},
+		History: &v1.GameMoveHistory{Groups: []*v1.GameMoveGroup{{GroupNumber: 1, Moves: []*v1.GameMove{{}}}}},
+	}
+	source := &v1.GetGameResponse{
+		Game:  migrationTestGame(),
+		State: &v1.GameState{TurnCounter: 5, CurrentPlayer: 1},
+		History: &v1.GameMoveHistory{Groups: []*v1.GameMoveGroup{
+			{GroupNumber: 1, Moves: []*v1.GameMove{{}}},
+			{GroupNumber: 2, Moves: []*v1.GameMove{{}, {}}},
+		}},
+	}
+	source.Game.Config.Players[1].UserId = "u_2"
+
+	want := "[turn: 3 -> 5 move groups: 1 -> 2 moves: 1 -> 3 player 2 user: bob -> u_2]"
+	if got := fmt.Sprint(diffGames(dest, source)); got != want {
+		t.Errorf("diffGames = %s, want %s", got, want)
+	}
+	if diffs := diffGames(source, source); len(diffs) != 0 {
+		t.Errorf("Expected no differences between a game and itself, got %v", diffs)
+	}
+}
+
+func TestExtractResourceID(t *testing.T) {
+	tests := []struct {
+		url, collection, want string
+		wantErr               bool
+	}{
+		{"http://localhost:8080/api/v1/games/abc123", "games", "abc123", false},
+		{"https://prod.example.com/api/v1/games/abc123/", "games", "abc123", false},
+		{"http://localhost:8080/api/v1/worlds/Desert", "worlds", "Desert", false},
+		{"http://localhost:8080/api/v1/worlds/Desert", "games", "", true},
+		{"http://localhost:8080/api/v1/games/", "games", "", true},
+	}
+	for _, tt := range tests {
+		got, err := extractResourceID(tt.url, tt.collection)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("extractResourceID(%q, %q) = %q, %v", tt.url, tt.collection, got, err)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// replayCmd exports a game's move history as an animation
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Export an animated replay of the game",
+	Long: `Render the game's move history as an animation with a frame per move.
+Frames show the paths units moved along, flashes where attacks landed and
+the turn and player to move.  GIF and APNG replays need a PNG asset theme
+(default); --frames writes one image per move in any theme.
+
+Examples:
+  ww replay --gif                       # writes <gameId>.gif
+  ww replay --apng -o replay.png
+  ww replay --gif --delay 500ms --hold 3
+  ww replay --frames out/ --theme fantasy`,
+	RunE: runReplay,
+}
+
+var (
+	replayGIF       bool
+	replayAPNG      bool
+	replayFramesDir string
+	replayOutput    string
+	replayTheme     string
+	replayDelay     time.Duration
+	replayHold      int
+	replayLabels    bool
+)
+
+func init() {
+	rootCmd.AddCommand(replayCmd)
+	replayCmd.Flags().BoolVar(&replayGIF, "gif", false, "Write an animated GIF (default)")
+	replayCmd.Flags().BoolVar(&replayAPNG, "apng", false, "Write an animated PNG")
+	replayCmd.Flags().StringVar(&replayFramesDir, "frames", "", "Write each frame to this directory instead")
+	replayCmd.Flags().StringVarP(&replayOutput, "output", "o", "", "File to write the animation to (default <gameId>.gif or .png)")
+	replayCmd.Flags().StringVar(&replayTheme, "theme", "default", "Theme to render with")
+	replayCmd.Flags().DurationVar(&replayDelay, "delay", themes.DefaultReplayFrameDelay, "How long each move is shown")
+	replayCmd.Flags().IntVar(&replayHold, "hold", 2, "Extra delays to hold the last frame for")
+	replayCmd.Flags().BoolVar(&replayLabels, "labels", false, "Show unit labels (Shortcut:MP/Health)")
+}
+
+func runReplay(cmd *cobra.Command, args []string) error {
+	if replayGIF && replayAPNG {
+		return fmt.Errorf("--gif and --apng cannot be used together")
+	}
+	gc, err := GetGameContext()
+	if err != nil {
+		return err
+	}
+	if gc.State == nil || gc.State.WorldData == nil {
+		return fmt.Errorf("game state not initialized")
+	}
+
+	render := lib.DefaultRenderOptions()
+	render.ShowUnitLabels = replayLabels
+	options := &themes.ReplayOptions{Render: render, FrameDelay: replayDelay, HoldLast: replayHold}
+	formatter := NewOutputFormatter()
+
+	if replayFramesDir != "" {
+		frames, contentType, err := services.RenderGameReplayFrames(gc.Game, gc.State, gc.History, replayTheme, options)
+		if err != nil {
+			return fmt.Errorf("failed to render replay: %w", err)
+		}
+		if err := os.MkdirAll(replayFramesDir, 0755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", replayFramesDir, err)
+		}
+		ext := strings.TrimPrefix(strings.TrimSuffix(contentType, "+xml"), "image/")
+		for i, frame := range frames {
+			path := filepath.Join(replayFramesDir, fmt.Sprintf("frame-%04d.%s", i, ext))
+			if err := os.WriteFile(path, frame, 0644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", path, err)
+			}
+		}
+		if formatter.JSON {
+			return formatter.PrintJSON(map[string]any{
+				"game_id": gc.GameID,
+				"dir":     replayFramesDir,
+				"frames":  len(frames),
+			})
+		}
+		fmt.Printf("Wrote %d frame(s) to %s\n", len(frames), replayFramesDir)
+		return nil
+	}
+
+	format, ext := themes.ReplayFormatGIF, ".gif"
+	if replayAPNG {
+		format, ext = themes.ReplayFormatAPNG, ".png"
+	}
+	data, _, err := services.RenderGameReplay(gc.Game, gc.State, gc.History, replayTheme, format, options)
+	if err != nil {
+		return fmt.Errorf("failed to render replay: %w", err)
+	}
+	output := replayOutput
+	if output == "" {
+		output = gc.GameID + ext
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"game_id": gc.GameID,
+			"file":    output,
+			"format":  format,
+			"bytes":   len(data),
+		})
+	}
+	fmt.Printf("Replay saved to %s (%d bytes)\n", output, len(data))
+	return nil
+}
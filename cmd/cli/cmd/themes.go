@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// themesCmd is the parent of the theme commands
+var themesCmd = &cobra.Command{
+	Use:   "themes",
+	Short: "List, validate and preview rendering themes",
+	Long: `List, validate and preview the themes maps are rendered in.
+
+Besides the built in themes, theme packs are loaded from the subdirectories
+of --dir (env: LILBATTLE_THEMES_DIR): each with a mapping.json manifest and
+the assets it refers to.  Themes can also be given as a pack directory.`,
+}
+
+var themesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List available themes",
+	Args:  cobra.NoArgs,
+	RunE:  runThemesList,
+}
+
+var themesValidateCmd = &cobra.Command{
+	Use:   "validate [theme|dir]...",
+	Short: "Check themes have every unit and terrain of the rules",
+	Long: `Check a theme has a mapping and assets in every player's colours for
+every unit and terrain in the rules.  Validates all themes when none are
+given and fails if any has issues.
+
+Examples:
+  ww themes validate
+  ww themes validate fantasy
+  ww themes validate ./packs/pixel`,
+	RunE: runThemesValidate,
+}
+
+var themesPreviewCmd = &cobra.Command{
+	Use:   "preview <theme|dir>",
+	Short: "Render a contact sheet of a theme",
+	Long: `Render every terrain and unit of the rules in a theme on one map:
+the terrains, city terrains in each player's colours, then each player's
+units.
+
+Examples:
+  ww themes preview modern                  # writes modern-contact.svg
+  ww themes preview ./packs/pixel -o pixel.png --players 2`,
+	Args: cobra.ExactArgs(1),
+	RunE: runThemesPreview,
+}
+
+var (
+	themesDir            string
+	themesPreviewOutput  string
+	themesPreviewPlayers int
+)
+
+func init() {
+	rootCmd.AddCommand(themesCmd)
+	themesCmd.AddCommand(themesListCmd)
+	themesCmd.AddCommand(themesValidateCmd)
+	themesCmd.AddCommand(themesPreviewCmd)
+
+	themesCmd.PersistentFlags().StringVar(&themesDir, "dir", os.Getenv("LILBATTLE_THEMES_DIR"), "Directory of theme packs to load")
+	themesPreviewCmd.Flags().StringVarP(&themesPreviewOutput, "output", "o", "", "File to write the contact sheet to (default <theme>-contact.png or .svg)")
+	themesPreviewCmd.Flags().IntVar(&themesPreviewPlayers, "players", 4, "Number of players to show colours for")
+}
+
+// loadThemePacks registers the packs in --dir, warning about broken ones
+func loadThemePacks() {
+	if themesDir == "" {
+		return
+	}
+	if _, err := themes.LoadThemePacks(themesDir); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
+
+// resolveTheme creates a registered theme, or loads the pack in a directory
+func resolveTheme(nameOrDir string) (string, themes.Theme, error) {
+	cityTerrains := lib.DefaultRulesEngine().GetCityTerrains()
+	if themes.DefaultRegistry.Has(nameOrDir) {
+		theme, err := themes.CreateTheme(nameOrDir, cityTerrains)
+		return nameOrDir, theme, err
+	}
+	if _, err := os.Stat(filepath.Join(nameOrDir, themes.ThemePackManifest)); err == nil {
+		theme, err := themes.LoadThemePack(nameOrDir, cityTerrains)
+		return filepath.Base(filepath.Clean(nameOrDir)), theme, err
+	}
+	return "", nil, fmt.Errorf("unknown theme: %s", nameOrDir)
+}
+
+func runThemesList(cmd *cobra.Command, args []string) error {
+	loadThemePacks()
+	formatter := NewOutputFormatter()
+	var items []map[string]any
+	for _, name := range themes.DefaultRegistry.GetAvailableThemes() {
+		_, theme, err := resolveTheme(name)
+		if err != nil {
+			return err
+		}
+		info := theme.GetThemeInfo()
+		items = append(items, map[string]any{
+			"name":       name,
+			"title":      info.GetName(),
+			"asset_type": info.GetAssetType(),
+			"asset_dir":  theme.AssetDir(),
+		})
+	}
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{"themes": items})
+	}
+
+	fmt.Printf("%-12s %-24s %-5s %s\n", "NAME", "TITLE", "TYPE", "ASSETS")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, item := range items {
+		fmt.Printf("%-12s %-24s %-5s %s\n", item["name"], truncate(item["title"].(string), 24), item["asset_type"], item["asset_dir"])
+	}
+	return nil
+}
+
+func runThemesValidate(cmd *cobra.Command, args []string) error {
+	loadThemePacks()
+	if len(args) == 0 {
+		args = themes.DefaultRegistry.GetAvailableThemes()
+	}
+
+	rules := lib.DefaultRulesEngine()
+	formatter := NewOutputFormatter()
+	results := map[string]any{}
+	total := 0
+	for _, arg := range args {
+		name, theme, err := resolveTheme(arg)
+		if err != nil {
+			return err
+		}
+		issues := themes.ValidateTheme(theme, rules)
+		total += len(issues)
+		if formatter.JSON {
+			messages := []string{}
+			for _, issue := range issues {
+				messages = append(messages, issue.String())
+			}
+			results[name] = messages
+			continue
+		}
+		if len(issues) == 0 {
+			fmt.Printf("%s: ok\n", name)
+			continue
+		}
+		fmt.Printf("%s: %d issue(s)\n", name, len(issues))
+		for _, issue := range issues {
+			fmt.Printf("  %s\n", issue)
+		}
+	}
+	if formatter.JSON {
+		if err := formatter.PrintJSON(map[string]any{"themes": results}); err != nil {
+			return err
+		}
+	}
+	if total > 0 {
+		return fmt.Errorf("%d theme issue(s) found", total)
+	}
+	return nil
+}
+
+func runThemesPreview(cmd *cobra.Command, args []string) error {
+	loadThemePacks()
+	name, theme, err := resolveTheme(args[0])
+	if err != nil {
+		return err
+	}
+	data, contentType, err := themes.ContactSheet(theme, lib.DefaultRulesEngine(), themesPreviewPlayers)
+	if err != nil {
+		return fmt.Errorf("failed to render contact sheet: %w", err)
+	}
+
+	output := themesPreviewOutput
+	if output == "" {
+		output = name + "-contact." + strings.TrimPrefix(strings.TrimSuffix(contentType, "+xml"), "image/")
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"theme": name,
+			"file":  output,
+			"bytes": len(data),
+		})
+	}
+	fmt.Printf("Contact sheet saved to %s (%d bytes)\n", output, len(data))
+	return nil
+}
@@ -26,7 +26,10 @@ Examples:
   ww worlds list --profile prod   # list worlds on prod profile
   ww worlds get aruba             # get world details
   ww worlds get prod:aruba        # get world from specific profile
-  ww worlds show aruba            # render world map inline`,
+  ww worlds show aruba            # render world map inline
+  ww worlds search island         # search worlds by text and facets
+  ww worlds revisions aruba       # list the world's revisions
+  ww worlds remixes aruba         # list the worlds copied from it`,
 }
 
 // worldsListCmd lists all worlds
@@ -160,7 +163,7 @@ func runWorldsList(cmd *cobra.Command, args []string) error {
 	}
 
 	ctx := context.Background()
-	resp, err := client.ListWorlds(ctx, &v1.ListWorldsRequest{})
+	worlds, err := client.ListAllWorlds(ctx, &v1.ListWorldsRequest{})
 	if err != nil {
 		return fmt.Errorf("failed to list worlds: %w", err)
 	}
@@ -169,7 +172,7 @@ func runWorldsList(cmd *cobra.Command, args []string) error {
 
 	if formatter.JSON {
 		items := []map[string]any{}
-		for _, w := range resp.Items {
+		for _, w := range worlds {
 			items = append(items, worldSummaryMap(w))
 		}
 		return formatter.PrintJSON(map[string]any{
@@ -178,7 +181,7 @@ func runWorldsList(cmd *cobra.Command, args []string) error {
 		})
 	}
 
-	if len(resp.Items) == 0 {
+	if len(worlds) == 0 {
 		fmt.Println("No worlds found.")
 		return nil
 	}
@@ -186,7 +189,7 @@ func runWorldsList(cmd *cobra.Command, args []string) error {
 	// Table-style output
 	fmt.Printf("%-20s %-30s %-12s\n", "ID", "NAME", "DIFFICULTY")
 	fmt.Println(strings.Repeat("-", 64))
-	for _, w := range resp.Items {
+	for _, w := range worlds {
 		difficulty := w.Difficulty
 		if difficulty == "" {
 			difficulty = "-"
@@ -197,7 +200,7 @@ func runWorldsList(cmd *cobra.Command, args []string) error {
 			difficulty,
 		)
 	}
-	fmt.Printf("\n%d world(s)\n", len(resp.Items))
+	fmt.Printf("\n%d world(s)\n", len(worlds))
 
 	return nil
 }
@@ -237,6 +240,10 @@ func runWorldsGet(cmd *cobra.Command, args []string) error {
 		if resp.WorldData != nil {
 			data["version"] = resp.WorldData.Version
 		}
+		if resp.World.ParentWorldId != "" {
+			data["parent_world_id"] = resp.World.ParentWorldId
+			data["parent_revision"] = resp.World.ParentRevision
+		}
 		if resp.World.CreatedAt != nil {
 			data["created_at"] = resp.World.CreatedAt.AsTime().Format("2006-01-02 15:04:05")
 		}
@@ -264,6 +271,9 @@ func runWorldsGet(cmd *cobra.Command, args []string) error {
 	if resp.WorldData != nil {
 		sb.WriteString(fmt.Sprintf("  Version:     %d\n", resp.WorldData.Version))
 	}
+	if resp.World.ParentWorldId != "" {
+		sb.WriteString(fmt.Sprintf("  Remix of:    %s (revision %d)\n", resp.World.ParentWorldId, resp.World.ParentRevision))
+	}
 	if resp.World.CreatedAt != nil {
 		sb.WriteString(fmt.Sprintf("  Created:     %s\n", resp.World.CreatedAt.AsTime().Format("2006-01-02 15:04:05")))
 	}
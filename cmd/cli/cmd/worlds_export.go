@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// worldsExportCmd renders a world as a printable poster
+var worldsExportCmd = &cobra.Command{
+	Use:   "export <id>",
+	Short: "Export a world as a printable PDF or SVG poster",
+	Long: `Render a world at print resolution for tabletop play.  Every hex is
+labelled with its Q,R and rRow,Col coordinates (as ww commands take them)
+and a legend names the theme's terrains and units.
+
+PDF posters are tiled over as many pages of --paper as the map needs and
+need a PNG asset theme.  SVG posters are a single page sized in
+millimetres for a large format printer.
+
+The world ID can include a profile prefix (profile:id).
+
+Examples:
+  ww worlds export aruba --pdf                      # writes aruba-poster.pdf
+  ww worlds export aruba --pdf --paper a3 --landscape --hex-size 25
+  ww worlds export aruba --svg --theme fantasy -o aruba.svg`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorldsExport,
+}
+
+var (
+	exportPDF       bool
+	exportSVG       bool
+	exportOutput    string
+	exportTheme     string
+	exportTitle     string
+	exportPaper     string
+	exportHexSize   float64
+	exportDPI       int
+	exportLandscape bool
+	exportNoLegend  bool
+)
+
+func init() {
+	worldsCmd.AddCommand(worldsExportCmd)
+	worldsExportCmd.Flags().BoolVar(&exportPDF, "pdf", false, "Export a multi-page PDF (the default)")
+	worldsExportCmd.Flags().BoolVar(&exportSVG, "svg", false, "Export a single SVG poster")
+	worldsExportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "File to write (default <id>-poster.pdf or .svg)")
+	worldsExportCmd.Flags().StringVar(&exportTheme, "theme", "default", "Theme to render in")
+	worldsExportCmd.Flags().StringVar(&exportTitle, "title", "", "Title printed on each page (default the world's name)")
+	worldsExportCmd.Flags().StringVar(&exportPaper, "paper", themes.DefaultPosterPaper, "Paper size for PDFs (a4, a3, letter, tabloid)")
+	worldsExportCmd.Flags().Float64Var(&exportHexSize, "hex-size", themes.DefaultPosterHexSize, "Width of a hex on paper in millimetres")
+	worldsExportCmd.Flags().IntVar(&exportDPI, "dpi", themes.DefaultPosterDPI, "Resolution the map is rendered at")
+	worldsExportCmd.Flags().BoolVar(&exportLandscape, "landscape", false, "Use landscape pages")
+	worldsExportCmd.Flags().BoolVar(&exportNoLegend, "no-legend", false, "Leave out the legend")
+	worldsExportCmd.MarkFlagsMutuallyExclusive("pdf", "svg")
+}
+
+func runWorldsExport(cmd *cobra.Command, args []string) error {
+	format := themes.PosterFormatPDF
+	if exportSVG {
+		format = themes.PosterFormatSVG
+	}
+
+	client, worldID, err := getWorldsClient(args[0])
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetWorld(context.Background(), &v1.GetWorldRequest{Id: worldID})
+	if err != nil {
+		return fmt.Errorf("failed to get world: %w", err)
+	}
+	if resp.WorldData == nil {
+		return fmt.Errorf("world %s has no map data", worldID)
+	}
+	lib.MigrateWorldData(resp.WorldData)
+
+	loadThemePacks()
+	options := &themes.PosterOptions{
+		Title:      exportTitle,
+		HexSize:    exportHexSize,
+		DPI:        exportDPI,
+		Paper:      exportPaper,
+		Landscape:  exportLandscape,
+		ShowLegend: !exportNoLegend,
+	}
+	if options.Title == "" {
+		options.Title = resp.World.GetName()
+	}
+	data, _, err := services.RenderWorldPoster(resp.WorldData, exportTheme, format, options)
+	if err != nil {
+		return fmt.Errorf("failed to render poster: %w", err)
+	}
+
+	output := exportOutput
+	if output == "" {
+		output = worldID + "-poster." + format
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"world":  worldID,
+			"format": format,
+			"file":   output,
+			"bytes":  len(data),
+		})
+	}
+	fmt.Printf("Poster saved to %s (%d bytes)\n", output, len(data))
+	return nil
+}
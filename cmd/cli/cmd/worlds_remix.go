@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// worldsRemixesCmd lists the worlds copied from a world
+var worldsRemixesCmd = &cobra.Command{
+	Use:   "remixes <id>",
+	Short: "List the worlds copied from a world",
+	Long: `List the worlds copied (remixed) from a world, newest first.
+
+The world ID can include a profile prefix (profile:id).
+
+Examples:
+  ww worlds remixes aruba
+  ww worlds remixes prod:aruba --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorldsRemixes,
+}
+
+// worldsMergeUpstreamCmd pulls a parent world's changes into a copy
+var worldsMergeUpstreamCmd = &cobra.Command{
+	Use:   "merge-upstream <id>",
+	Short: "Merge changes to a world's parent into it",
+	Long: `Merge the changes made to the world a copy was made from since it was
+copied (or last merged).  Tiles, units and crossings are merged cell by
+cell against the parent revision the copy records, so fixes upstream are
+picked up without losing the copy's own edits.
+
+Cells both worlds changed differently are conflicts.  By default they are
+listed and nothing is merged; --strategy ours or theirs settles them.
+
+Examples:
+  ww worlds merge-upstream my-aruba --dry-run
+  ww worlds merge-upstream my-aruba
+  ww worlds merge-upstream my-aruba --strategy theirs
+  ww worlds merge-upstream my-aruba --revision 7`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorldsMergeUpstream,
+}
+
+var (
+	mergeUpstreamRevision int64
+	mergeUpstreamStrategy string
+	mergeUpstreamDryRun   bool
+)
+
+func init() {
+	worldsCmd.AddCommand(worldsRemixesCmd)
+	worldsCmd.AddCommand(worldsMergeUpstreamCmd)
+	worldsMergeUpstreamCmd.Flags().Int64Var(&mergeUpstreamRevision, "revision", 0, "Parent revision to merge (default the latest)")
+	worldsMergeUpstreamCmd.Flags().StringVar(&mergeUpstreamStrategy, "strategy", "", "How to settle conflicts (ours, theirs)")
+	worldsMergeUpstreamCmd.Flags().BoolVar(&mergeUpstreamDryRun, "dry-run", false, "Show what would be merged without saving")
+}
+
+func runWorldsRemixes(cmd *cobra.Command, args []string) error {
+	client, worldID, err := getWorldsClient(args[0])
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	var remixes []*v1.World
+	req := &v1.ListDerivedWorldsRequest{WorldId: worldID, Pagination: &v1.Pagination{}}
+	for {
+		resp, err := client.ListDerivedWorlds(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to list remixes: %w", err)
+		}
+		remixes = append(remixes, resp.Items...)
+		if !resp.Pagination.GetHasMore() || resp.Pagination.NextPageKey == "" {
+			break
+		}
+		req.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		items := []map[string]any{}
+		for _, w := range remixes {
+			item := worldSummaryMap(w)
+			item["creator_id"] = w.CreatorId
+			item["parent_revision"] = w.ParentRevision
+			items = append(items, item)
+		}
+		return formatter.PrintJSON(map[string]any{
+			"world_id": worldID,
+			"remixes":  items,
+		})
+	}
+
+	fmt.Printf("%-24s %-30s %-16s %s\n", "ID", "NAME", "CREATOR", "FROM REVISION")
+	fmt.Println(strings.Repeat("-", 84))
+	for _, w := range remixes {
+		creator := w.CreatorId
+		if creator == "" {
+			creator = "-"
+		}
+		fmt.Printf("%-24s %-30s %-16s %d\n", truncate(w.Id, 24), truncate(w.Name, 30), truncate(creator, 16), w.ParentRevision)
+	}
+	fmt.Printf("\n%d remix(es)\n", len(remixes))
+	return nil
+}
+
+func runWorldsMergeUpstream(cmd *cobra.Command, args []string) error {
+	strategy := v1.WorldMergeStrategy_WORLD_MERGE_STRATEGY_UNSPECIFIED
+	switch mergeUpstreamStrategy {
+	case "":
+	case "ours":
+		strategy = v1.WorldMergeStrategy_WORLD_MERGE_STRATEGY_OURS
+	case "theirs":
+		strategy = v1.WorldMergeStrategy_WORLD_MERGE_STRATEGY_THEIRS
+	default:
+		return fmt.Errorf("invalid strategy %q: must be ours or theirs", mergeUpstreamStrategy)
+	}
+	client, worldID, err := getWorldsClient(args[0])
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.MergeWorldUpstream(context.Background(), &v1.MergeWorldUpstreamRequest{
+		WorldId:          worldID,
+		UpstreamRevision: mergeUpstreamRevision,
+		Strategy:         strategy,
+		DryRun:           mergeUpstreamDryRun,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to merge upstream: %w", err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		applied := []map[string]any{}
+		for _, c := range resp.Applied {
+			applied = append(applied, map[string]any{"layer": c.Layer, "key": c.Key, "kind": changeKindName(c.Kind)})
+		}
+		conflicts := []map[string]any{}
+		for _, c := range resp.Conflicts {
+			conflicts = append(conflicts, map[string]any{
+				"layer": c.Layer, "key": c.Key, "ours": changeKindName(c.Ours), "theirs": changeKindName(c.Theirs),
+			})
+		}
+		return formatter.PrintJSON(map[string]any{
+			"world_id":          worldID,
+			"parent_world_id":   resp.World.GetParentWorldId(),
+			"base_revision":     resp.BaseRevision,
+			"upstream_revision": resp.UpstreamRevision,
+			"merged":            resp.Merged,
+			"applied":           applied,
+			"conflicts":         conflicts,
+		})
+	}
+
+	fmt.Printf("Merging %s revisions %d..%d into '%s'\n", resp.World.GetParentWorldId(), resp.BaseRevision, resp.UpstreamRevision, worldID)
+	for _, c := range resp.Applied {
+		fmt.Printf("  %-9s %-8s %s\n", changeKindName(c.Kind), c.Layer, c.Key)
+	}
+	for _, c := range resp.Conflicts {
+		fmt.Printf("  CONFLICT  %-8s %s (ours %s, theirs %s)\n", c.Layer, c.Key, changeKindName(c.Ours), changeKindName(c.Theirs))
+	}
+	switch {
+	case resp.Merged:
+		fmt.Printf("Merged %d change(s), world is now revision %d\n", len(resp.Applied), resp.WorldData.GetVersion())
+	case mergeUpstreamDryRun:
+		fmt.Printf("Dry run: %d change(s), %d conflict(s)\n", len(resp.Applied), len(resp.Conflicts))
+	default:
+		fmt.Printf("Not merged: %d conflict(s) - rerun with --strategy ours or theirs\n", len(resp.Conflicts))
+	}
+	return nil
+}
+
+// changeKindName returns the short lower case name of a change kind
+func changeKindName(kind v1.WorldChangeKind) string {
+	return strings.ToLower(strings.TrimPrefix(kind.String(), "WORLD_CHANGE_KIND_"))
+}
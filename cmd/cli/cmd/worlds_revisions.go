@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// worldsRevisionsCmd lists a world's revisions
+var worldsRevisionsCmd = &cobra.Command{
+	Use:   "revisions <id>",
+	Short: "List a world's revisions",
+	Long: `List the immutable revisions of a world, newest first.  Every save of
+the world's map makes a revision, and games stay pinned to the revision
+they were created from.
+
+The world ID can include a profile prefix (profile:id).
+
+Examples:
+  ww worlds revisions aruba
+  ww worlds revisions prod:aruba --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorldsRevisions,
+}
+
+// worldsRevertCmd reverts a world to an older revision
+var worldsRevertCmd = &cobra.Command{
+	Use:   "revert <id> <revision>",
+	Short: "Revert a world to an older revision",
+	Long: `Make an older revision of a world its latest map.  The old map is saved
+as a new revision, so nothing after it is lost and the revert can itself
+be reverted.  Only the world's creator can revert it.
+
+Examples:
+  ww worlds revert aruba 3`,
+	Args: cobra.ExactArgs(2),
+	RunE: runWorldsRevert,
+}
+
+func init() {
+	worldsCmd.AddCommand(worldsRevisionsCmd)
+	worldsCmd.AddCommand(worldsRevertCmd)
+}
+
+func runWorldsRevisions(cmd *cobra.Command, args []string) error {
+	client, worldID, err := getWorldsClient(args[0])
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	var revisions []*v1.WorldRevision
+	req := &v1.ListWorldRevisionsRequest{WorldId: worldID, Pagination: &v1.Pagination{}}
+	for {
+		resp, err := client.ListWorldRevisions(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to list revisions: %w", err)
+		}
+		revisions = append(revisions, resp.Items...)
+		if !resp.Pagination.GetHasMore() {
+			break
+		}
+		req.Pagination.PageOffset = resp.Pagination.NextPageOffset
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		items := []map[string]any{}
+		for _, r := range revisions {
+			item := map[string]any{
+				"revision":      r.Revision,
+				"author_id":     r.AuthorId,
+				"reverted_from": r.RevertedFrom,
+				"content_hash":  r.ContentHash,
+				"tiles":         r.TileCount,
+				"units":         r.UnitCount,
+			}
+			if r.CreatedAt != nil {
+				item["created_at"] = r.CreatedAt.AsTime()
+			}
+			items = append(items, item)
+		}
+		return formatter.PrintJSON(map[string]any{
+			"world_id":  worldID,
+			"revisions": items,
+		})
+	}
+
+	fmt.Printf("%-9s %-20s %-16s %6s %6s  %s\n", "REVISION", "CREATED", "AUTHOR", "TILES", "UNITS", "NOTE")
+	fmt.Println(strings.Repeat("-", 72))
+	for _, r := range revisions {
+		created, author, note := "-", r.AuthorId, ""
+		if r.CreatedAt != nil {
+			created = r.CreatedAt.AsTime().Local().Format("2006-01-02 15:04")
+		}
+		if author == "" {
+			author = "-"
+		}
+		if r.RevertedFrom > 0 {
+			note = fmt.Sprintf("reverted to %d", r.RevertedFrom)
+		}
+		fmt.Printf("%-9d %-20s %-16s %6d %6d  %s\n", r.Revision, created, truncate(author, 16), r.TileCount, r.UnitCount, note)
+	}
+	fmt.Printf("\n%d revision(s)\n", len(revisions))
+	return nil
+}
+
+func runWorldsRevert(cmd *cobra.Command, args []string) error {
+	revision, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid revision %q", args[1])
+	}
+	client, worldID, err := getWorldsClient(args[0])
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.RevertWorld(context.Background(), &v1.RevertWorldRequest{WorldId: worldID, Revision: revision})
+	if err != nil {
+		return fmt.Errorf("failed to revert world: %w", err)
+	}
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		return formatter.PrintJSON(map[string]any{
+			"world_id":      worldID,
+			"reverted_from": revision,
+			"revision":      resp.WorldData.GetVersion(),
+		})
+	}
+	fmt.Printf("Reverted world '%s' to revision %d (now revision %d)\n", worldID, revision, resp.WorldData.GetVersion())
+	return nil
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// worldsSearchCmd searches worlds by free text and facets
+var worldsSearchCmd = &cobra.Command{
+	Use:   "search [query]",
+	Short: "Search worlds by text, tags, difficulty and size",
+	Long: `Search the worlds on the server.  The query matches the start of words in
+world names, tags, difficulty and description, and every word has to match.
+Results are by relevance (or popularity without a query) and are followed
+by the tags, difficulties and player counts of all the matches.
+
+Examples:
+  ww worlds search island
+  ww worlds search --tag naval --difficulty easy
+  ww worlds search --players 2 --max-tiles 200 --sort popularity
+  ww worlds search desert --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWorldsSearch,
+}
+
+var (
+	worldSearchTags         []string
+	worldSearchDifficulties []string
+	worldSearchPlayers      int32
+	worldSearchMinTiles     int32
+	worldSearchMaxTiles     int32
+	worldSearchSort         string
+	worldSearchLimit        int32
+)
+
+func init() {
+	worldsCmd.AddCommand(worldsSearchCmd)
+
+	worldsSearchCmd.Flags().StringSliceVar(&worldSearchTags, "tag", nil, "Only worlds with this tag (repeatable, all must match)")
+	worldsSearchCmd.Flags().StringSliceVar(&worldSearchDifficulties, "difficulty", nil, "Only worlds of this difficulty (repeatable, any may match)")
+	worldsSearchCmd.Flags().Int32Var(&worldSearchPlayers, "players", 0, "Only worlds for this many players")
+	worldsSearchCmd.Flags().Int32Var(&worldSearchMinTiles, "min-tiles", 0, "Only worlds with at least this many tiles")
+	worldsSearchCmd.Flags().Int32Var(&worldSearchMaxTiles, "max-tiles", 0, "Only worlds with at most this many tiles")
+	worldsSearchCmd.Flags().StringVar(&worldSearchSort, "sort", "", "Sort by relevance, popularity, name or updated_at")
+	worldsSearchCmd.Flags().Int32Var(&worldSearchLimit, "limit", 20, "Maximum number of worlds to show")
+}
+
+func runWorldsSearch(cmd *cobra.Command, args []string) error {
+	client, _, err := getWorldsClient("")
+	if err != nil {
+		return err
+	}
+
+	req := &v1.SearchWorldsRequest{
+		Tags:         worldSearchTags,
+		Difficulties: worldSearchDifficulties,
+		MinPlayers:   worldSearchPlayers,
+		MaxPlayers:   worldSearchPlayers,
+		MinTiles:     worldSearchMinTiles,
+		MaxTiles:     worldSearchMaxTiles,
+		SortBy:       worldSearchSort,
+		Pagination:   &v1.Pagination{PageSize: worldSearchLimit},
+	}
+	if len(args) > 0 {
+		req.Query = args[0]
+	}
+	resp, err := client.SearchWorlds(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to search worlds: %w", err)
+	}
+	total := int(resp.Pagination.GetTotalResults())
+
+	formatter := NewOutputFormatter()
+	if formatter.JSON {
+		items := []map[string]any{}
+		for _, w := range resp.Items {
+			item := worldSummaryMap(w)
+			item["tags"] = w.Tags
+			items = append(items, item)
+		}
+		return formatter.PrintJSON(map[string]any{
+			"worlds":        items,
+			"total":         total,
+			"tags":          facetMap(resp.Tags),
+			"difficulties":  facetMap(resp.Difficulties),
+			"player_counts": facetMap(resp.PlayerCounts),
+		})
+	}
+
+	if len(resp.Items) == 0 {
+		fmt.Println("No worlds found.")
+		return nil
+	}
+
+	fmt.Printf("%-20s %-30s %-12s %s\n", "ID", "NAME", "DIFFICULTY", "TAGS")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, w := range resp.Items {
+		difficulty := w.Difficulty
+		if difficulty == "" {
+			difficulty = "-"
+		}
+		fmt.Printf("%-20s %-30s %-12s %s\n",
+			truncate(w.Id, 20),
+			truncate(w.Name, 30),
+			difficulty,
+			truncate(strings.Join(w.Tags, ","), 30),
+		)
+	}
+	fmt.Printf("\n%d of %d world(s)\n", len(resp.Items), total)
+
+	for _, facet := range []struct {
+		name   string
+		counts []*v1.SearchFacetCount
+	}{{"Tags", resp.Tags}, {"Difficulty", resp.Difficulties}, {"Players", resp.PlayerCounts}} {
+		if len(facet.counts) == 0 {
+			continue
+		}
+		var values []string
+		for _, count := range facet.counts {
+			values = append(values, fmt.Sprintf("%s (%d)", count.Value, count.Count))
+		}
+		fmt.Printf("%-11s %s\n", facet.name+":", strings.Join(values, ", "))
+	}
+	return nil
+}
+
+// facetMap converts the counts of a search facet to a map for JSON output
+func facetMap(counts []*v1.SearchFacetCount) map[string]int32 {
+	m := map[string]int32{}
+	for _, count := range counts {
+		m[count.Value] = count.Count
+	}
+	return m
+}
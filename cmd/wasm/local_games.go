@@ -0,0 +1,143 @@
+//go:build js && wasm
+// +build js,wasm
+
+package main
+
+import (
+	"fmt"
+	"syscall/js"
+
+	"github.com/turnforge/lilbattle/services/singleton"
+	pj "google.golang.org/protobuf/encoding/protojson"
+)
+
+// registerLocalGameFunctions adds the JS API for hot-seat games.  In
+// hot-seat mode the FE calls useLocalPersister instead of
+// registerMovePersister so moves are saved to the browser's local storage
+// rather than posted to the server:
+//
+//	lilbattle.useLocalPersister()        -> route SaveMoveGroup to local storage
+//	lilbattle.loadLocalGame(gameId)      -> {found, game, state, history} (protojson)
+//	lilbattle.saveLocalGame(gameId)      -> snapshot the loaded game into local storage
+//	lilbattle.exportLocalGame(gameId)    -> {data} save file for lib.LoadGame
+//	lilbattle.importLocalGame(data)      -> {gameId}
+//	lilbattle.listLocalGames()           -> {gameIds}
+//	lilbattle.deleteLocalGame(gameId)
+func registerLocalGameFunctions(lilbattleObj js.Value, gamesService *singleton.SingletonGamesService) {
+	var persister *singleton.LocalPersister
+	localPersister := func() (*singleton.LocalPersister, error) {
+		if persister == nil {
+			store, err := newBrowserLocalStore()
+			if err != nil {
+				return nil, err
+			}
+			persister = singleton.NewLocalPersister(store)
+		}
+		return persister, nil
+	}
+
+	// withPersister wraps a handler with argument count checks, persister
+	// setup and the usual {success, error} result shape
+	withPersister := func(name string, nargs int, handler func(p *singleton.LocalPersister, args []js.Value) (map[string]any, error)) js.Func {
+		return js.FuncOf(func(this js.Value, args []js.Value) any {
+			if len(args) != nargs {
+				return failure(fmt.Errorf("%s requires %d argument(s)", name, nargs))
+			}
+			p, err := localPersister()
+			if err != nil {
+				return failure(err)
+			}
+			result, err := handler(p, args)
+			if err != nil {
+				return failure(err)
+			}
+			if result == nil {
+				result = map[string]any{}
+			}
+			result["success"] = true
+			return result
+		})
+	}
+
+	lilbattleObj.Set("useLocalPersister", withPersister("useLocalPersister", 0, func(p *singleton.LocalPersister, args []js.Value) (map[string]any, error) {
+		gamesService.Persister = p
+		return nil, nil
+	}))
+
+	lilbattleObj.Set("loadLocalGame", withPersister("loadLocalGame", 1, func(p *singleton.LocalPersister, args []js.Value) (map[string]any, error) {
+		gameId := args[0].String()
+		found, err := p.Exists(gameId)
+		if err != nil || !found {
+			return map[string]any{"found": false}, err
+		}
+
+		save, err := p.Load(gameId)
+		if err != nil {
+			return nil, err
+		}
+		gameJSON, err := pj.Marshal(save.Game)
+		if err != nil {
+			return nil, err
+		}
+		stateJSON, err := pj.Marshal(save.State)
+		if err != nil {
+			return nil, err
+		}
+		historyJSON, err := pj.Marshal(save.History)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{
+			"found":   true,
+			"game":    string(gameJSON),
+			"state":   string(stateJSON),
+			"history": string(historyJSON),
+		}, nil
+	}))
+
+	lilbattleObj.Set("saveLocalGame", withPersister("saveLocalGame", 1, func(p *singleton.LocalPersister, args []js.Value) (map[string]any, error) {
+		if gamesService.SingletonGame.Id != args[0].String() {
+			return nil, fmt.Errorf("game %s is not loaded", args[0].String())
+		}
+		return nil, p.Create(gamesService.SingletonGame, gamesService.SingletonGameState, gamesService.SingletonGameMoveHistory)
+	}))
+
+	lilbattleObj.Set("exportLocalGame", withPersister("exportLocalGame", 1, func(p *singleton.LocalPersister, args []js.Value) (map[string]any, error) {
+		data, err := p.Export(args[0].String())
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"data": string(data)}, nil
+	}))
+
+	lilbattleObj.Set("importLocalGame", withPersister("importLocalGame", 1, func(p *singleton.LocalPersister, args []js.Value) (map[string]any, error) {
+		gameId, err := p.Import([]byte(args[0].String()))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"gameId": gameId}, nil
+	}))
+
+	lilbattleObj.Set("listLocalGames", withPersister("listLocalGames", 0, func(p *singleton.LocalPersister, args []js.Value) (map[string]any, error) {
+		ids, err := p.List()
+		if err != nil {
+			return nil, err
+		}
+		gameIds := make([]any, len(ids))
+		for i, id := range ids {
+			gameIds[i] = id
+		}
+		return map[string]any{"gameIds": gameIds}, nil
+	}))
+
+	lilbattleObj.Set("deleteLocalGame", withPersister("deleteLocalGame", 1, func(p *singleton.LocalPersister, args []js.Value) (map[string]any, error) {
+		return nil, p.Delete(args[0].String())
+	}))
+}
+
+func failure(err error) map[string]any {
+	return map[string]any{
+		"success": false,
+		"error":   err.Error(),
+	}
+}
@@ -0,0 +1,67 @@
+//go:build js && wasm
+// +build js,wasm
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"syscall/js"
+)
+
+// browserLocalStore is a singleton.LocalStore backed by window.localStorage
+// so hot-seat games survive page reloads with no server involved.  Values are
+// save files (JSON) so they are stored as strings as is.
+type browserLocalStore struct {
+	storage js.Value
+}
+
+// newBrowserLocalStore returns a store over window.localStorage or an error
+// if the browser does not provide one (eg storage disabled in private mode)
+func newBrowserLocalStore() (*browserLocalStore, error) {
+	storage := js.Global().Get("localStorage")
+	if !storage.Truthy() {
+		return nil, fmt.Errorf("localStorage is not available")
+	}
+	return &browserLocalStore{storage: storage}, nil
+}
+
+// Get returns the value for a key
+func (s *browserLocalStore) Get(key string) ([]byte, bool, error) {
+	value := s.storage.Call("getItem", key)
+	if value.IsNull() || value.IsUndefined() {
+		return nil, false, nil
+	}
+	return []byte(value.String()), true, nil
+}
+
+// Put stores the value for a key.  setItem throws when the storage quota is
+// exceeded which is surfaced as an error.
+func (s *browserLocalStore) Put(key string, value []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to write local storage: %v", r)
+		}
+	}()
+	s.storage.Call("setItem", key, string(value))
+	return nil
+}
+
+// Delete removes a key
+func (s *browserLocalStore) Delete(key string) error {
+	s.storage.Call("removeItem", key)
+	return nil
+}
+
+// Keys returns the keys starting with the prefix in sorted order
+func (s *browserLocalStore) Keys(prefix string) (out []string, err error) {
+	n := s.storage.Get("length").Int()
+	for i := 0; i < n; i++ {
+		if key := s.storage.Call("key", i); key.Truthy() && strings.HasPrefix(key.String(), prefix) {
+			out = append(out, key.String())
+		}
+	}
+	sort.Strings(out)
+	return
+}
@@ -172,6 +172,8 @@ func main() {
 		return map[string]any{"success": true}
 	}))
 
+	registerLocalGameFunctions(lilbattleObj, wasmGamesService)
+
 	fmt.Println("LilBattle WASM module loaded successfully")
 
 	// Keep the WASM module running
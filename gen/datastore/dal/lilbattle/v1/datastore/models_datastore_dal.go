@@ -1147,3 +1147,217 @@ func (d *GameMoveDatastoreDAL) Query(ctx context.Context, client *dslib.Client,
 func (d *GameMoveDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
 	return client.Count(ctx, q)
 }
+
+// UserGameDatastoreDAL provides database access helper methods for datastore.UserGameDatastore.
+type UserGameDatastoreDAL struct {
+	// Kind overrides the Datastore kind for all operations.
+	// If empty, uses the struct's Kind() method (if any).
+	Kind string
+
+	// Namespace overrides the Datastore namespace for all operations.
+	// If empty, uses the default namespace.
+	Namespace string
+
+	// WillPut hook is called before Put operations.
+	// Return an error to prevent the put.
+	WillPut func(context.Context, *datastore.UserGameDatastore) error
+}
+
+// NewUserGameDatastoreDAL creates a new UserGameDatastoreDAL instance.
+// If kind is empty, operations will use the struct's Kind() method.
+func NewUserGameDatastoreDAL(kind string) *UserGameDatastoreDAL {
+	return &UserGameDatastoreDAL{Kind: kind}
+}
+
+// getKind returns the kind to use for operations.
+// Uses the DAL's Kind field if set, otherwise falls back to the struct's Kind() method.
+func (d *UserGameDatastoreDAL) getKind() string {
+	if d.Kind != "" {
+		return d.Kind
+	}
+	// Fall back to struct's Kind() method
+	var entity datastore.UserGameDatastore
+	return entity.Kind()
+}
+
+// newKey creates a new Datastore key for the given ID.
+func (d *UserGameDatastoreDAL) newKey(id string) *dslib.Key {
+	key := dslib.NameKey(d.getKind(), id, nil)
+	if d.Namespace != "" {
+		key.Namespace = d.Namespace
+	}
+	return key
+}
+
+// newIncompleteKey creates a new incomplete Datastore key (for auto-generated IDs).
+func (d *UserGameDatastoreDAL) newIncompleteKey() *dslib.Key {
+	key := dslib.IncompleteKey(d.getKind(), nil)
+	if d.Namespace != "" {
+		key.Namespace = d.Namespace
+	}
+	return key
+}
+
+// Put saves a datastore.UserGameDatastore entity to Datastore.
+// If the entity's Key field is set, uses that key; otherwise creates a key from the ID field.
+// Returns the key used to store the entity.
+func (d *UserGameDatastoreDAL) Put(ctx context.Context, client *dslib.Client, obj *datastore.UserGameDatastore) (*dslib.Key, error) {
+	// Call WillPut hook if set
+	if d.WillPut != nil {
+		if err := d.WillPut(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	// Determine the key to use
+	var key *dslib.Key
+	if obj.Key != nil {
+		key = obj.Key
+		// Apply namespace override if set
+		if d.Namespace != "" {
+			key.Namespace = d.Namespace
+		}
+	} else {
+		key = d.newIncompleteKey()
+	}
+
+	// Put the entity
+	resultKey, err := client.Put(ctx, key, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the entity's key
+	obj.Key = resultKey
+
+	return resultKey, nil
+}
+
+// Get retrieves a datastore.UserGameDatastore entity by key.
+// Returns (nil, nil) if the entity is not found.
+func (d *UserGameDatastoreDAL) Get(ctx context.Context, client *dslib.Client, key *dslib.Key) (*datastore.UserGameDatastore, error) {
+	var entity datastore.UserGameDatastore
+	err := client.Get(ctx, key, &entity)
+	if err != nil {
+		if err == dslib.ErrNoSuchEntity {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entity.Key = key
+	return &entity, nil
+}
+
+// Delete removes a datastore.UserGameDatastore entity by key.
+func (d *UserGameDatastoreDAL) Delete(ctx context.Context, client *dslib.Client, key *dslib.Key) error {
+	return client.Delete(ctx, key)
+}
+
+// GetMulti retrieves multiple datastore.UserGameDatastore entities by keys.
+// Returns entities in the same order as the keys. Missing entities are nil in the result slice.
+func (d *UserGameDatastoreDAL) GetMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) ([]*datastore.UserGameDatastore, error) {
+	if len(keys) == 0 {
+		return []*datastore.UserGameDatastore{}, nil
+	}
+
+	entities := make([]datastore.UserGameDatastore, len(keys))
+	err := client.GetMulti(ctx, keys, entities)
+	if err != nil {
+		// Handle partial errors (some entities not found)
+		if multiErr, ok := err.(dslib.MultiError); ok {
+			result := make([]*datastore.UserGameDatastore, len(keys))
+			for i, e := range multiErr {
+				if e == nil {
+					entities[i].Key = keys[i]
+					result[i] = &entities[i]
+				} else if e != dslib.ErrNoSuchEntity {
+					return nil, err // Return on non-NotFound errors
+				}
+				// nil for not-found entities
+			}
+			return result, nil
+		}
+		return nil, err
+	}
+
+	// All entities found
+	result := make([]*datastore.UserGameDatastore, len(keys))
+	for i := range entities {
+		entities[i].Key = keys[i]
+		result[i] = &entities[i]
+	}
+	return result, nil
+}
+
+// PutMulti saves multiple datastore.UserGameDatastore entities to Datastore.
+// Returns the keys used to store the entities.
+func (d *UserGameDatastoreDAL) PutMulti(ctx context.Context, client *dslib.Client, objs []*datastore.UserGameDatastore) ([]*dslib.Key, error) {
+	if len(objs) == 0 {
+		return []*dslib.Key{}, nil
+	}
+
+	// Call WillPut hook for each entity
+	if d.WillPut != nil {
+		for _, obj := range objs {
+			if err := d.WillPut(ctx, obj); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Build keys for each entity
+	keys := make([]*dslib.Key, len(objs))
+	for i, obj := range objs {
+		if obj.Key != nil {
+			keys[i] = obj.Key
+			if d.Namespace != "" {
+				keys[i].Namespace = d.Namespace
+			}
+		} else {
+			keys[i] = d.newIncompleteKey()
+		}
+	}
+
+	// Put all entities
+	resultKeys, err := client.PutMulti(ctx, keys, objs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update entity keys
+	for i, key := range resultKeys {
+		objs[i].Key = key
+	}
+
+	return resultKeys, nil
+}
+
+// DeleteMulti removes multiple datastore.UserGameDatastore entities by keys.
+func (d *UserGameDatastoreDAL) DeleteMulti(ctx context.Context, client *dslib.Client, keys []*dslib.Key) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return client.DeleteMulti(ctx, keys)
+}
+
+// Query retrieves datastore.UserGameDatastore entities matching the query.
+// The caller should create a query using dslib.NewQuery(dal.getKind()).
+func (d *UserGameDatastoreDAL) Query(ctx context.Context, client *dslib.Client, q *dslib.Query) ([]*datastore.UserGameDatastore, error) {
+	var entities []*datastore.UserGameDatastore
+	keys, err := client.GetAll(ctx, q, &entities)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set keys on entities
+	for i, key := range keys {
+		entities[i].Key = key
+	}
+
+	return entities, nil
+}
+
+// Count returns the number of entities matching the query.
+func (d *UserGameDatastoreDAL) Count(ctx context.Context, client *dslib.Client, q *dslib.Query) (int, error) {
+	return client.Count(ctx, q)
+}
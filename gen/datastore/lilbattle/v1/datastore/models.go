@@ -124,6 +124,10 @@ type WorldDatastore struct {
 	DefaultGameConfig GameConfigurationDatastore `datastore:"default_game_config,noindex"`
 
 	SearchIndexInfo IndexInfoDatastore `datastore:"search_index_info,flatten"`
+
+	ParentWorldId string `datastore:"parent_world_id"`
+
+	ParentRevision int64 `datastore:"parent_revision"`
 }
 
 // Kind returns the Datastore kind name for WorldDatastore.
@@ -388,6 +392,10 @@ type GameDatastore struct {
 	PreviewUrls []string `datastore:"preview_urls,noindex"`
 
 	SearchIndexInfo IndexInfoDatastore `datastore:"search_index_info,flatten"`
+
+	WorldRevision int64 `datastore:"world_revision"`
+
+	RulesVersion string `datastore:"rules_version"`
 }
 
 // Kind returns the Datastore kind name for GameDatastore.
@@ -424,6 +432,8 @@ type GameStateDatastore struct {
 	CurrentGroupNumber int64 `datastore:"current_group_number"`
 
 	PlayerStates map[int32]PlayerStateDatastore `datastore:"player_states,noindex"`
+
+	PendingOrders map[int32]PlayerOrdersDatastore `datastore:"pending_orders,noindex"`
 }
 
 // Kind returns the Datastore kind name for GameStateDatastore.
@@ -454,6 +464,19 @@ func (m *GameStateDatastore) Save() ([]datastore.Property, error) {
 		})
 	}
 
+	// Serialize PendingOrders map to JSON
+	if m.PendingOrders != nil {
+		PendingOrdersJSON, err := json.Marshal(m.PendingOrders)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal PendingOrders: %w", err)
+		}
+		props = append(props, datastore.Property{
+			Name:    "pending_orders",
+			Value:   PendingOrdersJSON,
+			NoIndex: true, // Maps are typically not indexed
+		})
+	}
+
 	return props, nil
 }
 
@@ -528,12 +551,17 @@ func (m *GameStateDatastore) Load(props []datastore.Property) error {
 
 	var PlayerStatesProp *datastore.Property
 
+	var PendingOrdersProp *datastore.Property
+
 	for i := range props {
 		switch props[i].Name {
 
 		case "player_states":
 			PlayerStatesProp = &props[i]
 
+		case "pending_orders":
+			PendingOrdersProp = &props[i]
+
 		default:
 			regularProps = append(regularProps, props[i])
 		}
@@ -620,6 +648,25 @@ func (m *GameStateDatastore) Load(props []datastore.Property) error {
 		}
 	}
 
+	// Deserialize PendingOrders from JSON
+	if PendingOrdersProp != nil {
+		var jsonBytes []byte
+		switch v := PendingOrdersProp.Value.(type) {
+		case []byte:
+			jsonBytes = v
+		case string:
+			jsonBytes = []byte(v)
+		default:
+			return fmt.Errorf("unexpected type for pending_orders: %T", PendingOrdersProp.Value)
+		}
+		if len(jsonBytes) > 0 {
+			m.PendingOrders = make(map[int32]PlayerOrdersDatastore)
+			if err := json.Unmarshal(jsonBytes, &m.PendingOrders); err != nil {
+				return fmt.Errorf("failed to unmarshal PendingOrders: %w", err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -700,6 +747,16 @@ type GameSettingsDatastore struct {
 	TeamMode string `datastore:"team_mode"`
 
 	MaxTurns int32 `datastore:"max_turns"`
+
+	TurnMode string `datastore:"turn_mode"`
+
+	SharedCoins bool `datastore:"shared_coins"`
+
+	SharedControl bool `datastore:"shared_control"`
+
+	AlliedSupport bool `datastore:"allied_support"`
+
+	CombinedVictory bool `datastore:"combined_victory"`
 }
 
 // PlayerStateDatastore is the Datastore entity for the source message.
@@ -740,3 +797,50 @@ type GameMoveDatastore struct {
 func (*GameMoveDatastore) Kind() string {
 	return "GameMove"
 }
+
+// PlayerOrdersDatastore is the Datastore entity for the source message.
+type PlayerOrdersDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	PlayerId int32 `datastore:"player_id"`
+
+	Commitment string `datastore:"commitment"`
+
+	CommittedAt time.Time `datastore:"committed_at"`
+
+	Revealed bool `datastore:"revealed"`
+
+	Moves []GameMoveDatastore `datastore:"moves,noindex"`
+
+	Salt string `datastore:"salt"`
+}
+
+// UserGameDatastore is the Datastore entity for the source message.
+type UserGameDatastore struct {
+	Key *datastore.Key `datastore:"-"`
+
+	UserId string `datastore:"user_id"`
+
+	GameId string `datastore:"game_id"`
+
+	PlayerIds []int32 `datastore:"player_ids,noindex"`
+
+	GameName string `datastore:"game_name"`
+
+	WorldId string `datastore:"world_id"`
+
+	Status models.GameStatus `datastore:"status"`
+
+	CurrentPlayer int32 `datastore:"current_player"`
+
+	TurnCounter int32 `datastore:"turn_counter"`
+
+	IsMyTurn bool `datastore:"is_my_turn"`
+
+	UpdatedAt time.Time `datastore:"updated_at"`
+}
+
+// Kind returns the Datastore kind name for UserGameDatastore.
+func (*UserGameDatastore) Kind() string {
+	return "UserGame"
+}
@@ -509,15 +509,17 @@ func WorldToWorldDatastore(
 
 	// Initialize struct with inline values
 	*dest = WorldDatastore{
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		Version:        src.Version,
+		Id:             src.Id,
+		CreatorId:      src.CreatorId,
+		Name:           src.Name,
+		Description:    src.Description,
+		Tags:           src.Tags,
+		ImageUrl:       src.ImageUrl,
+		Difficulty:     src.Difficulty,
+		PreviewUrls:    src.PreviewUrls,
+		ParentWorldId:  src.ParentWorldId,
+		ParentRevision: src.ParentRevision,
 	}
 	out = dest
 
@@ -578,17 +580,19 @@ func WorldFromWorldDatastore(
 
 	// Initialize struct with inline values
 	*dest = models.World{
-		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
-		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		CreatedAt:      converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt:      converters.TimeToTimestamp(src.UpdatedAt),
+		Version:        src.Version,
+		Id:             src.Id,
+		CreatorId:      src.CreatorId,
+		Name:           src.Name,
+		Description:    src.Description,
+		Tags:           src.Tags,
+		ImageUrl:       src.ImageUrl,
+		Difficulty:     src.Difficulty,
+		PreviewUrls:    src.PreviewUrls,
+		ParentWorldId:  src.ParentWorldId,
+		ParentRevision: src.ParentRevision,
 	}
 	out = dest
 
@@ -794,16 +798,18 @@ func GameToGameDatastore(
 
 	// Initialize struct with inline values
 	*dest = GameDatastore{
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		WorldId:     src.WorldId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		Version:       src.Version,
+		Id:            src.Id,
+		CreatorId:     src.CreatorId,
+		WorldId:       src.WorldId,
+		Name:          src.Name,
+		Description:   src.Description,
+		Tags:          src.Tags,
+		ImageUrl:      src.ImageUrl,
+		Difficulty:    src.Difficulty,
+		PreviewUrls:   src.PreviewUrls,
+		WorldRevision: src.WorldRevision,
+		RulesVersion:  src.RulesVersion,
 	}
 	out = dest
 
@@ -864,18 +870,20 @@ func GameFromGameDatastore(
 
 	// Initialize struct with inline values
 	*dest = models.Game{
-		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
-		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		WorldId:     src.WorldId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		CreatedAt:     converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt:     converters.TimeToTimestamp(src.UpdatedAt),
+		Version:       src.Version,
+		Id:            src.Id,
+		CreatorId:     src.CreatorId,
+		WorldId:       src.WorldId,
+		Name:          src.Name,
+		Description:   src.Description,
+		Tags:          src.Tags,
+		ImageUrl:      src.ImageUrl,
+		Difficulty:    src.Difficulty,
+		PreviewUrls:   src.PreviewUrls,
+		WorldRevision: src.WorldRevision,
+		RulesVersion:  src.RulesVersion,
 	}
 	out = dest
 
@@ -960,6 +968,17 @@ func GameStateToGameStateDatastore(
 			out.PlayerStates[key] = converted
 		}
 	}
+	if src.PendingOrders != nil {
+		out.PendingOrders = make(map[int32]PlayerOrdersDatastore, len(src.PendingOrders))
+		for key, value := range src.PendingOrders {
+			var converted PlayerOrdersDatastore
+			_, err = PlayerOrdersToPlayerOrdersDatastore(value, &converted, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting PendingOrders[%v]: %w", key, err)
+			}
+			out.PendingOrders[key] = converted
+		}
+	}
 
 	// Apply decorator if provided
 	if decorator != nil {
@@ -1025,6 +1044,15 @@ func GameStateFromGameStateDatastore(
 			}
 		}
 	}
+	if src.PendingOrders != nil {
+		out.PendingOrders = make(map[int32]*models.PlayerOrders, len(src.PendingOrders))
+		for key, value := range src.PendingOrders {
+			out.PendingOrders[key], err = PlayerOrdersFromPlayerOrdersDatastore(nil, &value, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting PendingOrders[%v]: %w", key, err)
+			}
+		}
+	}
 
 	// Apply decorator if provided
 	if decorator != nil {
@@ -1471,10 +1499,15 @@ func GameSettingsToGameSettingsDatastore(
 
 	// Initialize struct with inline values
 	*dest = GameSettingsDatastore{
-		AllowedUnits:  src.AllowedUnits,
-		TurnTimeLimit: src.TurnTimeLimit,
-		TeamMode:      src.TeamMode,
-		MaxTurns:      src.MaxTurns,
+		AllowedUnits:    src.AllowedUnits,
+		TurnTimeLimit:   src.TurnTimeLimit,
+		TeamMode:        src.TeamMode,
+		MaxTurns:        src.MaxTurns,
+		TurnMode:        src.TurnMode,
+		SharedCoins:     src.SharedCoins,
+		SharedControl:   src.SharedControl,
+		AlliedSupport:   src.AlliedSupport,
+		CombinedVictory: src.CombinedVictory,
 	}
 	out = dest
 
@@ -1514,10 +1547,15 @@ func GameSettingsFromGameSettingsDatastore(
 
 	// Initialize struct with inline values
 	*dest = models.GameSettings{
-		AllowedUnits:  src.AllowedUnits,
-		TurnTimeLimit: src.TurnTimeLimit,
-		TeamMode:      src.TeamMode,
-		MaxTurns:      src.MaxTurns,
+		AllowedUnits:    src.AllowedUnits,
+		TurnTimeLimit:   src.TurnTimeLimit,
+		TeamMode:        src.TeamMode,
+		MaxTurns:        src.MaxTurns,
+		TurnMode:        src.TurnMode,
+		SharedCoins:     src.SharedCoins,
+		SharedControl:   src.SharedControl,
+		AlliedSupport:   src.AlliedSupport,
+		CombinedVictory: src.CombinedVictory,
 	}
 	out = dest
 
@@ -1727,3 +1765,215 @@ func GameMoveFromGameMoveDatastore(
 
 	return dest, nil
 }
+
+// PlayerOrdersToPlayerOrdersDatastore converts a PlayerOrders to PlayerOrdersDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source PlayerOrders message to convert from
+//   - dest: Destination PlayerOrdersDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted PlayerOrdersDatastore entity
+//   - Error if conversion fails
+func PlayerOrdersToPlayerOrdersDatastore(
+	src *models.PlayerOrders,
+	dest *PlayerOrdersDatastore,
+	decorator func(*models.PlayerOrders, *PlayerOrdersDatastore) error,
+) (out *PlayerOrdersDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &PlayerOrdersDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = PlayerOrdersDatastore{
+		PlayerId:   src.PlayerId,
+		Commitment: src.Commitment,
+		Revealed:   src.Revealed,
+		Salt:       src.Salt,
+	}
+	out = dest
+
+	if src.CommittedAt != nil {
+		out.CommittedAt = converters.TimestampToTime(src.CommittedAt)
+	}
+
+	if src.Moves != nil {
+		out.Moves = make([]GameMoveDatastore, len(src.Moves))
+		for i, item := range src.Moves {
+			_, err = GameMoveToGameMoveDatastore(item, &out.Moves[i], nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// PlayerOrdersFromPlayerOrdersDatastore converts a PlayerOrdersDatastore back to PlayerOrders.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination PlayerOrders message (if nil, a new one is created)
+//   - src: Source PlayerOrdersDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted PlayerOrders message
+//   - Error if conversion fails
+func PlayerOrdersFromPlayerOrdersDatastore(
+	dest *models.PlayerOrders,
+	src *PlayerOrdersDatastore,
+	decorator func(*models.PlayerOrders, *PlayerOrdersDatastore) error,
+) (out *models.PlayerOrders, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.PlayerOrders{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.PlayerOrders{
+		PlayerId:    src.PlayerId,
+		Commitment:  src.Commitment,
+		CommittedAt: converters.TimeToTimestamp(src.CommittedAt),
+		Revealed:    src.Revealed,
+		Salt:        src.Salt,
+	}
+	out = dest
+
+	if src.Moves != nil {
+		out.Moves = make([]*models.GameMove, len(src.Moves))
+		for i, item := range src.Moves {
+			out.Moves[i], err = GameMoveFromGameMoveDatastore(nil, &item, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// UserGameToUserGameDatastore converts a UserGame to UserGameDatastore.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - src: Source UserGame message to convert from
+//   - dest: Destination UserGameDatastore entity (if nil, a new one is created)
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted UserGameDatastore entity
+//   - Error if conversion fails
+func UserGameToUserGameDatastore(
+	src *models.UserGame,
+	dest *UserGameDatastore,
+	decorator func(*models.UserGame, *UserGameDatastore) error,
+) (out *UserGameDatastore, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &UserGameDatastore{}
+	}
+
+	// Initialize struct with inline values
+	*dest = UserGameDatastore{
+		UserId:        src.UserId,
+		GameId:        src.GameId,
+		PlayerIds:     src.PlayerIds,
+		GameName:      src.GameName,
+		WorldId:       src.WorldId,
+		Status:        src.Status,
+		CurrentPlayer: src.CurrentPlayer,
+		TurnCounter:   src.TurnCounter,
+		IsMyTurn:      src.IsMyTurn,
+	}
+	out = dest
+
+	if src.UpdatedAt != nil {
+		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// UserGameFromUserGameDatastore converts a UserGameDatastore back to UserGame.
+//
+// The optional decorator function allows custom field transformations after conversion.
+//
+// Parameters:
+//   - dest: Destination UserGame message (if nil, a new one is created)
+//   - src: Source UserGameDatastore entity to convert from
+//   - decorator: Optional function for custom transformations
+//
+// Returns:
+//   - Converted UserGame message
+//   - Error if conversion fails
+func UserGameFromUserGameDatastore(
+	dest *models.UserGame,
+	src *UserGameDatastore,
+	decorator func(*models.UserGame, *UserGameDatastore) error,
+) (out *models.UserGame, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.UserGame{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.UserGame{
+		UserId:        src.UserId,
+		GameId:        src.GameId,
+		PlayerIds:     src.PlayerIds,
+		GameName:      src.GameName,
+		WorldId:       src.WorldId,
+		Status:        src.Status,
+		CurrentPlayer: src.CurrentPlayer,
+		TurnCounter:   src.TurnCounter,
+		IsMyTurn:      src.IsMyTurn,
+		UpdatedAt:     converters.TimeToTimestamp(src.UpdatedAt),
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
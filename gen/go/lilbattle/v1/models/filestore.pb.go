@@ -422,8 +422,10 @@ type ListFilesRequest struct {
 	Pagination *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	// If true, populate signed_urls in each File response
 	IncludeSignedUrls bool `protobuf:"varint,3,opt,name=include_signed_urls,json=includeSignedUrls,proto3" json:"include_signed_urls,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// If true, list the files under every sub directory of path too
+	Recursive     bool `protobuf:"varint,4,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListFilesRequest) Reset() {
@@ -477,6 +479,13 @@ func (x *ListFilesRequest) GetIncludeSignedUrls() bool {
 	return false
 }
 
+func (x *ListFilesRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
 type ListFilesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*File                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -562,13 +571,14 @@ const file_lilbattle_v1_models_filestore_proto_rawDesc = "" +
 	"\x11DeleteFileRequest\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\"<\n" +
 	"\x12DeleteFileResponse\x12&\n" +
-	"\x04file\x18\x01 \x01(\v2\x12.lilbattle.v1.FileR\x04file\"\x90\x01\n" +
+	"\x04file\x18\x01 \x01(\v2\x12.lilbattle.v1.FileR\x04file\"\xae\x01\n" +
 	"\x10ListFilesRequest\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x128\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x18.lilbattle.v1.PaginationR\n" +
 	"pagination\x12.\n" +
-	"\x13include_signed_urls\x18\x03 \x01(\bR\x11includeSignedUrls\"\x7f\n" +
+	"\x13include_signed_urls\x18\x03 \x01(\bR\x11includeSignedUrls\x12\x1c\n" +
+	"\trecursive\x18\x04 \x01(\bR\trecursive\"\x7f\n" +
 	"\x11ListFilesResponse\x12(\n" +
 	"\x05items\x18\x01 \x03(\v2\x12.lilbattle.v1.FileR\x05items\x12@\n" +
 	"\n" +
@@ -31,7 +31,22 @@ type ListGamesRequest struct {
 	// Pagination info
 	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	// May be filter by owner id
-	OwnerId       string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Only games with this user in one of the player slots
+	PlayerUserId string `protobuf:"bytes,3,opt,name=player_user_id,json=playerUserId,proto3" json:"player_user_id,omitempty"`
+	// Only games in this status (unspecified matches every status)
+	Status GameStatus `protobuf:"varint,4,opt,name=status,proto3,enum=lilbattle.v1.GameStatus" json:"status,omitempty"`
+	// Only games created from this world
+	WorldId string `protobuf:"bytes,5,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Only games carrying this tag
+	Tag string `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Only games created/updated within these windows
+	Created *TimeRange `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	Updated *TimeRange `protobuf:"bytes,8,opt,name=updated,proto3" json:"updated,omitempty"`
+	// Field to sort by - "updated_at" (default), "created_at" or "name"
+	SortBy string `protobuf:"bytes,9,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// "asc" or "desc".  Defaults to newest first for times and A-Z for names.
+	SortOrder     string `protobuf:"bytes,10,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -80,6 +95,62 @@ func (x *ListGamesRequest) GetOwnerId() string {
 	return ""
 }
 
+func (x *ListGamesRequest) GetPlayerUserId() string {
+	if x != nil {
+		return x.PlayerUserId
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (x *ListGamesRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetCreated() *TimeRange {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetUpdated() *TimeRange {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
 type ListGamesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*Game                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -1845,16 +1916,663 @@ func (x *JoinGameResponse) GetPlayerId() int32 {
 	return 0
 }
 
+// *
+// Request to commit orders for the current turn in a simultaneous turns game.
+// Only a hash of the orders is sent so other players learn nothing about them
+// until everyone has committed.
+type CommitOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the game
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The player slot committing (1-based).  Optional - defaults to the
+	// caller's slot.  Must belong to the caller.
+	PlayerId int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Hex encoded SHA-256 of the moves and salt (see lib.OrdersCommitment)
+	Commitment    string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitOrdersRequest) Reset() {
+	*x = CommitOrdersRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitOrdersRequest) ProtoMessage() {}
+
+func (x *CommitOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitOrdersRequest.ProtoReflect.Descriptor instead.
+func (*CommitOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CommitOrdersRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *CommitOrdersRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *CommitOrdersRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+// *
+// Response after committing orders
+type CommitOrdersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player ID the orders were committed for
+	PlayerId int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Players that have not committed their orders yet
+	WaitingOn     []int32 `protobuf:"varint,2,rep,packed,name=waiting_on,json=waitingOn,proto3" json:"waiting_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitOrdersResponse) Reset() {
+	*x = CommitOrdersResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitOrdersResponse) ProtoMessage() {}
+
+func (x *CommitOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitOrdersResponse.ProtoReflect.Descriptor instead.
+func (*CommitOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CommitOrdersResponse) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *CommitOrdersResponse) GetWaitingOn() []int32 {
+	if x != nil {
+		return x.WaitingOn
+	}
+	return nil
+}
+
+// *
+// Request to reveal previously committed orders.
+// Only allowed once every player has committed.
+type RevealOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the game
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The player slot revealing (1-based).  Optional - defaults to the
+	// caller's slot.  Must belong to the caller.
+	PlayerId int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// The moves that were committed to
+	Moves []*GameMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	// The salt used when computing the commitment
+	Salt          string `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealOrdersRequest) Reset() {
+	*x = RevealOrdersRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealOrdersRequest) ProtoMessage() {}
+
+func (x *RevealOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealOrdersRequest.ProtoReflect.Descriptor instead.
+func (*RevealOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RevealOrdersRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RevealOrdersRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *RevealOrdersRequest) GetMoves() []*GameMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *RevealOrdersRequest) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+// *
+// Response after revealing orders
+type RevealOrdersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player ID the orders were revealed for
+	PlayerId int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Players that have not revealed their orders yet
+	WaitingOn []int32 `protobuf:"varint,2,rep,packed,name=waiting_on,json=waitingOn,proto3" json:"waiting_on,omitempty"`
+	// Whether this reveal completed the turn and all orders were resolved
+	Resolved bool `protobuf:"varint,3,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	// The resolved moves (in resolution order) with their changes when resolved.
+	// Orders that could not be carried out are included without changes and
+	// with the reason in their description.
+	Moves         []*GameMove `protobuf:"bytes,4,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealOrdersResponse) Reset() {
+	*x = RevealOrdersResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealOrdersResponse) ProtoMessage() {}
+
+func (x *RevealOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealOrdersResponse.ProtoReflect.Descriptor instead.
+func (*RevealOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RevealOrdersResponse) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *RevealOrdersResponse) GetWaitingOn() []int32 {
+	if x != nil {
+		return x.WaitingOn
+	}
+	return nil
+}
+
+func (x *RevealOrdersResponse) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *RevealOrdersResponse) GetMoves() []*GameMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+// *
+// Request to list the games the caller is a player in
+type ListMyGamesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pagination info (offset based)
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Only games where it is the caller's turn
+	OnlyMyTurn bool `protobuf:"varint,2,opt,name=only_my_turn,json=onlyMyTurn,proto3" json:"only_my_turn,omitempty"`
+	// Only games in this status (unspecified matches every status)
+	Status        GameStatus `protobuf:"varint,3,opt,name=status,proto3,enum=lilbattle.v1.GameStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyGamesRequest) Reset() {
+	*x = ListMyGamesRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyGamesRequest) ProtoMessage() {}
+
+func (x *ListMyGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyGamesRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListMyGamesRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListMyGamesRequest) GetOnlyMyTurn() bool {
+	if x != nil {
+		return x.OnlyMyTurn
+	}
+	return false
+}
+
+func (x *ListMyGamesRequest) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+// *
+// The caller's games, those waiting on the caller first and then most
+// recently updated first
+type ListMyGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*UserGame            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyGamesResponse) Reset() {
+	*x = ListMyGamesResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyGamesResponse) ProtoMessage() {}
+
+func (x *ListMyGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListMyGamesResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListMyGamesResponse) GetItems() []*UserGame {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListMyGamesResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// *
+// Request to export a game archive
+type ExportGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGameRequest) Reset() {
+	*x = ExportGameRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGameRequest) ProtoMessage() {}
+
+func (x *ExportGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGameRequest.ProtoReflect.Descriptor instead.
+func (*ExportGameRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExportGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// *
+// A game archive - a zip file with a manifest, see lib/game_archive.go
+type ExportGameResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Archive []byte                 `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	// SHA-256 content hash recorded in the archive's manifest
+	ContentHash   string `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGameResponse) Reset() {
+	*x = ExportGameResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGameResponse) ProtoMessage() {}
+
+func (x *ExportGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGameResponse.ProtoReflect.Descriptor instead.
+func (*ExportGameResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExportGameResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ExportGameResponse) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+// *
+// Request to import a game archive
+type ImportGameRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Archive []byte                 `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	// ID to import the game under - defaults to the archived game's ID
+	GameId        string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGameRequest) Reset() {
+	*x = ImportGameRequest{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGameRequest) ProtoMessage() {}
+
+func (x *ImportGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGameRequest.ProtoReflect.Descriptor instead.
+func (*ImportGameRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ImportGameRequest) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ImportGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ImportGameResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The imported game (not set if the ID was taken)
+	Game *Game `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	// Content hash of the verified archive
+	ContentHash string `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// Number of move groups replayed to verify the archive
+	ReplayedGroups int64 `protobuf:"varint,3,opt,name=replayed_groups,json=replayedGroups,proto3" json:"replayed_groups,omitempty"`
+	// Whether the bundled rules are the rules this server plays with.  If
+	// not the server keeps the bundled rules and the game goes on being
+	// played with them.
+	RulesMatch bool `protobuf:"varint,4,opt,name=rules_match,json=rulesMatch,proto3" json:"rules_match,omitempty"`
+	//*
+	// Error specific to a field if there are any errors - as in CreateGame a
+	// taken "id" maps to a suggested free one.
+	FieldErrors   map[string]string `protobuf:"bytes,5,rep,name=field_errors,json=fieldErrors,proto3" json:"field_errors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGameResponse) Reset() {
+	*x = ImportGameResponse{}
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGameResponse) ProtoMessage() {}
+
+func (x *ImportGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_games_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGameResponse.ProtoReflect.Descriptor instead.
+func (*ImportGameResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_games_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *ImportGameResponse) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *ImportGameResponse) GetReplayedGroups() int64 {
+	if x != nil {
+		return x.ReplayedGroups
+	}
+	return 0
+}
+
+func (x *ImportGameResponse) GetRulesMatch() bool {
+	if x != nil {
+		return x.RulesMatch
+	}
+	return false
+}
+
+func (x *ImportGameResponse) GetFieldErrors() map[string]string {
+	if x != nil {
+		return x.FieldErrors
+	}
+	return nil
+}
+
 var File_lilbattle_v1_models_games_service_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_models_games_service_proto_rawDesc = "" +
 	"\n" +
-	"'lilbattle/v1/models/games_service.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\"g\n" +
+	"'lilbattle/v1/models/games_service.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\"\x8a\x03\n" +
 	"\x10ListGamesRequest\x128\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x18.lilbattle.v1.PaginationR\n" +
 	"pagination\x12\x19\n" +
-	"\bowner_id\x18\x02 \x01(\tR\aownerId\"\x7f\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12$\n" +
+	"\x0eplayer_user_id\x18\x03 \x01(\tR\fplayerUserId\x120\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.lilbattle.v1.GameStatusR\x06status\x12\x19\n" +
+	"\bworld_id\x18\x05 \x01(\tR\aworldId\x12\x10\n" +
+	"\x03tag\x18\x06 \x01(\tR\x03tag\x121\n" +
+	"\acreated\x18\a \x01(\v2\x17.lilbattle.v1.TimeRangeR\acreated\x121\n" +
+	"\aupdated\x18\b \x01(\v2\x17.lilbattle.v1.TimeRangeR\aupdated\x12\x17\n" +
+	"\asort_by\x18\t \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\n" +
+	" \x01(\tR\tsortOrder\"\x7f\n" +
 	"\x11ListGamesResponse\x12(\n" +
 	"\x05items\x18\x01 \x03(\v2\x12.lilbattle.v1.GameR\x05items\x12@\n" +
 	"\n" +
@@ -1984,7 +2702,58 @@ const file_lilbattle_v1_models_games_service_proto_rawDesc = "" +
 	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\"W\n" +
 	"\x10JoinGameResponse\x12&\n" +
 	"\x04game\x18\x01 \x01(\v2\x12.lilbattle.v1.GameR\x04game\x12\x1b\n" +
-	"\tplayer_id\x18\x02 \x01(\x05R\bplayerIdB\xbd\x01\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\"k\n" +
+	"\x13CommitOrdersRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x03 \x01(\tR\n" +
+	"commitment\"R\n" +
+	"\x14CommitOrdersResponse\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x1d\n" +
+	"\n" +
+	"waiting_on\x18\x02 \x03(\x05R\twaitingOn\"\x8d\x01\n" +
+	"\x13RevealOrdersRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12,\n" +
+	"\x05moves\x18\x03 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\x12\x12\n" +
+	"\x04salt\x18\x04 \x01(\tR\x04salt\"\x9c\x01\n" +
+	"\x14RevealOrdersResponse\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x1d\n" +
+	"\n" +
+	"waiting_on\x18\x02 \x03(\x05R\twaitingOn\x12\x1a\n" +
+	"\bresolved\x18\x03 \x01(\bR\bresolved\x12,\n" +
+	"\x05moves\x18\x04 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\"\xa2\x01\n" +
+	"\x12ListMyGamesRequest\x128\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x18.lilbattle.v1.PaginationR\n" +
+	"pagination\x12 \n" +
+	"\fonly_my_turn\x18\x02 \x01(\bR\n" +
+	"onlyMyTurn\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.lilbattle.v1.GameStatusR\x06status\"\x85\x01\n" +
+	"\x13ListMyGamesResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.lilbattle.v1.UserGameR\x05items\x12@\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2 .lilbattle.v1.PaginationResponseR\n" +
+	"pagination\",\n" +
+	"\x11ExportGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"Q\n" +
+	"\x12ExportGameResponse\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\x12!\n" +
+	"\fcontent_hash\x18\x02 \x01(\tR\vcontentHash\"F\n" +
+	"\x11ImportGameRequest\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\"\xbf\x02\n" +
+	"\x12ImportGameResponse\x12&\n" +
+	"\x04game\x18\x01 \x01(\v2\x12.lilbattle.v1.GameR\x04game\x12!\n" +
+	"\fcontent_hash\x18\x02 \x01(\tR\vcontentHash\x12'\n" +
+	"\x0freplayed_groups\x18\x03 \x01(\x03R\x0ereplayedGroups\x12\x1f\n" +
+	"\vrules_match\x18\x04 \x01(\bR\n" +
+	"rulesMatch\x12T\n" +
+	"\ffield_errors\x18\x05 \x03(\v21.lilbattle.v1.ImportGameResponse.FieldErrorsEntryR\vfieldErrors\x1a>\n" +
+	"\x10FieldErrorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\xbd\x01\n" +
 	"\x10com.lilbattle.v1B\x11GamesServiceProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var (
@@ -1999,7 +2768,7 @@ func file_lilbattle_v1_models_games_service_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_models_games_service_proto_rawDescData
 }
 
-var file_lilbattle_v1_models_games_service_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_lilbattle_v1_models_games_service_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_lilbattle_v1_models_games_service_proto_goTypes = []any{
 	(*ListGamesRequest)(nil),       // 0: lilbattle.v1.ListGamesRequest
 	(*ListGamesResponse)(nil),      // 1: lilbattle.v1.ListGamesResponse
@@ -2030,69 +2799,94 @@ var file_lilbattle_v1_models_games_service_proto_goTypes = []any{
 	(*SimulateFixResponse)(nil),    // 26: lilbattle.v1.SimulateFixResponse
 	(*JoinGameRequest)(nil),        // 27: lilbattle.v1.JoinGameRequest
 	(*JoinGameResponse)(nil),       // 28: lilbattle.v1.JoinGameResponse
-	nil,                            // 29: lilbattle.v1.GetGamesResponse.GamesEntry
-	nil,                            // 30: lilbattle.v1.CreateGameResponse.FieldErrorsEntry
-	nil,                            // 31: lilbattle.v1.SimulateAttackResponse.AttackerDamageDistributionEntry
-	nil,                            // 32: lilbattle.v1.SimulateAttackResponse.DefenderDamageDistributionEntry
-	nil,                            // 33: lilbattle.v1.SimulateFixResponse.HealingDistributionEntry
-	(*Pagination)(nil),             // 34: lilbattle.v1.Pagination
-	(*Game)(nil),                   // 35: lilbattle.v1.Game
-	(*PaginationResponse)(nil),     // 36: lilbattle.v1.PaginationResponse
-	(*GameState)(nil),              // 37: lilbattle.v1.GameState
-	(*GameMoveHistory)(nil),        // 38: lilbattle.v1.GameMoveHistory
-	(*fieldmaskpb.FieldMask)(nil),  // 39: google.protobuf.FieldMask
-	(*GameMove)(nil),               // 40: lilbattle.v1.GameMove
-	(*GameMoveGroup)(nil),          // 41: lilbattle.v1.GameMoveGroup
-	(*Position)(nil),               // 42: lilbattle.v1.Position
-	(*AllPaths)(nil),               // 43: lilbattle.v1.AllPaths
-	(*MoveUnitAction)(nil),         // 44: lilbattle.v1.MoveUnitAction
-	(*AttackUnitAction)(nil),       // 45: lilbattle.v1.AttackUnitAction
-	(*BuildUnitAction)(nil),        // 46: lilbattle.v1.BuildUnitAction
-	(*CaptureBuildingAction)(nil),  // 47: lilbattle.v1.CaptureBuildingAction
-	(*EndTurnAction)(nil),          // 48: lilbattle.v1.EndTurnAction
-	(*HealUnitAction)(nil),         // 49: lilbattle.v1.HealUnitAction
+	(*CommitOrdersRequest)(nil),    // 29: lilbattle.v1.CommitOrdersRequest
+	(*CommitOrdersResponse)(nil),   // 30: lilbattle.v1.CommitOrdersResponse
+	(*RevealOrdersRequest)(nil),    // 31: lilbattle.v1.RevealOrdersRequest
+	(*RevealOrdersResponse)(nil),   // 32: lilbattle.v1.RevealOrdersResponse
+	(*ListMyGamesRequest)(nil),     // 33: lilbattle.v1.ListMyGamesRequest
+	(*ListMyGamesResponse)(nil),    // 34: lilbattle.v1.ListMyGamesResponse
+	(*ExportGameRequest)(nil),      // 35: lilbattle.v1.ExportGameRequest
+	(*ExportGameResponse)(nil),     // 36: lilbattle.v1.ExportGameResponse
+	(*ImportGameRequest)(nil),      // 37: lilbattle.v1.ImportGameRequest
+	(*ImportGameResponse)(nil),     // 38: lilbattle.v1.ImportGameResponse
+	nil,                            // 39: lilbattle.v1.GetGamesResponse.GamesEntry
+	nil,                            // 40: lilbattle.v1.CreateGameResponse.FieldErrorsEntry
+	nil,                            // 41: lilbattle.v1.SimulateAttackResponse.AttackerDamageDistributionEntry
+	nil,                            // 42: lilbattle.v1.SimulateAttackResponse.DefenderDamageDistributionEntry
+	nil,                            // 43: lilbattle.v1.SimulateFixResponse.HealingDistributionEntry
+	nil,                            // 44: lilbattle.v1.ImportGameResponse.FieldErrorsEntry
+	(*Pagination)(nil),             // 45: lilbattle.v1.Pagination
+	(GameStatus)(0),                // 46: lilbattle.v1.GameStatus
+	(*TimeRange)(nil),              // 47: lilbattle.v1.TimeRange
+	(*Game)(nil),                   // 48: lilbattle.v1.Game
+	(*PaginationResponse)(nil),     // 49: lilbattle.v1.PaginationResponse
+	(*GameState)(nil),              // 50: lilbattle.v1.GameState
+	(*GameMoveHistory)(nil),        // 51: lilbattle.v1.GameMoveHistory
+	(*fieldmaskpb.FieldMask)(nil),  // 52: google.protobuf.FieldMask
+	(*GameMove)(nil),               // 53: lilbattle.v1.GameMove
+	(*GameMoveGroup)(nil),          // 54: lilbattle.v1.GameMoveGroup
+	(*Position)(nil),               // 55: lilbattle.v1.Position
+	(*AllPaths)(nil),               // 56: lilbattle.v1.AllPaths
+	(*MoveUnitAction)(nil),         // 57: lilbattle.v1.MoveUnitAction
+	(*AttackUnitAction)(nil),       // 58: lilbattle.v1.AttackUnitAction
+	(*BuildUnitAction)(nil),        // 59: lilbattle.v1.BuildUnitAction
+	(*CaptureBuildingAction)(nil),  // 60: lilbattle.v1.CaptureBuildingAction
+	(*EndTurnAction)(nil),          // 61: lilbattle.v1.EndTurnAction
+	(*HealUnitAction)(nil),         // 62: lilbattle.v1.HealUnitAction
+	(*UserGame)(nil),               // 63: lilbattle.v1.UserGame
 }
 var file_lilbattle_v1_models_games_service_proto_depIdxs = []int32{
-	34, // 0: lilbattle.v1.ListGamesRequest.pagination:type_name -> lilbattle.v1.Pagination
-	35, // 1: lilbattle.v1.ListGamesResponse.items:type_name -> lilbattle.v1.Game
-	36, // 2: lilbattle.v1.ListGamesResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
-	35, // 3: lilbattle.v1.GetGameResponse.game:type_name -> lilbattle.v1.Game
-	37, // 4: lilbattle.v1.GetGameResponse.state:type_name -> lilbattle.v1.GameState
-	38, // 5: lilbattle.v1.GetGameResponse.history:type_name -> lilbattle.v1.GameMoveHistory
-	35, // 6: lilbattle.v1.UpdateGameRequest.new_game:type_name -> lilbattle.v1.Game
-	37, // 7: lilbattle.v1.UpdateGameRequest.new_state:type_name -> lilbattle.v1.GameState
-	38, // 8: lilbattle.v1.UpdateGameRequest.new_history:type_name -> lilbattle.v1.GameMoveHistory
-	39, // 9: lilbattle.v1.UpdateGameRequest.update_mask:type_name -> google.protobuf.FieldMask
-	35, // 10: lilbattle.v1.UpdateGameResponse.game:type_name -> lilbattle.v1.Game
-	29, // 11: lilbattle.v1.GetGamesResponse.games:type_name -> lilbattle.v1.GetGamesResponse.GamesEntry
-	35, // 12: lilbattle.v1.CreateGameRequest.game:type_name -> lilbattle.v1.Game
-	35, // 13: lilbattle.v1.CreateGameResponse.game:type_name -> lilbattle.v1.Game
-	37, // 14: lilbattle.v1.CreateGameResponse.game_state:type_name -> lilbattle.v1.GameState
-	30, // 15: lilbattle.v1.CreateGameResponse.field_errors:type_name -> lilbattle.v1.CreateGameResponse.FieldErrorsEntry
-	40, // 16: lilbattle.v1.ProcessMovesRequest.moves:type_name -> lilbattle.v1.GameMove
-	15, // 17: lilbattle.v1.ProcessMovesRequest.expected_response:type_name -> lilbattle.v1.ProcessMovesResponse
-	40, // 18: lilbattle.v1.ProcessMovesResponse.moves:type_name -> lilbattle.v1.GameMove
-	37, // 19: lilbattle.v1.GetGameStateResponse.state:type_name -> lilbattle.v1.GameState
-	41, // 20: lilbattle.v1.ListMovesResponse.move_groups:type_name -> lilbattle.v1.GameMoveGroup
-	42, // 21: lilbattle.v1.GetOptionsAtRequest.pos:type_name -> lilbattle.v1.Position
-	22, // 22: lilbattle.v1.GetOptionsAtResponse.options:type_name -> lilbattle.v1.GameOption
-	43, // 23: lilbattle.v1.GetOptionsAtResponse.all_paths:type_name -> lilbattle.v1.AllPaths
-	44, // 24: lilbattle.v1.GameOption.move:type_name -> lilbattle.v1.MoveUnitAction
-	45, // 25: lilbattle.v1.GameOption.attack:type_name -> lilbattle.v1.AttackUnitAction
-	46, // 26: lilbattle.v1.GameOption.build:type_name -> lilbattle.v1.BuildUnitAction
-	47, // 27: lilbattle.v1.GameOption.capture:type_name -> lilbattle.v1.CaptureBuildingAction
-	48, // 28: lilbattle.v1.GameOption.end_turn:type_name -> lilbattle.v1.EndTurnAction
-	49, // 29: lilbattle.v1.GameOption.heal:type_name -> lilbattle.v1.HealUnitAction
-	31, // 30: lilbattle.v1.SimulateAttackResponse.attacker_damage_distribution:type_name -> lilbattle.v1.SimulateAttackResponse.AttackerDamageDistributionEntry
-	32, // 31: lilbattle.v1.SimulateAttackResponse.defender_damage_distribution:type_name -> lilbattle.v1.SimulateAttackResponse.DefenderDamageDistributionEntry
-	33, // 32: lilbattle.v1.SimulateFixResponse.healing_distribution:type_name -> lilbattle.v1.SimulateFixResponse.HealingDistributionEntry
-	35, // 33: lilbattle.v1.JoinGameResponse.game:type_name -> lilbattle.v1.Game
-	35, // 34: lilbattle.v1.GetGamesResponse.GamesEntry.value:type_name -> lilbattle.v1.Game
-	35, // [35:35] is the sub-list for method output_type
-	35, // [35:35] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	45, // 0: lilbattle.v1.ListGamesRequest.pagination:type_name -> lilbattle.v1.Pagination
+	46, // 1: lilbattle.v1.ListGamesRequest.status:type_name -> lilbattle.v1.GameStatus
+	47, // 2: lilbattle.v1.ListGamesRequest.created:type_name -> lilbattle.v1.TimeRange
+	47, // 3: lilbattle.v1.ListGamesRequest.updated:type_name -> lilbattle.v1.TimeRange
+	48, // 4: lilbattle.v1.ListGamesResponse.items:type_name -> lilbattle.v1.Game
+	49, // 5: lilbattle.v1.ListGamesResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
+	48, // 6: lilbattle.v1.GetGameResponse.game:type_name -> lilbattle.v1.Game
+	50, // 7: lilbattle.v1.GetGameResponse.state:type_name -> lilbattle.v1.GameState
+	51, // 8: lilbattle.v1.GetGameResponse.history:type_name -> lilbattle.v1.GameMoveHistory
+	48, // 9: lilbattle.v1.UpdateGameRequest.new_game:type_name -> lilbattle.v1.Game
+	50, // 10: lilbattle.v1.UpdateGameRequest.new_state:type_name -> lilbattle.v1.GameState
+	51, // 11: lilbattle.v1.UpdateGameRequest.new_history:type_name -> lilbattle.v1.GameMoveHistory
+	52, // 12: lilbattle.v1.UpdateGameRequest.update_mask:type_name -> google.protobuf.FieldMask
+	48, // 13: lilbattle.v1.UpdateGameResponse.game:type_name -> lilbattle.v1.Game
+	39, // 14: lilbattle.v1.GetGamesResponse.games:type_name -> lilbattle.v1.GetGamesResponse.GamesEntry
+	48, // 15: lilbattle.v1.CreateGameRequest.game:type_name -> lilbattle.v1.Game
+	48, // 16: lilbattle.v1.CreateGameResponse.game:type_name -> lilbattle.v1.Game
+	50, // 17: lilbattle.v1.CreateGameResponse.game_state:type_name -> lilbattle.v1.GameState
+	40, // 18: lilbattle.v1.CreateGameResponse.field_errors:type_name -> lilbattle.v1.CreateGameResponse.FieldErrorsEntry
+	53, // 19: lilbattle.v1.ProcessMovesRequest.moves:type_name -> lilbattle.v1.GameMove
+	15, // 20: lilbattle.v1.ProcessMovesRequest.expected_response:type_name -> lilbattle.v1.ProcessMovesResponse
+	53, // 21: lilbattle.v1.ProcessMovesResponse.moves:type_name -> lilbattle.v1.GameMove
+	50, // 22: lilbattle.v1.GetGameStateResponse.state:type_name -> lilbattle.v1.GameState
+	54, // 23: lilbattle.v1.ListMovesResponse.move_groups:type_name -> lilbattle.v1.GameMoveGroup
+	55, // 24: lilbattle.v1.GetOptionsAtRequest.pos:type_name -> lilbattle.v1.Position
+	22, // 25: lilbattle.v1.GetOptionsAtResponse.options:type_name -> lilbattle.v1.GameOption
+	56, // 26: lilbattle.v1.GetOptionsAtResponse.all_paths:type_name -> lilbattle.v1.AllPaths
+	57, // 27: lilbattle.v1.GameOption.move:type_name -> lilbattle.v1.MoveUnitAction
+	58, // 28: lilbattle.v1.GameOption.attack:type_name -> lilbattle.v1.AttackUnitAction
+	59, // 29: lilbattle.v1.GameOption.build:type_name -> lilbattle.v1.BuildUnitAction
+	60, // 30: lilbattle.v1.GameOption.capture:type_name -> lilbattle.v1.CaptureBuildingAction
+	61, // 31: lilbattle.v1.GameOption.end_turn:type_name -> lilbattle.v1.EndTurnAction
+	62, // 32: lilbattle.v1.GameOption.heal:type_name -> lilbattle.v1.HealUnitAction
+	41, // 33: lilbattle.v1.SimulateAttackResponse.attacker_damage_distribution:type_name -> lilbattle.v1.SimulateAttackResponse.AttackerDamageDistributionEntry
+	42, // 34: lilbattle.v1.SimulateAttackResponse.defender_damage_distribution:type_name -> lilbattle.v1.SimulateAttackResponse.DefenderDamageDistributionEntry
+	43, // 35: lilbattle.v1.SimulateFixResponse.healing_distribution:type_name -> lilbattle.v1.SimulateFixResponse.HealingDistributionEntry
+	48, // 36: lilbattle.v1.JoinGameResponse.game:type_name -> lilbattle.v1.Game
+	53, // 37: lilbattle.v1.RevealOrdersRequest.moves:type_name -> lilbattle.v1.GameMove
+	53, // 38: lilbattle.v1.RevealOrdersResponse.moves:type_name -> lilbattle.v1.GameMove
+	45, // 39: lilbattle.v1.ListMyGamesRequest.pagination:type_name -> lilbattle.v1.Pagination
+	46, // 40: lilbattle.v1.ListMyGamesRequest.status:type_name -> lilbattle.v1.GameStatus
+	63, // 41: lilbattle.v1.ListMyGamesResponse.items:type_name -> lilbattle.v1.UserGame
+	49, // 42: lilbattle.v1.ListMyGamesResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
+	48, // 43: lilbattle.v1.ImportGameResponse.game:type_name -> lilbattle.v1.Game
+	44, // 44: lilbattle.v1.ImportGameResponse.field_errors:type_name -> lilbattle.v1.ImportGameResponse.FieldErrorsEntry
+	48, // 45: lilbattle.v1.GetGamesResponse.GamesEntry.value:type_name -> lilbattle.v1.Game
+	46, // [46:46] is the sub-list for method output_type
+	46, // [46:46] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_models_games_service_proto_init() }
@@ -2115,7 +2909,7 @@ func file_lilbattle_v1_models_games_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_games_service_proto_rawDesc), len(file_lilbattle_v1_models_games_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -31,6 +31,7 @@ const (
 	RunState_RUN_STATE_UNSPECIFIED RunState = 0
 	RunState_RUN_STATE_STARTED     RunState = 1
 	RunState_RUN_STATE_FINISHED    RunState = 2
+	RunState_RUN_STATE_FAILED      RunState = 3
 )
 
 // Enum value maps for RunState.
@@ -39,11 +40,13 @@ var (
 		0: "RUN_STATE_UNSPECIFIED",
 		1: "RUN_STATE_STARTED",
 		2: "RUN_STATE_FINISHED",
+		3: "RUN_STATE_FAILED",
 	}
 	RunState_value = map[string]int32{
 		"RUN_STATE_UNSPECIFIED": 0,
 		"RUN_STATE_STARTED":     1,
 		"RUN_STATE_FINISHED":    2,
+		"RUN_STATE_FAILED":      3,
 	}
 )
 
@@ -74,6 +77,63 @@ func (RunState) EnumDescriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_jobs_proto_rawDescGZIP(), []int{0}
 }
 
+type JobStatus int32
+
+const (
+	JobStatus_JOB_STATUS_UNSPECIFIED JobStatus = 0
+	// Waiting for run_after
+	JobStatus_JOB_STATUS_PENDING   JobStatus = 1
+	JobStatus_JOB_STATUS_RUNNING   JobStatus = 2
+	JobStatus_JOB_STATUS_SUCCEEDED JobStatus = 3
+	// Out of retries - only runs again when retried or enqueued again
+	JobStatus_JOB_STATUS_FAILED JobStatus = 4
+)
+
+// Enum value maps for JobStatus.
+var (
+	JobStatus_name = map[int32]string{
+		0: "JOB_STATUS_UNSPECIFIED",
+		1: "JOB_STATUS_PENDING",
+		2: "JOB_STATUS_RUNNING",
+		3: "JOB_STATUS_SUCCEEDED",
+		4: "JOB_STATUS_FAILED",
+	}
+	JobStatus_value = map[string]int32{
+		"JOB_STATUS_UNSPECIFIED": 0,
+		"JOB_STATUS_PENDING":     1,
+		"JOB_STATUS_RUNNING":     2,
+		"JOB_STATUS_SUCCEEDED":   3,
+		"JOB_STATUS_FAILED":      4,
+	}
+)
+
+func (x JobStatus) Enum() *JobStatus {
+	p := new(JobStatus)
+	*p = x
+	return p
+}
+
+func (x JobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lilbattle_v1_models_jobs_proto_enumTypes[1].Descriptor()
+}
+
+func (JobStatus) Type() protoreflect.EnumType {
+	return &file_lilbattle_v1_models_jobs_proto_enumTypes[1]
+}
+
+func (x JobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobStatus.Descriptor instead.
+func (JobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_jobs_proto_rawDescGZIP(), []int{1}
+}
+
 // Job describes the work that needs to be done.
 type Job struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
@@ -89,7 +149,24 @@ type Job struct {
 	// Debounce so we dont run it too many time within this many seconds
 	DebounceWindowSeconds int32 `protobuf:"varint,7,opt,name=debounce_window_seconds,json=debounceWindowSeconds,proto3" json:"debounce_window_seconds,omitempty"`
 	// Whether the job is a oneoff or can repeat
-	RepeatInfo    *RepeatInfo `protobuf:"bytes,8,opt,name=repeat_info,json=repeatInfo,proto3" json:"repeat_info,omitempty"`
+	RepeatInfo *RepeatInfo `protobuf:"bytes,8,opt,name=repeat_info,json=repeatInfo,proto3" json:"repeat_info,omitempty"`
+	// job_type:entity_type:entity_id - so enqueueing the same work again
+	// updates the pending job instead of adding another one
+	Id     string    `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	Status JobStatus `protobuf:"varint,10,opt,name=status,proto3,enum=lilbattle.v1.JobStatus" json:"status,omitempty"`
+	// When the job is next due to run
+	RunAfter *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=run_after,json=runAfter,proto3" json:"run_after,omitempty"`
+	// Failed runs since the job last succeeded
+	Attempts int32 `protobuf:"varint,12,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// How many times a failing job is retried before it is marked failed.
+	// 0 uses the runner's default.
+	MaxRetries int32 `protobuf:"varint,13,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Error of the last failed run
+	LastError string `protobuf:"bytes,14,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// ID of the latest run
+	LastRunId string `protobuf:"bytes,15,opt,name=last_run_id,json=lastRunId,proto3" json:"last_run_id,omitempty"`
+	// Incremented on every save for optimistic locking
+	Version       int64 `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -180,10 +257,69 @@ func (x *Job) GetRepeatInfo() *RepeatInfo {
 	return nil
 }
 
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetStatus() JobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobStatus_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *Job) GetRunAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAfter
+	}
+	return nil
+}
+
+func (x *Job) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Job) GetMaxRetries() int32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *Job) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Job) GetLastRunId() string {
+	if x != nil {
+		return x.LastRunId
+	}
+	return ""
+}
+
+func (x *Job) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type RepeatInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Seconds from the end of one run to the start of the next.  0 runs the
+	// job once each time it is enqueued.
+	IntervalSeconds int32 `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *RepeatInfo) Reset() {
@@ -216,6 +352,13 @@ func (*RepeatInfo) Descriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_jobs_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *RepeatInfo) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
 type Run struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	JobId string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
@@ -233,7 +376,9 @@ type Run struct {
 	// (not sure if needed) - This should be provided by the source
 	LastContentHash string `protobuf:"bytes,9,opt,name=last_content_hash,json=lastContentHash,proto3" json:"last_content_hash,omitempty"`
 	// If there were retries
-	RetryCount    int32 `protobuf:"varint,10,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	RetryCount    int32                  `protobuf:"varint,10,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	JobType       string                 `protobuf:"bytes,11,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -338,11 +483,334 @@ func (x *Run) GetRetryCount() int32 {
 	return 0
 }
 
+func (x *Run) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *Run) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type ListJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only jobs of this type
+	JobType string `protobuf:"bytes,1,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	// Only jobs in this status (unspecified matches every status)
+	Status        JobStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=lilbattle.v1.JobStatus" json:"status,omitempty"`
+	Pagination    *Pagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_jobs_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListJobsRequest) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetStatus() JobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobStatus_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *ListJobsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Jobs due soonest first
+	Items         []*Job              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Pagination    *PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_jobs_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListJobsResponse) GetItems() []*Job {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListJobsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// How many of the latest runs to return (default 20)
+	RunLimit      int32 `protobuf:"varint,2,opt,name=run_limit,json=runLimit,proto3" json:"run_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_jobs_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetJobRequest) GetRunLimit() int32 {
+	if x != nil {
+		return x.RunLimit
+	}
+	return 0
+}
+
+type GetJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Job   *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// Run history, newest first
+	Runs          []*Run `protobuf:"bytes,2,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_jobs_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *GetJobResponse) GetRuns() []*Run {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type RetryJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryJobRequest) Reset() {
+	*x = RetryJobRequest{}
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryJobRequest) ProtoMessage() {}
+
+func (x *RetryJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryJobRequest.ProtoReflect.Descriptor instead.
+func (*RetryJobRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_jobs_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetryJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RetryJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryJobResponse) Reset() {
+	*x = RetryJobResponse{}
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryJobResponse) ProtoMessage() {}
+
+func (x *RetryJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_jobs_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryJobResponse.ProtoReflect.Descriptor instead.
+func (*RetryJobResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_jobs_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RetryJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
 var File_lilbattle_v1_models_jobs_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_models_jobs_proto_rawDesc = "" +
 	"\n" +
-	"\x1elilbattle/v1/models/jobs.proto\x12\flilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/protobuf/any.proto\x1a google/protobuf/field_mask.proto\"\xf8\x02\n" +
+	"\x1elilbattle/v1/models/jobs.proto\x12\flilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/protobuf/any.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\"\x88\x05\n" +
 	"\x03Job\x12\x1f\n" +
 	"\ventity_type\x18\x01 \x01(\tR\n" +
 	"entityType\x12\x1b\n" +
@@ -355,9 +823,21 @@ const file_lilbattle_v1_models_jobs_proto_rawDesc = "" +
 	"\bjob_data\x18\x06 \x01(\v2\x14.google.protobuf.AnyR\ajobData\x126\n" +
 	"\x17debounce_window_seconds\x18\a \x01(\x05R\x15debounceWindowSeconds\x129\n" +
 	"\vrepeat_info\x18\b \x01(\v2\x18.lilbattle.v1.RepeatInfoR\n" +
-	"repeatInfo\"\f\n" +
+	"repeatInfo\x12\x0e\n" +
+	"\x02id\x18\t \x01(\tR\x02id\x12/\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x17.lilbattle.v1.JobStatusR\x06status\x127\n" +
+	"\trun_after\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\brunAfter\x12\x1a\n" +
+	"\battempts\x18\f \x01(\x05R\battempts\x12\x1f\n" +
+	"\vmax_retries\x18\r \x01(\x05R\n" +
+	"maxRetries\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x0e \x01(\tR\tlastError\x12\x1e\n" +
+	"\vlast_run_id\x18\x0f \x01(\tR\tlastRunId\x12\x18\n" +
+	"\aversion\x18\x10 \x01(\x03R\aversion\"7\n" +
 	"\n" +
-	"RepeatInfo\"\xaf\x03\n" +
+	"RepeatInfo\x12)\n" +
+	"\x10interval_seconds\x18\x01 \x01(\x05R\x0fintervalSeconds\"\x87\x04\n" +
 	"\x03Run\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x15\n" +
 	"\x06run_id\x18\x02 \x01(\tR\x05runId\x129\n" +
@@ -374,11 +854,42 @@ const file_lilbattle_v1_models_jobs_proto_rawDesc = "" +
 	"\x11last_content_hash\x18\t \x01(\tR\x0flastContentHash\x12\x1f\n" +
 	"\vretry_count\x18\n" +
 	" \x01(\x05R\n" +
-	"retryCount*T\n" +
+	"retryCount\x12\x19\n" +
+	"\bjob_type\x18\v \x01(\tR\ajobType\x12;\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"\x97\x01\n" +
+	"\x0fListJobsRequest\x12\x19\n" +
+	"\bjob_type\x18\x01 \x01(\tR\ajobType\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.lilbattle.v1.JobStatusR\x06status\x128\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x18.lilbattle.v1.PaginationR\n" +
+	"pagination\"}\n" +
+	"\x10ListJobsResponse\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.lilbattle.v1.JobR\x05items\x12@\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2 .lilbattle.v1.PaginationResponseR\n" +
+	"pagination\"<\n" +
+	"\rGetJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\trun_limit\x18\x02 \x01(\x05R\brunLimit\"\\\n" +
+	"\x0eGetJobResponse\x12#\n" +
+	"\x03job\x18\x01 \x01(\v2\x11.lilbattle.v1.JobR\x03job\x12%\n" +
+	"\x04runs\x18\x02 \x03(\v2\x11.lilbattle.v1.RunR\x04runs\"!\n" +
+	"\x0fRetryJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x10RetryJobResponse\x12#\n" +
+	"\x03job\x18\x01 \x01(\v2\x11.lilbattle.v1.JobR\x03job*j\n" +
 	"\bRunState\x12\x19\n" +
 	"\x15RUN_STATE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11RUN_STATE_STARTED\x10\x01\x12\x16\n" +
-	"\x12RUN_STATE_FINISHED\x10\x02B\xb5\x01\n" +
+	"\x12RUN_STATE_FINISHED\x10\x02\x12\x14\n" +
+	"\x10RUN_STATE_FAILED\x10\x03*\x88\x01\n" +
+	"\tJobStatus\x12\x1a\n" +
+	"\x16JOB_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12JOB_STATUS_PENDING\x10\x01\x12\x16\n" +
+	"\x12JOB_STATUS_RUNNING\x10\x02\x12\x18\n" +
+	"\x14JOB_STATUS_SUCCEEDED\x10\x03\x12\x15\n" +
+	"\x11JOB_STATUS_FAILED\x10\x04B\xb5\x01\n" +
 	"\x10com.lilbattle.v1B\tJobsProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var (
@@ -393,31 +904,50 @@ func file_lilbattle_v1_models_jobs_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_models_jobs_proto_rawDescData
 }
 
-var file_lilbattle_v1_models_jobs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_lilbattle_v1_models_jobs_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lilbattle_v1_models_jobs_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_lilbattle_v1_models_jobs_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_lilbattle_v1_models_jobs_proto_goTypes = []any{
 	(RunState)(0),                 // 0: lilbattle.v1.RunState
-	(*Job)(nil),                   // 1: lilbattle.v1.Job
-	(*RepeatInfo)(nil),            // 2: lilbattle.v1.RepeatInfo
-	(*Run)(nil),                   // 3: lilbattle.v1.Run
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
-	(*anypb.Any)(nil),             // 5: google.protobuf.Any
+	(JobStatus)(0),                // 1: lilbattle.v1.JobStatus
+	(*Job)(nil),                   // 2: lilbattle.v1.Job
+	(*RepeatInfo)(nil),            // 3: lilbattle.v1.RepeatInfo
+	(*Run)(nil),                   // 4: lilbattle.v1.Run
+	(*ListJobsRequest)(nil),       // 5: lilbattle.v1.ListJobsRequest
+	(*ListJobsResponse)(nil),      // 6: lilbattle.v1.ListJobsResponse
+	(*GetJobRequest)(nil),         // 7: lilbattle.v1.GetJobRequest
+	(*GetJobResponse)(nil),        // 8: lilbattle.v1.GetJobResponse
+	(*RetryJobRequest)(nil),       // 9: lilbattle.v1.RetryJobRequest
+	(*RetryJobResponse)(nil),      // 10: lilbattle.v1.RetryJobResponse
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*anypb.Any)(nil),             // 12: google.protobuf.Any
+	(*Pagination)(nil),            // 13: lilbattle.v1.Pagination
+	(*PaginationResponse)(nil),    // 14: lilbattle.v1.PaginationResponse
 }
 var file_lilbattle_v1_models_jobs_proto_depIdxs = []int32{
-	4, // 0: lilbattle.v1.Job.created_at:type_name -> google.protobuf.Timestamp
-	4, // 1: lilbattle.v1.Job.updated_at:type_name -> google.protobuf.Timestamp
-	5, // 2: lilbattle.v1.Job.job_data:type_name -> google.protobuf.Any
-	2, // 3: lilbattle.v1.Job.repeat_info:type_name -> lilbattle.v1.RepeatInfo
-	4, // 4: lilbattle.v1.Run.created_at:type_name -> google.protobuf.Timestamp
-	4, // 5: lilbattle.v1.Run.started_at:type_name -> google.protobuf.Timestamp
-	4, // 6: lilbattle.v1.Run.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 7: lilbattle.v1.Run.state:type_name -> lilbattle.v1.RunState
-	5, // 8: lilbattle.v1.Run.run_data:type_name -> google.protobuf.Any
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	11, // 0: lilbattle.v1.Job.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: lilbattle.v1.Job.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 2: lilbattle.v1.Job.job_data:type_name -> google.protobuf.Any
+	3,  // 3: lilbattle.v1.Job.repeat_info:type_name -> lilbattle.v1.RepeatInfo
+	1,  // 4: lilbattle.v1.Job.status:type_name -> lilbattle.v1.JobStatus
+	11, // 5: lilbattle.v1.Job.run_after:type_name -> google.protobuf.Timestamp
+	11, // 6: lilbattle.v1.Run.created_at:type_name -> google.protobuf.Timestamp
+	11, // 7: lilbattle.v1.Run.started_at:type_name -> google.protobuf.Timestamp
+	11, // 8: lilbattle.v1.Run.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: lilbattle.v1.Run.state:type_name -> lilbattle.v1.RunState
+	12, // 10: lilbattle.v1.Run.run_data:type_name -> google.protobuf.Any
+	11, // 11: lilbattle.v1.Run.finished_at:type_name -> google.protobuf.Timestamp
+	1,  // 12: lilbattle.v1.ListJobsRequest.status:type_name -> lilbattle.v1.JobStatus
+	13, // 13: lilbattle.v1.ListJobsRequest.pagination:type_name -> lilbattle.v1.Pagination
+	2,  // 14: lilbattle.v1.ListJobsResponse.items:type_name -> lilbattle.v1.Job
+	14, // 15: lilbattle.v1.ListJobsResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
+	2,  // 16: lilbattle.v1.GetJobResponse.job:type_name -> lilbattle.v1.Job
+	4,  // 17: lilbattle.v1.GetJobResponse.runs:type_name -> lilbattle.v1.Run
+	2,  // 18: lilbattle.v1.RetryJobResponse.job:type_name -> lilbattle.v1.Job
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_models_jobs_proto_init() }
@@ -425,13 +955,14 @@ func file_lilbattle_v1_models_jobs_proto_init() {
 	if File_lilbattle_v1_models_jobs_proto != nil {
 		return
 	}
+	file_lilbattle_v1_models_models_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_jobs_proto_rawDesc), len(file_lilbattle_v1_models_jobs_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -247,6 +247,114 @@ func (PathDirection) EnumDescriptor() ([]byte, []int) {
 	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{3}
 }
 
+// How a cell of a world differs from the same cell in another version of
+// the world
+type WorldChangeKind int32
+
+const (
+	WorldChangeKind_WORLD_CHANGE_KIND_UNSPECIFIED WorldChangeKind = 0
+	WorldChangeKind_WORLD_CHANGE_KIND_ADDED       WorldChangeKind = 1
+	WorldChangeKind_WORLD_CHANGE_KIND_REMOVED     WorldChangeKind = 2
+	WorldChangeKind_WORLD_CHANGE_KIND_MODIFIED    WorldChangeKind = 3
+)
+
+// Enum value maps for WorldChangeKind.
+var (
+	WorldChangeKind_name = map[int32]string{
+		0: "WORLD_CHANGE_KIND_UNSPECIFIED",
+		1: "WORLD_CHANGE_KIND_ADDED",
+		2: "WORLD_CHANGE_KIND_REMOVED",
+		3: "WORLD_CHANGE_KIND_MODIFIED",
+	}
+	WorldChangeKind_value = map[string]int32{
+		"WORLD_CHANGE_KIND_UNSPECIFIED": 0,
+		"WORLD_CHANGE_KIND_ADDED":       1,
+		"WORLD_CHANGE_KIND_REMOVED":     2,
+		"WORLD_CHANGE_KIND_MODIFIED":    3,
+	}
+)
+
+func (x WorldChangeKind) Enum() *WorldChangeKind {
+	p := new(WorldChangeKind)
+	*p = x
+	return p
+}
+
+func (x WorldChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorldChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_lilbattle_v1_models_models_proto_enumTypes[4].Descriptor()
+}
+
+func (WorldChangeKind) Type() protoreflect.EnumType {
+	return &file_lilbattle_v1_models_models_proto_enumTypes[4]
+}
+
+func (x WorldChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorldChangeKind.Descriptor instead.
+func (WorldChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{4}
+}
+
+// What a three-way world merge does with cells both sides changed
+// differently
+type WorldMergeStrategy int32
+
+const (
+	// Report the conflicts and do not merge
+	WorldMergeStrategy_WORLD_MERGE_STRATEGY_UNSPECIFIED WorldMergeStrategy = 0
+	// Keep our side of every conflict
+	WorldMergeStrategy_WORLD_MERGE_STRATEGY_OURS WorldMergeStrategy = 1
+	// Take their side of every conflict
+	WorldMergeStrategy_WORLD_MERGE_STRATEGY_THEIRS WorldMergeStrategy = 2
+)
+
+// Enum value maps for WorldMergeStrategy.
+var (
+	WorldMergeStrategy_name = map[int32]string{
+		0: "WORLD_MERGE_STRATEGY_UNSPECIFIED",
+		1: "WORLD_MERGE_STRATEGY_OURS",
+		2: "WORLD_MERGE_STRATEGY_THEIRS",
+	}
+	WorldMergeStrategy_value = map[string]int32{
+		"WORLD_MERGE_STRATEGY_UNSPECIFIED": 0,
+		"WORLD_MERGE_STRATEGY_OURS":        1,
+		"WORLD_MERGE_STRATEGY_THEIRS":      2,
+	}
+)
+
+func (x WorldMergeStrategy) Enum() *WorldMergeStrategy {
+	p := new(WorldMergeStrategy)
+	*p = x
+	return p
+}
+
+func (x WorldMergeStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorldMergeStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_lilbattle_v1_models_models_proto_enumTypes[5].Descriptor()
+}
+
+func (WorldMergeStrategy) Type() protoreflect.EnumType {
+	return &file_lilbattle_v1_models_models_proto_enumTypes[5]
+}
+
+func (x WorldMergeStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorldMergeStrategy.Descriptor instead.
+func (WorldMergeStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{5}
+}
+
 type IndexInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// We maintain an IndexInfo for each type of "indexing" operation needed
@@ -392,7 +500,8 @@ type PaginationResponse struct {
 	// Whether theere are more results.
 	HasMore bool `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	// *
-	// Total number of results.
+	// Total number of results.  Backends that cannot count matches cheaply
+	// leave this as 0.
 	TotalResults  int32 `protobuf:"varint,5,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -482,8 +591,14 @@ type World struct {
 	// Default game configs
 	DefaultGameConfig *GameConfiguration `protobuf:"bytes,12,opt,name=default_game_config,json=defaultGameConfig,proto3" json:"default_game_config,omitempty"`
 	SearchIndexInfo   *IndexInfo         `protobuf:"bytes,13,opt,name=search_index_info,json=searchIndexInfo,proto3" json:"search_index_info,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// World this one was copied (remixed) from, empty for original worlds
+	ParentWorldId string `protobuf:"bytes,14,opt,name=parent_world_id,json=parentWorldId,proto3" json:"parent_world_id,omitempty"`
+	// Revision of the parent world this one was copied from, or last merged
+	// upstream changes from.  It is the base of three-way merges with the
+	// parent.
+	ParentRevision int64 `protobuf:"varint,15,opt,name=parent_revision,json=parentRevision,proto3" json:"parent_revision,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *World) Reset() {
@@ -607,6 +722,20 @@ func (x *World) GetSearchIndexInfo() *IndexInfo {
 	return nil
 }
 
+func (x *World) GetParentWorldId() string {
+	if x != nil {
+		return x.ParentWorldId
+	}
+	return ""
+}
+
+func (x *World) GetParentRevision() int64 {
+	if x != nil {
+		return x.ParentRevision
+	}
+	return 0
+}
+
 type WorldData struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// New map-based storage (key = "q,r" coordinate string)
@@ -1827,8 +1956,14 @@ type Game struct {
 	// Can be overridden to point to CDN or external hosting
 	PreviewUrls     []string   `protobuf:"bytes,13,rep,name=preview_urls,json=previewUrls,proto3" json:"preview_urls,omitempty"`
 	SearchIndexInfo *IndexInfo `protobuf:"bytes,15,opt,name=search_index_info,json=searchIndexInfo,proto3" json:"search_index_info,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// The world revision (WorldData version) this game was created from.
+	// 0 for games created before worlds kept revisions.
+	WorldRevision int64 `protobuf:"varint,16,opt,name=world_revision,json=worldRevision,proto3" json:"world_revision,omitempty"`
+	// The version (content hash) of the rules and damage data this game is
+	// played with.  Empty for games created before games recorded it.
+	RulesVersion  string `protobuf:"bytes,17,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Game) Reset() {
@@ -1959,6 +2094,20 @@ func (x *Game) GetSearchIndexInfo() *IndexInfo {
 	return nil
 }
 
+func (x *Game) GetWorldRevision() int64 {
+	if x != nil {
+		return x.WorldRevision
+	}
+	return 0
+}
+
+func (x *Game) GetRulesVersion() string {
+	if x != nil {
+		return x.RulesVersion
+	}
+	return ""
+}
+
 type GameConfiguration struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Player configuration
@@ -2314,14 +2463,26 @@ type GameSettings struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// List of allowed unit type IDs
 	AllowedUnits []int32 `protobuf:"varint,1,rep,packed,name=allowed_units,json=allowedUnits,proto3" json:"allowed_units,omitempty"`
-	// Turn time limit in seconds (0 = no limit)
+	// Turn time limit in seconds (0 = no limit, or 24 hours for each orders phase of simultaneous turns)
 	TurnTimeLimit int32 `protobuf:"varint,2,opt,name=turn_time_limit,json=turnTimeLimit,proto3" json:"turn_time_limit,omitempty"`
 	// Team mode
 	TeamMode string `protobuf:"bytes,3,opt,name=team_mode,json=teamMode,proto3" json:"team_mode,omitempty"` // "ffa" or "teams"
 	// Maximum number of turns (0 = unlimited)
-	MaxTurns      int32 `protobuf:"varint,4,opt,name=max_turns,json=maxTurns,proto3" json:"max_turns,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	MaxTurns int32 `protobuf:"varint,4,opt,name=max_turns,json=maxTurns,proto3" json:"max_turns,omitempty"`
+	// Turn mode - "sequential" (default) or "simultaneous"
+	// In simultaneous mode all players commit orders for a turn in parallel
+	// and they are resolved together once everyone has revealed them.
+	TurnMode string `protobuf:"bytes,5,opt,name=turn_mode,json=turnMode,proto3" json:"turn_mode,omitempty"`
+	// Teammates share a single coin pool for building units
+	SharedCoins bool `protobuf:"varint,6,opt,name=shared_coins,json=sharedCoins,proto3" json:"shared_coins,omitempty"`
+	// Teammates may move and act with each other's units on their turn
+	SharedControl bool `protobuf:"varint,7,opt,name=shared_control,json=sharedControl,proto3" json:"shared_control,omitempty"`
+	// Units may be healed and fixed at allied bases and by allied units
+	AlliedSupport bool `protobuf:"varint,8,opt,name=allied_support,json=alliedSupport,proto3" json:"allied_support,omitempty"`
+	// The game is won by a team once only its players have units left
+	CombinedVictory bool `protobuf:"varint,9,opt,name=combined_victory,json=combinedVictory,proto3" json:"combined_victory,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GameSettings) Reset() {
@@ -2382,6 +2543,41 @@ func (x *GameSettings) GetMaxTurns() int32 {
 	return 0
 }
 
+func (x *GameSettings) GetTurnMode() string {
+	if x != nil {
+		return x.TurnMode
+	}
+	return ""
+}
+
+func (x *GameSettings) GetSharedCoins() bool {
+	if x != nil {
+		return x.SharedCoins
+	}
+	return false
+}
+
+func (x *GameSettings) GetSharedControl() bool {
+	if x != nil {
+		return x.SharedControl
+	}
+	return false
+}
+
+func (x *GameSettings) GetAlliedSupport() bool {
+	if x != nil {
+		return x.AlliedSupport
+	}
+	return false
+}
+
+func (x *GameSettings) GetCombinedVictory() bool {
+	if x != nil {
+		return x.CombinedVictory
+	}
+	return false
+}
+
 // Runtime state for a player during the game
 // This is separate from GamePlayer (which is player configuration)
 // PlayerState is indexed by player_id in the player_states map
@@ -2461,7 +2657,10 @@ type GameState struct {
 	CurrentGroupNumber int64 `protobuf:"varint,14,opt,name=current_group_number,json=currentGroupNumber,proto3" json:"current_group_number,omitempty"`
 	// Per-player runtime state, keyed by player_id (1-based)
 	// This holds mutable player state like coins that changes during gameplay
-	PlayerStates  map[int32]*PlayerState `protobuf:"bytes,15,rep,name=player_states,json=playerStates,proto3" json:"player_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PlayerStates map[int32]*PlayerState `protobuf:"bytes,15,rep,name=player_states,json=playerStates,proto3" json:"player_states,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Orders committed/revealed for the current turn in simultaneous mode,
+	// keyed by player_id.  Cleared once the turn is resolved.
+	PendingOrders map[int32]*PlayerOrders `protobuf:"bytes,16,rep,name=pending_orders,json=pendingOrders,proto3" json:"pending_orders,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2587,6 +2786,13 @@ func (x *GameState) GetPlayerStates() map[int32]*PlayerState {
 	return nil
 }
 
+func (x *GameState) GetPendingOrders() map[int32]*PlayerOrders {
+	if x != nil {
+		return x.PendingOrders
+	}
+	return nil
+}
+
 // Holds the game's move history (can be used as a replay log)
 type GameMoveHistory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -4600,148 +4806,799 @@ func (x *Path) GetTotalCost() float64 {
 	return 0
 }
 
-var File_lilbattle_v1_models_models_proto protoreflect.FileDescriptor
+// Orders a player has submitted for the current turn in simultaneous mode.
+// Orders go through a commit/reveal flow - a player first commits a hash of
+// their moves so nobody can react to anybody else's orders, and once every
+// player has committed the moves are revealed and checked against the hash.
+type PlayerOrders struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Hex encoded SHA-256 of the moves and salt (see lib.OrdersCommitment)
+	Commitment  string                 `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	CommittedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=committed_at,json=committedAt,proto3" json:"committed_at,omitempty"`
+	// Set once the moves have been revealed and verified against the commitment
+	Revealed bool `protobuf:"varint,4,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	// The revealed moves - empty until revealed
+	Moves []*GameMove `protobuf:"bytes,5,rep,name=moves,proto3" json:"moves,omitempty"`
+	// Salt used in the commitment so identical orders do not hash the same
+	Salt          string `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_lilbattle_v1_models_models_proto_rawDesc = "" +
-	"\n" +
-	" lilbattle/v1/models/models.proto\x12\flilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xba\x01\n" +
-	"\tIndexInfo\x12B\n" +
-	"\x0flast_updated_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\rlastUpdatedAt\x12B\n" +
-	"\x0flast_indexed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rlastIndexedAt\x12%\n" +
-	"\x0eneeds_indexing\x18\x03 \x01(\bR\rneedsIndexing\"e\n" +
-	"\n" +
-	"Pagination\x12\x19\n" +
-	"\bpage_key\x18\x01 \x01(\tR\apageKey\x12\x1f\n" +
-	"\vpage_offset\x18\x02 \x01(\x05R\n" +
-	"pageOffset\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xa2\x01\n" +
-	"\x12PaginationResponse\x12\"\n" +
-	"\rnext_page_key\x18\x02 \x01(\tR\vnextPageKey\x12(\n" +
-	"\x10next_page_offset\x18\x03 \x01(\x05R\x0enextPageOffset\x12\x19\n" +
-	"\bhas_more\x18\x04 \x01(\bR\ahasMore\x12#\n" +
-	"\rtotal_results\x18\x05 \x01(\x05R\ftotalResults\"\x86\x04\n" +
-	"\x05World\x129\n" +
-	"\n" +
-	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\x03R\aversion\x12\x0e\n" +
-	"\x02id\x18\x04 \x01(\tR\x02id\x12\x1d\n" +
-	"\n" +
-	"creator_id\x18\x05 \x01(\tR\tcreatorId\x12\x12\n" +
-	"\x04name\x18\x06 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\a \x01(\tR\vdescription\x12\x12\n" +
-	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1b\n" +
-	"\timage_url\x18\t \x01(\tR\bimageUrl\x12\x1e\n" +
-	"\n" +
-	"difficulty\x18\n" +
-	" \x01(\tR\n" +
-	"difficulty\x12!\n" +
-	"\fpreview_urls\x18\v \x03(\tR\vpreviewUrls\x12O\n" +
-	"\x13default_game_config\x18\f \x01(\v2\x1f.lilbattle.v1.GameConfigurationR\x11defaultGameConfig\x12C\n" +
-	"\x11search_index_info\x18\r \x01(\v2\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\"\xdb\x04\n" +
-	"\tWorldData\x12B\n" +
-	"\ttiles_map\x18\x01 \x03(\v2%.lilbattle.v1.WorldData.TilesMapEntryR\btilesMap\x12B\n" +
-	"\tunits_map\x18\x02 \x03(\v2%.lilbattle.v1.WorldData.UnitsMapEntryR\bunitsMap\x12K\n" +
-	"\x15screenshot_index_info\x18\x03 \x01(\v2\x17.lilbattle.v1.IndexInfoR\x13screenshotIndexInfo\x12!\n" +
-	"\fcontent_hash\x18\x04 \x01(\tR\vcontentHash\x12\x18\n" +
-	"\aversion\x18\x05 \x01(\x03R\aversion\x12D\n" +
-	"\tcrossings\x18\b \x03(\v2&.lilbattle.v1.WorldData.CrossingsEntryR\tcrossings\x1aO\n" +
-	"\rTilesMapEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
-	"\x05value\x18\x02 \x01(\v2\x12.lilbattle.v1.TileR\x05value:\x028\x01\x1aO\n" +
-	"\rUnitsMapEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
-	"\x05value\x18\x02 \x01(\v2\x12.lilbattle.v1.UnitR\x05value:\x028\x01\x1aT\n" +
-	"\x0eCrossingsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.lilbattle.v1.CrossingR\x05value:\x028\x01\"[\n" +
-	"\bCrossing\x12.\n" +
-	"\x04type\x18\x01 \x01(\x0e2\x1a.lilbattle.v1.CrossingTypeR\x04type\x12\x1f\n" +
-	"\vconnects_to\x18\x02 \x03(\bR\n" +
-	"connectsTo\"\xc9\x01\n" +
-	"\x04Tile\x12\f\n" +
-	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
-	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
-	"\ttile_type\x18\x03 \x01(\x05R\btileType\x12\x16\n" +
-	"\x06player\x18\x04 \x01(\x05R\x06player\x12\x1a\n" +
-	"\bshortcut\x18\x05 \x01(\tR\bshortcut\x12&\n" +
-	"\x0flast_acted_turn\x18\x06 \x01(\x05R\rlastActedTurn\x12,\n" +
-	"\x12last_toppedup_turn\x18\a \x01(\x05R\x10lastToppedupTurn\"\xa5\x04\n" +
-	"\x04Unit\x12\f\n" +
-	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
-	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x16\n" +
-	"\x06player\x18\x03 \x01(\x05R\x06player\x12\x1b\n" +
-	"\tunit_type\x18\x04 \x01(\x05R\bunitType\x12\x1a\n" +
-	"\bshortcut\x18\x05 \x01(\tR\bshortcut\x12)\n" +
-	"\x10available_health\x18\x06 \x01(\x05R\x0favailableHealth\x12#\n" +
-	"\rdistance_left\x18\a \x01(\x01R\fdistanceLeft\x12&\n" +
-	"\x0flast_acted_turn\x18\b \x01(\x05R\rlastActedTurn\x12,\n" +
-	"\x12last_toppedup_turn\x18\t \x01(\x05R\x10lastToppedupTurn\x12;\n" +
-	"\x1aattacks_received_this_turn\x18\n" +
-	" \x01(\x05R\x17attacksReceivedThisTurn\x12A\n" +
-	"\x0eattack_history\x18\v \x03(\v2\x1a.lilbattle.v1.AttackRecordR\rattackHistory\x12)\n" +
-	"\x10progression_step\x18\f \x01(\x05R\x0fprogressionStep\x12-\n" +
-	"\x12chosen_alternative\x18\r \x01(\tR\x11chosenAlternative\x120\n" +
-	"\x14capture_started_turn\x18\x0e \x01(\x05R\x12captureStartedTurn\"h\n" +
-	"\fAttackRecord\x12\f\n" +
-	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
-	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
-	"\tis_ranged\x18\x03 \x01(\bR\bisRanged\x12\x1f\n" +
-	"\vturn_number\x18\x04 \x01(\x05R\n" +
-	"turnNumber\"\x89\x03\n" +
-	"\x11TerrainDefinition\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x05 \x01(\x05R\x04type\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\\\n" +
-	"\x0funit_properties\x18\a \x03(\v23.lilbattle.v1.TerrainDefinition.UnitPropertiesEntryR\x0eunitProperties\x12,\n" +
-	"\x12buildable_unit_ids\x18\b \x03(\x05R\x10buildableUnitIds\x12&\n" +
-	"\x0fincome_per_turn\x18\t \x01(\x05R\rincomePerTurn\x1af\n" +
-	"\x13UnitPropertiesEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\x05R\x03key\x129\n" +
-	"\x05value\x18\x02 \x01(\v2#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x028\x01\"\x82\b\n" +
-	"\x0eUnitDefinition\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06health\x18\x04 \x01(\x05R\x06health\x12\x14\n" +
-	"\x05coins\x18\x05 \x01(\x05R\x05coins\x12'\n" +
-	"\x0fmovement_points\x18\x06 \x01(\x01R\x0emovementPoints\x12%\n" +
-	"\x0eretreat_points\x18\a \x01(\x01R\rretreatPoints\x12\x18\n" +
-	"\adefense\x18\b \x01(\x05R\adefense\x12!\n" +
-	"\fattack_range\x18\t \x01(\x05R\vattackRange\x12(\n" +
-	"\x10min_attack_range\x18\n" +
-	" \x01(\x05R\x0eminAttackRange\x12#\n" +
-	"\rsplash_damage\x18\v \x01(\x05R\fsplashDamage\x12b\n" +
-	"\x12terrain_properties\x18\f \x03(\v23.lilbattle.v1.UnitDefinition.TerrainPropertiesEntryR\x11terrainProperties\x12\x1e\n" +
-	"\n" +
-	"properties\x18\r \x03(\tR\n" +
-	"properties\x12\x1d\n" +
-	"\n" +
-	"unit_class\x18\x0e \x01(\tR\tunitClass\x12!\n" +
-	"\funit_terrain\x18\x0f \x01(\tR\vunitTerrain\x12W\n" +
-	"\x0fattack_vs_class\x18\x10 \x03(\v2/.lilbattle.v1.UnitDefinition.AttackVsClassEntryR\rattackVsClass\x12!\n" +
-	"\faction_order\x18\x11 \x03(\tR\vactionOrder\x12S\n" +
-	"\raction_limits\x18\x12 \x03(\v2..lilbattle.v1.UnitDefinition.ActionLimitsEntryR\factionLimits\x12\x1b\n" +
-	"\tfix_value\x18\x13 \x01(\x05R\bfixValue\x1ai\n" +
-	"\x16TerrainPropertiesEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\x05R\x03key\x129\n" +
-	"\x05value\x18\x02 \x01(\v2#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x028\x01\x1a@\n" +
-	"\x12AttackVsClassEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a?\n" +
-	"\x11ActionLimitsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xec\x02\n" +
-	"\x15TerrainUnitProperties\x12\x1d\n" +
-	"\n" +
-	"terrain_id\x18\x01 \x01(\x05R\tterrainId\x12\x17\n" +
-	"\aunit_id\x18\x02 \x01(\x05R\x06unitId\x12#\n" +
-	"\rmovement_cost\x18\x03 \x01(\x01R\fmovementCost\x12#\n" +
-	"\rhealing_bonus\x18\x04 \x01(\x05R\fhealingBonus\x12\x1b\n" +
-	"\tcan_build\x18\x05 \x01(\bR\bcanBuild\x12\x1f\n" +
-	"\vcan_capture\x18\x06 \x01(\bR\n" +
+func (x *PlayerOrders) Reset() {
+	*x = PlayerOrders{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerOrders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerOrders) ProtoMessage() {}
+
+func (x *PlayerOrders) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerOrders.ProtoReflect.Descriptor instead.
+func (*PlayerOrders) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PlayerOrders) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerOrders) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *PlayerOrders) GetCommittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommittedAt
+	}
+	return nil
+}
+
+func (x *PlayerOrders) GetRevealed() bool {
+	if x != nil {
+		return x.Revealed
+	}
+	return false
+}
+
+func (x *PlayerOrders) GetMoves() []*GameMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *PlayerOrders) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+// A time window used to filter list results.  Either bound may be omitted;
+// start is inclusive and end is exclusive.
+type TimeRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TimeRange) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TimeRange) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+// An entry in the per-user game index - one per (user, game) the user holds
+// a player slot in.  Entries are rewritten whenever the game is created,
+// joined or changes turn so "my games" can be listed without loading every
+// game.
+type UserGame struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The user's player slots in the game (usually one)
+	PlayerIds []int32 `protobuf:"varint,3,rep,packed,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	// Denormalized from the game for listing
+	GameName string `protobuf:"bytes,4,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`
+	WorldId  string `protobuf:"bytes,5,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Denormalized from the game state
+	Status        GameStatus `protobuf:"varint,6,opt,name=status,proto3,enum=lilbattle.v1.GameStatus" json:"status,omitempty"`
+	CurrentPlayer int32      `protobuf:"varint,7,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`
+	TurnCounter   int32      `protobuf:"varint,8,opt,name=turn_counter,json=turnCounter,proto3" json:"turn_counter,omitempty"`
+	// Whether the game is waiting on this user - it is one of their slots'
+	// turn, or in simultaneous mode they have orders to commit or reveal
+	IsMyTurn bool `protobuf:"varint,9,opt,name=is_my_turn,json=isMyTurn,proto3" json:"is_my_turn,omitempty"`
+	// When the entry was last rewritten
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGame) Reset() {
+	*x = UserGame{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGame) ProtoMessage() {}
+
+func (x *UserGame) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGame.ProtoReflect.Descriptor instead.
+func (*UserGame) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UserGame) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserGame) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *UserGame) GetPlayerIds() []int32 {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *UserGame) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *UserGame) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *UserGame) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (x *UserGame) GetCurrentPlayer() int32 {
+	if x != nil {
+		return x.CurrentPlayer
+	}
+	return 0
+}
+
+func (x *UserGame) GetTurnCounter() int32 {
+	if x != nil {
+		return x.TurnCounter
+	}
+	return 0
+}
+
+func (x *UserGame) GetIsMyTurn() bool {
+	if x != nil {
+		return x.IsMyTurn
+	}
+	return false
+}
+
+func (x *UserGame) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// The index entries of one user (the file backend stores one per user)
+type UserGameList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*UserGame            `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGameList) Reset() {
+	*x = UserGameList{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGameList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGameList) ProtoMessage() {}
+
+func (x *UserGameList) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGameList.ProtoReflect.Descriptor instead.
+func (*UserGameList) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UserGameList) GetGames() []*UserGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+// An immutable snapshot of a world's data.  A revision is recorded every
+// time the world's data is saved and is numbered by the WorldData version
+// it captures, so games can be pinned to the revision they started from.
+type WorldRevision struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	WorldId string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// The WorldData version this revision captures
+	Revision  int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// User whose save created the revision
+	AuthorId string `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Set when the revision was created by reverting to an older one
+	RevertedFrom int64  `protobuf:"varint,5,opt,name=reverted_from,json=revertedFrom,proto3" json:"reverted_from,omitempty"`
+	ContentHash  string `protobuf:"bytes,6,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	TileCount    int32  `protobuf:"varint,7,opt,name=tile_count,json=tileCount,proto3" json:"tile_count,omitempty"`
+	UnitCount    int32  `protobuf:"varint,8,opt,name=unit_count,json=unitCount,proto3" json:"unit_count,omitempty"`
+	// The world's data at this revision (not set when listing revisions)
+	WorldData     *WorldData `protobuf:"bytes,9,opt,name=world_data,json=worldData,proto3" json:"world_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldRevision) Reset() {
+	*x = WorldRevision{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldRevision) ProtoMessage() {}
+
+func (x *WorldRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldRevision.ProtoReflect.Descriptor instead.
+func (*WorldRevision) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WorldRevision) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *WorldRevision) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *WorldRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WorldRevision) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *WorldRevision) GetRevertedFrom() int64 {
+	if x != nil {
+		return x.RevertedFrom
+	}
+	return 0
+}
+
+func (x *WorldRevision) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *WorldRevision) GetTileCount() int32 {
+	if x != nil {
+		return x.TileCount
+	}
+	return 0
+}
+
+func (x *WorldRevision) GetUnitCount() int32 {
+	if x != nil {
+		return x.UnitCount
+	}
+	return 0
+}
+
+func (x *WorldRevision) GetWorldData() *WorldData {
+	if x != nil {
+		return x.WorldData
+	}
+	return nil
+}
+
+// A change to one cell of one layer of a world
+type WorldCellChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "tile", "unit" or "crossing"
+	Layer string `protobuf:"bytes,1,opt,name=layer,proto3" json:"layer,omitempty"`
+	// "q,r" coordinate of the cell
+	Key           string          `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Kind          WorldChangeKind `protobuf:"varint,3,opt,name=kind,proto3,enum=lilbattle.v1.WorldChangeKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldCellChange) Reset() {
+	*x = WorldCellChange{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldCellChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldCellChange) ProtoMessage() {}
+
+func (x *WorldCellChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldCellChange.ProtoReflect.Descriptor instead.
+func (*WorldCellChange) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *WorldCellChange) GetLayer() string {
+	if x != nil {
+		return x.Layer
+	}
+	return ""
+}
+
+func (x *WorldCellChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *WorldCellChange) GetKind() WorldChangeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return WorldChangeKind_WORLD_CHANGE_KIND_UNSPECIFIED
+}
+
+// A cell both sides of a three-way world merge changed, differently
+type WorldMergeConflict struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "tile", "unit" or "crossing"
+	Layer string `protobuf:"bytes,1,opt,name=layer,proto3" json:"layer,omitempty"`
+	// "q,r" coordinate of the cell
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// How each side changed the cell relative to the common base
+	Ours          WorldChangeKind `protobuf:"varint,3,opt,name=ours,proto3,enum=lilbattle.v1.WorldChangeKind" json:"ours,omitempty"`
+	Theirs        WorldChangeKind `protobuf:"varint,4,opt,name=theirs,proto3,enum=lilbattle.v1.WorldChangeKind" json:"theirs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldMergeConflict) Reset() {
+	*x = WorldMergeConflict{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldMergeConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldMergeConflict) ProtoMessage() {}
+
+func (x *WorldMergeConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldMergeConflict.ProtoReflect.Descriptor instead.
+func (*WorldMergeConflict) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *WorldMergeConflict) GetLayer() string {
+	if x != nil {
+		return x.Layer
+	}
+	return ""
+}
+
+func (x *WorldMergeConflict) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *WorldMergeConflict) GetOurs() WorldChangeKind {
+	if x != nil {
+		return x.Ours
+	}
+	return WorldChangeKind_WORLD_CHANGE_KIND_UNSPECIFIED
+}
+
+func (x *WorldMergeConflict) GetTheirs() WorldChangeKind {
+	if x != nil {
+		return x.Theirs
+	}
+	return WorldChangeKind_WORLD_CHANGE_KIND_UNSPECIFIED
+}
+
+// A world as kept in the world search index, with the attributes searches
+// filter and sort on
+type WorldSearchEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	World *World                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`
+	// Number of players - the distinct players owning units or tiles
+	Players int32 `protobuf:"varint,2,opt,name=players,proto3" json:"players,omitempty"`
+	// Number of tiles, the map size
+	Tiles int32 `protobuf:"varint,3,opt,name=tiles,proto3" json:"tiles,omitempty"`
+	// Number of games created from the world, its popularity
+	Games int32 `protobuf:"varint,4,opt,name=games,proto3" json:"games,omitempty"`
+	// When the entry was last indexed
+	IndexedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=indexed_at,json=indexedAt,proto3" json:"indexed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldSearchEntry) Reset() {
+	*x = WorldSearchEntry{}
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldSearchEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldSearchEntry) ProtoMessage() {}
+
+func (x *WorldSearchEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_models_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldSearchEntry.ProtoReflect.Descriptor instead.
+func (*WorldSearchEntry) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_models_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *WorldSearchEntry) GetWorld() *World {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+func (x *WorldSearchEntry) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *WorldSearchEntry) GetTiles() int32 {
+	if x != nil {
+		return x.Tiles
+	}
+	return 0
+}
+
+func (x *WorldSearchEntry) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *WorldSearchEntry) GetIndexedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IndexedAt
+	}
+	return nil
+}
+
+var File_lilbattle_v1_models_models_proto protoreflect.FileDescriptor
+
+const file_lilbattle_v1_models_models_proto_rawDesc = "" +
+	"\n" +
+	" lilbattle/v1/models/models.proto\x12\flilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xba\x01\n" +
+	"\tIndexInfo\x12B\n" +
+	"\x0flast_updated_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\rlastUpdatedAt\x12B\n" +
+	"\x0flast_indexed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rlastIndexedAt\x12%\n" +
+	"\x0eneeds_indexing\x18\x03 \x01(\bR\rneedsIndexing\"e\n" +
+	"\n" +
+	"Pagination\x12\x19\n" +
+	"\bpage_key\x18\x01 \x01(\tR\apageKey\x12\x1f\n" +
+	"\vpage_offset\x18\x02 \x01(\x05R\n" +
+	"pageOffset\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xa2\x01\n" +
+	"\x12PaginationResponse\x12\"\n" +
+	"\rnext_page_key\x18\x02 \x01(\tR\vnextPageKey\x12(\n" +
+	"\x10next_page_offset\x18\x03 \x01(\x05R\x0enextPageOffset\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\x12#\n" +
+	"\rtotal_results\x18\x05 \x01(\x05R\ftotalResults\"\xd7\x04\n" +
+	"\x05World\x129\n" +
+	"\n" +
+	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x05 \x01(\tR\tcreatorId\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1b\n" +
+	"\timage_url\x18\t \x01(\tR\bimageUrl\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\n" +
+	" \x01(\tR\n" +
+	"difficulty\x12!\n" +
+	"\fpreview_urls\x18\v \x03(\tR\vpreviewUrls\x12O\n" +
+	"\x13default_game_config\x18\f \x01(\v2\x1f.lilbattle.v1.GameConfigurationR\x11defaultGameConfig\x12C\n" +
+	"\x11search_index_info\x18\r \x01(\v2\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\x12&\n" +
+	"\x0fparent_world_id\x18\x0e \x01(\tR\rparentWorldId\x12'\n" +
+	"\x0fparent_revision\x18\x0f \x01(\x03R\x0eparentRevision\"\xdb\x04\n" +
+	"\tWorldData\x12B\n" +
+	"\ttiles_map\x18\x01 \x03(\v2%.lilbattle.v1.WorldData.TilesMapEntryR\btilesMap\x12B\n" +
+	"\tunits_map\x18\x02 \x03(\v2%.lilbattle.v1.WorldData.UnitsMapEntryR\bunitsMap\x12K\n" +
+	"\x15screenshot_index_info\x18\x03 \x01(\v2\x17.lilbattle.v1.IndexInfoR\x13screenshotIndexInfo\x12!\n" +
+	"\fcontent_hash\x18\x04 \x01(\tR\vcontentHash\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\x12D\n" +
+	"\tcrossings\x18\b \x03(\v2&.lilbattle.v1.WorldData.CrossingsEntryR\tcrossings\x1aO\n" +
+	"\rTilesMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.lilbattle.v1.TileR\x05value:\x028\x01\x1aO\n" +
+	"\rUnitsMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.lilbattle.v1.UnitR\x05value:\x028\x01\x1aT\n" +
+	"\x0eCrossingsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.lilbattle.v1.CrossingR\x05value:\x028\x01\"[\n" +
+	"\bCrossing\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.lilbattle.v1.CrossingTypeR\x04type\x12\x1f\n" +
+	"\vconnects_to\x18\x02 \x03(\bR\n" +
+	"connectsTo\"\xc9\x01\n" +
+	"\x04Tile\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
+	"\ttile_type\x18\x03 \x01(\x05R\btileType\x12\x16\n" +
+	"\x06player\x18\x04 \x01(\x05R\x06player\x12\x1a\n" +
+	"\bshortcut\x18\x05 \x01(\tR\bshortcut\x12&\n" +
+	"\x0flast_acted_turn\x18\x06 \x01(\x05R\rlastActedTurn\x12,\n" +
+	"\x12last_toppedup_turn\x18\a \x01(\x05R\x10lastToppedupTurn\"\xa5\x04\n" +
+	"\x04Unit\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\x05R\x06player\x12\x1b\n" +
+	"\tunit_type\x18\x04 \x01(\x05R\bunitType\x12\x1a\n" +
+	"\bshortcut\x18\x05 \x01(\tR\bshortcut\x12)\n" +
+	"\x10available_health\x18\x06 \x01(\x05R\x0favailableHealth\x12#\n" +
+	"\rdistance_left\x18\a \x01(\x01R\fdistanceLeft\x12&\n" +
+	"\x0flast_acted_turn\x18\b \x01(\x05R\rlastActedTurn\x12,\n" +
+	"\x12last_toppedup_turn\x18\t \x01(\x05R\x10lastToppedupTurn\x12;\n" +
+	"\x1aattacks_received_this_turn\x18\n" +
+	" \x01(\x05R\x17attacksReceivedThisTurn\x12A\n" +
+	"\x0eattack_history\x18\v \x03(\v2\x1a.lilbattle.v1.AttackRecordR\rattackHistory\x12)\n" +
+	"\x10progression_step\x18\f \x01(\x05R\x0fprogressionStep\x12-\n" +
+	"\x12chosen_alternative\x18\r \x01(\tR\x11chosenAlternative\x120\n" +
+	"\x14capture_started_turn\x18\x0e \x01(\x05R\x12captureStartedTurn\"h\n" +
+	"\fAttackRecord\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
+	"\tis_ranged\x18\x03 \x01(\bR\bisRanged\x12\x1f\n" +
+	"\vturn_number\x18\x04 \x01(\x05R\n" +
+	"turnNumber\"\x89\x03\n" +
+	"\x11TerrainDefinition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\x05R\x04type\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\\\n" +
+	"\x0funit_properties\x18\a \x03(\v23.lilbattle.v1.TerrainDefinition.UnitPropertiesEntryR\x0eunitProperties\x12,\n" +
+	"\x12buildable_unit_ids\x18\b \x03(\x05R\x10buildableUnitIds\x12&\n" +
+	"\x0fincome_per_turn\x18\t \x01(\x05R\rincomePerTurn\x1af\n" +
+	"\x13UnitPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x129\n" +
+	"\x05value\x18\x02 \x01(\v2#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x028\x01\"\x82\b\n" +
+	"\x0eUnitDefinition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06health\x18\x04 \x01(\x05R\x06health\x12\x14\n" +
+	"\x05coins\x18\x05 \x01(\x05R\x05coins\x12'\n" +
+	"\x0fmovement_points\x18\x06 \x01(\x01R\x0emovementPoints\x12%\n" +
+	"\x0eretreat_points\x18\a \x01(\x01R\rretreatPoints\x12\x18\n" +
+	"\adefense\x18\b \x01(\x05R\adefense\x12!\n" +
+	"\fattack_range\x18\t \x01(\x05R\vattackRange\x12(\n" +
+	"\x10min_attack_range\x18\n" +
+	" \x01(\x05R\x0eminAttackRange\x12#\n" +
+	"\rsplash_damage\x18\v \x01(\x05R\fsplashDamage\x12b\n" +
+	"\x12terrain_properties\x18\f \x03(\v23.lilbattle.v1.UnitDefinition.TerrainPropertiesEntryR\x11terrainProperties\x12\x1e\n" +
+	"\n" +
+	"properties\x18\r \x03(\tR\n" +
+	"properties\x12\x1d\n" +
+	"\n" +
+	"unit_class\x18\x0e \x01(\tR\tunitClass\x12!\n" +
+	"\funit_terrain\x18\x0f \x01(\tR\vunitTerrain\x12W\n" +
+	"\x0fattack_vs_class\x18\x10 \x03(\v2/.lilbattle.v1.UnitDefinition.AttackVsClassEntryR\rattackVsClass\x12!\n" +
+	"\faction_order\x18\x11 \x03(\tR\vactionOrder\x12S\n" +
+	"\raction_limits\x18\x12 \x03(\v2..lilbattle.v1.UnitDefinition.ActionLimitsEntryR\factionLimits\x12\x1b\n" +
+	"\tfix_value\x18\x13 \x01(\x05R\bfixValue\x1ai\n" +
+	"\x16TerrainPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x129\n" +
+	"\x05value\x18\x02 \x01(\v2#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x028\x01\x1a@\n" +
+	"\x12AttackVsClassEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a?\n" +
+	"\x11ActionLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xec\x02\n" +
+	"\x15TerrainUnitProperties\x12\x1d\n" +
+	"\n" +
+	"terrain_id\x18\x01 \x01(\x05R\tterrainId\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\x05R\x06unitId\x12#\n" +
+	"\rmovement_cost\x18\x03 \x01(\x01R\fmovementCost\x12#\n" +
+	"\rhealing_bonus\x18\x04 \x01(\x05R\fhealingBonus\x12\x1b\n" +
+	"\tcan_build\x18\x05 \x01(\bR\bcanBuild\x12\x1f\n" +
+	"\vcan_capture\x18\x06 \x01(\bR\n" +
 	"canCapture\x12!\n" +
 	"\fattack_bonus\x18\a \x01(\x05R\vattackBonus\x12#\n" +
 	"\rdefense_bonus\x18\b \x01(\x05R\fdefenseBonus\x12!\n" +
@@ -4790,7 +5647,7 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2 .lilbattle.v1.UnitUnitPropertiesR\x05value:\x028\x01\x1aZ\n" +
 	"\x11TerrainTypesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\x0e2\x19.lilbattle.v1.TerrainTypeR\x05value:\x028\x01\"\x88\x04\n" +
+	"\x05value\x18\x02 \x01(\x0e2\x19.lilbattle.v1.TerrainTypeR\x05value:\x028\x01\"\xd4\x04\n" +
 	"\x04Game\x129\n" +
 	"\n" +
 	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
@@ -4811,7 +5668,9 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"difficulty\x127\n" +
 	"\x06config\x18\f \x01(\v2\x1f.lilbattle.v1.GameConfigurationR\x06config\x12!\n" +
 	"\fpreview_urls\x18\r \x03(\tR\vpreviewUrls\x12C\n" +
-	"\x11search_index_info\x18\x0f \x01(\v2\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\"\xf0\x01\n" +
+	"\x11search_index_info\x18\x0f \x01(\v2\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\x12%\n" +
+	"\x0eworld_revision\x18\x10 \x01(\x03R\rworldRevision\x12#\n" +
+	"\rrules_version\x18\x11 \x01(\tR\frulesVersion\"\xf0\x01\n" +
 	"\x11GameConfiguration\x122\n" +
 	"\aplayers\x18\x01 \x03(\v2\x18.lilbattle.v1.GamePlayerR\aplayers\x12,\n" +
 	"\x05teams\x18\x02 \x03(\v2\x16.lilbattle.v1.GameTeamR\x05teams\x12A\n" +
@@ -4841,15 +5700,20 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05color\x18\x03 \x01(\tR\x05color\x12\x1b\n" +
-	"\tis_active\x18\x04 \x01(\bR\bisActive\"\x95\x01\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\"\xce\x02\n" +
 	"\fGameSettings\x12#\n" +
 	"\rallowed_units\x18\x01 \x03(\x05R\fallowedUnits\x12&\n" +
 	"\x0fturn_time_limit\x18\x02 \x01(\x05R\rturnTimeLimit\x12\x1b\n" +
 	"\tteam_mode\x18\x03 \x01(\tR\bteamMode\x12\x1b\n" +
-	"\tmax_turns\x18\x04 \x01(\x05R\bmaxTurns\"@\n" +
+	"\tmax_turns\x18\x04 \x01(\x05R\bmaxTurns\x12\x1b\n" +
+	"\tturn_mode\x18\x05 \x01(\tR\bturnMode\x12!\n" +
+	"\fshared_coins\x18\x06 \x01(\bR\vsharedCoins\x12%\n" +
+	"\x0eshared_control\x18\a \x01(\bR\rsharedControl\x12%\n" +
+	"\x0eallied_support\x18\b \x01(\bR\ralliedSupport\x12)\n" +
+	"\x10combined_victory\x18\t \x01(\bR\x0fcombinedVictory\"@\n" +
 	"\vPlayerState\x12\x14\n" +
 	"\x05coins\x18\x01 \x01(\x05R\x05coins\x12\x1b\n" +
-	"\tis_active\x18\x02 \x01(\bR\bisActive\"\x90\x05\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\"\xc1\x06\n" +
 	"\tGameState\x129\n" +
 	"\n" +
 	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
@@ -4867,10 +5731,14 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x0ewinning_player\x18\f \x01(\x05R\rwinningPlayer\x12!\n" +
 	"\fwinning_team\x18\r \x01(\x05R\vwinningTeam\x120\n" +
 	"\x14current_group_number\x18\x0e \x01(\x03R\x12currentGroupNumber\x12N\n" +
-	"\rplayer_states\x18\x0f \x03(\v2).lilbattle.v1.GameState.PlayerStatesEntryR\fplayerStates\x1aZ\n" +
+	"\rplayer_states\x18\x0f \x03(\v2).lilbattle.v1.GameState.PlayerStatesEntryR\fplayerStates\x12Q\n" +
+	"\x0epending_orders\x18\x10 \x03(\v2*.lilbattle.v1.GameState.PendingOrdersEntryR\rpendingOrders\x1aZ\n" +
 	"\x11PlayerStatesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.lilbattle.v1.PlayerStateR\x05value:\x028\x01\"_\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.lilbattle.v1.PlayerStateR\x05value:\x028\x01\x1a\\\n" +
+	"\x12PendingOrdersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.lilbattle.v1.PlayerOrdersR\x05value:\x028\x01\"_\n" +
 	"\x0fGameMoveHistory\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x123\n" +
 	"\x06groups\x18\x02 \x03(\v2\x1b.lilbattle.v1.GameMoveGroupR\x06groups\"\xd2\x01\n" +
@@ -5033,7 +5901,66 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"directions\x18\x02 \x03(\x0e2\x1b.lilbattle.v1.PathDirectionR\n" +
 	"directions\x12\x1d\n" +
 	"\n" +
-	"total_cost\x18\x03 \x01(\x01R\ttotalCost*_\n" +
+	"total_cost\x18\x03 \x01(\x01R\ttotalCost\"\xe8\x01\n" +
+	"\fPlayerOrders\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x02 \x01(\tR\n" +
+	"commitment\x12=\n" +
+	"\fcommitted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcommittedAt\x12\x1a\n" +
+	"\brevealed\x18\x04 \x01(\bR\brevealed\x12,\n" +
+	"\x05moves\x18\x05 \x03(\v2\x16.lilbattle.v1.GameMoveR\x05moves\x12\x12\n" +
+	"\x04salt\x18\x06 \x01(\tR\x04salt\"k\n" +
+	"\tTimeRange\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\xe8\x02\n" +
+	"\bUserGame\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1d\n" +
+	"\n" +
+	"player_ids\x18\x03 \x03(\x05R\tplayerIds\x12\x1b\n" +
+	"\tgame_name\x18\x04 \x01(\tR\bgameName\x12\x19\n" +
+	"\bworld_id\x18\x05 \x01(\tR\aworldId\x120\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x18.lilbattle.v1.GameStatusR\x06status\x12%\n" +
+	"\x0ecurrent_player\x18\a \x01(\x05R\rcurrentPlayer\x12!\n" +
+	"\fturn_counter\x18\b \x01(\x05R\vturnCounter\x12\x1c\n" +
+	"\n" +
+	"is_my_turn\x18\t \x01(\bR\bisMyTurn\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"<\n" +
+	"\fUserGameList\x12,\n" +
+	"\x05games\x18\x01 \x03(\v2\x16.lilbattle.v1.UserGameR\x05games\"\xdc\x02\n" +
+	"\rWorldRevision\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12#\n" +
+	"\rreverted_from\x18\x05 \x01(\x03R\frevertedFrom\x12!\n" +
+	"\fcontent_hash\x18\x06 \x01(\tR\vcontentHash\x12\x1d\n" +
+	"\n" +
+	"tile_count\x18\a \x01(\x05R\ttileCount\x12\x1d\n" +
+	"\n" +
+	"unit_count\x18\b \x01(\x05R\tunitCount\x126\n" +
+	"\n" +
+	"world_data\x18\t \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData\"l\n" +
+	"\x0fWorldCellChange\x12\x14\n" +
+	"\x05layer\x18\x01 \x01(\tR\x05layer\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x121\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1d.lilbattle.v1.WorldChangeKindR\x04kind\"\xa6\x01\n" +
+	"\x12WorldMergeConflict\x12\x14\n" +
+	"\x05layer\x18\x01 \x01(\tR\x05layer\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x121\n" +
+	"\x04ours\x18\x03 \x01(\x0e2\x1d.lilbattle.v1.WorldChangeKindR\x04ours\x125\n" +
+	"\x06theirs\x18\x04 \x01(\x0e2\x1d.lilbattle.v1.WorldChangeKindR\x06theirs\"\xbe\x01\n" +
+	"\x10WorldSearchEntry\x12)\n" +
+	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x12\x18\n" +
+	"\aplayers\x18\x02 \x01(\x05R\aplayers\x12\x14\n" +
+	"\x05tiles\x18\x03 \x01(\x05R\x05tiles\x12\x14\n" +
+	"\x05games\x18\x04 \x01(\x05R\x05games\x129\n" +
+	"\n" +
+	"indexed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tindexedAt*_\n" +
 	"\fCrossingType\x12\x1d\n" +
 	"\x19CROSSING_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12CROSSING_TYPE_ROAD\x10\x01\x12\x18\n" +
@@ -5058,7 +5985,16 @@ const file_lilbattle_v1_models_models_proto_rawDesc = "" +
 	"\x18PATH_DIRECTION_TOP_RIGHT\x10\x03\x12\x18\n" +
 	"\x14PATH_DIRECTION_RIGHT\x10\x04\x12\x1f\n" +
 	"\x1bPATH_DIRECTION_BOTTOM_RIGHT\x10\x05\x12\x1e\n" +
-	"\x1aPATH_DIRECTION_BOTTOM_LEFT\x10\x06B\xb7\x01\n" +
+	"\x1aPATH_DIRECTION_BOTTOM_LEFT\x10\x06*\x90\x01\n" +
+	"\x0fWorldChangeKind\x12!\n" +
+	"\x1dWORLD_CHANGE_KIND_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17WORLD_CHANGE_KIND_ADDED\x10\x01\x12\x1d\n" +
+	"\x19WORLD_CHANGE_KIND_REMOVED\x10\x02\x12\x1e\n" +
+	"\x1aWORLD_CHANGE_KIND_MODIFIED\x10\x03*z\n" +
+	"\x12WorldMergeStrategy\x12$\n" +
+	" WORLD_MERGE_STRATEGY_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19WORLD_MERGE_STRATEGY_OURS\x10\x01\x12\x1f\n" +
+	"\x1bWORLD_MERGE_STRATEGY_THEIRS\x10\x02B\xb7\x01\n" +
 	"\x10com.lilbattle.v1B\vModelsProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var (
@@ -5073,181 +6009,208 @@ func file_lilbattle_v1_models_models_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_models_models_proto_rawDescData
 }
 
-var file_lilbattle_v1_models_models_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_lilbattle_v1_models_models_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_lilbattle_v1_models_models_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_lilbattle_v1_models_models_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
 var file_lilbattle_v1_models_models_proto_goTypes = []any{
 	(CrossingType)(0),             // 0: lilbattle.v1.CrossingType
 	(TerrainType)(0),              // 1: lilbattle.v1.TerrainType
 	(GameStatus)(0),               // 2: lilbattle.v1.GameStatus
 	(PathDirection)(0),            // 3: lilbattle.v1.PathDirection
-	(*IndexInfo)(nil),             // 4: lilbattle.v1.IndexInfo
-	(*Pagination)(nil),            // 5: lilbattle.v1.Pagination
-	(*PaginationResponse)(nil),    // 6: lilbattle.v1.PaginationResponse
-	(*World)(nil),                 // 7: lilbattle.v1.World
-	(*WorldData)(nil),             // 8: lilbattle.v1.WorldData
-	(*Crossing)(nil),              // 9: lilbattle.v1.Crossing
-	(*Tile)(nil),                  // 10: lilbattle.v1.Tile
-	(*Unit)(nil),                  // 11: lilbattle.v1.Unit
-	(*AttackRecord)(nil),          // 12: lilbattle.v1.AttackRecord
-	(*TerrainDefinition)(nil),     // 13: lilbattle.v1.TerrainDefinition
-	(*UnitDefinition)(nil),        // 14: lilbattle.v1.UnitDefinition
-	(*TerrainUnitProperties)(nil), // 15: lilbattle.v1.TerrainUnitProperties
-	(*UnitUnitProperties)(nil),    // 16: lilbattle.v1.UnitUnitProperties
-	(*DamageDistribution)(nil),    // 17: lilbattle.v1.DamageDistribution
-	(*DamageRange)(nil),           // 18: lilbattle.v1.DamageRange
-	(*RulesEngine)(nil),           // 19: lilbattle.v1.RulesEngine
-	(*Game)(nil),                  // 20: lilbattle.v1.Game
-	(*GameConfiguration)(nil),     // 21: lilbattle.v1.GameConfiguration
-	(*IncomeConfig)(nil),          // 22: lilbattle.v1.IncomeConfig
-	(*GamePlayer)(nil),            // 23: lilbattle.v1.GamePlayer
-	(*GameTeam)(nil),              // 24: lilbattle.v1.GameTeam
-	(*GameSettings)(nil),          // 25: lilbattle.v1.GameSettings
-	(*PlayerState)(nil),           // 26: lilbattle.v1.PlayerState
-	(*GameState)(nil),             // 27: lilbattle.v1.GameState
-	(*GameMoveHistory)(nil),       // 28: lilbattle.v1.GameMoveHistory
-	(*GameMoveGroup)(nil),         // 29: lilbattle.v1.GameMoveGroup
-	(*GameMove)(nil),              // 30: lilbattle.v1.GameMove
-	(*Position)(nil),              // 31: lilbattle.v1.Position
-	(*MoveUnitAction)(nil),        // 32: lilbattle.v1.MoveUnitAction
-	(*AttackUnitAction)(nil),      // 33: lilbattle.v1.AttackUnitAction
-	(*BuildUnitAction)(nil),       // 34: lilbattle.v1.BuildUnitAction
-	(*CaptureBuildingAction)(nil), // 35: lilbattle.v1.CaptureBuildingAction
-	(*EndTurnAction)(nil),         // 36: lilbattle.v1.EndTurnAction
-	(*HealUnitAction)(nil),        // 37: lilbattle.v1.HealUnitAction
-	(*FixUnitAction)(nil),         // 38: lilbattle.v1.FixUnitAction
-	(*WorldChange)(nil),           // 39: lilbattle.v1.WorldChange
-	(*UnitHealedChange)(nil),      // 40: lilbattle.v1.UnitHealedChange
-	(*UnitFixedChange)(nil),       // 41: lilbattle.v1.UnitFixedChange
-	(*UnitMovedChange)(nil),       // 42: lilbattle.v1.UnitMovedChange
-	(*UnitDamagedChange)(nil),     // 43: lilbattle.v1.UnitDamagedChange
-	(*UnitKilledChange)(nil),      // 44: lilbattle.v1.UnitKilledChange
-	(*PlayerChangedChange)(nil),   // 45: lilbattle.v1.PlayerChangedChange
-	(*UnitBuiltChange)(nil),       // 46: lilbattle.v1.UnitBuiltChange
-	(*CoinsChangedChange)(nil),    // 47: lilbattle.v1.CoinsChangedChange
-	(*TileCapturedChange)(nil),    // 48: lilbattle.v1.TileCapturedChange
-	(*CaptureStartedChange)(nil),  // 49: lilbattle.v1.CaptureStartedChange
-	(*AllPaths)(nil),              // 50: lilbattle.v1.AllPaths
-	(*PathEdge)(nil),              // 51: lilbattle.v1.PathEdge
-	(*Path)(nil),                  // 52: lilbattle.v1.Path
-	nil,                           // 53: lilbattle.v1.WorldData.TilesMapEntry
-	nil,                           // 54: lilbattle.v1.WorldData.UnitsMapEntry
-	nil,                           // 55: lilbattle.v1.WorldData.CrossingsEntry
-	nil,                           // 56: lilbattle.v1.TerrainDefinition.UnitPropertiesEntry
-	nil,                           // 57: lilbattle.v1.UnitDefinition.TerrainPropertiesEntry
-	nil,                           // 58: lilbattle.v1.UnitDefinition.AttackVsClassEntry
-	nil,                           // 59: lilbattle.v1.UnitDefinition.ActionLimitsEntry
-	nil,                           // 60: lilbattle.v1.RulesEngine.UnitsEntry
-	nil,                           // 61: lilbattle.v1.RulesEngine.TerrainsEntry
-	nil,                           // 62: lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry
-	nil,                           // 63: lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry
-	nil,                           // 64: lilbattle.v1.RulesEngine.TerrainTypesEntry
-	nil,                           // 65: lilbattle.v1.GameState.PlayerStatesEntry
-	nil,                           // 66: lilbattle.v1.AllPaths.EdgesEntry
-	(*timestamppb.Timestamp)(nil), // 67: google.protobuf.Timestamp
+	(WorldChangeKind)(0),          // 4: lilbattle.v1.WorldChangeKind
+	(WorldMergeStrategy)(0),       // 5: lilbattle.v1.WorldMergeStrategy
+	(*IndexInfo)(nil),             // 6: lilbattle.v1.IndexInfo
+	(*Pagination)(nil),            // 7: lilbattle.v1.Pagination
+	(*PaginationResponse)(nil),    // 8: lilbattle.v1.PaginationResponse
+	(*World)(nil),                 // 9: lilbattle.v1.World
+	(*WorldData)(nil),             // 10: lilbattle.v1.WorldData
+	(*Crossing)(nil),              // 11: lilbattle.v1.Crossing
+	(*Tile)(nil),                  // 12: lilbattle.v1.Tile
+	(*Unit)(nil),                  // 13: lilbattle.v1.Unit
+	(*AttackRecord)(nil),          // 14: lilbattle.v1.AttackRecord
+	(*TerrainDefinition)(nil),     // 15: lilbattle.v1.TerrainDefinition
+	(*UnitDefinition)(nil),        // 16: lilbattle.v1.UnitDefinition
+	(*TerrainUnitProperties)(nil), // 17: lilbattle.v1.TerrainUnitProperties
+	(*UnitUnitProperties)(nil),    // 18: lilbattle.v1.UnitUnitProperties
+	(*DamageDistribution)(nil),    // 19: lilbattle.v1.DamageDistribution
+	(*DamageRange)(nil),           // 20: lilbattle.v1.DamageRange
+	(*RulesEngine)(nil),           // 21: lilbattle.v1.RulesEngine
+	(*Game)(nil),                  // 22: lilbattle.v1.Game
+	(*GameConfiguration)(nil),     // 23: lilbattle.v1.GameConfiguration
+	(*IncomeConfig)(nil),          // 24: lilbattle.v1.IncomeConfig
+	(*GamePlayer)(nil),            // 25: lilbattle.v1.GamePlayer
+	(*GameTeam)(nil),              // 26: lilbattle.v1.GameTeam
+	(*GameSettings)(nil),          // 27: lilbattle.v1.GameSettings
+	(*PlayerState)(nil),           // 28: lilbattle.v1.PlayerState
+	(*GameState)(nil),             // 29: lilbattle.v1.GameState
+	(*GameMoveHistory)(nil),       // 30: lilbattle.v1.GameMoveHistory
+	(*GameMoveGroup)(nil),         // 31: lilbattle.v1.GameMoveGroup
+	(*GameMove)(nil),              // 32: lilbattle.v1.GameMove
+	(*Position)(nil),              // 33: lilbattle.v1.Position
+	(*MoveUnitAction)(nil),        // 34: lilbattle.v1.MoveUnitAction
+	(*AttackUnitAction)(nil),      // 35: lilbattle.v1.AttackUnitAction
+	(*BuildUnitAction)(nil),       // 36: lilbattle.v1.BuildUnitAction
+	(*CaptureBuildingAction)(nil), // 37: lilbattle.v1.CaptureBuildingAction
+	(*EndTurnAction)(nil),         // 38: lilbattle.v1.EndTurnAction
+	(*HealUnitAction)(nil),        // 39: lilbattle.v1.HealUnitAction
+	(*FixUnitAction)(nil),         // 40: lilbattle.v1.FixUnitAction
+	(*WorldChange)(nil),           // 41: lilbattle.v1.WorldChange
+	(*UnitHealedChange)(nil),      // 42: lilbattle.v1.UnitHealedChange
+	(*UnitFixedChange)(nil),       // 43: lilbattle.v1.UnitFixedChange
+	(*UnitMovedChange)(nil),       // 44: lilbattle.v1.UnitMovedChange
+	(*UnitDamagedChange)(nil),     // 45: lilbattle.v1.UnitDamagedChange
+	(*UnitKilledChange)(nil),      // 46: lilbattle.v1.UnitKilledChange
+	(*PlayerChangedChange)(nil),   // 47: lilbattle.v1.PlayerChangedChange
+	(*UnitBuiltChange)(nil),       // 48: lilbattle.v1.UnitBuiltChange
+	(*CoinsChangedChange)(nil),    // 49: lilbattle.v1.CoinsChangedChange
+	(*TileCapturedChange)(nil),    // 50: lilbattle.v1.TileCapturedChange
+	(*CaptureStartedChange)(nil),  // 51: lilbattle.v1.CaptureStartedChange
+	(*AllPaths)(nil),              // 52: lilbattle.v1.AllPaths
+	(*PathEdge)(nil),              // 53: lilbattle.v1.PathEdge
+	(*Path)(nil),                  // 54: lilbattle.v1.Path
+	(*PlayerOrders)(nil),          // 55: lilbattle.v1.PlayerOrders
+	(*TimeRange)(nil),             // 56: lilbattle.v1.TimeRange
+	(*UserGame)(nil),              // 57: lilbattle.v1.UserGame
+	(*UserGameList)(nil),          // 58: lilbattle.v1.UserGameList
+	(*WorldRevision)(nil),         // 59: lilbattle.v1.WorldRevision
+	(*WorldCellChange)(nil),       // 60: lilbattle.v1.WorldCellChange
+	(*WorldMergeConflict)(nil),    // 61: lilbattle.v1.WorldMergeConflict
+	(*WorldSearchEntry)(nil),      // 62: lilbattle.v1.WorldSearchEntry
+	nil,                           // 63: lilbattle.v1.WorldData.TilesMapEntry
+	nil,                           // 64: lilbattle.v1.WorldData.UnitsMapEntry
+	nil,                           // 65: lilbattle.v1.WorldData.CrossingsEntry
+	nil,                           // 66: lilbattle.v1.TerrainDefinition.UnitPropertiesEntry
+	nil,                           // 67: lilbattle.v1.UnitDefinition.TerrainPropertiesEntry
+	nil,                           // 68: lilbattle.v1.UnitDefinition.AttackVsClassEntry
+	nil,                           // 69: lilbattle.v1.UnitDefinition.ActionLimitsEntry
+	nil,                           // 70: lilbattle.v1.RulesEngine.UnitsEntry
+	nil,                           // 71: lilbattle.v1.RulesEngine.TerrainsEntry
+	nil,                           // 72: lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry
+	nil,                           // 73: lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry
+	nil,                           // 74: lilbattle.v1.RulesEngine.TerrainTypesEntry
+	nil,                           // 75: lilbattle.v1.GameState.PlayerStatesEntry
+	nil,                           // 76: lilbattle.v1.GameState.PendingOrdersEntry
+	nil,                           // 77: lilbattle.v1.AllPaths.EdgesEntry
+	(*timestamppb.Timestamp)(nil), // 78: google.protobuf.Timestamp
 }
 var file_lilbattle_v1_models_models_proto_depIdxs = []int32{
-	67, // 0: lilbattle.v1.IndexInfo.last_updated_at:type_name -> google.protobuf.Timestamp
-	67, // 1: lilbattle.v1.IndexInfo.last_indexed_at:type_name -> google.protobuf.Timestamp
-	67, // 2: lilbattle.v1.World.created_at:type_name -> google.protobuf.Timestamp
-	67, // 3: lilbattle.v1.World.updated_at:type_name -> google.protobuf.Timestamp
-	21, // 4: lilbattle.v1.World.default_game_config:type_name -> lilbattle.v1.GameConfiguration
-	4,  // 5: lilbattle.v1.World.search_index_info:type_name -> lilbattle.v1.IndexInfo
-	53, // 6: lilbattle.v1.WorldData.tiles_map:type_name -> lilbattle.v1.WorldData.TilesMapEntry
-	54, // 7: lilbattle.v1.WorldData.units_map:type_name -> lilbattle.v1.WorldData.UnitsMapEntry
-	4,  // 8: lilbattle.v1.WorldData.screenshot_index_info:type_name -> lilbattle.v1.IndexInfo
-	55, // 9: lilbattle.v1.WorldData.crossings:type_name -> lilbattle.v1.WorldData.CrossingsEntry
-	0,  // 10: lilbattle.v1.Crossing.type:type_name -> lilbattle.v1.CrossingType
-	12, // 11: lilbattle.v1.Unit.attack_history:type_name -> lilbattle.v1.AttackRecord
-	56, // 12: lilbattle.v1.TerrainDefinition.unit_properties:type_name -> lilbattle.v1.TerrainDefinition.UnitPropertiesEntry
-	57, // 13: lilbattle.v1.UnitDefinition.terrain_properties:type_name -> lilbattle.v1.UnitDefinition.TerrainPropertiesEntry
-	58, // 14: lilbattle.v1.UnitDefinition.attack_vs_class:type_name -> lilbattle.v1.UnitDefinition.AttackVsClassEntry
-	59, // 15: lilbattle.v1.UnitDefinition.action_limits:type_name -> lilbattle.v1.UnitDefinition.ActionLimitsEntry
-	17, // 16: lilbattle.v1.UnitUnitProperties.damage:type_name -> lilbattle.v1.DamageDistribution
-	18, // 17: lilbattle.v1.DamageDistribution.ranges:type_name -> lilbattle.v1.DamageRange
-	60, // 18: lilbattle.v1.RulesEngine.units:type_name -> lilbattle.v1.RulesEngine.UnitsEntry
-	61, // 19: lilbattle.v1.RulesEngine.terrains:type_name -> lilbattle.v1.RulesEngine.TerrainsEntry
-	62, // 20: lilbattle.v1.RulesEngine.terrain_unit_properties:type_name -> lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry
-	63, // 21: lilbattle.v1.RulesEngine.unit_unit_properties:type_name -> lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry
-	64, // 22: lilbattle.v1.RulesEngine.terrain_types:type_name -> lilbattle.v1.RulesEngine.TerrainTypesEntry
-	67, // 23: lilbattle.v1.Game.created_at:type_name -> google.protobuf.Timestamp
-	67, // 24: lilbattle.v1.Game.updated_at:type_name -> google.protobuf.Timestamp
-	21, // 25: lilbattle.v1.Game.config:type_name -> lilbattle.v1.GameConfiguration
-	4,  // 26: lilbattle.v1.Game.search_index_info:type_name -> lilbattle.v1.IndexInfo
-	23, // 27: lilbattle.v1.GameConfiguration.players:type_name -> lilbattle.v1.GamePlayer
-	24, // 28: lilbattle.v1.GameConfiguration.teams:type_name -> lilbattle.v1.GameTeam
-	22, // 29: lilbattle.v1.GameConfiguration.income_configs:type_name -> lilbattle.v1.IncomeConfig
-	25, // 30: lilbattle.v1.GameConfiguration.settings:type_name -> lilbattle.v1.GameSettings
-	67, // 31: lilbattle.v1.GameState.updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 32: lilbattle.v1.GameState.world_data:type_name -> lilbattle.v1.WorldData
-	2,  // 33: lilbattle.v1.GameState.status:type_name -> lilbattle.v1.GameStatus
-	65, // 34: lilbattle.v1.GameState.player_states:type_name -> lilbattle.v1.GameState.PlayerStatesEntry
-	29, // 35: lilbattle.v1.GameMoveHistory.groups:type_name -> lilbattle.v1.GameMoveGroup
-	67, // 36: lilbattle.v1.GameMoveGroup.started_at:type_name -> google.protobuf.Timestamp
-	67, // 37: lilbattle.v1.GameMoveGroup.ended_at:type_name -> google.protobuf.Timestamp
-	30, // 38: lilbattle.v1.GameMoveGroup.moves:type_name -> lilbattle.v1.GameMove
-	67, // 39: lilbattle.v1.GameMove.timestamp:type_name -> google.protobuf.Timestamp
-	32, // 40: lilbattle.v1.GameMove.move_unit:type_name -> lilbattle.v1.MoveUnitAction
-	33, // 41: lilbattle.v1.GameMove.attack_unit:type_name -> lilbattle.v1.AttackUnitAction
-	36, // 42: lilbattle.v1.GameMove.end_turn:type_name -> lilbattle.v1.EndTurnAction
-	34, // 43: lilbattle.v1.GameMove.build_unit:type_name -> lilbattle.v1.BuildUnitAction
-	35, // 44: lilbattle.v1.GameMove.capture_building:type_name -> lilbattle.v1.CaptureBuildingAction
-	37, // 45: lilbattle.v1.GameMove.heal_unit:type_name -> lilbattle.v1.HealUnitAction
-	38, // 46: lilbattle.v1.GameMove.fix_unit:type_name -> lilbattle.v1.FixUnitAction
-	39, // 47: lilbattle.v1.GameMove.changes:type_name -> lilbattle.v1.WorldChange
-	31, // 48: lilbattle.v1.MoveUnitAction.from:type_name -> lilbattle.v1.Position
-	31, // 49: lilbattle.v1.MoveUnitAction.to:type_name -> lilbattle.v1.Position
-	52, // 50: lilbattle.v1.MoveUnitAction.reconstructed_path:type_name -> lilbattle.v1.Path
-	31, // 51: lilbattle.v1.AttackUnitAction.attacker:type_name -> lilbattle.v1.Position
-	31, // 52: lilbattle.v1.AttackUnitAction.defender:type_name -> lilbattle.v1.Position
-	31, // 53: lilbattle.v1.BuildUnitAction.pos:type_name -> lilbattle.v1.Position
-	31, // 54: lilbattle.v1.CaptureBuildingAction.pos:type_name -> lilbattle.v1.Position
-	31, // 55: lilbattle.v1.HealUnitAction.pos:type_name -> lilbattle.v1.Position
-	31, // 56: lilbattle.v1.FixUnitAction.fixer:type_name -> lilbattle.v1.Position
-	31, // 57: lilbattle.v1.FixUnitAction.target:type_name -> lilbattle.v1.Position
-	42, // 58: lilbattle.v1.WorldChange.unit_moved:type_name -> lilbattle.v1.UnitMovedChange
-	43, // 59: lilbattle.v1.WorldChange.unit_damaged:type_name -> lilbattle.v1.UnitDamagedChange
-	44, // 60: lilbattle.v1.WorldChange.unit_killed:type_name -> lilbattle.v1.UnitKilledChange
-	45, // 61: lilbattle.v1.WorldChange.player_changed:type_name -> lilbattle.v1.PlayerChangedChange
-	46, // 62: lilbattle.v1.WorldChange.unit_built:type_name -> lilbattle.v1.UnitBuiltChange
-	47, // 63: lilbattle.v1.WorldChange.coins_changed:type_name -> lilbattle.v1.CoinsChangedChange
-	48, // 64: lilbattle.v1.WorldChange.tile_captured:type_name -> lilbattle.v1.TileCapturedChange
-	49, // 65: lilbattle.v1.WorldChange.capture_started:type_name -> lilbattle.v1.CaptureStartedChange
-	40, // 66: lilbattle.v1.WorldChange.unit_healed:type_name -> lilbattle.v1.UnitHealedChange
-	41, // 67: lilbattle.v1.WorldChange.unit_fixed:type_name -> lilbattle.v1.UnitFixedChange
-	11, // 68: lilbattle.v1.UnitHealedChange.previous_unit:type_name -> lilbattle.v1.Unit
-	11, // 69: lilbattle.v1.UnitHealedChange.updated_unit:type_name -> lilbattle.v1.Unit
-	11, // 70: lilbattle.v1.UnitFixedChange.fixer_unit:type_name -> lilbattle.v1.Unit
-	11, // 71: lilbattle.v1.UnitFixedChange.previous_target:type_name -> lilbattle.v1.Unit
-	11, // 72: lilbattle.v1.UnitFixedChange.updated_target:type_name -> lilbattle.v1.Unit
-	11, // 73: lilbattle.v1.UnitMovedChange.previous_unit:type_name -> lilbattle.v1.Unit
-	11, // 74: lilbattle.v1.UnitMovedChange.updated_unit:type_name -> lilbattle.v1.Unit
-	11, // 75: lilbattle.v1.UnitDamagedChange.previous_unit:type_name -> lilbattle.v1.Unit
-	11, // 76: lilbattle.v1.UnitDamagedChange.updated_unit:type_name -> lilbattle.v1.Unit
-	11, // 77: lilbattle.v1.UnitKilledChange.previous_unit:type_name -> lilbattle.v1.Unit
-	11, // 78: lilbattle.v1.PlayerChangedChange.reset_units:type_name -> lilbattle.v1.Unit
-	11, // 79: lilbattle.v1.UnitBuiltChange.unit:type_name -> lilbattle.v1.Unit
-	11, // 80: lilbattle.v1.TileCapturedChange.capturing_unit:type_name -> lilbattle.v1.Unit
-	11, // 81: lilbattle.v1.CaptureStartedChange.capturing_unit:type_name -> lilbattle.v1.Unit
-	66, // 82: lilbattle.v1.AllPaths.edges:type_name -> lilbattle.v1.AllPaths.EdgesEntry
-	51, // 83: lilbattle.v1.Path.edges:type_name -> lilbattle.v1.PathEdge
-	3,  // 84: lilbattle.v1.Path.directions:type_name -> lilbattle.v1.PathDirection
-	10, // 85: lilbattle.v1.WorldData.TilesMapEntry.value:type_name -> lilbattle.v1.Tile
-	11, // 86: lilbattle.v1.WorldData.UnitsMapEntry.value:type_name -> lilbattle.v1.Unit
-	9,  // 87: lilbattle.v1.WorldData.CrossingsEntry.value:type_name -> lilbattle.v1.Crossing
-	15, // 88: lilbattle.v1.TerrainDefinition.UnitPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
-	15, // 89: lilbattle.v1.UnitDefinition.TerrainPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
-	14, // 90: lilbattle.v1.RulesEngine.UnitsEntry.value:type_name -> lilbattle.v1.UnitDefinition
-	13, // 91: lilbattle.v1.RulesEngine.TerrainsEntry.value:type_name -> lilbattle.v1.TerrainDefinition
-	15, // 92: lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
-	16, // 93: lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry.value:type_name -> lilbattle.v1.UnitUnitProperties
-	1,  // 94: lilbattle.v1.RulesEngine.TerrainTypesEntry.value:type_name -> lilbattle.v1.TerrainType
-	26, // 95: lilbattle.v1.GameState.PlayerStatesEntry.value:type_name -> lilbattle.v1.PlayerState
-	51, // 96: lilbattle.v1.AllPaths.EdgesEntry.value:type_name -> lilbattle.v1.PathEdge
-	97, // [97:97] is the sub-list for method output_type
-	97, // [97:97] is the sub-list for method input_type
-	97, // [97:97] is the sub-list for extension type_name
-	97, // [97:97] is the sub-list for extension extendee
-	0,  // [0:97] is the sub-list for field type_name
+	78,  // 0: lilbattle.v1.IndexInfo.last_updated_at:type_name -> google.protobuf.Timestamp
+	78,  // 1: lilbattle.v1.IndexInfo.last_indexed_at:type_name -> google.protobuf.Timestamp
+	78,  // 2: lilbattle.v1.World.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 3: lilbattle.v1.World.updated_at:type_name -> google.protobuf.Timestamp
+	23,  // 4: lilbattle.v1.World.default_game_config:type_name -> lilbattle.v1.GameConfiguration
+	6,   // 5: lilbattle.v1.World.search_index_info:type_name -> lilbattle.v1.IndexInfo
+	63,  // 6: lilbattle.v1.WorldData.tiles_map:type_name -> lilbattle.v1.WorldData.TilesMapEntry
+	64,  // 7: lilbattle.v1.WorldData.units_map:type_name -> lilbattle.v1.WorldData.UnitsMapEntry
+	6,   // 8: lilbattle.v1.WorldData.screenshot_index_info:type_name -> lilbattle.v1.IndexInfo
+	65,  // 9: lilbattle.v1.WorldData.crossings:type_name -> lilbattle.v1.WorldData.CrossingsEntry
+	0,   // 10: lilbattle.v1.Crossing.type:type_name -> lilbattle.v1.CrossingType
+	14,  // 11: lilbattle.v1.Unit.attack_history:type_name -> lilbattle.v1.AttackRecord
+	66,  // 12: lilbattle.v1.TerrainDefinition.unit_properties:type_name -> lilbattle.v1.TerrainDefinition.UnitPropertiesEntry
+	67,  // 13: lilbattle.v1.UnitDefinition.terrain_properties:type_name -> lilbattle.v1.UnitDefinition.TerrainPropertiesEntry
+	68,  // 14: lilbattle.v1.UnitDefinition.attack_vs_class:type_name -> lilbattle.v1.UnitDefinition.AttackVsClassEntry
+	69,  // 15: lilbattle.v1.UnitDefinition.action_limits:type_name -> lilbattle.v1.UnitDefinition.ActionLimitsEntry
+	19,  // 16: lilbattle.v1.UnitUnitProperties.damage:type_name -> lilbattle.v1.DamageDistribution
+	20,  // 17: lilbattle.v1.DamageDistribution.ranges:type_name -> lilbattle.v1.DamageRange
+	70,  // 18: lilbattle.v1.RulesEngine.units:type_name -> lilbattle.v1.RulesEngine.UnitsEntry
+	71,  // 19: lilbattle.v1.RulesEngine.terrains:type_name -> lilbattle.v1.RulesEngine.TerrainsEntry
+	72,  // 20: lilbattle.v1.RulesEngine.terrain_unit_properties:type_name -> lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry
+	73,  // 21: lilbattle.v1.RulesEngine.unit_unit_properties:type_name -> lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry
+	74,  // 22: lilbattle.v1.RulesEngine.terrain_types:type_name -> lilbattle.v1.RulesEngine.TerrainTypesEntry
+	78,  // 23: lilbattle.v1.Game.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 24: lilbattle.v1.Game.updated_at:type_name -> google.protobuf.Timestamp
+	23,  // 25: lilbattle.v1.Game.config:type_name -> lilbattle.v1.GameConfiguration
+	6,   // 26: lilbattle.v1.Game.search_index_info:type_name -> lilbattle.v1.IndexInfo
+	25,  // 27: lilbattle.v1.GameConfiguration.players:type_name -> lilbattle.v1.GamePlayer
+	26,  // 28: lilbattle.v1.GameConfiguration.teams:type_name -> lilbattle.v1.GameTeam
+	24,  // 29: lilbattle.v1.GameConfiguration.income_configs:type_name -> lilbattle.v1.IncomeConfig
+	27,  // 30: lilbattle.v1.GameConfiguration.settings:type_name -> lilbattle.v1.GameSettings
+	78,  // 31: lilbattle.v1.GameState.updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 32: lilbattle.v1.GameState.world_data:type_name -> lilbattle.v1.WorldData
+	2,   // 33: lilbattle.v1.GameState.status:type_name -> lilbattle.v1.GameStatus
+	75,  // 34: lilbattle.v1.GameState.player_states:type_name -> lilbattle.v1.GameState.PlayerStatesEntry
+	76,  // 35: lilbattle.v1.GameState.pending_orders:type_name -> lilbattle.v1.GameState.PendingOrdersEntry
+	31,  // 36: lilbattle.v1.GameMoveHistory.groups:type_name -> lilbattle.v1.GameMoveGroup
+	78,  // 37: lilbattle.v1.GameMoveGroup.started_at:type_name -> google.protobuf.Timestamp
+	78,  // 38: lilbattle.v1.GameMoveGroup.ended_at:type_name -> google.protobuf.Timestamp
+	32,  // 39: lilbattle.v1.GameMoveGroup.moves:type_name -> lilbattle.v1.GameMove
+	78,  // 40: lilbattle.v1.GameMove.timestamp:type_name -> google.protobuf.Timestamp
+	34,  // 41: lilbattle.v1.GameMove.move_unit:type_name -> lilbattle.v1.MoveUnitAction
+	35,  // 42: lilbattle.v1.GameMove.attack_unit:type_name -> lilbattle.v1.AttackUnitAction
+	38,  // 43: lilbattle.v1.GameMove.end_turn:type_name -> lilbattle.v1.EndTurnAction
+	36,  // 44: lilbattle.v1.GameMove.build_unit:type_name -> lilbattle.v1.BuildUnitAction
+	37,  // 45: lilbattle.v1.GameMove.capture_building:type_name -> lilbattle.v1.CaptureBuildingAction
+	39,  // 46: lilbattle.v1.GameMove.heal_unit:type_name -> lilbattle.v1.HealUnitAction
+	40,  // 47: lilbattle.v1.GameMove.fix_unit:type_name -> lilbattle.v1.FixUnitAction
+	41,  // 48: lilbattle.v1.GameMove.changes:type_name -> lilbattle.v1.WorldChange
+	33,  // 49: lilbattle.v1.MoveUnitAction.from:type_name -> lilbattle.v1.Position
+	33,  // 50: lilbattle.v1.MoveUnitAction.to:type_name -> lilbattle.v1.Position
+	54,  // 51: lilbattle.v1.MoveUnitAction.reconstructed_path:type_name -> lilbattle.v1.Path
+	33,  // 52: lilbattle.v1.AttackUnitAction.attacker:type_name -> lilbattle.v1.Position
+	33,  // 53: lilbattle.v1.AttackUnitAction.defender:type_name -> lilbattle.v1.Position
+	33,  // 54: lilbattle.v1.BuildUnitAction.pos:type_name -> lilbattle.v1.Position
+	33,  // 55: lilbattle.v1.CaptureBuildingAction.pos:type_name -> lilbattle.v1.Position
+	33,  // 56: lilbattle.v1.HealUnitAction.pos:type_name -> lilbattle.v1.Position
+	33,  // 57: lilbattle.v1.FixUnitAction.fixer:type_name -> lilbattle.v1.Position
+	33,  // 58: lilbattle.v1.FixUnitAction.target:type_name -> lilbattle.v1.Position
+	44,  // 59: lilbattle.v1.WorldChange.unit_moved:type_name -> lilbattle.v1.UnitMovedChange
+	45,  // 60: lilbattle.v1.WorldChange.unit_damaged:type_name -> lilbattle.v1.UnitDamagedChange
+	46,  // 61: lilbattle.v1.WorldChange.unit_killed:type_name -> lilbattle.v1.UnitKilledChange
+	47,  // 62: lilbattle.v1.WorldChange.player_changed:type_name -> lilbattle.v1.PlayerChangedChange
+	48,  // 63: lilbattle.v1.WorldChange.unit_built:type_name -> lilbattle.v1.UnitBuiltChange
+	49,  // 64: lilbattle.v1.WorldChange.coins_changed:type_name -> lilbattle.v1.CoinsChangedChange
+	50,  // 65: lilbattle.v1.WorldChange.tile_captured:type_name -> lilbattle.v1.TileCapturedChange
+	51,  // 66: lilbattle.v1.WorldChange.capture_started:type_name -> lilbattle.v1.CaptureStartedChange
+	42,  // 67: lilbattle.v1.WorldChange.unit_healed:type_name -> lilbattle.v1.UnitHealedChange
+	43,  // 68: lilbattle.v1.WorldChange.unit_fixed:type_name -> lilbattle.v1.UnitFixedChange
+	13,  // 69: lilbattle.v1.UnitHealedChange.previous_unit:type_name -> lilbattle.v1.Unit
+	13,  // 70: lilbattle.v1.UnitHealedChange.updated_unit:type_name -> lilbattle.v1.Unit
+	13,  // 71: lilbattle.v1.UnitFixedChange.fixer_unit:type_name -> lilbattle.v1.Unit
+	13,  // 72: lilbattle.v1.UnitFixedChange.previous_target:type_name -> lilbattle.v1.Unit
+	13,  // 73: lilbattle.v1.UnitFixedChange.updated_target:type_name -> lilbattle.v1.Unit
+	13,  // 74: lilbattle.v1.UnitMovedChange.previous_unit:type_name -> lilbattle.v1.Unit
+	13,  // 75: lilbattle.v1.UnitMovedChange.updated_unit:type_name -> lilbattle.v1.Unit
+	13,  // 76: lilbattle.v1.UnitDamagedChange.previous_unit:type_name -> lilbattle.v1.Unit
+	13,  // 77: lilbattle.v1.UnitDamagedChange.updated_unit:type_name -> lilbattle.v1.Unit
+	13,  // 78: lilbattle.v1.UnitKilledChange.previous_unit:type_name -> lilbattle.v1.Unit
+	13,  // 79: lilbattle.v1.PlayerChangedChange.reset_units:type_name -> lilbattle.v1.Unit
+	13,  // 80: lilbattle.v1.UnitBuiltChange.unit:type_name -> lilbattle.v1.Unit
+	13,  // 81: lilbattle.v1.TileCapturedChange.capturing_unit:type_name -> lilbattle.v1.Unit
+	13,  // 82: lilbattle.v1.CaptureStartedChange.capturing_unit:type_name -> lilbattle.v1.Unit
+	77,  // 83: lilbattle.v1.AllPaths.edges:type_name -> lilbattle.v1.AllPaths.EdgesEntry
+	53,  // 84: lilbattle.v1.Path.edges:type_name -> lilbattle.v1.PathEdge
+	3,   // 85: lilbattle.v1.Path.directions:type_name -> lilbattle.v1.PathDirection
+	78,  // 86: lilbattle.v1.PlayerOrders.committed_at:type_name -> google.protobuf.Timestamp
+	32,  // 87: lilbattle.v1.PlayerOrders.moves:type_name -> lilbattle.v1.GameMove
+	78,  // 88: lilbattle.v1.TimeRange.start:type_name -> google.protobuf.Timestamp
+	78,  // 89: lilbattle.v1.TimeRange.end:type_name -> google.protobuf.Timestamp
+	2,   // 90: lilbattle.v1.UserGame.status:type_name -> lilbattle.v1.GameStatus
+	78,  // 91: lilbattle.v1.UserGame.updated_at:type_name -> google.protobuf.Timestamp
+	57,  // 92: lilbattle.v1.UserGameList.games:type_name -> lilbattle.v1.UserGame
+	78,  // 93: lilbattle.v1.WorldRevision.created_at:type_name -> google.protobuf.Timestamp
+	10,  // 94: lilbattle.v1.WorldRevision.world_data:type_name -> lilbattle.v1.WorldData
+	4,   // 95: lilbattle.v1.WorldCellChange.kind:type_name -> lilbattle.v1.WorldChangeKind
+	4,   // 96: lilbattle.v1.WorldMergeConflict.ours:type_name -> lilbattle.v1.WorldChangeKind
+	4,   // 97: lilbattle.v1.WorldMergeConflict.theirs:type_name -> lilbattle.v1.WorldChangeKind
+	9,   // 98: lilbattle.v1.WorldSearchEntry.world:type_name -> lilbattle.v1.World
+	78,  // 99: lilbattle.v1.WorldSearchEntry.indexed_at:type_name -> google.protobuf.Timestamp
+	12,  // 100: lilbattle.v1.WorldData.TilesMapEntry.value:type_name -> lilbattle.v1.Tile
+	13,  // 101: lilbattle.v1.WorldData.UnitsMapEntry.value:type_name -> lilbattle.v1.Unit
+	11,  // 102: lilbattle.v1.WorldData.CrossingsEntry.value:type_name -> lilbattle.v1.Crossing
+	17,  // 103: lilbattle.v1.TerrainDefinition.UnitPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
+	17,  // 104: lilbattle.v1.UnitDefinition.TerrainPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
+	16,  // 105: lilbattle.v1.RulesEngine.UnitsEntry.value:type_name -> lilbattle.v1.UnitDefinition
+	15,  // 106: lilbattle.v1.RulesEngine.TerrainsEntry.value:type_name -> lilbattle.v1.TerrainDefinition
+	17,  // 107: lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntry.value:type_name -> lilbattle.v1.TerrainUnitProperties
+	18,  // 108: lilbattle.v1.RulesEngine.UnitUnitPropertiesEntry.value:type_name -> lilbattle.v1.UnitUnitProperties
+	1,   // 109: lilbattle.v1.RulesEngine.TerrainTypesEntry.value:type_name -> lilbattle.v1.TerrainType
+	28,  // 110: lilbattle.v1.GameState.PlayerStatesEntry.value:type_name -> lilbattle.v1.PlayerState
+	55,  // 111: lilbattle.v1.GameState.PendingOrdersEntry.value:type_name -> lilbattle.v1.PlayerOrders
+	53,  // 112: lilbattle.v1.AllPaths.EdgesEntry.value:type_name -> lilbattle.v1.PathEdge
+	113, // [113:113] is the sub-list for method output_type
+	113, // [113:113] is the sub-list for method input_type
+	113, // [113:113] is the sub-list for extension type_name
+	113, // [113:113] is the sub-list for extension extendee
+	0,   // [0:113] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_models_models_proto_init() }
@@ -5282,8 +6245,8 @@ func file_lilbattle_v1_models_models_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_models_proto_rawDesc), len(file_lilbattle_v1_models_models_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   63,
+			NumEnums:      6,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
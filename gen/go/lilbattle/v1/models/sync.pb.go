@@ -96,9 +96,13 @@ type SubscribeResponse struct {
 	// Current game state (for initial load or catchup)
 	GameState *GameState `protobuf:"bytes,2,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"`
 	// Game metadata
-	Game          *Game `protobuf:"bytes,3,opt,name=game,proto3" json:"game,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Game *Game `protobuf:"bytes,3,opt,name=game,proto3" json:"game,omitempty"`
+	// Set when from_sequence can't be resumed because the updates since then
+	// are no longer retained.  The client should reload the game via GetGame
+	// and track sequences from current_sequence.
+	ReloadRequired bool `protobuf:"varint,4,opt,name=reload_required,json=reloadRequired,proto3" json:"reload_required,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SubscribeResponse) Reset() {
@@ -152,6 +156,13 @@ func (x *SubscribeResponse) GetGame() *Game {
 	return nil
 }
 
+func (x *SubscribeResponse) GetReloadRequired() bool {
+	if x != nil {
+		return x.ReloadRequired
+	}
+	return false
+}
+
 // GameUpdate is streamed to subscribers when game state changes
 type GameUpdate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -642,12 +653,13 @@ const file_lilbattle_v1_models_sync_proto_rawDesc = "" +
 	"\x10SubscribeRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
 	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12#\n" +
-	"\rfrom_sequence\x18\x03 \x01(\x03R\ffromSequence\"\x9e\x01\n" +
+	"\rfrom_sequence\x18\x03 \x01(\x03R\ffromSequence\"\xc7\x01\n" +
 	"\x11SubscribeResponse\x12)\n" +
 	"\x10current_sequence\x18\x01 \x01(\x03R\x0fcurrentSequence\x126\n" +
 	"\n" +
 	"game_state\x18\x02 \x01(\v2\x17.lilbattle.v1.GameStateR\tgameState\x12&\n" +
-	"\x04game\x18\x03 \x01(\v2\x12.lilbattle.v1.GameR\x04game\"\x82\x03\n" +
+	"\x04game\x18\x03 \x01(\v2\x12.lilbattle.v1.GameR\x04game\x12'\n" +
+	"\x0freload_required\x18\x04 \x01(\bR\x0ereloadRequired\"\x82\x03\n" +
 	"\n" +
 	"GameUpdate\x12\x1a\n" +
 	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12G\n" +
@@ -132,7 +132,18 @@ type ListWorldsRequest struct {
 	// Pagination info
 	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	// May be filter by owner id
-	OwnerId       string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Only worlds carrying this tag
+	Tag string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Only worlds created/updated within these windows
+	Created *TimeRange `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	Updated *TimeRange `protobuf:"bytes,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	// Field to sort by - "name" (default), "updated_at" or "created_at"
+	SortBy string `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// "asc" or "desc".  Defaults to newest first for times and A-Z for names.
+	SortOrder string `protobuf:"bytes,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// Only worlds copied from this world
+	ParentWorldId string `protobuf:"bytes,8,opt,name=parent_world_id,json=parentWorldId,proto3" json:"parent_world_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -181,6 +192,48 @@ func (x *ListWorldsRequest) GetOwnerId() string {
 	return ""
 }
 
+func (x *ListWorldsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListWorldsRequest) GetCreated() *TimeRange {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ListWorldsRequest) GetUpdated() *TimeRange {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *ListWorldsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListWorldsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListWorldsRequest) GetParentWorldId() string {
+	if x != nil {
+		return x.ParentWorldId
+	}
+	return ""
+}
+
 type ListWorldsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*World               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -234,9 +287,10 @@ func (x *ListWorldsResponse) GetPagination() *PaginationResponse {
 }
 
 type GetWorldRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // Optional, defaults to default_version
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional revision (WorldData version) to fetch, defaults to the latest
+	Version       string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -767,75 +821,910 @@ func (x *CreateWorldResponse) GetFieldErrors() map[string]string {
 	return nil
 }
 
-var File_lilbattle_v1_models_world_service_proto protoreflect.FileDescriptor
+// *
+// Request to list a world's revisions
+type ListWorldRevisionsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	WorldId string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Pagination info
+	Pagination    *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_lilbattle_v1_models_world_service_proto_rawDesc = "" +
-	"\n" +
-	"'lilbattle/v1/models/world_service.proto\x12\flilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd8\x01\n" +
-	"\tWorldInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x1e\n" +
-	"\n" +
-	"difficulty\x18\x05 \x01(\tR\n" +
-	"difficulty\x12\x12\n" +
-	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x12\n" +
-	"\x04icon\x18\a \x01(\tR\x04icon\x12!\n" +
-	"\flast_updated\x18\b \x01(\tR\vlastUpdated\"h\n" +
-	"\x11ListWorldsRequest\x128\n" +
-	"\n" +
-	"pagination\x18\x01 \x01(\v2\x18.lilbattle.v1.PaginationR\n" +
-	"pagination\x12\x19\n" +
-	"\bowner_id\x18\x02 \x01(\tR\aownerId\"\x81\x01\n" +
-	"\x12ListWorldsResponse\x12)\n" +
-	"\x05items\x18\x01 \x03(\v2\x13.lilbattle.v1.WorldR\x05items\x12@\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2 .lilbattle.v1.PaginationResponseR\n" +
-	"pagination\";\n" +
-	"\x0fGetWorldRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\"u\n" +
-	"\x10GetWorldResponse\x12)\n" +
-	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
-	"\n" +
-	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData\"\xf0\x01\n" +
-	"\x12UpdateWorldRequest\x12)\n" +
-	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
-	"\n" +
-	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData\x12\x1f\n" +
-	"\vclear_world\x18\x03 \x01(\bR\n" +
-	"clearWorld\x12;\n" +
-	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask:\x19\x92A\x16\n" +
-	"\x14*\x12UpdateWorldRequest\"\x94\x01\n" +
-	"\x13UpdateWorldResponse\x12)\n" +
-	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
-	"\n" +
-	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData:\x1a\x92A\x17\n" +
-	"\x15*\x13UpdateWorldResponse\"$\n" +
-	"\x12DeleteWorldRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
-	"\x13DeleteWorldResponse\"$\n" +
-	"\x10GetWorldsRequest\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\tR\x03ids\"\xa8\x01\n" +
-	"\x11GetWorldsResponse\x12C\n" +
-	"\x06worlds\x18\x01 \x03(\v2+.lilbattle.v1.GetWorldsResponse.WorldsEntryR\x06worlds\x1aN\n" +
-	"\vWorldsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.lilbattle.v1.WorldR\x05value:\x028\x01\"w\n" +
-	"\x12CreateWorldRequest\x12)\n" +
-	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
-	"\n" +
-	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData\"\x8f\x02\n" +
-	"\x13CreateWorldResponse\x12)\n" +
-	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
-	"\n" +
-	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData\x12U\n" +
-	"\ffield_errors\x18\x03 \x03(\v22.lilbattle.v1.CreateWorldResponse.FieldErrorsEntryR\vfieldErrors\x1a>\n" +
-	"\x10FieldErrorsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\xbd\x01\n" +
+func (x *ListWorldRevisionsRequest) Reset() {
+	*x = ListWorldRevisionsRequest{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorldRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorldRevisionsRequest) ProtoMessage() {}
+
+func (x *ListWorldRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorldRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorldRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListWorldRevisionsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *ListWorldRevisionsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// *
+// A world's revisions, newest first, without their world data
+type ListWorldRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*WorldRevision       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorldRevisionsResponse) Reset() {
+	*x = ListWorldRevisionsResponse{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorldRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorldRevisionsResponse) ProtoMessage() {}
+
+func (x *ListWorldRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorldRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorldRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListWorldRevisionsResponse) GetItems() []*WorldRevision {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListWorldRevisionsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// *
+// Request to make an older revision of a world its latest one
+type RevertWorldRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	WorldId string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// The revision to revert to
+	Revision      int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertWorldRequest) Reset() {
+	*x = RevertWorldRequest{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertWorldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertWorldRequest) ProtoMessage() {}
+
+func (x *RevertWorldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertWorldRequest.ProtoReflect.Descriptor instead.
+func (*RevertWorldRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevertWorldRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *RevertWorldRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// *
+// Response of a revert.  The world's data is saved as a new revision so
+// the revisions after the one reverted to are kept.
+type RevertWorldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         *World                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`
+	WorldData     *WorldData             `protobuf:"bytes,2,opt,name=world_data,json=worldData,proto3" json:"world_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertWorldResponse) Reset() {
+	*x = RevertWorldResponse{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertWorldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertWorldResponse) ProtoMessage() {}
+
+func (x *RevertWorldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertWorldResponse.ProtoReflect.Descriptor instead.
+func (*RevertWorldResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RevertWorldResponse) GetWorld() *World {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+func (x *RevertWorldResponse) GetWorldData() *WorldData {
+	if x != nil {
+		return x.WorldData
+	}
+	return nil
+}
+
+// *
+// Request to list the worlds copied (remixed) from a world
+type ListDerivedWorldsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	WorldId string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Pagination info
+	Pagination    *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDerivedWorldsRequest) Reset() {
+	*x = ListDerivedWorldsRequest{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDerivedWorldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDerivedWorldsRequest) ProtoMessage() {}
+
+func (x *ListDerivedWorldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDerivedWorldsRequest.ProtoReflect.Descriptor instead.
+func (*ListDerivedWorldsRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListDerivedWorldsRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *ListDerivedWorldsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListDerivedWorldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*World               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDerivedWorldsResponse) Reset() {
+	*x = ListDerivedWorldsResponse{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDerivedWorldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDerivedWorldsResponse) ProtoMessage() {}
+
+func (x *ListDerivedWorldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDerivedWorldsResponse.ProtoReflect.Descriptor instead.
+func (*ListDerivedWorldsResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListDerivedWorldsResponse) GetItems() []*World {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListDerivedWorldsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// *
+// Request to pull changes made to a world's parent since it was copied (or
+// last merged) into the world.  The parent revision the world records is
+// the base of a three-way merge of tiles, units and crossings.
+type MergeWorldUpstreamRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	WorldId string                 `protobuf:"bytes,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Parent revision to merge, defaults to the parent's latest
+	UpstreamRevision int64 `protobuf:"varint,2,opt,name=upstream_revision,json=upstreamRevision,proto3" json:"upstream_revision,omitempty"`
+	// What to do with cells both worlds changed.  By default conflicts are
+	// reported and nothing is merged.
+	Strategy WorldMergeStrategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=lilbattle.v1.WorldMergeStrategy" json:"strategy,omitempty"`
+	// Compute the merge without saving it
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeWorldUpstreamRequest) Reset() {
+	*x = MergeWorldUpstreamRequest{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeWorldUpstreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeWorldUpstreamRequest) ProtoMessage() {}
+
+func (x *MergeWorldUpstreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeWorldUpstreamRequest.ProtoReflect.Descriptor instead.
+func (*MergeWorldUpstreamRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MergeWorldUpstreamRequest) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *MergeWorldUpstreamRequest) GetUpstreamRevision() int64 {
+	if x != nil {
+		return x.UpstreamRevision
+	}
+	return 0
+}
+
+func (x *MergeWorldUpstreamRequest) GetStrategy() WorldMergeStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return WorldMergeStrategy_WORLD_MERGE_STRATEGY_UNSPECIFIED
+}
+
+func (x *MergeWorldUpstreamRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type MergeWorldUpstreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	World *World                 `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`
+	// The merged world data - saved unless this was a dry run or it
+	// conflicted without a strategy
+	WorldData *WorldData `protobuf:"bytes,2,opt,name=world_data,json=worldData,proto3" json:"world_data,omitempty"`
+	// Parent revisions the merge was between
+	BaseRevision     int64 `protobuf:"varint,3,opt,name=base_revision,json=baseRevision,proto3" json:"base_revision,omitempty"`
+	UpstreamRevision int64 `protobuf:"varint,4,opt,name=upstream_revision,json=upstreamRevision,proto3" json:"upstream_revision,omitempty"`
+	// Upstream changes taken into the world
+	Applied []*WorldCellChange `protobuf:"bytes,5,rep,name=applied,proto3" json:"applied,omitempty"`
+	// Cells both worlds changed differently
+	Conflicts []*WorldMergeConflict `protobuf:"bytes,6,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	// Whether the merged data was saved
+	Merged        bool `protobuf:"varint,7,opt,name=merged,proto3" json:"merged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeWorldUpstreamResponse) Reset() {
+	*x = MergeWorldUpstreamResponse{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeWorldUpstreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeWorldUpstreamResponse) ProtoMessage() {}
+
+func (x *MergeWorldUpstreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeWorldUpstreamResponse.ProtoReflect.Descriptor instead.
+func (*MergeWorldUpstreamResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MergeWorldUpstreamResponse) GetWorld() *World {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+func (x *MergeWorldUpstreamResponse) GetWorldData() *WorldData {
+	if x != nil {
+		return x.WorldData
+	}
+	return nil
+}
+
+func (x *MergeWorldUpstreamResponse) GetBaseRevision() int64 {
+	if x != nil {
+		return x.BaseRevision
+	}
+	return 0
+}
+
+func (x *MergeWorldUpstreamResponse) GetUpstreamRevision() int64 {
+	if x != nil {
+		return x.UpstreamRevision
+	}
+	return 0
+}
+
+func (x *MergeWorldUpstreamResponse) GetApplied() []*WorldCellChange {
+	if x != nil {
+		return x.Applied
+	}
+	return nil
+}
+
+func (x *MergeWorldUpstreamResponse) GetConflicts() []*WorldMergeConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *MergeWorldUpstreamResponse) GetMerged() bool {
+	if x != nil {
+		return x.Merged
+	}
+	return false
+}
+
+// *
+// Request to search worlds by free text and facets.  Zero fields match
+// every world.
+type SearchWorldsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Free text matched against the name, tags, difficulty and description.
+	// Words match as prefixes and every word must match.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Only worlds carrying all of these tags
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Only worlds of one of these difficulties
+	Difficulties []string `protobuf:"bytes,3,rep,name=difficulties,proto3" json:"difficulties,omitempty"`
+	// Only worlds for this many players
+	MinPlayers int32 `protobuf:"varint,4,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	MaxPlayers int32 `protobuf:"varint,5,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	// Only worlds with this many tiles
+	MinTiles int32 `protobuf:"varint,6,opt,name=min_tiles,json=minTiles,proto3" json:"min_tiles,omitempty"`
+	MaxTiles int32 `protobuf:"varint,7,opt,name=max_tiles,json=maxTiles,proto3" json:"max_tiles,omitempty"`
+	// Field to sort by - "relevance" (default with a query), "popularity"
+	// (default without), "name" or "updated_at"
+	SortBy string `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// "asc" or "desc".  Defaults to best/most/newest first and A-Z for names.
+	SortOrder string `protobuf:"bytes,9,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// Pagination info - by offset
+	Pagination    *Pagination `protobuf:"bytes,10,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchWorldsRequest) Reset() {
+	*x = SearchWorldsRequest{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWorldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWorldsRequest) ProtoMessage() {}
+
+func (x *SearchWorldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWorldsRequest.ProtoReflect.Descriptor instead.
+func (*SearchWorldsRequest) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchWorldsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchWorldsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchWorldsRequest) GetDifficulties() []string {
+	if x != nil {
+		return x.Difficulties
+	}
+	return nil
+}
+
+func (x *SearchWorldsRequest) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *SearchWorldsRequest) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *SearchWorldsRequest) GetMinTiles() int32 {
+	if x != nil {
+		return x.MinTiles
+	}
+	return 0
+}
+
+func (x *SearchWorldsRequest) GetMaxTiles() int32 {
+	if x != nil {
+		return x.MaxTiles
+	}
+	return 0
+}
+
+func (x *SearchWorldsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *SearchWorldsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *SearchWorldsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// A value of a search facet and how many results have it
+type SearchFacetCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFacetCount) Reset() {
+	*x = SearchFacetCount{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacetCount) ProtoMessage() {}
+
+func (x *SearchFacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFacetCount.ProtoReflect.Descriptor instead.
+func (*SearchFacetCount) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchFacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SearchFacetCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SearchWorldsResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Items      []*World               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Pagination *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Facets of all the results (not just this page), most common first
+	Tags          []*SearchFacetCount `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Difficulties  []*SearchFacetCount `protobuf:"bytes,4,rep,name=difficulties,proto3" json:"difficulties,omitempty"`
+	PlayerCounts  []*SearchFacetCount `protobuf:"bytes,5,rep,name=player_counts,json=playerCounts,proto3" json:"player_counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchWorldsResponse) Reset() {
+	*x = SearchWorldsResponse{}
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWorldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWorldsResponse) ProtoMessage() {}
+
+func (x *SearchWorldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lilbattle_v1_models_world_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWorldsResponse.ProtoReflect.Descriptor instead.
+func (*SearchWorldsResponse) Descriptor() ([]byte, []int) {
+	return file_lilbattle_v1_models_world_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchWorldsResponse) GetItems() []*World {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchWorldsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchWorldsResponse) GetTags() []*SearchFacetCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchWorldsResponse) GetDifficulties() []*SearchFacetCount {
+	if x != nil {
+		return x.Difficulties
+	}
+	return nil
+}
+
+func (x *SearchWorldsResponse) GetPlayerCounts() []*SearchFacetCount {
+	if x != nil {
+		return x.PlayerCounts
+	}
+	return nil
+}
+
+var File_lilbattle_v1_models_world_service_proto protoreflect.FileDescriptor
+
+const file_lilbattle_v1_models_world_service_proto_rawDesc = "" +
+	"\n" +
+	"'lilbattle/v1/models/world_service.proto\x12\flilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd8\x01\n" +
+	"\tWorldInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x05 \x01(\tR\n" +
+	"difficulty\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x12\n" +
+	"\x04icon\x18\a \x01(\tR\x04icon\x12!\n" +
+	"\flast_updated\x18\b \x01(\tR\vlastUpdated\"\xc0\x02\n" +
+	"\x11ListWorldsRequest\x128\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x18.lilbattle.v1.PaginationR\n" +
+	"pagination\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\x121\n" +
+	"\acreated\x18\x04 \x01(\v2\x17.lilbattle.v1.TimeRangeR\acreated\x121\n" +
+	"\aupdated\x18\x05 \x01(\v2\x17.lilbattle.v1.TimeRangeR\aupdated\x12\x17\n" +
+	"\asort_by\x18\x06 \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\a \x01(\tR\tsortOrder\x12&\n" +
+	"\x0fparent_world_id\x18\b \x01(\tR\rparentWorldId\"\x81\x01\n" +
+	"\x12ListWorldsResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.lilbattle.v1.WorldR\x05items\x12@\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2 .lilbattle.v1.PaginationResponseR\n" +
+	"pagination\";\n" +
+	"\x0fGetWorldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"u\n" +
+	"\x10GetWorldResponse\x12)\n" +
+	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
+	"\n" +
+	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData\"\xf0\x01\n" +
+	"\x12UpdateWorldRequest\x12)\n" +
+	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
+	"\n" +
+	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData\x12\x1f\n" +
+	"\vclear_world\x18\x03 \x01(\bR\n" +
+	"clearWorld\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask:\x19\x92A\x16\n" +
+	"\x14*\x12UpdateWorldRequest\"\x94\x01\n" +
+	"\x13UpdateWorldResponse\x12)\n" +
+	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
+	"\n" +
+	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData:\x1a\x92A\x17\n" +
+	"\x15*\x13UpdateWorldResponse\"$\n" +
+	"\x12DeleteWorldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13DeleteWorldResponse\"$\n" +
+	"\x10GetWorldsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\xa8\x01\n" +
+	"\x11GetWorldsResponse\x12C\n" +
+	"\x06worlds\x18\x01 \x03(\v2+.lilbattle.v1.GetWorldsResponse.WorldsEntryR\x06worlds\x1aN\n" +
+	"\vWorldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.lilbattle.v1.WorldR\x05value:\x028\x01\"w\n" +
+	"\x12CreateWorldRequest\x12)\n" +
+	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
+	"\n" +
+	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData\"\x8f\x02\n" +
+	"\x13CreateWorldResponse\x12)\n" +
+	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
+	"\n" +
+	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData\x12U\n" +
+	"\ffield_errors\x18\x03 \x03(\v22.lilbattle.v1.CreateWorldResponse.FieldErrorsEntryR\vfieldErrors\x1a>\n" +
+	"\x10FieldErrorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"p\n" +
+	"\x19ListWorldRevisionsRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x128\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x18.lilbattle.v1.PaginationR\n" +
+	"pagination\"\x91\x01\n" +
+	"\x1aListWorldRevisionsResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.lilbattle.v1.WorldRevisionR\x05items\x12@\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2 .lilbattle.v1.PaginationResponseR\n" +
+	"pagination\"K\n" +
+	"\x12RevertWorldRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\"x\n" +
+	"\x13RevertWorldResponse\x12)\n" +
+	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
+	"\n" +
+	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData\"o\n" +
+	"\x18ListDerivedWorldsRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x128\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x18.lilbattle.v1.PaginationR\n" +
+	"pagination\"\x88\x01\n" +
+	"\x19ListDerivedWorldsResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.lilbattle.v1.WorldR\x05items\x12@\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2 .lilbattle.v1.PaginationResponseR\n" +
+	"pagination\"\xba\x01\n" +
+	"\x19MergeWorldUpstreamRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\tR\aworldId\x12+\n" +
+	"\x11upstream_revision\x18\x02 \x01(\x03R\x10upstreamRevision\x12<\n" +
+	"\bstrategy\x18\x03 \x01(\x0e2 .lilbattle.v1.WorldMergeStrategyR\bstrategy\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\xe2\x02\n" +
+	"\x1aMergeWorldUpstreamResponse\x12)\n" +
+	"\x05world\x18\x01 \x01(\v2\x13.lilbattle.v1.WorldR\x05world\x126\n" +
+	"\n" +
+	"world_data\x18\x02 \x01(\v2\x17.lilbattle.v1.WorldDataR\tworldData\x12#\n" +
+	"\rbase_revision\x18\x03 \x01(\x03R\fbaseRevision\x12+\n" +
+	"\x11upstream_revision\x18\x04 \x01(\x03R\x10upstreamRevision\x127\n" +
+	"\aapplied\x18\x05 \x03(\v2\x1d.lilbattle.v1.WorldCellChangeR\aapplied\x12>\n" +
+	"\tconflicts\x18\x06 \x03(\v2 .lilbattle.v1.WorldMergeConflictR\tconflicts\x12\x16\n" +
+	"\x06merged\x18\a \x01(\bR\x06merged\"\xd1\x02\n" +
+	"\x13SearchWorldsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12\"\n" +
+	"\fdifficulties\x18\x03 \x03(\tR\fdifficulties\x12\x1f\n" +
+	"\vmin_players\x18\x04 \x01(\x05R\n" +
+	"minPlayers\x12\x1f\n" +
+	"\vmax_players\x18\x05 \x01(\x05R\n" +
+	"maxPlayers\x12\x1b\n" +
+	"\tmin_tiles\x18\x06 \x01(\x05R\bminTiles\x12\x1b\n" +
+	"\tmax_tiles\x18\a \x01(\x05R\bmaxTiles\x12\x17\n" +
+	"\asort_by\x18\b \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\t \x01(\tR\tsortOrder\x128\n" +
+	"\n" +
+	"pagination\x18\n" +
+	" \x01(\v2\x18.lilbattle.v1.PaginationR\n" +
+	"pagination\">\n" +
+	"\x10SearchFacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xc0\x02\n" +
+	"\x14SearchWorldsResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.lilbattle.v1.WorldR\x05items\x12@\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2 .lilbattle.v1.PaginationResponseR\n" +
+	"pagination\x122\n" +
+	"\x04tags\x18\x03 \x03(\v2\x1e.lilbattle.v1.SearchFacetCountR\x04tags\x12B\n" +
+	"\fdifficulties\x18\x04 \x03(\v2\x1e.lilbattle.v1.SearchFacetCountR\fdifficulties\x12C\n" +
+	"\rplayer_counts\x18\x05 \x03(\v2\x1e.lilbattle.v1.SearchFacetCountR\fplayerCountsB\xbd\x01\n" +
 	"\x10com.lilbattle.v1B\x11WorldServiceProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var (
@@ -850,52 +1739,89 @@ func file_lilbattle_v1_models_world_service_proto_rawDescGZIP() []byte {
 	return file_lilbattle_v1_models_world_service_proto_rawDescData
 }
 
-var file_lilbattle_v1_models_world_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_lilbattle_v1_models_world_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_lilbattle_v1_models_world_service_proto_goTypes = []any{
-	(*WorldInfo)(nil),             // 0: lilbattle.v1.WorldInfo
-	(*ListWorldsRequest)(nil),     // 1: lilbattle.v1.ListWorldsRequest
-	(*ListWorldsResponse)(nil),    // 2: lilbattle.v1.ListWorldsResponse
-	(*GetWorldRequest)(nil),       // 3: lilbattle.v1.GetWorldRequest
-	(*GetWorldResponse)(nil),      // 4: lilbattle.v1.GetWorldResponse
-	(*UpdateWorldRequest)(nil),    // 5: lilbattle.v1.UpdateWorldRequest
-	(*UpdateWorldResponse)(nil),   // 6: lilbattle.v1.UpdateWorldResponse
-	(*DeleteWorldRequest)(nil),    // 7: lilbattle.v1.DeleteWorldRequest
-	(*DeleteWorldResponse)(nil),   // 8: lilbattle.v1.DeleteWorldResponse
-	(*GetWorldsRequest)(nil),      // 9: lilbattle.v1.GetWorldsRequest
-	(*GetWorldsResponse)(nil),     // 10: lilbattle.v1.GetWorldsResponse
-	(*CreateWorldRequest)(nil),    // 11: lilbattle.v1.CreateWorldRequest
-	(*CreateWorldResponse)(nil),   // 12: lilbattle.v1.CreateWorldResponse
-	nil,                           // 13: lilbattle.v1.GetWorldsResponse.WorldsEntry
-	nil,                           // 14: lilbattle.v1.CreateWorldResponse.FieldErrorsEntry
-	(*Pagination)(nil),            // 15: lilbattle.v1.Pagination
-	(*World)(nil),                 // 16: lilbattle.v1.World
-	(*PaginationResponse)(nil),    // 17: lilbattle.v1.PaginationResponse
-	(*WorldData)(nil),             // 18: lilbattle.v1.WorldData
-	(*fieldmaskpb.FieldMask)(nil), // 19: google.protobuf.FieldMask
+	(*WorldInfo)(nil),                  // 0: lilbattle.v1.WorldInfo
+	(*ListWorldsRequest)(nil),          // 1: lilbattle.v1.ListWorldsRequest
+	(*ListWorldsResponse)(nil),         // 2: lilbattle.v1.ListWorldsResponse
+	(*GetWorldRequest)(nil),            // 3: lilbattle.v1.GetWorldRequest
+	(*GetWorldResponse)(nil),           // 4: lilbattle.v1.GetWorldResponse
+	(*UpdateWorldRequest)(nil),         // 5: lilbattle.v1.UpdateWorldRequest
+	(*UpdateWorldResponse)(nil),        // 6: lilbattle.v1.UpdateWorldResponse
+	(*DeleteWorldRequest)(nil),         // 7: lilbattle.v1.DeleteWorldRequest
+	(*DeleteWorldResponse)(nil),        // 8: lilbattle.v1.DeleteWorldResponse
+	(*GetWorldsRequest)(nil),           // 9: lilbattle.v1.GetWorldsRequest
+	(*GetWorldsResponse)(nil),          // 10: lilbattle.v1.GetWorldsResponse
+	(*CreateWorldRequest)(nil),         // 11: lilbattle.v1.CreateWorldRequest
+	(*CreateWorldResponse)(nil),        // 12: lilbattle.v1.CreateWorldResponse
+	(*ListWorldRevisionsRequest)(nil),  // 13: lilbattle.v1.ListWorldRevisionsRequest
+	(*ListWorldRevisionsResponse)(nil), // 14: lilbattle.v1.ListWorldRevisionsResponse
+	(*RevertWorldRequest)(nil),         // 15: lilbattle.v1.RevertWorldRequest
+	(*RevertWorldResponse)(nil),        // 16: lilbattle.v1.RevertWorldResponse
+	(*ListDerivedWorldsRequest)(nil),   // 17: lilbattle.v1.ListDerivedWorldsRequest
+	(*ListDerivedWorldsResponse)(nil),  // 18: lilbattle.v1.ListDerivedWorldsResponse
+	(*MergeWorldUpstreamRequest)(nil),  // 19: lilbattle.v1.MergeWorldUpstreamRequest
+	(*MergeWorldUpstreamResponse)(nil), // 20: lilbattle.v1.MergeWorldUpstreamResponse
+	(*SearchWorldsRequest)(nil),        // 21: lilbattle.v1.SearchWorldsRequest
+	(*SearchFacetCount)(nil),           // 22: lilbattle.v1.SearchFacetCount
+	(*SearchWorldsResponse)(nil),       // 23: lilbattle.v1.SearchWorldsResponse
+	nil,                                // 24: lilbattle.v1.GetWorldsResponse.WorldsEntry
+	nil,                                // 25: lilbattle.v1.CreateWorldResponse.FieldErrorsEntry
+	(*Pagination)(nil),                 // 26: lilbattle.v1.Pagination
+	(*TimeRange)(nil),                  // 27: lilbattle.v1.TimeRange
+	(*World)(nil),                      // 28: lilbattle.v1.World
+	(*PaginationResponse)(nil),         // 29: lilbattle.v1.PaginationResponse
+	(*WorldData)(nil),                  // 30: lilbattle.v1.WorldData
+	(*fieldmaskpb.FieldMask)(nil),      // 31: google.protobuf.FieldMask
+	(*WorldRevision)(nil),              // 32: lilbattle.v1.WorldRevision
+	(WorldMergeStrategy)(0),            // 33: lilbattle.v1.WorldMergeStrategy
+	(*WorldCellChange)(nil),            // 34: lilbattle.v1.WorldCellChange
+	(*WorldMergeConflict)(nil),         // 35: lilbattle.v1.WorldMergeConflict
 }
 var file_lilbattle_v1_models_world_service_proto_depIdxs = []int32{
-	15, // 0: lilbattle.v1.ListWorldsRequest.pagination:type_name -> lilbattle.v1.Pagination
-	16, // 1: lilbattle.v1.ListWorldsResponse.items:type_name -> lilbattle.v1.World
-	17, // 2: lilbattle.v1.ListWorldsResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
-	16, // 3: lilbattle.v1.GetWorldResponse.world:type_name -> lilbattle.v1.World
-	18, // 4: lilbattle.v1.GetWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
-	16, // 5: lilbattle.v1.UpdateWorldRequest.world:type_name -> lilbattle.v1.World
-	18, // 6: lilbattle.v1.UpdateWorldRequest.world_data:type_name -> lilbattle.v1.WorldData
-	19, // 7: lilbattle.v1.UpdateWorldRequest.update_mask:type_name -> google.protobuf.FieldMask
-	16, // 8: lilbattle.v1.UpdateWorldResponse.world:type_name -> lilbattle.v1.World
-	18, // 9: lilbattle.v1.UpdateWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
-	13, // 10: lilbattle.v1.GetWorldsResponse.worlds:type_name -> lilbattle.v1.GetWorldsResponse.WorldsEntry
-	16, // 11: lilbattle.v1.CreateWorldRequest.world:type_name -> lilbattle.v1.World
-	18, // 12: lilbattle.v1.CreateWorldRequest.world_data:type_name -> lilbattle.v1.WorldData
-	16, // 13: lilbattle.v1.CreateWorldResponse.world:type_name -> lilbattle.v1.World
-	18, // 14: lilbattle.v1.CreateWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
-	14, // 15: lilbattle.v1.CreateWorldResponse.field_errors:type_name -> lilbattle.v1.CreateWorldResponse.FieldErrorsEntry
-	16, // 16: lilbattle.v1.GetWorldsResponse.WorldsEntry.value:type_name -> lilbattle.v1.World
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	26, // 0: lilbattle.v1.ListWorldsRequest.pagination:type_name -> lilbattle.v1.Pagination
+	27, // 1: lilbattle.v1.ListWorldsRequest.created:type_name -> lilbattle.v1.TimeRange
+	27, // 2: lilbattle.v1.ListWorldsRequest.updated:type_name -> lilbattle.v1.TimeRange
+	28, // 3: lilbattle.v1.ListWorldsResponse.items:type_name -> lilbattle.v1.World
+	29, // 4: lilbattle.v1.ListWorldsResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
+	28, // 5: lilbattle.v1.GetWorldResponse.world:type_name -> lilbattle.v1.World
+	30, // 6: lilbattle.v1.GetWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
+	28, // 7: lilbattle.v1.UpdateWorldRequest.world:type_name -> lilbattle.v1.World
+	30, // 8: lilbattle.v1.UpdateWorldRequest.world_data:type_name -> lilbattle.v1.WorldData
+	31, // 9: lilbattle.v1.UpdateWorldRequest.update_mask:type_name -> google.protobuf.FieldMask
+	28, // 10: lilbattle.v1.UpdateWorldResponse.world:type_name -> lilbattle.v1.World
+	30, // 11: lilbattle.v1.UpdateWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
+	24, // 12: lilbattle.v1.GetWorldsResponse.worlds:type_name -> lilbattle.v1.GetWorldsResponse.WorldsEntry
+	28, // 13: lilbattle.v1.CreateWorldRequest.world:type_name -> lilbattle.v1.World
+	30, // 14: lilbattle.v1.CreateWorldRequest.world_data:type_name -> lilbattle.v1.WorldData
+	28, // 15: lilbattle.v1.CreateWorldResponse.world:type_name -> lilbattle.v1.World
+	30, // 16: lilbattle.v1.CreateWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
+	25, // 17: lilbattle.v1.CreateWorldResponse.field_errors:type_name -> lilbattle.v1.CreateWorldResponse.FieldErrorsEntry
+	26, // 18: lilbattle.v1.ListWorldRevisionsRequest.pagination:type_name -> lilbattle.v1.Pagination
+	32, // 19: lilbattle.v1.ListWorldRevisionsResponse.items:type_name -> lilbattle.v1.WorldRevision
+	29, // 20: lilbattle.v1.ListWorldRevisionsResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
+	28, // 21: lilbattle.v1.RevertWorldResponse.world:type_name -> lilbattle.v1.World
+	30, // 22: lilbattle.v1.RevertWorldResponse.world_data:type_name -> lilbattle.v1.WorldData
+	26, // 23: lilbattle.v1.ListDerivedWorldsRequest.pagination:type_name -> lilbattle.v1.Pagination
+	28, // 24: lilbattle.v1.ListDerivedWorldsResponse.items:type_name -> lilbattle.v1.World
+	29, // 25: lilbattle.v1.ListDerivedWorldsResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
+	33, // 26: lilbattle.v1.MergeWorldUpstreamRequest.strategy:type_name -> lilbattle.v1.WorldMergeStrategy
+	28, // 27: lilbattle.v1.MergeWorldUpstreamResponse.world:type_name -> lilbattle.v1.World
+	30, // 28: lilbattle.v1.MergeWorldUpstreamResponse.world_data:type_name -> lilbattle.v1.WorldData
+	34, // 29: lilbattle.v1.MergeWorldUpstreamResponse.applied:type_name -> lilbattle.v1.WorldCellChange
+	35, // 30: lilbattle.v1.MergeWorldUpstreamResponse.conflicts:type_name -> lilbattle.v1.WorldMergeConflict
+	26, // 31: lilbattle.v1.SearchWorldsRequest.pagination:type_name -> lilbattle.v1.Pagination
+	28, // 32: lilbattle.v1.SearchWorldsResponse.items:type_name -> lilbattle.v1.World
+	29, // 33: lilbattle.v1.SearchWorldsResponse.pagination:type_name -> lilbattle.v1.PaginationResponse
+	22, // 34: lilbattle.v1.SearchWorldsResponse.tags:type_name -> lilbattle.v1.SearchFacetCount
+	22, // 35: lilbattle.v1.SearchWorldsResponse.difficulties:type_name -> lilbattle.v1.SearchFacetCount
+	22, // 36: lilbattle.v1.SearchWorldsResponse.player_counts:type_name -> lilbattle.v1.SearchFacetCount
+	28, // 37: lilbattle.v1.GetWorldsResponse.WorldsEntry.value:type_name -> lilbattle.v1.World
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_lilbattle_v1_models_world_service_proto_init() }
@@ -910,7 +1836,7 @@ func file_lilbattle_v1_models_world_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_models_world_service_proto_rawDesc), len(file_lilbattle_v1_models_world_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -29,7 +29,7 @@ var File_lilbattle_v1_services_games_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_services_games_proto_rawDesc = "" +
 	"\n" +
-	"!lilbattle/v1/services/games.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a'lilbattle/v1/models/games_service.proto2\x86\f\n" +
+	"!lilbattle/v1/services/games.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a'lilbattle/v1/models/games_service.proto2\xe0\x10\n" +
 	"\fGamesService\x12e\n" +
 	"\n" +
 	"CreateGame\x12\x1f.lilbattle.v1.CreateGameRequest\x1a .lilbattle.v1.CreateGameResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/games\x12e\n" +
@@ -46,7 +46,14 @@ const file_lilbattle_v1_services_games_proto_rawDesc = "" +
 	"\fGetOptionsAt\x12!.lilbattle.v1.GetOptionsAtRequest\x1a\".lilbattle.v1.GetOptionsAtResponse\"^\x82\xd3\xe4\x93\x02XZ)\x12'/v1/games/{game_id}/options/{pos.label}\x12+/v1/games/{game_id}/options/{pos.q}/{pos.r}\x12\x81\x01\n" +
 	"\x0eSimulateAttack\x12#.lilbattle.v1.SimulateAttackRequest\x1a$.lilbattle.v1.SimulateAttackResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/games/simulate_attack\x12u\n" +
 	"\vSimulateFix\x12 .lilbattle.v1.SimulateFixRequest\x1a!.lilbattle.v1.SimulateFixResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/games/simulate_fix\x12n\n" +
-	"\bJoinGame\x12\x1d.lilbattle.v1.JoinGameRequest\x1a\x1e.lilbattle.v1.JoinGameResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/games/{game_id}/joinB\xb8\x01\n" +
+	"\bJoinGame\x12\x1d.lilbattle.v1.JoinGameRequest\x1a\x1e.lilbattle.v1.JoinGameResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/games/{game_id}/join\x12\x83\x01\n" +
+	"\fCommitOrders\x12!.lilbattle.v1.CommitOrdersRequest\x1a\".lilbattle.v1.CommitOrdersResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/games/{game_id}/orders/commit\x12\x83\x01\n" +
+	"\fRevealOrders\x12!.lilbattle.v1.RevealOrdersRequest\x1a\".lilbattle.v1.RevealOrdersResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/games/{game_id}/orders/reveal\x12h\n" +
+	"\vListMyGames\x12 .lilbattle.v1.ListMyGamesRequest\x1a!.lilbattle.v1.ListMyGamesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/me/games\x12t\n" +
+	"\n" +
+	"ExportGame\x12\x1f.lilbattle.v1.ExportGameRequest\x1a .lilbattle.v1.ExportGameResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/games/{game_id}/archive\x12l\n" +
+	"\n" +
+	"ImportGame\x12\x1f.lilbattle.v1.ImportGameRequest\x1a .lilbattle.v1.ImportGameResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/games:importB\xb8\x01\n" +
 	"\x10com.lilbattle.v1B\n" +
 	"GamesProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
@@ -64,19 +71,29 @@ var file_lilbattle_v1_services_games_proto_goTypes = []any{
 	(*models.SimulateAttackRequest)(nil),  // 10: lilbattle.v1.SimulateAttackRequest
 	(*models.SimulateFixRequest)(nil),     // 11: lilbattle.v1.SimulateFixRequest
 	(*models.JoinGameRequest)(nil),        // 12: lilbattle.v1.JoinGameRequest
-	(*models.CreateGameResponse)(nil),     // 13: lilbattle.v1.CreateGameResponse
-	(*models.GetGamesResponse)(nil),       // 14: lilbattle.v1.GetGamesResponse
-	(*models.ListGamesResponse)(nil),      // 15: lilbattle.v1.ListGamesResponse
-	(*models.GetGameResponse)(nil),        // 16: lilbattle.v1.GetGameResponse
-	(*models.DeleteGameResponse)(nil),     // 17: lilbattle.v1.DeleteGameResponse
-	(*models.UpdateGameResponse)(nil),     // 18: lilbattle.v1.UpdateGameResponse
-	(*models.GetGameStateResponse)(nil),   // 19: lilbattle.v1.GetGameStateResponse
-	(*models.ListMovesResponse)(nil),      // 20: lilbattle.v1.ListMovesResponse
-	(*models.ProcessMovesResponse)(nil),   // 21: lilbattle.v1.ProcessMovesResponse
-	(*models.GetOptionsAtResponse)(nil),   // 22: lilbattle.v1.GetOptionsAtResponse
-	(*models.SimulateAttackResponse)(nil), // 23: lilbattle.v1.SimulateAttackResponse
-	(*models.SimulateFixResponse)(nil),    // 24: lilbattle.v1.SimulateFixResponse
-	(*models.JoinGameResponse)(nil),       // 25: lilbattle.v1.JoinGameResponse
+	(*models.CommitOrdersRequest)(nil),    // 13: lilbattle.v1.CommitOrdersRequest
+	(*models.RevealOrdersRequest)(nil),    // 14: lilbattle.v1.RevealOrdersRequest
+	(*models.ListMyGamesRequest)(nil),     // 15: lilbattle.v1.ListMyGamesRequest
+	(*models.ExportGameRequest)(nil),      // 16: lilbattle.v1.ExportGameRequest
+	(*models.ImportGameRequest)(nil),      // 17: lilbattle.v1.ImportGameRequest
+	(*models.CreateGameResponse)(nil),     // 18: lilbattle.v1.CreateGameResponse
+	(*models.GetGamesResponse)(nil),       // 19: lilbattle.v1.GetGamesResponse
+	(*models.ListGamesResponse)(nil),      // 20: lilbattle.v1.ListGamesResponse
+	(*models.GetGameResponse)(nil),        // 21: lilbattle.v1.GetGameResponse
+	(*models.DeleteGameResponse)(nil),     // 22: lilbattle.v1.DeleteGameResponse
+	(*models.UpdateGameResponse)(nil),     // 23: lilbattle.v1.UpdateGameResponse
+	(*models.GetGameStateResponse)(nil),   // 24: lilbattle.v1.GetGameStateResponse
+	(*models.ListMovesResponse)(nil),      // 25: lilbattle.v1.ListMovesResponse
+	(*models.ProcessMovesResponse)(nil),   // 26: lilbattle.v1.ProcessMovesResponse
+	(*models.GetOptionsAtResponse)(nil),   // 27: lilbattle.v1.GetOptionsAtResponse
+	(*models.SimulateAttackResponse)(nil), // 28: lilbattle.v1.SimulateAttackResponse
+	(*models.SimulateFixResponse)(nil),    // 29: lilbattle.v1.SimulateFixResponse
+	(*models.JoinGameResponse)(nil),       // 30: lilbattle.v1.JoinGameResponse
+	(*models.CommitOrdersResponse)(nil),   // 31: lilbattle.v1.CommitOrdersResponse
+	(*models.RevealOrdersResponse)(nil),   // 32: lilbattle.v1.RevealOrdersResponse
+	(*models.ListMyGamesResponse)(nil),    // 33: lilbattle.v1.ListMyGamesResponse
+	(*models.ExportGameResponse)(nil),     // 34: lilbattle.v1.ExportGameResponse
+	(*models.ImportGameResponse)(nil),     // 35: lilbattle.v1.ImportGameResponse
 }
 var file_lilbattle_v1_services_games_proto_depIdxs = []int32{
 	0,  // 0: lilbattle.v1.GamesService.CreateGame:input_type -> lilbattle.v1.CreateGameRequest
@@ -92,21 +109,31 @@ var file_lilbattle_v1_services_games_proto_depIdxs = []int32{
 	10, // 10: lilbattle.v1.GamesService.SimulateAttack:input_type -> lilbattle.v1.SimulateAttackRequest
 	11, // 11: lilbattle.v1.GamesService.SimulateFix:input_type -> lilbattle.v1.SimulateFixRequest
 	12, // 12: lilbattle.v1.GamesService.JoinGame:input_type -> lilbattle.v1.JoinGameRequest
-	13, // 13: lilbattle.v1.GamesService.CreateGame:output_type -> lilbattle.v1.CreateGameResponse
-	14, // 14: lilbattle.v1.GamesService.GetGames:output_type -> lilbattle.v1.GetGamesResponse
-	15, // 15: lilbattle.v1.GamesService.ListGames:output_type -> lilbattle.v1.ListGamesResponse
-	16, // 16: lilbattle.v1.GamesService.GetGame:output_type -> lilbattle.v1.GetGameResponse
-	17, // 17: lilbattle.v1.GamesService.DeleteGame:output_type -> lilbattle.v1.DeleteGameResponse
-	18, // 18: lilbattle.v1.GamesService.UpdateGame:output_type -> lilbattle.v1.UpdateGameResponse
-	19, // 19: lilbattle.v1.GamesService.GetGameState:output_type -> lilbattle.v1.GetGameStateResponse
-	20, // 20: lilbattle.v1.GamesService.ListMoves:output_type -> lilbattle.v1.ListMovesResponse
-	21, // 21: lilbattle.v1.GamesService.ProcessMoves:output_type -> lilbattle.v1.ProcessMovesResponse
-	22, // 22: lilbattle.v1.GamesService.GetOptionsAt:output_type -> lilbattle.v1.GetOptionsAtResponse
-	23, // 23: lilbattle.v1.GamesService.SimulateAttack:output_type -> lilbattle.v1.SimulateAttackResponse
-	24, // 24: lilbattle.v1.GamesService.SimulateFix:output_type -> lilbattle.v1.SimulateFixResponse
-	25, // 25: lilbattle.v1.GamesService.JoinGame:output_type -> lilbattle.v1.JoinGameResponse
-	13, // [13:26] is the sub-list for method output_type
-	0,  // [0:13] is the sub-list for method input_type
+	13, // 13: lilbattle.v1.GamesService.CommitOrders:input_type -> lilbattle.v1.CommitOrdersRequest
+	14, // 14: lilbattle.v1.GamesService.RevealOrders:input_type -> lilbattle.v1.RevealOrdersRequest
+	15, // 15: lilbattle.v1.GamesService.ListMyGames:input_type -> lilbattle.v1.ListMyGamesRequest
+	16, // 16: lilbattle.v1.GamesService.ExportGame:input_type -> lilbattle.v1.ExportGameRequest
+	17, // 17: lilbattle.v1.GamesService.ImportGame:input_type -> lilbattle.v1.ImportGameRequest
+	18, // 18: lilbattle.v1.GamesService.CreateGame:output_type -> lilbattle.v1.CreateGameResponse
+	19, // 19: lilbattle.v1.GamesService.GetGames:output_type -> lilbattle.v1.GetGamesResponse
+	20, // 20: lilbattle.v1.GamesService.ListGames:output_type -> lilbattle.v1.ListGamesResponse
+	21, // 21: lilbattle.v1.GamesService.GetGame:output_type -> lilbattle.v1.GetGameResponse
+	22, // 22: lilbattle.v1.GamesService.DeleteGame:output_type -> lilbattle.v1.DeleteGameResponse
+	23, // 23: lilbattle.v1.GamesService.UpdateGame:output_type -> lilbattle.v1.UpdateGameResponse
+	24, // 24: lilbattle.v1.GamesService.GetGameState:output_type -> lilbattle.v1.GetGameStateResponse
+	25, // 25: lilbattle.v1.GamesService.ListMoves:output_type -> lilbattle.v1.ListMovesResponse
+	26, // 26: lilbattle.v1.GamesService.ProcessMoves:output_type -> lilbattle.v1.ProcessMovesResponse
+	27, // 27: lilbattle.v1.GamesService.GetOptionsAt:output_type -> lilbattle.v1.GetOptionsAtResponse
+	28, // 28: lilbattle.v1.GamesService.SimulateAttack:output_type -> lilbattle.v1.SimulateAttackResponse
+	29, // 29: lilbattle.v1.GamesService.SimulateFix:output_type -> lilbattle.v1.SimulateFixResponse
+	30, // 30: lilbattle.v1.GamesService.JoinGame:output_type -> lilbattle.v1.JoinGameResponse
+	31, // 31: lilbattle.v1.GamesService.CommitOrders:output_type -> lilbattle.v1.CommitOrdersResponse
+	32, // 32: lilbattle.v1.GamesService.RevealOrders:output_type -> lilbattle.v1.RevealOrdersResponse
+	33, // 33: lilbattle.v1.GamesService.ListMyGames:output_type -> lilbattle.v1.ListMyGamesResponse
+	34, // 34: lilbattle.v1.GamesService.ExportGame:output_type -> lilbattle.v1.ExportGameResponse
+	35, // 35: lilbattle.v1.GamesService.ImportGame:output_type -> lilbattle.v1.ImportGameResponse
+	18, // [18:36] is the sub-list for method output_type
+	0,  // [0:18] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
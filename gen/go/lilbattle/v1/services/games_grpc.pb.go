@@ -34,6 +34,11 @@ const (
 	GamesService_SimulateAttack_FullMethodName = "/lilbattle.v1.GamesService/SimulateAttack"
 	GamesService_SimulateFix_FullMethodName    = "/lilbattle.v1.GamesService/SimulateFix"
 	GamesService_JoinGame_FullMethodName       = "/lilbattle.v1.GamesService/JoinGame"
+	GamesService_CommitOrders_FullMethodName   = "/lilbattle.v1.GamesService/CommitOrders"
+	GamesService_RevealOrders_FullMethodName   = "/lilbattle.v1.GamesService/RevealOrders"
+	GamesService_ListMyGames_FullMethodName    = "/lilbattle.v1.GamesService/ListMyGames"
+	GamesService_ExportGame_FullMethodName     = "/lilbattle.v1.GamesService/ExportGame"
+	GamesService_ImportGame_FullMethodName     = "/lilbattle.v1.GamesService/ImportGame"
 )
 
 // GamesServiceClient is the client API for GamesService service.
@@ -75,6 +80,26 @@ type GamesServiceClient interface {
 	// Join a game as an open player slot
 	// User must be authenticated. The player slot must be "open" to be joinable.
 	JoinGame(ctx context.Context, in *models.JoinGameRequest, opts ...grpc.CallOption) (*models.JoinGameResponse, error)
+	//*
+	// Commit a hash of the caller's orders for the current turn.
+	// Only valid for games in simultaneous turn mode.
+	CommitOrders(ctx context.Context, in *models.CommitOrdersRequest, opts ...grpc.CallOption) (*models.CommitOrdersResponse, error)
+	//*
+	// Reveal the caller's committed orders.  Once all players have revealed,
+	// the turn is resolved for everyone at once.
+	RevealOrders(ctx context.Context, in *models.RevealOrdersRequest, opts ...grpc.CallOption) (*models.RevealOrdersResponse, error)
+	//*
+	// List the games the caller holds a player slot in along with whose turn
+	// it is.  Served from the per-user game index.
+	ListMyGames(ctx context.Context, in *models.ListMyGamesRequest, opts ...grpc.CallOption) (*models.ListMyGamesResponse, error)
+	//*
+	// Export a game as a portable archive bundling its state, history, the
+	// world it started from and the rules it was played with
+	ExportGame(ctx context.Context, in *models.ExportGameRequest, opts ...grpc.CallOption) (*models.ExportGameResponse, error)
+	//*
+	// Import a game archive.  The archive's content hash is checked and its
+	// history replayed against the bundled rules before the game is saved.
+	ImportGame(ctx context.Context, in *models.ImportGameRequest, opts ...grpc.CallOption) (*models.ImportGameResponse, error)
 }
 
 type gamesServiceClient struct {
@@ -215,6 +240,56 @@ func (c *gamesServiceClient) JoinGame(ctx context.Context, in *models.JoinGameRe
 	return out, nil
 }
 
+func (c *gamesServiceClient) CommitOrders(ctx context.Context, in *models.CommitOrdersRequest, opts ...grpc.CallOption) (*models.CommitOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.CommitOrdersResponse)
+	err := c.cc.Invoke(ctx, GamesService_CommitOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) RevealOrders(ctx context.Context, in *models.RevealOrdersRequest, opts ...grpc.CallOption) (*models.RevealOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.RevealOrdersResponse)
+	err := c.cc.Invoke(ctx, GamesService_RevealOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) ListMyGames(ctx context.Context, in *models.ListMyGamesRequest, opts ...grpc.CallOption) (*models.ListMyGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListMyGamesResponse)
+	err := c.cc.Invoke(ctx, GamesService_ListMyGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) ExportGame(ctx context.Context, in *models.ExportGameRequest, opts ...grpc.CallOption) (*models.ExportGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ExportGameResponse)
+	err := c.cc.Invoke(ctx, GamesService_ExportGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) ImportGame(ctx context.Context, in *models.ImportGameRequest, opts ...grpc.CallOption) (*models.ImportGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ImportGameResponse)
+	err := c.cc.Invoke(ctx, GamesService_ImportGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GamesServiceServer is the server API for GamesService service.
 // All implementations should embed UnimplementedGamesServiceServer
 // for forward compatibility.
@@ -254,6 +329,26 @@ type GamesServiceServer interface {
 	// Join a game as an open player slot
 	// User must be authenticated. The player slot must be "open" to be joinable.
 	JoinGame(context.Context, *models.JoinGameRequest) (*models.JoinGameResponse, error)
+	//*
+	// Commit a hash of the caller's orders for the current turn.
+	// Only valid for games in simultaneous turn mode.
+	CommitOrders(context.Context, *models.CommitOrdersRequest) (*models.CommitOrdersResponse, error)
+	//*
+	// Reveal the caller's committed orders.  Once all players have revealed,
+	// the turn is resolved for everyone at once.
+	RevealOrders(context.Context, *models.RevealOrdersRequest) (*models.RevealOrdersResponse, error)
+	//*
+	// List the games the caller holds a player slot in along with whose turn
+	// it is.  Served from the per-user game index.
+	ListMyGames(context.Context, *models.ListMyGamesRequest) (*models.ListMyGamesResponse, error)
+	//*
+	// Export a game as a portable archive bundling its state, history, the
+	// world it started from and the rules it was played with
+	ExportGame(context.Context, *models.ExportGameRequest) (*models.ExportGameResponse, error)
+	//*
+	// Import a game archive.  The archive's content hash is checked and its
+	// history replayed against the bundled rules before the game is saved.
+	ImportGame(context.Context, *models.ImportGameRequest) (*models.ImportGameResponse, error)
 }
 
 // UnimplementedGamesServiceServer should be embedded to have
@@ -302,6 +397,21 @@ func (UnimplementedGamesServiceServer) SimulateFix(context.Context, *models.Simu
 func (UnimplementedGamesServiceServer) JoinGame(context.Context, *models.JoinGameRequest) (*models.JoinGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinGame not implemented")
 }
+func (UnimplementedGamesServiceServer) CommitOrders(context.Context, *models.CommitOrdersRequest) (*models.CommitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitOrders not implemented")
+}
+func (UnimplementedGamesServiceServer) RevealOrders(context.Context, *models.RevealOrdersRequest) (*models.RevealOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealOrders not implemented")
+}
+func (UnimplementedGamesServiceServer) ListMyGames(context.Context, *models.ListMyGamesRequest) (*models.ListMyGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyGames not implemented")
+}
+func (UnimplementedGamesServiceServer) ExportGame(context.Context, *models.ExportGameRequest) (*models.ExportGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGame not implemented")
+}
+func (UnimplementedGamesServiceServer) ImportGame(context.Context, *models.ImportGameRequest) (*models.ImportGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGame not implemented")
+}
 func (UnimplementedGamesServiceServer) testEmbeddedByValue() {}
 
 // UnsafeGamesServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -556,6 +666,96 @@ func _GamesService_JoinGame_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GamesService_CommitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.CommitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).CommitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_CommitOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).CommitOrders(ctx, req.(*models.CommitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_RevealOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.RevealOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).RevealOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_RevealOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).RevealOrders(ctx, req.(*models.RevealOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_ListMyGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListMyGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).ListMyGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_ListMyGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).ListMyGames(ctx, req.(*models.ListMyGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_ExportGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ExportGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).ExportGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_ExportGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).ExportGame(ctx, req.(*models.ExportGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_ImportGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ImportGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).ImportGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_ImportGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).ImportGame(ctx, req.(*models.ImportGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GamesService_ServiceDesc is the grpc.ServiceDesc for GamesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -615,6 +815,26 @@ var GamesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "JoinGame",
 			Handler:    _GamesService_JoinGame_Handler,
 		},
+		{
+			MethodName: "CommitOrders",
+			Handler:    _GamesService_CommitOrders_Handler,
+		},
+		{
+			MethodName: "RevealOrders",
+			Handler:    _GamesService_RevealOrders_Handler,
+		},
+		{
+			MethodName: "ListMyGames",
+			Handler:    _GamesService_ListMyGames_Handler,
+		},
+		{
+			MethodName: "ExportGame",
+			Handler:    _GamesService_ExportGame_Handler,
+		},
+		{
+			MethodName: "ImportGame",
+			Handler:    _GamesService_ImportGame_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lilbattle/v1/services/games.proto",
@@ -0,0 +1,81 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: lilbattle/v1/services/jobs.proto
+
+package lilbattlev1
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	models "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_lilbattle_v1_services_jobs_proto protoreflect.FileDescriptor
+
+const file_lilbattle_v1_services_jobs_proto_rawDesc = "" +
+	"\n" +
+	" lilbattle/v1/services/jobs.proto\x12\flilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1elilbattle/v1/models/jobs.proto2\xb1\x02\n" +
+	"\vJobsService\x12[\n" +
+	"\bListJobs\x12\x1d.lilbattle.v1.ListJobsRequest\x1a\x1e.lilbattle.v1.ListJobsResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/jobs\x12Z\n" +
+	"\x06GetJob\x12\x1b.lilbattle.v1.GetJobRequest\x1a\x1c.lilbattle.v1.GetJobResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/jobs/{id}\x12i\n" +
+	"\bRetryJob\x12\x1d.lilbattle.v1.RetryJobRequest\x1a\x1e.lilbattle.v1.RetryJobResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/jobs/{id}:retryB\xb7\x01\n" +
+	"\x10com.lilbattle.v1B\tJobsProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
+
+var file_lilbattle_v1_services_jobs_proto_goTypes = []any{
+	(*models.ListJobsRequest)(nil),  // 0: lilbattle.v1.ListJobsRequest
+	(*models.GetJobRequest)(nil),    // 1: lilbattle.v1.GetJobRequest
+	(*models.RetryJobRequest)(nil),  // 2: lilbattle.v1.RetryJobRequest
+	(*models.ListJobsResponse)(nil), // 3: lilbattle.v1.ListJobsResponse
+	(*models.GetJobResponse)(nil),   // 4: lilbattle.v1.GetJobResponse
+	(*models.RetryJobResponse)(nil), // 5: lilbattle.v1.RetryJobResponse
+}
+var file_lilbattle_v1_services_jobs_proto_depIdxs = []int32{
+	0, // 0: lilbattle.v1.JobsService.ListJobs:input_type -> lilbattle.v1.ListJobsRequest
+	1, // 1: lilbattle.v1.JobsService.GetJob:input_type -> lilbattle.v1.GetJobRequest
+	2, // 2: lilbattle.v1.JobsService.RetryJob:input_type -> lilbattle.v1.RetryJobRequest
+	3, // 3: lilbattle.v1.JobsService.ListJobs:output_type -> lilbattle.v1.ListJobsResponse
+	4, // 4: lilbattle.v1.JobsService.GetJob:output_type -> lilbattle.v1.GetJobResponse
+	5, // 5: lilbattle.v1.JobsService.RetryJob:output_type -> lilbattle.v1.RetryJobResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_lilbattle_v1_services_jobs_proto_init() }
+func file_lilbattle_v1_services_jobs_proto_init() {
+	if File_lilbattle_v1_services_jobs_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lilbattle_v1_services_jobs_proto_rawDesc), len(file_lilbattle_v1_services_jobs_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lilbattle_v1_services_jobs_proto_goTypes,
+		DependencyIndexes: file_lilbattle_v1_services_jobs_proto_depIdxs,
+	}.Build()
+	File_lilbattle_v1_services_jobs_proto = out.File
+	file_lilbattle_v1_services_jobs_proto_goTypes = nil
+	file_lilbattle_v1_services_jobs_proto_depIdxs = nil
+}
@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: lilbattle/v1/services/jobs.proto
+
+package lilbattlev1
+
+import (
+	context "context"
+
+	models "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	JobsService_ListJobs_FullMethodName = "/lilbattle.v1.JobsService/ListJobs"
+	JobsService_GetJob_FullMethodName   = "/lilbattle.v1.JobsService/GetJob"
+	JobsService_RetryJob_FullMethodName = "/lilbattle.v1.JobsService/RetryJob"
+)
+
+// JobsServiceClient is the client API for JobsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Inspects and manages the background jobs queue
+type JobsServiceClient interface {
+	//*
+	// List jobs, optionally by type and status
+	ListJobs(ctx context.Context, in *models.ListJobsRequest, opts ...grpc.CallOption) (*models.ListJobsResponse, error)
+	//*
+	// Get a job with its run history
+	GetJob(ctx context.Context, in *models.GetJobRequest, opts ...grpc.CallOption) (*models.GetJobResponse, error)
+	//*
+	// Run a job again now, with fresh retries
+	RetryJob(ctx context.Context, in *models.RetryJobRequest, opts ...grpc.CallOption) (*models.RetryJobResponse, error)
+}
+
+type jobsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobsServiceClient(cc grpc.ClientConnInterface) JobsServiceClient {
+	return &jobsServiceClient{cc}
+}
+
+func (c *jobsServiceClient) ListJobs(ctx context.Context, in *models.ListJobsRequest, opts ...grpc.CallOption) (*models.ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListJobsResponse)
+	err := c.cc.Invoke(ctx, JobsService_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobsServiceClient) GetJob(ctx context.Context, in *models.GetJobRequest, opts ...grpc.CallOption) (*models.GetJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.GetJobResponse)
+	err := c.cc.Invoke(ctx, JobsService_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobsServiceClient) RetryJob(ctx context.Context, in *models.RetryJobRequest, opts ...grpc.CallOption) (*models.RetryJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.RetryJobResponse)
+	err := c.cc.Invoke(ctx, JobsService_RetryJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobsServiceServer is the server API for JobsService service.
+// All implementations should embed UnimplementedJobsServiceServer
+// for forward compatibility.
+//
+// Inspects and manages the background jobs queue
+type JobsServiceServer interface {
+	//*
+	// List jobs, optionally by type and status
+	ListJobs(context.Context, *models.ListJobsRequest) (*models.ListJobsResponse, error)
+	//*
+	// Get a job with its run history
+	GetJob(context.Context, *models.GetJobRequest) (*models.GetJobResponse, error)
+	//*
+	// Run a job again now, with fresh retries
+	RetryJob(context.Context, *models.RetryJobRequest) (*models.RetryJobResponse, error)
+}
+
+// UnimplementedJobsServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJobsServiceServer struct{}
+
+func (UnimplementedJobsServiceServer) ListJobs(context.Context, *models.ListJobsRequest) (*models.ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedJobsServiceServer) GetJob(context.Context, *models.GetJobRequest) (*models.GetJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedJobsServiceServer) RetryJob(context.Context, *models.RetryJobRequest) (*models.RetryJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryJob not implemented")
+}
+func (UnimplementedJobsServiceServer) testEmbeddedByValue() {}
+
+// UnsafeJobsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobsServiceServer will
+// result in compilation errors.
+type UnsafeJobsServiceServer interface {
+	mustEmbedUnimplementedJobsServiceServer()
+}
+
+func RegisterJobsServiceServer(s grpc.ServiceRegistrar, srv JobsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedJobsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JobsService_ServiceDesc, srv)
+}
+
+func _JobsService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobsServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobsService_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobsServiceServer).ListJobs(ctx, req.(*models.ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobsService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobsServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobsService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobsServiceServer).GetJob(ctx, req.(*models.GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobsService_RetryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.RetryJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobsServiceServer).RetryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobsService_RetryJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobsServiceServer).RetryJob(ctx, req.(*models.RetryJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JobsService_ServiceDesc is the grpc.ServiceDesc for JobsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lilbattle.v1.JobsService",
+	HandlerType: (*JobsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListJobs",
+			Handler:    _JobsService_ListJobs_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _JobsService_GetJob_Handler,
+		},
+		{
+			MethodName: "RetryJob",
+			Handler:    _JobsService_RetryJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lilbattle/v1/services/jobs.proto",
+}
@@ -66,6 +66,19 @@ const (
 	GamesServiceSimulateFixProcedure = "/lilbattle.v1.GamesService/SimulateFix"
 	// GamesServiceJoinGameProcedure is the fully-qualified name of the GamesService's JoinGame RPC.
 	GamesServiceJoinGameProcedure = "/lilbattle.v1.GamesService/JoinGame"
+	// GamesServiceCommitOrdersProcedure is the fully-qualified name of the GamesService's CommitOrders
+	// RPC.
+	GamesServiceCommitOrdersProcedure = "/lilbattle.v1.GamesService/CommitOrders"
+	// GamesServiceRevealOrdersProcedure is the fully-qualified name of the GamesService's RevealOrders
+	// RPC.
+	GamesServiceRevealOrdersProcedure = "/lilbattle.v1.GamesService/RevealOrders"
+	// GamesServiceListMyGamesProcedure is the fully-qualified name of the GamesService's ListMyGames
+	// RPC.
+	GamesServiceListMyGamesProcedure = "/lilbattle.v1.GamesService/ListMyGames"
+	// GamesServiceExportGameProcedure is the fully-qualified name of the GamesService's ExportGame RPC.
+	GamesServiceExportGameProcedure = "/lilbattle.v1.GamesService/ExportGame"
+	// GamesServiceImportGameProcedure is the fully-qualified name of the GamesService's ImportGame RPC.
+	GamesServiceImportGameProcedure = "/lilbattle.v1.GamesService/ImportGame"
 )
 
 // GamesServiceClient is a client for the lilbattle.v1.GamesService service.
@@ -103,6 +116,26 @@ type GamesServiceClient interface {
 	// Join a game as an open player slot
 	// User must be authenticated. The player slot must be "open" to be joinable.
 	JoinGame(context.Context, *connect.Request[models.JoinGameRequest]) (*connect.Response[models.JoinGameResponse], error)
+	//*
+	// Commit a hash of the caller's orders for the current turn.
+	// Only valid for games in simultaneous turn mode.
+	CommitOrders(context.Context, *connect.Request[models.CommitOrdersRequest]) (*connect.Response[models.CommitOrdersResponse], error)
+	//*
+	// Reveal the caller's committed orders.  Once all players have revealed,
+	// the turn is resolved for everyone at once.
+	RevealOrders(context.Context, *connect.Request[models.RevealOrdersRequest]) (*connect.Response[models.RevealOrdersResponse], error)
+	//*
+	// List the games the caller holds a player slot in along with whose turn
+	// it is.  Served from the per-user game index.
+	ListMyGames(context.Context, *connect.Request[models.ListMyGamesRequest]) (*connect.Response[models.ListMyGamesResponse], error)
+	//*
+	// Export a game as a portable archive bundling its state, history, the
+	// world it started from and the rules it was played with
+	ExportGame(context.Context, *connect.Request[models.ExportGameRequest]) (*connect.Response[models.ExportGameResponse], error)
+	//*
+	// Import a game archive.  The archive's content hash is checked and its
+	// history replayed against the bundled rules before the game is saved.
+	ImportGame(context.Context, *connect.Request[models.ImportGameRequest]) (*connect.Response[models.ImportGameResponse], error)
 }
 
 // NewGamesServiceClient constructs a client for the lilbattle.v1.GamesService service. By default,
@@ -194,6 +227,36 @@ func NewGamesServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(gamesServiceMethods.ByName("JoinGame")),
 			connect.WithClientOptions(opts...),
 		),
+		commitOrders: connect.NewClient[models.CommitOrdersRequest, models.CommitOrdersResponse](
+			httpClient,
+			baseURL+GamesServiceCommitOrdersProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("CommitOrders")),
+			connect.WithClientOptions(opts...),
+		),
+		revealOrders: connect.NewClient[models.RevealOrdersRequest, models.RevealOrdersResponse](
+			httpClient,
+			baseURL+GamesServiceRevealOrdersProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("RevealOrders")),
+			connect.WithClientOptions(opts...),
+		),
+		listMyGames: connect.NewClient[models.ListMyGamesRequest, models.ListMyGamesResponse](
+			httpClient,
+			baseURL+GamesServiceListMyGamesProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("ListMyGames")),
+			connect.WithClientOptions(opts...),
+		),
+		exportGame: connect.NewClient[models.ExportGameRequest, models.ExportGameResponse](
+			httpClient,
+			baseURL+GamesServiceExportGameProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("ExportGame")),
+			connect.WithClientOptions(opts...),
+		),
+		importGame: connect.NewClient[models.ImportGameRequest, models.ImportGameResponse](
+			httpClient,
+			baseURL+GamesServiceImportGameProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("ImportGame")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -212,6 +275,11 @@ type gamesServiceClient struct {
 	simulateAttack *connect.Client[models.SimulateAttackRequest, models.SimulateAttackResponse]
 	simulateFix    *connect.Client[models.SimulateFixRequest, models.SimulateFixResponse]
 	joinGame       *connect.Client[models.JoinGameRequest, models.JoinGameResponse]
+	commitOrders   *connect.Client[models.CommitOrdersRequest, models.CommitOrdersResponse]
+	revealOrders   *connect.Client[models.RevealOrdersRequest, models.RevealOrdersResponse]
+	listMyGames    *connect.Client[models.ListMyGamesRequest, models.ListMyGamesResponse]
+	exportGame     *connect.Client[models.ExportGameRequest, models.ExportGameResponse]
+	importGame     *connect.Client[models.ImportGameRequest, models.ImportGameResponse]
 }
 
 // CreateGame calls lilbattle.v1.GamesService.CreateGame.
@@ -279,6 +347,31 @@ func (c *gamesServiceClient) JoinGame(ctx context.Context, req *connect.Request[
 	return c.joinGame.CallUnary(ctx, req)
 }
 
+// CommitOrders calls lilbattle.v1.GamesService.CommitOrders.
+func (c *gamesServiceClient) CommitOrders(ctx context.Context, req *connect.Request[models.CommitOrdersRequest]) (*connect.Response[models.CommitOrdersResponse], error) {
+	return c.commitOrders.CallUnary(ctx, req)
+}
+
+// RevealOrders calls lilbattle.v1.GamesService.RevealOrders.
+func (c *gamesServiceClient) RevealOrders(ctx context.Context, req *connect.Request[models.RevealOrdersRequest]) (*connect.Response[models.RevealOrdersResponse], error) {
+	return c.revealOrders.CallUnary(ctx, req)
+}
+
+// ListMyGames calls lilbattle.v1.GamesService.ListMyGames.
+func (c *gamesServiceClient) ListMyGames(ctx context.Context, req *connect.Request[models.ListMyGamesRequest]) (*connect.Response[models.ListMyGamesResponse], error) {
+	return c.listMyGames.CallUnary(ctx, req)
+}
+
+// ExportGame calls lilbattle.v1.GamesService.ExportGame.
+func (c *gamesServiceClient) ExportGame(ctx context.Context, req *connect.Request[models.ExportGameRequest]) (*connect.Response[models.ExportGameResponse], error) {
+	return c.exportGame.CallUnary(ctx, req)
+}
+
+// ImportGame calls lilbattle.v1.GamesService.ImportGame.
+func (c *gamesServiceClient) ImportGame(ctx context.Context, req *connect.Request[models.ImportGameRequest]) (*connect.Response[models.ImportGameResponse], error) {
+	return c.importGame.CallUnary(ctx, req)
+}
+
 // GamesServiceHandler is an implementation of the lilbattle.v1.GamesService service.
 type GamesServiceHandler interface {
 	// *
@@ -314,6 +407,26 @@ type GamesServiceHandler interface {
 	// Join a game as an open player slot
 	// User must be authenticated. The player slot must be "open" to be joinable.
 	JoinGame(context.Context, *connect.Request[models.JoinGameRequest]) (*connect.Response[models.JoinGameResponse], error)
+	//*
+	// Commit a hash of the caller's orders for the current turn.
+	// Only valid for games in simultaneous turn mode.
+	CommitOrders(context.Context, *connect.Request[models.CommitOrdersRequest]) (*connect.Response[models.CommitOrdersResponse], error)
+	//*
+	// Reveal the caller's committed orders.  Once all players have revealed,
+	// the turn is resolved for everyone at once.
+	RevealOrders(context.Context, *connect.Request[models.RevealOrdersRequest]) (*connect.Response[models.RevealOrdersResponse], error)
+	//*
+	// List the games the caller holds a player slot in along with whose turn
+	// it is.  Served from the per-user game index.
+	ListMyGames(context.Context, *connect.Request[models.ListMyGamesRequest]) (*connect.Response[models.ListMyGamesResponse], error)
+	//*
+	// Export a game as a portable archive bundling its state, history, the
+	// world it started from and the rules it was played with
+	ExportGame(context.Context, *connect.Request[models.ExportGameRequest]) (*connect.Response[models.ExportGameResponse], error)
+	//*
+	// Import a game archive.  The archive's content hash is checked and its
+	// history replayed against the bundled rules before the game is saved.
+	ImportGame(context.Context, *connect.Request[models.ImportGameRequest]) (*connect.Response[models.ImportGameResponse], error)
 }
 
 // NewGamesServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -401,6 +514,36 @@ func NewGamesServiceHandler(svc GamesServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(gamesServiceMethods.ByName("JoinGame")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gamesServiceCommitOrdersHandler := connect.NewUnaryHandler(
+		GamesServiceCommitOrdersProcedure,
+		svc.CommitOrders,
+		connect.WithSchema(gamesServiceMethods.ByName("CommitOrders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceRevealOrdersHandler := connect.NewUnaryHandler(
+		GamesServiceRevealOrdersProcedure,
+		svc.RevealOrders,
+		connect.WithSchema(gamesServiceMethods.ByName("RevealOrders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceListMyGamesHandler := connect.NewUnaryHandler(
+		GamesServiceListMyGamesProcedure,
+		svc.ListMyGames,
+		connect.WithSchema(gamesServiceMethods.ByName("ListMyGames")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceExportGameHandler := connect.NewUnaryHandler(
+		GamesServiceExportGameProcedure,
+		svc.ExportGame,
+		connect.WithSchema(gamesServiceMethods.ByName("ExportGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceImportGameHandler := connect.NewUnaryHandler(
+		GamesServiceImportGameProcedure,
+		svc.ImportGame,
+		connect.WithSchema(gamesServiceMethods.ByName("ImportGame")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/lilbattle.v1.GamesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GamesServiceCreateGameProcedure:
@@ -429,6 +572,16 @@ func NewGamesServiceHandler(svc GamesServiceHandler, opts ...connect.HandlerOpti
 			gamesServiceSimulateFixHandler.ServeHTTP(w, r)
 		case GamesServiceJoinGameProcedure:
 			gamesServiceJoinGameHandler.ServeHTTP(w, r)
+		case GamesServiceCommitOrdersProcedure:
+			gamesServiceCommitOrdersHandler.ServeHTTP(w, r)
+		case GamesServiceRevealOrdersProcedure:
+			gamesServiceRevealOrdersHandler.ServeHTTP(w, r)
+		case GamesServiceListMyGamesProcedure:
+			gamesServiceListMyGamesHandler.ServeHTTP(w, r)
+		case GamesServiceExportGameProcedure:
+			gamesServiceExportGameHandler.ServeHTTP(w, r)
+		case GamesServiceImportGameProcedure:
+			gamesServiceImportGameHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -489,3 +642,23 @@ func (UnimplementedGamesServiceHandler) SimulateFix(context.Context, *connect.Re
 func (UnimplementedGamesServiceHandler) JoinGame(context.Context, *connect.Request[models.JoinGameRequest]) (*connect.Response[models.JoinGameResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.JoinGame is not implemented"))
 }
+
+func (UnimplementedGamesServiceHandler) CommitOrders(context.Context, *connect.Request[models.CommitOrdersRequest]) (*connect.Response[models.CommitOrdersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.CommitOrders is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) RevealOrders(context.Context, *connect.Request[models.RevealOrdersRequest]) (*connect.Response[models.RevealOrdersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.RevealOrders is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) ListMyGames(context.Context, *connect.Request[models.ListMyGamesRequest]) (*connect.Response[models.ListMyGamesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.ListMyGames is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) ExportGame(context.Context, *connect.Request[models.ExportGameRequest]) (*connect.Response[models.ExportGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.ExportGame is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) ImportGame(context.Context, *connect.Request[models.ImportGameRequest]) (*connect.Response[models.ImportGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.GamesService.ImportGame is not implemented"))
+}
@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lilbattle/v1/services/jobs.proto
+
+package lilbattlev1connect
+
+import (
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+
+	connect "connectrpc.com/connect"
+	models "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	services "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// JobsServiceName is the fully-qualified name of the JobsService service.
+	JobsServiceName = "lilbattle.v1.JobsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// JobsServiceListJobsProcedure is the fully-qualified name of the JobsService's ListJobs RPC.
+	JobsServiceListJobsProcedure = "/lilbattle.v1.JobsService/ListJobs"
+	// JobsServiceGetJobProcedure is the fully-qualified name of the JobsService's GetJob RPC.
+	JobsServiceGetJobProcedure = "/lilbattle.v1.JobsService/GetJob"
+	// JobsServiceRetryJobProcedure is the fully-qualified name of the JobsService's RetryJob RPC.
+	JobsServiceRetryJobProcedure = "/lilbattle.v1.JobsService/RetryJob"
+)
+
+// JobsServiceClient is a client for the lilbattle.v1.JobsService service.
+type JobsServiceClient interface {
+	//*
+	// List jobs, optionally by type and status
+	ListJobs(context.Context, *connect.Request[models.ListJobsRequest]) (*connect.Response[models.ListJobsResponse], error)
+	//*
+	// Get a job with its run history
+	GetJob(context.Context, *connect.Request[models.GetJobRequest]) (*connect.Response[models.GetJobResponse], error)
+	//*
+	// Run a job again now, with fresh retries
+	RetryJob(context.Context, *connect.Request[models.RetryJobRequest]) (*connect.Response[models.RetryJobResponse], error)
+}
+
+// NewJobsServiceClient constructs a client for the lilbattle.v1.JobsService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewJobsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) JobsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	jobsServiceMethods := services.File_lilbattle_v1_services_jobs_proto.Services().ByName("JobsService").Methods()
+	return &jobsServiceClient{
+		listJobs: connect.NewClient[models.ListJobsRequest, models.ListJobsResponse](
+			httpClient,
+			baseURL+JobsServiceListJobsProcedure,
+			connect.WithSchema(jobsServiceMethods.ByName("ListJobs")),
+			connect.WithClientOptions(opts...),
+		),
+		getJob: connect.NewClient[models.GetJobRequest, models.GetJobResponse](
+			httpClient,
+			baseURL+JobsServiceGetJobProcedure,
+			connect.WithSchema(jobsServiceMethods.ByName("GetJob")),
+			connect.WithClientOptions(opts...),
+		),
+		retryJob: connect.NewClient[models.RetryJobRequest, models.RetryJobResponse](
+			httpClient,
+			baseURL+JobsServiceRetryJobProcedure,
+			connect.WithSchema(jobsServiceMethods.ByName("RetryJob")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// jobsServiceClient implements JobsServiceClient.
+type jobsServiceClient struct {
+	listJobs *connect.Client[models.ListJobsRequest, models.ListJobsResponse]
+	getJob   *connect.Client[models.GetJobRequest, models.GetJobResponse]
+	retryJob *connect.Client[models.RetryJobRequest, models.RetryJobResponse]
+}
+
+// ListJobs calls lilbattle.v1.JobsService.ListJobs.
+func (c *jobsServiceClient) ListJobs(ctx context.Context, req *connect.Request[models.ListJobsRequest]) (*connect.Response[models.ListJobsResponse], error) {
+	return c.listJobs.CallUnary(ctx, req)
+}
+
+// GetJob calls lilbattle.v1.JobsService.GetJob.
+func (c *jobsServiceClient) GetJob(ctx context.Context, req *connect.Request[models.GetJobRequest]) (*connect.Response[models.GetJobResponse], error) {
+	return c.getJob.CallUnary(ctx, req)
+}
+
+// RetryJob calls lilbattle.v1.JobsService.RetryJob.
+func (c *jobsServiceClient) RetryJob(ctx context.Context, req *connect.Request[models.RetryJobRequest]) (*connect.Response[models.RetryJobResponse], error) {
+	return c.retryJob.CallUnary(ctx, req)
+}
+
+// JobsServiceHandler is an implementation of the lilbattle.v1.JobsService service.
+type JobsServiceHandler interface {
+	//*
+	// List jobs, optionally by type and status
+	ListJobs(context.Context, *connect.Request[models.ListJobsRequest]) (*connect.Response[models.ListJobsResponse], error)
+	//*
+	// Get a job with its run history
+	GetJob(context.Context, *connect.Request[models.GetJobRequest]) (*connect.Response[models.GetJobResponse], error)
+	//*
+	// Run a job again now, with fresh retries
+	RetryJob(context.Context, *connect.Request[models.RetryJobRequest]) (*connect.Response[models.RetryJobResponse], error)
+}
+
+// NewJobsServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewJobsServiceHandler(svc JobsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	jobsServiceMethods := services.File_lilbattle_v1_services_jobs_proto.Services().ByName("JobsService").Methods()
+	jobsServiceListJobsHandler := connect.NewUnaryHandler(
+		JobsServiceListJobsProcedure,
+		svc.ListJobs,
+		connect.WithSchema(jobsServiceMethods.ByName("ListJobs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobsServiceGetJobHandler := connect.NewUnaryHandler(
+		JobsServiceGetJobProcedure,
+		svc.GetJob,
+		connect.WithSchema(jobsServiceMethods.ByName("GetJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobsServiceRetryJobHandler := connect.NewUnaryHandler(
+		JobsServiceRetryJobProcedure,
+		svc.RetryJob,
+		connect.WithSchema(jobsServiceMethods.ByName("RetryJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lilbattle.v1.JobsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case JobsServiceListJobsProcedure:
+			jobsServiceListJobsHandler.ServeHTTP(w, r)
+		case JobsServiceGetJobProcedure:
+			jobsServiceGetJobHandler.ServeHTTP(w, r)
+		case JobsServiceRetryJobProcedure:
+			jobsServiceRetryJobHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedJobsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedJobsServiceHandler struct{}
+
+func (UnimplementedJobsServiceHandler) ListJobs(context.Context, *connect.Request[models.ListJobsRequest]) (*connect.Response[models.ListJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.JobsService.ListJobs is not implemented"))
+}
+
+func (UnimplementedJobsServiceHandler) GetJob(context.Context, *connect.Request[models.GetJobRequest]) (*connect.Response[models.GetJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.JobsService.GetJob is not implemented"))
+}
+
+func (UnimplementedJobsServiceHandler) RetryJob(context.Context, *connect.Request[models.RetryJobRequest]) (*connect.Response[models.RetryJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.JobsService.RetryJob is not implemented"))
+}
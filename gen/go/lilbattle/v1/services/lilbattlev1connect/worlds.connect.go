@@ -51,6 +51,21 @@ const (
 	// WorldsServiceUpdateWorldProcedure is the fully-qualified name of the WorldsService's UpdateWorld
 	// RPC.
 	WorldsServiceUpdateWorldProcedure = "/lilbattle.v1.WorldsService/UpdateWorld"
+	// WorldsServiceListWorldRevisionsProcedure is the fully-qualified name of the WorldsService's
+	// ListWorldRevisions RPC.
+	WorldsServiceListWorldRevisionsProcedure = "/lilbattle.v1.WorldsService/ListWorldRevisions"
+	// WorldsServiceRevertWorldProcedure is the fully-qualified name of the WorldsService's RevertWorld
+	// RPC.
+	WorldsServiceRevertWorldProcedure = "/lilbattle.v1.WorldsService/RevertWorld"
+	// WorldsServiceListDerivedWorldsProcedure is the fully-qualified name of the WorldsService's
+	// ListDerivedWorlds RPC.
+	WorldsServiceListDerivedWorldsProcedure = "/lilbattle.v1.WorldsService/ListDerivedWorlds"
+	// WorldsServiceSearchWorldsProcedure is the fully-qualified name of the WorldsService's
+	// SearchWorlds RPC.
+	WorldsServiceSearchWorldsProcedure = "/lilbattle.v1.WorldsService/SearchWorlds"
+	// WorldsServiceMergeWorldUpstreamProcedure is the fully-qualified name of the WorldsService's
+	// MergeWorldUpstream RPC.
+	WorldsServiceMergeWorldUpstreamProcedure = "/lilbattle.v1.WorldsService/MergeWorldUpstream"
 )
 
 // WorldsServiceClient is a client for the lilbattle.v1.WorldsService service.
@@ -70,6 +85,23 @@ type WorldsServiceClient interface {
 	DeleteWorld(context.Context, *connect.Request[models.DeleteWorldRequest]) (*connect.Response[models.DeleteWorldResponse], error)
 	// GetWorld returns a specific world with metadata
 	UpdateWorld(context.Context, *connect.Request[models.UpdateWorldRequest]) (*connect.Response[models.UpdateWorldResponse], error)
+	// ListWorldRevisions returns a world's immutable revisions, newest first
+	ListWorldRevisions(context.Context, *connect.Request[models.ListWorldRevisionsRequest]) (*connect.Response[models.ListWorldRevisionsResponse], error)
+	//*
+	// Revert a world to an older revision.  The old data is saved as a new
+	// revision rather than discarding the ones after it.
+	RevertWorld(context.Context, *connect.Request[models.RevertWorldRequest]) (*connect.Response[models.RevertWorldResponse], error)
+	// ListDerivedWorlds returns the worlds copied (remixed) from a world
+	ListDerivedWorlds(context.Context, *connect.Request[models.ListDerivedWorldsRequest]) (*connect.Response[models.ListDerivedWorldsResponse], error)
+	//*
+	// Search worlds by free text, tags, difficulty, player count and map
+	// size.  Results come from the world search index so recent changes show
+	// up once the world is reindexed.
+	SearchWorlds(context.Context, *connect.Request[models.SearchWorldsRequest]) (*connect.Response[models.SearchWorldsResponse], error)
+	//*
+	// Merge the changes made to a world's parent since it was copied into
+	// the world, with a three-way merge of tiles, units and crossings.
+	MergeWorldUpstream(context.Context, *connect.Request[models.MergeWorldUpstreamRequest]) (*connect.Response[models.MergeWorldUpstreamResponse], error)
 }
 
 // NewWorldsServiceClient constructs a client for the lilbattle.v1.WorldsService service. By
@@ -119,17 +151,52 @@ func NewWorldsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(worldsServiceMethods.ByName("UpdateWorld")),
 			connect.WithClientOptions(opts...),
 		),
+		listWorldRevisions: connect.NewClient[models.ListWorldRevisionsRequest, models.ListWorldRevisionsResponse](
+			httpClient,
+			baseURL+WorldsServiceListWorldRevisionsProcedure,
+			connect.WithSchema(worldsServiceMethods.ByName("ListWorldRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		revertWorld: connect.NewClient[models.RevertWorldRequest, models.RevertWorldResponse](
+			httpClient,
+			baseURL+WorldsServiceRevertWorldProcedure,
+			connect.WithSchema(worldsServiceMethods.ByName("RevertWorld")),
+			connect.WithClientOptions(opts...),
+		),
+		listDerivedWorlds: connect.NewClient[models.ListDerivedWorldsRequest, models.ListDerivedWorldsResponse](
+			httpClient,
+			baseURL+WorldsServiceListDerivedWorldsProcedure,
+			connect.WithSchema(worldsServiceMethods.ByName("ListDerivedWorlds")),
+			connect.WithClientOptions(opts...),
+		),
+		searchWorlds: connect.NewClient[models.SearchWorldsRequest, models.SearchWorldsResponse](
+			httpClient,
+			baseURL+WorldsServiceSearchWorldsProcedure,
+			connect.WithSchema(worldsServiceMethods.ByName("SearchWorlds")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeWorldUpstream: connect.NewClient[models.MergeWorldUpstreamRequest, models.MergeWorldUpstreamResponse](
+			httpClient,
+			baseURL+WorldsServiceMergeWorldUpstreamProcedure,
+			connect.WithSchema(worldsServiceMethods.ByName("MergeWorldUpstream")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // worldsServiceClient implements WorldsServiceClient.
 type worldsServiceClient struct {
-	createWorld *connect.Client[models.CreateWorldRequest, models.CreateWorldResponse]
-	getWorlds   *connect.Client[models.GetWorldsRequest, models.GetWorldsResponse]
-	listWorlds  *connect.Client[models.ListWorldsRequest, models.ListWorldsResponse]
-	getWorld    *connect.Client[models.GetWorldRequest, models.GetWorldResponse]
-	deleteWorld *connect.Client[models.DeleteWorldRequest, models.DeleteWorldResponse]
-	updateWorld *connect.Client[models.UpdateWorldRequest, models.UpdateWorldResponse]
+	createWorld        *connect.Client[models.CreateWorldRequest, models.CreateWorldResponse]
+	getWorlds          *connect.Client[models.GetWorldsRequest, models.GetWorldsResponse]
+	listWorlds         *connect.Client[models.ListWorldsRequest, models.ListWorldsResponse]
+	getWorld           *connect.Client[models.GetWorldRequest, models.GetWorldResponse]
+	deleteWorld        *connect.Client[models.DeleteWorldRequest, models.DeleteWorldResponse]
+	updateWorld        *connect.Client[models.UpdateWorldRequest, models.UpdateWorldResponse]
+	listWorldRevisions *connect.Client[models.ListWorldRevisionsRequest, models.ListWorldRevisionsResponse]
+	revertWorld        *connect.Client[models.RevertWorldRequest, models.RevertWorldResponse]
+	listDerivedWorlds  *connect.Client[models.ListDerivedWorldsRequest, models.ListDerivedWorldsResponse]
+	searchWorlds       *connect.Client[models.SearchWorldsRequest, models.SearchWorldsResponse]
+	mergeWorldUpstream *connect.Client[models.MergeWorldUpstreamRequest, models.MergeWorldUpstreamResponse]
 }
 
 // CreateWorld calls lilbattle.v1.WorldsService.CreateWorld.
@@ -162,6 +229,31 @@ func (c *worldsServiceClient) UpdateWorld(ctx context.Context, req *connect.Requ
 	return c.updateWorld.CallUnary(ctx, req)
 }
 
+// ListWorldRevisions calls lilbattle.v1.WorldsService.ListWorldRevisions.
+func (c *worldsServiceClient) ListWorldRevisions(ctx context.Context, req *connect.Request[models.ListWorldRevisionsRequest]) (*connect.Response[models.ListWorldRevisionsResponse], error) {
+	return c.listWorldRevisions.CallUnary(ctx, req)
+}
+
+// RevertWorld calls lilbattle.v1.WorldsService.RevertWorld.
+func (c *worldsServiceClient) RevertWorld(ctx context.Context, req *connect.Request[models.RevertWorldRequest]) (*connect.Response[models.RevertWorldResponse], error) {
+	return c.revertWorld.CallUnary(ctx, req)
+}
+
+// ListDerivedWorlds calls lilbattle.v1.WorldsService.ListDerivedWorlds.
+func (c *worldsServiceClient) ListDerivedWorlds(ctx context.Context, req *connect.Request[models.ListDerivedWorldsRequest]) (*connect.Response[models.ListDerivedWorldsResponse], error) {
+	return c.listDerivedWorlds.CallUnary(ctx, req)
+}
+
+// SearchWorlds calls lilbattle.v1.WorldsService.SearchWorlds.
+func (c *worldsServiceClient) SearchWorlds(ctx context.Context, req *connect.Request[models.SearchWorldsRequest]) (*connect.Response[models.SearchWorldsResponse], error) {
+	return c.searchWorlds.CallUnary(ctx, req)
+}
+
+// MergeWorldUpstream calls lilbattle.v1.WorldsService.MergeWorldUpstream.
+func (c *worldsServiceClient) MergeWorldUpstream(ctx context.Context, req *connect.Request[models.MergeWorldUpstreamRequest]) (*connect.Response[models.MergeWorldUpstreamResponse], error) {
+	return c.mergeWorldUpstream.CallUnary(ctx, req)
+}
+
 // WorldsServiceHandler is an implementation of the lilbattle.v1.WorldsService service.
 type WorldsServiceHandler interface {
 	// *
@@ -179,6 +271,23 @@ type WorldsServiceHandler interface {
 	DeleteWorld(context.Context, *connect.Request[models.DeleteWorldRequest]) (*connect.Response[models.DeleteWorldResponse], error)
 	// GetWorld returns a specific world with metadata
 	UpdateWorld(context.Context, *connect.Request[models.UpdateWorldRequest]) (*connect.Response[models.UpdateWorldResponse], error)
+	// ListWorldRevisions returns a world's immutable revisions, newest first
+	ListWorldRevisions(context.Context, *connect.Request[models.ListWorldRevisionsRequest]) (*connect.Response[models.ListWorldRevisionsResponse], error)
+	//*
+	// Revert a world to an older revision.  The old data is saved as a new
+	// revision rather than discarding the ones after it.
+	RevertWorld(context.Context, *connect.Request[models.RevertWorldRequest]) (*connect.Response[models.RevertWorldResponse], error)
+	// ListDerivedWorlds returns the worlds copied (remixed) from a world
+	ListDerivedWorlds(context.Context, *connect.Request[models.ListDerivedWorldsRequest]) (*connect.Response[models.ListDerivedWorldsResponse], error)
+	//*
+	// Search worlds by free text, tags, difficulty, player count and map
+	// size.  Results come from the world search index so recent changes show
+	// up once the world is reindexed.
+	SearchWorlds(context.Context, *connect.Request[models.SearchWorldsRequest]) (*connect.Response[models.SearchWorldsResponse], error)
+	//*
+	// Merge the changes made to a world's parent since it was copied into
+	// the world, with a three-way merge of tiles, units and crossings.
+	MergeWorldUpstream(context.Context, *connect.Request[models.MergeWorldUpstreamRequest]) (*connect.Response[models.MergeWorldUpstreamResponse], error)
 }
 
 // NewWorldsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -224,6 +333,36 @@ func NewWorldsServiceHandler(svc WorldsServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(worldsServiceMethods.ByName("UpdateWorld")),
 		connect.WithHandlerOptions(opts...),
 	)
+	worldsServiceListWorldRevisionsHandler := connect.NewUnaryHandler(
+		WorldsServiceListWorldRevisionsProcedure,
+		svc.ListWorldRevisions,
+		connect.WithSchema(worldsServiceMethods.ByName("ListWorldRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	worldsServiceRevertWorldHandler := connect.NewUnaryHandler(
+		WorldsServiceRevertWorldProcedure,
+		svc.RevertWorld,
+		connect.WithSchema(worldsServiceMethods.ByName("RevertWorld")),
+		connect.WithHandlerOptions(opts...),
+	)
+	worldsServiceListDerivedWorldsHandler := connect.NewUnaryHandler(
+		WorldsServiceListDerivedWorldsProcedure,
+		svc.ListDerivedWorlds,
+		connect.WithSchema(worldsServiceMethods.ByName("ListDerivedWorlds")),
+		connect.WithHandlerOptions(opts...),
+	)
+	worldsServiceSearchWorldsHandler := connect.NewUnaryHandler(
+		WorldsServiceSearchWorldsProcedure,
+		svc.SearchWorlds,
+		connect.WithSchema(worldsServiceMethods.ByName("SearchWorlds")),
+		connect.WithHandlerOptions(opts...),
+	)
+	worldsServiceMergeWorldUpstreamHandler := connect.NewUnaryHandler(
+		WorldsServiceMergeWorldUpstreamProcedure,
+		svc.MergeWorldUpstream,
+		connect.WithSchema(worldsServiceMethods.ByName("MergeWorldUpstream")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/lilbattle.v1.WorldsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case WorldsServiceCreateWorldProcedure:
@@ -238,6 +377,16 @@ func NewWorldsServiceHandler(svc WorldsServiceHandler, opts ...connect.HandlerOp
 			worldsServiceDeleteWorldHandler.ServeHTTP(w, r)
 		case WorldsServiceUpdateWorldProcedure:
 			worldsServiceUpdateWorldHandler.ServeHTTP(w, r)
+		case WorldsServiceListWorldRevisionsProcedure:
+			worldsServiceListWorldRevisionsHandler.ServeHTTP(w, r)
+		case WorldsServiceRevertWorldProcedure:
+			worldsServiceRevertWorldHandler.ServeHTTP(w, r)
+		case WorldsServiceListDerivedWorldsProcedure:
+			worldsServiceListDerivedWorldsHandler.ServeHTTP(w, r)
+		case WorldsServiceSearchWorldsProcedure:
+			worldsServiceSearchWorldsHandler.ServeHTTP(w, r)
+		case WorldsServiceMergeWorldUpstreamProcedure:
+			worldsServiceMergeWorldUpstreamHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -270,3 +419,23 @@ func (UnimplementedWorldsServiceHandler) DeleteWorld(context.Context, *connect.R
 func (UnimplementedWorldsServiceHandler) UpdateWorld(context.Context, *connect.Request[models.UpdateWorldRequest]) (*connect.Response[models.UpdateWorldResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.WorldsService.UpdateWorld is not implemented"))
 }
+
+func (UnimplementedWorldsServiceHandler) ListWorldRevisions(context.Context, *connect.Request[models.ListWorldRevisionsRequest]) (*connect.Response[models.ListWorldRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.WorldsService.ListWorldRevisions is not implemented"))
+}
+
+func (UnimplementedWorldsServiceHandler) RevertWorld(context.Context, *connect.Request[models.RevertWorldRequest]) (*connect.Response[models.RevertWorldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.WorldsService.RevertWorld is not implemented"))
+}
+
+func (UnimplementedWorldsServiceHandler) ListDerivedWorlds(context.Context, *connect.Request[models.ListDerivedWorldsRequest]) (*connect.Response[models.ListDerivedWorldsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.WorldsService.ListDerivedWorlds is not implemented"))
+}
+
+func (UnimplementedWorldsServiceHandler) SearchWorlds(context.Context, *connect.Request[models.SearchWorldsRequest]) (*connect.Response[models.SearchWorldsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.WorldsService.SearchWorlds is not implemented"))
+}
+
+func (UnimplementedWorldsServiceHandler) MergeWorldUpstream(context.Context, *connect.Request[models.MergeWorldUpstreamRequest]) (*connect.Response[models.MergeWorldUpstreamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lilbattle.v1.WorldsService.MergeWorldUpstream is not implemented"))
+}
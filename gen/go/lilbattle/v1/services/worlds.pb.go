@@ -29,7 +29,8 @@ var File_lilbattle_v1_services_worlds_proto protoreflect.FileDescriptor
 
 const file_lilbattle_v1_services_worlds_proto_rawDesc = "" +
 	"\n" +
-	"\"lilbattle/v1/services/worlds.proto\x12\flilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a'lilbattle/v1/models/world_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x95\x05\n" +
+	"\"lilbattle/v1/services/worlds.proto\x12\flilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a'lilbattle/v1/models/world_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xbf\n" +
+	"\n" +
 	"\rWorldsService\x12i\n" +
 	"\vCreateWorld\x12 .lilbattle.v1.CreateWorldRequest\x1a!.lilbattle.v1.CreateWorldResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v1/worlds\x12i\n" +
@@ -39,22 +40,37 @@ const file_lilbattle_v1_services_worlds_proto_rawDesc = "" +
 	"/v1/worlds\x12b\n" +
 	"\bGetWorld\x12\x1d.lilbattle.v1.GetWorldRequest\x1a\x1e.lilbattle.v1.GetWorldResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/worlds/{id}\x12m\n" +
 	"\vDeleteWorld\x12 .lilbattle.v1.DeleteWorldRequest\x1a!.lilbattle.v1.DeleteWorldResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/worlds/{id=*}\x12v\n" +
-	"\vUpdateWorld\x12 .lilbattle.v1.UpdateWorldRequest\x1a!.lilbattle.v1.UpdateWorldResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/worlds/{world.id=*}B\xb9\x01\n" +
+	"\vUpdateWorld\x12 .lilbattle.v1.UpdateWorldRequest\x1a!.lilbattle.v1.UpdateWorldResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/worlds/{world.id=*}\x12\x90\x01\n" +
+	"\x12ListWorldRevisions\x12'.lilbattle.v1.ListWorldRevisionsRequest\x1a(.lilbattle.v1.ListWorldRevisionsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/worlds/{world_id}/revisions\x12{\n" +
+	"\vRevertWorld\x12 .lilbattle.v1.RevertWorldRequest\x1a!.lilbattle.v1.RevertWorldResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/worlds/{world_id}:revert\x12\x8b\x01\n" +
+	"\x11ListDerivedWorlds\x12&.lilbattle.v1.ListDerivedWorldsRequest\x1a'.lilbattle.v1.ListDerivedWorldsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/worlds/{world_id}/derived\x12p\n" +
+	"\fSearchWorlds\x12!.lilbattle.v1.SearchWorldsRequest\x1a\".lilbattle.v1.SearchWorldsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/worlds:search\x12\x97\x01\n" +
+	"\x12MergeWorldUpstream\x12'.lilbattle.v1.MergeWorldUpstreamRequest\x1a(.lilbattle.v1.MergeWorldUpstreamResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/worlds/{world_id}:mergeUpstreamB\xb9\x01\n" +
 	"\x10com.lilbattle.v1B\vWorldsProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\fLilbattle.V1\xca\x02\fLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3"
 
 var file_lilbattle_v1_services_worlds_proto_goTypes = []any{
-	(*models.CreateWorldRequest)(nil),  // 0: lilbattle.v1.CreateWorldRequest
-	(*models.GetWorldsRequest)(nil),    // 1: lilbattle.v1.GetWorldsRequest
-	(*models.ListWorldsRequest)(nil),   // 2: lilbattle.v1.ListWorldsRequest
-	(*models.GetWorldRequest)(nil),     // 3: lilbattle.v1.GetWorldRequest
-	(*models.DeleteWorldRequest)(nil),  // 4: lilbattle.v1.DeleteWorldRequest
-	(*models.UpdateWorldRequest)(nil),  // 5: lilbattle.v1.UpdateWorldRequest
-	(*models.CreateWorldResponse)(nil), // 6: lilbattle.v1.CreateWorldResponse
-	(*models.GetWorldsResponse)(nil),   // 7: lilbattle.v1.GetWorldsResponse
-	(*models.ListWorldsResponse)(nil),  // 8: lilbattle.v1.ListWorldsResponse
-	(*models.GetWorldResponse)(nil),    // 9: lilbattle.v1.GetWorldResponse
-	(*models.DeleteWorldResponse)(nil), // 10: lilbattle.v1.DeleteWorldResponse
-	(*models.UpdateWorldResponse)(nil), // 11: lilbattle.v1.UpdateWorldResponse
+	(*models.CreateWorldRequest)(nil),         // 0: lilbattle.v1.CreateWorldRequest
+	(*models.GetWorldsRequest)(nil),           // 1: lilbattle.v1.GetWorldsRequest
+	(*models.ListWorldsRequest)(nil),          // 2: lilbattle.v1.ListWorldsRequest
+	(*models.GetWorldRequest)(nil),            // 3: lilbattle.v1.GetWorldRequest
+	(*models.DeleteWorldRequest)(nil),         // 4: lilbattle.v1.DeleteWorldRequest
+	(*models.UpdateWorldRequest)(nil),         // 5: lilbattle.v1.UpdateWorldRequest
+	(*models.ListWorldRevisionsRequest)(nil),  // 6: lilbattle.v1.ListWorldRevisionsRequest
+	(*models.RevertWorldRequest)(nil),         // 7: lilbattle.v1.RevertWorldRequest
+	(*models.ListDerivedWorldsRequest)(nil),   // 8: lilbattle.v1.ListDerivedWorldsRequest
+	(*models.SearchWorldsRequest)(nil),        // 9: lilbattle.v1.SearchWorldsRequest
+	(*models.MergeWorldUpstreamRequest)(nil),  // 10: lilbattle.v1.MergeWorldUpstreamRequest
+	(*models.CreateWorldResponse)(nil),        // 11: lilbattle.v1.CreateWorldResponse
+	(*models.GetWorldsResponse)(nil),          // 12: lilbattle.v1.GetWorldsResponse
+	(*models.ListWorldsResponse)(nil),         // 13: lilbattle.v1.ListWorldsResponse
+	(*models.GetWorldResponse)(nil),           // 14: lilbattle.v1.GetWorldResponse
+	(*models.DeleteWorldResponse)(nil),        // 15: lilbattle.v1.DeleteWorldResponse
+	(*models.UpdateWorldResponse)(nil),        // 16: lilbattle.v1.UpdateWorldResponse
+	(*models.ListWorldRevisionsResponse)(nil), // 17: lilbattle.v1.ListWorldRevisionsResponse
+	(*models.RevertWorldResponse)(nil),        // 18: lilbattle.v1.RevertWorldResponse
+	(*models.ListDerivedWorldsResponse)(nil),  // 19: lilbattle.v1.ListDerivedWorldsResponse
+	(*models.SearchWorldsResponse)(nil),       // 20: lilbattle.v1.SearchWorldsResponse
+	(*models.MergeWorldUpstreamResponse)(nil), // 21: lilbattle.v1.MergeWorldUpstreamResponse
 }
 var file_lilbattle_v1_services_worlds_proto_depIdxs = []int32{
 	0,  // 0: lilbattle.v1.WorldsService.CreateWorld:input_type -> lilbattle.v1.CreateWorldRequest
@@ -63,14 +79,24 @@ var file_lilbattle_v1_services_worlds_proto_depIdxs = []int32{
 	3,  // 3: lilbattle.v1.WorldsService.GetWorld:input_type -> lilbattle.v1.GetWorldRequest
 	4,  // 4: lilbattle.v1.WorldsService.DeleteWorld:input_type -> lilbattle.v1.DeleteWorldRequest
 	5,  // 5: lilbattle.v1.WorldsService.UpdateWorld:input_type -> lilbattle.v1.UpdateWorldRequest
-	6,  // 6: lilbattle.v1.WorldsService.CreateWorld:output_type -> lilbattle.v1.CreateWorldResponse
-	7,  // 7: lilbattle.v1.WorldsService.GetWorlds:output_type -> lilbattle.v1.GetWorldsResponse
-	8,  // 8: lilbattle.v1.WorldsService.ListWorlds:output_type -> lilbattle.v1.ListWorldsResponse
-	9,  // 9: lilbattle.v1.WorldsService.GetWorld:output_type -> lilbattle.v1.GetWorldResponse
-	10, // 10: lilbattle.v1.WorldsService.DeleteWorld:output_type -> lilbattle.v1.DeleteWorldResponse
-	11, // 11: lilbattle.v1.WorldsService.UpdateWorld:output_type -> lilbattle.v1.UpdateWorldResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
+	6,  // 6: lilbattle.v1.WorldsService.ListWorldRevisions:input_type -> lilbattle.v1.ListWorldRevisionsRequest
+	7,  // 7: lilbattle.v1.WorldsService.RevertWorld:input_type -> lilbattle.v1.RevertWorldRequest
+	8,  // 8: lilbattle.v1.WorldsService.ListDerivedWorlds:input_type -> lilbattle.v1.ListDerivedWorldsRequest
+	9,  // 9: lilbattle.v1.WorldsService.SearchWorlds:input_type -> lilbattle.v1.SearchWorldsRequest
+	10, // 10: lilbattle.v1.WorldsService.MergeWorldUpstream:input_type -> lilbattle.v1.MergeWorldUpstreamRequest
+	11, // 11: lilbattle.v1.WorldsService.CreateWorld:output_type -> lilbattle.v1.CreateWorldResponse
+	12, // 12: lilbattle.v1.WorldsService.GetWorlds:output_type -> lilbattle.v1.GetWorldsResponse
+	13, // 13: lilbattle.v1.WorldsService.ListWorlds:output_type -> lilbattle.v1.ListWorldsResponse
+	14, // 14: lilbattle.v1.WorldsService.GetWorld:output_type -> lilbattle.v1.GetWorldResponse
+	15, // 15: lilbattle.v1.WorldsService.DeleteWorld:output_type -> lilbattle.v1.DeleteWorldResponse
+	16, // 16: lilbattle.v1.WorldsService.UpdateWorld:output_type -> lilbattle.v1.UpdateWorldResponse
+	17, // 17: lilbattle.v1.WorldsService.ListWorldRevisions:output_type -> lilbattle.v1.ListWorldRevisionsResponse
+	18, // 18: lilbattle.v1.WorldsService.RevertWorld:output_type -> lilbattle.v1.RevertWorldResponse
+	19, // 19: lilbattle.v1.WorldsService.ListDerivedWorlds:output_type -> lilbattle.v1.ListDerivedWorldsResponse
+	20, // 20: lilbattle.v1.WorldsService.SearchWorlds:output_type -> lilbattle.v1.SearchWorldsResponse
+	21, // 21: lilbattle.v1.WorldsService.MergeWorldUpstream:output_type -> lilbattle.v1.MergeWorldUpstreamResponse
+	11, // [11:22] is the sub-list for method output_type
+	0,  // [0:11] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -21,12 +21,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WorldsService_CreateWorld_FullMethodName = "/lilbattle.v1.WorldsService/CreateWorld"
-	WorldsService_GetWorlds_FullMethodName   = "/lilbattle.v1.WorldsService/GetWorlds"
-	WorldsService_ListWorlds_FullMethodName  = "/lilbattle.v1.WorldsService/ListWorlds"
-	WorldsService_GetWorld_FullMethodName    = "/lilbattle.v1.WorldsService/GetWorld"
-	WorldsService_DeleteWorld_FullMethodName = "/lilbattle.v1.WorldsService/DeleteWorld"
-	WorldsService_UpdateWorld_FullMethodName = "/lilbattle.v1.WorldsService/UpdateWorld"
+	WorldsService_CreateWorld_FullMethodName        = "/lilbattle.v1.WorldsService/CreateWorld"
+	WorldsService_GetWorlds_FullMethodName          = "/lilbattle.v1.WorldsService/GetWorlds"
+	WorldsService_ListWorlds_FullMethodName         = "/lilbattle.v1.WorldsService/ListWorlds"
+	WorldsService_GetWorld_FullMethodName           = "/lilbattle.v1.WorldsService/GetWorld"
+	WorldsService_DeleteWorld_FullMethodName        = "/lilbattle.v1.WorldsService/DeleteWorld"
+	WorldsService_UpdateWorld_FullMethodName        = "/lilbattle.v1.WorldsService/UpdateWorld"
+	WorldsService_ListWorldRevisions_FullMethodName = "/lilbattle.v1.WorldsService/ListWorldRevisions"
+	WorldsService_RevertWorld_FullMethodName        = "/lilbattle.v1.WorldsService/RevertWorld"
+	WorldsService_ListDerivedWorlds_FullMethodName  = "/lilbattle.v1.WorldsService/ListDerivedWorlds"
+	WorldsService_SearchWorlds_FullMethodName       = "/lilbattle.v1.WorldsService/SearchWorlds"
+	WorldsService_MergeWorldUpstream_FullMethodName = "/lilbattle.v1.WorldsService/MergeWorldUpstream"
 )
 
 // WorldsServiceClient is the client API for WorldsService service.
@@ -50,6 +55,23 @@ type WorldsServiceClient interface {
 	DeleteWorld(ctx context.Context, in *models.DeleteWorldRequest, opts ...grpc.CallOption) (*models.DeleteWorldResponse, error)
 	// GetWorld returns a specific world with metadata
 	UpdateWorld(ctx context.Context, in *models.UpdateWorldRequest, opts ...grpc.CallOption) (*models.UpdateWorldResponse, error)
+	// ListWorldRevisions returns a world's immutable revisions, newest first
+	ListWorldRevisions(ctx context.Context, in *models.ListWorldRevisionsRequest, opts ...grpc.CallOption) (*models.ListWorldRevisionsResponse, error)
+	//*
+	// Revert a world to an older revision.  The old data is saved as a new
+	// revision rather than discarding the ones after it.
+	RevertWorld(ctx context.Context, in *models.RevertWorldRequest, opts ...grpc.CallOption) (*models.RevertWorldResponse, error)
+	// ListDerivedWorlds returns the worlds copied (remixed) from a world
+	ListDerivedWorlds(ctx context.Context, in *models.ListDerivedWorldsRequest, opts ...grpc.CallOption) (*models.ListDerivedWorldsResponse, error)
+	//*
+	// Search worlds by free text, tags, difficulty, player count and map
+	// size.  Results come from the world search index so recent changes show
+	// up once the world is reindexed.
+	SearchWorlds(ctx context.Context, in *models.SearchWorldsRequest, opts ...grpc.CallOption) (*models.SearchWorldsResponse, error)
+	//*
+	// Merge the changes made to a world's parent since it was copied into
+	// the world, with a three-way merge of tiles, units and crossings.
+	MergeWorldUpstream(ctx context.Context, in *models.MergeWorldUpstreamRequest, opts ...grpc.CallOption) (*models.MergeWorldUpstreamResponse, error)
 }
 
 type worldsServiceClient struct {
@@ -120,6 +142,56 @@ func (c *worldsServiceClient) UpdateWorld(ctx context.Context, in *models.Update
 	return out, nil
 }
 
+func (c *worldsServiceClient) ListWorldRevisions(ctx context.Context, in *models.ListWorldRevisionsRequest, opts ...grpc.CallOption) (*models.ListWorldRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListWorldRevisionsResponse)
+	err := c.cc.Invoke(ctx, WorldsService_ListWorldRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldsServiceClient) RevertWorld(ctx context.Context, in *models.RevertWorldRequest, opts ...grpc.CallOption) (*models.RevertWorldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.RevertWorldResponse)
+	err := c.cc.Invoke(ctx, WorldsService_RevertWorld_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldsServiceClient) ListDerivedWorlds(ctx context.Context, in *models.ListDerivedWorldsRequest, opts ...grpc.CallOption) (*models.ListDerivedWorldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListDerivedWorldsResponse)
+	err := c.cc.Invoke(ctx, WorldsService_ListDerivedWorlds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldsServiceClient) SearchWorlds(ctx context.Context, in *models.SearchWorldsRequest, opts ...grpc.CallOption) (*models.SearchWorldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.SearchWorldsResponse)
+	err := c.cc.Invoke(ctx, WorldsService_SearchWorlds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldsServiceClient) MergeWorldUpstream(ctx context.Context, in *models.MergeWorldUpstreamRequest, opts ...grpc.CallOption) (*models.MergeWorldUpstreamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.MergeWorldUpstreamResponse)
+	err := c.cc.Invoke(ctx, WorldsService_MergeWorldUpstream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorldsServiceServer is the server API for WorldsService service.
 // All implementations should embed UnimplementedWorldsServiceServer
 // for forward compatibility.
@@ -141,6 +213,23 @@ type WorldsServiceServer interface {
 	DeleteWorld(context.Context, *models.DeleteWorldRequest) (*models.DeleteWorldResponse, error)
 	// GetWorld returns a specific world with metadata
 	UpdateWorld(context.Context, *models.UpdateWorldRequest) (*models.UpdateWorldResponse, error)
+	// ListWorldRevisions returns a world's immutable revisions, newest first
+	ListWorldRevisions(context.Context, *models.ListWorldRevisionsRequest) (*models.ListWorldRevisionsResponse, error)
+	//*
+	// Revert a world to an older revision.  The old data is saved as a new
+	// revision rather than discarding the ones after it.
+	RevertWorld(context.Context, *models.RevertWorldRequest) (*models.RevertWorldResponse, error)
+	// ListDerivedWorlds returns the worlds copied (remixed) from a world
+	ListDerivedWorlds(context.Context, *models.ListDerivedWorldsRequest) (*models.ListDerivedWorldsResponse, error)
+	//*
+	// Search worlds by free text, tags, difficulty, player count and map
+	// size.  Results come from the world search index so recent changes show
+	// up once the world is reindexed.
+	SearchWorlds(context.Context, *models.SearchWorldsRequest) (*models.SearchWorldsResponse, error)
+	//*
+	// Merge the changes made to a world's parent since it was copied into
+	// the world, with a three-way merge of tiles, units and crossings.
+	MergeWorldUpstream(context.Context, *models.MergeWorldUpstreamRequest) (*models.MergeWorldUpstreamResponse, error)
 }
 
 // UnimplementedWorldsServiceServer should be embedded to have
@@ -168,6 +257,21 @@ func (UnimplementedWorldsServiceServer) DeleteWorld(context.Context, *models.Del
 func (UnimplementedWorldsServiceServer) UpdateWorld(context.Context, *models.UpdateWorldRequest) (*models.UpdateWorldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorld not implemented")
 }
+func (UnimplementedWorldsServiceServer) ListWorldRevisions(context.Context, *models.ListWorldRevisionsRequest) (*models.ListWorldRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorldRevisions not implemented")
+}
+func (UnimplementedWorldsServiceServer) RevertWorld(context.Context, *models.RevertWorldRequest) (*models.RevertWorldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertWorld not implemented")
+}
+func (UnimplementedWorldsServiceServer) ListDerivedWorlds(context.Context, *models.ListDerivedWorldsRequest) (*models.ListDerivedWorldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDerivedWorlds not implemented")
+}
+func (UnimplementedWorldsServiceServer) SearchWorlds(context.Context, *models.SearchWorldsRequest) (*models.SearchWorldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchWorlds not implemented")
+}
+func (UnimplementedWorldsServiceServer) MergeWorldUpstream(context.Context, *models.MergeWorldUpstreamRequest) (*models.MergeWorldUpstreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeWorldUpstream not implemented")
+}
 func (UnimplementedWorldsServiceServer) testEmbeddedByValue() {}
 
 // UnsafeWorldsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -296,6 +400,96 @@ func _WorldsService_UpdateWorld_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorldsService_ListWorldRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListWorldRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldsServiceServer).ListWorldRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldsService_ListWorldRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldsServiceServer).ListWorldRevisions(ctx, req.(*models.ListWorldRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldsService_RevertWorld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.RevertWorldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldsServiceServer).RevertWorld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldsService_RevertWorld_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldsServiceServer).RevertWorld(ctx, req.(*models.RevertWorldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldsService_ListDerivedWorlds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListDerivedWorldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldsServiceServer).ListDerivedWorlds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldsService_ListDerivedWorlds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldsServiceServer).ListDerivedWorlds(ctx, req.(*models.ListDerivedWorldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldsService_SearchWorlds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.SearchWorldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldsServiceServer).SearchWorlds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldsService_SearchWorlds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldsServiceServer).SearchWorlds(ctx, req.(*models.SearchWorldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldsService_MergeWorldUpstream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.MergeWorldUpstreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldsServiceServer).MergeWorldUpstream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldsService_MergeWorldUpstream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldsServiceServer).MergeWorldUpstream(ctx, req.(*models.MergeWorldUpstreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorldsService_ServiceDesc is the grpc.ServiceDesc for WorldsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,6 +521,26 @@ var WorldsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateWorld",
 			Handler:    _WorldsService_UpdateWorld_Handler,
 		},
+		{
+			MethodName: "ListWorldRevisions",
+			Handler:    _WorldsService_ListWorldRevisions_Handler,
+		},
+		{
+			MethodName: "RevertWorld",
+			Handler:    _WorldsService_RevertWorld_Handler,
+		},
+		{
+			MethodName: "ListDerivedWorlds",
+			Handler:    _WorldsService_ListDerivedWorlds_Handler,
+		},
+		{
+			MethodName: "SearchWorlds",
+			Handler:    _WorldsService_SearchWorlds_Handler,
+		},
+		{
+			MethodName: "MergeWorldUpstream",
+			Handler:    _WorldsService_MergeWorldUpstream_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lilbattle/v1/services/worlds.proto",
@@ -652,3 +652,143 @@ func (d *GameMoveGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, keys []G
 	err := query.Find(&out).Error
 	return out, err
 }
+
+// UserGameKey represents the composite primary key for gorm.UserGameGORM
+type UserGameKey struct {
+	UserId string
+	GameId string
+}
+
+// UserGameGORMDAL provides database access helper methods for gorm.UserGameGORM.
+type UserGameGORMDAL struct {
+	// TableName overrides the table for all operations.
+	// If empty, uses the struct's TableName() method (if any) or GORM's default.
+	TableName string
+
+	// WillCreate hook is called when Save detects the record doesn't exist and will create it.
+	// Return an error to prevent creation.
+	WillCreate func(context.Context, *gorm.UserGameGORM) error
+}
+
+// NewUserGameGORMDAL creates a new UserGameGORMDAL instance.
+// If tableName is empty, operations will use the struct's TableName() method
+// or GORM's default table naming convention.
+func NewUserGameGORMDAL(tableName string) *UserGameGORMDAL {
+	return &UserGameGORMDAL{TableName: tableName}
+}
+
+// db returns a *gorm.DB scoped to the correct table.
+// If TableName is set, uses db.Table(); otherwise returns db unchanged
+// to let GORM resolve the table name from the struct's TableName() method.
+func (d *UserGameGORMDAL) db(db *gormlib.DB) *gormlib.DB {
+	if d.TableName != "" {
+		return db.Table(d.TableName)
+	}
+	return db
+}
+
+// Create creates a new gorm.UserGameGORM record.
+// Returns an error if the record already exists.
+func (d *UserGameGORMDAL) Create(ctx context.Context, db *gormlib.DB, obj *gorm.UserGameGORM) error {
+	return d.db(db).Create(obj).Error
+}
+
+// Update updates an existing gorm.UserGameGORM record.
+// Returns ErrRecordNotFound if the record doesn't exist.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Update(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *UserGameGORMDAL) Update(ctx context.Context, db *gormlib.DB, obj *gorm.UserGameGORM) error {
+	result := d.db(db).Updates(obj)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	// Check if record was found and updated
+	if result.RowsAffected == 0 {
+		return gormlib.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Save creates or updates a gorm.UserGameGORM record (upsert).
+// If the record doesn't exist, it will call WillCreate hook before saving.
+// For conditional updates (optimistic locking), pass a db with WHERE conditions:
+//
+//	dal.Save(ctx, db.Where("version = ?", oldVersion), obj)
+func (d *UserGameGORMDAL) Save(ctx context.Context, db *gormlib.DB, obj *gorm.UserGameGORM) error {
+	// Validate primary key(s)
+	if obj.UserId == "" {
+		return errors.New("primary key 'UserId' cannot be empty")
+	}
+	if obj.GameId == "" {
+		return errors.New("primary key 'GameId' cannot be empty")
+	}
+
+	// Check if record exists by trying to fetch it
+	var existing gorm.UserGameGORM
+	err := d.db(db).First(&existing, "user_id = ?", "game_id = ?", obj.UserId, obj.GameId).Error
+
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			// Record doesn't exist - call WillCreate hook before saving
+			if d.WillCreate != nil {
+				if err := d.WillCreate(ctx, obj); err != nil {
+					return err
+				}
+			}
+		} else {
+			// Other error
+			return err
+		}
+	}
+
+	// Save (create or update)
+	return d.db(db).Save(obj).Error
+}
+
+// Get retrieves a gorm.UserGameGORM record by primary keys.
+// Returns (nil, nil) if the record is not found (not an error).
+func (d *UserGameGORMDAL) Get(ctx context.Context, db *gormlib.DB, userId string, gameId string) (*gorm.UserGameGORM, error) {
+	var out gorm.UserGameGORM
+	err := d.db(db).First(&out, "user_id = ? AND game_id = ?", userId, gameId).Error
+	if err != nil {
+		if errors.Is(err, gormlib.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes a gorm.UserGameGORM record by primary keys.
+func (d *UserGameGORMDAL) Delete(ctx context.Context, db *gormlib.DB, userId string, gameId string) error {
+	return d.db(db).Where("user_id = ? AND game_id = ?", userId, gameId).Delete(&gorm.UserGameGORM{}).Error
+}
+
+// List retrieves multiple gorm.UserGameGORM records using the provided query.
+// The caller is responsible for adding filters, ordering, and pagination to the query.
+func (d *UserGameGORMDAL) List(ctx context.Context, query *gormlib.DB) ([]*gorm.UserGameGORM, error) {
+	var out []*gorm.UserGameGORM
+	err := d.db(query).Find(&out).Error
+	return out, err
+}
+
+// BatchGet retrieves multiple gorm.UserGameGORM records by primary keys.
+// Results are returned in the order provided by the database (not necessarily the input order).
+func (d *UserGameGORMDAL) BatchGet(ctx context.Context, db *gormlib.DB, keys []UserGameKey) ([]*gorm.UserGameGORM, error) {
+	if len(keys) == 0 {
+		return []*gorm.UserGameGORM{}, nil
+	}
+
+	// Build OR query for each key combination
+	query := d.db(db).Where("1 = 0") // Start with false condition
+	for _, key := range keys {
+		query = query.Or("user_id = ? AND game_id = ?", key.UserId, key.GameId)
+	}
+
+	var out []*gorm.UserGameGORM
+	err := query.Find(&out).Error
+	return out, err
+}
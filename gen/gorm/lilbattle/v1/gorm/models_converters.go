@@ -398,15 +398,17 @@ func WorldToWorldGORM(
 
 	// Initialize struct with inline values
 	*dest = WorldGORM{
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		Version:        src.Version,
+		Id:             src.Id,
+		CreatorId:      src.CreatorId,
+		Name:           src.Name,
+		Description:    src.Description,
+		Tags:           src.Tags,
+		ImageUrl:       src.ImageUrl,
+		Difficulty:     src.Difficulty,
+		PreviewUrls:    src.PreviewUrls,
+		ParentWorldId:  src.ParentWorldId,
+		ParentRevision: src.ParentRevision,
 	}
 	out = dest
 
@@ -457,17 +459,19 @@ func WorldFromWorldGORM(
 
 	// Initialize struct with inline values
 	*dest = models.World{
-		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
-		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		CreatedAt:      converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt:      converters.TimeToTimestamp(src.UpdatedAt),
+		Version:        src.Version,
+		Id:             src.Id,
+		CreatorId:      src.CreatorId,
+		Name:           src.Name,
+		Description:    src.Description,
+		Tags:           src.Tags,
+		ImageUrl:       src.ImageUrl,
+		Difficulty:     src.Difficulty,
+		PreviewUrls:    src.PreviewUrls,
+		ParentWorldId:  src.ParentWorldId,
+		ParentRevision: src.ParentRevision,
 	}
 	out = dest
 
@@ -642,16 +646,18 @@ func GameToGameGORM(
 
 	// Initialize struct with inline values
 	*dest = GameGORM{
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		WorldId:     src.WorldId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		Version:       src.Version,
+		Id:            src.Id,
+		CreatorId:     src.CreatorId,
+		WorldId:       src.WorldId,
+		Name:          src.Name,
+		Description:   src.Description,
+		Tags:          src.Tags,
+		ImageUrl:      src.ImageUrl,
+		Difficulty:    src.Difficulty,
+		PreviewUrls:   src.PreviewUrls,
+		WorldRevision: src.WorldRevision,
+		RulesVersion:  src.RulesVersion,
 	}
 	out = dest
 
@@ -702,18 +708,20 @@ func GameFromGameGORM(
 
 	// Initialize struct with inline values
 	*dest = models.Game{
-		CreatedAt:   converters.TimeToTimestamp(src.CreatedAt),
-		UpdatedAt:   converters.TimeToTimestamp(src.UpdatedAt),
-		Version:     src.Version,
-		Id:          src.Id,
-		CreatorId:   src.CreatorId,
-		WorldId:     src.WorldId,
-		Name:        src.Name,
-		Description: src.Description,
-		Tags:        src.Tags,
-		ImageUrl:    src.ImageUrl,
-		Difficulty:  src.Difficulty,
-		PreviewUrls: src.PreviewUrls,
+		CreatedAt:     converters.TimeToTimestamp(src.CreatedAt),
+		UpdatedAt:     converters.TimeToTimestamp(src.UpdatedAt),
+		Version:       src.Version,
+		Id:            src.Id,
+		CreatorId:     src.CreatorId,
+		WorldId:       src.WorldId,
+		Name:          src.Name,
+		Description:   src.Description,
+		Tags:          src.Tags,
+		ImageUrl:      src.ImageUrl,
+		Difficulty:    src.Difficulty,
+		PreviewUrls:   src.PreviewUrls,
+		WorldRevision: src.WorldRevision,
+		RulesVersion:  src.RulesVersion,
 	}
 	out = dest
 
@@ -787,6 +795,17 @@ func GameStateToGameStateGORM(
 			out.PlayerStates[key] = converted
 		}
 	}
+	if src.PendingOrders != nil {
+		out.PendingOrders = make(map[int32]PlayerOrdersGORM, len(src.PendingOrders))
+		for key, value := range src.PendingOrders {
+			var converted PlayerOrdersGORM
+			_, err = PlayerOrdersToPlayerOrdersGORM(value, &converted, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting PendingOrders[%v]: %w", key, err)
+			}
+			out.PendingOrders[key] = converted
+		}
+	}
 
 	// Apply decorator if provided
 	if decorator != nil {
@@ -842,6 +861,15 @@ func GameStateFromGameStateGORM(
 			}
 		}
 	}
+	if src.PendingOrders != nil {
+		out.PendingOrders = make(map[int32]*models.PlayerOrders, len(src.PendingOrders))
+		for key, value := range src.PendingOrders {
+			out.PendingOrders[key], err = PlayerOrdersFromPlayerOrdersGORM(nil, &value, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting PendingOrders[%v]: %w", key, err)
+			}
+		}
+	}
 
 	// Apply decorator if provided
 	if decorator != nil {
@@ -1197,10 +1225,15 @@ func GameSettingsToGameSettingsGORM(
 
 	// Initialize struct with inline values
 	*dest = GameSettingsGORM{
-		AllowedUnits:  src.AllowedUnits,
-		TurnTimeLimit: src.TurnTimeLimit,
-		TeamMode:      src.TeamMode,
-		MaxTurns:      src.MaxTurns,
+		AllowedUnits:    src.AllowedUnits,
+		TurnTimeLimit:   src.TurnTimeLimit,
+		TeamMode:        src.TeamMode,
+		MaxTurns:        src.MaxTurns,
+		TurnMode:        src.TurnMode,
+		SharedCoins:     src.SharedCoins,
+		SharedControl:   src.SharedControl,
+		AlliedSupport:   src.AlliedSupport,
+		CombinedVictory: src.CombinedVictory,
 	}
 	out = dest
 
@@ -1230,10 +1263,15 @@ func GameSettingsFromGameSettingsGORM(
 
 	// Initialize struct with inline values
 	*dest = models.GameSettings{
-		AllowedUnits:  src.AllowedUnits,
-		TurnTimeLimit: src.TurnTimeLimit,
-		TeamMode:      src.TeamMode,
-		MaxTurns:      src.MaxTurns,
+		AllowedUnits:    src.AllowedUnits,
+		TurnTimeLimit:   src.TurnTimeLimit,
+		TeamMode:        src.TeamMode,
+		MaxTurns:        src.MaxTurns,
+		TurnMode:        src.TurnMode,
+		SharedCoins:     src.SharedCoins,
+		SharedControl:   src.SharedControl,
+		AlliedSupport:   src.AlliedSupport,
+		CombinedVictory: src.CombinedVictory,
 	}
 	out = dest
 
@@ -1709,3 +1747,175 @@ func GameMoveFromGameMoveGORM(
 
 	return out, nil
 }
+
+// PlayerOrdersToPlayerOrdersGORM converts a models.PlayerOrders to PlayerOrdersGORM.
+// The optional decorator function allows custom field transformations.
+func PlayerOrdersToPlayerOrdersGORM(
+	src *models.PlayerOrders,
+	dest *PlayerOrdersGORM,
+	decorator func(*models.PlayerOrders, *PlayerOrdersGORM) error,
+) (out *PlayerOrdersGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &PlayerOrdersGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = PlayerOrdersGORM{
+		PlayerId:   src.PlayerId,
+		Commitment: src.Commitment,
+		Revealed:   src.Revealed,
+		Salt:       src.Salt,
+	}
+	out = dest
+
+	if src.CommittedAt != nil {
+		out.CommittedAt = converters.TimestampToTime(src.CommittedAt)
+	}
+
+	if src.Moves != nil {
+		out.Moves = make([]GameMoveGORM, len(src.Moves))
+		for i, item := range src.Moves {
+			_, err = GameMoveToGameMoveGORM(item, &out.Moves[i], nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// PlayerOrdersFromPlayerOrdersGORM converts a PlayerOrdersGORM back to models.PlayerOrders.
+// The optional decorator function allows custom field transformations.
+func PlayerOrdersFromPlayerOrdersGORM(
+	dest *models.PlayerOrders,
+	src *PlayerOrdersGORM,
+	decorator func(dest *models.PlayerOrders, src *PlayerOrdersGORM) error,
+) (out *models.PlayerOrders, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.PlayerOrders{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.PlayerOrders{
+		PlayerId:    src.PlayerId,
+		Commitment:  src.Commitment,
+		CommittedAt: converters.TimeToTimestamp(src.CommittedAt),
+		Revealed:    src.Revealed,
+		Salt:        src.Salt,
+	}
+	out = dest
+
+	if src.Moves != nil {
+		out.Moves = make([]*models.GameMove, len(src.Moves))
+		for i, item := range src.Moves {
+			out.Moves[i], err = GameMoveFromGameMoveGORM(nil, &item, nil)
+			if err != nil {
+				return nil, fmt.Errorf("converting Moves[%d]: %w", i, err)
+			}
+		}
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// UserGameToUserGameGORM converts a models.UserGame to UserGameGORM.
+// The optional decorator function allows custom field transformations.
+func UserGameToUserGameGORM(
+	src *models.UserGame,
+	dest *UserGameGORM,
+	decorator func(*models.UserGame, *UserGameGORM) error,
+) (out *UserGameGORM, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &UserGameGORM{}
+	}
+
+	// Initialize struct with inline values
+	*dest = UserGameGORM{
+		UserId:        src.UserId,
+		GameId:        src.GameId,
+		PlayerIds:     src.PlayerIds,
+		GameName:      src.GameName,
+		WorldId:       src.WorldId,
+		Status:        src.Status,
+		CurrentPlayer: src.CurrentPlayer,
+		TurnCounter:   src.TurnCounter,
+		IsMyTurn:      src.IsMyTurn,
+	}
+	out = dest
+
+	if src.UpdatedAt != nil {
+		out.UpdatedAt = converters.TimestampToTime(src.UpdatedAt)
+	}
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(src, dest); err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// UserGameFromUserGameGORM converts a UserGameGORM back to models.UserGame.
+// The optional decorator function allows custom field transformations.
+func UserGameFromUserGameGORM(
+	dest *models.UserGame,
+	src *UserGameGORM,
+	decorator func(dest *models.UserGame, src *UserGameGORM) error,
+) (out *models.UserGame, err error) {
+	if src == nil {
+		return nil, nil
+	}
+	if dest == nil {
+		dest = &models.UserGame{}
+	}
+
+	// Initialize struct with inline values
+	*dest = models.UserGame{
+		UserId:        src.UserId,
+		GameId:        src.GameId,
+		PlayerIds:     src.PlayerIds,
+		GameName:      src.GameName,
+		WorldId:       src.WorldId,
+		Status:        src.Status,
+		CurrentPlayer: src.CurrentPlayer,
+		TurnCounter:   src.TurnCounter,
+		IsMyTurn:      src.IsMyTurn,
+		UpdatedAt:     converters.TimeToTimestamp(src.UpdatedAt),
+	}
+	out = dest
+
+	// Apply decorator if provided
+	if decorator != nil {
+		if err := decorator(dest, src); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
@@ -171,6 +171,8 @@ type WorldGORM struct {
 	PreviewUrls       []string `gorm:"serializer:json"`
 	DefaultGameConfig GameConfigurationGORM
 	SearchIndexInfo   IndexInfoGORM `gorm:"embedded;embeddedPrefix:search_index_"`
+	ParentWorldId     string
+	ParentRevision    int64
 }
 
 // TableName returns the table name for WorldGORM
@@ -234,6 +236,8 @@ type GameGORM struct {
 	Config          GameConfigurationGORM
 	PreviewUrls     []string      `gorm:"serializer:json"`
 	SearchIndexInfo IndexInfoGORM `gorm:"embedded;embeddedPrefix:search_index_"`
+	WorldRevision   int64
+	RulesVersion    string
 }
 
 // TableName returns the table name for GameGORM
@@ -255,7 +259,8 @@ type GameStateGORM struct {
 	WinningPlayer      int32
 	WinningTeam        int32
 	CurrentGroupNumber int64
-	PlayerStates       map[int32]PlayerStateGORM `gorm:"serializer:json"`
+	PlayerStates       map[int32]PlayerStateGORM  `gorm:"serializer:json"`
+	PendingOrders      map[int32]PlayerOrdersGORM `gorm:"serializer:json"`
 }
 
 // TableName returns the table name for GameStateGORM
@@ -400,10 +405,15 @@ func (m *GameTeamGORM) Scan(value interface{}) error {
 
 // GameSettingsGORM is the GORM model for lilbattle.v1.GameSettings
 type GameSettingsGORM struct {
-	AllowedUnits  []int32 `gorm:"serializer:json"`
-	TurnTimeLimit int32
-	TeamMode      string
-	MaxTurns      int32
+	AllowedUnits    []int32 `gorm:"serializer:json"`
+	TurnTimeLimit   int32
+	TeamMode        string
+	MaxTurns        int32
+	TurnMode        string
+	SharedCoins     bool
+	SharedControl   bool
+	AlliedSupport   bool
+	CombinedVictory bool
 }
 
 // PlayerStateGORM is the GORM model for lilbattle.v1.PlayerState
@@ -479,3 +489,56 @@ type GameMoveGORM struct {
 func (*GameMoveGORM) TableName() string {
 	return "game_moves"
 }
+
+// PlayerOrdersGORM is the GORM model for lilbattle.v1.PlayerOrders
+type PlayerOrdersGORM struct {
+	PlayerId    int32
+	Commitment  string
+	CommittedAt time.Time
+	Revealed    bool
+	Moves       []GameMoveGORM `gorm:"serializer:json"`
+	Salt        string
+}
+
+// Value implements driver.Valuer for PlayerOrdersGORM
+func (m PlayerOrdersGORM) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+// Scan implements sql.Scanner for PlayerOrdersGORM
+func (m *PlayerOrdersGORM) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan PlayerOrdersGORM: unsupported type %T", value)
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
+// UserGameGORM is the GORM model for lilbattle.v1.UserGame
+type UserGameGORM struct {
+	UserId        string  `gorm:"primaryKey"`
+	GameId        string  `gorm:"primaryKey;index:idx_user_games_game_id"`
+	PlayerIds     []int32 `gorm:"serializer:json"`
+	GameName      string
+	WorldId       string
+	Status        models.GameStatus
+	CurrentPlayer int32
+	TurnCounter   int32
+	IsMyTurn      bool
+	UpdatedAt     time.Time
+}
+
+// TableName returns the table name for UserGameGORM
+func (*UserGameGORM) TableName() string {
+	return "user_games"
+}
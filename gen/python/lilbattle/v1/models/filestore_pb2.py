@@ -28,7 +28,7 @@ from google.protobuf import field_mask_pb2 as google_dot_protobuf_dot_field__mas
 from lilbattle.v1.models import models_pb2 as lilbattle_dot_v1_dot_models_dot_models__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n#lilbattle/v1/models/filestore.proto\x12\x0clilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/protobuf/any.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\"\x94\x03\n\x04\x46ile\x12\x12\n\x04path\x18\x01 \x01(\tR\x04path\x12!\n\x0c\x63ontent_type\x18\x02 \x01(\tR\x0b\x63ontentType\x12\x1b\n\tfile_size\x18\x03 \x01(\x04R\x08\x66ileSize\x12\x1b\n\tis_public\x18\x04 \x01(\x08R\x08isPublic\x12\x39\n\ncreated_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n\x0c\x64ownload_url\x18\x07 \x01(\tR\x0b\x64ownloadUrl\x12\x43\n\x0bsigned_urls\x18\x08 \x03(\x0b\x32\".lilbattle.v1.File.SignedUrlsEntryR\nsignedUrls\x1a=\n\x0fSignedUrlsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"R\n\x0ePutFileRequest\x12&\n\x04\x66ile\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.FileR\x04\x66ile\x12\x18\n\x07\x63ontent\x18\x02 \x01(\x0cR\x07\x63ontent\"9\n\x0fPutFileResponse\x12&\n\x04\x66ile\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.FileR\x04\x66ile\"T\n\x0eGetFileRequest\x12\x12\n\x04path\x18\x01 \x01(\tR\x04path\x12.\n\x13include_signed_urls\x18\x02 \x01(\x08R\x11includeSignedUrls\"9\n\x0fGetFileResponse\x12&\n\x04\x66ile\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.FileR\x04\x66ile\"\'\n\x11\x44\x65leteFileRequest\x12\x12\n\x04path\x18\x01 \x01(\tR\x04path\"<\n\x12\x44\x65leteFileResponse\x12&\n\x04\x66ile\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.FileR\x04\x66ile\"\x90\x01\n\x10ListFilesRequest\x12\x12\n\x04path\x18\x01 \x01(\tR\x04path\x12\x38\n\npagination\x18\x02 \x01(\x0b\x32\x18.lilbattle.v1.PaginationR\npagination\x12.\n\x13include_signed_urls\x18\x03 \x01(\x08R\x11includeSignedUrls\"\x7f\n\x11ListFilesResponse\x12(\n\x05items\x18\x01 \x03(\x0b\x32\x12.lilbattle.v1.FileR\x05items\x12@\n\npagination\x18\x02 \x01(\x0b\x32 .lilbattle.v1.PaginationResponseR\npaginationB\xba\x01\n\x10\x63om.lilbattle.v1B\x0e\x46ilestoreProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n#lilbattle/v1/models/filestore.proto\x12\x0clilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/protobuf/any.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\"\x94\x03\n\x04\x46ile\x12\x12\n\x04path\x18\x01 \x01(\tR\x04path\x12!\n\x0c\x63ontent_type\x18\x02 \x01(\tR\x0b\x63ontentType\x12\x1b\n\tfile_size\x18\x03 \x01(\x04R\x08\x66ileSize\x12\x1b\n\tis_public\x18\x04 \x01(\x08R\x08isPublic\x12\x39\n\ncreated_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n\x0c\x64ownload_url\x18\x07 \x01(\tR\x0b\x64ownloadUrl\x12\x43\n\x0bsigned_urls\x18\x08 \x03(\x0b\x32\".lilbattle.v1.File.SignedUrlsEntryR\nsignedUrls\x1a=\n\x0fSignedUrlsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"R\n\x0ePutFileRequest\x12&\n\x04\x66ile\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.FileR\x04\x66ile\x12\x18\n\x07\x63ontent\x18\x02 \x01(\x0cR\x07\x63ontent\"9\n\x0fPutFileResponse\x12&\n\x04\x66ile\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.FileR\x04\x66ile\"T\n\x0eGetFileRequest\x12\x12\n\x04path\x18\x01 \x01(\tR\x04path\x12.\n\x13include_signed_urls\x18\x02 \x01(\x08R\x11includeSignedUrls\"9\n\x0fGetFileResponse\x12&\n\x04\x66ile\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.FileR\x04\x66ile\"\'\n\x11\x44\x65leteFileRequest\x12\x12\n\x04path\x18\x01 \x01(\tR\x04path\"<\n\x12\x44\x65leteFileResponse\x12&\n\x04\x66ile\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.FileR\x04\x66ile\"\xae\x01\n\x10ListFilesRequest\x12\x12\n\x04path\x18\x01 \x01(\tR\x04path\x12\x38\n\npagination\x18\x02 \x01(\x0b\x32\x18.lilbattle.v1.PaginationR\npagination\x12.\n\x13include_signed_urls\x18\x03 \x01(\x08R\x11includeSignedUrls\x12\x1c\n\trecursive\x18\x04 \x01(\x08R\trecursive\"\x7f\n\x11ListFilesResponse\x12(\n\x05items\x18\x01 \x03(\x0b\x32\x12.lilbattle.v1.FileR\x05items\x12@\n\npagination\x18\x02 \x01(\x0b\x32 .lilbattle.v1.PaginationResponseR\npaginationB\xba\x01\n\x10\x63om.lilbattle.v1B\x0e\x46ilestoreProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -55,7 +55,7 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_DELETEFILERESPONSE']._serialized_start=917
   _globals['_DELETEFILERESPONSE']._serialized_end=977
   _globals['_LISTFILESREQUEST']._serialized_start=980
-  _globals['_LISTFILESREQUEST']._serialized_end=1124
-  _globals['_LISTFILESRESPONSE']._serialized_start=1126
-  _globals['_LISTFILESRESPONSE']._serialized_end=1253
+  _globals['_LISTFILESREQUEST']._serialized_end=1154
+  _globals['_LISTFILESRESPONSE']._serialized_start=1156
+  _globals['_LISTFILESRESPONSE']._serialized_end=1283
 # @@protoc_insertion_point(module_scope)
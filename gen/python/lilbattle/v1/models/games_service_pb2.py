@@ -28,7 +28,7 @@ from google.protobuf import field_mask_pb2 as google_dot_protobuf_dot_field__mas
 from lilbattle.v1.models import models_pb2 as lilbattle_dot_v1_dot_models_dot_models__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\'lilbattle/v1/models/games_service.proto\x12\x0clilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\"g\n\x10ListGamesRequest\x12\x38\n\npagination\x18\x01 \x01(\x0b\x32\x18.lilbattle.v1.PaginationR\npagination\x12\x19\n\x08owner_id\x18\x02 \x01(\tR\x07ownerId\"\x7f\n\x11ListGamesResponse\x12(\n\x05items\x18\x01 \x03(\x0b\x32\x12.lilbattle.v1.GameR\x05items\x12@\n\npagination\x18\x02 \x01(\x0b\x32 .lilbattle.v1.PaginationResponseR\npagination\":\n\x0eGetGameRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n\x07version\x18\x02 \x01(\tR\x07version\"\xa1\x01\n\x0fGetGameResponse\x12&\n\x04game\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game\x12-\n\x05state\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.GameStateR\x05state\x12\x37\n\x07history\x18\x03 \x01(\x0b\x32\x1d.lilbattle.v1.GameMoveHistoryR\x07history\"A\n\x15GetGameContentRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n\x07version\x18\x02 \x01(\tR\x07version\"\x93\x01\n\x16GetGameContentResponse\x12+\n\x11lilbattle_content\x18\x01 \x01(\tR\x10lilbattleContent\x12%\n\x0erecipe_content\x18\x02 \x01(\tR\rrecipeContent\x12%\n\x0ereadme_content\x18\x03 \x01(\tR\rreadmeContent\"\xa8\x02\n\x11UpdateGameRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12-\n\x08new_game\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x07newGame\x12\x34\n\tnew_state\x18\x03 \x01(\x0b\x32\x17.lilbattle.v1.GameStateR\x08newState\x12>\n\x0bnew_history\x18\x04 \x01(\x0b\x32\x1d.lilbattle.v1.GameMoveHistoryR\nnewHistory\x12;\n\x0bupdate_mask\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskR\nupdateMask:\x18\x92\x41\x15\n\x13*\x11UpdateGameRequest\"W\n\x12UpdateGameResponse\x12&\n\x04game\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game:\x19\x92\x41\x16\n\x14*\x12UpdateGameResponse\"#\n\x11\x44\x65leteGameRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\x14\n\x12\x44\x65leteGameResponse\"#\n\x0fGetGamesRequest\x12\x10\n\x03ids\x18\x01 \x03(\tR\x03ids\"\xa1\x01\n\x10GetGamesResponse\x12?\n\x05games\x18\x01 \x03(\x0b\x32).lilbattle.v1.GetGamesResponse.GamesEntryR\x05games\x1aL\n\nGamesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12(\n\x05value\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x05value:\x02\x38\x01\";\n\x11\x43reateGameRequest\x12&\n\x04game\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game\"\x8a\x02\n\x12\x43reateGameResponse\x12&\n\x04game\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game\x12\x36\n\ngame_state\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.GameStateR\tgameState\x12T\n\x0c\x66ield_errors\x18\x03 \x03(\x0b\x32\x31.lilbattle.v1.CreateGameResponse.FieldErrorsEntryR\x0b\x66ieldErrors\x1a>\n\x10\x46ieldErrorsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"\xc6\x01\n\x13ProcessMovesRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12,\n\x05moves\x18\x02 \x03(\x0b\x32\x16.lilbattle.v1.GameMoveR\x05moves\x12O\n\x11\x65xpected_response\x18\x03 \x01(\x0b\x32\".lilbattle.v1.ProcessMovesResponseR\x10\x65xpectedResponse\x12\x17\n\x07\x64ry_run\x18\x04 \x01(\x08R\x06\x64ryRun\"D\n\x14ProcessMovesResponse\x12,\n\x05moves\x18\x03 \x03(\x0b\x32\x16.lilbattle.v1.GameMoveR\x05moves\".\n\x13GetGameStateRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\"E\n\x14GetGameStateResponse\x12-\n\x05state\x18\x01 \x01(\x0b\x32\x17.lilbattle.v1.GameStateR\x05state\"e\n\x10ListMovesRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x1d\n\nfrom_group\x18\x02 \x01(\x03R\tfromGroup\x12\x19\n\x08to_group\x18\x03 \x01(\x03R\x07toGroup\"l\n\x11ListMovesResponse\x12\x19\n\x08has_more\x18\x01 \x01(\x08R\x07hasMore\x12<\n\x0bmove_groups\x18\x02 \x03(\x0b\x32\x1b.lilbattle.v1.GameMoveGroupR\nmoveGroups\"X\n\x13GetOptionsAtRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12(\n\x03pos\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x03pos\"\xd1\x01\n\x14GetOptionsAtResponse\x12\x32\n\x07options\x18\x01 \x03(\x0b\x32\x18.lilbattle.v1.GameOptionR\x07options\x12%\n\x0e\x63urrent_player\x18\x02 \x01(\x05R\rcurrentPlayer\x12)\n\x10game_initialized\x18\x03 \x01(\x08R\x0fgameInitialized\x12\x33\n\tall_paths\x18\x05 \x01(\x0b\x32\x16.lilbattle.v1.AllPathsR\x08\x61llPaths\"\xef\x02\n\nGameOption\x12\x32\n\x04move\x18\x01 \x01(\x0b\x32\x1c.lilbattle.v1.MoveUnitActionH\x00R\x04move\x12\x38\n\x06\x61ttack\x18\x02 \x01(\x0b\x32\x1e.lilbattle.v1.AttackUnitActionH\x00R\x06\x61ttack\x12\x35\n\x05\x62uild\x18\x03 \x01(\x0b\x32\x1d.lilbattle.v1.BuildUnitActionH\x00R\x05\x62uild\x12?\n\x07\x63\x61pture\x18\x04 \x01(\x0b\x32#.lilbattle.v1.CaptureBuildingActionH\x00R\x07\x63\x61pture\x12\x38\n\x08\x65nd_turn\x18\x05 \x01(\x0b\x32\x1b.lilbattle.v1.EndTurnActionH\x00R\x07\x65ndTurn\x12\x32\n\x04heal\x18\x06 \x01(\x0b\x32\x1c.lilbattle.v1.HealUnitActionH\x00R\x04healB\r\n\x0boption_type\"\xe5\x02\n\x15SimulateAttackRequest\x12,\n\x12\x61ttacker_unit_type\x18\x01 \x01(\x05R\x10\x61ttackerUnitType\x12)\n\x10\x61ttacker_terrain\x18\x02 \x01(\x05R\x0f\x61ttackerTerrain\x12\'\n\x0f\x61ttacker_health\x18\x03 \x01(\x05R\x0e\x61ttackerHealth\x12,\n\x12\x64\x65\x66\x65nder_unit_type\x18\x04 \x01(\x05R\x10\x64\x65\x66\x65nderUnitType\x12)\n\x10\x64\x65\x66\x65nder_terrain\x18\x05 \x01(\x05R\x0f\x64\x65\x66\x65nderTerrain\x12\'\n\x0f\x64\x65\x66\x65nder_health\x18\x06 \x01(\x05R\x0e\x64\x65\x66\x65nderHealth\x12\x1f\n\x0bwound_bonus\x18\x07 \x01(\x05R\nwoundBonus\x12\'\n\x0fnum_simulations\x18\x08 \x01(\x05R\x0enumSimulations\"\xa4\x05\n\x16SimulateAttackResponse\x12\x86\x01\n\x1c\x61ttacker_damage_distribution\x18\x01 \x03(\x0b\x32\x44.lilbattle.v1.SimulateAttackResponse.AttackerDamageDistributionEntryR\x1a\x61ttackerDamageDistribution\x12\x86\x01\n\x1c\x64\x65\x66\x65nder_damage_distribution\x18\x02 \x03(\x0b\x32\x44.lilbattle.v1.SimulateAttackResponse.DefenderDamageDistributionEntryR\x1a\x64\x65\x66\x65nderDamageDistribution\x12\x30\n\x14\x61ttacker_mean_damage\x18\x03 \x01(\x01R\x12\x61ttackerMeanDamage\x12\x30\n\x14\x64\x65\x66\x65nder_mean_damage\x18\x04 \x01(\x01R\x12\x64\x65\x66\x65nderMeanDamage\x12:\n\x19\x61ttacker_kill_probability\x18\x05 \x01(\x01R\x17\x61ttackerKillProbability\x12:\n\x19\x64\x65\x66\x65nder_kill_probability\x18\x06 \x01(\x01R\x17\x64\x65\x66\x65nderKillProbability\x1aM\n\x1f\x41ttackerDamageDistributionEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\x1aM\n\x1f\x44\x65\x66\x65nderDamageDistributionEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\"\xc1\x01\n\x12SimulateFixRequest\x12(\n\x10\x66ixing_unit_type\x18\x01 \x01(\x05R\x0e\x66ixingUnitType\x12,\n\x12\x66ixing_unit_health\x18\x02 \x01(\x05R\x10\x66ixingUnitHealth\x12*\n\x11injured_unit_type\x18\x03 \x01(\x05R\x0finjuredUnitType\x12\'\n\x0fnum_simulations\x18\x04 \x01(\x05R\x0enumSimulations\"\x8c\x02\n\x13SimulateFixResponse\x12m\n\x14healing_distribution\x18\x01 \x03(\x0b\x32:.lilbattle.v1.SimulateFixResponse.HealingDistributionEntryR\x13healingDistribution\x12!\n\x0cmean_healing\x18\x02 \x01(\x01R\x0bmeanHealing\x12\x1b\n\tfix_value\x18\x03 \x01(\x05R\x08\x66ixValue\x1a\x46\n\x18HealingDistributionEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\"G\n\x0fJoinGameRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n\tplayer_id\x18\x02 \x01(\x05R\x08playerId\"W\n\x10JoinGameResponse\x12&\n\x04game\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game\x12\x1b\n\tplayer_id\x18\x02 \x01(\x05R\x08playerIdB\xbd\x01\n\x10\x63om.lilbattle.v1B\x11GamesServiceProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\'lilbattle/v1/models/games_service.proto\x12\x0clilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\"\x8a\x03\n\x10ListGamesRequest\x12\x38\n\npagination\x18\x01 \x01(\x0b\x32\x18.lilbattle.v1.PaginationR\npagination\x12\x19\n\x08owner_id\x18\x02 \x01(\tR\x07ownerId\x12$\n\x0eplayer_user_id\x18\x03 \x01(\tR\x0cplayerUserId\x12\x30\n\x06status\x18\x04 \x01(\x0e\x32\x18.lilbattle.v1.GameStatusR\x06status\x12\x19\n\x08world_id\x18\x05 \x01(\tR\x07worldId\x12\x10\n\x03tag\x18\x06 \x01(\tR\x03tag\x12\x31\n\x07\x63reated\x18\x07 \x01(\x0b\x32\x17.lilbattle.v1.TimeRangeR\x07\x63reated\x12\x31\n\x07updated\x18\x08 \x01(\x0b\x32\x17.lilbattle.v1.TimeRangeR\x07updated\x12\x17\n\x07sort_by\x18\t \x01(\tR\x06sortBy\x12\x1d\n\nsort_order\x18\n \x01(\tR\tsortOrder\"\x7f\n\x11ListGamesResponse\x12(\n\x05items\x18\x01 \x03(\x0b\x32\x12.lilbattle.v1.GameR\x05items\x12@\n\npagination\x18\x02 \x01(\x0b\x32 .lilbattle.v1.PaginationResponseR\npagination\":\n\x0eGetGameRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n\x07version\x18\x02 \x01(\tR\x07version\"\xa1\x01\n\x0fGetGameResponse\x12&\n\x04game\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game\x12-\n\x05state\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.GameStateR\x05state\x12\x37\n\x07history\x18\x03 \x01(\x0b\x32\x1d.lilbattle.v1.GameMoveHistoryR\x07history\"A\n\x15GetGameContentRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n\x07version\x18\x02 \x01(\tR\x07version\"\x93\x01\n\x16GetGameContentResponse\x12+\n\x11lilbattle_content\x18\x01 \x01(\tR\x10lilbattleContent\x12%\n\x0erecipe_content\x18\x02 \x01(\tR\rrecipeContent\x12%\n\x0ereadme_content\x18\x03 \x01(\tR\rreadmeContent\"\xa8\x02\n\x11UpdateGameRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12-\n\x08new_game\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x07newGame\x12\x34\n\tnew_state\x18\x03 \x01(\x0b\x32\x17.lilbattle.v1.GameStateR\x08newState\x12>\n\x0bnew_history\x18\x04 \x01(\x0b\x32\x1d.lilbattle.v1.GameMoveHistoryR\nnewHistory\x12;\n\x0bupdate_mask\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskR\nupdateMask:\x18\x92\x41\x15\n\x13*\x11UpdateGameRequest\"W\n\x12UpdateGameResponse\x12&\n\x04game\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game:\x19\x92\x41\x16\n\x14*\x12UpdateGameResponse\"#\n\x11\x44\x65leteGameRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\x14\n\x12\x44\x65leteGameResponse\"#\n\x0fGetGamesRequest\x12\x10\n\x03ids\x18\x01 \x03(\tR\x03ids\"\xa1\x01\n\x10GetGamesResponse\x12?\n\x05games\x18\x01 \x03(\x0b\x32).lilbattle.v1.GetGamesResponse.GamesEntryR\x05games\x1aL\n\nGamesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12(\n\x05value\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x05value:\x02\x38\x01\";\n\x11\x43reateGameRequest\x12&\n\x04game\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game\"\x8a\x02\n\x12\x43reateGameResponse\x12&\n\x04game\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game\x12\x36\n\ngame_state\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.GameStateR\tgameState\x12T\n\x0c\x66ield_errors\x18\x03 \x03(\x0b\x32\x31.lilbattle.v1.CreateGameResponse.FieldErrorsEntryR\x0b\x66ieldErrors\x1a>\n\x10\x46ieldErrorsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"\xc6\x01\n\x13ProcessMovesRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12,\n\x05moves\x18\x02 \x03(\x0b\x32\x16.lilbattle.v1.GameMoveR\x05moves\x12O\n\x11\x65xpected_response\x18\x03 \x01(\x0b\x32\".lilbattle.v1.ProcessMovesResponseR\x10\x65xpectedResponse\x12\x17\n\x07\x64ry_run\x18\x04 \x01(\x08R\x06\x64ryRun\"D\n\x14ProcessMovesResponse\x12,\n\x05moves\x18\x03 \x03(\x0b\x32\x16.lilbattle.v1.GameMoveR\x05moves\".\n\x13GetGameStateRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\"E\n\x14GetGameStateResponse\x12-\n\x05state\x18\x01 \x01(\x0b\x32\x17.lilbattle.v1.GameStateR\x05state\"e\n\x10ListMovesRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x1d\n\nfrom_group\x18\x02 \x01(\x03R\tfromGroup\x12\x19\n\x08to_group\x18\x03 \x01(\x03R\x07toGroup\"l\n\x11ListMovesResponse\x12\x19\n\x08has_more\x18\x01 \x01(\x08R\x07hasMore\x12<\n\x0bmove_groups\x18\x02 \x03(\x0b\x32\x1b.lilbattle.v1.GameMoveGroupR\nmoveGroups\"X\n\x13GetOptionsAtRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12(\n\x03pos\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x03pos\"\xd1\x01\n\x14GetOptionsAtResponse\x12\x32\n\x07options\x18\x01 \x03(\x0b\x32\x18.lilbattle.v1.GameOptionR\x07options\x12%\n\x0e\x63urrent_player\x18\x02 \x01(\x05R\rcurrentPlayer\x12)\n\x10game_initialized\x18\x03 \x01(\x08R\x0fgameInitialized\x12\x33\n\tall_paths\x18\x05 \x01(\x0b\x32\x16.lilbattle.v1.AllPathsR\x08\x61llPaths\"\xef\x02\n\nGameOption\x12\x32\n\x04move\x18\x01 \x01(\x0b\x32\x1c.lilbattle.v1.MoveUnitActionH\x00R\x04move\x12\x38\n\x06\x61ttack\x18\x02 \x01(\x0b\x32\x1e.lilbattle.v1.AttackUnitActionH\x00R\x06\x61ttack\x12\x35\n\x05\x62uild\x18\x03 \x01(\x0b\x32\x1d.lilbattle.v1.BuildUnitActionH\x00R\x05\x62uild\x12?\n\x07\x63\x61pture\x18\x04 \x01(\x0b\x32#.lilbattle.v1.CaptureBuildingActionH\x00R\x07\x63\x61pture\x12\x38\n\x08\x65nd_turn\x18\x05 \x01(\x0b\x32\x1b.lilbattle.v1.EndTurnActionH\x00R\x07\x65ndTurn\x12\x32\n\x04heal\x18\x06 \x01(\x0b\x32\x1c.lilbattle.v1.HealUnitActionH\x00R\x04healB\r\n\x0boption_type\"\xe5\x02\n\x15SimulateAttackRequest\x12,\n\x12\x61ttacker_unit_type\x18\x01 \x01(\x05R\x10\x61ttackerUnitType\x12)\n\x10\x61ttacker_terrain\x18\x02 \x01(\x05R\x0f\x61ttackerTerrain\x12\'\n\x0f\x61ttacker_health\x18\x03 \x01(\x05R\x0e\x61ttackerHealth\x12,\n\x12\x64\x65\x66\x65nder_unit_type\x18\x04 \x01(\x05R\x10\x64\x65\x66\x65nderUnitType\x12)\n\x10\x64\x65\x66\x65nder_terrain\x18\x05 \x01(\x05R\x0f\x64\x65\x66\x65nderTerrain\x12\'\n\x0f\x64\x65\x66\x65nder_health\x18\x06 \x01(\x05R\x0e\x64\x65\x66\x65nderHealth\x12\x1f\n\x0bwound_bonus\x18\x07 \x01(\x05R\nwoundBonus\x12\'\n\x0fnum_simulations\x18\x08 \x01(\x05R\x0enumSimulations\"\xa4\x05\n\x16SimulateAttackResponse\x12\x86\x01\n\x1c\x61ttacker_damage_distribution\x18\x01 \x03(\x0b\x32\x44.lilbattle.v1.SimulateAttackResponse.AttackerDamageDistributionEntryR\x1a\x61ttackerDamageDistribution\x12\x86\x01\n\x1c\x64\x65\x66\x65nder_damage_distribution\x18\x02 \x03(\x0b\x32\x44.lilbattle.v1.SimulateAttackResponse.DefenderDamageDistributionEntryR\x1a\x64\x65\x66\x65nderDamageDistribution\x12\x30\n\x14\x61ttacker_mean_damage\x18\x03 \x01(\x01R\x12\x61ttackerMeanDamage\x12\x30\n\x14\x64\x65\x66\x65nder_mean_damage\x18\x04 \x01(\x01R\x12\x64\x65\x66\x65nderMeanDamage\x12:\n\x19\x61ttacker_kill_probability\x18\x05 \x01(\x01R\x17\x61ttackerKillProbability\x12:\n\x19\x64\x65\x66\x65nder_kill_probability\x18\x06 \x01(\x01R\x17\x64\x65\x66\x65nderKillProbability\x1aM\n\x1f\x41ttackerDamageDistributionEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\x1aM\n\x1f\x44\x65\x66\x65nderDamageDistributionEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\"\xc1\x01\n\x12SimulateFixRequest\x12(\n\x10\x66ixing_unit_type\x18\x01 \x01(\x05R\x0e\x66ixingUnitType\x12,\n\x12\x66ixing_unit_health\x18\x02 \x01(\x05R\x10\x66ixingUnitHealth\x12*\n\x11injured_unit_type\x18\x03 \x01(\x05R\x0finjuredUnitType\x12\'\n\x0fnum_simulations\x18\x04 \x01(\x05R\x0enumSimulations\"\x8c\x02\n\x13SimulateFixResponse\x12m\n\x14healing_distribution\x18\x01 \x03(\x0b\x32:.lilbattle.v1.SimulateFixResponse.HealingDistributionEntryR\x13healingDistribution\x12!\n\x0cmean_healing\x18\x02 \x01(\x01R\x0bmeanHealing\x12\x1b\n\tfix_value\x18\x03 \x01(\x05R\x08\x66ixValue\x1a\x46\n\x18HealingDistributionEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\"G\n\x0fJoinGameRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n\tplayer_id\x18\x02 \x01(\x05R\x08playerId\"W\n\x10JoinGameResponse\x12&\n\x04game\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game\x12\x1b\n\tplayer_id\x18\x02 \x01(\x05R\x08playerId\"k\n\x13\x43ommitOrdersRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n\tplayer_id\x18\x02 \x01(\x05R\x08playerId\x12\x1e\n\ncommitment\x18\x03 \x01(\tR\ncommitment\"R\n\x14\x43ommitOrdersResponse\x12\x1b\n\tplayer_id\x18\x01 \x01(\x05R\x08playerId\x12\x1d\n\nwaiting_on\x18\x02 \x03(\x05R\twaitingOn\"\x8d\x01\n\x13RevealOrdersRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n\tplayer_id\x18\x02 \x01(\x05R\x08playerId\x12,\n\x05moves\x18\x03 \x03(\x0b\x32\x16.lilbattle.v1.GameMoveR\x05moves\x12\x12\n\x04salt\x18\x04 \x01(\tR\x04salt\"\x9c\x01\n\x14RevealOrdersResponse\x12\x1b\n\tplayer_id\x18\x01 \x01(\x05R\x08playerId\x12\x1d\n\nwaiting_on\x18\x02 \x03(\x05R\twaitingOn\x12\x1a\n\x08resolved\x18\x03 \x01(\x08R\x08resolved\x12,\n\x05moves\x18\x04 \x03(\x0b\x32\x16.lilbattle.v1.GameMoveR\x05moves\"\xa2\x01\n\x12ListMyGamesRequest\x12\x38\n\npagination\x18\x01 \x01(\x0b\x32\x18.lilbattle.v1.PaginationR\npagination\x12 \n\x0conly_my_turn\x18\x02 \x01(\x08R\nonlyMyTurn\x12\x30\n\x06status\x18\x03 \x01(\x0e\x32\x18.lilbattle.v1.GameStatusR\x06status\"\x85\x01\n\x13ListMyGamesResponse\x12,\n\x05items\x18\x01 \x03(\x0b\x32\x16.lilbattle.v1.UserGameR\x05items\x12@\n\npagination\x18\x02 \x01(\x0b\x32 .lilbattle.v1.PaginationResponseR\npagination\",\n\x11\x45xportGameRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\"Q\n\x12\x45xportGameResponse\x12\x18\n\x07\x61rchive\x18\x01 \x01(\x0cR\x07\x61rchive\x12!\n\x0c\x63ontent_hash\x18\x02 \x01(\tR\x0b\x63ontentHash\"F\n\x11ImportGameRequest\x12\x18\n\x07\x61rchive\x18\x01 \x01(\x0cR\x07\x61rchive\x12\x17\n\x07game_id\x18\x02 \x01(\tR\x06gameId\"\xbf\x02\n\x12ImportGameResponse\x12&\n\x04game\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game\x12!\n\x0c\x63ontent_hash\x18\x02 \x01(\tR\x0b\x63ontentHash\x12\'\n\x0freplayed_groups\x18\x03 \x01(\x03R\x0ereplayedGroups\x12\x1f\n\x0brules_match\x18\x04 \x01(\x08R\nrulesMatch\x12T\n\x0c\x66ield_errors\x18\x05 \x03(\x0b\x32\x31.lilbattle.v1.ImportGameResponse.FieldErrorsEntryR\x0b\x66ieldErrors\x1a>\n\x10\x46ieldErrorsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\x42\xbd\x01\n\x10\x63om.lilbattle.v1B\x11GamesServiceProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -50,72 +50,96 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_SIMULATEATTACKRESPONSE_DEFENDERDAMAGEDISTRIBUTIONENTRY']._serialized_options = b'8\001'
   _globals['_SIMULATEFIXRESPONSE_HEALINGDISTRIBUTIONENTRY']._loaded_options = None
   _globals['_SIMULATEFIXRESPONSE_HEALINGDISTRIBUTIONENTRY']._serialized_options = b'8\001'
-  _globals['_LISTGAMESREQUEST']._serialized_start=203
-  _globals['_LISTGAMESREQUEST']._serialized_end=306
-  _globals['_LISTGAMESRESPONSE']._serialized_start=308
-  _globals['_LISTGAMESRESPONSE']._serialized_end=435
-  _globals['_GETGAMEREQUEST']._serialized_start=437
-  _globals['_GETGAMEREQUEST']._serialized_end=495
-  _globals['_GETGAMERESPONSE']._serialized_start=498
-  _globals['_GETGAMERESPONSE']._serialized_end=659
-  _globals['_GETGAMECONTENTREQUEST']._serialized_start=661
-  _globals['_GETGAMECONTENTREQUEST']._serialized_end=726
-  _globals['_GETGAMECONTENTRESPONSE']._serialized_start=729
-  _globals['_GETGAMECONTENTRESPONSE']._serialized_end=876
-  _globals['_UPDATEGAMEREQUEST']._serialized_start=879
-  _globals['_UPDATEGAMEREQUEST']._serialized_end=1175
-  _globals['_UPDATEGAMERESPONSE']._serialized_start=1177
-  _globals['_UPDATEGAMERESPONSE']._serialized_end=1264
-  _globals['_DELETEGAMEREQUEST']._serialized_start=1266
-  _globals['_DELETEGAMEREQUEST']._serialized_end=1301
-  _globals['_DELETEGAMERESPONSE']._serialized_start=1303
-  _globals['_DELETEGAMERESPONSE']._serialized_end=1323
-  _globals['_GETGAMESREQUEST']._serialized_start=1325
-  _globals['_GETGAMESREQUEST']._serialized_end=1360
-  _globals['_GETGAMESRESPONSE']._serialized_start=1363
-  _globals['_GETGAMESRESPONSE']._serialized_end=1524
-  _globals['_GETGAMESRESPONSE_GAMESENTRY']._serialized_start=1448
-  _globals['_GETGAMESRESPONSE_GAMESENTRY']._serialized_end=1524
-  _globals['_CREATEGAMEREQUEST']._serialized_start=1526
-  _globals['_CREATEGAMEREQUEST']._serialized_end=1585
-  _globals['_CREATEGAMERESPONSE']._serialized_start=1588
-  _globals['_CREATEGAMERESPONSE']._serialized_end=1854
-  _globals['_CREATEGAMERESPONSE_FIELDERRORSENTRY']._serialized_start=1792
-  _globals['_CREATEGAMERESPONSE_FIELDERRORSENTRY']._serialized_end=1854
-  _globals['_PROCESSMOVESREQUEST']._serialized_start=1857
-  _globals['_PROCESSMOVESREQUEST']._serialized_end=2055
-  _globals['_PROCESSMOVESRESPONSE']._serialized_start=2057
-  _globals['_PROCESSMOVESRESPONSE']._serialized_end=2125
-  _globals['_GETGAMESTATEREQUEST']._serialized_start=2127
-  _globals['_GETGAMESTATEREQUEST']._serialized_end=2173
-  _globals['_GETGAMESTATERESPONSE']._serialized_start=2175
-  _globals['_GETGAMESTATERESPONSE']._serialized_end=2244
-  _globals['_LISTMOVESREQUEST']._serialized_start=2246
-  _globals['_LISTMOVESREQUEST']._serialized_end=2347
-  _globals['_LISTMOVESRESPONSE']._serialized_start=2349
-  _globals['_LISTMOVESRESPONSE']._serialized_end=2457
-  _globals['_GETOPTIONSATREQUEST']._serialized_start=2459
-  _globals['_GETOPTIONSATREQUEST']._serialized_end=2547
-  _globals['_GETOPTIONSATRESPONSE']._serialized_start=2550
-  _globals['_GETOPTIONSATRESPONSE']._serialized_end=2759
-  _globals['_GAMEOPTION']._serialized_start=2762
-  _globals['_GAMEOPTION']._serialized_end=3129
-  _globals['_SIMULATEATTACKREQUEST']._serialized_start=3132
-  _globals['_SIMULATEATTACKREQUEST']._serialized_end=3489
-  _globals['_SIMULATEATTACKRESPONSE']._serialized_start=3492
-  _globals['_SIMULATEATTACKRESPONSE']._serialized_end=4168
-  _globals['_SIMULATEATTACKRESPONSE_ATTACKERDAMAGEDISTRIBUTIONENTRY']._serialized_start=4012
-  _globals['_SIMULATEATTACKRESPONSE_ATTACKERDAMAGEDISTRIBUTIONENTRY']._serialized_end=4089
-  _globals['_SIMULATEATTACKRESPONSE_DEFENDERDAMAGEDISTRIBUTIONENTRY']._serialized_start=4091
-  _globals['_SIMULATEATTACKRESPONSE_DEFENDERDAMAGEDISTRIBUTIONENTRY']._serialized_end=4168
-  _globals['_SIMULATEFIXREQUEST']._serialized_start=4171
-  _globals['_SIMULATEFIXREQUEST']._serialized_end=4364
-  _globals['_SIMULATEFIXRESPONSE']._serialized_start=4367
-  _globals['_SIMULATEFIXRESPONSE']._serialized_end=4635
-  _globals['_SIMULATEFIXRESPONSE_HEALINGDISTRIBUTIONENTRY']._serialized_start=4565
-  _globals['_SIMULATEFIXRESPONSE_HEALINGDISTRIBUTIONENTRY']._serialized_end=4635
-  _globals['_JOINGAMEREQUEST']._serialized_start=4637
-  _globals['_JOINGAMEREQUEST']._serialized_end=4708
-  _globals['_JOINGAMERESPONSE']._serialized_start=4710
-  _globals['_JOINGAMERESPONSE']._serialized_end=4797
+  _globals['_IMPORTGAMERESPONSE_FIELDERRORSENTRY']._loaded_options = None
+  _globals['_IMPORTGAMERESPONSE_FIELDERRORSENTRY']._serialized_options = b'8\001'
+  _globals['_LISTGAMESREQUEST']._serialized_start=204
+  _globals['_LISTGAMESREQUEST']._serialized_end=598
+  _globals['_LISTGAMESRESPONSE']._serialized_start=600
+  _globals['_LISTGAMESRESPONSE']._serialized_end=727
+  _globals['_GETGAMEREQUEST']._serialized_start=729
+  _globals['_GETGAMEREQUEST']._serialized_end=787
+  _globals['_GETGAMERESPONSE']._serialized_start=790
+  _globals['_GETGAMERESPONSE']._serialized_end=951
+  _globals['_GETGAMECONTENTREQUEST']._serialized_start=953
+  _globals['_GETGAMECONTENTREQUEST']._serialized_end=1018
+  _globals['_GETGAMECONTENTRESPONSE']._serialized_start=1021
+  _globals['_GETGAMECONTENTRESPONSE']._serialized_end=1168
+  _globals['_UPDATEGAMEREQUEST']._serialized_start=1171
+  _globals['_UPDATEGAMEREQUEST']._serialized_end=1467
+  _globals['_UPDATEGAMERESPONSE']._serialized_start=1469
+  _globals['_UPDATEGAMERESPONSE']._serialized_end=1556
+  _globals['_DELETEGAMEREQUEST']._serialized_start=1558
+  _globals['_DELETEGAMEREQUEST']._serialized_end=1593
+  _globals['_DELETEGAMERESPONSE']._serialized_start=1595
+  _globals['_DELETEGAMERESPONSE']._serialized_end=1615
+  _globals['_GETGAMESREQUEST']._serialized_start=1617
+  _globals['_GETGAMESREQUEST']._serialized_end=1652
+  _globals['_GETGAMESRESPONSE']._serialized_start=1655
+  _globals['_GETGAMESRESPONSE']._serialized_end=1816
+  _globals['_GETGAMESRESPONSE_GAMESENTRY']._serialized_start=1740
+  _globals['_GETGAMESRESPONSE_GAMESENTRY']._serialized_end=1816
+  _globals['_CREATEGAMEREQUEST']._serialized_start=1818
+  _globals['_CREATEGAMEREQUEST']._serialized_end=1877
+  _globals['_CREATEGAMERESPONSE']._serialized_start=1880
+  _globals['_CREATEGAMERESPONSE']._serialized_end=2146
+  _globals['_CREATEGAMERESPONSE_FIELDERRORSENTRY']._serialized_start=2084
+  _globals['_CREATEGAMERESPONSE_FIELDERRORSENTRY']._serialized_end=2146
+  _globals['_PROCESSMOVESREQUEST']._serialized_start=2149
+  _globals['_PROCESSMOVESREQUEST']._serialized_end=2347
+  _globals['_PROCESSMOVESRESPONSE']._serialized_start=2349
+  _globals['_PROCESSMOVESRESPONSE']._serialized_end=2417
+  _globals['_GETGAMESTATEREQUEST']._serialized_start=2419
+  _globals['_GETGAMESTATEREQUEST']._serialized_end=2465
+  _globals['_GETGAMESTATERESPONSE']._serialized_start=2467
+  _globals['_GETGAMESTATERESPONSE']._serialized_end=2536
+  _globals['_LISTMOVESREQUEST']._serialized_start=2538
+  _globals['_LISTMOVESREQUEST']._serialized_end=2639
+  _globals['_LISTMOVESRESPONSE']._serialized_start=2641
+  _globals['_LISTMOVESRESPONSE']._serialized_end=2749
+  _globals['_GETOPTIONSATREQUEST']._serialized_start=2751
+  _globals['_GETOPTIONSATREQUEST']._serialized_end=2839
+  _globals['_GETOPTIONSATRESPONSE']._serialized_start=2842
+  _globals['_GETOPTIONSATRESPONSE']._serialized_end=3051
+  _globals['_GAMEOPTION']._serialized_start=3054
+  _globals['_GAMEOPTION']._serialized_end=3421
+  _globals['_SIMULATEATTACKREQUEST']._serialized_start=3424
+  _globals['_SIMULATEATTACKREQUEST']._serialized_end=3781
+  _globals['_SIMULATEATTACKRESPONSE']._serialized_start=3784
+  _globals['_SIMULATEATTACKRESPONSE']._serialized_end=4460
+  _globals['_SIMULATEATTACKRESPONSE_ATTACKERDAMAGEDISTRIBUTIONENTRY']._serialized_start=4304
+  _globals['_SIMULATEATTACKRESPONSE_ATTACKERDAMAGEDISTRIBUTIONENTRY']._serialized_end=4381
+  _globals['_SIMULATEATTACKRESPONSE_DEFENDERDAMAGEDISTRIBUTIONENTRY']._serialized_start=4383
+  _globals['_SIMULATEATTACKRESPONSE_DEFENDERDAMAGEDISTRIBUTIONENTRY']._serialized_end=4460
+  _globals['_SIMULATEFIXREQUEST']._serialized_start=4463
+  _globals['_SIMULATEFIXREQUEST']._serialized_end=4656
+  _globals['_SIMULATEFIXRESPONSE']._serialized_start=4659
+  _globals['_SIMULATEFIXRESPONSE']._serialized_end=4927
+  _globals['_SIMULATEFIXRESPONSE_HEALINGDISTRIBUTIONENTRY']._serialized_start=4857
+  _globals['_SIMULATEFIXRESPONSE_HEALINGDISTRIBUTIONENTRY']._serialized_end=4927
+  _globals['_JOINGAMEREQUEST']._serialized_start=4929
+  _globals['_JOINGAMEREQUEST']._serialized_end=5000
+  _globals['_JOINGAMERESPONSE']._serialized_start=5002
+  _globals['_JOINGAMERESPONSE']._serialized_end=5089
+  _globals['_COMMITORDERSREQUEST']._serialized_start=5091
+  _globals['_COMMITORDERSREQUEST']._serialized_end=5198
+  _globals['_COMMITORDERSRESPONSE']._serialized_start=5200
+  _globals['_COMMITORDERSRESPONSE']._serialized_end=5282
+  _globals['_REVEALORDERSREQUEST']._serialized_start=5285
+  _globals['_REVEALORDERSREQUEST']._serialized_end=5426
+  _globals['_REVEALORDERSRESPONSE']._serialized_start=5429
+  _globals['_REVEALORDERSRESPONSE']._serialized_end=5585
+  _globals['_LISTMYGAMESREQUEST']._serialized_start=5588
+  _globals['_LISTMYGAMESREQUEST']._serialized_end=5750
+  _globals['_LISTMYGAMESRESPONSE']._serialized_start=5753
+  _globals['_LISTMYGAMESRESPONSE']._serialized_end=5886
+  _globals['_EXPORTGAMEREQUEST']._serialized_start=5888
+  _globals['_EXPORTGAMEREQUEST']._serialized_end=5932
+  _globals['_EXPORTGAMERESPONSE']._serialized_start=5934
+  _globals['_EXPORTGAMERESPONSE']._serialized_end=6015
+  _globals['_IMPORTGAMEREQUEST']._serialized_start=6017
+  _globals['_IMPORTGAMEREQUEST']._serialized_end=6087
+  _globals['_IMPORTGAMERESPONSE']._serialized_start=6090
+  _globals['_IMPORTGAMERESPONSE']._serialized_end=6409
+  _globals['_IMPORTGAMERESPONSE_FIELDERRORSENTRY']._serialized_start=2084
+  _globals['_IMPORTGAMERESPONSE_FIELDERRORSENTRY']._serialized_end=2146
 # @@protoc_insertion_point(module_scope)
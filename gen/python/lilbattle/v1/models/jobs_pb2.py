@@ -25,9 +25,10 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 from google.protobuf import any_pb2 as google_dot_protobuf_dot_any__pb2
 from google.protobuf import field_mask_pb2 as google_dot_protobuf_dot_field__mask__pb2
+from lilbattle.v1.models import models_pb2 as lilbattle_dot_v1_dot_models_dot_models__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1elilbattle/v1/models/jobs.proto\x12\x0clilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/protobuf/any.proto\x1a google/protobuf/field_mask.proto\"\xf8\x02\n\x03Job\x12\x1f\n\x0b\x65ntity_type\x18\x01 \x01(\tR\nentityType\x12\x1b\n\tentity_id\x18\x02 \x01(\tR\x08\x65ntityId\x12\x19\n\x08job_type\x18\x03 \x01(\tR\x07jobType\x12\x39\n\ncreated_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n\x08job_data\x18\x06 \x01(\x0b\x32\x14.google.protobuf.AnyR\x07jobData\x12\x36\n\x17\x64\x65\x62ounce_window_seconds\x18\x07 \x01(\x05R\x15\x64\x65\x62ounceWindowSeconds\x12\x39\n\x0brepeat_info\x18\x08 \x01(\x0b\x32\x18.lilbattle.v1.RepeatInfoR\nrepeatInfo\"\x0c\n\nRepeatInfo\"\xaf\x03\n\x03Run\x12\x15\n\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x15\n\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x39\n\ncreated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nstarted_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tstartedAt\x12\x39\n\nupdated_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12,\n\x05state\x18\x06 \x01(\x0e\x32\x16.lilbattle.v1.RunStateR\x05state\x12/\n\x08run_data\x18\x07 \x01(\x0b\x32\x14.google.protobuf.AnyR\x07runData\x12\x1d\n\nlast_error\x18\x08 \x01(\tR\tlastError\x12*\n\x11last_content_hash\x18\t \x01(\tR\x0flastContentHash\x12\x1f\n\x0bretry_count\x18\n \x01(\x05R\nretryCount*T\n\x08RunState\x12\x19\n\x15RUN_STATE_UNSPECIFIED\x10\x00\x12\x15\n\x11RUN_STATE_STARTED\x10\x01\x12\x16\n\x12RUN_STATE_FINISHED\x10\x02\x42\xb5\x01\n\x10\x63om.lilbattle.v1B\tJobsProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1elilbattle/v1/models/jobs.proto\x12\x0clilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/protobuf/any.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\"\x88\x05\n\x03Job\x12\x1f\n\x0b\x65ntity_type\x18\x01 \x01(\tR\nentityType\x12\x1b\n\tentity_id\x18\x02 \x01(\tR\x08\x65ntityId\x12\x19\n\x08job_type\x18\x03 \x01(\tR\x07jobType\x12\x39\n\ncreated_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n\x08job_data\x18\x06 \x01(\x0b\x32\x14.google.protobuf.AnyR\x07jobData\x12\x36\n\x17\x64\x65\x62ounce_window_seconds\x18\x07 \x01(\x05R\x15\x64\x65\x62ounceWindowSeconds\x12\x39\n\x0brepeat_info\x18\x08 \x01(\x0b\x32\x18.lilbattle.v1.RepeatInfoR\nrepeatInfo\x12\x0e\n\x02id\x18\t \x01(\tR\x02id\x12/\n\x06status\x18\n \x01(\x0e\x32\x17.lilbattle.v1.JobStatusR\x06status\x12\x37\n\trun_after\x18\x0b \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x08runAfter\x12\x1a\n\x08\x61ttempts\x18\x0c \x01(\x05R\x08\x61ttempts\x12\x1f\n\x0bmax_retries\x18\r \x01(\x05R\nmaxRetries\x12\x1d\n\nlast_error\x18\x0e \x01(\tR\tlastError\x12\x1e\n\x0blast_run_id\x18\x0f \x01(\tR\tlastRunId\x12\x18\n\x07version\x18\x10 \x01(\x03R\x07version\"7\n\nRepeatInfo\x12)\n\x10interval_seconds\x18\x01 \x01(\x05R\x0fintervalSeconds\"\x87\x04\n\x03Run\x12\x15\n\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x15\n\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x39\n\ncreated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nstarted_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tstartedAt\x12\x39\n\nupdated_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12,\n\x05state\x18\x06 \x01(\x0e\x32\x16.lilbattle.v1.RunStateR\x05state\x12/\n\x08run_data\x18\x07 \x01(\x0b\x32\x14.google.protobuf.AnyR\x07runData\x12\x1d\n\nlast_error\x18\x08 \x01(\tR\tlastError\x12*\n\x11last_content_hash\x18\t \x01(\tR\x0flastContentHash\x12\x1f\n\x0bretry_count\x18\n \x01(\x05R\nretryCount\x12\x19\n\x08job_type\x18\x0b \x01(\tR\x07jobType\x12;\n\x0b\x66inished_at\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.TimestampR\nfinishedAt\"\x97\x01\n\x0fListJobsRequest\x12\x19\n\x08job_type\x18\x01 \x01(\tR\x07jobType\x12/\n\x06status\x18\x02 \x01(\x0e\x32\x17.lilbattle.v1.JobStatusR\x06status\x12\x38\n\npagination\x18\x03 \x01(\x0b\x32\x18.lilbattle.v1.PaginationR\npagination\"}\n\x10ListJobsResponse\x12\'\n\x05items\x18\x01 \x03(\x0b\x32\x11.lilbattle.v1.JobR\x05items\x12@\n\npagination\x18\x02 \x01(\x0b\x32 .lilbattle.v1.PaginationResponseR\npagination\"<\n\rGetJobRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n\trun_limit\x18\x02 \x01(\x05R\x08runLimit\"\\\n\x0eGetJobResponse\x12#\n\x03job\x18\x01 \x01(\x0b\x32\x11.lilbattle.v1.JobR\x03job\x12%\n\x04runs\x18\x02 \x03(\x0b\x32\x11.lilbattle.v1.RunR\x04runs\"!\n\x0fRetryJobRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"7\n\x10RetryJobResponse\x12#\n\x03job\x18\x01 \x01(\x0b\x32\x11.lilbattle.v1.JobR\x03job*j\n\x08RunState\x12\x19\n\x15RUN_STATE_UNSPECIFIED\x10\x00\x12\x15\n\x11RUN_STATE_STARTED\x10\x01\x12\x16\n\x12RUN_STATE_FINISHED\x10\x02\x12\x14\n\x10RUN_STATE_FAILED\x10\x03*\x88\x01\n\tJobStatus\x12\x1a\n\x16JOB_STATUS_UNSPECIFIED\x10\x00\x12\x16\n\x12JOB_STATUS_PENDING\x10\x01\x12\x16\n\x12JOB_STATUS_RUNNING\x10\x02\x12\x18\n\x14JOB_STATUS_SUCCEEDED\x10\x03\x12\x15\n\x11JOB_STATUS_FAILED\x10\x04\x42\xb5\x01\n\x10\x63om.lilbattle.v1B\tJobsProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -35,12 +36,26 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'lilbattle.v1.models.jobs_pb
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'\n\020com.lilbattle.v1B\tJobsProtoP\001ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\242\002\003LXX\252\002\014Lilbattle.V1\312\002\014Lilbattle\\V1\342\002\030Lilbattle\\V1\\GPBMetadata\352\002\rLilbattle::V1'
-  _globals['_RUNSTATE']._serialized_start=969
-  _globals['_RUNSTATE']._serialized_end=1053
-  _globals['_JOB']._serialized_start=143
-  _globals['_JOB']._serialized_end=519
-  _globals['_REPEATINFO']._serialized_start=521
-  _globals['_REPEATINFO']._serialized_end=533
-  _globals['_RUN']._serialized_start=536
-  _globals['_RUN']._serialized_end=967
+  _globals['_RUNSTATE']._serialized_start=1935
+  _globals['_RUNSTATE']._serialized_end=2041
+  _globals['_JOBSTATUS']._serialized_start=2044
+  _globals['_JOBSTATUS']._serialized_end=2180
+  _globals['_JOB']._serialized_start=177
+  _globals['_JOB']._serialized_end=825
+  _globals['_REPEATINFO']._serialized_start=827
+  _globals['_REPEATINFO']._serialized_end=882
+  _globals['_RUN']._serialized_start=885
+  _globals['_RUN']._serialized_end=1404
+  _globals['_LISTJOBSREQUEST']._serialized_start=1407
+  _globals['_LISTJOBSREQUEST']._serialized_end=1558
+  _globals['_LISTJOBSRESPONSE']._serialized_start=1560
+  _globals['_LISTJOBSRESPONSE']._serialized_end=1685
+  _globals['_GETJOBREQUEST']._serialized_start=1687
+  _globals['_GETJOBREQUEST']._serialized_end=1747
+  _globals['_GETJOBRESPONSE']._serialized_start=1749
+  _globals['_GETJOBRESPONSE']._serialized_end=1841
+  _globals['_RETRYJOBREQUEST']._serialized_start=1843
+  _globals['_RETRYJOBREQUEST']._serialized_end=1876
+  _globals['_RETRYJOBRESPONSE']._serialized_start=1878
+  _globals['_RETRYJOBRESPONSE']._serialized_end=1933
 # @@protoc_insertion_point(module_scope)
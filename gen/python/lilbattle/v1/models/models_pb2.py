@@ -26,7 +26,7 @@ from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__
 from google.protobuf import struct_pb2 as google_dot_protobuf_dot_struct__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n lilbattle/v1/models/models.proto\x12\x0clilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xba\x01\n\tIndexInfo\x12\x42\n\x0flast_updated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\rlastUpdatedAt\x12\x42\n\x0flast_indexed_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\rlastIndexedAt\x12%\n\x0eneeds_indexing\x18\x03 \x01(\x08R\rneedsIndexing\"e\n\nPagination\x12\x19\n\x08page_key\x18\x01 \x01(\tR\x07pageKey\x12\x1f\n\x0bpage_offset\x18\x02 \x01(\x05R\npageOffset\x12\x1b\n\tpage_size\x18\x03 \x01(\x05R\x08pageSize\"\xa2\x01\n\x12PaginationResponse\x12\"\n\rnext_page_key\x18\x02 \x01(\tR\x0bnextPageKey\x12(\n\x10next_page_offset\x18\x03 \x01(\x05R\x0enextPageOffset\x12\x19\n\x08has_more\x18\x04 \x01(\x08R\x07hasMore\x12#\n\rtotal_results\x18\x05 \x01(\x05R\x0ctotalResults\"\x86\x04\n\x05World\x12\x39\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n\x07version\x18\x03 \x01(\x03R\x07version\x12\x0e\n\x02id\x18\x04 \x01(\tR\x02id\x12\x1d\n\ncreator_id\x18\x05 \x01(\tR\tcreatorId\x12\x12\n\x04name\x18\x06 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x07 \x01(\tR\x0b\x64\x65scription\x12\x12\n\x04tags\x18\x08 \x03(\tR\x04tags\x12\x1b\n\timage_url\x18\t \x01(\tR\x08imageUrl\x12\x1e\n\ndifficulty\x18\n \x01(\tR\ndifficulty\x12!\n\x0cpreview_urls\x18\x0b \x03(\tR\x0bpreviewUrls\x12O\n\x13\x64\x65\x66\x61ult_game_config\x18\x0c \x01(\x0b\x32\x1f.lilbattle.v1.GameConfigurationR\x11\x64\x65\x66\x61ultGameConfig\x12\x43\n\x11search_index_info\x18\r \x01(\x0b\x32\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\"\xdb\x04\n\tWorldData\x12\x42\n\ttiles_map\x18\x01 \x03(\x0b\x32%.lilbattle.v1.WorldData.TilesMapEntryR\x08tilesMap\x12\x42\n\tunits_map\x18\x02 \x03(\x0b\x32%.lilbattle.v1.WorldData.UnitsMapEntryR\x08unitsMap\x12K\n\x15screenshot_index_info\x18\x03 \x01(\x0b\x32\x17.lilbattle.v1.IndexInfoR\x13screenshotIndexInfo\x12!\n\x0c\x63ontent_hash\x18\x04 \x01(\tR\x0b\x63ontentHash\x12\x18\n\x07version\x18\x05 \x01(\x03R\x07version\x12\x44\n\tcrossings\x18\x08 \x03(\x0b\x32&.lilbattle.v1.WorldData.CrossingsEntryR\tcrossings\x1aO\n\rTilesMapEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12(\n\x05value\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.TileR\x05value:\x02\x38\x01\x1aO\n\rUnitsMapEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12(\n\x05value\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x05value:\x02\x38\x01\x1aT\n\x0e\x43rossingsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12,\n\x05value\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.CrossingR\x05value:\x02\x38\x01\"[\n\x08\x43rossing\x12.\n\x04type\x18\x01 \x01(\x0e\x32\x1a.lilbattle.v1.CrossingTypeR\x04type\x12\x1f\n\x0b\x63onnects_to\x18\x02 \x03(\x08R\nconnectsTo\"\xc9\x01\n\x04Tile\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\ttile_type\x18\x03 \x01(\x05R\x08tileType\x12\x16\n\x06player\x18\x04 \x01(\x05R\x06player\x12\x1a\n\x08shortcut\x18\x05 \x01(\tR\x08shortcut\x12&\n\x0flast_acted_turn\x18\x06 \x01(\x05R\rlastActedTurn\x12,\n\x12last_toppedup_turn\x18\x07 \x01(\x05R\x10lastToppedupTurn\"\xa5\x04\n\x04Unit\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x16\n\x06player\x18\x03 \x01(\x05R\x06player\x12\x1b\n\tunit_type\x18\x04 \x01(\x05R\x08unitType\x12\x1a\n\x08shortcut\x18\x05 \x01(\tR\x08shortcut\x12)\n\x10\x61vailable_health\x18\x06 \x01(\x05R\x0f\x61vailableHealth\x12#\n\rdistance_left\x18\x07 \x01(\x01R\x0c\x64istanceLeft\x12&\n\x0flast_acted_turn\x18\x08 \x01(\x05R\rlastActedTurn\x12,\n\x12last_toppedup_turn\x18\t \x01(\x05R\x10lastToppedupTurn\x12;\n\x1a\x61ttacks_received_this_turn\x18\n \x01(\x05R\x17\x61ttacksReceivedThisTurn\x12\x41\n\x0e\x61ttack_history\x18\x0b \x03(\x0b\x32\x1a.lilbattle.v1.AttackRecordR\rattackHistory\x12)\n\x10progression_step\x18\x0c \x01(\x05R\x0fprogressionStep\x12-\n\x12\x63hosen_alternative\x18\r \x01(\tR\x11\x63hosenAlternative\x12\x30\n\x14\x63\x61pture_started_turn\x18\x0e \x01(\x05R\x12\x63\x61ptureStartedTurn\"h\n\x0c\x41ttackRecord\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\tis_ranged\x18\x03 \x01(\x08R\x08isRanged\x12\x1f\n\x0bturn_number\x18\x04 \x01(\x05R\nturnNumber\"\x89\x03\n\x11TerrainDefinition\x12\x0e\n\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n\x04type\x18\x05 \x01(\x05R\x04type\x12 \n\x0b\x64\x65scription\x18\x06 \x01(\tR\x0b\x64\x65scription\x12\\\n\x0funit_properties\x18\x07 \x03(\x0b\x32\x33.lilbattle.v1.TerrainDefinition.UnitPropertiesEntryR\x0eunitProperties\x12,\n\x12\x62uildable_unit_ids\x18\x08 \x03(\x05R\x10\x62uildableUnitIds\x12&\n\x0fincome_per_turn\x18\t \x01(\x05R\rincomePerTurn\x1a\x66\n\x13UnitPropertiesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x39\n\x05value\x18\x02 \x01(\x0b\x32#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x02\x38\x01\"\x82\x08\n\x0eUnitDefinition\x12\x0e\n\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x03 \x01(\tR\x0b\x64\x65scription\x12\x16\n\x06health\x18\x04 \x01(\x05R\x06health\x12\x14\n\x05\x63oins\x18\x05 \x01(\x05R\x05\x63oins\x12\'\n\x0fmovement_points\x18\x06 \x01(\x01R\x0emovementPoints\x12%\n\x0eretreat_points\x18\x07 \x01(\x01R\rretreatPoints\x12\x18\n\x07\x64\x65\x66\x65nse\x18\x08 \x01(\x05R\x07\x64\x65\x66\x65nse\x12!\n\x0c\x61ttack_range\x18\t \x01(\x05R\x0b\x61ttackRange\x12(\n\x10min_attack_range\x18\n \x01(\x05R\x0eminAttackRange\x12#\n\rsplash_damage\x18\x0b \x01(\x05R\x0csplashDamage\x12\x62\n\x12terrain_properties\x18\x0c \x03(\x0b\x32\x33.lilbattle.v1.UnitDefinition.TerrainPropertiesEntryR\x11terrainProperties\x12\x1e\n\nproperties\x18\r \x03(\tR\nproperties\x12\x1d\n\nunit_class\x18\x0e \x01(\tR\tunitClass\x12!\n\x0cunit_terrain\x18\x0f \x01(\tR\x0bunitTerrain\x12W\n\x0f\x61ttack_vs_class\x18\x10 \x03(\x0b\x32/.lilbattle.v1.UnitDefinition.AttackVsClassEntryR\rattackVsClass\x12!\n\x0c\x61\x63tion_order\x18\x11 \x03(\tR\x0b\x61\x63tionOrder\x12S\n\raction_limits\x18\x12 \x03(\x0b\x32..lilbattle.v1.UnitDefinition.ActionLimitsEntryR\x0c\x61\x63tionLimits\x12\x1b\n\tfix_value\x18\x13 \x01(\x05R\x08\x66ixValue\x1ai\n\x16TerrainPropertiesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x39\n\x05value\x18\x02 \x01(\x0b\x32#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x02\x38\x01\x1a@\n\x12\x41ttackVsClassEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\x1a?\n\x11\x41\x63tionLimitsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\"\xec\x02\n\x15TerrainUnitProperties\x12\x1d\n\nterrain_id\x18\x01 \x01(\x05R\tterrainId\x12\x17\n\x07unit_id\x18\x02 \x01(\x05R\x06unitId\x12#\n\rmovement_cost\x18\x03 \x01(\x01R\x0cmovementCost\x12#\n\rhealing_bonus\x18\x04 \x01(\x05R\x0chealingBonus\x12\x1b\n\tcan_build\x18\x05 \x01(\x08R\x08\x63\x61nBuild\x12\x1f\n\x0b\x63\x61n_capture\x18\x06 \x01(\x08R\ncanCapture\x12!\n\x0c\x61ttack_bonus\x18\x07 \x01(\x05R\x0b\x61ttackBonus\x12#\n\rdefense_bonus\x18\x08 \x01(\x05R\x0c\x64\x65\x66\x65nseBonus\x12!\n\x0c\x61ttack_range\x18\t \x01(\x05R\x0b\x61ttackRange\x12(\n\x10min_attack_range\x18\n \x01(\x05R\x0eminAttackRange\"\x97\x02\n\x12UnitUnitProperties\x12\x1f\n\x0b\x61ttacker_id\x18\x01 \x01(\x05R\nattackerId\x12\x1f\n\x0b\x64\x65\x66\x65nder_id\x18\x02 \x01(\x05R\ndefenderId\x12,\n\x0f\x61ttack_override\x18\x03 \x01(\x05H\x00R\x0e\x61ttackOverride\x88\x01\x01\x12.\n\x10\x64\x65\x66\x65nse_override\x18\x04 \x01(\x05H\x01R\x0f\x64\x65\x66\x65nseOverride\x88\x01\x01\x12\x38\n\x06\x64\x61mage\x18\x05 \x01(\x0b\x32 .lilbattle.v1.DamageDistributionR\x06\x64\x61mageB\x12\n\x10_attack_overrideB\x13\n\x11_defense_override\"\xae\x01\n\x12\x44\x61mageDistribution\x12\x1d\n\nmin_damage\x18\x01 \x01(\x01R\tminDamage\x12\x1d\n\nmax_damage\x18\x02 \x01(\x01R\tmaxDamage\x12\'\n\x0f\x65xpected_damage\x18\x03 \x01(\x01R\x0e\x65xpectedDamage\x12\x31\n\x06ranges\x18\x04 \x03(\x0b\x32\x19.lilbattle.v1.DamageRangeR\x06ranges\"i\n\x0b\x44\x61mageRange\x12\x1b\n\tmin_value\x18\x01 \x01(\x01R\x08minValue\x12\x1b\n\tmax_value\x18\x02 \x01(\x01R\x08maxValue\x12 \n\x0bprobability\x18\x03 \x01(\x01R\x0bprobability\"\x9d\x07\n\x0bRulesEngine\x12:\n\x05units\x18\x01 \x03(\x0b\x32$.lilbattle.v1.RulesEngine.UnitsEntryR\x05units\x12\x43\n\x08terrains\x18\x02 \x03(\x0b\x32\'.lilbattle.v1.RulesEngine.TerrainsEntryR\x08terrains\x12l\n\x17terrain_unit_properties\x18\x03 \x03(\x0b\x32\x34.lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntryR\x15terrainUnitProperties\x12\x63\n\x14unit_unit_properties\x18\x04 \x03(\x0b\x32\x31.lilbattle.v1.RulesEngine.UnitUnitPropertiesEntryR\x12unitUnitProperties\x12P\n\rterrain_types\x18\x05 \x03(\x0b\x32+.lilbattle.v1.RulesEngine.TerrainTypesEntryR\x0cterrainTypes\x1aV\n\nUnitsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32\x1c.lilbattle.v1.UnitDefinitionR\x05value:\x02\x38\x01\x1a\\\n\rTerrainsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x35\n\x05value\x18\x02 \x01(\x0b\x32\x1f.lilbattle.v1.TerrainDefinitionR\x05value:\x02\x38\x01\x1am\n\x1aTerrainUnitPropertiesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x39\n\x05value\x18\x02 \x01(\x0b\x32#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x02\x38\x01\x1ag\n\x17UnitUnitPropertiesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x36\n\x05value\x18\x02 \x01(\x0b\x32 .lilbattle.v1.UnitUnitPropertiesR\x05value:\x02\x38\x01\x1aZ\n\x11TerrainTypesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12/\n\x05value\x18\x02 \x01(\x0e\x32\x19.lilbattle.v1.TerrainTypeR\x05value:\x02\x38\x01\"\x88\x04\n\x04Game\x12\x39\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n\x07version\x18\x03 \x01(\x03R\x07version\x12\x0e\n\x02id\x18\x04 \x01(\tR\x02id\x12\x1d\n\ncreator_id\x18\x05 \x01(\tR\tcreatorId\x12\x19\n\x08world_id\x18\x06 \x01(\tR\x07worldId\x12\x12\n\x04name\x18\x07 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x08 \x01(\tR\x0b\x64\x65scription\x12\x12\n\x04tags\x18\t \x03(\tR\x04tags\x12\x1b\n\timage_url\x18\n \x01(\tR\x08imageUrl\x12\x1e\n\ndifficulty\x18\x0b \x01(\tR\ndifficulty\x12\x37\n\x06\x63onfig\x18\x0c \x01(\x0b\x32\x1f.lilbattle.v1.GameConfigurationR\x06\x63onfig\x12!\n\x0cpreview_urls\x18\r \x03(\tR\x0bpreviewUrls\x12\x43\n\x11search_index_info\x18\x0f \x01(\x0b\x32\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\"\xf0\x01\n\x11GameConfiguration\x12\x32\n\x07players\x18\x01 \x03(\x0b\x32\x18.lilbattle.v1.GamePlayerR\x07players\x12,\n\x05teams\x18\x02 \x03(\x0b\x32\x16.lilbattle.v1.GameTeamR\x05teams\x12\x41\n\x0eincome_configs\x18\x03 \x01(\x0b\x32\x1a.lilbattle.v1.IncomeConfigR\rincomeConfigs\x12\x36\n\x08settings\x18\x04 \x01(\x0b\x32\x1a.lilbattle.v1.GameSettingsR\x08settings\"\xab\x02\n\x0cIncomeConfig\x12%\n\x0estarting_coins\x18\x01 \x01(\x05R\rstartingCoins\x12\x1f\n\x0bgame_income\x18\x02 \x01(\x05R\ngameIncome\x12\'\n\x0flandbase_income\x18\x03 \x01(\x05R\x0elandbaseIncome\x12)\n\x10navalbase_income\x18\x04 \x01(\x05R\x0fnavalbaseIncome\x12-\n\x12\x61irportbase_income\x18\x05 \x01(\x05R\x11\x61irportbaseIncome\x12-\n\x12missilesilo_income\x18\x06 \x01(\x05R\x11missilesiloIncome\x12!\n\x0cmines_income\x18\x07 \x01(\x05R\x0bminesIncome\"\xea\x01\n\nGamePlayer\x12\x1b\n\tplayer_id\x18\x01 \x01(\x05R\x08playerId\x12\x17\n\x07user_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n\x0bplayer_type\x18\x03 \x01(\tR\nplayerType\x12\x14\n\x05\x63olor\x18\x04 \x01(\tR\x05\x63olor\x12\x17\n\x07team_id\x18\x05 \x01(\x05R\x06teamId\x12\x12\n\x04name\x18\x06 \x01(\tR\x04name\x12\x1b\n\tis_active\x18\x07 \x01(\x08R\x08isActive\x12%\n\x0estarting_coins\x18\x08 \x01(\x05R\rstartingCoins\"j\n\x08GameTeam\x12\x17\n\x07team_id\x18\x01 \x01(\x05R\x06teamId\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n\x05\x63olor\x18\x03 \x01(\tR\x05\x63olor\x12\x1b\n\tis_active\x18\x04 \x01(\x08R\x08isActive\"\x95\x01\n\x0cGameSettings\x12#\n\rallowed_units\x18\x01 \x03(\x05R\x0c\x61llowedUnits\x12&\n\x0fturn_time_limit\x18\x02 \x01(\x05R\rturnTimeLimit\x12\x1b\n\tteam_mode\x18\x03 \x01(\tR\x08teamMode\x12\x1b\n\tmax_turns\x18\x04 \x01(\x05R\x08maxTurns\"@\n\x0bPlayerState\x12\x14\n\x05\x63oins\x18\x01 \x01(\x05R\x05\x63oins\x12\x1b\n\tis_active\x18\x02 \x01(\x08R\x08isActive\"\x90\x05\n\tGameState\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n\x07game_id\x18\x03 \x01(\tR\x06gameId\x12!\n\x0cturn_counter\x18\x04 \x01(\x05R\x0bturnCounter\x12%\n\x0e\x63urrent_player\x18\x05 \x01(\x05R\rcurrentPlayer\x12\x36\n\nworld_data\x18\x06 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\x12\x1d\n\nstate_hash\x18\x08 \x01(\tR\tstateHash\x12\x18\n\x07version\x18\t \x01(\x03R\x07version\x12\x30\n\x06status\x18\n \x01(\x0e\x32\x18.lilbattle.v1.GameStatusR\x06status\x12\x1a\n\x08\x66inished\x18\x0b \x01(\x08R\x08\x66inished\x12%\n\x0ewinning_player\x18\x0c \x01(\x05R\rwinningPlayer\x12!\n\x0cwinning_team\x18\r \x01(\x05R\x0bwinningTeam\x12\x30\n\x14\x63urrent_group_number\x18\x0e \x01(\x03R\x12\x63urrentGroupNumber\x12N\n\rplayer_states\x18\x0f \x03(\x0b\x32).lilbattle.v1.GameState.PlayerStatesEntryR\x0cplayerStates\x1aZ\n\x11PlayerStatesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12/\n\x05value\x18\x02 \x01(\x0b\x32\x19.lilbattle.v1.PlayerStateR\x05value:\x02\x38\x01\"_\n\x0fGameMoveHistory\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x33\n\x06groups\x18\x02 \x03(\x0b\x32\x1b.lilbattle.v1.GameMoveGroupR\x06groups\"\xd2\x01\n\rGameMoveGroup\x12\x39\n\nstarted_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tstartedAt\x12\x35\n\x08\x65nded_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x07\x65ndedAt\x12!\n\x0cgroup_number\x18\x04 \x01(\x03R\x0bgroupNumber\x12,\n\x05moves\x18\x05 \x03(\x0b\x32\x16.lilbattle.v1.GameMoveR\x05moves\"\x8d\x06\n\x08GameMove\x12\x16\n\x06player\x18\x01 \x01(\x05R\x06player\x12!\n\x0cgroup_number\x18\x02 \x01(\x03R\x0bgroupNumber\x12\x1f\n\x0bmove_number\x18\x03 \x01(\x03R\nmoveNumber\x12\x38\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12;\n\tmove_unit\x18\x05 \x01(\x0b\x32\x1c.lilbattle.v1.MoveUnitActionH\x00R\x08moveUnit\x12\x41\n\x0b\x61ttack_unit\x18\x06 \x01(\x0b\x32\x1e.lilbattle.v1.AttackUnitActionH\x00R\nattackUnit\x12\x38\n\x08\x65nd_turn\x18\x07 \x01(\x0b\x32\x1b.lilbattle.v1.EndTurnActionH\x00R\x07\x65ndTurn\x12>\n\nbuild_unit\x18\x08 \x01(\x0b\x32\x1d.lilbattle.v1.BuildUnitActionH\x00R\tbuildUnit\x12P\n\x10\x63\x61pture_building\x18\r \x01(\x0b\x32#.lilbattle.v1.CaptureBuildingActionH\x00R\x0f\x63\x61ptureBuilding\x12;\n\theal_unit\x18\x0e \x01(\x0b\x32\x1c.lilbattle.v1.HealUnitActionH\x00R\x08healUnit\x12\x38\n\x08\x66ix_unit\x18\x0f \x01(\x0b\x32\x1b.lilbattle.v1.FixUnitActionH\x00R\x07\x66ixUnit\x12!\n\x0csequence_num\x18\t \x01(\x03R\x0bsequenceNum\x12!\n\x0cis_permanent\x18\n \x01(\x08R\x0bisPermanent\x12\x33\n\x07\x63hanges\x18\x0b \x03(\x0b\x32\x19.lilbattle.v1.WorldChangeR\x07\x63hanges\x12 \n\x0b\x64\x65scription\x18\x0c \x01(\tR\x0b\x64\x65scriptionB\x0b\n\tmove_type\"<\n\x08Position\x12\x14\n\x05label\x18\x01 \x01(\tR\x05label\x12\x0c\n\x01q\x18\x02 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x03 \x01(\x05R\x01r\"\xcc\x01\n\x0eMoveUnitAction\x12*\n\x04\x66rom\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x04\x66rom\x12&\n\x02to\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x02to\x12#\n\rmovement_cost\x18\x03 \x01(\x01R\x0cmovementCost\x12\x41\n\x12reconstructed_path\x18\x04 \x01(\x0b\x32\x12.lilbattle.v1.PathR\x11reconstructedPath\"\x9a\x02\n\x10\x41ttackUnitAction\x12\x32\n\x08\x61ttacker\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x08\x61ttacker\x12\x32\n\x08\x64\x65\x66\x65nder\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x08\x64\x65\x66\x65nder\x12(\n\x10target_unit_type\x18\x07 \x01(\x05R\x0etargetUnitType\x12,\n\x12target_unit_health\x18\x08 \x01(\x05R\x10targetUnitHealth\x12\x1d\n\ncan_attack\x18\t \x01(\x08R\tcanAttack\x12\'\n\x0f\x64\x61mage_estimate\x18\n \x01(\x05R\x0e\x64\x61mageEstimate\"l\n\x0f\x42uildUnitAction\x12(\n\x03pos\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x03pos\x12\x1b\n\tunit_type\x18\x02 \x01(\x05R\x08unitType\x12\x12\n\x04\x63ost\x18\x03 \x01(\x05R\x04\x63ost\"^\n\x15\x43\x61ptureBuildingAction\x12(\n\x03pos\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x03pos\x12\x1b\n\ttile_type\x18\x03 \x01(\x05R\x08tileType\"\x0f\n\rEndTurnAction\"[\n\x0eHealUnitAction\x12(\n\x03pos\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x03pos\x12\x1f\n\x0bheal_amount\x18\x02 \x01(\x05R\nhealAmount\"\x8c\x01\n\rFixUnitAction\x12,\n\x05\x66ixer\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x05\x66ixer\x12.\n\x06target\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x06target\x12\x1d\n\nfix_amount\x18\x03 \x01(\x05R\tfixAmount\"\xd5\x05\n\x0bWorldChange\x12>\n\nunit_moved\x18\x01 \x01(\x0b\x32\x1d.lilbattle.v1.UnitMovedChangeH\x00R\tunitMoved\x12\x44\n\x0cunit_damaged\x18\x02 \x01(\x0b\x32\x1f.lilbattle.v1.UnitDamagedChangeH\x00R\x0bunitDamaged\x12\x41\n\x0bunit_killed\x18\x03 \x01(\x0b\x32\x1e.lilbattle.v1.UnitKilledChangeH\x00R\nunitKilled\x12J\n\x0eplayer_changed\x18\x04 \x01(\x0b\x32!.lilbattle.v1.PlayerChangedChangeH\x00R\rplayerChanged\x12>\n\nunit_built\x18\x05 \x01(\x0b\x32\x1d.lilbattle.v1.UnitBuiltChangeH\x00R\tunitBuilt\x12G\n\rcoins_changed\x18\x06 \x01(\x0b\x32 .lilbattle.v1.CoinsChangedChangeH\x00R\x0c\x63oinsChanged\x12G\n\rtile_captured\x18\x07 \x01(\x0b\x32 .lilbattle.v1.TileCapturedChangeH\x00R\x0ctileCaptured\x12M\n\x0f\x63\x61pture_started\x18\x08 \x01(\x0b\x32\".lilbattle.v1.CaptureStartedChangeH\x00R\x0e\x63\x61ptureStarted\x12\x41\n\x0bunit_healed\x18\t \x01(\x0b\x32\x1e.lilbattle.v1.UnitHealedChangeH\x00R\nunitHealed\x12>\n\nunit_fixed\x18\n \x01(\x0b\x32\x1d.lilbattle.v1.UnitFixedChangeH\x00R\tunitFixedB\r\n\x0b\x63hange_type\"\xa3\x01\n\x10UnitHealedChange\x12\x37\n\rprevious_unit\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0cpreviousUnit\x12\x35\n\x0cupdated_unit\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0bupdatedUnit\x12\x1f\n\x0bheal_amount\x18\x03 \x01(\x05R\nhealAmount\"\xdb\x01\n\x0fUnitFixedChange\x12\x31\n\nfixer_unit\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\tfixerUnit\x12;\n\x0fprevious_target\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0epreviousTarget\x12\x39\n\x0eupdated_target\x18\x03 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\rupdatedTarget\x12\x1d\n\nfix_amount\x18\x04 \x01(\x05R\tfixAmount\"\x81\x01\n\x0fUnitMovedChange\x12\x37\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0cpreviousUnit\x12\x35\n\x0cupdated_unit\x18\x07 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0bupdatedUnit\"\x83\x01\n\x11UnitDamagedChange\x12\x37\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0cpreviousUnit\x12\x35\n\x0cupdated_unit\x18\x07 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0bupdatedUnit\"K\n\x10UnitKilledChange\x12\x37\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0cpreviousUnit\"\xd2\x01\n\x13PlayerChangedChange\x12\'\n\x0fprevious_player\x18\x01 \x01(\x05R\x0epreviousPlayer\x12\x1d\n\nnew_player\x18\x02 \x01(\x05R\tnewPlayer\x12#\n\rprevious_turn\x18\x03 \x01(\x05R\x0cpreviousTurn\x12\x19\n\x08new_turn\x18\x04 \x01(\x05R\x07newTurn\x12\x33\n\x0breset_units\x18\x05 \x03(\x0b\x32\x12.lilbattle.v1.UnitR\nresetUnits\"\xa9\x01\n\x0fUnitBuiltChange\x12&\n\x04unit\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x04unit\x12\x15\n\x06tile_q\x18\x02 \x01(\x05R\x05tileQ\x12\x15\n\x06tile_r\x18\x03 \x01(\x05R\x05tileR\x12\x1d\n\ncoins_cost\x18\x04 \x01(\x05R\tcoinsCost\x12!\n\x0cplayer_coins\x18\x05 \x01(\x05R\x0bplayerCoins\"\x8d\x01\n\x12\x43oinsChangedChange\x12\x1b\n\tplayer_id\x18\x01 \x01(\x05R\x08playerId\x12%\n\x0eprevious_coins\x18\x02 \x01(\x05R\rpreviousCoins\x12\x1b\n\tnew_coins\x18\x03 \x01(\x05R\x08newCoins\x12\x16\n\x06reason\x18\x04 \x01(\tR\x06reason\"\xde\x01\n\x12TileCapturedChange\x12\x39\n\x0e\x63\x61pturing_unit\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\rcapturingUnit\x12\x15\n\x06tile_q\x18\x02 \x01(\x05R\x05tileQ\x12\x15\n\x06tile_r\x18\x03 \x01(\x05R\x05tileR\x12\x1b\n\ttile_type\x18\x04 \x01(\x05R\x08tileType\x12%\n\x0eprevious_owner\x18\x05 \x01(\x05R\rpreviousOwner\x12\x1b\n\tnew_owner\x18\x06 \x01(\x05R\x08newOwner\"\xc1\x01\n\x14\x43\x61ptureStartedChange\x12\x39\n\x0e\x63\x61pturing_unit\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\rcapturingUnit\x12\x15\n\x06tile_q\x18\x02 \x01(\x05R\x05tileQ\x12\x15\n\x06tile_r\x18\x03 \x01(\x05R\x05tileR\x12\x1b\n\ttile_type\x18\x04 \x01(\x05R\x08tileType\x12#\n\rcurrent_owner\x18\x05 \x01(\x05R\x0c\x63urrentOwner\"\xcb\x01\n\x08\x41llPaths\x12\x19\n\x08source_q\x18\x01 \x01(\x05R\x07sourceQ\x12\x19\n\x08source_r\x18\x02 \x01(\x05R\x07sourceR\x12\x37\n\x05\x65\x64ges\x18\x03 \x03(\x0b\x32!.lilbattle.v1.AllPaths.EdgesEntryR\x05\x65\x64ges\x1aP\n\nEdgesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12,\n\x05value\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.PathEdgeR\x05value:\x02\x38\x01\"\x88\x02\n\x08PathEdge\x12\x15\n\x06\x66rom_q\x18\x01 \x01(\x05R\x05\x66romQ\x12\x15\n\x06\x66rom_r\x18\x02 \x01(\x05R\x05\x66romR\x12\x11\n\x04to_q\x18\x03 \x01(\x05R\x03toQ\x12\x11\n\x04to_r\x18\x04 \x01(\x05R\x03toR\x12#\n\rmovement_cost\x18\x05 \x01(\x01R\x0cmovementCost\x12\x1d\n\ntotal_cost\x18\x06 \x01(\x01R\ttotalCost\x12!\n\x0cterrain_type\x18\x07 \x01(\tR\x0bterrainType\x12 \n\x0b\x65xplanation\x18\x08 \x01(\tR\x0b\x65xplanation\x12\x1f\n\x0bis_occupied\x18\t \x01(\x08R\nisOccupied\"\x90\x01\n\x04Path\x12,\n\x05\x65\x64ges\x18\x01 \x03(\x0b\x32\x16.lilbattle.v1.PathEdgeR\x05\x65\x64ges\x12;\n\ndirections\x18\x02 \x03(\x0e\x32\x1b.lilbattle.v1.PathDirectionR\ndirections\x12\x1d\n\ntotal_cost\x18\x03 \x01(\x01R\ttotalCost*_\n\x0c\x43rossingType\x12\x1d\n\x19\x43ROSSING_TYPE_UNSPECIFIED\x10\x00\x12\x16\n\x12\x43ROSSING_TYPE_ROAD\x10\x01\x12\x18\n\x14\x43ROSSING_TYPE_BRIDGE\x10\x02*\xa3\x01\n\x0bTerrainType\x12\x1c\n\x18TERRAIN_TYPE_UNSPECIFIED\x10\x00\x12\x15\n\x11TERRAIN_TYPE_CITY\x10\x01\x12\x17\n\x13TERRAIN_TYPE_NATURE\x10\x02\x12\x17\n\x13TERRAIN_TYPE_BRIDGE\x10\x03\x12\x16\n\x12TERRAIN_TYPE_WATER\x10\x04\x12\x15\n\x11TERRAIN_TYPE_ROAD\x10\x05*q\n\nGameStatus\x12\x1b\n\x17GAME_STATUS_UNSPECIFIED\x10\x00\x12\x17\n\x13GAME_STATUS_PLAYING\x10\x01\x12\x16\n\x12GAME_STATUS_PAUSED\x10\x02\x12\x15\n\x11GAME_STATUS_ENDED\x10\x03*\xde\x01\n\rPathDirection\x12\x1e\n\x1aPATH_DIRECTION_UNSPECIFIED\x10\x00\x12\x17\n\x13PATH_DIRECTION_LEFT\x10\x01\x12\x1b\n\x17PATH_DIRECTION_TOP_LEFT\x10\x02\x12\x1c\n\x18PATH_DIRECTION_TOP_RIGHT\x10\x03\x12\x18\n\x14PATH_DIRECTION_RIGHT\x10\x04\x12\x1f\n\x1bPATH_DIRECTION_BOTTOM_RIGHT\x10\x05\x12\x1e\n\x1aPATH_DIRECTION_BOTTOM_LEFT\x10\x06\x42\xb7\x01\n\x10\x63om.lilbattle.v1B\x0bModelsProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n lilbattle/v1/models/models.proto\x12\x0clilbattle.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xba\x01\n\tIndexInfo\x12\x42\n\x0flast_updated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\rlastUpdatedAt\x12\x42\n\x0flast_indexed_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\rlastIndexedAt\x12%\n\x0eneeds_indexing\x18\x03 \x01(\x08R\rneedsIndexing\"e\n\nPagination\x12\x19\n\x08page_key\x18\x01 \x01(\tR\x07pageKey\x12\x1f\n\x0bpage_offset\x18\x02 \x01(\x05R\npageOffset\x12\x1b\n\tpage_size\x18\x03 \x01(\x05R\x08pageSize\"\xa2\x01\n\x12PaginationResponse\x12\"\n\rnext_page_key\x18\x02 \x01(\tR\x0bnextPageKey\x12(\n\x10next_page_offset\x18\x03 \x01(\x05R\x0enextPageOffset\x12\x19\n\x08has_more\x18\x04 \x01(\x08R\x07hasMore\x12#\n\rtotal_results\x18\x05 \x01(\x05R\x0ctotalResults\"\xd7\x04\n\x05World\x12\x39\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n\x07version\x18\x03 \x01(\x03R\x07version\x12\x0e\n\x02id\x18\x04 \x01(\tR\x02id\x12\x1d\n\ncreator_id\x18\x05 \x01(\tR\tcreatorId\x12\x12\n\x04name\x18\x06 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x07 \x01(\tR\x0b\x64\x65scription\x12\x12\n\x04tags\x18\x08 \x03(\tR\x04tags\x12\x1b\n\timage_url\x18\t \x01(\tR\x08imageUrl\x12\x1e\n\ndifficulty\x18\n \x01(\tR\ndifficulty\x12!\n\x0cpreview_urls\x18\x0b \x03(\tR\x0bpreviewUrls\x12O\n\x13\x64\x65\x66\x61ult_game_config\x18\x0c \x01(\x0b\x32\x1f.lilbattle.v1.GameConfigurationR\x11\x64\x65\x66\x61ultGameConfig\x12\x43\n\x11search_index_info\x18\r \x01(\x0b\x32\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\x12&\n\x0fparent_world_id\x18\x0e \x01(\tR\rparentWorldId\x12\'\n\x0fparent_revision\x18\x0f \x01(\x03R\x0eparentRevision\"\xdb\x04\n\tWorldData\x12\x42\n\ttiles_map\x18\x01 \x03(\x0b\x32%.lilbattle.v1.WorldData.TilesMapEntryR\x08tilesMap\x12\x42\n\tunits_map\x18\x02 \x03(\x0b\x32%.lilbattle.v1.WorldData.UnitsMapEntryR\x08unitsMap\x12K\n\x15screenshot_index_info\x18\x03 \x01(\x0b\x32\x17.lilbattle.v1.IndexInfoR\x13screenshotIndexInfo\x12!\n\x0c\x63ontent_hash\x18\x04 \x01(\tR\x0b\x63ontentHash\x12\x18\n\x07version\x18\x05 \x01(\x03R\x07version\x12\x44\n\tcrossings\x18\x08 \x03(\x0b\x32&.lilbattle.v1.WorldData.CrossingsEntryR\tcrossings\x1aO\n\rTilesMapEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12(\n\x05value\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.TileR\x05value:\x02\x38\x01\x1aO\n\rUnitsMapEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12(\n\x05value\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x05value:\x02\x38\x01\x1aT\n\x0e\x43rossingsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12,\n\x05value\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.CrossingR\x05value:\x02\x38\x01\"[\n\x08\x43rossing\x12.\n\x04type\x18\x01 \x01(\x0e\x32\x1a.lilbattle.v1.CrossingTypeR\x04type\x12\x1f\n\x0b\x63onnects_to\x18\x02 \x03(\x08R\nconnectsTo\"\xc9\x01\n\x04Tile\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\ttile_type\x18\x03 \x01(\x05R\x08tileType\x12\x16\n\x06player\x18\x04 \x01(\x05R\x06player\x12\x1a\n\x08shortcut\x18\x05 \x01(\tR\x08shortcut\x12&\n\x0flast_acted_turn\x18\x06 \x01(\x05R\rlastActedTurn\x12,\n\x12last_toppedup_turn\x18\x07 \x01(\x05R\x10lastToppedupTurn\"\xa5\x04\n\x04Unit\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x16\n\x06player\x18\x03 \x01(\x05R\x06player\x12\x1b\n\tunit_type\x18\x04 \x01(\x05R\x08unitType\x12\x1a\n\x08shortcut\x18\x05 \x01(\tR\x08shortcut\x12)\n\x10\x61vailable_health\x18\x06 \x01(\x05R\x0f\x61vailableHealth\x12#\n\rdistance_left\x18\x07 \x01(\x01R\x0c\x64istanceLeft\x12&\n\x0flast_acted_turn\x18\x08 \x01(\x05R\rlastActedTurn\x12,\n\x12last_toppedup_turn\x18\t \x01(\x05R\x10lastToppedupTurn\x12;\n\x1a\x61ttacks_received_this_turn\x18\n \x01(\x05R\x17\x61ttacksReceivedThisTurn\x12\x41\n\x0e\x61ttack_history\x18\x0b \x03(\x0b\x32\x1a.lilbattle.v1.AttackRecordR\rattackHistory\x12)\n\x10progression_step\x18\x0c \x01(\x05R\x0fprogressionStep\x12-\n\x12\x63hosen_alternative\x18\r \x01(\tR\x11\x63hosenAlternative\x12\x30\n\x14\x63\x61pture_started_turn\x18\x0e \x01(\x05R\x12\x63\x61ptureStartedTurn\"h\n\x0c\x41ttackRecord\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\tis_ranged\x18\x03 \x01(\x08R\x08isRanged\x12\x1f\n\x0bturn_number\x18\x04 \x01(\x05R\nturnNumber\"\x89\x03\n\x11TerrainDefinition\x12\x0e\n\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n\x04type\x18\x05 \x01(\x05R\x04type\x12 \n\x0b\x64\x65scription\x18\x06 \x01(\tR\x0b\x64\x65scription\x12\\\n\x0funit_properties\x18\x07 \x03(\x0b\x32\x33.lilbattle.v1.TerrainDefinition.UnitPropertiesEntryR\x0eunitProperties\x12,\n\x12\x62uildable_unit_ids\x18\x08 \x03(\x05R\x10\x62uildableUnitIds\x12&\n\x0fincome_per_turn\x18\t \x01(\x05R\rincomePerTurn\x1a\x66\n\x13UnitPropertiesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x39\n\x05value\x18\x02 \x01(\x0b\x32#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x02\x38\x01\"\x82\x08\n\x0eUnitDefinition\x12\x0e\n\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x03 \x01(\tR\x0b\x64\x65scription\x12\x16\n\x06health\x18\x04 \x01(\x05R\x06health\x12\x14\n\x05\x63oins\x18\x05 \x01(\x05R\x05\x63oins\x12\'\n\x0fmovement_points\x18\x06 \x01(\x01R\x0emovementPoints\x12%\n\x0eretreat_points\x18\x07 \x01(\x01R\rretreatPoints\x12\x18\n\x07\x64\x65\x66\x65nse\x18\x08 \x01(\x05R\x07\x64\x65\x66\x65nse\x12!\n\x0c\x61ttack_range\x18\t \x01(\x05R\x0b\x61ttackRange\x12(\n\x10min_attack_range\x18\n \x01(\x05R\x0eminAttackRange\x12#\n\rsplash_damage\x18\x0b \x01(\x05R\x0csplashDamage\x12\x62\n\x12terrain_properties\x18\x0c \x03(\x0b\x32\x33.lilbattle.v1.UnitDefinition.TerrainPropertiesEntryR\x11terrainProperties\x12\x1e\n\nproperties\x18\r \x03(\tR\nproperties\x12\x1d\n\nunit_class\x18\x0e \x01(\tR\tunitClass\x12!\n\x0cunit_terrain\x18\x0f \x01(\tR\x0bunitTerrain\x12W\n\x0f\x61ttack_vs_class\x18\x10 \x03(\x0b\x32/.lilbattle.v1.UnitDefinition.AttackVsClassEntryR\rattackVsClass\x12!\n\x0c\x61\x63tion_order\x18\x11 \x03(\tR\x0b\x61\x63tionOrder\x12S\n\raction_limits\x18\x12 \x03(\x0b\x32..lilbattle.v1.UnitDefinition.ActionLimitsEntryR\x0c\x61\x63tionLimits\x12\x1b\n\tfix_value\x18\x13 \x01(\x05R\x08\x66ixValue\x1ai\n\x16TerrainPropertiesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x39\n\x05value\x18\x02 \x01(\x0b\x32#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x02\x38\x01\x1a@\n\x12\x41ttackVsClassEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\x1a?\n\x11\x41\x63tionLimitsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\"\xec\x02\n\x15TerrainUnitProperties\x12\x1d\n\nterrain_id\x18\x01 \x01(\x05R\tterrainId\x12\x17\n\x07unit_id\x18\x02 \x01(\x05R\x06unitId\x12#\n\rmovement_cost\x18\x03 \x01(\x01R\x0cmovementCost\x12#\n\rhealing_bonus\x18\x04 \x01(\x05R\x0chealingBonus\x12\x1b\n\tcan_build\x18\x05 \x01(\x08R\x08\x63\x61nBuild\x12\x1f\n\x0b\x63\x61n_capture\x18\x06 \x01(\x08R\ncanCapture\x12!\n\x0c\x61ttack_bonus\x18\x07 \x01(\x05R\x0b\x61ttackBonus\x12#\n\rdefense_bonus\x18\x08 \x01(\x05R\x0c\x64\x65\x66\x65nseBonus\x12!\n\x0c\x61ttack_range\x18\t \x01(\x05R\x0b\x61ttackRange\x12(\n\x10min_attack_range\x18\n \x01(\x05R\x0eminAttackRange\"\x97\x02\n\x12UnitUnitProperties\x12\x1f\n\x0b\x61ttacker_id\x18\x01 \x01(\x05R\nattackerId\x12\x1f\n\x0b\x64\x65\x66\x65nder_id\x18\x02 \x01(\x05R\ndefenderId\x12,\n\x0f\x61ttack_override\x18\x03 \x01(\x05H\x00R\x0e\x61ttackOverride\x88\x01\x01\x12.\n\x10\x64\x65\x66\x65nse_override\x18\x04 \x01(\x05H\x01R\x0f\x64\x65\x66\x65nseOverride\x88\x01\x01\x12\x38\n\x06\x64\x61mage\x18\x05 \x01(\x0b\x32 .lilbattle.v1.DamageDistributionR\x06\x64\x61mageB\x12\n\x10_attack_overrideB\x13\n\x11_defense_override\"\xae\x01\n\x12\x44\x61mageDistribution\x12\x1d\n\nmin_damage\x18\x01 \x01(\x01R\tminDamage\x12\x1d\n\nmax_damage\x18\x02 \x01(\x01R\tmaxDamage\x12\'\n\x0f\x65xpected_damage\x18\x03 \x01(\x01R\x0e\x65xpectedDamage\x12\x31\n\x06ranges\x18\x04 \x03(\x0b\x32\x19.lilbattle.v1.DamageRangeR\x06ranges\"i\n\x0b\x44\x61mageRange\x12\x1b\n\tmin_value\x18\x01 \x01(\x01R\x08minValue\x12\x1b\n\tmax_value\x18\x02 \x01(\x01R\x08maxValue\x12 \n\x0bprobability\x18\x03 \x01(\x01R\x0bprobability\"\x9d\x07\n\x0bRulesEngine\x12:\n\x05units\x18\x01 \x03(\x0b\x32$.lilbattle.v1.RulesEngine.UnitsEntryR\x05units\x12\x43\n\x08terrains\x18\x02 \x03(\x0b\x32\'.lilbattle.v1.RulesEngine.TerrainsEntryR\x08terrains\x12l\n\x17terrain_unit_properties\x18\x03 \x03(\x0b\x32\x34.lilbattle.v1.RulesEngine.TerrainUnitPropertiesEntryR\x15terrainUnitProperties\x12\x63\n\x14unit_unit_properties\x18\x04 \x03(\x0b\x32\x31.lilbattle.v1.RulesEngine.UnitUnitPropertiesEntryR\x12unitUnitProperties\x12P\n\rterrain_types\x18\x05 \x03(\x0b\x32+.lilbattle.v1.RulesEngine.TerrainTypesEntryR\x0cterrainTypes\x1aV\n\nUnitsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32\x1c.lilbattle.v1.UnitDefinitionR\x05value:\x02\x38\x01\x1a\\\n\rTerrainsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x35\n\x05value\x18\x02 \x01(\x0b\x32\x1f.lilbattle.v1.TerrainDefinitionR\x05value:\x02\x38\x01\x1am\n\x1aTerrainUnitPropertiesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x39\n\x05value\x18\x02 \x01(\x0b\x32#.lilbattle.v1.TerrainUnitPropertiesR\x05value:\x02\x38\x01\x1ag\n\x17UnitUnitPropertiesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x36\n\x05value\x18\x02 \x01(\x0b\x32 .lilbattle.v1.UnitUnitPropertiesR\x05value:\x02\x38\x01\x1aZ\n\x11TerrainTypesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12/\n\x05value\x18\x02 \x01(\x0e\x32\x19.lilbattle.v1.TerrainTypeR\x05value:\x02\x38\x01\"\xd4\x04\n\x04Game\x12\x39\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n\x07version\x18\x03 \x01(\x03R\x07version\x12\x0e\n\x02id\x18\x04 \x01(\tR\x02id\x12\x1d\n\ncreator_id\x18\x05 \x01(\tR\tcreatorId\x12\x19\n\x08world_id\x18\x06 \x01(\tR\x07worldId\x12\x12\n\x04name\x18\x07 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x08 \x01(\tR\x0b\x64\x65scription\x12\x12\n\x04tags\x18\t \x03(\tR\x04tags\x12\x1b\n\timage_url\x18\n \x01(\tR\x08imageUrl\x12\x1e\n\ndifficulty\x18\x0b \x01(\tR\ndifficulty\x12\x37\n\x06\x63onfig\x18\x0c \x01(\x0b\x32\x1f.lilbattle.v1.GameConfigurationR\x06\x63onfig\x12!\n\x0cpreview_urls\x18\r \x03(\tR\x0bpreviewUrls\x12\x43\n\x11search_index_info\x18\x0f \x01(\x0b\x32\x17.lilbattle.v1.IndexInfoR\x0fsearchIndexInfo\x12%\n\x0eworld_revision\x18\x10 \x01(\x03R\rworldRevision\x12#\n\rrules_version\x18\x11 \x01(\tR\x0crulesVersion\"\xf0\x01\n\x11GameConfiguration\x12\x32\n\x07players\x18\x01 \x03(\x0b\x32\x18.lilbattle.v1.GamePlayerR\x07players\x12,\n\x05teams\x18\x02 \x03(\x0b\x32\x16.lilbattle.v1.GameTeamR\x05teams\x12\x41\n\x0eincome_configs\x18\x03 \x01(\x0b\x32\x1a.lilbattle.v1.IncomeConfigR\rincomeConfigs\x12\x36\n\x08settings\x18\x04 \x01(\x0b\x32\x1a.lilbattle.v1.GameSettingsR\x08settings\"\xab\x02\n\x0cIncomeConfig\x12%\n\x0estarting_coins\x18\x01 \x01(\x05R\rstartingCoins\x12\x1f\n\x0bgame_income\x18\x02 \x01(\x05R\ngameIncome\x12\'\n\x0flandbase_income\x18\x03 \x01(\x05R\x0elandbaseIncome\x12)\n\x10navalbase_income\x18\x04 \x01(\x05R\x0fnavalbaseIncome\x12-\n\x12\x61irportbase_income\x18\x05 \x01(\x05R\x11\x61irportbaseIncome\x12-\n\x12missilesilo_income\x18\x06 \x01(\x05R\x11missilesiloIncome\x12!\n\x0cmines_income\x18\x07 \x01(\x05R\x0bminesIncome\"\xea\x01\n\nGamePlayer\x12\x1b\n\tplayer_id\x18\x01 \x01(\x05R\x08playerId\x12\x17\n\x07user_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n\x0bplayer_type\x18\x03 \x01(\tR\nplayerType\x12\x14\n\x05\x63olor\x18\x04 \x01(\tR\x05\x63olor\x12\x17\n\x07team_id\x18\x05 \x01(\x05R\x06teamId\x12\x12\n\x04name\x18\x06 \x01(\tR\x04name\x12\x1b\n\tis_active\x18\x07 \x01(\x08R\x08isActive\x12%\n\x0estarting_coins\x18\x08 \x01(\x05R\rstartingCoins\"j\n\x08GameTeam\x12\x17\n\x07team_id\x18\x01 \x01(\x05R\x06teamId\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n\x05\x63olor\x18\x03 \x01(\tR\x05\x63olor\x12\x1b\n\tis_active\x18\x04 \x01(\x08R\x08isActive\"\xce\x02\n\x0cGameSettings\x12#\n\rallowed_units\x18\x01 \x03(\x05R\x0c\x61llowedUnits\x12&\n\x0fturn_time_limit\x18\x02 \x01(\x05R\rturnTimeLimit\x12\x1b\n\tteam_mode\x18\x03 \x01(\tR\x08teamMode\x12\x1b\n\tmax_turns\x18\x04 \x01(\x05R\x08maxTurns\x12\x1b\n\tturn_mode\x18\x05 \x01(\tR\x08turnMode\x12!\n\x0cshared_coins\x18\x06 \x01(\x08R\x0bsharedCoins\x12%\n\x0eshared_control\x18\x07 \x01(\x08R\rsharedControl\x12%\n\x0e\x61llied_support\x18\x08 \x01(\x08R\ralliedSupport\x12)\n\x10\x63ombined_victory\x18\t \x01(\x08R\x0f\x63ombinedVictory\"@\n\x0bPlayerState\x12\x14\n\x05\x63oins\x18\x01 \x01(\x05R\x05\x63oins\x12\x1b\n\tis_active\x18\x02 \x01(\x08R\x08isActive\"\xc1\x06\n\tGameState\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n\x07game_id\x18\x03 \x01(\tR\x06gameId\x12!\n\x0cturn_counter\x18\x04 \x01(\x05R\x0bturnCounter\x12%\n\x0e\x63urrent_player\x18\x05 \x01(\x05R\rcurrentPlayer\x12\x36\n\nworld_data\x18\x06 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\x12\x1d\n\nstate_hash\x18\x08 \x01(\tR\tstateHash\x12\x18\n\x07version\x18\t \x01(\x03R\x07version\x12\x30\n\x06status\x18\n \x01(\x0e\x32\x18.lilbattle.v1.GameStatusR\x06status\x12\x1a\n\x08\x66inished\x18\x0b \x01(\x08R\x08\x66inished\x12%\n\x0ewinning_player\x18\x0c \x01(\x05R\rwinningPlayer\x12!\n\x0cwinning_team\x18\r \x01(\x05R\x0bwinningTeam\x12\x30\n\x14\x63urrent_group_number\x18\x0e \x01(\x03R\x12\x63urrentGroupNumber\x12N\n\rplayer_states\x18\x0f \x03(\x0b\x32).lilbattle.v1.GameState.PlayerStatesEntryR\x0cplayerStates\x12Q\n\x0epending_orders\x18\x10 \x03(\x0b\x32*.lilbattle.v1.GameState.PendingOrdersEntryR\rpendingOrders\x1aZ\n\x11PlayerStatesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12/\n\x05value\x18\x02 \x01(\x0b\x32\x19.lilbattle.v1.PlayerStateR\x05value:\x02\x38\x01\x1a\\\n\x12PendingOrdersEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x30\n\x05value\x18\x02 \x01(\x0b\x32\x1a.lilbattle.v1.PlayerOrdersR\x05value:\x02\x38\x01\"_\n\x0fGameMoveHistory\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x33\n\x06groups\x18\x02 \x03(\x0b\x32\x1b.lilbattle.v1.GameMoveGroupR\x06groups\"\xd2\x01\n\rGameMoveGroup\x12\x39\n\nstarted_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tstartedAt\x12\x35\n\x08\x65nded_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x07\x65ndedAt\x12!\n\x0cgroup_number\x18\x04 \x01(\x03R\x0bgroupNumber\x12,\n\x05moves\x18\x05 \x03(\x0b\x32\x16.lilbattle.v1.GameMoveR\x05moves\"\x8d\x06\n\x08GameMove\x12\x16\n\x06player\x18\x01 \x01(\x05R\x06player\x12!\n\x0cgroup_number\x18\x02 \x01(\x03R\x0bgroupNumber\x12\x1f\n\x0bmove_number\x18\x03 \x01(\x03R\nmoveNumber\x12\x38\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12;\n\tmove_unit\x18\x05 \x01(\x0b\x32\x1c.lilbattle.v1.MoveUnitActionH\x00R\x08moveUnit\x12\x41\n\x0b\x61ttack_unit\x18\x06 \x01(\x0b\x32\x1e.lilbattle.v1.AttackUnitActionH\x00R\nattackUnit\x12\x38\n\x08\x65nd_turn\x18\x07 \x01(\x0b\x32\x1b.lilbattle.v1.EndTurnActionH\x00R\x07\x65ndTurn\x12>\n\nbuild_unit\x18\x08 \x01(\x0b\x32\x1d.lilbattle.v1.BuildUnitActionH\x00R\tbuildUnit\x12P\n\x10\x63\x61pture_building\x18\r \x01(\x0b\x32#.lilbattle.v1.CaptureBuildingActionH\x00R\x0f\x63\x61ptureBuilding\x12;\n\theal_unit\x18\x0e \x01(\x0b\x32\x1c.lilbattle.v1.HealUnitActionH\x00R\x08healUnit\x12\x38\n\x08\x66ix_unit\x18\x0f \x01(\x0b\x32\x1b.lilbattle.v1.FixUnitActionH\x00R\x07\x66ixUnit\x12!\n\x0csequence_num\x18\t \x01(\x03R\x0bsequenceNum\x12!\n\x0cis_permanent\x18\n \x01(\x08R\x0bisPermanent\x12\x33\n\x07\x63hanges\x18\x0b \x03(\x0b\x32\x19.lilbattle.v1.WorldChangeR\x07\x63hanges\x12 \n\x0b\x64\x65scription\x18\x0c \x01(\tR\x0b\x64\x65scriptionB\x0b\n\tmove_type\"<\n\x08Position\x12\x14\n\x05label\x18\x01 \x01(\tR\x05label\x12\x0c\n\x01q\x18\x02 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x03 \x01(\x05R\x01r\"\xcc\x01\n\x0eMoveUnitAction\x12*\n\x04\x66rom\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x04\x66rom\x12&\n\x02to\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x02to\x12#\n\rmovement_cost\x18\x03 \x01(\x01R\x0cmovementCost\x12\x41\n\x12reconstructed_path\x18\x04 \x01(\x0b\x32\x12.lilbattle.v1.PathR\x11reconstructedPath\"\x9a\x02\n\x10\x41ttackUnitAction\x12\x32\n\x08\x61ttacker\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x08\x61ttacker\x12\x32\n\x08\x64\x65\x66\x65nder\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x08\x64\x65\x66\x65nder\x12(\n\x10target_unit_type\x18\x07 \x01(\x05R\x0etargetUnitType\x12,\n\x12target_unit_health\x18\x08 \x01(\x05R\x10targetUnitHealth\x12\x1d\n\ncan_attack\x18\t \x01(\x08R\tcanAttack\x12\'\n\x0f\x64\x61mage_estimate\x18\n \x01(\x05R\x0e\x64\x61mageEstimate\"l\n\x0f\x42uildUnitAction\x12(\n\x03pos\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x03pos\x12\x1b\n\tunit_type\x18\x02 \x01(\x05R\x08unitType\x12\x12\n\x04\x63ost\x18\x03 \x01(\x05R\x04\x63ost\"^\n\x15\x43\x61ptureBuildingAction\x12(\n\x03pos\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x03pos\x12\x1b\n\ttile_type\x18\x03 \x01(\x05R\x08tileType\"\x0f\n\rEndTurnAction\"[\n\x0eHealUnitAction\x12(\n\x03pos\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x03pos\x12\x1f\n\x0bheal_amount\x18\x02 \x01(\x05R\nhealAmount\"\x8c\x01\n\rFixUnitAction\x12,\n\x05\x66ixer\x18\x01 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x05\x66ixer\x12.\n\x06target\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.PositionR\x06target\x12\x1d\n\nfix_amount\x18\x03 \x01(\x05R\tfixAmount\"\xd5\x05\n\x0bWorldChange\x12>\n\nunit_moved\x18\x01 \x01(\x0b\x32\x1d.lilbattle.v1.UnitMovedChangeH\x00R\tunitMoved\x12\x44\n\x0cunit_damaged\x18\x02 \x01(\x0b\x32\x1f.lilbattle.v1.UnitDamagedChangeH\x00R\x0bunitDamaged\x12\x41\n\x0bunit_killed\x18\x03 \x01(\x0b\x32\x1e.lilbattle.v1.UnitKilledChangeH\x00R\nunitKilled\x12J\n\x0eplayer_changed\x18\x04 \x01(\x0b\x32!.lilbattle.v1.PlayerChangedChangeH\x00R\rplayerChanged\x12>\n\nunit_built\x18\x05 \x01(\x0b\x32\x1d.lilbattle.v1.UnitBuiltChangeH\x00R\tunitBuilt\x12G\n\rcoins_changed\x18\x06 \x01(\x0b\x32 .lilbattle.v1.CoinsChangedChangeH\x00R\x0c\x63oinsChanged\x12G\n\rtile_captured\x18\x07 \x01(\x0b\x32 .lilbattle.v1.TileCapturedChangeH\x00R\x0ctileCaptured\x12M\n\x0f\x63\x61pture_started\x18\x08 \x01(\x0b\x32\".lilbattle.v1.CaptureStartedChangeH\x00R\x0e\x63\x61ptureStarted\x12\x41\n\x0bunit_healed\x18\t \x01(\x0b\x32\x1e.lilbattle.v1.UnitHealedChangeH\x00R\nunitHealed\x12>\n\nunit_fixed\x18\n \x01(\x0b\x32\x1d.lilbattle.v1.UnitFixedChangeH\x00R\tunitFixedB\r\n\x0b\x63hange_type\"\xa3\x01\n\x10UnitHealedChange\x12\x37\n\rprevious_unit\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0cpreviousUnit\x12\x35\n\x0cupdated_unit\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0bupdatedUnit\x12\x1f\n\x0bheal_amount\x18\x03 \x01(\x05R\nhealAmount\"\xdb\x01\n\x0fUnitFixedChange\x12\x31\n\nfixer_unit\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\tfixerUnit\x12;\n\x0fprevious_target\x18\x02 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0epreviousTarget\x12\x39\n\x0eupdated_target\x18\x03 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\rupdatedTarget\x12\x1d\n\nfix_amount\x18\x04 \x01(\x05R\tfixAmount\"\x81\x01\n\x0fUnitMovedChange\x12\x37\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0cpreviousUnit\x12\x35\n\x0cupdated_unit\x18\x07 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0bupdatedUnit\"\x83\x01\n\x11UnitDamagedChange\x12\x37\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0cpreviousUnit\x12\x35\n\x0cupdated_unit\x18\x07 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0bupdatedUnit\"K\n\x10UnitKilledChange\x12\x37\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x0cpreviousUnit\"\xd2\x01\n\x13PlayerChangedChange\x12\'\n\x0fprevious_player\x18\x01 \x01(\x05R\x0epreviousPlayer\x12\x1d\n\nnew_player\x18\x02 \x01(\x05R\tnewPlayer\x12#\n\rprevious_turn\x18\x03 \x01(\x05R\x0cpreviousTurn\x12\x19\n\x08new_turn\x18\x04 \x01(\x05R\x07newTurn\x12\x33\n\x0breset_units\x18\x05 \x03(\x0b\x32\x12.lilbattle.v1.UnitR\nresetUnits\"\xa9\x01\n\x0fUnitBuiltChange\x12&\n\x04unit\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\x04unit\x12\x15\n\x06tile_q\x18\x02 \x01(\x05R\x05tileQ\x12\x15\n\x06tile_r\x18\x03 \x01(\x05R\x05tileR\x12\x1d\n\ncoins_cost\x18\x04 \x01(\x05R\tcoinsCost\x12!\n\x0cplayer_coins\x18\x05 \x01(\x05R\x0bplayerCoins\"\x8d\x01\n\x12\x43oinsChangedChange\x12\x1b\n\tplayer_id\x18\x01 \x01(\x05R\x08playerId\x12%\n\x0eprevious_coins\x18\x02 \x01(\x05R\rpreviousCoins\x12\x1b\n\tnew_coins\x18\x03 \x01(\x05R\x08newCoins\x12\x16\n\x06reason\x18\x04 \x01(\tR\x06reason\"\xde\x01\n\x12TileCapturedChange\x12\x39\n\x0e\x63\x61pturing_unit\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\rcapturingUnit\x12\x15\n\x06tile_q\x18\x02 \x01(\x05R\x05tileQ\x12\x15\n\x06tile_r\x18\x03 \x01(\x05R\x05tileR\x12\x1b\n\ttile_type\x18\x04 \x01(\x05R\x08tileType\x12%\n\x0eprevious_owner\x18\x05 \x01(\x05R\rpreviousOwner\x12\x1b\n\tnew_owner\x18\x06 \x01(\x05R\x08newOwner\"\xc1\x01\n\x14\x43\x61ptureStartedChange\x12\x39\n\x0e\x63\x61pturing_unit\x18\x01 \x01(\x0b\x32\x12.lilbattle.v1.UnitR\rcapturingUnit\x12\x15\n\x06tile_q\x18\x02 \x01(\x05R\x05tileQ\x12\x15\n\x06tile_r\x18\x03 \x01(\x05R\x05tileR\x12\x1b\n\ttile_type\x18\x04 \x01(\x05R\x08tileType\x12#\n\rcurrent_owner\x18\x05 \x01(\x05R\x0c\x63urrentOwner\"\xcb\x01\n\x08\x41llPaths\x12\x19\n\x08source_q\x18\x01 \x01(\x05R\x07sourceQ\x12\x19\n\x08source_r\x18\x02 \x01(\x05R\x07sourceR\x12\x37\n\x05\x65\x64ges\x18\x03 \x03(\x0b\x32!.lilbattle.v1.AllPaths.EdgesEntryR\x05\x65\x64ges\x1aP\n\nEdgesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12,\n\x05value\x18\x02 \x01(\x0b\x32\x16.lilbattle.v1.PathEdgeR\x05value:\x02\x38\x01\"\x88\x02\n\x08PathEdge\x12\x15\n\x06\x66rom_q\x18\x01 \x01(\x05R\x05\x66romQ\x12\x15\n\x06\x66rom_r\x18\x02 \x01(\x05R\x05\x66romR\x12\x11\n\x04to_q\x18\x03 \x01(\x05R\x03toQ\x12\x11\n\x04to_r\x18\x04 \x01(\x05R\x03toR\x12#\n\rmovement_cost\x18\x05 \x01(\x01R\x0cmovementCost\x12\x1d\n\ntotal_cost\x18\x06 \x01(\x01R\ttotalCost\x12!\n\x0cterrain_type\x18\x07 \x01(\tR\x0bterrainType\x12 \n\x0b\x65xplanation\x18\x08 \x01(\tR\x0b\x65xplanation\x12\x1f\n\x0bis_occupied\x18\t \x01(\x08R\nisOccupied\"\x90\x01\n\x04Path\x12,\n\x05\x65\x64ges\x18\x01 \x03(\x0b\x32\x16.lilbattle.v1.PathEdgeR\x05\x65\x64ges\x12;\n\ndirections\x18\x02 \x03(\x0e\x32\x1b.lilbattle.v1.PathDirectionR\ndirections\x12\x1d\n\ntotal_cost\x18\x03 \x01(\x01R\ttotalCost\"\xe8\x01\n\x0cPlayerOrders\x12\x1b\n\tplayer_id\x18\x01 \x01(\x05R\x08playerId\x12\x1e\n\ncommitment\x18\x02 \x01(\tR\ncommitment\x12=\n\x0c\x63ommitted_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x0b\x63ommittedAt\x12\x1a\n\x08revealed\x18\x04 \x01(\x08R\x08revealed\x12,\n\x05moves\x18\x05 \x03(\x0b\x32\x16.lilbattle.v1.GameMoveR\x05moves\x12\x12\n\x04salt\x18\x06 \x01(\tR\x04salt\"k\n\tTimeRange\x12\x30\n\x05start\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x05start\x12,\n\x03\x65nd\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x03\x65nd\"\xe8\x02\n\x08UserGame\x12\x17\n\x07user_id\x18\x01 \x01(\tR\x06userId\x12\x17\n\x07game_id\x18\x02 \x01(\tR\x06gameId\x12\x1d\n\nplayer_ids\x18\x03 \x03(\x05R\tplayerIds\x12\x1b\n\tgame_name\x18\x04 \x01(\tR\x08gameName\x12\x19\n\x08world_id\x18\x05 \x01(\tR\x07worldId\x12\x30\n\x06status\x18\x06 \x01(\x0e\x32\x18.lilbattle.v1.GameStatusR\x06status\x12%\n\x0e\x63urrent_player\x18\x07 \x01(\x05R\rcurrentPlayer\x12!\n\x0cturn_counter\x18\x08 \x01(\x05R\x0bturnCounter\x12\x1c\n\nis_my_turn\x18\t \x01(\x08R\x08isMyTurn\x12\x39\n\nupdated_at\x18\n \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\"<\n\x0cUserGameList\x12,\n\x05games\x18\x01 \x03(\x0b\x32\x16.lilbattle.v1.UserGameR\x05games\"\xdc\x02\n\rWorldRevision\x12\x19\n\x08world_id\x18\x01 \x01(\tR\x07worldId\x12\x1a\n\x08revision\x18\x02 \x01(\x03R\x08revision\x12\x39\n\ncreated_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n\tauthor_id\x18\x04 \x01(\tR\x08\x61uthorId\x12#\n\rreverted_from\x18\x05 \x01(\x03R\x0crevertedFrom\x12!\n\x0c\x63ontent_hash\x18\x06 \x01(\tR\x0b\x63ontentHash\x12\x1d\n\ntile_count\x18\x07 \x01(\x05R\ttileCount\x12\x1d\n\nunit_count\x18\x08 \x01(\x05R\tunitCount\x12\x36\n\nworld_data\x18\t \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\"l\n\x0fWorldCellChange\x12\x14\n\x05layer\x18\x01 \x01(\tR\x05layer\x12\x10\n\x03key\x18\x02 \x01(\tR\x03key\x12\x31\n\x04kind\x18\x03 \x01(\x0e\x32\x1d.lilbattle.v1.WorldChangeKindR\x04kind\"\xa6\x01\n\x12WorldMergeConflict\x12\x14\n\x05layer\x18\x01 \x01(\tR\x05layer\x12\x10\n\x03key\x18\x02 \x01(\tR\x03key\x12\x31\n\x04ours\x18\x03 \x01(\x0e\x32\x1d.lilbattle.v1.WorldChangeKindR\x04ours\x12\x35\n\x06theirs\x18\x04 \x01(\x0e\x32\x1d.lilbattle.v1.WorldChangeKindR\x06theirs\"\xbe\x01\n\x10WorldSearchEntry\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x18\n\x07players\x18\x02 \x01(\x05R\x07players\x12\x14\n\x05tiles\x18\x03 \x01(\x05R\x05tiles\x12\x14\n\x05games\x18\x04 \x01(\x05R\x05games\x12\x39\n\nindexed_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tindexedAt*_\n\x0c\x43rossingType\x12\x1d\n\x19\x43ROSSING_TYPE_UNSPECIFIED\x10\x00\x12\x16\n\x12\x43ROSSING_TYPE_ROAD\x10\x01\x12\x18\n\x14\x43ROSSING_TYPE_BRIDGE\x10\x02*\xa3\x01\n\x0bTerrainType\x12\x1c\n\x18TERRAIN_TYPE_UNSPECIFIED\x10\x00\x12\x15\n\x11TERRAIN_TYPE_CITY\x10\x01\x12\x17\n\x13TERRAIN_TYPE_NATURE\x10\x02\x12\x17\n\x13TERRAIN_TYPE_BRIDGE\x10\x03\x12\x16\n\x12TERRAIN_TYPE_WATER\x10\x04\x12\x15\n\x11TERRAIN_TYPE_ROAD\x10\x05*q\n\nGameStatus\x12\x1b\n\x17GAME_STATUS_UNSPECIFIED\x10\x00\x12\x17\n\x13GAME_STATUS_PLAYING\x10\x01\x12\x16\n\x12GAME_STATUS_PAUSED\x10\x02\x12\x15\n\x11GAME_STATUS_ENDED\x10\x03*\xde\x01\n\rPathDirection\x12\x1e\n\x1aPATH_DIRECTION_UNSPECIFIED\x10\x00\x12\x17\n\x13PATH_DIRECTION_LEFT\x10\x01\x12\x1b\n\x17PATH_DIRECTION_TOP_LEFT\x10\x02\x12\x1c\n\x18PATH_DIRECTION_TOP_RIGHT\x10\x03\x12\x18\n\x14PATH_DIRECTION_RIGHT\x10\x04\x12\x1f\n\x1bPATH_DIRECTION_BOTTOM_RIGHT\x10\x05\x12\x1e\n\x1aPATH_DIRECTION_BOTTOM_LEFT\x10\x06*\x90\x01\n\x0fWorldChangeKind\x12!\n\x1dWORLD_CHANGE_KIND_UNSPECIFIED\x10\x00\x12\x1b\n\x17WORLD_CHANGE_KIND_ADDED\x10\x01\x12\x1d\n\x19WORLD_CHANGE_KIND_REMOVED\x10\x02\x12\x1e\n\x1aWORLD_CHANGE_KIND_MODIFIED\x10\x03*z\n\x12WorldMergeStrategy\x12$\n WORLD_MERGE_STRATEGY_UNSPECIFIED\x10\x00\x12\x1d\n\x19WORLD_MERGE_STRATEGY_OURS\x10\x01\x12\x1f\n\x1bWORLD_MERGE_STRATEGY_THEIRS\x10\x02\x42\xb7\x01\n\x10\x63om.lilbattle.v1B\x0bModelsProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -60,16 +60,22 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_RULESENGINE_TERRAINTYPESENTRY']._serialized_options = b'8\001'
   _globals['_GAMESTATE_PLAYERSTATESENTRY']._loaded_options = None
   _globals['_GAMESTATE_PLAYERSTATESENTRY']._serialized_options = b'8\001'
+  _globals['_GAMESTATE_PENDINGORDERSENTRY']._loaded_options = None
+  _globals['_GAMESTATE_PENDINGORDERSENTRY']._serialized_options = b'8\001'
   _globals['_ALLPATHS_EDGESENTRY']._loaded_options = None
   _globals['_ALLPATHS_EDGESENTRY']._serialized_options = b'8\001'
-  _globals['_CROSSINGTYPE']._serialized_start=13364
-  _globals['_CROSSINGTYPE']._serialized_end=13459
-  _globals['_TERRAINTYPE']._serialized_start=13462
-  _globals['_TERRAINTYPE']._serialized_end=13625
-  _globals['_GAMESTATUS']._serialized_start=13627
-  _globals['_GAMESTATUS']._serialized_end=13740
-  _globals['_PATHDIRECTION']._serialized_start=13743
-  _globals['_PATHDIRECTION']._serialized_end=13965
+  _globals['_CROSSINGTYPE']._serialized_start=15475
+  _globals['_CROSSINGTYPE']._serialized_end=15570
+  _globals['_TERRAINTYPE']._serialized_start=15573
+  _globals['_TERRAINTYPE']._serialized_end=15736
+  _globals['_GAMESTATUS']._serialized_start=15738
+  _globals['_GAMESTATUS']._serialized_end=15851
+  _globals['_PATHDIRECTION']._serialized_start=15854
+  _globals['_PATHDIRECTION']._serialized_end=16076
+  _globals['_WORLDCHANGEKIND']._serialized_start=16079
+  _globals['_WORLDCHANGEKIND']._serialized_end=16223
+  _globals['_WORLDMERGESTRATEGY']._serialized_start=16225
+  _globals['_WORLDMERGESTRATEGY']._serialized_end=16347
   _globals['_INDEXINFO']._serialized_start=114
   _globals['_INDEXINFO']._serialized_end=300
   _globals['_PAGINATION']._serialized_start=302
@@ -77,123 +83,141 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_PAGINATIONRESPONSE']._serialized_start=406
   _globals['_PAGINATIONRESPONSE']._serialized_end=568
   _globals['_WORLD']._serialized_start=571
-  _globals['_WORLD']._serialized_end=1089
-  _globals['_WORLDDATA']._serialized_start=1092
-  _globals['_WORLDDATA']._serialized_end=1695
-  _globals['_WORLDDATA_TILESMAPENTRY']._serialized_start=1449
-  _globals['_WORLDDATA_TILESMAPENTRY']._serialized_end=1528
-  _globals['_WORLDDATA_UNITSMAPENTRY']._serialized_start=1530
-  _globals['_WORLDDATA_UNITSMAPENTRY']._serialized_end=1609
-  _globals['_WORLDDATA_CROSSINGSENTRY']._serialized_start=1611
-  _globals['_WORLDDATA_CROSSINGSENTRY']._serialized_end=1695
-  _globals['_CROSSING']._serialized_start=1697
-  _globals['_CROSSING']._serialized_end=1788
-  _globals['_TILE']._serialized_start=1791
-  _globals['_TILE']._serialized_end=1992
-  _globals['_UNIT']._serialized_start=1995
-  _globals['_UNIT']._serialized_end=2544
-  _globals['_ATTACKRECORD']._serialized_start=2546
-  _globals['_ATTACKRECORD']._serialized_end=2650
-  _globals['_TERRAINDEFINITION']._serialized_start=2653
-  _globals['_TERRAINDEFINITION']._serialized_end=3046
-  _globals['_TERRAINDEFINITION_UNITPROPERTIESENTRY']._serialized_start=2944
-  _globals['_TERRAINDEFINITION_UNITPROPERTIESENTRY']._serialized_end=3046
-  _globals['_UNITDEFINITION']._serialized_start=3049
-  _globals['_UNITDEFINITION']._serialized_end=4075
-  _globals['_UNITDEFINITION_TERRAINPROPERTIESENTRY']._serialized_start=3839
-  _globals['_UNITDEFINITION_TERRAINPROPERTIESENTRY']._serialized_end=3944
-  _globals['_UNITDEFINITION_ATTACKVSCLASSENTRY']._serialized_start=3946
-  _globals['_UNITDEFINITION_ATTACKVSCLASSENTRY']._serialized_end=4010
-  _globals['_UNITDEFINITION_ACTIONLIMITSENTRY']._serialized_start=4012
-  _globals['_UNITDEFINITION_ACTIONLIMITSENTRY']._serialized_end=4075
-  _globals['_TERRAINUNITPROPERTIES']._serialized_start=4078
-  _globals['_TERRAINUNITPROPERTIES']._serialized_end=4442
-  _globals['_UNITUNITPROPERTIES']._serialized_start=4445
-  _globals['_UNITUNITPROPERTIES']._serialized_end=4724
-  _globals['_DAMAGEDISTRIBUTION']._serialized_start=4727
-  _globals['_DAMAGEDISTRIBUTION']._serialized_end=4901
-  _globals['_DAMAGERANGE']._serialized_start=4903
-  _globals['_DAMAGERANGE']._serialized_end=5008
-  _globals['_RULESENGINE']._serialized_start=5011
-  _globals['_RULESENGINE']._serialized_end=5936
-  _globals['_RULESENGINE_UNITSENTRY']._serialized_start=5448
-  _globals['_RULESENGINE_UNITSENTRY']._serialized_end=5534
-  _globals['_RULESENGINE_TERRAINSENTRY']._serialized_start=5536
-  _globals['_RULESENGINE_TERRAINSENTRY']._serialized_end=5628
-  _globals['_RULESENGINE_TERRAINUNITPROPERTIESENTRY']._serialized_start=5630
-  _globals['_RULESENGINE_TERRAINUNITPROPERTIESENTRY']._serialized_end=5739
-  _globals['_RULESENGINE_UNITUNITPROPERTIESENTRY']._serialized_start=5741
-  _globals['_RULESENGINE_UNITUNITPROPERTIESENTRY']._serialized_end=5844
-  _globals['_RULESENGINE_TERRAINTYPESENTRY']._serialized_start=5846
-  _globals['_RULESENGINE_TERRAINTYPESENTRY']._serialized_end=5936
-  _globals['_GAME']._serialized_start=5939
-  _globals['_GAME']._serialized_end=6459
-  _globals['_GAMECONFIGURATION']._serialized_start=6462
-  _globals['_GAMECONFIGURATION']._serialized_end=6702
-  _globals['_INCOMECONFIG']._serialized_start=6705
-  _globals['_INCOMECONFIG']._serialized_end=7004
-  _globals['_GAMEPLAYER']._serialized_start=7007
-  _globals['_GAMEPLAYER']._serialized_end=7241
-  _globals['_GAMETEAM']._serialized_start=7243
-  _globals['_GAMETEAM']._serialized_end=7349
-  _globals['_GAMESETTINGS']._serialized_start=7352
-  _globals['_GAMESETTINGS']._serialized_end=7501
-  _globals['_PLAYERSTATE']._serialized_start=7503
-  _globals['_PLAYERSTATE']._serialized_end=7567
-  _globals['_GAMESTATE']._serialized_start=7570
-  _globals['_GAMESTATE']._serialized_end=8226
-  _globals['_GAMESTATE_PLAYERSTATESENTRY']._serialized_start=8136
-  _globals['_GAMESTATE_PLAYERSTATESENTRY']._serialized_end=8226
-  _globals['_GAMEMOVEHISTORY']._serialized_start=8228
-  _globals['_GAMEMOVEHISTORY']._serialized_end=8323
-  _globals['_GAMEMOVEGROUP']._serialized_start=8326
-  _globals['_GAMEMOVEGROUP']._serialized_end=8536
-  _globals['_GAMEMOVE']._serialized_start=8539
-  _globals['_GAMEMOVE']._serialized_end=9320
-  _globals['_POSITION']._serialized_start=9322
-  _globals['_POSITION']._serialized_end=9382
-  _globals['_MOVEUNITACTION']._serialized_start=9385
-  _globals['_MOVEUNITACTION']._serialized_end=9589
-  _globals['_ATTACKUNITACTION']._serialized_start=9592
-  _globals['_ATTACKUNITACTION']._serialized_end=9874
-  _globals['_BUILDUNITACTION']._serialized_start=9876
-  _globals['_BUILDUNITACTION']._serialized_end=9984
-  _globals['_CAPTUREBUILDINGACTION']._serialized_start=9986
-  _globals['_CAPTUREBUILDINGACTION']._serialized_end=10080
-  _globals['_ENDTURNACTION']._serialized_start=10082
-  _globals['_ENDTURNACTION']._serialized_end=10097
-  _globals['_HEALUNITACTION']._serialized_start=10099
-  _globals['_HEALUNITACTION']._serialized_end=10190
-  _globals['_FIXUNITACTION']._serialized_start=10193
-  _globals['_FIXUNITACTION']._serialized_end=10333
-  _globals['_WORLDCHANGE']._serialized_start=10336
-  _globals['_WORLDCHANGE']._serialized_end=11061
-  _globals['_UNITHEALEDCHANGE']._serialized_start=11064
-  _globals['_UNITHEALEDCHANGE']._serialized_end=11227
-  _globals['_UNITFIXEDCHANGE']._serialized_start=11230
-  _globals['_UNITFIXEDCHANGE']._serialized_end=11449
-  _globals['_UNITMOVEDCHANGE']._serialized_start=11452
-  _globals['_UNITMOVEDCHANGE']._serialized_end=11581
-  _globals['_UNITDAMAGEDCHANGE']._serialized_start=11584
-  _globals['_UNITDAMAGEDCHANGE']._serialized_end=11715
-  _globals['_UNITKILLEDCHANGE']._serialized_start=11717
-  _globals['_UNITKILLEDCHANGE']._serialized_end=11792
-  _globals['_PLAYERCHANGEDCHANGE']._serialized_start=11795
-  _globals['_PLAYERCHANGEDCHANGE']._serialized_end=12005
-  _globals['_UNITBUILTCHANGE']._serialized_start=12008
-  _globals['_UNITBUILTCHANGE']._serialized_end=12177
-  _globals['_COINSCHANGEDCHANGE']._serialized_start=12180
-  _globals['_COINSCHANGEDCHANGE']._serialized_end=12321
-  _globals['_TILECAPTUREDCHANGE']._serialized_start=12324
-  _globals['_TILECAPTUREDCHANGE']._serialized_end=12546
-  _globals['_CAPTURESTARTEDCHANGE']._serialized_start=12549
-  _globals['_CAPTURESTARTEDCHANGE']._serialized_end=12742
-  _globals['_ALLPATHS']._serialized_start=12745
-  _globals['_ALLPATHS']._serialized_end=12948
-  _globals['_ALLPATHS_EDGESENTRY']._serialized_start=12868
-  _globals['_ALLPATHS_EDGESENTRY']._serialized_end=12948
-  _globals['_PATHEDGE']._serialized_start=12951
-  _globals['_PATHEDGE']._serialized_end=13215
-  _globals['_PATH']._serialized_start=13218
-  _globals['_PATH']._serialized_end=13362
+  _globals['_WORLD']._serialized_end=1170
+  _globals['_WORLDDATA']._serialized_start=1173
+  _globals['_WORLDDATA']._serialized_end=1776
+  _globals['_WORLDDATA_TILESMAPENTRY']._serialized_start=1530
+  _globals['_WORLDDATA_TILESMAPENTRY']._serialized_end=1609
+  _globals['_WORLDDATA_UNITSMAPENTRY']._serialized_start=1611
+  _globals['_WORLDDATA_UNITSMAPENTRY']._serialized_end=1690
+  _globals['_WORLDDATA_CROSSINGSENTRY']._serialized_start=1692
+  _globals['_WORLDDATA_CROSSINGSENTRY']._serialized_end=1776
+  _globals['_CROSSING']._serialized_start=1778
+  _globals['_CROSSING']._serialized_end=1869
+  _globals['_TILE']._serialized_start=1872
+  _globals['_TILE']._serialized_end=2073
+  _globals['_UNIT']._serialized_start=2076
+  _globals['_UNIT']._serialized_end=2625
+  _globals['_ATTACKRECORD']._serialized_start=2627
+  _globals['_ATTACKRECORD']._serialized_end=2731
+  _globals['_TERRAINDEFINITION']._serialized_start=2734
+  _globals['_TERRAINDEFINITION']._serialized_end=3127
+  _globals['_TERRAINDEFINITION_UNITPROPERTIESENTRY']._serialized_start=3025
+  _globals['_TERRAINDEFINITION_UNITPROPERTIESENTRY']._serialized_end=3127
+  _globals['_UNITDEFINITION']._serialized_start=3130
+  _globals['_UNITDEFINITION']._serialized_end=4156
+  _globals['_UNITDEFINITION_TERRAINPROPERTIESENTRY']._serialized_start=3920
+  _globals['_UNITDEFINITION_TERRAINPROPERTIESENTRY']._serialized_end=4025
+  _globals['_UNITDEFINITION_ATTACKVSCLASSENTRY']._serialized_start=4027
+  _globals['_UNITDEFINITION_ATTACKVSCLASSENTRY']._serialized_end=4091
+  _globals['_UNITDEFINITION_ACTIONLIMITSENTRY']._serialized_start=4093
+  _globals['_UNITDEFINITION_ACTIONLIMITSENTRY']._serialized_end=4156
+  _globals['_TERRAINUNITPROPERTIES']._serialized_start=4159
+  _globals['_TERRAINUNITPROPERTIES']._serialized_end=4523
+  _globals['_UNITUNITPROPERTIES']._serialized_start=4526
+  _globals['_UNITUNITPROPERTIES']._serialized_end=4805
+  _globals['_DAMAGEDISTRIBUTION']._serialized_start=4808
+  _globals['_DAMAGEDISTRIBUTION']._serialized_end=4982
+  _globals['_DAMAGERANGE']._serialized_start=4984
+  _globals['_DAMAGERANGE']._serialized_end=5089
+  _globals['_RULESENGINE']._serialized_start=5092
+  _globals['_RULESENGINE']._serialized_end=6017
+  _globals['_RULESENGINE_UNITSENTRY']._serialized_start=5529
+  _globals['_RULESENGINE_UNITSENTRY']._serialized_end=5615
+  _globals['_RULESENGINE_TERRAINSENTRY']._serialized_start=5617
+  _globals['_RULESENGINE_TERRAINSENTRY']._serialized_end=5709
+  _globals['_RULESENGINE_TERRAINUNITPROPERTIESENTRY']._serialized_start=5711
+  _globals['_RULESENGINE_TERRAINUNITPROPERTIESENTRY']._serialized_end=5820
+  _globals['_RULESENGINE_UNITUNITPROPERTIESENTRY']._serialized_start=5822
+  _globals['_RULESENGINE_UNITUNITPROPERTIESENTRY']._serialized_end=5925
+  _globals['_RULESENGINE_TERRAINTYPESENTRY']._serialized_start=5927
+  _globals['_RULESENGINE_TERRAINTYPESENTRY']._serialized_end=6017
+  _globals['_GAME']._serialized_start=6020
+  _globals['_GAME']._serialized_end=6616
+  _globals['_GAMECONFIGURATION']._serialized_start=6619
+  _globals['_GAMECONFIGURATION']._serialized_end=6859
+  _globals['_INCOMECONFIG']._serialized_start=6862
+  _globals['_INCOMECONFIG']._serialized_end=7161
+  _globals['_GAMEPLAYER']._serialized_start=7164
+  _globals['_GAMEPLAYER']._serialized_end=7398
+  _globals['_GAMETEAM']._serialized_start=7400
+  _globals['_GAMETEAM']._serialized_end=7506
+  _globals['_GAMESETTINGS']._serialized_start=7509
+  _globals['_GAMESETTINGS']._serialized_end=7843
+  _globals['_PLAYERSTATE']._serialized_start=7845
+  _globals['_PLAYERSTATE']._serialized_end=7909
+  _globals['_GAMESTATE']._serialized_start=7912
+  _globals['_GAMESTATE']._serialized_end=8745
+  _globals['_GAMESTATE_PLAYERSTATESENTRY']._serialized_start=8561
+  _globals['_GAMESTATE_PLAYERSTATESENTRY']._serialized_end=8651
+  _globals['_GAMESTATE_PENDINGORDERSENTRY']._serialized_start=8653
+  _globals['_GAMESTATE_PENDINGORDERSENTRY']._serialized_end=8745
+  _globals['_GAMEMOVEHISTORY']._serialized_start=8747
+  _globals['_GAMEMOVEHISTORY']._serialized_end=8842
+  _globals['_GAMEMOVEGROUP']._serialized_start=8845
+  _globals['_GAMEMOVEGROUP']._serialized_end=9055
+  _globals['_GAMEMOVE']._serialized_start=9058
+  _globals['_GAMEMOVE']._serialized_end=9839
+  _globals['_POSITION']._serialized_start=9841
+  _globals['_POSITION']._serialized_end=9901
+  _globals['_MOVEUNITACTION']._serialized_start=9904
+  _globals['_MOVEUNITACTION']._serialized_end=10108
+  _globals['_ATTACKUNITACTION']._serialized_start=10111
+  _globals['_ATTACKUNITACTION']._serialized_end=10393
+  _globals['_BUILDUNITACTION']._serialized_start=10395
+  _globals['_BUILDUNITACTION']._serialized_end=10503
+  _globals['_CAPTUREBUILDINGACTION']._serialized_start=10505
+  _globals['_CAPTUREBUILDINGACTION']._serialized_end=10599
+  _globals['_ENDTURNACTION']._serialized_start=10601
+  _globals['_ENDTURNACTION']._serialized_end=10616
+  _globals['_HEALUNITACTION']._serialized_start=10618
+  _globals['_HEALUNITACTION']._serialized_end=10709
+  _globals['_FIXUNITACTION']._serialized_start=10712
+  _globals['_FIXUNITACTION']._serialized_end=10852
+  _globals['_WORLDCHANGE']._serialized_start=10855
+  _globals['_WORLDCHANGE']._serialized_end=11580
+  _globals['_UNITHEALEDCHANGE']._serialized_start=11583
+  _globals['_UNITHEALEDCHANGE']._serialized_end=11746
+  _globals['_UNITFIXEDCHANGE']._serialized_start=11749
+  _globals['_UNITFIXEDCHANGE']._serialized_end=11968
+  _globals['_UNITMOVEDCHANGE']._serialized_start=11971
+  _globals['_UNITMOVEDCHANGE']._serialized_end=12100
+  _globals['_UNITDAMAGEDCHANGE']._serialized_start=12103
+  _globals['_UNITDAMAGEDCHANGE']._serialized_end=12234
+  _globals['_UNITKILLEDCHANGE']._serialized_start=12236
+  _globals['_UNITKILLEDCHANGE']._serialized_end=12311
+  _globals['_PLAYERCHANGEDCHANGE']._serialized_start=12314
+  _globals['_PLAYERCHANGEDCHANGE']._serialized_end=12524
+  _globals['_UNITBUILTCHANGE']._serialized_start=12527
+  _globals['_UNITBUILTCHANGE']._serialized_end=12696
+  _globals['_COINSCHANGEDCHANGE']._serialized_start=12699
+  _globals['_COINSCHANGEDCHANGE']._serialized_end=12840
+  _globals['_TILECAPTUREDCHANGE']._serialized_start=12843
+  _globals['_TILECAPTUREDCHANGE']._serialized_end=13065
+  _globals['_CAPTURESTARTEDCHANGE']._serialized_start=13068
+  _globals['_CAPTURESTARTEDCHANGE']._serialized_end=13261
+  _globals['_ALLPATHS']._serialized_start=13264
+  _globals['_ALLPATHS']._serialized_end=13467
+  _globals['_ALLPATHS_EDGESENTRY']._serialized_start=13387
+  _globals['_ALLPATHS_EDGESENTRY']._serialized_end=13467
+  _globals['_PATHEDGE']._serialized_start=13470
+  _globals['_PATHEDGE']._serialized_end=13734
+  _globals['_PATH']._serialized_start=13737
+  _globals['_PATH']._serialized_end=13881
+  _globals['_PLAYERORDERS']._serialized_start=13884
+  _globals['_PLAYERORDERS']._serialized_end=14116
+  _globals['_TIMERANGE']._serialized_start=14118
+  _globals['_TIMERANGE']._serialized_end=14225
+  _globals['_USERGAME']._serialized_start=14228
+  _globals['_USERGAME']._serialized_end=14588
+  _globals['_USERGAMELIST']._serialized_start=14590
+  _globals['_USERGAMELIST']._serialized_end=14650
+  _globals['_WORLDREVISION']._serialized_start=14653
+  _globals['_WORLDREVISION']._serialized_end=15001
+  _globals['_WORLDCELLCHANGE']._serialized_start=15003
+  _globals['_WORLDCELLCHANGE']._serialized_end=15111
+  _globals['_WORLDMERGECONFLICT']._serialized_start=15114
+  _globals['_WORLDMERGECONFLICT']._serialized_end=15280
+  _globals['_WORLDSEARCHENTRY']._serialized_start=15283
+  _globals['_WORLDSEARCHENTRY']._serialized_end=15473
 # @@protoc_insertion_point(module_scope)
@@ -25,7 +25,7 @@ _sym_db = _symbol_database.Default()
 from lilbattle.v1.models import models_pb2 as lilbattle_dot_v1_dot_models_dot_models__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1elilbattle/v1/models/sync.proto\x12\x0clilbattle.v1\x1a lilbattle/v1/models/models.proto\"m\n\x10SubscribeRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n\tplayer_id\x18\x02 \x01(\tR\x08playerId\x12#\n\rfrom_sequence\x18\x03 \x01(\x03R\x0c\x66romSequence\"\x9e\x01\n\x11SubscribeResponse\x12)\n\x10\x63urrent_sequence\x18\x01 \x01(\x03R\x0f\x63urrentSequence\x12\x36\n\ngame_state\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.GameStateR\tgameState\x12&\n\x04game\x18\x03 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game\"\x82\x03\n\nGameUpdate\x12\x1a\n\x08sequence\x18\x01 \x01(\x03R\x08sequence\x12G\n\x0fmoves_published\x18\x02 \x01(\x0b\x32\x1c.lilbattle.v1.MovesPublishedH\x00R\x0emovesPublished\x12\x41\n\rplayer_joined\x18\x03 \x01(\x0b\x32\x1a.lilbattle.v1.PlayerJoinedH\x00R\x0cplayerJoined\x12;\n\x0bplayer_left\x18\x04 \x01(\x0b\x32\x18.lilbattle.v1.PlayerLeftH\x00R\nplayerLeft\x12\x38\n\ngame_ended\x18\x05 \x01(\x0b\x32\x17.lilbattle.v1.GameEndedH\x00R\tgameEnded\x12\x46\n\rinitial_state\x18\x06 \x01(\x0b\x32\x1f.lilbattle.v1.SubscribeResponseH\x00R\x0cinitialStateB\r\n\x0bupdate_type\"y\n\x0eMovesPublished\x12\x16\n\x06player\x18\x01 \x01(\x05R\x06player\x12,\n\x05moves\x18\x02 \x03(\x0b\x32\x16.lilbattle.v1.GameMoveR\x05moves\x12!\n\x0cgroup_number\x18\x03 \x01(\x03R\x0bgroupNumber\"P\n\x0cPlayerJoined\x12\x1b\n\tplayer_id\x18\x01 \x01(\tR\x08playerId\x12#\n\rplayer_number\x18\x02 \x01(\x05R\x0cplayerNumber\"N\n\nPlayerLeft\x12\x1b\n\tplayer_id\x18\x01 \x01(\tR\x08playerId\x12#\n\rplayer_number\x18\x02 \x01(\x05R\x0cplayerNumber\";\n\tGameEnded\x12\x16\n\x06winner\x18\x01 \x01(\x05R\x06winner\x12\x16\n\x06reason\x18\x02 \x01(\tR\x06reason\"]\n\x10\x42roadcastRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x30\n\x06update\x18\x02 \x01(\x0b\x32\x18.lilbattle.v1.GameUpdateR\x06update\"Z\n\x11\x42roadcastResponse\x12)\n\x10subscriber_count\x18\x01 \x01(\x05R\x0fsubscriberCount\x12\x1a\n\x08sequence\x18\x02 \x01(\x03R\x08sequenceB\xb5\x01\n\x10\x63om.lilbattle.v1B\tSyncProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1elilbattle/v1/models/sync.proto\x12\x0clilbattle.v1\x1a lilbattle/v1/models/models.proto\"m\n\x10SubscribeRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n\tplayer_id\x18\x02 \x01(\tR\x08playerId\x12#\n\rfrom_sequence\x18\x03 \x01(\x03R\x0c\x66romSequence\"\xc7\x01\n\x11SubscribeResponse\x12)\n\x10\x63urrent_sequence\x18\x01 \x01(\x03R\x0f\x63urrentSequence\x12\x36\n\ngame_state\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.GameStateR\tgameState\x12&\n\x04game\x18\x03 \x01(\x0b\x32\x12.lilbattle.v1.GameR\x04game\x12\'\n\x0freload_required\x18\x04 \x01(\x08R\x0ereloadRequired\"\x82\x03\n\nGameUpdate\x12\x1a\n\x08sequence\x18\x01 \x01(\x03R\x08sequence\x12G\n\x0fmoves_published\x18\x02 \x01(\x0b\x32\x1c.lilbattle.v1.MovesPublishedH\x00R\x0emovesPublished\x12\x41\n\rplayer_joined\x18\x03 \x01(\x0b\x32\x1a.lilbattle.v1.PlayerJoinedH\x00R\x0cplayerJoined\x12;\n\x0bplayer_left\x18\x04 \x01(\x0b\x32\x18.lilbattle.v1.PlayerLeftH\x00R\nplayerLeft\x12\x38\n\ngame_ended\x18\x05 \x01(\x0b\x32\x17.lilbattle.v1.GameEndedH\x00R\tgameEnded\x12\x46\n\rinitial_state\x18\x06 \x01(\x0b\x32\x1f.lilbattle.v1.SubscribeResponseH\x00R\x0cinitialStateB\r\n\x0bupdate_type\"y\n\x0eMovesPublished\x12\x16\n\x06player\x18\x01 \x01(\x05R\x06player\x12,\n\x05moves\x18\x02 \x03(\x0b\x32\x16.lilbattle.v1.GameMoveR\x05moves\x12!\n\x0cgroup_number\x18\x03 \x01(\x03R\x0bgroupNumber\"P\n\x0cPlayerJoined\x12\x1b\n\tplayer_id\x18\x01 \x01(\tR\x08playerId\x12#\n\rplayer_number\x18\x02 \x01(\x05R\x0cplayerNumber\"N\n\nPlayerLeft\x12\x1b\n\tplayer_id\x18\x01 \x01(\tR\x08playerId\x12#\n\rplayer_number\x18\x02 \x01(\x05R\x0cplayerNumber\";\n\tGameEnded\x12\x16\n\x06winner\x18\x01 \x01(\x05R\x06winner\x12\x16\n\x06reason\x18\x02 \x01(\tR\x06reason\"]\n\x10\x42roadcastRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x30\n\x06update\x18\x02 \x01(\x0b\x32\x18.lilbattle.v1.GameUpdateR\x06update\"Z\n\x11\x42roadcastResponse\x12)\n\x10subscriber_count\x18\x01 \x01(\x05R\x0fsubscriberCount\x12\x1a\n\x08sequence\x18\x02 \x01(\x03R\x08sequenceB\xb5\x01\n\x10\x63om.lilbattle.v1B\tSyncProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -36,19 +36,19 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_SUBSCRIBEREQUEST']._serialized_start=82
   _globals['_SUBSCRIBEREQUEST']._serialized_end=191
   _globals['_SUBSCRIBERESPONSE']._serialized_start=194
-  _globals['_SUBSCRIBERESPONSE']._serialized_end=352
-  _globals['_GAMEUPDATE']._serialized_start=355
-  _globals['_GAMEUPDATE']._serialized_end=741
-  _globals['_MOVESPUBLISHED']._serialized_start=743
-  _globals['_MOVESPUBLISHED']._serialized_end=864
-  _globals['_PLAYERJOINED']._serialized_start=866
-  _globals['_PLAYERJOINED']._serialized_end=946
-  _globals['_PLAYERLEFT']._serialized_start=948
-  _globals['_PLAYERLEFT']._serialized_end=1026
-  _globals['_GAMEENDED']._serialized_start=1028
-  _globals['_GAMEENDED']._serialized_end=1087
-  _globals['_BROADCASTREQUEST']._serialized_start=1089
-  _globals['_BROADCASTREQUEST']._serialized_end=1182
-  _globals['_BROADCASTRESPONSE']._serialized_start=1184
-  _globals['_BROADCASTRESPONSE']._serialized_end=1274
+  _globals['_SUBSCRIBERESPONSE']._serialized_end=393
+  _globals['_GAMEUPDATE']._serialized_start=396
+  _globals['_GAMEUPDATE']._serialized_end=782
+  _globals['_MOVESPUBLISHED']._serialized_start=784
+  _globals['_MOVESPUBLISHED']._serialized_end=905
+  _globals['_PLAYERJOINED']._serialized_start=907
+  _globals['_PLAYERJOINED']._serialized_end=987
+  _globals['_PLAYERLEFT']._serialized_start=989
+  _globals['_PLAYERLEFT']._serialized_end=1067
+  _globals['_GAMEENDED']._serialized_start=1069
+  _globals['_GAMEENDED']._serialized_end=1128
+  _globals['_BROADCASTREQUEST']._serialized_start=1130
+  _globals['_BROADCASTREQUEST']._serialized_end=1223
+  _globals['_BROADCASTRESPONSE']._serialized_start=1225
+  _globals['_BROADCASTRESPONSE']._serialized_end=1315
 # @@protoc_insertion_point(module_scope)
@@ -28,7 +28,7 @@ from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
 from protoc_gen_openapiv2.options import annotations_pb2 as protoc__gen__openapiv2_dot_options_dot_annotations__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\'lilbattle/v1/models/world_service.proto\x12\x0clilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd8\x01\n\tWorldInfo\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x03 \x01(\tR\x0b\x64\x65scription\x12\x1a\n\x08\x63\x61tegory\x18\x04 \x01(\tR\x08\x63\x61tegory\x12\x1e\n\ndifficulty\x18\x05 \x01(\tR\ndifficulty\x12\x12\n\x04tags\x18\x06 \x03(\tR\x04tags\x12\x12\n\x04icon\x18\x07 \x01(\tR\x04icon\x12!\n\x0clast_updated\x18\x08 \x01(\tR\x0blastUpdated\"h\n\x11ListWorldsRequest\x12\x38\n\npagination\x18\x01 \x01(\x0b\x32\x18.lilbattle.v1.PaginationR\npagination\x12\x19\n\x08owner_id\x18\x02 \x01(\tR\x07ownerId\"\x81\x01\n\x12ListWorldsResponse\x12)\n\x05items\x18\x01 \x03(\x0b\x32\x13.lilbattle.v1.WorldR\x05items\x12@\n\npagination\x18\x02 \x01(\x0b\x32 .lilbattle.v1.PaginationResponseR\npagination\";\n\x0fGetWorldRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n\x07version\x18\x02 \x01(\tR\x07version\"u\n\x10GetWorldResponse\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\"\xf0\x01\n\x12UpdateWorldRequest\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\x12\x1f\n\x0b\x63lear_world\x18\x03 \x01(\x08R\nclearWorld\x12;\n\x0bupdate_mask\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskR\nupdateMask:\x19\x92\x41\x16\n\x14*\x12UpdateWorldRequest\"\x94\x01\n\x13UpdateWorldResponse\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData:\x1a\x92\x41\x17\n\x15*\x13UpdateWorldResponse\"$\n\x12\x44\x65leteWorldRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\x15\n\x13\x44\x65leteWorldResponse\"$\n\x10GetWorldsRequest\x12\x10\n\x03ids\x18\x01 \x03(\tR\x03ids\"\xa8\x01\n\x11GetWorldsResponse\x12\x43\n\x06worlds\x18\x01 \x03(\x0b\x32+.lilbattle.v1.GetWorldsResponse.WorldsEntryR\x06worlds\x1aN\n\x0bWorldsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12)\n\x05value\x18\x02 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05value:\x02\x38\x01\"w\n\x12\x43reateWorldRequest\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\"\x8f\x02\n\x13\x43reateWorldResponse\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\x12U\n\x0c\x66ield_errors\x18\x03 \x03(\x0b\x32\x32.lilbattle.v1.CreateWorldResponse.FieldErrorsEntryR\x0b\x66ieldErrors\x1a>\n\x10\x46ieldErrorsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\x42\xbd\x01\n\x10\x63om.lilbattle.v1B\x11WorldServiceProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\'lilbattle/v1/models/world_service.proto\x12\x0clilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd8\x01\n\tWorldInfo\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x03 \x01(\tR\x0b\x64\x65scription\x12\x1a\n\x08\x63\x61tegory\x18\x04 \x01(\tR\x08\x63\x61tegory\x12\x1e\n\ndifficulty\x18\x05 \x01(\tR\ndifficulty\x12\x12\n\x04tags\x18\x06 \x03(\tR\x04tags\x12\x12\n\x04icon\x18\x07 \x01(\tR\x04icon\x12!\n\x0clast_updated\x18\x08 \x01(\tR\x0blastUpdated\"\xc0\x02\n\x11ListWorldsRequest\x12\x38\n\npagination\x18\x01 \x01(\x0b\x32\x18.lilbattle.v1.PaginationR\npagination\x12\x19\n\x08owner_id\x18\x02 \x01(\tR\x07ownerId\x12\x10\n\x03tag\x18\x03 \x01(\tR\x03tag\x12\x31\n\x07\x63reated\x18\x04 \x01(\x0b\x32\x17.lilbattle.v1.TimeRangeR\x07\x63reated\x12\x31\n\x07updated\x18\x05 \x01(\x0b\x32\x17.lilbattle.v1.TimeRangeR\x07updated\x12\x17\n\x07sort_by\x18\x06 \x01(\tR\x06sortBy\x12\x1d\n\nsort_order\x18\x07 \x01(\tR\tsortOrder\x12&\n\x0fparent_world_id\x18\x08 \x01(\tR\rparentWorldId\"\x81\x01\n\x12ListWorldsResponse\x12)\n\x05items\x18\x01 \x03(\x0b\x32\x13.lilbattle.v1.WorldR\x05items\x12@\n\npagination\x18\x02 \x01(\x0b\x32 .lilbattle.v1.PaginationResponseR\npagination\";\n\x0fGetWorldRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n\x07version\x18\x02 \x01(\tR\x07version\"u\n\x10GetWorldResponse\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\"\xf0\x01\n\x12UpdateWorldRequest\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\x12\x1f\n\x0b\x63lear_world\x18\x03 \x01(\x08R\nclearWorld\x12;\n\x0bupdate_mask\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskR\nupdateMask:\x19\x92\x41\x16\n\x14*\x12UpdateWorldRequest\"\x94\x01\n\x13UpdateWorldResponse\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData:\x1a\x92\x41\x17\n\x15*\x13UpdateWorldResponse\"$\n\x12\x44\x65leteWorldRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\x15\n\x13\x44\x65leteWorldResponse\"$\n\x10GetWorldsRequest\x12\x10\n\x03ids\x18\x01 \x03(\tR\x03ids\"\xa8\x01\n\x11GetWorldsResponse\x12\x43\n\x06worlds\x18\x01 \x03(\x0b\x32+.lilbattle.v1.GetWorldsResponse.WorldsEntryR\x06worlds\x1aN\n\x0bWorldsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12)\n\x05value\x18\x02 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05value:\x02\x38\x01\"w\n\x12\x43reateWorldRequest\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\"\x8f\x02\n\x13\x43reateWorldResponse\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\x12U\n\x0c\x66ield_errors\x18\x03 \x03(\x0b\x32\x32.lilbattle.v1.CreateWorldResponse.FieldErrorsEntryR\x0b\x66ieldErrors\x1a>\n\x10\x46ieldErrorsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"p\n\x19ListWorldRevisionsRequest\x12\x19\n\x08world_id\x18\x01 \x01(\tR\x07worldId\x12\x38\n\npagination\x18\x02 \x01(\x0b\x32\x18.lilbattle.v1.PaginationR\npagination\"\x91\x01\n\x1aListWorldRevisionsResponse\x12\x31\n\x05items\x18\x01 \x03(\x0b\x32\x1b.lilbattle.v1.WorldRevisionR\x05items\x12@\n\npagination\x18\x02 \x01(\x0b\x32 .lilbattle.v1.PaginationResponseR\npagination\"K\n\x12RevertWorldRequest\x12\x19\n\x08world_id\x18\x01 \x01(\tR\x07worldId\x12\x1a\n\x08revision\x18\x02 \x01(\x03R\x08revision\"x\n\x13RevertWorldResponse\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\"o\n\x18ListDerivedWorldsRequest\x12\x19\n\x08world_id\x18\x01 \x01(\tR\x07worldId\x12\x38\n\npagination\x18\x02 \x01(\x0b\x32\x18.lilbattle.v1.PaginationR\npagination\"\x88\x01\n\x19ListDerivedWorldsResponse\x12)\n\x05items\x18\x01 \x03(\x0b\x32\x13.lilbattle.v1.WorldR\x05items\x12@\n\npagination\x18\x02 \x01(\x0b\x32 .lilbattle.v1.PaginationResponseR\npagination\"\xba\x01\n\x19MergeWorldUpstreamRequest\x12\x19\n\x08world_id\x18\x01 \x01(\tR\x07worldId\x12+\n\x11upstream_revision\x18\x02 \x01(\x03R\x10upstreamRevision\x12<\n\x08strategy\x18\x03 \x01(\x0e\x32 .lilbattle.v1.WorldMergeStrategyR\x08strategy\x12\x17\n\x07\x64ry_run\x18\x04 \x01(\x08R\x06\x64ryRun\"\xe2\x02\n\x1aMergeWorldUpstreamResponse\x12)\n\x05world\x18\x01 \x01(\x0b\x32\x13.lilbattle.v1.WorldR\x05world\x12\x36\n\nworld_data\x18\x02 \x01(\x0b\x32\x17.lilbattle.v1.WorldDataR\tworldData\x12#\n\rbase_revision\x18\x03 \x01(\x03R\x0c\x62\x61seRevision\x12+\n\x11upstream_revision\x18\x04 \x01(\x03R\x10upstreamRevision\x12\x37\n\x07\x61pplied\x18\x05 \x03(\x0b\x32\x1d.lilbattle.v1.WorldCellChangeR\x07\x61pplied\x12>\n\tconflicts\x18\x06 \x03(\x0b\x32 .lilbattle.v1.WorldMergeConflictR\tconflicts\x12\x16\n\x06merged\x18\x07 \x01(\x08R\x06merged\"\xd1\x02\n\x13SearchWorldsRequest\x12\x14\n\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n\x04tags\x18\x02 \x03(\tR\x04tags\x12\"\n\x0c\x64ifficulties\x18\x03 \x03(\tR\x0c\x64ifficulties\x12\x1f\n\x0bmin_players\x18\x04 \x01(\x05R\nminPlayers\x12\x1f\n\x0bmax_players\x18\x05 \x01(\x05R\nmaxPlayers\x12\x1b\n\tmin_tiles\x18\x06 \x01(\x05R\x08minTiles\x12\x1b\n\tmax_tiles\x18\x07 \x01(\x05R\x08maxTiles\x12\x17\n\x07sort_by\x18\x08 \x01(\tR\x06sortBy\x12\x1d\n\nsort_order\x18\t \x01(\tR\tsortOrder\x12\x38\n\npagination\x18\n \x01(\x0b\x32\x18.lilbattle.v1.PaginationR\npagination\">\n\x10SearchFacetCount\x12\x14\n\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n\x05\x63ount\x18\x02 \x01(\x05R\x05\x63ount\"\xc0\x02\n\x14SearchWorldsResponse\x12)\n\x05items\x18\x01 \x03(\x0b\x32\x13.lilbattle.v1.WorldR\x05items\x12@\n\npagination\x18\x02 \x01(\x0b\x32 .lilbattle.v1.PaginationResponseR\npagination\x12\x32\n\x04tags\x18\x03 \x03(\x0b\x32\x1e.lilbattle.v1.SearchFacetCountR\x04tags\x12\x42\n\x0c\x64ifficulties\x18\x04 \x03(\x0b\x32\x1e.lilbattle.v1.SearchFacetCountR\x0c\x64ifficulties\x12\x43\n\rplayer_counts\x18\x05 \x03(\x0b\x32\x1e.lilbattle.v1.SearchFacetCountR\x0cplayerCountsB\xbd\x01\n\x10\x63om.lilbattle.v1B\x11WorldServiceProtoP\x01ZEgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/models;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -46,32 +46,54 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_CREATEWORLDRESPONSE_FIELDERRORSENTRY']._serialized_options = b'8\001'
   _globals['_WORLDINFO']._serialized_start=204
   _globals['_WORLDINFO']._serialized_end=420
-  _globals['_LISTWORLDSREQUEST']._serialized_start=422
-  _globals['_LISTWORLDSREQUEST']._serialized_end=526
-  _globals['_LISTWORLDSRESPONSE']._serialized_start=529
-  _globals['_LISTWORLDSRESPONSE']._serialized_end=658
-  _globals['_GETWORLDREQUEST']._serialized_start=660
-  _globals['_GETWORLDREQUEST']._serialized_end=719
-  _globals['_GETWORLDRESPONSE']._serialized_start=721
-  _globals['_GETWORLDRESPONSE']._serialized_end=838
-  _globals['_UPDATEWORLDREQUEST']._serialized_start=841
-  _globals['_UPDATEWORLDREQUEST']._serialized_end=1081
-  _globals['_UPDATEWORLDRESPONSE']._serialized_start=1084
-  _globals['_UPDATEWORLDRESPONSE']._serialized_end=1232
-  _globals['_DELETEWORLDREQUEST']._serialized_start=1234
-  _globals['_DELETEWORLDREQUEST']._serialized_end=1270
-  _globals['_DELETEWORLDRESPONSE']._serialized_start=1272
-  _globals['_DELETEWORLDRESPONSE']._serialized_end=1293
-  _globals['_GETWORLDSREQUEST']._serialized_start=1295
-  _globals['_GETWORLDSREQUEST']._serialized_end=1331
-  _globals['_GETWORLDSRESPONSE']._serialized_start=1334
-  _globals['_GETWORLDSRESPONSE']._serialized_end=1502
-  _globals['_GETWORLDSRESPONSE_WORLDSENTRY']._serialized_start=1424
-  _globals['_GETWORLDSRESPONSE_WORLDSENTRY']._serialized_end=1502
-  _globals['_CREATEWORLDREQUEST']._serialized_start=1504
-  _globals['_CREATEWORLDREQUEST']._serialized_end=1623
-  _globals['_CREATEWORLDRESPONSE']._serialized_start=1626
-  _globals['_CREATEWORLDRESPONSE']._serialized_end=1897
-  _globals['_CREATEWORLDRESPONSE_FIELDERRORSENTRY']._serialized_start=1835
-  _globals['_CREATEWORLDRESPONSE_FIELDERRORSENTRY']._serialized_end=1897
+  _globals['_LISTWORLDSREQUEST']._serialized_start=423
+  _globals['_LISTWORLDSREQUEST']._serialized_end=743
+  _globals['_LISTWORLDSRESPONSE']._serialized_start=746
+  _globals['_LISTWORLDSRESPONSE']._serialized_end=875
+  _globals['_GETWORLDREQUEST']._serialized_start=877
+  _globals['_GETWORLDREQUEST']._serialized_end=936
+  _globals['_GETWORLDRESPONSE']._serialized_start=938
+  _globals['_GETWORLDRESPONSE']._serialized_end=1055
+  _globals['_UPDATEWORLDREQUEST']._serialized_start=1058
+  _globals['_UPDATEWORLDREQUEST']._serialized_end=1298
+  _globals['_UPDATEWORLDRESPONSE']._serialized_start=1301
+  _globals['_UPDATEWORLDRESPONSE']._serialized_end=1449
+  _globals['_DELETEWORLDREQUEST']._serialized_start=1451
+  _globals['_DELETEWORLDREQUEST']._serialized_end=1487
+  _globals['_DELETEWORLDRESPONSE']._serialized_start=1489
+  _globals['_DELETEWORLDRESPONSE']._serialized_end=1510
+  _globals['_GETWORLDSREQUEST']._serialized_start=1512
+  _globals['_GETWORLDSREQUEST']._serialized_end=1548
+  _globals['_GETWORLDSRESPONSE']._serialized_start=1551
+  _globals['_GETWORLDSRESPONSE']._serialized_end=1719
+  _globals['_GETWORLDSRESPONSE_WORLDSENTRY']._serialized_start=1641
+  _globals['_GETWORLDSRESPONSE_WORLDSENTRY']._serialized_end=1719
+  _globals['_CREATEWORLDREQUEST']._serialized_start=1721
+  _globals['_CREATEWORLDREQUEST']._serialized_end=1840
+  _globals['_CREATEWORLDRESPONSE']._serialized_start=1843
+  _globals['_CREATEWORLDRESPONSE']._serialized_end=2114
+  _globals['_CREATEWORLDRESPONSE_FIELDERRORSENTRY']._serialized_start=2052
+  _globals['_CREATEWORLDRESPONSE_FIELDERRORSENTRY']._serialized_end=2114
+  _globals['_LISTWORLDREVISIONSREQUEST']._serialized_start=2116
+  _globals['_LISTWORLDREVISIONSREQUEST']._serialized_end=2228
+  _globals['_LISTWORLDREVISIONSRESPONSE']._serialized_start=2231
+  _globals['_LISTWORLDREVISIONSRESPONSE']._serialized_end=2376
+  _globals['_REVERTWORLDREQUEST']._serialized_start=2378
+  _globals['_REVERTWORLDREQUEST']._serialized_end=2453
+  _globals['_REVERTWORLDRESPONSE']._serialized_start=2455
+  _globals['_REVERTWORLDRESPONSE']._serialized_end=2575
+  _globals['_LISTDERIVEDWORLDSREQUEST']._serialized_start=2577
+  _globals['_LISTDERIVEDWORLDSREQUEST']._serialized_end=2688
+  _globals['_LISTDERIVEDWORLDSRESPONSE']._serialized_start=2691
+  _globals['_LISTDERIVEDWORLDSRESPONSE']._serialized_end=2827
+  _globals['_MERGEWORLDUPSTREAMREQUEST']._serialized_start=2830
+  _globals['_MERGEWORLDUPSTREAMREQUEST']._serialized_end=3016
+  _globals['_MERGEWORLDUPSTREAMRESPONSE']._serialized_start=3019
+  _globals['_MERGEWORLDUPSTREAMRESPONSE']._serialized_end=3373
+  _globals['_SEARCHWORLDSREQUEST']._serialized_start=3376
+  _globals['_SEARCHWORLDSREQUEST']._serialized_end=3713
+  _globals['_SEARCHFACETCOUNT']._serialized_start=3715
+  _globals['_SEARCHFACETCOUNT']._serialized_end=3777
+  _globals['_SEARCHWORLDSRESPONSE']._serialized_start=3780
+  _globals['_SEARCHWORLDSRESPONSE']._serialized_end=4100
 # @@protoc_insertion_point(module_scope)
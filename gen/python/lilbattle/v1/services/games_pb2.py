@@ -29,7 +29,7 @@ from lilbattle.v1.models import models_pb2 as lilbattle_dot_v1_dot_models_dot_mo
 from lilbattle.v1.models import games_service_pb2 as lilbattle_dot_v1_dot_models_dot_games__service__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n!lilbattle/v1/services/games.proto\x12\x0clilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a\'lilbattle/v1/models/games_service.proto2\x86\x0c\n\x0cGamesService\x12\x65\n\nCreateGame\x12\x1f.lilbattle.v1.CreateGameRequest\x1a .lilbattle.v1.CreateGameResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\"\t/v1/games:\x01*\x12\x65\n\x08GetGames\x12\x1d.lilbattle.v1.GetGamesRequest\x1a\x1e.lilbattle.v1.GetGamesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/games:batchGet\x12_\n\tListGames\x12\x1e.lilbattle.v1.ListGamesRequest\x1a\x1f.lilbattle.v1.ListGamesResponse\"\x11\x82\xd3\xe4\x93\x02\x0b\x12\t/v1/games\x12^\n\x07GetGame\x12\x1c.lilbattle.v1.GetGameRequest\x1a\x1d.lilbattle.v1.GetGameResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/games/{id}\x12i\n\nDeleteGame\x12\x1f.lilbattle.v1.DeleteGameRequest\x1a .lilbattle.v1.DeleteGameResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/games/{id=*}\x12q\n\nUpdateGame\x12\x1f.lilbattle.v1.UpdateGameRequest\x1a .lilbattle.v1.UpdateGameResponse\" \x82\xd3\xe4\x93\x02\x1a\x32\x15/v1/games/{game_id=*}:\x01*\x12x\n\x0cGetGameState\x12!.lilbattle.v1.GetGameStateRequest\x1a\".lilbattle.v1.GetGameStateResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/games/{game_id}/state\x12o\n\tListMoves\x12\x1e.lilbattle.v1.ListMovesRequest\x1a\x1f.lilbattle.v1.ListMovesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/games/{game_id}/moves\x12{\n\x0cProcessMoves\x12!.lilbattle.v1.ProcessMovesRequest\x1a\".lilbattle.v1.ProcessMovesResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/games/{game_id}/moves:\x01*\x12\xb5\x01\n\x0cGetOptionsAt\x12!.lilbattle.v1.GetOptionsAtRequest\x1a\".lilbattle.v1.GetOptionsAtResponse\"^\x82\xd3\xe4\x93\x02X\x12+/v1/games/{game_id}/options/{pos.q}/{pos.r}Z)\x12\'/v1/games/{game_id}/options/{pos.label}\x12\x81\x01\n\x0eSimulateAttack\x12#.lilbattle.v1.SimulateAttackRequest\x1a$.lilbattle.v1.SimulateAttackResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/games/simulate_attack:\x01*\x12u\n\x0bSimulateFix\x12 .lilbattle.v1.SimulateFixRequest\x1a!.lilbattle.v1.SimulateFixResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/games/simulate_fix:\x01*\x12n\n\x08JoinGame\x12\x1d.lilbattle.v1.JoinGameRequest\x1a\x1e.lilbattle.v1.JoinGameResponse\"#\x82\xd3\xe4\x93\x02\x1d\"\x18/v1/games/{game_id}/join:\x01*B\xb8\x01\n\x10\x63om.lilbattle.v1B\nGamesProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n!lilbattle/v1/services/games.proto\x12\x0clilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a\'lilbattle/v1/models/games_service.proto2\xe0\x10\n\x0cGamesService\x12\x65\n\nCreateGame\x12\x1f.lilbattle.v1.CreateGameRequest\x1a .lilbattle.v1.CreateGameResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\"\t/v1/games:\x01*\x12\x65\n\x08GetGames\x12\x1d.lilbattle.v1.GetGamesRequest\x1a\x1e.lilbattle.v1.GetGamesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/games:batchGet\x12_\n\tListGames\x12\x1e.lilbattle.v1.ListGamesRequest\x1a\x1f.lilbattle.v1.ListGamesResponse\"\x11\x82\xd3\xe4\x93\x02\x0b\x12\t/v1/games\x12^\n\x07GetGame\x12\x1c.lilbattle.v1.GetGameRequest\x1a\x1d.lilbattle.v1.GetGameResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/games/{id}\x12i\n\nDeleteGame\x12\x1f.lilbattle.v1.DeleteGameRequest\x1a .lilbattle.v1.DeleteGameResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/games/{id=*}\x12q\n\nUpdateGame\x12\x1f.lilbattle.v1.UpdateGameRequest\x1a .lilbattle.v1.UpdateGameResponse\" \x82\xd3\xe4\x93\x02\x1a\x32\x15/v1/games/{game_id=*}:\x01*\x12x\n\x0cGetGameState\x12!.lilbattle.v1.GetGameStateRequest\x1a\".lilbattle.v1.GetGameStateResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/games/{game_id}/state\x12o\n\tListMoves\x12\x1e.lilbattle.v1.ListMovesRequest\x1a\x1f.lilbattle.v1.ListMovesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/games/{game_id}/moves\x12{\n\x0cProcessMoves\x12!.lilbattle.v1.ProcessMovesRequest\x1a\".lilbattle.v1.ProcessMovesResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/games/{game_id}/moves:\x01*\x12\xb5\x01\n\x0cGetOptionsAt\x12!.lilbattle.v1.GetOptionsAtRequest\x1a\".lilbattle.v1.GetOptionsAtResponse\"^\x82\xd3\xe4\x93\x02X\x12+/v1/games/{game_id}/options/{pos.q}/{pos.r}Z)\x12\'/v1/games/{game_id}/options/{pos.label}\x12\x81\x01\n\x0eSimulateAttack\x12#.lilbattle.v1.SimulateAttackRequest\x1a$.lilbattle.v1.SimulateAttackResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/games/simulate_attack:\x01*\x12u\n\x0bSimulateFix\x12 .lilbattle.v1.SimulateFixRequest\x1a!.lilbattle.v1.SimulateFixResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/games/simulate_fix:\x01*\x12n\n\x08JoinGame\x12\x1d.lilbattle.v1.JoinGameRequest\x1a\x1e.lilbattle.v1.JoinGameResponse\"#\x82\xd3\xe4\x93\x02\x1d\"\x18/v1/games/{game_id}/join:\x01*\x12\x83\x01\n\x0c\x43ommitOrders\x12!.lilbattle.v1.CommitOrdersRequest\x1a\".lilbattle.v1.CommitOrdersResponse\",\x82\xd3\xe4\x93\x02&\"!/v1/games/{game_id}/orders/commit:\x01*\x12\x83\x01\n\x0cRevealOrders\x12!.lilbattle.v1.RevealOrdersRequest\x1a\".lilbattle.v1.RevealOrdersResponse\",\x82\xd3\xe4\x93\x02&\"!/v1/games/{game_id}/orders/reveal:\x01*\x12h\n\x0bListMyGames\x12 .lilbattle.v1.ListMyGamesRequest\x1a!.lilbattle.v1.ListMyGamesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\x0c/v1/me/games\x12t\n\nExportGame\x12\x1f.lilbattle.v1.ExportGameRequest\x1a .lilbattle.v1.ExportGameResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/games/{game_id}/archive\x12l\n\nImportGame\x12\x1f.lilbattle.v1.ImportGameRequest\x1a .lilbattle.v1.ImportGameResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x10/v1/games:import:\x01*B\xb8\x01\n\x10\x63om.lilbattle.v1B\nGamesProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -63,6 +63,16 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_GAMESSERVICE'].methods_by_name['SimulateFix']._serialized_options = b'\202\323\344\223\002\033\"\026/v1/games/simulate_fix:\001*'
   _globals['_GAMESSERVICE'].methods_by_name['JoinGame']._loaded_options = None
   _globals['_GAMESSERVICE'].methods_by_name['JoinGame']._serialized_options = b'\202\323\344\223\002\035\"\030/v1/games/{game_id}/join:\001*'
+  _globals['_GAMESSERVICE'].methods_by_name['CommitOrders']._loaded_options = None
+  _globals['_GAMESSERVICE'].methods_by_name['CommitOrders']._serialized_options = b'\202\323\344\223\002&\"!/v1/games/{game_id}/orders/commit:\001*'
+  _globals['_GAMESSERVICE'].methods_by_name['RevealOrders']._loaded_options = None
+  _globals['_GAMESSERVICE'].methods_by_name['RevealOrders']._serialized_options = b'\202\323\344\223\002&\"!/v1/games/{game_id}/orders/reveal:\001*'
+  _globals['_GAMESSERVICE'].methods_by_name['ListMyGames']._loaded_options = None
+  _globals['_GAMESSERVICE'].methods_by_name['ListMyGames']._serialized_options = b'\202\323\344\223\002\016\022\014/v1/me/games'
+  _globals['_GAMESSERVICE'].methods_by_name['ExportGame']._loaded_options = None
+  _globals['_GAMESSERVICE'].methods_by_name['ExportGame']._serialized_options = b'\202\323\344\223\002\035\022\033/v1/games/{game_id}/archive'
+  _globals['_GAMESSERVICE'].methods_by_name['ImportGame']._loaded_options = None
+  _globals['_GAMESSERVICE'].methods_by_name['ImportGame']._serialized_options = b'\202\323\344\223\002\025\"\020/v1/games:import:\001*'
   _globals['_GAMESSERVICE']._serialized_start=239
-  _globals['_GAMESSERVICE']._serialized_end=1781
+  _globals['_GAMESSERVICE']._serialized_end=2383
 # @@protoc_insertion_point(module_scope)
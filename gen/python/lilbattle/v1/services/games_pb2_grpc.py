@@ -80,6 +80,31 @@ class GamesServiceStub:
                 request_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.JoinGameRequest.SerializeToString,
                 response_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.JoinGameResponse.FromString,
                 _registered_method=True)
+        self.CommitOrders = channel.unary_unary(
+                '/lilbattle.v1.GamesService/CommitOrders',
+                request_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.CommitOrdersRequest.SerializeToString,
+                response_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.CommitOrdersResponse.FromString,
+                _registered_method=True)
+        self.RevealOrders = channel.unary_unary(
+                '/lilbattle.v1.GamesService/RevealOrders',
+                request_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.RevealOrdersRequest.SerializeToString,
+                response_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.RevealOrdersResponse.FromString,
+                _registered_method=True)
+        self.ListMyGames = channel.unary_unary(
+                '/lilbattle.v1.GamesService/ListMyGames',
+                request_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ListMyGamesRequest.SerializeToString,
+                response_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ListMyGamesResponse.FromString,
+                _registered_method=True)
+        self.ExportGame = channel.unary_unary(
+                '/lilbattle.v1.GamesService/ExportGame',
+                request_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ExportGameRequest.SerializeToString,
+                response_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ExportGameResponse.FromString,
+                _registered_method=True)
+        self.ImportGame = channel.unary_unary(
+                '/lilbattle.v1.GamesService/ImportGame',
+                request_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ImportGameRequest.SerializeToString,
+                response_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ImportGameResponse.FromString,
+                _registered_method=True)
 
 
 class GamesServiceServicer:
@@ -184,6 +209,51 @@ class GamesServiceServicer:
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def CommitOrders(self, request, context):
+        """*
+        Commit a hash of the caller's orders for the current turn.
+        Only valid for games in simultaneous turn mode.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RevealOrders(self, request, context):
+        """*
+        Reveal the caller's committed orders.  Once all players have revealed,
+        the turn is resolved for everyone at once.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListMyGames(self, request, context):
+        """*
+        List the games the caller holds a player slot in along with whose turn
+        it is.  Served from the per-user game index.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ExportGame(self, request, context):
+        """*
+        Export a game as a portable archive bundling its state, history, the
+        world it started from and the rules it was played with
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ImportGame(self, request, context):
+        """*
+        Import a game archive.  The archive's content hash is checked and its
+        history replayed against the bundled rules before the game is saved.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_GamesServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -252,6 +322,31 @@ def add_GamesServiceServicer_to_server(servicer, server):
                     request_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.JoinGameRequest.FromString,
                     response_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.JoinGameResponse.SerializeToString,
             ),
+            'CommitOrders': grpc.unary_unary_rpc_method_handler(
+                    servicer.CommitOrders,
+                    request_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.CommitOrdersRequest.FromString,
+                    response_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.CommitOrdersResponse.SerializeToString,
+            ),
+            'RevealOrders': grpc.unary_unary_rpc_method_handler(
+                    servicer.RevealOrders,
+                    request_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.RevealOrdersRequest.FromString,
+                    response_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.RevealOrdersResponse.SerializeToString,
+            ),
+            'ListMyGames': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListMyGames,
+                    request_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ListMyGamesRequest.FromString,
+                    response_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ListMyGamesResponse.SerializeToString,
+            ),
+            'ExportGame': grpc.unary_unary_rpc_method_handler(
+                    servicer.ExportGame,
+                    request_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ExportGameRequest.FromString,
+                    response_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ExportGameResponse.SerializeToString,
+            ),
+            'ImportGame': grpc.unary_unary_rpc_method_handler(
+                    servicer.ImportGame,
+                    request_deserializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ImportGameRequest.FromString,
+                    response_serializer=lilbattle_dot_v1_dot_models_dot_games__service__pb2.ImportGameResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'lilbattle.v1.GamesService', rpc_method_handlers)
@@ -614,3 +709,138 @@ class GamesService:
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def CommitOrders(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/lilbattle.v1.GamesService/CommitOrders',
+            lilbattle_dot_v1_dot_models_dot_games__service__pb2.CommitOrdersRequest.SerializeToString,
+            lilbattle_dot_v1_dot_models_dot_games__service__pb2.CommitOrdersResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RevealOrders(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/lilbattle.v1.GamesService/RevealOrders',
+            lilbattle_dot_v1_dot_models_dot_games__service__pb2.RevealOrdersRequest.SerializeToString,
+            lilbattle_dot_v1_dot_models_dot_games__service__pb2.RevealOrdersResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListMyGames(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/lilbattle.v1.GamesService/ListMyGames',
+            lilbattle_dot_v1_dot_models_dot_games__service__pb2.ListMyGamesRequest.SerializeToString,
+            lilbattle_dot_v1_dot_models_dot_games__service__pb2.ListMyGamesResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ExportGame(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/lilbattle.v1.GamesService/ExportGame',
+            lilbattle_dot_v1_dot_models_dot_games__service__pb2.ExportGameRequest.SerializeToString,
+            lilbattle_dot_v1_dot_models_dot_games__service__pb2.ExportGameResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ImportGame(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/lilbattle.v1.GamesService/ImportGame',
+            lilbattle_dot_v1_dot_models_dot_games__service__pb2.ImportGameRequest.SerializeToString,
+            lilbattle_dot_v1_dot_models_dot_games__service__pb2.ImportGameResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
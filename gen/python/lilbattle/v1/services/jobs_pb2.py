@@ -0,0 +1,45 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: lilbattle/v1/services/jobs.proto
+# Protobuf Python Version: 7.35.1
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    7,
+    35,
+    1,
+    '',
+    'lilbattle/v1/services/jobs.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
+from lilbattle.v1.models import jobs_pb2 as lilbattle_dot_v1_dot_models_dot_jobs__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n lilbattle/v1/services/jobs.proto\x12\x0clilbattle.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1elilbattle/v1/models/jobs.proto2\xb1\x02\n\x0bJobsService\x12[\n\x08ListJobs\x12\x1d.lilbattle.v1.ListJobsRequest\x1a\x1e.lilbattle.v1.ListJobsResponse\"\x10\x82\xd3\xe4\x93\x02\n\x12\x08/v1/jobs\x12Z\n\x06GetJob\x12\x1b.lilbattle.v1.GetJobRequest\x1a\x1c.lilbattle.v1.GetJobResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/jobs/{id}\x12i\n\x08RetryJob\x12\x1d.lilbattle.v1.RetryJobRequest\x1a\x1e.lilbattle.v1.RetryJobResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\"\x13/v1/jobs/{id}:retry:\x01*B\xb7\x01\n\x10\x63om.lilbattle.v1B\tJobsProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'lilbattle.v1.services.jobs_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'\n\020com.lilbattle.v1B\tJobsProtoP\001ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\242\002\003LXX\252\002\014Lilbattle.V1\312\002\014Lilbattle\\V1\342\002\030Lilbattle\\V1\\GPBMetadata\352\002\rLilbattle::V1'
+  _globals['_JOBSSERVICE'].methods_by_name['ListJobs']._loaded_options = None
+  _globals['_JOBSSERVICE'].methods_by_name['ListJobs']._serialized_options = b'\202\323\344\223\002\n\022\010/v1/jobs'
+  _globals['_JOBSSERVICE'].methods_by_name['GetJob']._loaded_options = None
+  _globals['_JOBSSERVICE'].methods_by_name['GetJob']._serialized_options = b'\202\323\344\223\002\017\022\r/v1/jobs/{id}'
+  _globals['_JOBSSERVICE'].methods_by_name['RetryJob']._loaded_options = None
+  _globals['_JOBSSERVICE'].methods_by_name['RetryJob']._serialized_options = b'\202\323\344\223\002\030\"\023/v1/jobs/{id}:retry:\001*'
+  _globals['_JOBSSERVICE']._serialized_start=113
+  _globals['_JOBSSERVICE']._serialized_end=418
+# @@protoc_insertion_point(module_scope)
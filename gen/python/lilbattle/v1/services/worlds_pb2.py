@@ -29,7 +29,7 @@ from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
 from protoc_gen_openapiv2.options import annotations_pb2 as protoc__gen__openapiv2_dot_options_dot_annotations__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\"lilbattle/v1/services/worlds.proto\x12\x0clilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a\'lilbattle/v1/models/world_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x95\x05\n\rWorldsService\x12i\n\x0b\x43reateWorld\x12 .lilbattle.v1.CreateWorldRequest\x1a!.lilbattle.v1.CreateWorldResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\"\n/v1/worlds:\x01*\x12i\n\tGetWorlds\x12\x1e.lilbattle.v1.GetWorldsRequest\x1a\x1f.lilbattle.v1.GetWorldsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/worlds:batchGet\x12\x63\n\nListWorlds\x12\x1f.lilbattle.v1.ListWorldsRequest\x1a .lilbattle.v1.ListWorldsResponse\"\x12\x82\xd3\xe4\x93\x02\x0c\x12\n/v1/worlds\x12\x62\n\x08GetWorld\x12\x1d.lilbattle.v1.GetWorldRequest\x1a\x1e.lilbattle.v1.GetWorldResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/worlds/{id}\x12m\n\x0b\x44\x65leteWorld\x12 .lilbattle.v1.DeleteWorldRequest\x1a!.lilbattle.v1.DeleteWorldResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/worlds/{id=*}\x12v\n\x0bUpdateWorld\x12 .lilbattle.v1.UpdateWorldRequest\x1a!.lilbattle.v1.UpdateWorldResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x32\x17/v1/worlds/{world.id=*}:\x01*B\xb9\x01\n\x10\x63om.lilbattle.v1B\x0bWorldsProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\"lilbattle/v1/services/worlds.proto\x12\x0clilbattle.v1\x1a google/protobuf/field_mask.proto\x1a lilbattle/v1/models/models.proto\x1a\'lilbattle/v1/models/world_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xbf\n\n\rWorldsService\x12i\n\x0b\x43reateWorld\x12 .lilbattle.v1.CreateWorldRequest\x1a!.lilbattle.v1.CreateWorldResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\"\n/v1/worlds:\x01*\x12i\n\tGetWorlds\x12\x1e.lilbattle.v1.GetWorldsRequest\x1a\x1f.lilbattle.v1.GetWorldsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/worlds:batchGet\x12\x63\n\nListWorlds\x12\x1f.lilbattle.v1.ListWorldsRequest\x1a .lilbattle.v1.ListWorldsResponse\"\x12\x82\xd3\xe4\x93\x02\x0c\x12\n/v1/worlds\x12\x62\n\x08GetWorld\x12\x1d.lilbattle.v1.GetWorldRequest\x1a\x1e.lilbattle.v1.GetWorldResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/worlds/{id}\x12m\n\x0b\x44\x65leteWorld\x12 .lilbattle.v1.DeleteWorldRequest\x1a!.lilbattle.v1.DeleteWorldResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/worlds/{id=*}\x12v\n\x0bUpdateWorld\x12 .lilbattle.v1.UpdateWorldRequest\x1a!.lilbattle.v1.UpdateWorldResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x32\x17/v1/worlds/{world.id=*}:\x01*\x12\x90\x01\n\x12ListWorldRevisions\x12\'.lilbattle.v1.ListWorldRevisionsRequest\x1a(.lilbattle.v1.ListWorldRevisionsResponse\"\'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/worlds/{world_id}/revisions\x12{\n\x0bRevertWorld\x12 .lilbattle.v1.RevertWorldRequest\x1a!.lilbattle.v1.RevertWorldResponse\"\'\x82\xd3\xe4\x93\x02!\"\x1c/v1/worlds/{world_id}:revert:\x01*\x12\x8b\x01\n\x11ListDerivedWorlds\x12&.lilbattle.v1.ListDerivedWorldsRequest\x1a\'.lilbattle.v1.ListDerivedWorldsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/worlds/{world_id}/derived\x12p\n\x0cSearchWorlds\x12!.lilbattle.v1.SearchWorldsRequest\x1a\".lilbattle.v1.SearchWorldsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/worlds:search\x12\x97\x01\n\x12MergeWorldUpstream\x12\'.lilbattle.v1.MergeWorldUpstreamRequest\x1a(.lilbattle.v1.MergeWorldUpstreamResponse\".\x82\xd3\xe4\x93\x02(\"#/v1/worlds/{world_id}:mergeUpstream:\x01*B\xb9\x01\n\x10\x63om.lilbattle.v1B\x0bWorldsProtoP\x01ZGgithub.com/turnforge/lilbattle/gen/go/lilbattle/v1/services;lilbattlev1\xa2\x02\x03LXX\xaa\x02\x0cLilbattle.V1\xca\x02\x0cLilbattle\\V1\xe2\x02\x18Lilbattle\\V1\\GPBMetadata\xea\x02\rLilbattle::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -49,6 +49,16 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_WORLDSSERVICE'].methods_by_name['DeleteWorld']._serialized_options = b'\202\323\344\223\002\023*\021/v1/worlds/{id=*}'
   _globals['_WORLDSSERVICE'].methods_by_name['UpdateWorld']._loaded_options = None
   _globals['_WORLDSSERVICE'].methods_by_name['UpdateWorld']._serialized_options = b'\202\323\344\223\002\0342\027/v1/worlds/{world.id=*}:\001*'
+  _globals['_WORLDSSERVICE'].methods_by_name['ListWorldRevisions']._loaded_options = None
+  _globals['_WORLDSSERVICE'].methods_by_name['ListWorldRevisions']._serialized_options = b'\202\323\344\223\002!\022\037/v1/worlds/{world_id}/revisions'
+  _globals['_WORLDSSERVICE'].methods_by_name['RevertWorld']._loaded_options = None
+  _globals['_WORLDSSERVICE'].methods_by_name['RevertWorld']._serialized_options = b'\202\323\344\223\002!\"\034/v1/worlds/{world_id}:revert:\001*'
+  _globals['_WORLDSSERVICE'].methods_by_name['ListDerivedWorlds']._loaded_options = None
+  _globals['_WORLDSSERVICE'].methods_by_name['ListDerivedWorlds']._serialized_options = b'\202\323\344\223\002\037\022\035/v1/worlds/{world_id}/derived'
+  _globals['_WORLDSSERVICE'].methods_by_name['SearchWorlds']._loaded_options = None
+  _globals['_WORLDSSERVICE'].methods_by_name['SearchWorlds']._serialized_options = b'\202\323\344\223\002\023\022\021/v1/worlds:search'
+  _globals['_WORLDSSERVICE'].methods_by_name['MergeWorldUpstream']._loaded_options = None
+  _globals['_WORLDSSERVICE'].methods_by_name['MergeWorldUpstream']._serialized_options = b'\202\323\344\223\002(\"#/v1/worlds/{world_id}:mergeUpstream:\001*'
   _globals['_WORLDSSERVICE']._serialized_start=240
-  _globals['_WORLDSSERVICE']._serialized_end=901
+  _globals['_WORLDSSERVICE']._serialized_end=1583
 # @@protoc_insertion_point(module_scope)
@@ -45,6 +45,31 @@ class WorldsServiceStub:
                 request_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.UpdateWorldRequest.SerializeToString,
                 response_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.UpdateWorldResponse.FromString,
                 _registered_method=True)
+        self.ListWorldRevisions = channel.unary_unary(
+                '/lilbattle.v1.WorldsService/ListWorldRevisions',
+                request_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListWorldRevisionsRequest.SerializeToString,
+                response_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListWorldRevisionsResponse.FromString,
+                _registered_method=True)
+        self.RevertWorld = channel.unary_unary(
+                '/lilbattle.v1.WorldsService/RevertWorld',
+                request_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.RevertWorldRequest.SerializeToString,
+                response_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.RevertWorldResponse.FromString,
+                _registered_method=True)
+        self.ListDerivedWorlds = channel.unary_unary(
+                '/lilbattle.v1.WorldsService/ListDerivedWorlds',
+                request_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListDerivedWorldsRequest.SerializeToString,
+                response_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListDerivedWorldsResponse.FromString,
+                _registered_method=True)
+        self.MergeWorldUpstream = channel.unary_unary(
+                '/lilbattle.v1.WorldsService/MergeWorldUpstream',
+                request_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.MergeWorldUpstreamRequest.SerializeToString,
+                response_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.MergeWorldUpstreamResponse.FromString,
+                _registered_method=True)
+        self.SearchWorlds = channel.unary_unary(
+                '/lilbattle.v1.WorldsService/SearchWorlds',
+                request_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.SearchWorldsRequest.SerializeToString,
+                response_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.SearchWorldsResponse.FromString,
+                _registered_method=True)
 
 
 class WorldsServiceServicer:
@@ -96,6 +121,52 @@ class WorldsServiceServicer:
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ListWorldRevisions(self, request, context):
+        """ListWorldRevisions returns a world's immutable revisions, newest first
+        
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RevertWorld(self, request, context):
+        """*
+        Revert a world to an older revision.  The old data is saved as a new
+        revision rather than discarding the ones after it.
+        
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListDerivedWorlds(self, request, context):
+        """ListDerivedWorlds returns the worlds copied (remixed) from a world
+        
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MergeWorldUpstream(self, request, context):
+        """*
+        Merge the changes made to a world's parent since it was copied into
+        the world, with a three-way merge of tiles, units and crossings.
+        
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SearchWorlds(self, request, context):
+        """Search worlds by free text, tags, difficulty, player count and map
+        size.  Results come from the world search index so recent changes show
+        up once the world is reindexed.
+        
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_WorldsServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -129,6 +200,31 @@ def add_WorldsServiceServicer_to_server(servicer, server):
                     request_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.UpdateWorldRequest.FromString,
                     response_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.UpdateWorldResponse.SerializeToString,
             ),
+            'ListWorldRevisions': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListWorldRevisions,
+                    request_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListWorldRevisionsRequest.FromString,
+                    response_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListWorldRevisionsResponse.SerializeToString,
+            ),
+            'RevertWorld': grpc.unary_unary_rpc_method_handler(
+                    servicer.RevertWorld,
+                    request_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.RevertWorldRequest.FromString,
+                    response_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.RevertWorldResponse.SerializeToString,
+            ),
+            'ListDerivedWorlds': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListDerivedWorlds,
+                    request_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListDerivedWorldsRequest.FromString,
+                    response_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListDerivedWorldsResponse.SerializeToString,
+            ),
+            'MergeWorldUpstream': grpc.unary_unary_rpc_method_handler(
+                    servicer.MergeWorldUpstream,
+                    request_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.MergeWorldUpstreamRequest.FromString,
+                    response_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.MergeWorldUpstreamResponse.SerializeToString,
+            ),
+            'SearchWorlds': grpc.unary_unary_rpc_method_handler(
+                    servicer.SearchWorlds,
+                    request_deserializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.SearchWorldsRequest.FromString,
+                    response_serializer=lilbattle_dot_v1_dot_models_dot_world__service__pb2.SearchWorldsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'lilbattle.v1.WorldsService', rpc_method_handlers)
@@ -302,3 +398,138 @@ class WorldsService:
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def ListWorldRevisions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/lilbattle.v1.WorldsService/ListWorldRevisions',
+            lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListWorldRevisionsRequest.SerializeToString,
+            lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListWorldRevisionsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RevertWorld(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/lilbattle.v1.WorldsService/RevertWorld',
+            lilbattle_dot_v1_dot_models_dot_world__service__pb2.RevertWorldRequest.SerializeToString,
+            lilbattle_dot_v1_dot_models_dot_world__service__pb2.RevertWorldResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListDerivedWorlds(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/lilbattle.v1.WorldsService/ListDerivedWorlds',
+            lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListDerivedWorldsRequest.SerializeToString,
+            lilbattle_dot_v1_dot_models_dot_world__service__pb2.ListDerivedWorldsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def MergeWorldUpstream(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/lilbattle.v1.WorldsService/MergeWorldUpstream',
+            lilbattle_dot_v1_dot_models_dot_world__service__pb2.MergeWorldUpstreamRequest.SerializeToString,
+            lilbattle_dot_v1_dot_models_dot_world__service__pb2.MergeWorldUpstreamResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SearchWorlds(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/lilbattle.v1.WorldsService/SearchWorlds',
+            lilbattle_dot_v1_dot_models_dot_world__service__pb2.SearchWorldsRequest.SerializeToString,
+            lilbattle_dot_v1_dot_models_dot_world__service__pb2.SearchWorldsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
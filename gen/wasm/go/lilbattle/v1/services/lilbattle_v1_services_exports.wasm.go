@@ -22,6 +22,7 @@ type Lilbattle_v1ServicesExports struct {
 	FileStoreService            FileStoreServiceServer
 	GamesService                GamesServiceServer
 	IndexerService              IndexerServiceServer
+	JobsService                 JobsServiceServer
 	SingletonInitializerService SingletonInitializerServiceServer
 	GameViewPresenter           GameViewPresenterServer
 	GameSyncService             GameSyncServiceServer
@@ -93,6 +94,21 @@ func (exports *Lilbattle_v1ServicesExports) RegisterAPI() {
 			"joinGame": js.FuncOf(func(this js.Value, args []js.Value) any {
 				return exports.gamesServiceJoinGame(this, args)
 			}),
+			"commitOrders": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceCommitOrders(this, args)
+			}),
+			"revealOrders": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceRevealOrders(this, args)
+			}),
+			"listMyGames": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceListMyGames(this, args)
+			}),
+			"exportGame": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceExportGame(this, args)
+			}),
+			"importGame": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceImportGame(this, args)
+			}),
 		},
 		"indexerService": map[string]interface{}{
 			"ensureIndexState": js.FuncOf(func(this js.Value, args []js.Value) any {
@@ -108,6 +124,17 @@ func (exports *Lilbattle_v1ServicesExports) RegisterAPI() {
 				return exports.indexerServiceDeleteIndexStates(this, args)
 			}),
 		},
+		"jobsService": map[string]interface{}{
+			"listJobs": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.jobsServiceListJobs(this, args)
+			}),
+			"getJob": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.jobsServiceGetJob(this, args)
+			}),
+			"retryJob": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.jobsServiceRetryJob(this, args)
+			}),
+		},
 		"singletonInitializerService": map[string]interface{}{
 			"initializeSingleton": js.FuncOf(func(this js.Value, args []js.Value) any {
 				return exports.singletonInitializerServiceInitializeSingleton(this, args)
@@ -163,6 +190,21 @@ func (exports *Lilbattle_v1ServicesExports) RegisterAPI() {
 			"updateWorld": js.FuncOf(func(this js.Value, args []js.Value) any {
 				return exports.worldsServiceUpdateWorld(this, args)
 			}),
+			"listWorldRevisions": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.worldsServiceListWorldRevisions(this, args)
+			}),
+			"revertWorld": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.worldsServiceRevertWorld(this, args)
+			}),
+			"listDerivedWorlds": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.worldsServiceListDerivedWorlds(this, args)
+			}),
+			"searchWorlds": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.worldsServiceSearchWorlds(this, args)
+			}),
+			"mergeWorldUpstream": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.worldsServiceMergeWorldUpstream(this, args)
+			}),
 		},
 	}
 	js.Global().Set("lilbattle", js.ValueOf(lilbattle))
@@ -990,10 +1032,10 @@ func (exports *Lilbattle_v1ServicesExports) gamesServiceJoinGame(this js.Value,
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// indexerServiceEnsureIndexState handles the EnsureIndexState method for IndexerService
-func (exports *Lilbattle_v1ServicesExports) indexerServiceEnsureIndexState(this js.Value, args []js.Value) any {
-	if exports.IndexerService == nil {
-		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
+// gamesServiceCommitOrders handles the CommitOrders method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceCommitOrders(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1006,7 +1048,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceEnsureIndexState(this
 	}
 
 	// Parse request
-	req := &v1models.EnsureIndexStateRequest{}
+	req := &v1models.CommitOrdersRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1020,7 +1062,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceEnsureIndexState(this
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.IndexerService.EnsureIndexState(ctx, req)
+	resp, err := exports.GamesService.CommitOrders(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1038,10 +1080,10 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceEnsureIndexState(this
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// indexerServiceGetIndexStates handles the GetIndexStates method for IndexerService
-func (exports *Lilbattle_v1ServicesExports) indexerServiceGetIndexStates(this js.Value, args []js.Value) any {
-	if exports.IndexerService == nil {
-		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
+// gamesServiceRevealOrders handles the RevealOrders method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceRevealOrders(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1054,7 +1096,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceGetIndexStates(this js
 	}
 
 	// Parse request
-	req := &v1models.GetIndexStatesRequest{}
+	req := &v1models.RevealOrdersRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1068,7 +1110,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceGetIndexStates(this js
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.IndexerService.GetIndexStates(ctx, req)
+	resp, err := exports.GamesService.RevealOrders(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1086,10 +1128,10 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceGetIndexStates(this js
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// indexerServiceListIndexStates handles the ListIndexStates method for IndexerService
-func (exports *Lilbattle_v1ServicesExports) indexerServiceListIndexStates(this js.Value, args []js.Value) any {
-	if exports.IndexerService == nil {
-		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
+// gamesServiceListMyGames handles the ListMyGames method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceListMyGames(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1102,7 +1144,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceListIndexStates(this j
 	}
 
 	// Parse request
-	req := &v1models.ListIndexStatesRequest{}
+	req := &v1models.ListMyGamesRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1116,7 +1158,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceListIndexStates(this j
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.IndexerService.ListIndexStates(ctx, req)
+	resp, err := exports.GamesService.ListMyGames(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1134,10 +1176,10 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceListIndexStates(this j
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// indexerServiceDeleteIndexStates handles the DeleteIndexStates method for IndexerService
-func (exports *Lilbattle_v1ServicesExports) indexerServiceDeleteIndexStates(this js.Value, args []js.Value) any {
-	if exports.IndexerService == nil {
-		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
+// gamesServiceExportGame handles the ExportGame method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceExportGame(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1150,7 +1192,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceDeleteIndexStates(this
 	}
 
 	// Parse request
-	req := &v1models.DeleteIndexStatesRequest{}
+	req := &v1models.ExportGameRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1164,7 +1206,7 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceDeleteIndexStates(this
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.IndexerService.DeleteIndexStates(ctx, req)
+	resp, err := exports.GamesService.ExportGame(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1182,10 +1224,10 @@ func (exports *Lilbattle_v1ServicesExports) indexerServiceDeleteIndexStates(this
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// singletonInitializerServiceInitializeSingleton handles the InitializeSingleton method for SingletonInitializerService
-func (exports *Lilbattle_v1ServicesExports) singletonInitializerServiceInitializeSingleton(this js.Value, args []js.Value) any {
-	if exports.SingletonInitializerService == nil {
-		return wasm.CreateJSResponse(false, "SingletonInitializerService not initialized", nil)
+// gamesServiceImportGame handles the ImportGame method for GamesService
+func (exports *Lilbattle_v1ServicesExports) gamesServiceImportGame(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return wasm.CreateJSResponse(false, "GamesService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1198,7 +1240,7 @@ func (exports *Lilbattle_v1ServicesExports) singletonInitializerServiceInitializ
 	}
 
 	// Parse request
-	req := &v1models.InitializeSingletonRequest{}
+	req := &v1models.ImportGameRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1212,7 +1254,7 @@ func (exports *Lilbattle_v1ServicesExports) singletonInitializerServiceInitializ
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.SingletonInitializerService.InitializeSingleton(ctx, req)
+	resp, err := exports.GamesService.ImportGame(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1230,10 +1272,10 @@ func (exports *Lilbattle_v1ServicesExports) singletonInitializerServiceInitializ
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterInitializeGame handles the InitializeGame method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterInitializeGame(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// indexerServiceEnsureIndexState handles the EnsureIndexState method for IndexerService
+func (exports *Lilbattle_v1ServicesExports) indexerServiceEnsureIndexState(this js.Value, args []js.Value) any {
+	if exports.IndexerService == nil {
+		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1246,7 +1288,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterInitializeGame(this
 	}
 
 	// Parse request
-	req := &v1models.InitializeGameRequest{}
+	req := &v1models.EnsureIndexStateRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1260,7 +1302,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterInitializeGame(this
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.GameViewPresenter.InitializeGame(ctx, req)
+	resp, err := exports.IndexerService.EnsureIndexState(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1278,70 +1320,58 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterInitializeGame(this
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterClientReady handles the ClientReady method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterClientReady(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// indexerServiceGetIndexStates handles the GetIndexStates method for IndexerService
+func (exports *Lilbattle_v1ServicesExports) indexerServiceGetIndexStates(this js.Value, args []js.Value) any {
+	if exports.IndexerService == nil {
+		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
 	}
-	// Promise method: returns JS Promise, executes in goroutine
+	// Synchronous method
 	if len(args) < 1 {
-		return wasm.CreateRejectedPromise("Request JSON required")
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
 	}
 
 	requestJSON := args[0].String()
 	if requestJSON == "" {
-		return wasm.CreateRejectedPromise("Request JSON is empty")
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
 	}
 
 	// Parse request
-	req := &v1models.ClientReadyRequest{}
+	req := &v1models.GetIndexStatesRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
-		AllowPartial:   true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
 	}); err != nil {
-		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
 	}
 
-	// Return Promise immediately, work happens in goroutine
-	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
-		go func() {
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancel()
-
-			// Call service method
-			resp, err := exports.GameViewPresenter.ClientReady(ctx, req)
-			if err != nil {
-				reject(err.Error())
-				return
-			}
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
 
-			// Marshal response
-			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
-				UseProtoNames:   false,
-				EmitUnpopulated: true,
-				UseEnumNumbers:  false,
-			})
-			if err != nil {
-				reject(fmt.Sprintf("Failed to marshal response: %v", err))
-				return
-			}
+	// Call service method
+	resp, err := exports.IndexerService.GetIndexStates(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
 
-			// Convert JSON to JavaScript object and resolve
-			var jsObject interface{}
-			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
-				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
-				return
-			}
-			resolve(js.ValueOf(jsObject))
-		}()
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
 	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterSceneClicked handles the SceneClicked method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterSceneClicked(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// indexerServiceListIndexStates handles the ListIndexStates method for IndexerService
+func (exports *Lilbattle_v1ServicesExports) indexerServiceListIndexStates(this js.Value, args []js.Value) any {
+	if exports.IndexerService == nil {
+		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1354,7 +1384,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterSceneClicked(this j
 	}
 
 	// Parse request
-	req := &v1models.SceneClickedRequest{}
+	req := &v1models.ListIndexStatesRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1368,7 +1398,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterSceneClicked(this j
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.GameViewPresenter.SceneClicked(ctx, req)
+	resp, err := exports.IndexerService.ListIndexStates(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1386,10 +1416,10 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterSceneClicked(this j
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterTurnOptionClicked handles the TurnOptionClicked method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterTurnOptionClicked(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// indexerServiceDeleteIndexStates handles the DeleteIndexStates method for IndexerService
+func (exports *Lilbattle_v1ServicesExports) indexerServiceDeleteIndexStates(this js.Value, args []js.Value) any {
+	if exports.IndexerService == nil {
+		return wasm.CreateJSResponse(false, "IndexerService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1402,7 +1432,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterTurnOptionClicked(t
 	}
 
 	// Parse request
-	req := &v1models.TurnOptionClickedRequest{}
+	req := &v1models.DeleteIndexStatesRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1416,7 +1446,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterTurnOptionClicked(t
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.GameViewPresenter.TurnOptionClicked(ctx, req)
+	resp, err := exports.IndexerService.DeleteIndexStates(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1434,10 +1464,10 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterTurnOptionClicked(t
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterEndTurnButtonClicked handles the EndTurnButtonClicked method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterEndTurnButtonClicked(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// jobsServiceListJobs handles the ListJobs method for JobsService
+func (exports *Lilbattle_v1ServicesExports) jobsServiceListJobs(this js.Value, args []js.Value) any {
+	if exports.JobsService == nil {
+		return wasm.CreateJSResponse(false, "JobsService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1450,7 +1480,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterEndTurnButtonClicke
 	}
 
 	// Parse request
-	req := &v1models.EndTurnButtonClickedRequest{}
+	req := &v1models.ListJobsRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1464,7 +1494,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterEndTurnButtonClicke
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.GameViewPresenter.EndTurnButtonClicked(ctx, req)
+	resp, err := exports.JobsService.ListJobs(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1482,10 +1512,10 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterEndTurnButtonClicke
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterBuildOptionClicked handles the BuildOptionClicked method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterBuildOptionClicked(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// jobsServiceGetJob handles the GetJob method for JobsService
+func (exports *Lilbattle_v1ServicesExports) jobsServiceGetJob(this js.Value, args []js.Value) any {
+	if exports.JobsService == nil {
+		return wasm.CreateJSResponse(false, "JobsService not initialized", nil)
 	}
 	// Synchronous method
 	if len(args) < 1 {
@@ -1498,7 +1528,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterBuildOptionClicked(
 	}
 
 	// Parse request
-	req := &v1models.BuildOptionClickedRequest{}
+	req := &v1models.GetJobRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -1512,7 +1542,7 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterBuildOptionClicked(
 	defer cancel()
 
 	// Call service method
-	resp, err := exports.GameViewPresenter.BuildOptionClicked(ctx, req)
+	resp, err := exports.JobsService.GetJob(ctx, req)
 	if err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
 	}
@@ -1530,74 +1560,62 @@ func (exports *Lilbattle_v1ServicesExports) gameViewPresenterBuildOptionClicked(
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameViewPresenterApplyRemoteChanges handles the ApplyRemoteChanges method for GameViewPresenter
-func (exports *Lilbattle_v1ServicesExports) gameViewPresenterApplyRemoteChanges(this js.Value, args []js.Value) any {
-	if exports.GameViewPresenter == nil {
-		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+// jobsServiceRetryJob handles the RetryJob method for JobsService
+func (exports *Lilbattle_v1ServicesExports) jobsServiceRetryJob(this js.Value, args []js.Value) any {
+	if exports.JobsService == nil {
+		return wasm.CreateJSResponse(false, "JobsService not initialized", nil)
 	}
-	// Promise method: returns JS Promise, executes in goroutine
+	// Synchronous method
 	if len(args) < 1 {
-		return wasm.CreateRejectedPromise("Request JSON required")
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
 	}
 
 	requestJSON := args[0].String()
 	if requestJSON == "" {
-		return wasm.CreateRejectedPromise("Request JSON is empty")
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
 	}
 
 	// Parse request
-	req := &v1models.ApplyRemoteChangesRequest{}
+	req := &v1models.RetryJobRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
-		AllowPartial:   true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
 	}); err != nil {
-		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
 	}
 
-	// Return Promise immediately, work happens in goroutine
-	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
-		go func() {
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancel()
-
-			// Call service method
-			resp, err := exports.GameViewPresenter.ApplyRemoteChanges(ctx, req)
-			if err != nil {
-				reject(err.Error())
-				return
-			}
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
 
-			// Marshal response
-			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
-				UseProtoNames:   false,
-				EmitUnpopulated: true,
-				UseEnumNumbers:  false,
-			})
-			if err != nil {
-				reject(fmt.Sprintf("Failed to marshal response: %v", err))
-				return
-			}
+	// Call service method
+	resp, err := exports.JobsService.RetryJob(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
 
-			// Convert JSON to JavaScript object and resolve
-			var jsObject interface{}
-			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
-				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
-				return
-			}
-			resolve(js.ValueOf(jsObject))
-		}()
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
 	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
-// gameSyncServiceSubscribe handles the Subscribe method for GameSyncService
-func (exports *Lilbattle_v1ServicesExports) gameSyncServiceSubscribe(this js.Value, args []js.Value) any {
-	if exports.GameSyncService == nil {
-		return wasm.CreateJSResponse(false, "GameSyncService not initialized", nil)
+// singletonInitializerServiceInitializeSingleton handles the InitializeSingleton method for SingletonInitializerService
+func (exports *Lilbattle_v1ServicesExports) singletonInitializerServiceInitializeSingleton(this js.Value, args []js.Value) any {
+	if exports.SingletonInitializerService == nil {
+		return wasm.CreateJSResponse(false, "SingletonInitializerService not initialized", nil)
 	}
-	// Server streaming method: expect request JSON and callback function
-	if len(args) < 2 {
-		return wasm.CreateJSResponse(false, "Request JSON and callback function required for streaming method", nil)
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
 	}
 
 	requestJSON := args[0].String()
@@ -1605,22 +1623,430 @@ func (exports *Lilbattle_v1ServicesExports) gameSyncServiceSubscribe(this js.Val
 		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
 	}
 
-	callback := args[1]
-	if callback.Type() != js.TypeFunction {
-		return wasm.CreateJSResponse(false, "Second argument must be a callback function", nil)
-	}
-
 	// Parse request
-	req := &v1models.SubscribeRequest{}
+	req := &v1models.InitializeSingletonRequest{}
 	marshaller := wasm.GetGlobalMarshaller()
 	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
 		DiscardUnknown: true,
-		AllowPartial:   true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
 	}); err != nil {
 		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
 	}
 
-	// Start streaming in goroutine to avoid blocking
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.SingletonInitializerService.InitializeSingleton(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterInitializeGame handles the InitializeGame method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterInitializeGame(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.InitializeGameRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GameViewPresenter.InitializeGame(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterClientReady handles the ClientReady method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterClientReady(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Promise method: returns JS Promise, executes in goroutine
+	if len(args) < 1 {
+		return wasm.CreateRejectedPromise("Request JSON required")
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateRejectedPromise("Request JSON is empty")
+	}
+
+	// Parse request
+	req := &v1models.ClientReadyRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true,
+	}); err != nil {
+		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+	}
+
+	// Return Promise immediately, work happens in goroutine
+	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// Call service method
+			resp, err := exports.GameViewPresenter.ClientReady(ctx, req)
+			if err != nil {
+				reject(err.Error())
+				return
+			}
+
+			// Marshal response
+			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+				UseProtoNames:   false,
+				EmitUnpopulated: true,
+				UseEnumNumbers:  false,
+			})
+			if err != nil {
+				reject(fmt.Sprintf("Failed to marshal response: %v", err))
+				return
+			}
+
+			// Convert JSON to JavaScript object and resolve
+			var jsObject interface{}
+			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
+				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
+				return
+			}
+			resolve(js.ValueOf(jsObject))
+		}()
+	})
+}
+
+// gameViewPresenterSceneClicked handles the SceneClicked method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterSceneClicked(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.SceneClickedRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GameViewPresenter.SceneClicked(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterTurnOptionClicked handles the TurnOptionClicked method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterTurnOptionClicked(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.TurnOptionClickedRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GameViewPresenter.TurnOptionClicked(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterEndTurnButtonClicked handles the EndTurnButtonClicked method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterEndTurnButtonClicked(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.EndTurnButtonClickedRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GameViewPresenter.EndTurnButtonClicked(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterBuildOptionClicked handles the BuildOptionClicked method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterBuildOptionClicked(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.BuildOptionClickedRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GameViewPresenter.BuildOptionClicked(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gameViewPresenterApplyRemoteChanges handles the ApplyRemoteChanges method for GameViewPresenter
+func (exports *Lilbattle_v1ServicesExports) gameViewPresenterApplyRemoteChanges(this js.Value, args []js.Value) any {
+	if exports.GameViewPresenter == nil {
+		return wasm.CreateJSResponse(false, "GameViewPresenter not initialized", nil)
+	}
+	// Promise method: returns JS Promise, executes in goroutine
+	if len(args) < 1 {
+		return wasm.CreateRejectedPromise("Request JSON required")
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateRejectedPromise("Request JSON is empty")
+	}
+
+	// Parse request
+	req := &v1models.ApplyRemoteChangesRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true,
+	}); err != nil {
+		return wasm.CreateRejectedPromise(fmt.Sprintf("Failed to parse request: %v", err))
+	}
+
+	// Return Promise immediately, work happens in goroutine
+	return wasm.CreateJSPromise(func(resolve, reject func(any)) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// Call service method
+			resp, err := exports.GameViewPresenter.ApplyRemoteChanges(ctx, req)
+			if err != nil {
+				reject(err.Error())
+				return
+			}
+
+			// Marshal response
+			responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+				UseProtoNames:   false,
+				EmitUnpopulated: true,
+				UseEnumNumbers:  false,
+			})
+			if err != nil {
+				reject(fmt.Sprintf("Failed to marshal response: %v", err))
+				return
+			}
+
+			// Convert JSON to JavaScript object and resolve
+			var jsObject interface{}
+			if err := json.Unmarshal(responseJSON, &jsObject); err != nil {
+				reject(fmt.Sprintf("Failed to convert response to JS object: %v", err))
+				return
+			}
+			resolve(js.ValueOf(jsObject))
+		}()
+	})
+}
+
+// gameSyncServiceSubscribe handles the Subscribe method for GameSyncService
+func (exports *Lilbattle_v1ServicesExports) gameSyncServiceSubscribe(this js.Value, args []js.Value) any {
+	if exports.GameSyncService == nil {
+		return wasm.CreateJSResponse(false, "GameSyncService not initialized", nil)
+	}
+	// Server streaming method: expect request JSON and callback function
+	if len(args) < 2 {
+		return wasm.CreateJSResponse(false, "Request JSON and callback function required for streaming method", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	callback := args[1]
+	if callback.Type() != js.TypeFunction {
+		return wasm.CreateJSResponse(false, "Second argument must be a callback function", nil)
+	}
+
+	// Parse request
+	req := &v1models.SubscribeRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true,
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Start streaming in goroutine to avoid blocking
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
@@ -1982,3 +2408,243 @@ func (exports *Lilbattle_v1ServicesExports) worldsServiceUpdateWorld(this js.Val
 
 	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
+
+// worldsServiceListWorldRevisions handles the ListWorldRevisions method for WorldsService
+func (exports *Lilbattle_v1ServicesExports) worldsServiceListWorldRevisions(this js.Value, args []js.Value) any {
+	if exports.WorldsService == nil {
+		return wasm.CreateJSResponse(false, "WorldsService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.ListWorldRevisionsRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.WorldsService.ListWorldRevisions(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// worldsServiceRevertWorld handles the RevertWorld method for WorldsService
+func (exports *Lilbattle_v1ServicesExports) worldsServiceRevertWorld(this js.Value, args []js.Value) any {
+	if exports.WorldsService == nil {
+		return wasm.CreateJSResponse(false, "WorldsService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.RevertWorldRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.WorldsService.RevertWorld(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// worldsServiceListDerivedWorlds handles the ListDerivedWorlds method for WorldsService
+func (exports *Lilbattle_v1ServicesExports) worldsServiceListDerivedWorlds(this js.Value, args []js.Value) any {
+	if exports.WorldsService == nil {
+		return wasm.CreateJSResponse(false, "WorldsService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.ListDerivedWorldsRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.WorldsService.ListDerivedWorlds(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// worldsServiceSearchWorlds handles the SearchWorlds method for WorldsService
+func (exports *Lilbattle_v1ServicesExports) worldsServiceSearchWorlds(this js.Value, args []js.Value) any {
+	if exports.WorldsService == nil {
+		return wasm.CreateJSResponse(false, "WorldsService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.SearchWorldsRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.WorldsService.SearchWorlds(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// worldsServiceMergeWorldUpstream handles the MergeWorldUpstream method for WorldsService
+func (exports *Lilbattle_v1ServicesExports) worldsServiceMergeWorldUpstream(this js.Value, args []js.Value) any {
+	if exports.WorldsService == nil {
+		return wasm.CreateJSResponse(false, "WorldsService not initialized", nil)
+	}
+	// Synchronous method
+	if len(args) < 1 {
+		return wasm.CreateJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return wasm.CreateJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &v1models.MergeWorldUpstreamRequest{}
+	marshaller := wasm.GetGlobalMarshaller()
+	if err := marshaller.Unmarshal([]byte(requestJSON), req, wasm.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}); err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.WorldsService.MergeWorldUpstream(ctx, req)
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	responseJSON, err := marshaller.Marshal(resp, wasm.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: true,  // Emit zero values to avoid undefined in JavaScript
+		UseEnumNumbers:  false, // Use enum string values
+	})
+	if err != nil {
+		return wasm.CreateJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return wasm.CreateJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
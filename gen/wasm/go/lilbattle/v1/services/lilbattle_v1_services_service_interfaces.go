@@ -65,6 +65,26 @@ type GamesServiceServer interface {
 	Join a game as an open player slot
 	User must be authenticated. The player slot must be "open" to be joinable. */
 	JoinGame(context.Context, *v1models.JoinGameRequest) (*v1models.JoinGameResponse, error)
+	/** *
+	Commit a hash of the caller's orders for the current turn.
+	Only valid for games in simultaneous turn mode. */
+	CommitOrders(context.Context, *v1models.CommitOrdersRequest) (*v1models.CommitOrdersResponse, error)
+	/** *
+	Reveal the caller's committed orders.  Once all players have revealed,
+	the turn is resolved for everyone at once. */
+	RevealOrders(context.Context, *v1models.RevealOrdersRequest) (*v1models.RevealOrdersResponse, error)
+	/** *
+	List the games the caller holds a player slot in along with whose turn
+	it is.  Served from the per-user game index. */
+	ListMyGames(context.Context, *v1models.ListMyGamesRequest) (*v1models.ListMyGamesResponse, error)
+	/** *
+	Export a game as a portable archive bundling its state, history, the
+	world it started from and the rules it was played with */
+	ExportGame(context.Context, *v1models.ExportGameRequest) (*v1models.ExportGameResponse, error)
+	/** *
+	Import a game archive.  The archive's content hash is checked and its
+	history replayed against the bundled rules before the game is saved. */
+	ImportGame(context.Context, *v1models.ImportGameRequest) (*v1models.ImportGameResponse, error)
 }
 
 // IndexerServiceServer is the server API for IndexerService service (WASM version without gRPC embedding).
@@ -81,6 +101,19 @@ type IndexerServiceServer interface {
 	DeleteIndexStates(context.Context, *v1models.DeleteIndexStatesRequest) (*v1models.DeleteIndexStatesResponse, error)
 }
 
+// JobsServiceServer is the server API for JobsService service (WASM version without gRPC embedding).
+type JobsServiceServer interface {
+	/** *
+	List jobs, optionally by type and status */
+	ListJobs(context.Context, *v1models.ListJobsRequest) (*v1models.ListJobsResponse, error)
+	/** *
+	Get a job with its run history */
+	GetJob(context.Context, *v1models.GetJobRequest) (*v1models.GetJobResponse, error)
+	/** *
+	Run a job again now, with fresh retries */
+	RetryJob(context.Context, *v1models.RetryJobRequest) (*v1models.RetryJobResponse, error)
+}
+
 // SingletonInitializerServiceServer is the server API for SingletonInitializerService service (WASM version without gRPC embedding).
 type SingletonInitializerServiceServer interface {
 	InitializeSingleton(context.Context, *v1models.InitializeSingletonRequest) (*v1models.InitializeSingletonResponse, error)
@@ -148,6 +181,23 @@ type WorldsServiceServer interface {
 	DeleteWorld(context.Context, *v1models.DeleteWorldRequest) (*v1models.DeleteWorldResponse, error)
 	/** GetWorld returns a specific world with metadata */
 	UpdateWorld(context.Context, *v1models.UpdateWorldRequest) (*v1models.UpdateWorldResponse, error)
+	/** ListWorldRevisions returns a world's immutable revisions, newest first */
+	ListWorldRevisions(context.Context, *v1models.ListWorldRevisionsRequest) (*v1models.ListWorldRevisionsResponse, error)
+	/** *
+	Revert a world to an older revision.  The old data is saved as a new
+	revision rather than discarding the ones after it. */
+	RevertWorld(context.Context, *v1models.RevertWorldRequest) (*v1models.RevertWorldResponse, error)
+	/** ListDerivedWorlds returns the worlds copied (remixed) from a world */
+	ListDerivedWorlds(context.Context, *v1models.ListDerivedWorldsRequest) (*v1models.ListDerivedWorldsResponse, error)
+	/** *
+	Search worlds by free text, tags, difficulty, player count and map
+	size.  Results come from the world search index so recent changes show
+	up once the world is reindexed. */
+	SearchWorlds(context.Context, *v1models.SearchWorldsRequest) (*v1models.SearchWorldsResponse, error)
+	/** *
+	Merge the changes made to a world's parent since it was copied into
+	the world, with a three-way merge of tiles, units and crossings. */
+	MergeWorldUpstream(context.Context, *v1models.MergeWorldUpstreamRequest) (*v1models.MergeWorldUpstreamResponse, error)
 }
 
 // Server stream interfaces for streaming methods
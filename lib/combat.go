@@ -224,6 +224,12 @@ func (re *RulesEngine) CanUnitFixTarget(fixer *v1.Unit, target *v1.Unit) (bool,
 		return false, nil // Can only fix friendly units
 	}
 
+	return re.canFixUnitTerrain(fixer, fixerData, target)
+}
+
+// canFixUnitTerrain checks if a fixer can fix a target based on unit terrain
+// type compatibility alone (ownership is checked by the caller)
+func (re *RulesEngine) canFixUnitTerrain(fixer *v1.Unit, fixerData *v1.UnitDefinition, target *v1.Unit) (bool, error) {
 	// Check terrain compatibility using DefaultFixTargets
 	allowedTerrains, hasRestriction := DefaultFixTargets[fixer.UnitType]
 	if !hasRestriction {
@@ -92,13 +92,11 @@ func (g *Game) NumPlayers() int32 {
 	return int32(len(g.Config.Players))
 }
 
-// ArePlayersOnSameTeam checks if two players are on the same team
+// ArePlayersOnSameTeam checks if two different players are on the same team.
+// Players are only ever on a team in team games and team 0 means no team.
 func (g *Game) ArePlayersOnSameTeam(playerID1, playerID2 int) bool {
-	if playerID1 < 0 || playerID1 >= len(g.Game.Config.Players) ||
-		playerID2 < 0 || playerID2 >= len(g.Game.Config.Players) {
-		return false
-	}
-	return g.Game.Config.Players[playerID1].TeamId == g.Game.Config.Players[playerID2].TeamId
+	team := TeamOf(g.Game, int32(playerID1))
+	return playerID1 != playerID2 && team > 0 && team == TeamOf(g.Game, int32(playerID2))
 }
 
 // =============================================================================
@@ -146,6 +144,9 @@ func (g *Game) TopUpUnitIfNeeded(unit *v1.Unit) error {
 	if unit.LastToppedupTurn >= g.TurnCounter {
 		return nil // Already topped up this turn
 	}
+	if g.awaitingOwnersTurn(unit) {
+		return nil // A teammate is using what is left from the owner's last turn
+	}
 
 	// Get unit definition from rules engine
 	if g.RulesEngine == nil {
@@ -232,8 +233,9 @@ func (g *Game) calculateHealAmount(unit *v1.Unit, unitData *v1.UnitDefinition) i
 	}
 
 	// Rule 2: Cannot heal on enemy-owned bases
-	// If tile is owned by another player (not neutral and not the unit's player)
-	if tile.Player != 0 && tile.Player != unit.Player {
+	// If tile is owned by another player (not neutral and not the unit's player
+	// or an ally providing support)
+	if tile.Player != 0 && !g.canSupport(tile.Player, unit.Player) {
 		return 0 // Enemy base, no healing
 	}
 
@@ -263,31 +265,50 @@ func (g *Game) calculateHealAmount(unit *v1.Unit, unitData *v1.UnitDefinition) i
 // checkVictoryConditions checks if any player has won
 func (g *Game) checkVictoryConditions() (winner int32, hasWinner bool) {
 	// Simple victory condition: last player with units wins
-	playersWithUnits := 0
-	lastPlayerWithUnits := int32(-1)
+	var playersWithUnits []int32
 
 	for playerID := int32(1); playerID <= g.World.PlayerCount(); playerID++ {
 		units := g.World.GetPlayerUnits(int(playerID))
 		if len(units) > 0 {
-			playersWithUnits++
-			lastPlayerWithUnits = playerID
+			playersWithUnits = append(playersWithUnits, playerID)
 		}
 	}
 
-	if playersWithUnits == 1 {
-		return lastPlayerWithUnits, true
+	if len(playersWithUnits) == 1 {
+		return playersWithUnits[0], true
+	}
+
+	// A team wins once only its players are left
+	if g.winningTeam(playersWithUnits) > 0 {
+		return g.teamWinner(playersWithUnits), true
 	}
 
 	return -1, false
 }
 
+// declareWinner marks the game as finished with the given winner (and their
+// team in team games)
+func (g *Game) declareWinner(winner int32) {
+	g.GameState.WinningPlayer = winner
+	g.GameState.WinningTeam = TeamOf(g.Game, winner)
+	g.GameState.Finished = true
+	g.GameState.Status = v1.GameStatus_GAME_STATUS_ENDED
+}
+
 // validateGameState validates the current game state
 func (g *Game) validateGameState() error {
 	if g.World == nil {
 		return fmt.Errorf("game has no world")
 	}
 
-	if g.GameState.CurrentPlayer < 0 || g.CurrentPlayer > g.World.PlayerCount() {
+	// Players without units left still take turns so the configured players
+	// are the bound when there are any
+	playerCount := g.NumPlayers()
+	if playerCount == 0 {
+		playerCount = g.World.PlayerCount()
+	}
+
+	if g.GameState.CurrentPlayer < 0 || g.CurrentPlayer > playerCount {
 		return fmt.Errorf("invalid current player: %d", g.CurrentPlayer)
 	}
 
@@ -295,8 +316,8 @@ func (g *Game) validateGameState() error {
 		return fmt.Errorf("invalid turn counter: %d", g.TurnCounter)
 	}
 
-	if int32(len(g.World.unitsByPlayer)) != g.World.PlayerCount() {
-		return fmt.Errorf("units array length (%d) doesn't match player count (%d)", len(g.World.unitsByPlayer), g.World.PlayerCount())
+	if g.World.PlayerCount() > playerCount {
+		return fmt.Errorf("units array length (%d) doesn't match player count (%d)", g.World.PlayerCount(), playerCount)
 	}
 
 	return nil
@@ -324,13 +345,23 @@ func (g *Game) SetRulesEngine(rulesEngine *RulesEngine) {
 	g.RulesEngine = rulesEngine
 }
 
-// LoadGame restores a game from saved JSON data
+// LoadGame restores a game from saved JSON data (see SaveFile)
 func LoadGame(saveData []byte) (*Game, error) {
-	var game Game
-	if err := json.Unmarshal(saveData, &game); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal game data: %w", err)
+	save, err := ParseSaveFile(saveData)
+	if err != nil {
+		return nil, err
 	}
 
+	game := Game{
+		Game:            save.Game,
+		GameState:       save.State,
+		GameMoveHistory: save.History,
+		// The world is a view over the saved state's world data
+		World: NewWorld(save.Game.Name, save.State.WorldData),
+		Seed:  save.Seed,
+	}
+	save.State.WorldData = game.World.data
+
 	// Restore transient state
 	game.rng = rand.New(rand.NewSource(game.Seed))
 	game.RulesEngine = nil // Will be set by caller
@@ -360,7 +391,12 @@ func (g *Game) SaveGame() ([]byte, error) {
 	g.Game.UpdatedAt = tspb.New(time.Now())
 
 	// Serialize to JSON
-	data, err := json.MarshalIndent(g, "", "  ")
+	data, err := json.MarshalIndent(&SaveFile{
+		Game:    g.Game,
+		State:   g.GameState,
+		History: g.GameMoveHistory,
+		Seed:    g.Seed,
+	}, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize game state: %w", err)
 	}
@@ -466,13 +502,33 @@ func (g *Game) IsUnitExhausted(unit *v1.Unit) bool {
 func (g *Game) GetExhaustedUnits() []*v1.Unit {
 	var exhausted []*v1.Unit
 	for _, unit := range g.World.UnitsByCoord() {
-		if unit.Player == g.CurrentPlayer && g.IsUnitExhausted(unit) {
+		if g.CanControlUnit(unit) && g.IsUnitExhausted(unit) {
 			exhausted = append(exhausted, unit)
 		}
 	}
 	return exhausted
 }
 
+// RenderOptions returns options to render the game the way players see it:
+// with its crossings, unit health bars and the current player's exhausted
+// units dimmed
+func (g *Game) RenderOptions() *RenderOptions {
+	options := DefaultRenderOptions()
+	options.ShowHealthBars = true
+	for coord := range g.World.TilesByCoord() {
+		if crossing := g.World.CrossingAt(coord); crossing != nil {
+			if options.Crossings == nil {
+				options.Crossings = map[string]*v1.Crossing{}
+			}
+			options.Crossings[CoordKeyFromAxial(coord)] = crossing
+		}
+	}
+	for _, unit := range g.GetExhaustedUnits() {
+		options.Exhausted = append(options.Exhausted, UnitGetCoord(unit))
+	}
+	return options
+}
+
 // =============================================================================
 // Controller Methods - High-level game actions
 // =============================================================================
@@ -804,7 +860,7 @@ func (g *Game) GetUnitOptions(unit *v1.Unit) (options []*v1.GameOption, allPaths
 	if unit.AvailableHealth > 0 && captureAllowed && unit.CaptureStartedTurn == 0 {
 		coord := CoordFromInt32(unit.Q, unit.R)
 		tile := g.World.TileAt(coord)
-		if tile != nil && tile.Player != unit.Player {
+		if tile != nil && !AreAllies(g.Game, tile.Player, unit.Player) {
 			terrainProps := g.RulesEngine.GetTerrainUnitPropertiesForUnit(tile.TileType, unit.UnitType)
 			if terrainProps != nil && terrainProps.CanCapture {
 				captureAction := &v1.CaptureBuildingAction{
@@ -825,7 +881,7 @@ func (g *Game) GetUnitOptions(unit *v1.Unit) (options []*v1.GameOption, allPaths
 		tile := g.World.TileAt(coord)
 		if tile != nil {
 			// Can't heal on enemy-owned tiles
-			if tile.Player == 0 || tile.Player == unit.Player {
+			if tile.Player == 0 || g.canSupport(tile.Player, unit.Player) {
 				canHeal := true
 				// Air units can only heal on Airport Base
 				if unitDef.UnitTerrain == "Air" {
@@ -874,11 +930,8 @@ func (g *Game) GetTileOptions(tile *v1.Tile) (options []*v1.GameOption, err erro
 		return nil, nil
 	}
 
-	// Get current player's coins
-	playerCoins := int32(0)
-	if playerState := g.GameState.PlayerStates[g.CurrentPlayer]; playerState != nil {
-		playerCoins = playerState.Coins
-	}
+	// Get current player's coins (the team's pool with shared coins)
+	playerCoins := g.coinPool(g.CurrentPlayer)
 
 	// Get allowed actions for this tile
 	tileActions := g.RulesEngine.GetAllowedActionsForTile(tile, terrainDef, playerCoins)
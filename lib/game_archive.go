@@ -0,0 +1,260 @@
+package lib
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// GameArchiveFormat identifies a game archive's manifest
+const GameArchiveFormat = "lilbattle-game-archive"
+
+// GameArchiveVersion is the current version of the game archive format
+const GameArchiveVersion = 1
+
+// A game archive is a zip file holding everything needed to move a game
+// between servers and check it was not altered on the way:
+//
+//	manifest.json - format, version and the sha256 of every other file
+//	game.json     - the Game
+//	state.json    - its GameState
+//	history.json  - its GameMoveHistory
+//	world.json    - the WorldData the game started from
+//	rules.json    - the rules data it was played with
+//	damage.json   - the damage data it was played with
+//
+// The content hash covers every file's name and hash in this order, so it
+// identifies an archive's contents regardless of how the zip was written.
+const gameArchiveManifest = "manifest.json"
+
+var gameArchiveFiles = []string{"game.json", "state.json", "history.json", "world.json", "rules.json", "damage.json"}
+
+// Limits on what an archive may unpack to, so a small upload cannot expand
+// into more than a server will hold in memory.  A file read past the total
+// fails as too large.
+const (
+	maxArchiveFileSize  = 32 << 20
+	maxArchiveTotalSize = 64 << 20
+)
+
+// GameArchive is the decoded contents of a game archive
+type GameArchive struct {
+	Game    *v1.Game
+	State   *v1.GameState
+	History *v1.GameMoveHistory
+
+	// World is the world data the game started from
+	World *v1.WorldData
+
+	// RulesJSON and DamageJSON are the rules the game was played with
+	RulesJSON  []byte
+	DamageJSON []byte
+
+	ExportedAt time.Time
+}
+
+// gameArchiveManifestJSON is the manifest of an archive
+type gameArchiveManifestJSON struct {
+	Format      string            `json:"format"`
+	Version     int               `json:"version"`
+	GameId      string            `json:"game_id"`
+	ExportedAt  time.Time         `json:"exported_at"`
+	Files       map[string]string `json:"files"`
+	ContentHash string            `json:"content_hash"`
+}
+
+var archiveMarshal = protojson.MarshalOptions{Indent: "  "}
+
+// Marshal writes the archive as a zip file and returns it with its content
+// hash
+func (a *GameArchive) Marshal() (data []byte, contentHash string, err error) {
+	files := map[string][]byte{
+		"rules.json":  a.RulesJSON,
+		"damage.json": a.DamageJSON,
+	}
+	for name, m := range map[string]proto.Message{
+		"game.json":    a.Game,
+		"state.json":   a.State,
+		"history.json": a.History,
+		"world.json":   a.World,
+	} {
+		if files[name], err = archiveMarshal.Marshal(m); err != nil {
+			return nil, "", fmt.Errorf("failed to encode %s: %w", name, err)
+		}
+	}
+
+	manifest := gameArchiveManifestJSON{
+		Format:     GameArchiveFormat,
+		Version:    GameArchiveVersion,
+		GameId:     a.Game.GetId(),
+		ExportedAt: a.ExportedAt.UTC(),
+		Files:      map[string]string{},
+	}
+	for _, name := range gameArchiveFiles {
+		manifest.Files[name] = sha256Hex(files[name])
+	}
+	manifest.ContentHash = archiveContentHash(manifest.Files)
+	files[gameArchiveManifest], err = json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, "", err
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range append([]string{gameArchiveManifest}, gameArchiveFiles...) {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: manifest.ExportedAt})
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := w.Write(files[name]); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), manifest.ContentHash, nil
+}
+
+// ReadGameArchive decodes a game archive, checking every file against the
+// manifest.  It returns the archive with its content hash.
+func ReadGameArchive(data []byte) (*GameArchive, string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, "", fmt.Errorf("not a game archive: %w", err)
+	}
+	files := map[string][]byte{}
+	var total int64
+	for _, f := range zr.File {
+		if _, seen := files[f.Name]; seen {
+			return nil, "", fmt.Errorf("archive has %s more than once", f.Name)
+		}
+		// The size in the zip header can lie so reading is limited too
+		if f.UncompressedSize64 > maxArchiveFileSize {
+			return nil, "", fmt.Errorf("%s is larger than %d bytes", f.Name, maxArchiveFileSize)
+		}
+		if files[f.Name], err = readZipFile(f, min(maxArchiveFileSize, maxArchiveTotalSize-total)); err != nil {
+			return nil, "", fmt.Errorf("failed to read %s: %w", f.Name, err)
+		}
+		total += int64(len(files[f.Name]))
+	}
+
+	var manifest gameArchiveManifestJSON
+	if raw, ok := files[gameArchiveManifest]; !ok {
+		return nil, "", fmt.Errorf("archive has no %s", gameArchiveManifest)
+	} else if err := json.Unmarshal(raw, &manifest); err != nil {
+		return nil, "", fmt.Errorf("failed to decode %s: %w", gameArchiveManifest, err)
+	}
+	if manifest.Format != GameArchiveFormat {
+		return nil, "", fmt.Errorf("not a game archive: format is %q", manifest.Format)
+	}
+	if manifest.Version > GameArchiveVersion {
+		return nil, "", fmt.Errorf("unsupported game archive version %d", manifest.Version)
+	}
+	for _, name := range gameArchiveFiles {
+		content, ok := files[name]
+		if !ok {
+			return nil, "", fmt.Errorf("archive has no %s", name)
+		}
+		if sha256Hex(content) != manifest.Files[name] {
+			return nil, "", fmt.Errorf("%s does not match its hash in the manifest", name)
+		}
+	}
+	if archiveContentHash(manifest.Files) != manifest.ContentHash {
+		return nil, "", fmt.Errorf("archive content hash does not match its manifest")
+	}
+
+	a := &GameArchive{
+		Game:       &v1.Game{},
+		State:      &v1.GameState{},
+		History:    &v1.GameMoveHistory{},
+		World:      &v1.WorldData{},
+		RulesJSON:  files["rules.json"],
+		DamageJSON: files["damage.json"],
+		ExportedAt: manifest.ExportedAt,
+	}
+	for name, m := range map[string]proto.Message{
+		"game.json":    a.Game,
+		"state.json":   a.State,
+		"history.json": a.History,
+		"world.json":   a.World,
+	} {
+		if err := protojson.Unmarshal(files[name], m); err != nil {
+			return nil, "", fmt.Errorf("failed to decode %s: %w", name, err)
+		}
+	}
+	if a.Game.Id == "" {
+		return nil, "", fmt.Errorf("archived game has no ID")
+	}
+	if a.Game.Id != manifest.GameId {
+		return nil, "", fmt.Errorf("archive is for game %s but holds %s", manifest.GameId, a.Game.Id)
+	}
+	return a, manifest.ContentHash, nil
+}
+
+// Verify replays the archived history from the archived world with the
+// archived rules and checks it ends in the archived state.  It returns the
+// number of move groups replayed.
+func (a *GameArchive) Verify() (int64, error) {
+	if version := a.Game.GetRulesVersion(); version != "" && version != RulesVersion(a.RulesJSON, a.DamageJSON) {
+		return 0, fmt.Errorf("archived rules are not rules version %s the game was played with", version)
+	}
+	rulesEngine, err := LoadRulesEngineFromJSON(a.RulesJSON, a.DamageJSON)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load archived rules: %w", err)
+	}
+	replayed, err := ReplayGame(a.Game, a.World, a.History, rulesEngine)
+	if err != nil {
+		return 0, fmt.Errorf("replay failed: %w", err)
+	}
+	if err := CompareReplayedState(replayed, a.State); err != nil {
+		return 0, err
+	}
+	return int64(len(a.History.GetGroups())), nil
+}
+
+// RulesMatch reports whether the archive was played with the given rules
+// version
+func (a *GameArchive) RulesMatch(rulesVersion string) bool {
+	return RulesVersion(a.RulesJSON, a.DamageJSON) == rulesVersion
+}
+
+// readZipFile reads a file of at most limit bytes from a zip
+func readZipFile(f *zip.File, limit int64) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("file is larger than %d bytes", limit)
+	}
+	return data, nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// archiveContentHash hashes the archive's file names and hashes in order
+func archiveContentHash(files map[string]string) string {
+	h := sha256.New()
+	for _, name := range gameArchiveFiles {
+		fmt.Fprintf(h, "%s %s\n", name, files[name])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
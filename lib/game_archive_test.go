@@ -0,0 +1,189 @@
+package lib
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// playArchiveGroup plays a group the way GamesService.ProcessMoves does
+func playArchiveGroup(t *testing.T, game *v1.Game, state *v1.GameState, history *v1.GameMoveHistory, moves ...*v1.GameMove) {
+	t.Helper()
+	rtGame := ProtoToRuntimeGame(game, state)
+	rtGame.World = rtGame.World.Push()
+	if err := rtGame.ProcessMoves(moves); err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+	if err := rtGame.ApplyChanges(moves); err != nil {
+		t.Fatalf("ApplyChanges failed: %v", err)
+	}
+	state.WorldData = rtGame.World.WorldData()
+	state.CurrentGroupNumber++
+	history.Groups = append(history.Groups, &v1.GameMoveGroup{GroupNumber: state.CurrentGroupNumber, Moves: moves})
+}
+
+// newTestGameArchive plays a short game with an attack (so replay has to
+// reproduce the combat rolls) and archives it
+func newTestGameArchive(t *testing.T) *GameArchive {
+	t.Helper()
+	built := newTestGameBuilder().
+		grassTiles(2).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 2, testUnitTypeSoldier).
+		build()
+	game, world := built.Game, built.World.WorldData()
+
+	state := NewInitialGameState(game, world)
+	history := &v1.GameMoveHistory{GameId: game.Id}
+	endTurn := func(player int32) *v1.GameMove {
+		return &v1.GameMove{Player: player, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	}
+	playArchiveGroup(t, game, state, history, &v1.GameMove{
+		Player: 1,
+		MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{
+			Attacker: &v1.Position{Q: 0, R: 0},
+			Defender: &v1.Position{Q: 1, R: 0},
+		}},
+	})
+	playArchiveGroup(t, game, state, history, endTurn(1))
+	playArchiveGroup(t, game, state, history, endTurn(2))
+
+	rulesJSON, damageJSON := DefaultRulesJSON()
+	return &GameArchive{
+		Game:       game,
+		State:      state,
+		History:    history,
+		World:      world,
+		RulesJSON:  rulesJSON,
+		DamageJSON: damageJSON,
+		ExportedAt: time.Now(),
+	}
+}
+
+func TestGameArchive_RoundTrip(t *testing.T) {
+	archive := newTestGameArchive(t)
+	data, hash, err := archive.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	read, readHash, err := ReadGameArchive(data)
+	if err != nil {
+		t.Fatalf("ReadGameArchive failed: %v", err)
+	}
+	if readHash != hash {
+		t.Errorf("Expected content hash %s, got %s", hash, readHash)
+	}
+	if !proto.Equal(read.State, archive.State) || !proto.Equal(read.History, archive.History) {
+		t.Error("Archived state or history did not round trip")
+	}
+	groups, err := read.Verify()
+	if err != nil {
+		t.Fatalf("Verify failed: %v", err)
+	}
+	if groups != 3 {
+		t.Errorf("Expected 3 replayed groups, got %d", groups)
+	}
+	if !read.RulesMatch(DefaultRulesVersion()) {
+		t.Error("Expected the archive to match the default rules")
+	}
+}
+
+// rewriteArchiveFile replaces one file in an archive, leaving the manifest
+// as it was
+func rewriteArchiveFile(t *testing.T, data []byte, name string, edit func([]byte) []byte) []byte {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Failed to open archive: %v", err)
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range zr.File {
+		content, err := readZipFile(f, maxArchiveFileSize)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", f.Name, err)
+		}
+		if f.Name == name {
+			content = edit(content)
+		}
+		w, _ := zw.Create(f.Name)
+		w.Write(content)
+	}
+	zw.Close()
+	return buf.Bytes()
+}
+
+func TestGameArchive_DetectsEditedFile(t *testing.T) {
+	data, _, err := newTestGameArchive(t).Marshal()
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	edited := rewriteArchiveFile(t, data, "state.json", func(b []byte) []byte {
+		return bytes.Replace(b, []byte("turnCounter"), []byte("currentPlayer"), 1)
+	})
+	if _, _, err := ReadGameArchive(edited); err == nil || !strings.Contains(err.Error(), "state.json") {
+		t.Errorf("Expected state.json to fail its hash check, got %v", err)
+	}
+}
+
+// An archive rebuilt with a consistent manifest still has to replay
+func TestGameArchive_VerifyDetectsEditedHistory(t *testing.T) {
+	archive := newTestGameArchive(t)
+	edited := false
+	for _, change := range archive.History.Groups[0].Moves[0].Changes {
+		if damaged := change.GetUnitDamaged(); damaged != nil {
+			damaged.UpdatedUnit.AvailableHealth++
+			edited = true
+		}
+	}
+	if !edited {
+		t.Fatal("Expected the attack to damage a unit")
+	}
+	data, _, err := archive.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	read, _, err := ReadGameArchive(data)
+	if err != nil {
+		t.Fatalf("ReadGameArchive failed: %v", err)
+	}
+	if _, err := read.Verify(); err == nil {
+		t.Error("Expected replay to reject the edited combat result")
+	}
+}
+
+// A game records the rules it is played with - other rules cannot verify it
+func TestGameArchive_VerifyChecksRulesVersion(t *testing.T) {
+	archive := newTestGameArchive(t)
+	archive.Game.RulesVersion = DefaultRulesVersion()
+	if _, err := archive.Verify(); err != nil {
+		t.Fatalf("Verify failed: %v", err)
+	}
+	archive.Game.RulesVersion = "0123456789abcdef"
+	if _, err := archive.Verify(); err == nil || !strings.Contains(err.Error(), "rules version") {
+		t.Errorf("Expected rules of another version rejected, got %v", err)
+	}
+}
+
+func TestReadGameArchive_TooLarge(t *testing.T) {
+	data, _, err := newTestGameArchive(t).Marshal()
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	// Zeros compress to almost nothing
+	padded := rewriteArchiveFile(t, data, "world.json", func(b []byte) []byte {
+		return append(b, make([]byte, maxArchiveFileSize)...)
+	})
+	if len(padded) > 1<<20 {
+		t.Fatalf("Expected a small archive, got %d bytes", len(padded))
+	}
+	if _, _, err := ReadGameArchive(padded); err == nil || !strings.Contains(err.Error(), "larger than") {
+		t.Errorf("Expected the oversized file rejected, got %v", err)
+	}
+}
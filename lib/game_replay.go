@@ -0,0 +1,142 @@
+package lib
+
+import (
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// InitializePlayerStates sets up the PlayerStates of a new game from its
+// config - each player starts with their starting coins plus the base
+// income of the tiles they own
+func InitializePlayerStates(gameState *v1.GameState, config *v1.GameConfiguration) {
+	if config == nil {
+		return
+	}
+
+	if gameState.PlayerStates == nil {
+		gameState.PlayerStates = make(map[int32]*v1.PlayerState)
+	}
+
+	for _, player := range config.Players {
+		baseIncome := CalculatePlayerBaseIncome(player.PlayerId, gameState.WorldData, config.IncomeConfigs)
+		gameState.PlayerStates[player.PlayerId] = &v1.PlayerState{
+			Coins:    player.StartingCoins + baseIncome,
+			IsActive: true,
+		}
+	}
+}
+
+// NewInitialGameState returns the state a game starts in on the given
+// world, the way CreateGame sets it up.  The world data is copied.
+func NewInitialGameState(game *v1.Game, worldData *v1.WorldData) *v1.GameState {
+	state := &v1.GameState{
+		GameId:        game.Id,
+		CurrentPlayer: 1,
+		TurnCounter:   1,
+		WorldData:     proto.Clone(worldData).(*v1.WorldData),
+	}
+	MigrateWorldData(state.WorldData)
+	EnsureShortcuts(state.WorldData)
+	InitializePlayerStates(state, game.Config)
+	return state
+}
+
+// ReplayGame plays a game's move history from its starting world and
+// returns the state it ends in.  Each group is processed the way
+// GamesService.ProcessMoves processed it - on a fresh runtime game with the
+// default seed - and the changes it produces must match the recorded ones,
+// so a history that was edited or played with different rules fails.
+//
+// Simultaneous turns are resolved from every player's orders at once, which
+// the history does not keep, so their recorded changes are applied as they
+// are.  That still checks they apply cleanly and lead to the final state.
+func ReplayGame(game *v1.Game, worldData *v1.WorldData, history *v1.GameMoveHistory, rulesEngine *RulesEngine) (*v1.GameState, error) {
+	state := NewInitialGameState(game, worldData)
+	simultaneous := IsSimultaneous(game)
+	for _, group := range history.GetGroups() {
+		if group.GroupNumber <= state.CurrentGroupNumber {
+			return nil, fmt.Errorf("move group %d is out of order", group.GroupNumber)
+		}
+		rtGame := ProtoToRuntimeGameWithRules(game, state, rulesEngine)
+		rtGame.World = rtGame.World.Push()
+
+		moves := group.Moves
+		if !simultaneous {
+			replayed, err := replayMoves(rtGame, group)
+			if err != nil {
+				return nil, err
+			}
+			moves = replayed
+		}
+
+		if err := rtGame.ApplyChanges(moves); err != nil {
+			return nil, fmt.Errorf("move group %d: %w", group.GroupNumber, err)
+		}
+		state.WorldData = rtGame.World.WorldData()
+		state.CurrentGroupNumber = group.GroupNumber
+	}
+	return state, nil
+}
+
+// replayMoves processes copies of a group's moves and checks they produce
+// the recorded changes
+func replayMoves(rtGame *Game, group *v1.GameMoveGroup) ([]*v1.GameMove, error) {
+	moves := make([]*v1.GameMove, len(group.Moves))
+	for i, move := range group.Moves {
+		moves[i] = proto.Clone(move).(*v1.GameMove)
+		moves[i].Changes = nil
+	}
+	if err := rtGame.ProcessMoves(moves); err != nil {
+		return nil, fmt.Errorf("move group %d: %w", group.GroupNumber, err)
+	}
+	for i, move := range moves {
+		recorded := group.Moves[i].Changes
+		if len(move.Changes) != len(recorded) {
+			return nil, fmt.Errorf("move group %d move %d: replay produced %d changes, the history has %d",
+				group.GroupNumber, i, len(move.Changes), len(recorded))
+		}
+		for j, change := range move.Changes {
+			if !proto.Equal(change, recorded[j]) {
+				return nil, fmt.Errorf("move group %d move %d: change %d does not match the history",
+					group.GroupNumber, i, j)
+			}
+		}
+	}
+	return moves, nil
+}
+
+// CompareReplayedState checks a replayed state against the one a game was
+// saved with.  Bookkeeping that replay does not reproduce - timestamps,
+// versions, hashes, screenshot index info and pending simultaneous orders -
+// is ignored.
+func CompareReplayedState(replayed, saved *v1.GameState) error {
+	normalize := func(state *v1.GameState) *v1.GameState {
+		state = proto.Clone(state).(*v1.GameState)
+		state.UpdatedAt = nil
+		state.GameId = ""
+		state.Version = 0
+		state.StateHash = ""
+		state.PendingOrders = nil
+		if state.WorldData != nil {
+			state.WorldData.ScreenshotIndexInfo = nil
+			state.WorldData.ContentHash = ""
+			state.WorldData.Version = 0
+		}
+		return state
+	}
+	a, b := normalize(replayed), normalize(saved)
+	switch {
+	case a.CurrentGroupNumber != b.CurrentGroupNumber:
+		return fmt.Errorf("replay ended at move group %d, the game is at %d", a.CurrentGroupNumber, b.CurrentGroupNumber)
+	case a.TurnCounter != b.TurnCounter || a.CurrentPlayer != b.CurrentPlayer:
+		return fmt.Errorf("replay ended on turn %d player %d, the game is on turn %d player %d",
+			a.TurnCounter, a.CurrentPlayer, b.TurnCounter, b.CurrentPlayer)
+	case !proto.Equal(a.WorldData, b.WorldData):
+		return fmt.Errorf("replayed world does not match the game's")
+	case !proto.Equal(a, b):
+		return fmt.Errorf("replayed game state does not match the game's")
+	}
+	return nil
+}
@@ -400,6 +400,52 @@ type RenderOptions struct {
 	ShowUnitLabels      bool // Show unit labels (Shortcut:MP/Health) below units
 	ShowTileLabels      bool // Show tile labels (Shortcut) below tile
 	EvenRowOffsetCoords bool
+
+	// Overlay is drawn over the top-left corner of the map when set
+	Overlay *RenderOverlay
+
+	// Paths are drawn over the units, eg the moves of a replay frame
+	Paths []RenderPath
+
+	// Flashes highlight hexes over the units, eg where an attack landed
+	Flashes []AxialCoord
+
+	// Heatmap shades hexes between the tiles and units, eg the tactical
+	// overlays in tactical_overlays.go
+	Heatmap []HeatmapCell
+
+	// Crossings are roads and bridges drawn between the tiles and units,
+	// eg WorldData.Crossings
+	Crossings map[string]*v1.Crossing
+
+	// ShowHealthBars draws a bar of each unit's health over it
+	ShowHealthBars bool
+
+	// Exhausted units are dimmed, eg the current player's units with no
+	// movement left
+	Exhausted []AxialCoord
+}
+
+// HeatmapCell shades a hex in a player's colour, or red for player 0 (eg
+// threats), with an opacity from its weight between 0 and 1
+type HeatmapCell struct {
+	Coord  AxialCoord
+	Player int32
+	Weight float64
+}
+
+// RenderPath is a line through the centres of hexes in a player's colour
+type RenderPath struct {
+	Player int32
+	Hexes  []AxialCoord
+}
+
+// RenderOverlay is a caption followed by a swatch per player, eg a game's
+// turn and players in screenshots
+type RenderOverlay struct {
+	Caption       string  // eg "Turn 12"
+	Players       []int32 // Players drawn as swatches in their theme colour
+	CurrentPlayer int32   // Player whose swatch is outlined, 0 for none
 }
 
 // DefaultRenderOptions returns standard rendering options
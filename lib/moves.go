@@ -171,19 +171,20 @@ func (g *Game) ProcessBuildUnit(move *v1.GameMove, action *v1.BuildUnitAction) (
 		return fmt.Errorf("unit data not found for unit type %d", action.UnitType)
 	}
 
-	// Check if player has enough coins (from GameState.PlayerStates)
+	// Check if player has enough coins (from GameState.PlayerStates) - with
+	// shared coins the whole team's coins can be used
 	playerState := g.GameState.PlayerStates[g.CurrentPlayer]
 	if playerState == nil {
 		return fmt.Errorf("player state not found for player %d", g.CurrentPlayer)
 	}
-	playerCoins := playerState.Coins
+	playerCoins := g.coinPool(g.CurrentPlayer)
 
 	if playerCoins < unitData.Coins {
 		return fmt.Errorf("insufficient coins: need %d, have %d", unitData.Coins, playerCoins)
 	}
 
 	// Deduct coins from player (in GameState.PlayerStates)
-	playerState.Coins -= unitData.Coins
+	coinsChanges := g.spendCoins(g.CurrentPlayer, unitData.Coins, "build")
 
 	// Generate a shortcut for the new unit
 	newShortcut := g.World.GenerateUnitShortcut(g.CurrentPlayer)
@@ -219,24 +220,14 @@ func (g *Game) ProcessBuildUnit(move *v1.GameMove, action *v1.BuildUnitAction) (
 				TileQ:       tile.Q,
 				TileR:       tile.R,
 				CoinsCost:   unitData.Coins,
-				PlayerCoins: playerCoins - unitData.Coins,
+				PlayerCoins: playerState.Coins,
 			},
 		},
 	}
 	move.Changes = append(move.Changes, buildChange)
 
-	// Record the coin deduction
-	coinsChange := &v1.WorldChange{
-		ChangeType: &v1.WorldChange_CoinsChanged{
-			CoinsChanged: &v1.CoinsChangedChange{
-				PlayerId:      g.CurrentPlayer,
-				PreviousCoins: playerCoins,
-				NewCoins:      playerCoins - unitData.Coins,
-				Reason:        "build",
-			},
-		},
-	}
-	move.Changes = append(move.Changes, coinsChange)
+	// Record the coin deduction (one change per player charged)
+	move.Changes = append(move.Changes, coinsChanges...)
 
 	return nil
 }
@@ -257,7 +248,7 @@ func (g *Game) ProcessCaptureBuilding(move *v1.GameMove, action *v1.CaptureBuild
 	}
 
 	// Check if it's the correct player's turn
-	if unit.Player != g.CurrentPlayer {
+	if !g.CanControlUnit(unit) {
 		return fmt.Errorf("unit does not belong to current player %d", g.CurrentPlayer)
 	}
 
@@ -272,9 +263,12 @@ func (g *Game) ProcessCaptureBuilding(move *v1.GameMove, action *v1.CaptureBuild
 		return fmt.Errorf("no tile at position %v", coord)
 	}
 
-	// Check if tile is already owned by the capturing player
-	if tile.Player == g.CurrentPlayer {
-		return fmt.Errorf("tile at %v is already owned by player %d", coord, g.CurrentPlayer)
+	// Check if tile is already owned by the capturing player (or an ally)
+	if tile.Player == unit.Player {
+		return fmt.Errorf("tile at %v is already owned by player %d", coord, unit.Player)
+	}
+	if AreAllies(g.Game, tile.Player, unit.Player) {
+		return fmt.Errorf("tile at %v is owned by allied player %d", coord, tile.Player)
 	}
 
 	// Check if this unit type can capture
@@ -364,7 +358,7 @@ func (g *Game) ProcessHealUnit(move *v1.GameMove, action *v1.HealUnitAction) (er
 	}
 
 	// Verify unit belongs to current player
-	if unit.Player != g.CurrentPlayer {
+	if !g.CanControlUnit(unit) {
 		return fmt.Errorf("unit belongs to player %d, not current player %d", unit.Player, g.CurrentPlayer)
 	}
 
@@ -446,7 +440,7 @@ func (g *Game) ProcessFixUnit(move *v1.GameMove, action *v1.FixUnitAction) (err
 	}
 
 	// Verify fixer belongs to current player
-	if fixer.Player != g.CurrentPlayer {
+	if !g.CanControlUnit(fixer) {
 		return fmt.Errorf("fixer unit belongs to player %d, not current player %d", fixer.Player, g.CurrentPlayer)
 	}
 
@@ -461,8 +455,8 @@ func (g *Game) ProcessFixUnit(move *v1.GameMove, action *v1.FixUnitAction) (err
 		return fmt.Errorf("no unit at target position %v", targetCoord)
 	}
 
-	// Verify target is a friendly unit (same player)
-	if target.Player != fixer.Player {
+	// Verify target is a friendly unit (same player or a supported ally)
+	if !g.canSupport(fixer.Player, target.Player) {
 		return fmt.Errorf("can only fix friendly units")
 	}
 
@@ -484,7 +478,7 @@ func (g *Game) ProcessFixUnit(move *v1.GameMove, action *v1.FixUnitAction) (err
 	}
 
 	// Verify fixer can fix this target type (terrain compatibility)
-	canFix, err := g.RulesEngine.CanUnitFixTarget(fixer, target)
+	canFix, err := g.RulesEngine.canFixUnitTerrain(fixer, fixerData, target)
 	if err != nil {
 		return fmt.Errorf("failed to check fix compatibility: %w", err)
 	}
@@ -613,53 +607,12 @@ func (g *Game) ProcessEndTurn(move *v1.GameMove, action *v1.EndTurnAction) (err
 	previousPlayer := g.CurrentPlayer
 	previousTurn := g.TurnCounter
 
-	// Calculate income for ending player based on bases owned and their types
-	// Use IncomeConfig from game configuration if available
-	var incomeConfig *v1.IncomeConfig
-	if g.Config != nil {
-		incomeConfig = g.Config.IncomeConfigs
-	}
-
-	totalIncome := int32(0)
-	for _, tile := range g.World.TilesByCoord() {
-		if tile.Player == previousPlayer {
-			// Get income for this tile type from IncomeConfig (falls back to defaults)
-			tileIncome := GetTileIncomeFromConfig(tile.TileType, incomeConfig)
-			totalIncome += tileIncome
-		}
-	}
-
-	// Add base game income (income just for being in the game)
-	if incomeConfig != nil && incomeConfig.GameIncome > 0 {
-		totalIncome += incomeConfig.GameIncome
-	}
-
-	// Get player's current coins (from GameState.PlayerStates)
-	playerState := g.GameState.PlayerStates[previousPlayer]
-	if playerState == nil {
-		return fmt.Errorf("player state not found for player %d", previousPlayer)
+	// Calculate and credit income for the ending player
+	coinsChange, err := g.collectIncome(previousPlayer)
+	if err != nil {
+		return err
 	}
-	playerCoins := playerState.Coins
-
-	// Calculate and add income
-	income := totalIncome
-	newCoins := playerCoins + income
-
-	// Update player's coins (in GameState.PlayerStates)
-	playerState.Coins = newCoins
-
-	// Record the income change
-	if income > 0 {
-		coinsChange := &v1.WorldChange{
-			ChangeType: &v1.WorldChange_CoinsChanged{
-				CoinsChanged: &v1.CoinsChangedChange{
-					PlayerId:      previousPlayer,
-					PreviousCoins: playerCoins,
-					NewCoins:      newCoins,
-					Reason:        "income",
-				},
-			},
-		}
+	if coinsChange != nil {
 		move.Changes = append(move.Changes, coinsChange)
 	}
 
@@ -696,9 +649,7 @@ func (g *Game) ProcessEndTurn(move *v1.GameMove, action *v1.EndTurnAction) (err
 
 	// Check for victory conditions
 	if winner, hasWinner := g.checkVictoryConditions(); hasWinner {
-		g.GameState.WinningPlayer = winner
-		g.GameState.Finished = true
-		g.GameState.Status = v1.GameStatus_GAME_STATUS_ENDED
+		g.declareWinner(winner)
 
 		// Update GameLog status when game ends
 		// TODO - g.SetGameLogStatus("completed")
@@ -723,6 +674,57 @@ func (g *Game) ProcessEndTurn(move *v1.GameMove, action *v1.EndTurnAction) (err
 	return
 }
 
+// collectIncome credits a player with income for the bases they own and
+// returns the resulting CoinsChanged change (nil if there was no income).
+func (g *Game) collectIncome(playerId int32) (*v1.WorldChange, error) {
+	// Calculate income for the player based on bases owned and their types
+	// Use IncomeConfig from game configuration if available
+	var incomeConfig *v1.IncomeConfig
+	if g.Config != nil {
+		incomeConfig = g.Config.IncomeConfigs
+	}
+
+	totalIncome := int32(0)
+	for _, tile := range g.World.TilesByCoord() {
+		if tile.Player == playerId {
+			// Get income for this tile type from IncomeConfig (falls back to defaults)
+			tileIncome := GetTileIncomeFromConfig(tile.TileType, incomeConfig)
+			totalIncome += tileIncome
+		}
+	}
+
+	// Add base game income (income just for being in the game)
+	if incomeConfig != nil && incomeConfig.GameIncome > 0 {
+		totalIncome += incomeConfig.GameIncome
+	}
+
+	// Get player's current coins (from GameState.PlayerStates)
+	playerState := g.GameState.PlayerStates[playerId]
+	if playerState == nil {
+		return nil, fmt.Errorf("player state not found for player %d", playerId)
+	}
+	playerCoins := playerState.Coins
+	if totalIncome <= 0 {
+		return nil, nil
+	}
+
+	// Update player's coins (in GameState.PlayerStates)
+	newCoins := playerCoins + totalIncome
+	playerState.Coins = newCoins
+
+	// Record the income change
+	return &v1.WorldChange{
+		ChangeType: &v1.WorldChange_CoinsChanged{
+			CoinsChanged: &v1.CoinsChangedChange{
+				PlayerId:      playerId,
+				PreviousCoins: playerCoins,
+				NewCoins:      newCoins,
+				Reason:        "income",
+			},
+		},
+	}, nil
+}
+
 // ProcessMoveUnit executes unit movement using cube coordinates
 func (g *Game) ProcessMoveUnit(move *v1.GameMove, action *v1.MoveUnitAction, preventPassThrough bool) (err error) {
 	// Initialize the result object
@@ -747,7 +749,7 @@ func (g *Game) ProcessMoveUnit(move *v1.GameMove, action *v1.MoveUnitAction, pre
 	}
 
 	// Check if it's the correct player's turn
-	if unit.Player != g.CurrentPlayer {
+	if !g.CanControlUnit(unit) {
 		return fmt.Errorf("not player %d's turn", unit.Player)
 	}
 
@@ -835,7 +837,7 @@ func (g *Game) ProcessAttackUnit(move *v1.GameMove, action *v1.AttackUnitAction)
 	}
 
 	// Check if it's the correct player's turn
-	if attacker.Player != g.CurrentPlayer {
+	if !g.CanControlUnit(attacker) {
 		return fmt.Errorf("not player %d's turn", attacker.Player)
 	}
 
@@ -1104,7 +1106,7 @@ func (g *Game) CanMoveUnit(unit *v1.Unit, to AxialCoord, preventPassThrough bool
 	}
 
 	// Check if it's the correct player's turn
-	if unit.Player != g.CurrentPlayer {
+	if !g.CanControlUnit(unit) {
 		return false
 	}
 
@@ -1133,12 +1135,12 @@ func (g *Game) CanAttackUnit(attacker, defender *v1.Unit) bool {
 	}
 
 	// Check if it's the correct player's turn
-	if attacker.Player != g.CurrentPlayer {
+	if !g.CanControlUnit(attacker) {
 		return false
 	}
 
-	// Check if units are enemies
-	if attacker.Player == defender.Player {
+	// Check if units are enemies (allies never attack each other)
+	if AreAllies(g.Game, attacker.Player, defender.Player) {
 		return false
 	}
 
@@ -1171,7 +1173,7 @@ func (g *Game) GetMovementOptions(q, r int32, preventPassThrough bool) (*v1.AllP
 	if unit == nil {
 		return nil, fmt.Errorf("no unit found at position (%d, %d)", q, r)
 	}
-	if unit.Player != g.CurrentPlayer {
+	if !g.CanControlUnit(unit) {
 		return nil, fmt.Errorf("unit belongs to player %d, but it's player %d's turn", unit.Player, g.CurrentPlayer)
 	}
 	if unit.AvailableHealth <= 0 {
@@ -1189,13 +1191,17 @@ func (g *Game) GetAttackOptions(q, r int32) ([]AxialCoord, error) {
 	if unit == nil {
 		return nil, fmt.Errorf("no unit found at position (%d, %d)", q, r)
 	}
-	if unit.Player != g.CurrentPlayer {
+	if !g.CanControlUnit(unit) {
 		return nil, fmt.Errorf("unit belongs to player %d, but it's player %d's turn", unit.Player, g.CurrentPlayer)
 	}
 	if unit.AvailableHealth <= 0 {
 		return nil, fmt.Errorf("unit has no health remaining")
 	}
-	return g.RulesEngine.GetAttackOptions(g.World, unit)
+	coords, err := g.RulesEngine.GetAttackOptions(g.World, unit)
+	if err != nil {
+		return nil, err
+	}
+	return g.withoutAllies(unit, coords), nil
 }
 
 // CanSelectUnit validates if unit at given coordinates can be selected by current player
@@ -1204,7 +1210,7 @@ func (g *Game) CanSelectUnit(q, r int32) (bool, string) {
 	if unit == nil {
 		return false, fmt.Sprintf("no unit found at position (%d, %d)", q, r)
 	}
-	if unit.Player != g.CurrentPlayer {
+	if !g.CanControlUnit(unit) {
 		return false, fmt.Sprintf("unit belongs to player %d, but it's player %d's turn", unit.Player, g.CurrentPlayer)
 	}
 	if unit.AvailableHealth <= 0 {
@@ -1224,5 +1230,9 @@ func (g *Game) GetUnitAttackOptionsFrom(q, r int) ([]AxialCoord, error) {
 	return g.GetUnitAttackOptions(g.World.UnitAt(AxialCoord{q, r}))
 }
 func (g *Game) GetUnitAttackOptions(unit *v1.Unit) ([]AxialCoord, error) {
-	return g.RulesEngine.GetAttackOptions(g.World, unit)
+	coords, err := g.RulesEngine.GetAttackOptions(g.World, unit)
+	if err != nil {
+		return nil, err
+	}
+	return g.withoutAllies(unit, coords), nil
 }
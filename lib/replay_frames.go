@@ -0,0 +1,241 @@
+package lib
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReplayFrame is the world after one move of a game's history, with the
+// paths units took and the hexes that were hit during the move
+type ReplayFrame struct {
+	Tiles   map[string]*v1.Tile
+	Units   map[string]*v1.Unit
+	Turn    int32
+	Player  int32        // Player to move (or who just moved)
+	Move    *v1.GameMove // nil for the frame before the first move
+	Paths   []RenderPath
+	Flashes []AxialCoord
+}
+
+// ReplayFrames walks a game's move history and returns a frame for the
+// world before the first move followed by one per move.  The starting world
+// is found by undoing the recorded changes from the final state, so no
+// world revision or rules are needed, and moves are not validated - use
+// ReplayGame for that.
+func ReplayFrames(state *v1.GameState, history *v1.GameMoveHistory) []*ReplayFrame {
+	var moves []*v1.GameMove
+	for _, group := range history.GetGroups() {
+		moves = append(moves, group.Moves...)
+	}
+
+	tiles := cloneTiles(state.GetWorldData().GetTilesMap())
+	units := cloneUnits(state.GetWorldData().GetUnitsMap())
+	turn, player := state.GetTurnCounter(), state.GetCurrentPlayer()
+	beforeResets := unitsBeforeResets(moves)
+	for i := len(moves) - 1; i >= 0; i-- {
+		changes := moves[i].Changes
+		for j := len(changes) - 1; j >= 0; j-- {
+			if pc := changes[j].GetPlayerChanged(); pc != nil {
+				turn, player = pc.PreviousTurn, pc.PreviousPlayer
+				for _, unit := range beforeResets[pc] {
+					putUnit(units, unit)
+				}
+			}
+			undoWorldChange(tiles, units, changes[j])
+		}
+	}
+
+	frames := []*ReplayFrame{{Tiles: cloneTiles(tiles), Units: cloneUnits(units), Turn: turn, Player: player}}
+	for _, move := range moves {
+		frame := &ReplayFrame{Move: move, Turn: turn, Player: move.Player}
+		if path := movePath(move); path != nil {
+			frame.Paths = append(frame.Paths, *path)
+		}
+		if attack := move.GetAttackUnit(); attack != nil && attack.Defender != nil {
+			frame.Flashes = append(frame.Flashes, AxialCoord{Q: int(attack.Defender.Q), R: int(attack.Defender.R)})
+		}
+		for _, change := range move.Changes {
+			if pc := change.GetPlayerChanged(); pc != nil {
+				turn, player = pc.NewTurn, pc.NewPlayer
+				frame.Turn, frame.Player = turn, player
+			}
+			frame.Flashes = appendFlash(frame.Flashes, change)
+			applyWorldChange(tiles, units, change)
+		}
+		frame.Tiles, frame.Units = cloneTiles(tiles), cloneUnits(units)
+		frames = append(frames, frame)
+	}
+	return frames
+}
+
+// unitsBeforeResets returns the units each turn change in moves reset as
+// they were before it, ie as last recorded by an earlier change.  A unit
+// nothing recorded before its reset (untouched since the game started) is
+// left out and stays as reset when the change is undone.
+func unitsBeforeResets(moves []*v1.GameMove) map[*v1.PlayerChangedChange][]*v1.Unit {
+	recorded := map[string]*v1.Unit{}
+	before := map[*v1.PlayerChangedChange][]*v1.Unit{}
+	for _, move := range moves {
+		for _, change := range move.Changes {
+			if pc := change.GetPlayerChanged(); pc != nil {
+				for _, unit := range pc.ResetUnits {
+					if previous := recorded[CoordKey(unit.Q, unit.R)]; previous != nil {
+						before[pc] = append(before[pc], previous)
+					}
+				}
+			}
+			applyWorldChange(nil, recorded, change)
+		}
+	}
+	return before
+}
+
+// SampleReplayFrames returns at most limit of frames spread evenly through
+// them, always keeping the first and last.  A limit of 0 keeps them all.
+func SampleReplayFrames(frames []*ReplayFrame, limit int) []*ReplayFrame {
+	if limit <= 0 || len(frames) <= limit {
+		return frames
+	}
+	if limit == 1 {
+		return frames[len(frames)-1:]
+	}
+	sampled := make([]*ReplayFrame, 0, limit)
+	for i := range limit {
+		sampled = append(sampled, frames[i*(len(frames)-1)/(limit-1)])
+	}
+	return sampled
+}
+
+// movePath returns the hexes a move unit action went through, from its
+// reconstructed path or else straight from its start to its end
+func movePath(move *v1.GameMove) *RenderPath {
+	action := move.GetMoveUnit()
+	if action == nil {
+		return nil
+	}
+	path := &RenderPath{Player: move.Player}
+	if edges := action.GetReconstructedPath().GetEdges(); len(edges) > 0 {
+		path.Hexes = append(path.Hexes, AxialCoord{Q: int(edges[0].FromQ), R: int(edges[0].FromR)})
+		for _, edge := range edges {
+			path.Hexes = append(path.Hexes, AxialCoord{Q: int(edge.ToQ), R: int(edge.ToR)})
+		}
+		return path
+	}
+	if action.From == nil || action.To == nil {
+		return nil
+	}
+	path.Hexes = []AxialCoord{{Q: int(action.From.Q), R: int(action.From.R)}, {Q: int(action.To.Q), R: int(action.To.R)}}
+	return path
+}
+
+// appendFlash adds the hex a unit was damaged or killed on
+func appendFlash(flashes []AxialCoord, change *v1.WorldChange) []AxialCoord {
+	var unit *v1.Unit
+	if damaged := change.GetUnitDamaged(); damaged != nil {
+		unit = damaged.PreviousUnit
+	} else if killed := change.GetUnitKilled(); killed != nil {
+		unit = killed.PreviousUnit
+	}
+	if unit == nil {
+		return flashes
+	}
+	coord := AxialCoord{Q: int(unit.Q), R: int(unit.R)}
+	for _, flash := range flashes {
+		if flash == coord {
+			return flashes
+		}
+	}
+	return append(flashes, coord)
+}
+
+// applyWorldChange applies a recorded change to tile and unit maps.  Only
+// what is drawn is kept up to date - coins and the like are ignored.
+func applyWorldChange(tiles map[string]*v1.Tile, units map[string]*v1.Unit, change *v1.WorldChange) {
+	switch c := change.ChangeType.(type) {
+	case *v1.WorldChange_UnitMoved:
+		removeUnit(units, c.UnitMoved.PreviousUnit)
+		putUnit(units, c.UnitMoved.UpdatedUnit)
+	case *v1.WorldChange_UnitDamaged:
+		putUnit(units, c.UnitDamaged.UpdatedUnit)
+	case *v1.WorldChange_UnitHealed:
+		putUnit(units, c.UnitHealed.UpdatedUnit)
+	case *v1.WorldChange_UnitFixed:
+		putUnit(units, c.UnitFixed.UpdatedTarget)
+	case *v1.WorldChange_UnitKilled:
+		removeUnit(units, c.UnitKilled.PreviousUnit)
+	case *v1.WorldChange_UnitBuilt:
+		putUnit(units, c.UnitBuilt.Unit)
+	case *v1.WorldChange_CaptureStarted:
+		putUnit(units, c.CaptureStarted.CapturingUnit)
+	case *v1.WorldChange_TileCaptured:
+		setTileOwner(tiles, c.TileCaptured.TileQ, c.TileCaptured.TileR, c.TileCaptured.NewOwner)
+	case *v1.WorldChange_PlayerChanged:
+		for _, unit := range c.PlayerChanged.ResetUnits {
+			putUnit(units, unit)
+		}
+	}
+}
+
+// undoWorldChange reverts a change applied by applyWorldChange.  Unit state
+// a change does not record (eg before a capture started) is left as is
+// since it is not drawn.  Units reset by a turn change are restored by the
+// caller from unitsBeforeResets.
+func undoWorldChange(tiles map[string]*v1.Tile, units map[string]*v1.Unit, change *v1.WorldChange) {
+	switch c := change.ChangeType.(type) {
+	case *v1.WorldChange_UnitMoved:
+		removeUnit(units, c.UnitMoved.UpdatedUnit)
+		putUnit(units, c.UnitMoved.PreviousUnit)
+	case *v1.WorldChange_UnitDamaged:
+		putUnit(units, c.UnitDamaged.PreviousUnit)
+	case *v1.WorldChange_UnitHealed:
+		putUnit(units, c.UnitHealed.PreviousUnit)
+	case *v1.WorldChange_UnitFixed:
+		putUnit(units, c.UnitFixed.PreviousTarget)
+	case *v1.WorldChange_UnitKilled:
+		putUnit(units, c.UnitKilled.PreviousUnit)
+	case *v1.WorldChange_UnitBuilt:
+		removeUnit(units, c.UnitBuilt.Unit)
+	case *v1.WorldChange_TileCaptured:
+		setTileOwner(tiles, c.TileCaptured.TileQ, c.TileCaptured.TileR, c.TileCaptured.PreviousOwner)
+	}
+}
+
+func putUnit(units map[string]*v1.Unit, unit *v1.Unit) {
+	if unit != nil {
+		units[CoordKey(unit.Q, unit.R)] = proto.Clone(unit).(*v1.Unit)
+	}
+}
+
+func removeUnit(units map[string]*v1.Unit, unit *v1.Unit) {
+	if unit != nil {
+		delete(units, CoordKey(unit.Q, unit.R))
+	}
+}
+
+func setTileOwner(tiles map[string]*v1.Tile, q, r, player int32) {
+	key := CoordKey(q, r)
+	if tile := tiles[key]; tile != nil {
+		tile = proto.Clone(tile).(*v1.Tile)
+		tile.Player = player
+		tiles[key] = tile
+	}
+}
+
+// cloneTiles and cloneUnits copy the maps but share the (immutable) protos
+// since changes always replace rather than modify them
+
+func cloneTiles(tiles map[string]*v1.Tile) map[string]*v1.Tile {
+	out := make(map[string]*v1.Tile, len(tiles))
+	for key, tile := range tiles {
+		out[key] = tile
+	}
+	return out
+}
+
+func cloneUnits(units map[string]*v1.Unit) map[string]*v1.Unit {
+	out := make(map[string]*v1.Unit, len(units))
+	for key, unit := range units {
+		out[key] = unit
+	}
+	return out
+}
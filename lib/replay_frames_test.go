@@ -0,0 +1,151 @@
+package lib
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// replayTestGame returns a game's final state and the history that led to
+// it: player 1 moves a tank next to player 2's and attacks it, then player 2
+// kills the tank and captures player 1's base
+func replayTestGame() (*v1.GameState, *v1.GameMoveHistory) {
+	tank := &v1.Unit{Q: 0, R: 0, Player: 1, UnitType: 1, AvailableHealth: 10}
+	movedTank := &v1.Unit{Q: 2, R: 0, Player: 1, UnitType: 1, AvailableHealth: 10}
+	enemy := &v1.Unit{Q: 3, R: 0, Player: 2, UnitType: 1, AvailableHealth: 10}
+	damagedEnemy := &v1.Unit{Q: 3, R: 0, Player: 2, UnitType: 1, AvailableHealth: 4}
+
+	state := &v1.GameState{
+		TurnCounter:   2,
+		CurrentPlayer: 2,
+		WorldData: &v1.WorldData{
+			TilesMap: map[string]*v1.Tile{
+				"0,0": {Q: 0, R: 0, TileType: 5},
+				"1,0": {Q: 1, R: 0, TileType: 5},
+				"2,0": {Q: 2, R: 0, TileType: 5},
+				"3,0": {Q: 3, R: 0, TileType: 1, Player: 2},
+			},
+			UnitsMap: map[string]*v1.Unit{"3,0": damagedEnemy},
+		},
+	}
+	history := &v1.GameMoveHistory{Groups: []*v1.GameMoveGroup{{
+		GroupNumber: 1,
+		Moves: []*v1.GameMove{{
+			Player: 1,
+			MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{ReconstructedPath: &v1.Path{Edges: []*v1.PathEdge{
+				{FromQ: 0, FromR: 0, ToQ: 1, ToR: 0},
+				{FromQ: 1, FromR: 0, ToQ: 2, ToR: 0},
+			}}}},
+			Changes: []*v1.WorldChange{{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: &v1.UnitMovedChange{PreviousUnit: tank, UpdatedUnit: movedTank}}}},
+		}, {
+			Player:   1,
+			MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{Attacker: &v1.Position{Q: 2}, Defender: &v1.Position{Q: 3}}},
+			Changes:  []*v1.WorldChange{{ChangeType: &v1.WorldChange_UnitDamaged{UnitDamaged: &v1.UnitDamagedChange{PreviousUnit: enemy, UpdatedUnit: damagedEnemy}}}},
+		}},
+	}, {
+		GroupNumber: 2,
+		Moves: []*v1.GameMove{{
+			Player:   1,
+			MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}},
+			Changes:  []*v1.WorldChange{{ChangeType: &v1.WorldChange_PlayerChanged{PlayerChanged: &v1.PlayerChangedChange{PreviousPlayer: 1, NewPlayer: 2, PreviousTurn: 1, NewTurn: 2}}}},
+		}, {
+			Player: 2,
+			Changes: []*v1.WorldChange{
+				{ChangeType: &v1.WorldChange_UnitKilled{UnitKilled: &v1.UnitKilledChange{PreviousUnit: movedTank}}},
+				{ChangeType: &v1.WorldChange_TileCaptured{TileCaptured: &v1.TileCapturedChange{TileQ: 3, TileR: 0, PreviousOwner: 1, NewOwner: 2}}},
+			},
+		}},
+	}}}
+	return state, history
+}
+
+// TestReplayFrames_RewindsAndReplays tests that the starting world is found
+// by undoing the history and that each move's frame has its world, paths,
+// flashes and turn
+func TestReplayFrames_RewindsAndReplays(t *testing.T) {
+	state, history := replayTestGame()
+	frames := ReplayFrames(state, history)
+	if len(frames) != 5 {
+		t.Fatalf("Expected a starting frame and one per move, got %d", len(frames))
+	}
+
+	start := frames[0]
+	if start.Units["0,0"].GetAvailableHealth() != 10 || start.Units["3,0"].GetAvailableHealth() != 10 || len(start.Units) != 2 {
+		t.Errorf("Expected both units at full health to start with, got %v", start.Units)
+	}
+	if start.Tiles["3,0"].Player != 1 || start.Turn != 1 || start.Player != 1 {
+		t.Errorf("Expected player 1's base on turn 1, got %v on turn %d player %d", start.Tiles["3,0"], start.Turn, start.Player)
+	}
+
+	moved := frames[1]
+	if len(moved.Paths) != 1 || !slices.Equal(moved.Paths[0].Hexes, []AxialCoord{{0, 0}, {1, 0}, {2, 0}}) || moved.Paths[0].Player != 1 {
+		t.Errorf("Expected the tank's path through 1,0, got %v", moved.Paths)
+	}
+	if moved.Units["2,0"] == nil || moved.Units["0,0"] != nil {
+		t.Errorf("Expected the tank moved to 2,0, got %v", moved.Units)
+	}
+
+	if attacked := frames[2]; !slices.Equal(attacked.Flashes, []AxialCoord{{3, 0}}) || attacked.Units["3,0"].AvailableHealth != 4 {
+		t.Errorf("Expected one flash on the damaged enemy, got %v (%v)", attacked.Flashes, attacked.Units["3,0"])
+	}
+	if ended := frames[3]; ended.Turn != 2 || ended.Player != 2 {
+		t.Errorf("Expected turn 2 for player 2 after ending the turn, got %d/%d", ended.Turn, ended.Player)
+	}
+
+	last := frames[4]
+	if last.Units["2,0"] != nil || last.Tiles["3,0"].Player != 2 || !slices.Equal(last.Flashes, []AxialCoord{{2, 0}}) {
+		t.Errorf("Expected the tank killed and the base captured, got %v %v %v", last.Units, last.Tiles["3,0"], last.Flashes)
+	}
+
+	// The final state is left as it was
+	if state.WorldData.TilesMap["3,0"].Player != 2 || len(state.WorldData.UnitsMap) != 1 {
+		t.Errorf("Expected the game state untouched, got %v", state.WorldData)
+	}
+}
+
+// TestReplayFrames_UndoesUnitResets tests that stepping back over a turn
+// change puts the units it reset back as they were before it
+func TestReplayFrames_UndoesUnitResets(t *testing.T) {
+	state, history := replayTestGame()
+	healed := &v1.Unit{Q: 3, R: 0, Player: 2, UnitType: 1, AvailableHealth: 5, DistanceLeft: 3}
+	endTurn := history.Groups[1].Moves[0].Changes[0].GetPlayerChanged()
+	endTurn.ResetUnits = []*v1.Unit{healed}
+	state.WorldData.UnitsMap["3,0"] = healed
+
+	moves := append(slices.Clone(history.Groups[0].Moves), history.Groups[1].Moves...)
+	before := unitsBeforeResets(moves)[endTurn]
+	if len(before) != 1 || before[0].AvailableHealth != 4 || before[0].DistanceLeft != 0 {
+		t.Fatalf("Expected the enemy as damaged before the reset, got %v", before)
+	}
+
+	frames := ReplayFrames(state, history)
+	if got := frames[0].Units["3,0"].GetAvailableHealth(); got != 10 {
+		t.Errorf("Expected the enemy at full health to start with, got %d", got)
+	}
+	if got := frames[2].Units["3,0"].GetAvailableHealth(); got != 4 {
+		t.Errorf("Expected the enemy damaged before the turn ended, got %d", got)
+	}
+	if got := frames[3].Units["3,0"]; got.GetAvailableHealth() != 5 || got.GetDistanceLeft() != 3 {
+		t.Errorf("Expected the enemy reset when the turn ended, got %v", got)
+	}
+}
+
+// TestSampleReplayFrames tests that long replays are cut down evenly to
+// the limit, keeping the first and last frames
+func TestSampleReplayFrames(t *testing.T) {
+	var frames []*ReplayFrame
+	for turn := range 10 {
+		frames = append(frames, &ReplayFrame{Turn: int32(turn)})
+	}
+	var turns []int32
+	for _, frame := range SampleReplayFrames(frames, 4) {
+		turns = append(turns, frame.Turn)
+	}
+	if !slices.Equal(turns, []int32{0, 3, 6, 9}) {
+		t.Errorf("Expected 4 evenly spread frames, got turns %v", turns)
+	}
+	if got := SampleReplayFrames(frames, 0); len(got) != 10 {
+		t.Errorf("Expected no limit to keep every frame, got %d", len(got))
+	}
+}
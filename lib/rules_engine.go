@@ -2,7 +2,10 @@ package lib
 
 import (
 	"container/heap"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sync"
 
 	"github.com/turnforge/lilbattle/assets"
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
@@ -92,6 +95,28 @@ func DefaultRulesEngine() *RulesEngine {
 	return defaultRulesEngine
 }
 
+// DefaultRulesJSON returns the rules and damage data the default rules
+// engine was loaded from
+func DefaultRulesJSON() (rulesJSON []byte, damageJSON []byte) {
+	return assets.RulesDataJSON, assets.RulesDamageDataJSON
+}
+
+// RulesVersion returns the version of a set of rules and damage data - a
+// hash of their contents.  Games record the version they are played with
+// (Game.RulesVersion).
+func RulesVersion(rulesJSON, damageJSON []byte) string {
+	h := sha256.New()
+	h.Write(rulesJSON)
+	h.Write([]byte{0})
+	h.Write(damageJSON)
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// DefaultRulesVersion returns the version of the default rules
+var DefaultRulesVersion = sync.OnceValue(func() string {
+	return RulesVersion(DefaultRulesJSON())
+})
+
 // PopulateReferenceMaps populates the terrain/unit property reference maps for fast lookup
 // This should be called after loading the centralized properties
 func (re *RulesEngine) PopulateReferenceMaps() {
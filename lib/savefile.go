@@ -0,0 +1,159 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// SaveFileVersion is the current version of the save file format
+const SaveFileVersion = 1
+
+// SaveFile is the portable on-disk form of a game written by Game.SaveGame
+// and read by LoadGame.  It holds the game configuration, the current state
+// and the move history so a game can be exported from one place (eg a
+// browser's local storage) and continued somewhere else.
+type SaveFile struct {
+	Game    *v1.Game
+	State   *v1.GameState
+	History *v1.GameMoveHistory
+	Seed    int64
+}
+
+// saveFileJSON is the JSON envelope of a save file.  The proto messages are
+// encoded with protojson so oneofs (moves, changes) round trip.
+type saveFileJSON struct {
+	Version int             `json:"version"`
+	Seed    int64           `json:"seed"`
+	Game    json.RawMessage `json:"game"`
+	State   json.RawMessage `json:"state"`
+	History json.RawMessage `json:"history,omitempty"`
+}
+
+// MarshalJSON encodes the save file
+func (s *SaveFile) MarshalJSON() ([]byte, error) {
+	out := saveFileJSON{Version: SaveFileVersion, Seed: s.Seed}
+	var err error
+	if out.Game, err = protojson.Marshal(s.Game); err != nil {
+		return nil, fmt.Errorf("failed to encode game: %w", err)
+	}
+	if out.State, err = protojson.Marshal(s.State); err != nil {
+		return nil, fmt.Errorf("failed to encode game state: %w", err)
+	}
+	if s.History != nil {
+		if out.History, err = protojson.Marshal(s.History); err != nil {
+			return nil, fmt.Errorf("failed to encode move history: %w", err)
+		}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a save file
+func (s *SaveFile) UnmarshalJSON(data []byte) error {
+	var in saveFileJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if in.Version > SaveFileVersion {
+		return fmt.Errorf("unsupported save file version %d", in.Version)
+	}
+	if len(in.Game) == 0 || len(in.State) == 0 {
+		return fmt.Errorf("save file is missing the game or its state")
+	}
+
+	s.Seed = in.Seed
+	s.Game = &v1.Game{}
+	if err := protojson.Unmarshal(in.Game, s.Game); err != nil {
+		return fmt.Errorf("failed to decode game: %w", err)
+	}
+	s.State = &v1.GameState{}
+	if err := protojson.Unmarshal(in.State, s.State); err != nil {
+		return fmt.Errorf("failed to decode game state: %w", err)
+	}
+	s.History = &v1.GameMoveHistory{}
+	if len(in.History) > 0 {
+		if err := protojson.Unmarshal(in.History, s.History); err != nil {
+			return fmt.Errorf("failed to decode move history: %w", err)
+		}
+	}
+	return nil
+}
+
+// legacySaveFile is the format SaveGame wrote before save files were
+// versioned - the runtime Game passed straight to json.Marshal so the game's
+// and state's fields sit side by side at the top level.  Fields the two
+// share (eg game_id, version) were dropped by encoding/json and the move
+// history's oneof moves cannot be decoded so only the game, state and seed
+// survive.
+type legacySaveFile struct {
+	*v1.Game
+	*v1.GameState
+	World *struct {
+		Tiles []*v1.Tile
+		Units []*v1.Unit
+	} `json:"world"`
+	Seed int64 `json:"seed"`
+}
+
+// isLegacySaveFile returns true if the data has none of the save file
+// envelope's keys
+func isLegacySaveFile(saveData []byte) bool {
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(saveData, &keys); err != nil {
+		return false
+	}
+	_, hasGame := keys["game"]
+	_, hasState := keys["state"]
+	return !hasGame && !hasState
+}
+
+// parseLegacySaveFile decodes a pre-versioning save into a SaveFile with an
+// empty history.  The world is taken from the state's world data, falling
+// back to the separately encoded world.
+func parseLegacySaveFile(saveData []byte) (*SaveFile, error) {
+	legacy := legacySaveFile{Game: &v1.Game{}, GameState: &v1.GameState{}}
+	if err := json.Unmarshal(saveData, &legacy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal game data: %w", err)
+	}
+	if legacy.GameState.WorldData == nil && legacy.World != nil {
+		worldData := &v1.WorldData{TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+		for _, tile := range legacy.World.Tiles {
+			worldData.TilesMap[CoordKey(tile.Q, tile.R)] = tile
+		}
+		for _, unit := range legacy.World.Units {
+			worldData.UnitsMap[CoordKey(unit.Q, unit.R)] = unit
+		}
+		legacy.GameState.WorldData = worldData
+	}
+	legacy.GameState.GameId = legacy.Game.Id
+	return &SaveFile{
+		Game:    legacy.Game,
+		State:   legacy.GameState,
+		History: &v1.GameMoveHistory{GameId: legacy.Game.Id},
+		Seed:    legacy.Seed,
+	}, nil
+}
+
+// ParseSaveFile decodes and checks a save file without building a runtime
+// game.  Saves written before the versioned format are loaded too.
+func ParseSaveFile(saveData []byte) (*SaveFile, error) {
+	var save SaveFile
+	if isLegacySaveFile(saveData) {
+		legacy, err := parseLegacySaveFile(saveData)
+		if err != nil {
+			return nil, err
+		}
+		save = *legacy
+	} else if err := json.Unmarshal(saveData, &save); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal game data: %w", err)
+	}
+	if save.Game.Id == "" {
+		return nil, fmt.Errorf("saved game has no ID")
+	}
+	if save.State.GameId != "" && save.State.GameId != save.Game.Id {
+		return nil, fmt.Errorf("saved state is for game %s, not %s", save.State.GameId, save.Game.Id)
+	}
+	return &save, nil
+}
@@ -0,0 +1,136 @@
+package lib
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// TestSaveGame_LoadGameRoundTrip tests that a saved game including its move
+// history can be loaded back and played on
+func TestSaveGame_LoadGameRoundTrip(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(2).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		build()
+
+	move := moveOrder(0, 0, 1, 0)
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+	game.GameMoveHistory = &v1.GameMoveHistory{
+		GameId: game.Id,
+		Groups: []*v1.GameMoveGroup{{GroupNumber: 1, Moves: []*v1.GameMove{move}}},
+	}
+
+	data, err := game.SaveGame()
+	if err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+
+	loaded, err := LoadGame(data)
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	if loaded.Id != game.Id || loaded.Seed != game.Seed || loaded.CurrentPlayer != 1 {
+		t.Errorf("Loaded game does not match: id=%s seed=%d player=%d", loaded.Id, loaded.Seed, loaded.CurrentPlayer)
+	}
+	if loaded.World.UnitAt(AxialCoord{Q: 1, R: 0}) == nil {
+		t.Error("Moved unit should be at its new position")
+	}
+	if len(loaded.Groups) != 1 || loaded.Groups[0].Moves[0].GetMoveUnit() == nil {
+		t.Error("Move history should be restored")
+	}
+
+	// The world must be a view over the loaded state
+	loaded.SetRulesEngine(DefaultRulesEngine())
+	if err := loaded.ProcessMove(moveOrder(2, 0, 3, 0)); err == nil {
+		t.Error("Player 2's unit should not move on player 1's turn")
+	}
+	if err := loaded.ProcessMove(&v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}); err != nil {
+		t.Fatalf("EndTurn on loaded game failed: %v", err)
+	}
+	if loaded.GameState.CurrentPlayer != 2 {
+		t.Errorf("Expected player 2's turn, got %d", loaded.GameState.CurrentPlayer)
+	}
+}
+
+// TestParseSaveFile_Rejects tests that malformed save files are rejected
+func TestParseSaveFile_Rejects(t *testing.T) {
+	for name, data := range map[string]string{
+		"not json":      "nope",
+		"missing state": `{"version":1,"game":{"id":"g1"}}`,
+		"no id":         `{"version":1,"game":{},"state":{}}`,
+		"newer version": `{"version":99,"game":{"id":"g1"},"state":{}}`,
+		"wrong game":    `{"version":1,"game":{"id":"g1"},"state":{"gameId":"g2"}}`,
+	} {
+		if _, err := ParseSaveFile([]byte(data)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+// TestLoadGame_LegacySave tests that saves written before the versioned
+// format (the runtime game passed to json.Marshal) still load
+func TestLoadGame_LegacySave(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(2).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		currentPlayer(2).
+		build()
+	game.Name = "Old Game"
+	data, err := json.MarshalIndent(game, "", "  ")
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	loaded, err := LoadGame(data)
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	if loaded.Id != game.Id || loaded.Name != "Old Game" || loaded.Seed != game.Seed || loaded.CurrentPlayer != 2 {
+		t.Errorf("Loaded game does not match: id=%s name=%s seed=%d player=%d", loaded.Id, loaded.Name, loaded.Seed, loaded.CurrentPlayer)
+	}
+	if loaded.GameState.GameId != game.Id || loaded.GameMoveHistory == nil {
+		t.Error("Legacy saves should get the game's ID on the state and an empty history")
+	}
+	if unit := loaded.World.UnitAt(AxialCoord{Q: 2, R: 0}); unit == nil || unit.Player != 2 {
+		t.Error("Units should be restored")
+	}
+
+	// Without the state's world data the separately encoded world is used
+	var fields map[string]json.RawMessage
+	json.Unmarshal(data, &fields)
+	delete(fields, "world_data")
+	data, _ = json.Marshal(fields)
+	if loaded, err = LoadGame(data); err != nil {
+		t.Fatalf("LoadGame without world data failed: %v", err)
+	}
+	if unit := loaded.World.UnitAt(AxialCoord{Q: 2, R: 0}); unit == nil || unit.Player != 2 {
+		t.Error("Units should be restored from the world")
+	}
+}
+
+// TestLoadGame_ValidatesPlayers tests that the current player and the
+// players owning units must be within the game's players
+func TestLoadGame_ValidatesPlayers(t *testing.T) {
+	for name, build := range map[string]func() *Game{
+		"current player": func() *Game {
+			return newTestGameBuilder().grassTiles(1).unit(0, 0, 1, testUnitTypeSoldier).currentPlayer(3).build()
+		},
+		"unit owner": func() *Game {
+			return newTestGameBuilder().grassTiles(1).unit(0, 0, 3, testUnitTypeSoldier).build()
+		},
+	} {
+		data, err := build().SaveGame()
+		if err != nil {
+			t.Fatalf("%s: SaveGame failed: %v", name, err)
+		}
+		if _, err := LoadGame(data); err == nil {
+			t.Errorf("%s: expected a player outside the game to be rejected", name)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Turn modes supported by GameSettings.TurnMode
+const (
+	// TurnModeSequential - players take turns one at a time (the default)
+	TurnModeSequential = "sequential"
+
+	// TurnModeSimultaneous - all players submit hidden orders for a turn which
+	// are then resolved together
+	TurnModeSimultaneous = "simultaneous"
+)
+
+// IsSimultaneous returns true if the game is configured for simultaneous turns
+func IsSimultaneous(game *v1.Game) bool {
+	return game != nil && game.Config != nil && game.Config.Settings != nil &&
+		game.Config.Settings.TurnMode == TurnModeSimultaneous
+}
+
+// OrdersCommitment computes the commitment a player publishes before revealing
+// their orders.  It is the hex encoded SHA-256 of the deterministic proto
+// encoding of a PlayerOrders containing only the moves and the salt.  Clients
+// must compute it the same way for their reveal to be accepted.
+func OrdersCommitment(moves []*v1.GameMove, salt string) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(&v1.PlayerOrders{
+		Moves: moves,
+		Salt:  salt,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode orders: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// resolvingOrder is a single order being resolved along with the data needed
+// to decide its initiative
+type resolvingOrder struct {
+	move     *v1.GameMove
+	index    int   // Position within the player's own orders
+	rank     int   // Position of the player in this turn's rotation
+	unitType int32 // Type of the unit the order was issued to (0 if none)
+	ranged   bool
+	speed    float64
+}
+
+// ResolveSimultaneousTurn resolves the revealed orders of all players for the
+// current turn and then ends the turn for everyone at once.  Resolution is
+// deterministic and happens in phases:
+//
+//  1. Movement - moves whose destinations are contested by more than one player
+//     are cancelled.  The rest are applied in initiative order, retrying moves
+//     blocked by units that have not moved yet until no more progress is made.
+//  2. Combat - attacks are applied in initiative order, so units destroyed
+//     earlier in the phase do not get to attack.
+//  3. Everything else (builds, captures, heals, fixes) in player order.
+//  4. End of turn - income for every player, top-up of all units and a
+//     victory check.
+//
+// Initiative favours ranged units, then faster units, then the player order
+// which rotates every turn.  Orders that could not be carried out are returned
+// with no changes and a "cancelled: " description.  The returned moves are in
+// the order they were resolved with a final EndTurn move carrying the turn
+// change.  The orders passed in are copied and left as they were.
+func (g *Game) ResolveSimultaneousTurn(orders map[int32][]*v1.GameMove) (resolved []*v1.GameMove, err error) {
+	currentPlayer := g.CurrentPlayer
+	defer func() { g.CurrentPlayer = currentPlayer }()
+
+	var movements, attacks, others []*resolvingOrder
+	for rank, playerId := range g.turnRotation() {
+		// Track where this player's units are as their own orders move them so
+		// follow up orders are attributed to the right unit
+		unitTypes := map[AxialCoord]int32{}
+		for _, unit := range g.World.GetPlayerUnits(int(playerId)) {
+			unitTypes[UnitGetCoord(unit)] = unit.UnitType
+		}
+		for index, move := range orders[playerId] {
+			if move == nil || move.MoveType == nil {
+				continue
+			}
+			move = proto.Clone(move).(*v1.GameMove)
+			move.Player = playerId
+			order := &resolvingOrder{move: move, index: index, rank: rank}
+			switch a := move.MoveType.(type) {
+			case *v1.GameMove_MoveUnit:
+				from, to, err := g.moveEndpoints(a.MoveUnit)
+				if err == nil {
+					order.unitType = unitTypes[from]
+					delete(unitTypes, from)
+					unitTypes[to] = order.unitType
+				}
+				movements = append(movements, order)
+			case *v1.GameMove_AttackUnit:
+				if from, err := g.FromPos(a.AttackUnit.Attacker); err == nil {
+					order.unitType = unitTypes[from]
+				}
+				attacks = append(attacks, order)
+			case *v1.GameMove_EndTurn:
+				// The turn ends for everyone once all orders are resolved
+				continue
+			default:
+				others = append(others, order)
+			}
+			if order.unitType != 0 {
+				if unitData, err := g.RulesEngine.GetUnitData(order.unitType); err == nil {
+					order.ranged = unitData.AttackRange > 1
+					order.speed = unitData.MovementPoints
+				}
+			}
+		}
+	}
+	sortByInitiative(movements)
+	sortByInitiative(attacks)
+	sort.SliceStable(others, func(i, j int) bool {
+		if others[i].rank != others[j].rank {
+			return others[i].rank < others[j].rank
+		}
+		return others[i].index < others[j].index
+	})
+
+	resolved = append(resolved, g.resolveMovements(movements)...)
+	for _, order := range attacks {
+		resolved = append(resolved, g.resolveOrder(order))
+	}
+	for _, order := range others {
+		resolved = append(resolved, g.resolveOrder(order))
+	}
+
+	endTurn := &v1.GameMove{
+		MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}},
+	}
+	if err := g.endSimultaneousTurn(endTurn, currentPlayer); err != nil {
+		return nil, err
+	}
+	return append(resolved, endTurn), nil
+}
+
+// turnRotation returns the player IDs in this turn's priority order.  The
+// first player rotates each turn so no player always wins initiative ties.
+func (g *Game) turnRotation() []int32 {
+	numPlayers := g.NumPlayers()
+	if numPlayers <= 0 {
+		return nil
+	}
+	first := (g.TurnCounter - 1) % numPlayers
+	if first < 0 {
+		first += numPlayers
+	}
+	out := make([]int32, 0, numPlayers)
+	for i := int32(0); i < numPlayers; i++ {
+		out = append(out, (first+i)%numPlayers+1)
+	}
+	return out
+}
+
+// sortByInitiative orders resolving orders so ranged units act before direct
+// units and faster units before slower ones with ties broken by player order
+func sortByInitiative(orders []*resolvingOrder) {
+	sort.SliceStable(orders, func(i, j int) bool {
+		a, b := orders[i], orders[j]
+		if a.ranged != b.ranged {
+			return a.ranged
+		}
+		if a.speed != b.speed {
+			return a.speed > b.speed
+		}
+		if a.rank != b.rank {
+			return a.rank < b.rank
+		}
+		return a.index < b.index
+	})
+}
+
+// moveEndpoints resolves the from and to coordinates of a move action
+func (g *Game) moveEndpoints(action *v1.MoveUnitAction) (from, to AxialCoord, err error) {
+	from, err = g.FromPos(action.From)
+	if err != nil {
+		return
+	}
+	to, err = g.FromPosWithBase(action.To, &from)
+	return
+}
+
+// resolveMovements applies movement orders, cancelling contested destinations
+// and retrying blocked moves until a pass makes no progress
+func (g *Game) resolveMovements(orders []*resolvingOrder) (resolved []*v1.GameMove) {
+	destinations := map[AxialCoord]map[int32]bool{}
+	for _, order := range orders {
+		if _, to, err := g.moveEndpoints(order.move.GetMoveUnit()); err == nil {
+			if destinations[to] == nil {
+				destinations[to] = map[int32]bool{}
+			}
+			destinations[to][order.move.Player] = true
+		}
+	}
+
+	var pending []*resolvingOrder
+	for _, order := range orders {
+		_, to, err := g.moveEndpoints(order.move.GetMoveUnit())
+		if err == nil && len(destinations[to]) > 1 {
+			resolved = append(resolved, cancelOrder(order.move, fmt.Errorf("destination %v contested", to)))
+			continue
+		}
+		pending = append(pending, order)
+	}
+
+	lastErrors := map[*resolvingOrder]error{}
+	for len(pending) > 0 {
+		var blocked []*resolvingOrder
+		for _, order := range pending {
+			if err := g.applyOrder(order); err != nil {
+				lastErrors[order] = err
+				blocked = append(blocked, order)
+				continue
+			}
+			resolved = append(resolved, order.move)
+		}
+		if len(blocked) == len(pending) {
+			// No progress - whatever is left is blocked for good
+			for _, order := range blocked {
+				resolved = append(resolved, cancelOrder(order.move, lastErrors[order]))
+			}
+			break
+		}
+		pending = blocked
+	}
+	return
+}
+
+// resolveOrder applies a single order, cancelling it if it fails
+func (g *Game) resolveOrder(order *resolvingOrder) *v1.GameMove {
+	if err := g.applyOrder(order); err != nil {
+		return cancelOrder(order.move, err)
+	}
+	return order.move
+}
+
+// applyOrder runs an order through the regular move processors as its player
+func (g *Game) applyOrder(order *resolvingOrder) error {
+	if order.unitType != 0 {
+		var from *v1.Position
+		switch a := order.move.MoveType.(type) {
+		case *v1.GameMove_MoveUnit:
+			from = a.MoveUnit.From
+		case *v1.GameMove_AttackUnit:
+			from = a.AttackUnit.Attacker
+		}
+		if from != nil {
+			coord, err := g.FromPos(from)
+			if err != nil {
+				return err
+			}
+			// Make sure the order still refers to the unit it was issued to
+			unit := g.World.UnitAt(coord)
+			if unit == nil || unit.Player != order.move.Player || unit.UnitType != order.unitType {
+				return fmt.Errorf("unit no longer at %v", coord)
+			}
+		}
+	}
+	g.CurrentPlayer = order.move.Player
+	return g.ProcessMove(order.move)
+}
+
+// cancelOrder marks an order as not carried out
+func cancelOrder(move *v1.GameMove, reason error) *v1.GameMove {
+	move.Changes = []*v1.WorldChange{}
+	move.Description = fmt.Sprintf("cancelled: %v", reason)
+	return move
+}
+
+// endSimultaneousTurn ends the turn for all players at once - everyone gets
+// their income, the turn counter advances and all units are topped up
+func (g *Game) endSimultaneousTurn(move *v1.GameMove, currentPlayer int32) error {
+	previousTurn := g.TurnCounter
+	for _, playerId := range g.turnRotation() {
+		coinsChange, err := g.collectIncome(playerId)
+		if err != nil {
+			return err
+		}
+		if coinsChange != nil {
+			move.Changes = append(move.Changes, coinsChange)
+		}
+	}
+
+	g.TurnCounter++
+
+	// Top-up every unit and capture them as ResetUnits so remote clients
+	// receive the refreshed values
+	var resetUnits []*v1.Unit
+	for playerId := int32(1); playerId <= g.NumPlayers(); playerId++ {
+		for _, unit := range g.World.GetPlayerUnits(int(playerId)) {
+			if err := g.TopUpUnitIfNeeded(unit); err != nil {
+				fmt.Printf("endSimultaneousTurn: Warning - failed to top-up unit at (%d,%d): %v\n",
+					unit.Q, unit.R, err)
+			}
+			resetUnits = append(resetUnits, copyUnit(unit))
+		}
+	}
+
+	if winner, hasWinner := g.checkVictoryConditions(); hasWinner {
+		g.declareWinner(winner)
+	}
+
+	g.GameState.UpdatedAt = tspb.New(time.Now())
+	move.Changes = append(move.Changes, &v1.WorldChange{
+		ChangeType: &v1.WorldChange_PlayerChanged{
+			PlayerChanged: &v1.PlayerChangedChange{
+				PreviousPlayer: currentPlayer,
+				NewPlayer:      currentPlayer,
+				PreviousTurn:   previousTurn,
+				NewTurn:        g.TurnCounter,
+				ResetUnits:     resetUnits,
+			},
+		},
+	})
+	return nil
+}
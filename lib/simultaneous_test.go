@@ -0,0 +1,173 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+func moveOrder(fromQ, fromR, toQ, toR int32) *v1.GameMove {
+	return &v1.GameMove{
+		MoveType: &v1.GameMove_MoveUnit{
+			MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Q: fromQ, R: fromR},
+				To:   &v1.Position{Q: toQ, R: toR},
+			},
+		},
+	}
+}
+
+// TestOrdersCommitment tests that commitments are stable and bound to the salt
+func TestOrdersCommitment(t *testing.T) {
+	moves := []*v1.GameMove{moveOrder(0, 0, 1, 0)}
+
+	first, err := OrdersCommitment(moves, "salt")
+	if err != nil {
+		t.Fatalf("OrdersCommitment failed: %v", err)
+	}
+	second, _ := OrdersCommitment([]*v1.GameMove{moveOrder(0, 0, 1, 0)}, "salt")
+	if first != second {
+		t.Errorf("Commitment should be deterministic: %s != %s", first, second)
+	}
+	salted, _ := OrdersCommitment(moves, "other")
+	if first == salted {
+		t.Error("Commitment should change with the salt")
+	}
+	changed, _ := OrdersCommitment([]*v1.GameMove{moveOrder(0, 0, 0, 1)}, "salt")
+	if first == changed {
+		t.Error("Commitment should change with the moves")
+	}
+}
+
+// TestResolveSimultaneousTurn_ContestedDestination tests that moves by
+// different players into the same hex are all cancelled
+func TestResolveSimultaneousTurn_ContestedDestination(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		build()
+
+	orders := map[int32][]*v1.GameMove{
+		1: {moveOrder(0, 0, 1, 0)},
+		2: {moveOrder(2, 0, 1, 0)},
+	}
+	resolved, err := game.ResolveSimultaneousTurn(orders)
+	if err != nil {
+		t.Fatalf("ResolveSimultaneousTurn failed: %v", err)
+	}
+
+	// The revealed orders are left as they were
+	for playerId, moves := range orders {
+		if moves[0].Player != 0 || moves[0].Description != "" || len(moves[0].Changes) != 0 {
+			t.Errorf("Orders of player %d were changed: %v", playerId, moves[0])
+		}
+	}
+
+	for _, move := range resolved[:2] {
+		if !strings.HasPrefix(move.Description, "cancelled:") || len(move.Changes) != 0 {
+			t.Errorf("Contested move for player %d should be cancelled, got %q", move.Player, move.Description)
+		}
+	}
+	if game.World.UnitAt(AxialCoord{Q: 0, R: 0}) == nil || game.World.UnitAt(AxialCoord{Q: 2, R: 0}) == nil {
+		t.Error("Units with contested moves should not move")
+	}
+}
+
+// TestResolveSimultaneousTurn_ChainedMoves tests that a move blocked by a unit
+// which moves away later in the same turn still succeeds
+func TestResolveSimultaneousTurn_ChainedMoves(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 1, testUnitTypeSoldier).
+		unit(-2, 0, 2, testUnitTypeSoldier).
+		build()
+
+	resolved, err := game.ResolveSimultaneousTurn(map[int32][]*v1.GameMove{
+		1: {moveOrder(0, 0, 1, 0), moveOrder(1, 0, 2, 0)},
+	})
+	if err != nil {
+		t.Fatalf("ResolveSimultaneousTurn failed: %v", err)
+	}
+
+	for _, move := range resolved {
+		if strings.HasPrefix(move.Description, "cancelled:") {
+			t.Errorf("Unexpected cancelled move: %s", move.Description)
+		}
+	}
+	if game.World.UnitAt(AxialCoord{Q: 1, R: 0}) == nil || game.World.UnitAt(AxialCoord{Q: 2, R: 0}) == nil {
+		t.Error("Both units should have moved")
+	}
+	if game.World.UnitAt(AxialCoord{Q: 0, R: 0}) != nil {
+		t.Error("Origin hex should be empty")
+	}
+}
+
+// TestResolveSimultaneousTurn_EndsTurnForAll tests the shared end of turn
+func TestResolveSimultaneousTurn_EndsTurnForAll(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(2).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 2, testUnitTypeSoldier).
+		build()
+
+	resolved, err := game.ResolveSimultaneousTurn(map[int32][]*v1.GameMove{})
+	if err != nil {
+		t.Fatalf("ResolveSimultaneousTurn failed: %v", err)
+	}
+	if len(resolved) != 1 || resolved[0].GetEndTurn() == nil {
+		t.Fatalf("Expected a single end turn move, got %d moves", len(resolved))
+	}
+	if game.TurnCounter != 2 {
+		t.Errorf("Turn counter should advance to 2, got %d", game.TurnCounter)
+	}
+	if game.CurrentPlayer != 1 {
+		t.Errorf("Current player should be unchanged, got %d", game.CurrentPlayer)
+	}
+
+	var playerChanged *v1.PlayerChangedChange
+	for _, change := range resolved[0].Changes {
+		if pc := change.GetPlayerChanged(); pc != nil {
+			playerChanged = pc
+		}
+	}
+	if playerChanged == nil {
+		t.Fatal("End turn should record a player changed change")
+	}
+	if len(playerChanged.ResetUnits) != 2 {
+		t.Errorf("Units of all players should be reset, got %d", len(playerChanged.ResetUnits))
+	}
+}
+
+// TestSortByInitiative tests ranged, then faster, then player rotation ordering
+func TestSortByInitiative(t *testing.T) {
+	slow := &resolvingOrder{rank: 0, speed: 1}
+	fast := &resolvingOrder{rank: 1, speed: 3}
+	ranged := &resolvingOrder{rank: 1, speed: 1, ranged: true}
+	tieFirst := &resolvingOrder{rank: 0, speed: 3, index: 1}
+	orders := []*resolvingOrder{slow, fast, tieFirst, ranged}
+
+	sortByInitiative(orders)
+
+	expected := []*resolvingOrder{ranged, tieFirst, fast, slow}
+	for i := range expected {
+		if orders[i] != expected[i] {
+			t.Fatalf("Unexpected initiative order at %d", i)
+		}
+	}
+}
+
+// TestTurnRotation tests that the player priority rotates each turn
+func TestTurnRotation(t *testing.T) {
+	game := newTestGameBuilder().build()
+	game.TurnCounter = 1
+	if got := game.turnRotation(); got[0] != 1 || got[1] != 2 {
+		t.Errorf("Turn 1 rotation should start with player 1, got %v", got)
+	}
+	game.TurnCounter = 2
+	if got := game.turnRotation(); got[0] != 2 || got[1] != 1 {
+		t.Errorf("Turn 2 rotation should start with player 2, got %v", got)
+	}
+}
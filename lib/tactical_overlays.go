@@ -0,0 +1,190 @@
+package lib
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// Tactical overlays shade the map for coaching and debugging AI players:
+// where a player can be attacked next turn, where a unit can move and who
+// controls each area.  They are drawn through RenderOptions.Heatmap.
+
+// InfluenceRadius is how far a unit's influence reaches
+const InfluenceRadius = 3
+
+// ThreatMap returns the hexes the enemies of a player could attack next
+// turn - from anywhere they can move to with full movement points, within
+// their attack range.  Like GetAttackOptions only attacks the rules allow
+// count: enemies that cannot attack any of the player's (or its allies')
+// unit types are left out, as are hexes of units they cannot attack.  A
+// hex's weight grows with the number of enemy units that threaten it.
+func (g *Game) ThreatMap(player int32) ([]HeatmapCell, error) {
+	var defenders []int32
+	for _, unit := range g.World.UnitsByCoord() {
+		if unit.AvailableHealth > 0 && AreAllies(g.Game, unit.Player, player) && !slices.Contains(defenders, unit.UnitType) {
+			defenders = append(defenders, unit.UnitType)
+		}
+	}
+
+	threats := map[AxialCoord]int{}
+	for coord, unit := range g.World.UnitsByCoord() {
+		if unit.AvailableHealth <= 0 || AreAllies(g.Game, unit.Player, player) {
+			continue
+		}
+		canAttack := func(unitType int32) bool {
+			_, ok := g.RulesEngine.GetCombatPrediction(unit.UnitType, unitType)
+			return ok
+		}
+		if !slices.ContainsFunc(defenders, canAttack) {
+			continue
+		}
+		unitData, err := g.RulesEngine.GetUnitData(unit.UnitType)
+		if err != nil {
+			return nil, fmt.Errorf("unit at %v: %w", coord, err)
+		}
+		paths, err := g.RulesEngine.GetMovementOptions(g.World, unit, int(unitData.MovementPoints), false)
+		if err != nil {
+			return nil, fmt.Errorf("unit at %v: %w", coord, err)
+		}
+
+		// Attack from where the unit is or any hex it can stop on
+		origins := []AxialCoord{coord}
+		for _, edge := range paths.Edges {
+			if !edge.IsOccupied {
+				origins = append(origins, AxialCoord{Q: int(edge.ToQ), R: int(edge.ToR)})
+			}
+		}
+		minRange := max(int(unitData.MinAttackRange), 1)
+		targets := map[AxialCoord]bool{}
+		for _, origin := range origins {
+			for _, target := range origin.Range(int(unitData.AttackRange)) {
+				if origin.Distance(target) < minRange || g.World.TileAt(target) == nil {
+					continue
+				}
+				if defender := g.World.UnitAt(target); defender != nil && AreAllies(g.Game, defender.Player, player) && !canAttack(defender.UnitType) {
+					continue
+				}
+				targets[target] = true
+			}
+		}
+		for target := range targets {
+			threats[target]++
+		}
+	}
+
+	most := 0
+	for _, count := range threats {
+		most = max(most, count)
+	}
+	var cells []HeatmapCell
+	for coord, count := range threats {
+		cells = append(cells, HeatmapCell{Coord: coord, Weight: 0.4 + 0.6*float64(count)/float64(most)})
+	}
+	sortHeatmap(cells)
+	return cells, nil
+}
+
+// ReachMap returns the hexes the unit at a coordinate can move to, in its
+// player's colour and fading with the movement it costs.  A unit of the
+// player to move has what is left of its movement this turn, others their
+// full movement next turn.
+func (g *Game) ReachMap(coord AxialCoord) ([]HeatmapCell, error) {
+	unit := g.World.UnitAt(coord)
+	if unit == nil {
+		return nil, fmt.Errorf("no unit found at position (%d, %d)", coord.Q, coord.R)
+	}
+	unitData, err := g.RulesEngine.GetUnitData(unit.UnitType)
+	if err != nil {
+		return nil, err
+	}
+	budget := unitData.MovementPoints
+	if g.CanControlUnit(unit) && unit.LastToppedupTurn >= g.TurnCounter {
+		budget = unit.DistanceLeft
+	}
+	if budget <= 0 {
+		return nil, nil
+	}
+
+	paths, err := g.RulesEngine.GetMovementOptions(g.World, unit, int(budget), false)
+	if err != nil {
+		return nil, err
+	}
+	var cells []HeatmapCell
+	for _, edge := range paths.Edges {
+		if edge.IsOccupied {
+			continue
+		}
+		cells = append(cells, HeatmapCell{
+			Coord:  AxialCoord{Q: int(edge.ToQ), R: int(edge.ToR)},
+			Player: unit.Player,
+			Weight: 1 - 0.6*edge.TotalCost/budget,
+		})
+	}
+	sortHeatmap(cells)
+	return cells, nil
+}
+
+// InfluenceMap returns the player controlling each hex.  Units project
+// their remaining health, falling off with distance up to InfluenceRadius,
+// and owned tiles add to their own hex.  Hexes are shaded by how far the
+// strongest player is ahead of the next; contested ones are left out.
+func (g *Game) InfluenceMap() []HeatmapCell {
+	influence := map[AxialCoord]map[int32]float64{}
+	add := func(coord AxialCoord, player int32, amount float64) {
+		if g.World.TileAt(coord) == nil {
+			return
+		}
+		if influence[coord] == nil {
+			influence[coord] = map[int32]float64{}
+		}
+		influence[coord][player] += amount
+	}
+
+	for coord, unit := range g.World.UnitsByCoord() {
+		if unit.Player <= 0 || unit.AvailableHealth <= 0 {
+			continue
+		}
+		strength := 1.0
+		if unitData, err := g.RulesEngine.GetUnitData(unit.UnitType); err == nil && unitData.Health > 0 {
+			strength = float64(unit.AvailableHealth) / float64(unitData.Health)
+		}
+		for _, target := range coord.Range(InfluenceRadius) {
+			add(target, unit.Player, strength/float64(1+coord.Distance(target)))
+		}
+	}
+	for coord, tile := range g.World.TilesByCoord() {
+		if tile.Player > 0 {
+			add(coord, tile.Player, 0.5)
+		}
+	}
+
+	var cells []HeatmapCell
+	for coord, byPlayer := range influence {
+		var leader int32
+		var best, second float64
+		for player, amount := range byPlayer {
+			if amount > best {
+				leader, best, second = player, amount, best
+			} else if amount > second {
+				second = amount
+			}
+		}
+		if best > second {
+			cells = append(cells, HeatmapCell{Coord: coord, Player: leader, Weight: (best - second) / best})
+		}
+	}
+	sortHeatmap(cells)
+	return cells
+}
+
+// sortHeatmap orders cells by row then column so overlays render the same
+// every time
+func sortHeatmap(cells []HeatmapCell) {
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].Coord.R != cells[j].Coord.R {
+			return cells[i].Coord.R < cells[j].Coord.R
+		}
+		return cells[i].Coord.Q < cells[j].Coord.Q
+	})
+}
@@ -0,0 +1,171 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+func heatmapAt(cells []HeatmapCell, q, r int) *HeatmapCell {
+	for i := range cells {
+		if cells[i].Coord == (AxialCoord{Q: q, R: r}) {
+			return &cells[i]
+		}
+	}
+	return nil
+}
+
+// tacticalTestGame has a soldier each for players 1 and 2 facing each other
+// across open grass
+func tacticalTestGame() *Game {
+	return newTestGameBuilder().
+		grassTiles(5).
+		unitFull(-4, 0, 1, testUnitTypeSoldier, "A1", 10, 3).
+		unitFull(4, 0, 2, testUnitTypeSoldier, "B1", 10, 3).
+		currentPlayer(1).
+		build()
+}
+
+// TestThreatMap_EnemyMovementAndRange tests that the hexes an enemy can
+// reach and attack next turn are threatened and the rest are not
+func TestThreatMap_EnemyMovementAndRange(t *testing.T) {
+	game := tacticalTestGame()
+	unitData, _ := game.RulesEngine.GetUnitData(testUnitTypeSoldier)
+	reach := int(unitData.MovementPoints) + int(unitData.AttackRange)
+
+	cells, err := game.ThreatMap(1)
+	if err != nil {
+		t.Fatalf("ThreatMap failed: %v", err)
+	}
+	if heatmapAt(cells, 4-reach, 0) == nil || heatmapAt(cells, 3, 0) == nil {
+		t.Errorf("Expected hexes within %d of the enemy threatened, got %v", reach, cells)
+	}
+	if heatmapAt(cells, 4-reach-1, 0) != nil || heatmapAt(cells, -4, 0) != nil {
+		t.Errorf("Expected hexes beyond %d of the enemy safe, got %v", reach, cells)
+	}
+	for _, cell := range cells {
+		if cell.Player != 0 || cell.Weight != 1 {
+			t.Errorf("Expected every threat red at full weight from the one enemy, got %+v", cell)
+		}
+	}
+
+	// Player 2 is threatened by player 1's soldier instead
+	cells, _ = game.ThreatMap(2)
+	if heatmapAt(cells, -3, 0) == nil || heatmapAt(cells, 4, 0) != nil {
+		t.Errorf("Expected player 1's soldier to threaten its own side, got %v", cells)
+	}
+}
+
+// TestReachMap_FadesWithCost tests that a unit's reach excludes where it is
+// and fades the further it goes
+func TestReachMap_FadesWithCost(t *testing.T) {
+	game := tacticalTestGame()
+	cells, err := game.ReachMap(AxialCoord{Q: -4, R: 0})
+	if err != nil {
+		t.Fatalf("ReachMap failed: %v", err)
+	}
+	near, far := heatmapAt(cells, -3, 0), heatmapAt(cells, -2, 0)
+	if near == nil || far == nil || heatmapAt(cells, -4, 0) != nil {
+		t.Fatalf("Expected the hexes around the unit reachable, got %v", cells)
+	}
+	if near.Player != 1 || near.Weight <= far.Weight {
+		t.Errorf("Expected reach in player 1's colour fading with cost, got %+v and %+v", near, far)
+	}
+
+	if _, err := game.ReachMap(AxialCoord{Q: 0, R: 0}); err == nil {
+		t.Errorf("Expected an error for a hex without a unit")
+	}
+}
+
+// TestInfluenceMap_ControlledAndContested tests that each side controls the
+// hexes around its units and the middle is contested
+func TestInfluenceMap_ControlledAndContested(t *testing.T) {
+	game := tacticalTestGame()
+	cells := game.InfluenceMap()
+	if cell := heatmapAt(cells, -4, 0); cell == nil || cell.Player != 1 || cell.Weight != 1 {
+		t.Errorf("Expected player 1 in full control of its soldier's hex, got %+v", cell)
+	}
+	if cell := heatmapAt(cells, 3, 0); cell == nil || cell.Player != 2 {
+		t.Errorf("Expected player 2 in control next to its soldier, got %+v", cell)
+	}
+	if cell := heatmapAt(cells, 0, 0); cell != nil {
+		t.Errorf("Expected the middle out of reach of both, got %+v", cell)
+	}
+
+	// Closer together the middle is in reach of both and contested
+	game = newTestGameBuilder().
+		grassTiles(3).
+		unitFull(-2, 0, 1, testUnitTypeSoldier, "A1", 10, 3).
+		unitFull(2, 0, 2, testUnitTypeSoldier, "B1", 10, 3).
+		build()
+	cells = game.InfluenceMap()
+	if cell := heatmapAt(cells, 0, 0); cell != nil {
+		t.Errorf("Expected the middle contested, got %+v", cell)
+	}
+	if cell := heatmapAt(cells, -1, 0); cell == nil || cell.Player != 1 || cell.Weight >= 1 {
+		t.Errorf("Expected player 1 ahead but not alone next to its soldier, got %+v", cell)
+	}
+}
+
+// TestRenderOptions_CrossingsAndExhaustedUnits tests that a game renders
+// with its crossings, health bars and only the current player's exhausted
+// units dimmed
+func TestRenderOptions_CrossingsAndExhaustedUnits(t *testing.T) {
+	game := newTestGameBuilder().
+		grassTiles(2).
+		unitFull(0, 0, 1, testUnitTypeSoldier, "A1", 10, 0).
+		unitFull(1, 0, 1, testUnitTypeSoldier, "A2", 10, 3).
+		unitFull(-1, 0, 2, testUnitTypeSoldier, "B1", 10, 0).
+		currentPlayer(1).
+		build()
+	for _, unit := range game.World.UnitsByCoord() {
+		unit.LastToppedupTurn = game.TurnCounter
+	}
+	game.World.SetCrossing(AxialCoord{Q: 0, R: 1}, &v1.Crossing{
+		Type:       v1.CrossingType_CROSSING_TYPE_ROAD,
+		ConnectsTo: []bool{false, true, false, false, false, false},
+	})
+
+	options := game.RenderOptions()
+	if !options.ShowHealthBars {
+		t.Errorf("Expected health bars shown")
+	}
+	if crossing := options.Crossings["0,1"]; len(options.Crossings) != 1 || crossing == nil || !crossing.ConnectsTo[1] {
+		t.Errorf("Expected the road at 0,1 with its connection, got %v", options.Crossings)
+	}
+	if len(options.Exhausted) != 1 || options.Exhausted[0] != (AxialCoord{Q: 0, R: 0}) {
+		t.Errorf("Expected only A1 exhausted, got %v", options.Exhausted)
+	}
+}
+
+// TestThreatMap_OnlyValidAttacks tests that enemies threaten only units the
+// rules let them attack, and nothing at all when they cannot attack any of
+// the player's units
+func TestThreatMap_OnlyValidAttacks(t *testing.T) {
+	// Basic soldiers cannot attack jet fighters
+	const jetfighter int32 = 14
+	game := newTestGameBuilder().
+		grassTiles(5).
+		unitFull(-4, 0, 1, testUnitTypeSoldier, "A1", 10, 3).
+		unitFull(3, 0, 1, jetfighter, "A2", 10, 3).
+		unitFull(4, 0, 2, testUnitTypeSoldier, "B1", 10, 3).
+		currentPlayer(1).
+		build()
+	if _, canAttack := game.RulesEngine.GetCombatPrediction(testUnitTypeSoldier, jetfighter); canAttack {
+		t.Fatalf("Expected the rules to stop soldiers attacking jet fighters")
+	}
+
+	cells, err := game.ThreatMap(1)
+	if err != nil {
+		t.Fatalf("ThreatMap failed: %v", err)
+	}
+	if heatmapAt(cells, 2, 0) == nil || heatmapAt(cells, 3, 0) != nil {
+		t.Errorf("Expected open hexes threatened but not the jet fighter's, got %v", cells)
+	}
+
+	// With only the jet fighter left nothing threatens player 1
+	game.World.RemoveUnit(game.World.UnitAt(AxialCoord{Q: -4, R: 0}))
+	if cells, _ := game.ThreatMap(1); len(cells) != 0 {
+		t.Errorf("Expected no threats to a player the enemy cannot attack, got %v", cells)
+	}
+}
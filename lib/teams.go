@@ -0,0 +1,218 @@
+package lib
+
+import (
+	"sort"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// Team modes supported by GameSettings.TeamMode
+const (
+	// TeamModeFFA - every player for themselves (the default)
+	TeamModeFFA = "ffa"
+
+	// TeamModeTeams - players with the same (non zero) TeamId are allies
+	TeamModeTeams = "teams"
+)
+
+// IsTeamGame returns true if the game is configured for team play
+func IsTeamGame(game *v1.Game) bool {
+	return game != nil && game.Config != nil && game.Config.Settings != nil &&
+		game.Config.Settings.TeamMode == TeamModeTeams
+}
+
+// TeamOf returns the team a player belongs to or 0 if the game is not a team
+// game or the player has no team
+func TeamOf(game *v1.Game, playerId int32) int32 {
+	if !IsTeamGame(game) {
+		return 0
+	}
+	for _, player := range game.Config.Players {
+		if player.PlayerId == playerId {
+			return player.TeamId
+		}
+	}
+	return 0
+}
+
+// AreAllies returns true if two players are the same player or on the same
+// team of a team game
+func AreAllies(game *v1.Game, playerId1, playerId2 int32) bool {
+	if playerId1 == playerId2 {
+		return true
+	}
+	team := TeamOf(game, playerId1)
+	return team > 0 && team == TeamOf(game, playerId2)
+}
+
+// Teammates returns the IDs of all players on the same team as the given
+// player (including the player) sorted by ID.  Outside of team games this is
+// just the player.
+func Teammates(game *v1.Game, playerId int32) (out []int32) {
+	team := TeamOf(game, playerId)
+	if team == 0 {
+		return []int32{playerId}
+	}
+	for _, player := range game.Config.Players {
+		if player.TeamId == team {
+			out = append(out, player.PlayerId)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return
+}
+
+// HasSharedControl returns true if teammates may act with each other's units
+func HasSharedControl(game *v1.Game) bool {
+	return IsTeamGame(game) && game.Config.Settings.SharedControl
+}
+
+// HasSharedCoins returns true if teammates build from a shared coin pool
+func HasSharedCoins(game *v1.Game) bool {
+	return IsTeamGame(game) && game.Config.Settings.SharedCoins
+}
+
+// HasAlliedSupport returns true if units may be healed and fixed by allies
+func HasAlliedSupport(game *v1.Game) bool {
+	return IsTeamGame(game) && game.Config.Settings.AlliedSupport
+}
+
+// HasCombinedVictory returns true if a team wins together
+func HasCombinedVictory(game *v1.Game) bool {
+	return IsTeamGame(game) && game.Config.Settings.CombinedVictory
+}
+
+// CanControlUnit returns true if the current player may act with the unit -
+// their own units or, with shared control, their teammates' units
+func (g *Game) CanControlUnit(unit *v1.Unit) bool {
+	if unit.Player == g.CurrentPlayer {
+		return true
+	}
+	return HasSharedControl(g.Game) && AreAllies(g.Game, unit.Player, g.CurrentPlayer)
+}
+
+// awaitingOwnersTurn returns true if a teammate is acting with a unit whose
+// owner has not had their turn yet this round.  A unit gets one top-up per
+// round at the start of its owner's turn, so until then it only has what was
+// left from the owner's last turn - topping it up early would let it act on
+// the teammate's turn and again on its owner's.  Every turn starts by
+// topping up the incoming player's units (ProcessEndTurn), so a unit not
+// topped up this round tells its owner has not played yet whatever the turn
+// order.
+func (g *Game) awaitingOwnersTurn(unit *v1.Unit) bool {
+	return unit.Player != g.CurrentPlayer && unit.LastToppedupTurn > 0 &&
+		unit.LastToppedupTurn < g.TurnCounter && g.CanControlUnit(unit)
+}
+
+// canSupport returns true if units of the supporter may heal or fix units of
+// the target player (or units may heal on bases owned by the supporter)
+func (g *Game) canSupport(supporter, target int32) bool {
+	if supporter == target {
+		return true
+	}
+	return HasAlliedSupport(g.Game) && AreAllies(g.Game, supporter, target)
+}
+
+// coinPool returns the coins available to a player for building - their own
+// coins or, with shared coins, the combined coins of their team
+func (g *Game) coinPool(playerId int32) int32 {
+	if !HasSharedCoins(g.Game) {
+		if ps := g.GameState.PlayerStates[playerId]; ps != nil {
+			return ps.Coins
+		}
+		return 0
+	}
+	total := int32(0)
+	for _, mate := range Teammates(g.Game, playerId) {
+		if ps := g.GameState.PlayerStates[mate]; ps != nil {
+			total += ps.Coins
+		}
+	}
+	return total
+}
+
+// spendCoins deducts an amount from a player's coins returning a CoinsChanged
+// change for every player charged.  With shared coins the player pays first
+// and any shortfall is taken from teammates in player order.
+func (g *Game) spendCoins(playerId int32, amount int32, reason string) (changes []*v1.WorldChange) {
+	payers := []int32{playerId}
+	if HasSharedCoins(g.Game) {
+		for _, mate := range Teammates(g.Game, playerId) {
+			if mate != playerId {
+				payers = append(payers, mate)
+			}
+		}
+	}
+	for _, payer := range payers {
+		if amount <= 0 {
+			break
+		}
+		ps := g.GameState.PlayerStates[payer]
+		if ps == nil || ps.Coins <= 0 {
+			continue
+		}
+		paid := min(amount, ps.Coins)
+		previous := ps.Coins
+		ps.Coins -= paid
+		amount -= paid
+		changes = append(changes, &v1.WorldChange{
+			ChangeType: &v1.WorldChange_CoinsChanged{
+				CoinsChanged: &v1.CoinsChangedChange{
+					PlayerId:      payer,
+					PreviousCoins: previous,
+					NewCoins:      ps.Coins,
+					Reason:        reason,
+				},
+			},
+		})
+	}
+	return
+}
+
+// winningTeam returns the team whose players are the only ones with units
+// left, or 0 if there are players of other (or no) teams left too
+func (g *Game) winningTeam(playersWithUnits []int32) int32 {
+	if !IsTeamGame(g.Game) || len(playersWithUnits) == 0 {
+		return 0
+	}
+	team := TeamOf(g.Game, playersWithUnits[0])
+	for _, playerId := range playersWithUnits[1:] {
+		if TeamOf(g.Game, playerId) != team {
+			return 0
+		}
+	}
+	return team
+}
+
+// teamWinner picks the winner among the surviving players of a winning
+// team.  With combined victory the team wins together and its first player
+// stands for it; otherwise the player with the most units left wins, the
+// lower player ID on a tie.  Allies cannot attack each other, so the game
+// ends here rather than waiting for one of them to be the last.
+func (g *Game) teamWinner(playersWithUnits []int32) int32 {
+	if HasCombinedVictory(g.Game) {
+		return playersWithUnits[0]
+	}
+	winner, most := playersWithUnits[0], -1
+	for _, playerId := range playersWithUnits {
+		if count := len(g.World.GetPlayerUnits(int(playerId))); count > most {
+			winner, most = playerId, count
+		}
+	}
+	return winner
+}
+
+// withoutAllies filters attack positions holding units allied to the attacker
+func (g *Game) withoutAllies(attacker *v1.Unit, coords []AxialCoord) []AxialCoord {
+	if !IsTeamGame(g.Game) {
+		return coords
+	}
+	out := coords[:0]
+	for _, coord := range coords {
+		if target := g.World.UnitAt(coord); target != nil && AreAllies(g.Game, attacker.Player, target.Player) {
+			continue
+		}
+		out = append(out, coord)
+	}
+	return out
+}
@@ -0,0 +1,276 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// newTeamGameBuilder creates a 4 player builder for a 2v2 game with players
+// 1 and 3 on team 1 and players 2 and 4 on team 2
+func newTeamGameBuilder() *testGameBuilder {
+	b := newTestGameBuilder()
+	b.numPlayers = 4
+	return b
+}
+
+// asTeams puts a built game into team mode with the given settings
+func asTeams(game *Game, settings *v1.GameSettings) *Game {
+	settings.TeamMode = TeamModeTeams
+	game.Config.Settings = settings
+	for _, player := range game.Config.Players {
+		player.TeamId = 2 - player.PlayerId%2
+	}
+	return game
+}
+
+// TestAreAllies tests team membership outside and inside team mode
+func TestAreAllies(t *testing.T) {
+	game := newTeamGameBuilder().build()
+	for _, player := range game.Config.Players {
+		player.TeamId = 2 - player.PlayerId%2
+	}
+	if AreAllies(game.Game, 1, 3) {
+		t.Error("Players should not be allies outside team mode")
+	}
+
+	asTeams(game, &v1.GameSettings{})
+	if !AreAllies(game.Game, 1, 3) || !AreAllies(game.Game, 2, 4) {
+		t.Error("Players on the same team should be allies")
+	}
+	if AreAllies(game.Game, 1, 2) {
+		t.Error("Players on different teams should not be allies")
+	}
+	if !game.ArePlayersOnSameTeam(1, 3) || game.ArePlayersOnSameTeam(3, 4) {
+		t.Error("ArePlayersOnSameTeam should match team membership")
+	}
+
+	game.Config.Players[2].TeamId = 0
+	if AreAllies(game.Game, 1, 3) {
+		t.Error("Team 0 means no team")
+	}
+	if got := Teammates(game.Game, 2); len(got) != 2 || got[0] != 2 || got[1] != 4 {
+		t.Errorf("Expected teammates [2 4], got %v", got)
+	}
+}
+
+// TestTeams_SharedControl tests that a teammate's units can only be moved
+// with shared control
+func TestTeams_SharedControl(t *testing.T) {
+	for _, shared := range []bool{false, true} {
+		game := asTeams(newTeamGameBuilder().
+			grassTiles(3).
+			unit(0, 0, 3, testUnitTypeSoldier).
+			currentPlayer(1).
+			build(), &v1.GameSettings{SharedControl: shared})
+
+		err := game.ProcessMove(moveOrder(0, 0, 1, 0))
+		if shared && err != nil {
+			t.Errorf("Moving an allied unit with shared control failed: %v", err)
+		}
+		if !shared && err == nil {
+			t.Error("Moving an allied unit without shared control should fail")
+		}
+	}
+}
+
+// TestTeams_SharedControlOncePerRound tests that a teammate acting with an
+// ally's unit before the owner's turn does not top it up, so the unit acts
+// once between its owner's turns
+func TestTeams_SharedControlOncePerRound(t *testing.T) {
+	game := asTeams(newTeamGameBuilder().
+		grassTiles(3).
+		unitFull(0, 0, 3, testUnitTypeSoldier, "", 10, 0).
+		currentPlayer(1).
+		build(), &v1.GameSettings{SharedControl: true})
+	game.TurnCounter = 2
+	unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	unit.LastToppedupTurn = 1 // Used up on player 3's turn last round
+
+	if err := game.ProcessMove(moveOrder(0, 0, 1, 0)); err == nil {
+		t.Fatal("Player 1 should not be able to move a unit player 3 used up last round")
+	}
+	if unit.DistanceLeft != 0 || unit.LastToppedupTurn != 1 {
+		t.Errorf("Unit should not be topped up before its owner's turn, got distance %v topped up %d",
+			unit.DistanceLeft, unit.LastToppedupTurn)
+	}
+
+	// Player 3's turn tops it up as usual
+	for game.CurrentPlayer != 3 {
+		if err := game.ProcessMove(&v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}); err != nil {
+			t.Fatalf("EndTurn failed: %v", err)
+		}
+	}
+	if unit.DistanceLeft <= 0 || unit.LastToppedupTurn != 2 {
+		t.Errorf("Unit should be topped up on its owner's turn, got distance %v topped up %d",
+			unit.DistanceLeft, unit.LastToppedupTurn)
+	}
+	if err := game.ProcessMove(moveOrder(0, 0, 1, 0)); err != nil {
+		t.Errorf("Player 3 should be able to move their topped up unit: %v", err)
+	}
+}
+
+// TestTeams_SharedControlAnyTurnOrder tests that whether a unit's owner has
+// played this round comes from the unit's top-up rather than player IDs, so
+// a lower numbered owner that has not played yet still waits for their turn
+func TestTeams_SharedControlAnyTurnOrder(t *testing.T) {
+	game := asTeams(newTeamGameBuilder().
+		grassTiles(3).
+		unitFull(0, 0, 1, testUnitTypeSoldier, "", 10, 0).
+		currentPlayer(3).
+		build(), &v1.GameSettings{SharedControl: true})
+	game.TurnCounter = 2
+	unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	unit.LastToppedupTurn = 1 // Player 1 has not been topped up this round
+
+	if err := game.ProcessMove(moveOrder(0, 0, 1, 0)); err == nil {
+		t.Fatal("Player 3 should not be able to move a unit player 1 has not played this round")
+	}
+	if unit.DistanceLeft != 0 || unit.LastToppedupTurn != 1 {
+		t.Errorf("Unit should not be topped up before its owner's turn, got distance %v topped up %d",
+			unit.DistanceLeft, unit.LastToppedupTurn)
+	}
+
+	// Once player 1 has played this round the unit is topped up for player 3
+	unit.LastToppedupTurn = 2
+	unit.DistanceLeft = 3
+	if err := game.ProcessMove(moveOrder(0, 0, 1, 0)); err != nil {
+		t.Errorf("Player 3 should be able to move a unit player 1 left moves on: %v", err)
+	}
+}
+
+// TestTeams_CannotAttackAllies tests that allied units are not attack targets
+func TestTeams_CannotAttackAllies(t *testing.T) {
+	game := asTeams(newTeamGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(1, 0, 3, testUnitTypeSoldier).
+		unit(-1, 0, 2, testUnitTypeSoldier).
+		currentPlayer(1).
+		build(), &v1.GameSettings{})
+
+	attacker := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	if game.CanAttackUnit(attacker, game.World.UnitAt(AxialCoord{Q: 1, R: 0})) {
+		t.Error("Should not be able to attack an allied unit")
+	}
+	if !game.CanAttackUnit(attacker, game.World.UnitAt(AxialCoord{Q: -1, R: 0})) {
+		t.Error("Should be able to attack an enemy unit")
+	}
+
+	options, err := game.GetUnitAttackOptions(attacker)
+	if err != nil {
+		t.Fatalf("GetUnitAttackOptions failed: %v", err)
+	}
+	if len(options) != 1 || options[0] != (AxialCoord{Q: -1, R: 0}) {
+		t.Errorf("Only the enemy should be an attack option, got %v", options)
+	}
+}
+
+// TestTeams_SharedCoins tests building from the team's coin pool
+func TestTeams_SharedCoins(t *testing.T) {
+	game := asTeams(newTeamGameBuilder().
+		tile(0, 0, TileTypeLandBase, 1).
+		coins(1, 50).
+		coins(3, 100).
+		currentPlayer(1).
+		build(), &v1.GameSettings{SharedCoins: true})
+
+	move := &v1.GameMove{
+		MoveType: &v1.GameMove_BuildUnit{
+			BuildUnit: &v1.BuildUnitAction{
+				Pos:      &v1.Position{Q: 0, R: 0},
+				UnitType: testUnitTypeSoldier, // Costs 75
+			},
+		},
+	}
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("Building from the shared pool failed: %v", err)
+	}
+
+	if got := game.GameState.PlayerStates[1].Coins; got != 0 {
+		t.Errorf("Builder should pay first, expected 0 coins, got %d", got)
+	}
+	if got := game.GameState.PlayerStates[3].Coins; got != 75 {
+		t.Errorf("Teammate should cover the shortfall, expected 75 coins, got %d", got)
+	}
+
+	charged := map[int32]bool{}
+	for _, change := range move.Changes {
+		if cc := change.GetCoinsChanged(); cc != nil {
+			charged[cc.PlayerId] = true
+		}
+	}
+	if len(charged) != 2 || !charged[1] || !charged[3] {
+		t.Errorf("Expected coin changes for players 1 and 3, got %v", charged)
+	}
+}
+
+// TestTeams_AlliedSupport tests healing on an allied base
+func TestTeams_AlliedSupport(t *testing.T) {
+	for _, support := range []bool{false, true} {
+		game := asTeams(newTeamGameBuilder().
+			tile(0, 0, TileTypeLandBase, 3).
+			unitFull(0, 0, 1, testUnitTypeSoldier, "A1", 5, 3).
+			build(), &v1.GameSettings{AlliedSupport: support})
+		game.TurnCounter = 3
+
+		unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+		unitData, _ := game.RulesEngine.GetUnitData(unit.UnitType)
+		healed := game.calculateHealAmount(unit, unitData)
+		if support && healed <= 0 {
+			t.Error("Unit should heal on an allied base with allied support")
+		}
+		if !support && healed != 0 {
+			t.Errorf("Unit should not heal on an allied base without allied support, got %d", healed)
+		}
+	}
+}
+
+// TestTeams_CombinedVictory tests that a team wins once only its players
+// have units left
+func TestTeams_CombinedVictory(t *testing.T) {
+	game := asTeams(newTeamGameBuilder().
+		grassTiles(3).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 3, testUnitTypeSoldier).
+		currentPlayer(1).
+		build(), &v1.GameSettings{CombinedVictory: true})
+
+	move := &v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+	if !game.GameState.Finished {
+		t.Fatal("Game should be finished once only team 1 has units")
+	}
+	if game.GameState.WinningTeam != 1 {
+		t.Errorf("Expected team 1 to win, got %d", game.GameState.WinningTeam)
+	}
+}
+
+// TestTeams_TeamEliminatedWithoutCombinedVictory tests that a team game
+// still ends when one team is wiped out, with the ally holding the most
+// units as the winner
+func TestTeams_TeamEliminatedWithoutCombinedVictory(t *testing.T) {
+	game := asTeams(newTeamGameBuilder().
+		grassTiles(4).
+		unit(0, 0, 1, testUnitTypeSoldier).
+		unit(2, 0, 3, testUnitTypeSoldier).
+		unit(3, 0, 3, testUnitTypeSoldier).
+		currentPlayer(1).
+		build(), &v1.GameSettings{})
+
+	move := &v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	if err := game.ProcessMove(move); err != nil {
+		t.Fatalf("ProcessMove failed: %v", err)
+	}
+	if !game.GameState.Finished {
+		t.Fatal("Game should be finished once only team 1 has units")
+	}
+	if game.GameState.WinningTeam != 1 {
+		t.Errorf("Expected team 1 to win, got %d", game.GameState.WinningTeam)
+	}
+	if game.GameState.WinningPlayer != 3 {
+		t.Errorf("Expected player 3 with the most units to win, got %d", game.GameState.WinningPlayer)
+	}
+}
@@ -0,0 +1,130 @@
+package lib
+
+import (
+	"sort"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// Layers of world data a diff or merge reports changes in
+const (
+	WorldLayerTile     = "tile"
+	WorldLayerUnit     = "unit"
+	WorldLayerCrossing = "crossing"
+)
+
+// WorldMerge is the result of a three-way merge of world data
+type WorldMerge struct {
+	// The merged data.  Conflicts are resolved by the merge strategy, or in
+	// favour of ours when there is none.
+	Data *v1.WorldData
+
+	// Changes from theirs taken into the merged data
+	Applied []*v1.WorldCellChange
+
+	// Cells ours and theirs both changed, differently
+	Conflicts []*v1.WorldMergeConflict
+}
+
+// DiffWorldData lists the cells of tiles, units and crossings that differ
+// between two versions of world data, ordered by layer and key
+func DiffWorldData(from, to *v1.WorldData) []*v1.WorldCellChange {
+	var changes []*v1.WorldCellChange
+	changes = append(changes, diffLayer(WorldLayerTile, from.GetTilesMap(), to.GetTilesMap())...)
+	changes = append(changes, diffLayer(WorldLayerUnit, from.GetUnitsMap(), to.GetUnitsMap())...)
+	changes = append(changes, diffLayer(WorldLayerCrossing, from.GetCrossings(), to.GetCrossings())...)
+	return changes
+}
+
+// MergeWorldData merges the changes ours and theirs each made to base, cell
+// by cell.  A cell only one side changed takes that side's change; a cell
+// both changed the same way takes the change once; a cell both changed
+// differently is a conflict settled by strategy.  The merged data has ours'
+// version so it can be saved over ours.
+func MergeWorldData(base, ours, theirs *v1.WorldData, strategy v1.WorldMergeStrategy) *WorldMerge {
+	merge := &WorldMerge{Data: &v1.WorldData{Version: ours.GetVersion()}}
+	merge.Data.TilesMap = mergeLayer(merge, WorldLayerTile, base.GetTilesMap(), ours.GetTilesMap(), theirs.GetTilesMap(), strategy)
+	merge.Data.UnitsMap = mergeLayer(merge, WorldLayerUnit, base.GetUnitsMap(), ours.GetUnitsMap(), theirs.GetUnitsMap(), strategy)
+	merge.Data.Crossings = mergeLayer(merge, WorldLayerCrossing, base.GetCrossings(), ours.GetCrossings(), theirs.GetCrossings(), strategy)
+	return merge
+}
+
+func diffLayer[T proto.Message](layer string, from, to map[string]T) []*v1.WorldCellChange {
+	var changes []*v1.WorldCellChange
+	for _, key := range layerKeys(from, to) {
+		if kind := cellChange(from, to, key); kind != v1.WorldChangeKind_WORLD_CHANGE_KIND_UNSPECIFIED {
+			changes = append(changes, &v1.WorldCellChange{Layer: layer, Key: key, Kind: kind})
+		}
+	}
+	return changes
+}
+
+func mergeLayer[T proto.Message](merge *WorldMerge, layer string, base, ours, theirs map[string]T, strategy v1.WorldMergeStrategy) map[string]T {
+	merged := map[string]T{}
+	for _, key := range layerKeys(base, ours, theirs) {
+		oursChange := cellChange(base, ours, key)
+		theirsChange := cellChange(base, theirs, key)
+
+		from := ours
+		switch {
+		case theirsChange == v1.WorldChangeKind_WORLD_CHANGE_KIND_UNSPECIFIED || sameCell(ours, theirs, key):
+		case oursChange == v1.WorldChangeKind_WORLD_CHANGE_KIND_UNSPECIFIED:
+			from = theirs
+			merge.Applied = append(merge.Applied, &v1.WorldCellChange{Layer: layer, Key: key, Kind: theirsChange})
+		default:
+			merge.Conflicts = append(merge.Conflicts, &v1.WorldMergeConflict{
+				Layer: layer, Key: key, Ours: oursChange, Theirs: theirsChange,
+			})
+			if strategy == v1.WorldMergeStrategy_WORLD_MERGE_STRATEGY_THEIRS {
+				from = theirs
+			}
+		}
+		if cell, ok := from[key]; ok {
+			merged[key] = proto.Clone(cell).(T)
+		}
+	}
+	return merged
+}
+
+// cellChange returns how a cell changed from one version of a layer to
+// another, or UNSPECIFIED if it did not
+func cellChange[T proto.Message](from, to map[string]T, key string) v1.WorldChangeKind {
+	_, inFrom := from[key]
+	_, inTo := to[key]
+	switch {
+	case !inFrom && inTo:
+		return v1.WorldChangeKind_WORLD_CHANGE_KIND_ADDED
+	case inFrom && !inTo:
+		return v1.WorldChangeKind_WORLD_CHANGE_KIND_REMOVED
+	case inFrom && !sameCell(from, to, key):
+		return v1.WorldChangeKind_WORLD_CHANGE_KIND_MODIFIED
+	}
+	return v1.WorldChangeKind_WORLD_CHANGE_KIND_UNSPECIFIED
+}
+
+// sameCell reports whether a cell is the same (or absent) in both layers
+func sameCell[T proto.Message](a, b map[string]T, key string) bool {
+	cellA, inA := a[key]
+	cellB, inB := b[key]
+	if inA != inB {
+		return false
+	}
+	return !inA || proto.Equal(cellA, cellB)
+}
+
+// layerKeys returns the keys of every given layer, sorted
+func layerKeys[T any](layers ...map[string]T) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, layer := range layers {
+		for key := range layer {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,106 @@
+package lib
+
+import (
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+func mergeTestData(version int64, tiles map[string]int32, units map[string]int32) *v1.WorldData {
+	data := &v1.WorldData{Version: version, TilesMap: map[string]*v1.Tile{}, UnitsMap: map[string]*v1.Unit{}}
+	for key, tileType := range tiles {
+		data.TilesMap[key] = &v1.Tile{TileType: tileType}
+	}
+	for key, unitType := range units {
+		data.UnitsMap[key] = &v1.Unit{UnitType: unitType, Player: 1}
+	}
+	return data
+}
+
+// TestMergeWorldData_TakesNonConflictingChanges tests that changes only one
+// side made are all kept
+func TestMergeWorldData_TakesNonConflictingChanges(t *testing.T) {
+	base := mergeTestData(1, map[string]int32{"0,0": 5, "1,0": 5, "2,0": 5}, map[string]int32{"0,0": 1})
+	// Ours repaints a tile and adds a unit
+	ours := mergeTestData(4, map[string]int32{"0,0": 10, "1,0": 5, "2,0": 5}, map[string]int32{"0,0": 1, "1,0": 2})
+	// Theirs removes a tile, adds one and removes the unit
+	theirs := mergeTestData(2, map[string]int32{"0,0": 5, "1,0": 5, "3,0": 22}, nil)
+
+	merge := MergeWorldData(base, ours, theirs, v1.WorldMergeStrategy_WORLD_MERGE_STRATEGY_UNSPECIFIED)
+	if len(merge.Conflicts) != 0 {
+		t.Fatalf("Expected no conflicts, got %v", merge.Conflicts)
+	}
+	if merge.Data.Version != 4 {
+		t.Errorf("Merged data should keep our version, got %d", merge.Data.Version)
+	}
+	tiles := merge.Data.TilesMap
+	if len(tiles) != 3 || tiles["0,0"].TileType != 10 || tiles["3,0"].TileType != 22 || tiles["2,0"] != nil {
+		t.Errorf("Unexpected merged tiles: %v", tiles)
+	}
+	units := merge.Data.UnitsMap
+	if len(units) != 1 || units["1,0"].GetUnitType() != 2 {
+		t.Errorf("Unexpected merged units: %v", units)
+	}
+	if len(merge.Applied) != 3 {
+		t.Errorf("Expected theirs' 3 changes to be applied, got %v", merge.Applied)
+	}
+}
+
+// TestMergeWorldData_Conflicts tests that cells both sides changed
+// differently are reported and settled by the strategy
+func TestMergeWorldData_Conflicts(t *testing.T) {
+	base := mergeTestData(1, map[string]int32{"0,0": 5, "1,0": 5}, nil)
+	ours := mergeTestData(2, map[string]int32{"0,0": 10, "1,0": 14}, nil)
+	theirs := mergeTestData(2, map[string]int32{"0,0": 22, "1,0": 14}, nil)
+
+	for strategy, want := range map[v1.WorldMergeStrategy]int32{
+		v1.WorldMergeStrategy_WORLD_MERGE_STRATEGY_UNSPECIFIED: 10,
+		v1.WorldMergeStrategy_WORLD_MERGE_STRATEGY_OURS:        10,
+		v1.WorldMergeStrategy_WORLD_MERGE_STRATEGY_THEIRS:      22,
+	} {
+		merge := MergeWorldData(base, ours, theirs, strategy)
+		// Both sides made the same change to 1,0 so it does not conflict
+		if len(merge.Conflicts) != 1 {
+			t.Fatalf("%v: expected 1 conflict, got %v", strategy, merge.Conflicts)
+		}
+		conflict := merge.Conflicts[0]
+		if conflict.Layer != WorldLayerTile || conflict.Key != "0,0" ||
+			conflict.Ours != v1.WorldChangeKind_WORLD_CHANGE_KIND_MODIFIED ||
+			conflict.Theirs != v1.WorldChangeKind_WORLD_CHANGE_KIND_MODIFIED {
+			t.Errorf("%v: unexpected conflict %v", strategy, conflict)
+		}
+		if got := merge.Data.TilesMap["0,0"].TileType; got != want {
+			t.Errorf("%v: conflicting tile should be %d, got %d", strategy, want, got)
+		}
+		if merge.Data.TilesMap["1,0"].TileType != 14 || len(merge.Applied) != 0 {
+			t.Errorf("%v: a change both sides made should be kept once", strategy)
+		}
+	}
+}
+
+// TestDiffWorldData tests that a diff reports added, removed and modified
+// cells of every layer
+func TestDiffWorldData(t *testing.T) {
+	from := mergeTestData(1, map[string]int32{"0,0": 5, "1,0": 5}, map[string]int32{"0,0": 1})
+	to := mergeTestData(2, map[string]int32{"0,0": 10, "2,0": 5}, map[string]int32{"0,0": 1})
+	to.Crossings = map[string]*v1.Crossing{"2,0": {Type: v1.CrossingType_CROSSING_TYPE_ROAD}}
+
+	changes := DiffWorldData(from, to)
+	want := []struct {
+		layer, key string
+		kind       v1.WorldChangeKind
+	}{
+		{WorldLayerTile, "0,0", v1.WorldChangeKind_WORLD_CHANGE_KIND_MODIFIED},
+		{WorldLayerTile, "1,0", v1.WorldChangeKind_WORLD_CHANGE_KIND_REMOVED},
+		{WorldLayerTile, "2,0", v1.WorldChangeKind_WORLD_CHANGE_KIND_ADDED},
+		{WorldLayerCrossing, "2,0", v1.WorldChangeKind_WORLD_CHANGE_KIND_ADDED},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("Expected %d changes, got %v", len(want), changes)
+	}
+	for i, w := range want {
+		if c := changes[i]; c.Layer != w.layer || c.Key != w.key || c.Kind != w.kind {
+			t.Errorf("Change %d: expected %v, got %v", i, w, c)
+		}
+	}
+}
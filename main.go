@@ -7,6 +7,8 @@ import (
 	"log"
 	"log/slog"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"cloud.google.com/go/datastore"
 	"github.com/joho/godotenv"
@@ -19,6 +21,7 @@ import (
 	"github.com/turnforge/lilbattle/services/r2"
 	"github.com/turnforge/lilbattle/services/server"
 	"github.com/turnforge/lilbattle/utils"
+	"github.com/turnforge/lilbattle/web/assets/themes"
 	web "github.com/turnforge/lilbattle/web/server"
 	"google.golang.org/grpc"
 	"gorm.io/gorm"
@@ -30,13 +33,23 @@ var (
 	grpcAddress       = flag.String("grpcAddress", DefaultServiceAddress(), "Address where the gRPC endpoint is running")
 	gatewayAddress    = flag.String("gatewayAddress", DefaultGatewayAddress(), "Address where the http grpc gateway endpoint is running")
 	db_endpoint       = flag.String("db_endpoint", "", fmt.Sprintf("Endpoint of DB where all data is persisted.  Default value: LILBATTLE_DB_ENDPOINT environment variable or %s", DEFAULT_DB_ENDPOINT))
-	worlds_service_be = flag.String("worlds_service_be", "", "Storage for worlds service - 'local', 'pg', 'gae'. Env: WORLDS_SERVICE_BE. Default: pg")
-	games_service_be  = flag.String("games_service_be", "", "Storage for games service - 'local', 'pg', 'gae'. Env: GAMES_SERVICE_BE. Default: pg")
+	worlds_service_be = flag.String("worlds_service_be", "", "Storage for worlds service - 'local', 'pg', 'sqlite', 'gae'. Env: WORLDS_SERVICE_BE. Default: pg")
+	games_service_be  = flag.String("games_service_be", "", "Storage for games service - 'local', 'pg', 'sqlite', 'gae'. Env: GAMES_SERVICE_BE. Default: pg")
+	sqlite_path       = flag.String("sqlite_path", "", "Path of the SQLite database for the 'sqlite' backends. Env: LILBATTLE_SQLITE_PATH. Default: ~/dev-app-data/lilbattle/storage/lilbattle.db")
 	filestore_be      = flag.String("filestore_be", "", "Storage for filestore - 'local', 'r2', 'gae'. Env: FILESTORE_BE. Default: local")
 	gae_project       = flag.String("gae_project", "", "Google Cloud project ID for GAE/Datastore. Env: GAE_PROJECT")
 	gae_namespace     = flag.String("gae_namespace", "", "Datastore namespace (optional, for multi-tenancy). Env: GAE_NAMESPACE")
 )
 
+// sqliteBackends opens the 'sqlite' backends.  The SQLite driver needs CGO
+// so they are only built in with -tags sqlite (see main_sqlite.go) and this
+// is nil otherwise.
+var sqliteBackends interface {
+	OpenDB(path string) *gorm.DB
+	NewWorldsService(db *gorm.DB, clientMgr *services.ClientMgr) (*gormbe.WorldsService, error)
+	NewGamesService(db *gorm.DB, clientMgr *services.ClientMgr) (*gormbe.GamesService, error)
+}
+
 // getBackendConfig returns the backend configuration value with priority:
 // command line flag -> environment variable -> default value
 func getBackendConfig(flagValue *string, envVar string, defaultValue string) string {
@@ -52,6 +65,69 @@ func getBackendConfig(flagValue *string, envVar string, defaultValue string) str
 	return defaultValue
 }
 
+// newJanitor creates the janitor cleaning up abandoned games and orphaned
+// files from the LILBATTLE_JANITOR_* environment variables:
+//
+//	LILBATTLE_JANITOR_INTERVAL         how often it runs, eg 24h (unset: never)
+//	LILBATTLE_JANITOR_DRY_RUN          false to act rather than only report (default true)
+//	LILBATTLE_JANITOR_UNSTARTED_GAMES  rule for games with open seats and no moves, eg archive:30d
+//	LILBATTLE_JANITOR_INACTIVE_GAMES   rule for games with no move for a while, eg archive:180d
+//	LILBATTLE_JANITOR_ORPHAN_FILES     age after which orphaned screenshots are deleted, eg 7d
+//
+// Returns nil when the janitor is not enabled.
+func newJanitor(games v1s.GamesServiceServer, worlds v1s.WorldsServiceServer, files v1s.FileStoreServiceServer) *services.Janitor {
+	interval, err := services.ParseRetentionAge(os.Getenv("LILBATTLE_JANITOR_INTERVAL"))
+	if err != nil {
+		panic(fmt.Sprintf("Invalid LILBATTLE_JANITOR_INTERVAL: %v", err))
+	}
+	if interval == 0 {
+		return nil
+	}
+	var policy services.RetentionPolicy
+	if policy.Unstarted, err = services.ParseRetentionRule(os.Getenv("LILBATTLE_JANITOR_UNSTARTED_GAMES")); err != nil {
+		panic(fmt.Sprintf("Invalid LILBATTLE_JANITOR_UNSTARTED_GAMES: %v", err))
+	}
+	if policy.Inactive, err = services.ParseRetentionRule(os.Getenv("LILBATTLE_JANITOR_INACTIVE_GAMES")); err != nil {
+		panic(fmt.Sprintf("Invalid LILBATTLE_JANITOR_INACTIVE_GAMES: %v", err))
+	}
+	if policy.OrphanFilesAfter, err = services.ParseRetentionAge(os.Getenv("LILBATTLE_JANITOR_ORPHAN_FILES")); err != nil {
+		panic(fmt.Sprintf("Invalid LILBATTLE_JANITOR_ORPHAN_FILES: %v", err))
+	}
+	janitor := services.NewJanitor(games, worlds, files, policy)
+	janitor.Interval = interval
+	janitor.DryRun = os.Getenv("LILBATTLE_JANITOR_DRY_RUN") != "false"
+	log.Printf("Janitor enabled: every %s, dry run %v, policy %+v", interval, janitor.DryRun, policy)
+	return janitor
+}
+
+// loadThemePacks registers the theme packs in LILBATTLE_THEMES_DIR (each a
+// subdirectory with a mapping.json and its assets) and adds them to the
+// themes screenshots are rendered in.  Broken packs are logged and skipped.
+func loadThemePacks() {
+	dir := os.Getenv("LILBATTLE_THEMES_DIR")
+	if dir == "" {
+		return
+	}
+	names, err := themes.LoadThemePacks(dir)
+	if err != nil {
+		log.Printf("Failed to load theme packs: %v", err)
+	}
+	services.ScreenshotThemes = append(services.ScreenshotThemes, names...)
+	log.Printf("Theme packs loaded from %s: %v", dir, names)
+}
+
+// operators returns the user IDs in LILBATTLE_OPERATORS (comma separated)
+// allowed to manage the server's background jobs.  Unset lets nobody.
+func operators() []string {
+	var ids []string
+	for _, id := range strings.Split(os.Getenv("LILBATTLE_OPERATORS"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 type Backend struct {
 	GrpcAddress    string
 	GatewayAddress string
@@ -62,6 +138,7 @@ type Backend struct {
 
 func main() {
 	parseFlags()
+	loadThemePacks()
 
 	backend := Backend{GrpcAddress: *grpcAddress, GatewayAddress: *gatewayAddress}
 	backend.SetupApp()
@@ -127,6 +204,9 @@ func (b *Backend) SetupApp() *utils.App {
 			"/lilbattle.v1.WorldsService/ListWorlds",
 			"/lilbattle.v1.WorldsService/GetWorld",
 			"/lilbattle.v1.WorldsService/GetWorlds",
+			"/lilbattle.v1.WorldsService/ListWorldRevisions",
+			"/lilbattle.v1.WorldsService/ListDerivedWorlds",
+			"/lilbattle.v1.WorldsService/SearchWorlds",
 			// Games - allow browsing without login
 			"/lilbattle.v1.GamesService/ListGames",
 			"/lilbattle.v1.GamesService/GetGame",
@@ -158,6 +238,17 @@ func (b *Backend) SetupApp() *utils.App {
 			return db
 		}
 
+		var sqliteDB *gorm.DB = nil
+		ensureSQLite := func() *gorm.DB {
+			if sqliteBackends == nil {
+				panic("The sqlite backends are not built in - build with -tags sqlite (needs CGO)")
+			}
+			if sqliteDB == nil {
+				sqliteDB = sqliteBackends.OpenDB(getBackendConfig(sqlite_path, "LILBATTLE_SQLITE_PATH", ""))
+			}
+			return sqliteDB
+		}
+
 		var dsClient *datastore.Client = nil
 		ensureDatastore := func() *datastore.Client {
 			if dsClient == nil {
@@ -184,23 +275,55 @@ func (b *Backend) SetupApp() *utils.App {
 		switch worldsBE {
 		case "pg":
 			worldsService = gormbe.NewWorldsService(ensureDB(), clientMgr)
+		case "sqlite":
+			sqliteWorlds, err := sqliteBackends.NewWorldsService(ensureSQLite(), clientMgr)
+			if err != nil {
+				panic(fmt.Sprintf("Failed to create SQLite worlds service: %v", err))
+			}
+			worldsService = sqliteWorlds
 		case "local":
 			worldsService = fsbe.NewFSWorldsService("", clientMgr)
 		case "gae":
 			worldsService = gaebe.NewWorldsService(ensureDatastore(), dsNamespace, clientMgr)
 		default:
-			panic("Invalid worlds_service_be: " + worldsBE + ". Valid options: local, pg, gae")
+			panic("Invalid worlds_service_be: " + worldsBE + ". Valid options: local, pg, sqlite, gae")
 		}
 
+		// Background jobs and index states are kept with the games
+		var jobStore services.JobStore
+		var indexStateStore services.IndexStateStore
 		switch gamesBE {
 		case "local":
 			gamesService = fsbe.NewFSGamesService("", clientMgr)
+			jobStore = fsbe.NewFSJobStore("")
+			indexStateStore = fsbe.NewFSIndexStateStore("")
 		case "pg":
 			gamesService = gormbe.NewGamesService(ensureDB(), clientMgr)
+			jobStore = gormbe.NewJobStore(ensureDB())
+			indexStateStore = gormbe.NewIndexStateStore(ensureDB())
+		case "sqlite":
+			sqliteGames, err := sqliteBackends.NewGamesService(ensureSQLite(), clientMgr)
+			if err != nil {
+				panic(fmt.Sprintf("Failed to create SQLite games service: %v", err))
+			}
+			gamesService = sqliteGames
+			// The SQLite stores are the gormbe ones over the SQLite database
+			jobStore = gormbe.NewJobStore(ensureSQLite())
+			indexStateStore = gormbe.NewIndexStateStore(ensureSQLite())
 		case "gae":
 			gamesService = gaebe.NewGamesService(ensureDatastore(), dsNamespace, clientMgr)
+			jobStore = gaebe.NewJobStore(ensureDatastore(), dsNamespace)
+			indexStateStore = gaebe.NewIndexStateStore(ensureDatastore(), dsNamespace)
 		default:
-			panic("Invalid games_service_be: " + gamesBE + ". Valid options: local, pg, gae")
+			panic("Invalid games_service_be: " + gamesBE + ". Valid options: local, pg, sqlite, gae")
+		}
+
+		// "Your turn" emails and webhooks - hooked into OnMovesSaved of all backends
+		notifier := web.NewTurnNotifier()
+		if notifiable, ok := gamesService.(interface {
+			InitializeTurnNotifications(*services.TurnNotifier)
+		}); ok {
+			notifiable.InitializeTurnNotifications(notifier)
 		}
 
 		switch filestoreBE {
@@ -224,6 +347,77 @@ func (b *Backend) SetupApp() *utils.App {
 			panic("Invalid filestore_be: " + filestoreBE + ". Valid options: local, r2, gae")
 		}
 
+		jobRunner := services.NewJobRunner(jobStore)
+
+		// Turn notifications and screenshots are delivered and rendered as
+		// jobs so neither is lost on a restart
+		if notifier != nil {
+			notifier.UseJobRunner(jobRunner)
+		}
+		for _, service := range []any{worldsService, gamesService} {
+			if screenshots, ok := service.(interface {
+				InitializeScreenshotJobs(*services.JobRunner)
+			}); ok {
+				screenshots.InitializeScreenshotJobs(jobRunner)
+			}
+		}
+
+		// Simultaneous turns forfeit players who miss the orders deadline
+		if deadlines, ok := gamesService.(interface {
+			InitializeOrdersDeadlines(*services.JobRunner)
+		}); ok {
+			deadlines.InitializeOrdersDeadlines(jobRunner)
+		}
+
+		// Index games created before the user game index existed
+		if backfill, ok := gamesService.(interface {
+			InitializeUserGameBackfill(context.Context, *services.JobRunner) error
+		}); ok {
+			if err := backfill.InitializeUserGameBackfill(context.Background(), jobRunner); err != nil {
+				panic(fmt.Sprintf("Failed to enqueue user game index backfill: %v", err))
+			}
+		}
+
+		// Retention janitor - off unless LILBATTLE_JANITOR_INTERVAL is set
+		if janitor := newJanitor(gamesService, worldsService, filestore); janitor != nil {
+			if err := janitor.Schedule(context.Background(), jobRunner); err != nil {
+				panic(fmt.Sprintf("Failed to schedule janitor: %v", err))
+			}
+		}
+		jobRunner.Start()
+
+		// Screenshots missed while the server was down (or that failed) are
+		// found and queued again by the index reconciler
+		var indexSources []services.IndexSource
+		for _, service := range []any{worldsService, gamesService} {
+			if source, ok := service.(services.IndexSource); ok {
+				indexSources = append(indexSources, source)
+			}
+		}
+		services.NewIndexReconciler(indexStateStore, indexSources...).Start()
+
+		// World search is an embedded index each server keeps up to date
+		// for itself, with its keyword index states alongside it
+		searchDir := os.Getenv("LILBATTLE_SEARCH_INDEX_DIR")
+		if searchDir == "" {
+			searchDir = fsbe.DevDataPath("storage/search")
+		}
+		searchIndex := services.NewWorldSearchIndex(fsbe.NewFSWorldSearchStore(filepath.Join(searchDir, "worlds")))
+		if err := searchIndex.Load(context.Background()); err != nil {
+			log.Printf("Failed to load the world search index: %v", err)
+		}
+		if searchable, ok := worldsService.(interface {
+			InitializeSearchIndex(*services.WorldSearchIndex)
+		}); ok {
+			searchable.InitializeSearchIndex(searchIndex)
+		}
+		searchIndexer := services.NewWorldSearchIndexer(searchIndex, worldsService, gamesService)
+		searchIndexer.Start()
+		searchReconciler := services.NewIndexReconciler(fsbe.NewFSIndexStateStore(filepath.Join(searchDir, "index_states")), searchIndexer)
+		// Indexing a world is cheap next to screenshotting it
+		searchReconciler.MaxPerPass = 500
+		searchReconciler.Start()
+
 		// Create sync service for multiplayer real-time updates
 		syncService := services.NewGameSyncService()
 
@@ -231,6 +425,11 @@ func (b *Backend) SetupApp() *utils.App {
 		v1s.RegisterGamesServiceServer(server, gamesService)
 		v1s.RegisterFileStoreServiceServer(server, filestore)
 		v1s.RegisterGameSyncServiceServer(server, syncService)
+		ops := operators()
+		if len(ops) == 0 {
+			log.Printf("LILBATTLE_OPERATORS is not set - nobody can manage background jobs")
+		}
+		v1s.RegisterJobsServiceServer(server, services.NewJobsService(jobRunner, ops))
 
 		// TODO - use diferent kinds of db based on setup
 		// v1s.RegisterIndexerServiceServer(server, gormbe.NewIndexerService(ensureDB()))
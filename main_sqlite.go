@@ -0,0 +1,30 @@
+//go:build sqlite
+// +build sqlite
+
+package main
+
+import (
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/gormbe"
+	"github.com/turnforge/lilbattle/services/sqlitebe"
+	"gorm.io/gorm"
+)
+
+// sqliteBackendsImpl builds the 'sqlite' backends with sqlitebe
+type sqliteBackendsImpl struct{}
+
+func (sqliteBackendsImpl) OpenDB(path string) *gorm.DB {
+	return sqlitebe.OpenLilBattleDB(path)
+}
+
+func (sqliteBackendsImpl) NewWorldsService(db *gorm.DB, clientMgr *services.ClientMgr) (*gormbe.WorldsService, error) {
+	return sqlitebe.NewWorldsService(db, clientMgr)
+}
+
+func (sqliteBackendsImpl) NewGamesService(db *gorm.DB, clientMgr *services.ClientMgr) (*gormbe.GamesService, error) {
+	return sqlitebe.NewGamesService(db, clientMgr)
+}
+
+func init() {
+	sqliteBackends = sqliteBackendsImpl{}
+}
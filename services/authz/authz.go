@@ -8,9 +8,11 @@ package authz
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	oagrpc "github.com/panyam/oneauth/grpc"
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
 )
 
 // Common authorization errors
@@ -58,6 +60,20 @@ func RequireOwnership(ctx context.Context, creatorID string) error {
 	return nil
 }
 
+// RequireOperator checks that the authenticated user may manage the server
+// (inspect and retry background jobs).  operators lists the user IDs that
+// may; an empty list lets nobody.
+func RequireOperator(ctx context.Context, operators []string) error {
+	userID, err := RequireAuthenticated(ctx)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(operators, userID) {
+		return nil
+	}
+	return ErrForbidden
+}
+
 // CanModifyGame checks if user can modify game metadata (update/delete).
 // Only the game creator can modify game metadata.
 func CanModifyGame(ctx context.Context, game *v1.Game) error {
@@ -107,8 +123,52 @@ func RequireCurrentPlayer(ctx context.Context, game *v1.Game, currentPlayer int3
 }
 
 // CanSubmitMoves checks if user can submit moves to a game.
-// User must be a player in the game AND it must be their turn.
-func CanSubmitMoves(ctx context.Context, game *v1.Game, currentPlayer int32) error {
+// User must be a player in the game AND it must be their turn - or, in team
+// games with shared control, their teammate's turn.  Only the player whose
+// turn it is may end it.
+func CanSubmitMoves(ctx context.Context, game *v1.Game, currentPlayer int32, moves []*v1.GameMove) error {
 	_, err := RequireCurrentPlayer(ctx, game, currentPlayer)
-	return err
+	if err != ErrNotYourTurn || !lib.HasSharedControl(game) {
+		return err
+	}
+	for _, move := range moves {
+		if move.GetEndTurn() != nil {
+			return ErrNotYourTurn
+		}
+	}
+
+	userID, err := RequireAuthenticated(ctx)
+	if err != nil {
+		return err
+	}
+	for _, player := range game.Config.Players {
+		if player.UserId == userID && lib.AreAllies(game, player.PlayerId, currentPlayer) {
+			return nil
+		}
+	}
+	return ErrNotYourTurn
+}
+
+// CanSubmitOrders checks if user can commit or reveal orders for a player slot
+// in a simultaneous turn game.  If playerID is 0 the user's own slot is used.
+// Returns the player's ID the orders are for.
+func CanSubmitOrders(ctx context.Context, game *v1.Game, playerID int32) (int32, error) {
+	userID, err := RequireAuthenticated(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if playerID == 0 {
+		return RequireGamePlayer(ctx, game)
+	}
+	if game.Config == nil {
+		return 0, ErrNotPlayer
+	}
+
+	for _, player := range game.Config.Players {
+		if player.PlayerId == playerID && player.UserId == userID {
+			return playerID, nil
+		}
+	}
+
+	return 0, ErrNotPlayer
 }
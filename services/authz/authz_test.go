@@ -9,6 +9,7 @@ import (
 
 	oagrpc "github.com/panyam/oneauth/grpc"
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -58,6 +59,22 @@ func TestRequireOwnership_IsOwner(t *testing.T) {
 	}
 }
 
+func TestRequireOperator(t *testing.T) {
+	operators := []string{"ops1", "ops2"}
+	if err := RequireOperator(context.Background(), operators); err != ErrUnauthenticated {
+		t.Errorf("Expected ErrUnauthenticated, got %v", err)
+	}
+	if err := RequireOperator(contextWithUserID("user123"), operators); err != ErrForbidden {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	if err := RequireOperator(contextWithUserID("ops2"), operators); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := RequireOperator(contextWithUserID("user123"), nil); err != ErrForbidden {
+		t.Errorf("Expected nobody to operate without an operator list, got %v", err)
+	}
+}
+
 func TestRequireGamePlayer_NoConfig(t *testing.T) {
 	ctx := contextWithUserID("user123")
 	game := &v1.Game{
@@ -162,7 +179,7 @@ func TestCanSubmitMoves_Unauthenticated(t *testing.T) {
 		},
 	}
 
-	err := CanSubmitMoves(ctx, game, 1)
+	err := CanSubmitMoves(ctx, game, 1, nil)
 	if err != ErrUnauthenticated {
 		t.Errorf("Expected ErrUnauthenticated, got %v", err)
 	}
@@ -180,7 +197,7 @@ func TestCanSubmitMoves_NotAPlayer(t *testing.T) {
 		},
 	}
 
-	err := CanSubmitMoves(ctx, game, 1)
+	err := CanSubmitMoves(ctx, game, 1, nil)
 	if err != ErrNotPlayer {
 		t.Errorf("Expected ErrNotPlayer, got %v", err)
 	}
@@ -199,7 +216,7 @@ func TestCanSubmitMoves_NotYourTurn(t *testing.T) {
 	}
 
 	// User123 is player 2, but current player is 1
-	err := CanSubmitMoves(ctx, game, 1)
+	err := CanSubmitMoves(ctx, game, 1, nil)
 	if err != ErrNotYourTurn {
 		t.Errorf("Expected ErrNotYourTurn, got %v", err)
 	}
@@ -218,12 +235,61 @@ func TestCanSubmitMoves_Success(t *testing.T) {
 	}
 
 	// User123 is player 2, and current player is 2
-	err := CanSubmitMoves(ctx, game, 2)
+	err := CanSubmitMoves(ctx, game, 2, nil)
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
 	}
 }
 
+func teamGame(sharedControl bool) *v1.Game {
+	return &v1.Game{
+		Id: "game1",
+		Config: &v1.GameConfiguration{
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: "user456", TeamId: 1},
+				{PlayerId: 2, UserId: "user789", TeamId: 2},
+				{PlayerId: 3, UserId: "user123", TeamId: 1},
+				{PlayerId: 4, UserId: "userabc", TeamId: 2},
+			},
+			Settings: &v1.GameSettings{TeamMode: lib.TeamModeTeams, SharedControl: sharedControl},
+		},
+	}
+}
+
+func TestCanSubmitMoves_TeammateWithSharedControl(t *testing.T) {
+	ctx := contextWithUserID("user123")
+
+	// User123 is player 3 and may move on teammate player 1's turn
+	if err := CanSubmitMoves(ctx, teamGame(true), 1, nil); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	// But not on an opponent's turn
+	if err := CanSubmitMoves(ctx, teamGame(true), 2, nil); err != ErrNotYourTurn {
+		t.Errorf("Expected ErrNotYourTurn, got %v", err)
+	}
+
+	// And may not end the teammate's turn
+	moves := []*v1.GameMove{
+		{MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{}}},
+		{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}},
+	}
+	if err := CanSubmitMoves(ctx, teamGame(true), 1, moves); err != ErrNotYourTurn {
+		t.Errorf("Expected ErrNotYourTurn ending a teammate's turn, got %v", err)
+	}
+	if err := CanSubmitMoves(ctx, teamGame(true), 3, moves); err != nil {
+		t.Errorf("Expected players to end their own turn, got %v", err)
+	}
+}
+
+func TestCanSubmitMoves_TeammateWithoutSharedControl(t *testing.T) {
+	ctx := contextWithUserID("user123")
+
+	if err := CanSubmitMoves(ctx, teamGame(false), 1, nil); err != ErrNotYourTurn {
+		t.Errorf("Expected ErrNotYourTurn, got %v", err)
+	}
+}
+
 func TestCanModifyGame_NotOwner(t *testing.T) {
 	ctx := contextWithUserID("user123")
 	game := &v1.Game{
@@ -275,3 +341,46 @@ func TestCanModifyWorld_IsOwner(t *testing.T) {
 		t.Errorf("Expected no error, got %v", err)
 	}
 }
+
+func TestCanSubmitOrders_OtherPlayersSlot(t *testing.T) {
+	ctx := contextWithUserID("user123")
+	game := &v1.Game{
+		Id: "game1",
+		Config: &v1.GameConfiguration{
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: "user456"},
+				{PlayerId: 2, UserId: "user123"},
+			},
+		},
+	}
+
+	// User123 cannot submit orders for player 1
+	_, err := CanSubmitOrders(ctx, game, 1)
+	if err != ErrNotPlayer {
+		t.Errorf("Expected ErrNotPlayer, got %v", err)
+	}
+}
+
+func TestCanSubmitOrders_OwnSlot(t *testing.T) {
+	ctx := contextWithUserID("user123")
+	game := &v1.Game{
+		Id: "game1",
+		Config: &v1.GameConfiguration{
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: "user456"},
+				{PlayerId: 2, UserId: "user123"},
+			},
+		},
+	}
+
+	// Explicit slot and implied slot both resolve to player 2
+	for _, requested := range []int32{2, 0} {
+		playerID, err := CanSubmitOrders(ctx, game, requested)
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if playerID != 2 {
+			t.Errorf("Expected playerID 2, got %d", playerID)
+		}
+	}
+}
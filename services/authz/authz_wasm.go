@@ -36,6 +36,11 @@ func RequireOwnership(ctx context.Context, creatorID string) error {
 	return nil
 }
 
+// RequireOperator always succeeds in WASM context.
+func RequireOperator(ctx context.Context, operators []string) error {
+	return nil
+}
+
 // CanModifyGame always succeeds in WASM context.
 func CanModifyGame(ctx context.Context, game *v1.Game) error {
 	return nil
@@ -57,6 +62,14 @@ func RequireCurrentPlayer(ctx context.Context, game *v1.Game, currentPlayer int3
 }
 
 // CanSubmitMoves always succeeds in WASM context.
-func CanSubmitMoves(ctx context.Context, game *v1.Game, currentPlayer int32) error {
+func CanSubmitMoves(ctx context.Context, game *v1.Game, currentPlayer int32, moves []*v1.GameMove) error {
 	return nil
 }
+
+// CanSubmitOrders returns the requested player (or player 1) in WASM context.
+func CanSubmitOrders(ctx context.Context, game *v1.Game, playerID int32) (int32, error) {
+	if playerID == 0 {
+		return 1, nil
+	}
+	return playerID, nil
+}
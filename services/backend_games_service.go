@@ -5,6 +5,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
@@ -50,6 +51,34 @@ type GameStorageProvider interface {
 	DeleteFromStorage(ctx context.Context, id string) error
 }
 
+// TransactionalMoveSaver is optionally implemented by a GameStorageProvider
+// that can save a move group together with the resulting game state in one
+// transaction.  SaveMoveGroup uses it instead of SaveMoves then SaveGameState
+// so a failed save never leaves moves without their state.
+type TransactionalMoveSaver interface {
+	// SaveMovesAndState saves the group and the state atomically.  The
+	// state is only written if the stored version still matches
+	// state.Version (returning ErrStaleGameState otherwise) and its version
+	// is then incremented.
+	SaveMovesAndState(ctx context.Context, gameId string, group *v1.GameMoveGroup, state *v1.GameState) error
+}
+
+// ErrStaleGameState is returned when a game state is saved over a newer
+// version of it
+var ErrStaleGameState = errors.New("game state was modified by another request")
+
+// UserGameIndex is implemented by concrete backends to store the per-user
+// game index behind ListMyGames.  BackendGamesService computes the entries
+// (see UserGameEntries) and keeps them up to date.
+type UserGameIndex interface {
+	// SaveUserGames replaces every index entry of a game with the given
+	// entries.  No entries removes the game from the index.
+	SaveUserGames(ctx context.Context, gameId string, entries []*v1.UserGame) error
+
+	// ListUserGames returns every index entry of a user
+	ListUserGames(ctx context.Context, userId string) ([]*v1.UserGame, error)
+}
+
 // BackendGamesService provides shared caching and screenshot indexing logic for backend game services
 // It embeds BaseGamesService and adds caching + screenshot management
 type BackendGamesService struct {
@@ -58,6 +87,9 @@ type BackendGamesService struct {
 	ScreenShotIndexer *ScreenShotIndexer
 	GameStateUpdater  GameStateUpdater
 	StorageProvider   GameStorageProvider // Set by concrete implementations
+	TurnNotifier      *TurnNotifier       // Optional - set via InitializeTurnNotifications
+	UserGameIndex     UserGameIndex       // Optional - set by implementations that keep a user game index
+	RulesStore        RulesStore          // Optional - set by implementations that keep imported games' rules
 
 	// Cache configuration
 	CacheEnabled bool // Set to true to enable in-memory caching
@@ -149,19 +181,22 @@ func (s *BackendGamesService) GetGame(ctx context.Context, req *v1.GetGameReques
 
 // GetRuntimeGameCached returns a cached runtime game, creating one if needed
 // If caching is disabled, always creates a new runtime game
-func (s *BackendGamesService) GetRuntimeGameCached(id string, game *v1.Game, state *v1.GameState) *lib.Game {
+func (s *BackendGamesService) GetRuntimeGameCached(id string, game *v1.Game, state *v1.GameState) (*lib.Game, error) {
 	if s.CacheEnabled {
 		s.cacheMu.RLock()
 		rtGame, ok := s.runtimeCache[id]
 		s.cacheMu.RUnlock()
 
 		if ok {
-			return rtGame
+			return rtGame, nil
 		}
 	}
 
 	// Create new runtime game
-	rtGame := lib.ProtoToRuntimeGame(game, state)
+	rtGame, err := s.GetRuntimeGame(game, state)
+	if err != nil {
+		return nil, err
+	}
 
 	if s.CacheEnabled {
 		s.cacheMu.Lock()
@@ -169,12 +204,17 @@ func (s *BackendGamesService) GetRuntimeGameCached(id string, game *v1.Game, sta
 		s.cacheMu.Unlock()
 	}
 
-	return rtGame
+	return rtGame, nil
 }
 
-// GetRuntimeGame implements the GamesService interface
+// GetRuntimeGame implements the GamesService interface.  The game is played
+// with the rules it records (see RulesEngineFor).
 func (s *BackendGamesService) GetRuntimeGame(game *v1.Game, gameState *v1.GameState) (*lib.Game, error) {
-	return lib.ProtoToRuntimeGame(game, gameState), nil
+	rulesEngine, err := s.RulesEngineFor(context.Background(), game)
+	if err != nil {
+		return nil, err
+	}
+	return lib.ProtoToRuntimeGameWithRules(game, gameState, rulesEngine), nil
 }
 
 // UpdateGame updates an existing game with transparent caching.
@@ -247,9 +287,13 @@ func (s *BackendGamesService) UpdateGame(ctx context.Context, req *v1.UpdateGame
 			lib.MigrateWorldData(req.NewState.WorldData)
 		}
 
-		// Top up units
-		if req.NewState.WorldData != nil {
-			rg := lib.ProtoToRuntimeGame(game, req.NewState)
+		// Top up units - unless the state comes with its history (a restored
+		// or migrated game) where it must stay as the history left it
+		if req.NewState.WorldData != nil && req.NewHistory == nil {
+			rg, err := s.GetRuntimeGame(game, req.NewState)
+			if err != nil {
+				return nil, err
+			}
 			for _, unit := range req.NewState.WorldData.UnitsMap {
 				rg.TopUpUnitIfNeeded(unit)
 			}
@@ -257,11 +301,7 @@ func (s *BackendGamesService) UpdateGame(ctx context.Context, req *v1.UpdateGame
 
 		// Update version and index info
 		oldVersion := currentState.Version
-		if req.NewState.WorldData.ScreenshotIndexInfo == nil {
-			req.NewState.WorldData.ScreenshotIndexInfo = &v1.IndexInfo{}
-		}
-		req.NewState.WorldData.ScreenshotIndexInfo.LastUpdatedAt = timestamppb.New(time.Now())
-		req.NewState.WorldData.ScreenshotIndexInfo.NeedsIndexing = true
+		MarkScreenshotStale(req.NewState)
 		req.NewState.Version = oldVersion + 1
 
 		if err := s.StorageProvider.SaveGameState(ctx, req.GameId, req.NewState); err != nil {
@@ -271,9 +311,7 @@ func (s *BackendGamesService) UpdateGame(ctx context.Context, req *v1.UpdateGame
 		s.updateCache(req.GameId, nil, req.NewState, nil)
 
 		// Queue for screenshot
-		if s.ScreenShotIndexer != nil {
-			s.ScreenShotIndexer.Send("games", req.GameId, req.NewState.Version, req.NewState.WorldData)
-		}
+		s.QueueGameScreenshot(req.GameId, req.NewState)
 	}
 
 	// Handle history update
@@ -285,6 +323,11 @@ func (s *BackendGamesService) UpdateGame(ctx context.Context, req *v1.UpdateGame
 		s.updateCache(req.GameId, nil, nil, req.NewHistory)
 	}
 
+	// Names, players and turns shown in the user game index may have changed
+	if req.NewGame != nil || req.NewState != nil {
+		s.reindexUserGames(ctx, req.GameId, req.NewState)
+	}
+
 	return resp, nil
 }
 
@@ -312,6 +355,7 @@ func (s *BackendGamesService) DeleteGame(ctx context.Context, req *v1.DeleteGame
 	}
 
 	s.invalidateCache(req.Id)
+	s.UpdateUserGameIndex(ctx, game, nil)
 	return &v1.DeleteGameResponse{}, nil
 }
 
@@ -322,14 +366,29 @@ func (s *BackendGamesService) SaveMoveGroup(ctx context.Context, gameId string,
 		return fmt.Errorf("storage provider not configured")
 	}
 
-	// Save moves using backend-specific implementation
-	if err := s.StorageProvider.SaveMoves(ctx, gameId, group, state.CurrentGroupNumber); err != nil {
-		return fmt.Errorf("failed to save moves: %w", err)
+	// Whose turn it is only changes when the turn ends (or the game does).
+	// Screenshots follow turns rather than every move.
+	turnChanged := MovesChangeTurn(group.Moves) || state.Finished
+	if turnChanged {
+		MarkScreenshotStale(state)
 	}
 
-	// Save state (this is the "commit point")
-	if err := s.StorageProvider.SaveGameState(ctx, gameId, state); err != nil {
-		return fmt.Errorf("failed to save state: %w", err)
+	if saver, ok := s.StorageProvider.(TransactionalMoveSaver); ok {
+		if err := saver.SaveMovesAndState(ctx, gameId, group, state); err != nil {
+			// The cached state was modified in place - drop it
+			s.invalidateCache(gameId)
+			return fmt.Errorf("failed to save moves: %w", err)
+		}
+	} else {
+		// Save moves using backend-specific implementation
+		if err := s.StorageProvider.SaveMoves(ctx, gameId, group, state.CurrentGroupNumber); err != nil {
+			return fmt.Errorf("failed to save moves: %w", err)
+		}
+
+		// Save state (this is the "commit point")
+		if err := s.StorageProvider.SaveGameState(ctx, gameId, state); err != nil {
+			return fmt.Errorf("failed to save state: %w", err)
+		}
 	}
 
 	// Load updated history for cache
@@ -338,14 +397,32 @@ func (s *BackendGamesService) SaveMoveGroup(ctx context.Context, gameId string,
 	// Update cache transparently
 	s.updateCache(gameId, nil, state, history)
 
-	// Queue for screenshot
-	if s.ScreenShotIndexer != nil {
-		s.ScreenShotIndexer.Send("games", gameId, state.Version, state.WorldData)
+	if turnChanged {
+		s.reindexUserGames(ctx, gameId, state)
+		s.QueueGameScreenshot(gameId, state)
 	}
 
 	return nil
 }
 
+// SavePendingOrders saves the game state holding simultaneous turn orders
+// with transparent cache update.
+func (s *BackendGamesService) SavePendingOrders(ctx context.Context, gameId string, state *v1.GameState) error {
+	if s.StorageProvider == nil {
+		return fmt.Errorf("storage provider not configured")
+	}
+
+	if err := s.StorageProvider.SaveGameState(ctx, gameId, state); err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+
+	s.updateCache(gameId, nil, state, nil)
+
+	// Committing or revealing changes who the turn is waiting on
+	s.reindexUserGames(ctx, gameId, state)
+	return nil
+}
+
 // updateCache is a private method to update cache after mutations
 func (s *BackendGamesService) updateCache(id string, game *v1.Game, state *v1.GameState, history *v1.GameMoveHistory) {
 	if !s.CacheEnabled {
@@ -385,6 +462,14 @@ func (s *BackendGamesService) invalidateCache(id string) {
 	delete(s.runtimeCache, id)
 }
 
+// InitializeScreenshotJobs renders screenshots as jobs on runner rather
+// than in the indexer's own loop.  Does nothing when screenshots are off.
+func (s *BackendGamesService) InitializeScreenshotJobs(runner *JobRunner) {
+	if s.ScreenShotIndexer != nil {
+		s.ScreenShotIndexer.UseJobRunner(runner, s)
+	}
+}
+
 // InitializeScreenshotIndexer wires up the screenshot indexer's background
 // goroutine.
 //
@@ -404,6 +489,7 @@ func (s *BackendGamesService) InitializeScreenshotIndexer() {
 	}
 	s.ScreenShotIndexer = NewScreenShotIndexer(s.ClientMgr)
 	s.ScreenShotIndexer.OnComplete = s.handleScreenshotCompletion
+	s.ScreenShotIndexer.Overlay = s.gameScreenshotOverlay
 }
 
 // InitializeSyncBroadcast sets up the callback to broadcast moves to sync subscribers.
@@ -445,6 +531,184 @@ func (s *BackendGamesService) InitializeSyncBroadcast() {
 	}
 }
 
+// OrdersDeadlineJobType is the job that forfeits players late with their
+// simultaneous turn orders
+const OrdersDeadlineJobType = "orders_deadline"
+
+// InitializeOrdersDeadlines enforces simultaneous turn deadlines with jobs:
+// each time a game's orders phase changes, its job is moved to the phase's
+// deadline, when ForfeitLateOrders forfeits whoever is still late.
+func (s *BackendGamesService) InitializeOrdersDeadlines(runner *JobRunner) {
+	runner.Handle(OrdersDeadlineJobType, func(ctx context.Context, job *v1.Job) error {
+		_, err := s.ForfeitLateOrders(ctx, job.EntityId)
+		return err
+	})
+	s.OnOrdersDeadline = func(ctx context.Context, gameId string, deadline time.Time) {
+		job, err := NewJob(OrdersDeadlineJobType, "game", gameId, nil)
+		if err != nil {
+			log.Printf("Failed to create orders deadline job for game %s: %v", gameId, err)
+			return
+		}
+		// Run a second after the deadline so it has surely passed
+		job.DebounceWindowSeconds = int32(max(deadline.Sub(runner.Now()), 0)/time.Second) + 1
+		if _, err := runner.Enqueue(ctx, job); err != nil {
+			log.Printf("Failed to schedule orders deadline for game %s: %v", gameId, err)
+		}
+	}
+}
+
+// UserGamesBackfillJobType is the one-off job filling the user game index
+// in for games created before it
+const UserGamesBackfillJobType = "user_games_backfill"
+
+// InitializeUserGameBackfill registers the user game index backfill and
+// enqueues it the first time a server runs with this store.  Once it has
+// succeeded it stays done - retry it (ww jobs retry) to run it again.
+func (s *BackendGamesService) InitializeUserGameBackfill(ctx context.Context, runner *JobRunner) error {
+	if s.UserGameIndex == nil {
+		return nil
+	}
+	runner.Handle(UserGamesBackfillJobType, func(ctx context.Context, job *v1.Job) error {
+		indexed, err := s.BackfillUserGameIndex(ctx)
+		log.Printf("User game index backfill indexed %d games", indexed)
+		return err
+	})
+	job, err := NewJob(UserGamesBackfillJobType, "games", "all", nil)
+	if err != nil {
+		return err
+	}
+	_, err = runner.EnqueueOnce(ctx, job)
+	return err
+}
+
+// InitializeTurnNotifications chains turn notifications onto OnMovesSaved.
+// Must be called after InitializeSyncBroadcast so the broadcast callback is
+// preserved. Notifications are sent in the background so slow email or
+// webhook deliveries never hold up ProcessMoves.
+func (s *BackendGamesService) InitializeTurnNotifications(notifier *TurnNotifier) {
+	if notifier == nil {
+		return
+	}
+	s.TurnNotifier = notifier
+	prev := s.OnMovesSaved
+	s.OnMovesSaved = func(ctx context.Context, gameId string, moves []*v1.GameMove, groupNumber int64) {
+		if prev != nil {
+			prev(ctx, gameId, moves, groupNumber)
+		}
+		if !MovesChangeTurn(moves) {
+			return
+		}
+
+		gameresp, err := s.Self.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+		if err != nil || gameresp.Game == nil || gameresp.State == nil {
+			log.Printf("Failed to load game %s for turn notification: %v", gameId, err)
+			return
+		}
+		if gameresp.State.Finished {
+			return
+		}
+
+		game := gameresp.Game
+		turnCounter := gameresp.State.TurnCounter
+		// In simultaneous mode every player is due to submit orders
+		players := []int32{gameresp.State.CurrentPlayer}
+		if lib.IsSimultaneous(game) {
+			players = ordersPlayers(game, gameresp.State)
+		}
+		go func() {
+			for _, playerId := range players {
+				if err := notifier.NotifyTurn(context.Background(), game, playerId, turnCounter); err != nil {
+					log.Printf("Failed to send turn notification for game %s: %v", gameId, err)
+				}
+			}
+		}()
+	}
+}
+
+// UpdateUserGameIndex rewrites the user game index entries of a game.  A nil
+// state removes the game from the index.  The index is derived data so
+// failures are logged rather than failing the write that triggered them.
+func (s *BackendGamesService) UpdateUserGameIndex(ctx context.Context, game *v1.Game, state *v1.GameState) {
+	if s.UserGameIndex == nil || game == nil {
+		return
+	}
+	var entries []*v1.UserGame
+	if state != nil {
+		entries = UserGameEntries(game, state)
+	}
+	if err := s.UserGameIndex.SaveUserGames(ctx, game.Id, entries); err != nil {
+		log.Printf("Failed to update user game index for game %s: %v", game.Id, err)
+	}
+}
+
+// BackfillUserGameIndex rewrites the user game index entries of every game
+// and returns how many games it indexed.  Games are only indexed as they
+// change, so this fills the index in for games that existed before it did.
+func (s *BackendGamesService) BackfillUserGameIndex(ctx context.Context) (int, error) {
+	if s.UserGameIndex == nil {
+		return 0, nil
+	}
+	indexed := 0
+	req := &v1.ListGamesRequest{Pagination: &v1.Pagination{PageSize: MaxListPageSize}}
+	for {
+		resp, err := s.Self.ListGames(ctx, req)
+		if err != nil {
+			return indexed, fmt.Errorf("failed to list games: %w", err)
+		}
+		for _, game := range resp.Items {
+			gameresp, err := s.Self.GetGame(ctx, &v1.GetGameRequest{Id: game.Id})
+			if err != nil || gameresp.Game == nil {
+				log.Printf("Skipping game %s in the user game index backfill: %v", game.Id, err)
+				continue
+			}
+			if err := s.UserGameIndex.SaveUserGames(ctx, game.Id, UserGameEntries(gameresp.Game, gameresp.State)); err != nil {
+				return indexed, fmt.Errorf("failed to index game %s: %w", game.Id, err)
+			}
+			indexed++
+		}
+		if !resp.Pagination.GetHasMore() || resp.Pagination.NextPageKey == "" {
+			return indexed, nil
+		}
+		req.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+}
+
+// reindexUserGames updates the user game index after a game changed.  state
+// is the new state if known, otherwise the current state is loaded.
+func (s *BackendGamesService) reindexUserGames(ctx context.Context, gameId string, state *v1.GameState) {
+	if s.UserGameIndex == nil {
+		return
+	}
+	gameresp, err := s.Self.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+	if err != nil || gameresp.Game == nil {
+		log.Printf("Failed to load game %s for the user game index: %v", gameId, err)
+		return
+	}
+	if state == nil {
+		state = gameresp.State
+	}
+	s.UpdateUserGameIndex(ctx, gameresp.Game, state)
+}
+
+// ListMyGames lists the games the caller holds a player slot in from the
+// user game index.
+// Authorization: User must be authenticated.
+func (s *BackendGamesService) ListMyGames(ctx context.Context, req *v1.ListMyGamesRequest) (*v1.ListMyGamesResponse, error) {
+	userID, err := authz.RequireAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.UserGameIndex == nil {
+		return nil, fmt.Errorf("user game index not configured")
+	}
+	entries, err := s.UserGameIndex.ListUserGames(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list games of user %s: %w", userID, err)
+	}
+	items, pagination := PageUserGames(entries, req)
+	return &v1.ListMyGamesResponse{Items: items, Pagination: pagination}, nil
+}
+
 // ValidateCreateGameRequest validates a CreateGameRequest for common errors
 // that apply to all backend implementations (fsbe, gormbe, etc.)
 func (s *BackendGamesService) ValidateCreateGameRequest(game *v1.Game, worldData *v1.WorldData) error {
@@ -499,27 +763,7 @@ func (s *BackendGamesService) ValidateCreateGameRequest(game *v1.Game, worldData
 // This sets up initial coins (starting_coins + base income) for each player.
 // Called during game creation by both fsbe and gormbe.
 func (s *BackendGamesService) InitializePlayerStates(gameState *v1.GameState, config *v1.GameConfiguration) {
-	if config == nil {
-		return
-	}
-
-	if gameState.PlayerStates == nil {
-		gameState.PlayerStates = make(map[int32]*v1.PlayerState)
-	}
-
-	var incomeConfig *v1.IncomeConfig
-	if config.IncomeConfigs != nil {
-		incomeConfig = config.IncomeConfigs
-	}
-
-	for _, player := range config.Players {
-		baseIncome := lib.CalculatePlayerBaseIncome(player.PlayerId, gameState.WorldData, incomeConfig)
-		initialCoins := player.StartingCoins + baseIncome
-		gameState.PlayerStates[player.PlayerId] = &v1.PlayerState{
-			Coins:    initialCoins,
-			IsActive: true,
-		}
-	}
+	lib.InitializePlayerStates(gameState, config)
 }
 
 // handleScreenshotCompletion updates IndexInfo after screenshots are generated
@@ -568,6 +812,59 @@ func (s *BackendGamesService) handleScreenshotCompletion(items []ScreenShotItem)
 	return nil
 }
 
+// IndexEntityType implements IndexSource
+func (s *BackendGamesService) IndexEntityType() string {
+	return "games"
+}
+
+// IndexType implements IndexSource
+func (s *BackendGamesService) IndexType() string {
+	return ScreenshotIndexType
+}
+
+// ListIndexEntities implements IndexSource
+func (s *BackendGamesService) ListIndexEntities(ctx context.Context, since time.Time) ([]IndexEntity, error) {
+	var entities []IndexEntity
+	req := &v1.ListGamesRequest{
+		Pagination: &v1.Pagination{PageSize: MaxListPageSize},
+		SortBy:     SortByUpdatedAt,
+		SortOrder:  "asc",
+	}
+	if !since.IsZero() {
+		req.Updated = &v1.TimeRange{Start: timestamppb.New(since)}
+	}
+	for {
+		resp, err := s.Self.ListGames(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list games: %w", err)
+		}
+		for _, game := range resp.Items {
+			entities = append(entities, IndexEntity{Id: game.Id, UpdatedAt: game.UpdatedAt.AsTime()})
+		}
+		if !resp.Pagination.GetHasMore() || resp.Pagination.NextPageKey == "" {
+			return entities, nil
+		}
+		req.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+}
+
+// LoadIndexItem implements IndexSource
+func (s *BackendGamesService) LoadIndexItem(ctx context.Context, id string) (int64, *v1.WorldData, *v1.IndexInfo, error) {
+	resp, err := s.Self.GetGame(ctx, &v1.GetGameRequest{Id: id})
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return resp.State.GetVersion(), resp.State.GetWorldData(), resp.State.GetWorldData().GetScreenshotIndexInfo(), nil
+}
+
+// IndexQueue implements IndexSource
+func (s *BackendGamesService) IndexQueue() IndexQueue {
+	if s.ScreenShotIndexer == nil {
+		return nil
+	}
+	return s.ScreenShotIndexer
+}
+
 // JoinGame allows an authenticated user to join an open player slot in a game.
 // The player slot must have player_type = "open" to be joinable.
 func (s *BackendGamesService) JoinGame(ctx context.Context, req *v1.JoinGameRequest) (*v1.JoinGameResponse, error) {
@@ -640,6 +937,12 @@ func (s *BackendGamesService) JoinGame(ctx context.Context, req *v1.JoinGameRequ
 	// Update cache if enabled
 	s.updateCache(req.GameId, game, nil, nil)
 
+	if state, err := s.StorageProvider.LoadGameState(ctx, req.GameId); err == nil {
+		s.UpdateUserGameIndex(ctx, game, state)
+	} else {
+		log.Printf("Failed to load state of game %s for the user game index: %v", req.GameId, err)
+	}
+
 	log.Printf("User %s joined game %s as player %d", userID, req.GameId, req.PlayerId)
 
 	return &v1.JoinGameResponse{
@@ -5,8 +5,12 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // WorldDataUpdater is an interface for updating WorldData with optimistic locking
@@ -26,6 +30,20 @@ type BackendWorldsService struct {
 	ClientMgr         *ClientMgr
 	ScreenShotIndexer *ScreenShotIndexer
 	WorldDataUpdater  WorldDataUpdater
+
+	// Keeps the world's immutable revisions (see world_revisions.go)
+	RevisionStore WorldRevisionStore
+
+	// Serves SearchWorlds (see world_search.go) - nil when search is off
+	SearchIndex *WorldSearchIndex
+}
+
+// InitializeScreenshotJobs renders screenshots as jobs on runner rather
+// than in the indexer's own loop.  Does nothing when screenshots are off.
+func (s *BackendWorldsService) InitializeScreenshotJobs(runner *JobRunner) {
+	if s.ScreenShotIndexer != nil {
+		s.ScreenShotIndexer.UseJobRunner(runner, s)
+	}
 }
 
 // InitializeScreenshotIndexer wires up the screenshot indexer's background
@@ -94,3 +112,64 @@ func (s *BackendWorldsService) handleScreenshotCompletion(items []ScreenShotItem
 	}
 	return nil
 }
+
+// IndexEntityType implements IndexSource
+func (s *BackendWorldsService) IndexEntityType() string {
+	return "worlds"
+}
+
+// IndexType implements IndexSource
+func (s *BackendWorldsService) IndexType() string {
+	return ScreenshotIndexType
+}
+
+// ListIndexEntities implements IndexSource
+func (s *BackendWorldsService) ListIndexEntities(ctx context.Context, since time.Time) ([]IndexEntity, error) {
+	return listWorldIndexEntities(ctx, s.Self, since)
+}
+
+// listWorldIndexEntities lists the worlds updated since a time, or every
+// world when since is zero
+func listWorldIndexEntities(ctx context.Context, worlds interface {
+	ListWorlds(context.Context, *v1.ListWorldsRequest) (*v1.ListWorldsResponse, error)
+}, since time.Time) ([]IndexEntity, error) {
+	var entities []IndexEntity
+	req := &v1.ListWorldsRequest{
+		Pagination: &v1.Pagination{PageSize: MaxListPageSize},
+		SortBy:     SortByUpdatedAt,
+		SortOrder:  "asc",
+	}
+	if !since.IsZero() {
+		req.Updated = &v1.TimeRange{Start: tspb.New(since)}
+	}
+	for {
+		resp, err := worlds.ListWorlds(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list worlds: %w", err)
+		}
+		for _, world := range resp.Items {
+			entities = append(entities, IndexEntity{Id: world.Id, UpdatedAt: world.UpdatedAt.AsTime()})
+		}
+		if !resp.Pagination.GetHasMore() || resp.Pagination.NextPageKey == "" {
+			return entities, nil
+		}
+		req.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+}
+
+// LoadIndexItem implements IndexSource
+func (s *BackendWorldsService) LoadIndexItem(ctx context.Context, id string) (int64, *v1.WorldData, *v1.IndexInfo, error) {
+	resp, err := s.Self.GetWorld(ctx, &v1.GetWorldRequest{Id: id})
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return resp.WorldData.GetVersion(), resp.WorldData, resp.WorldData.GetScreenshotIndexInfo(), nil
+}
+
+// IndexQueue implements IndexSource
+func (s *BackendWorldsService) IndexQueue() IndexQueue {
+	if s.ScreenShotIndexer == nil {
+		return nil
+	}
+	return s.ScreenShotIndexer
+}
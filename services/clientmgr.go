@@ -31,6 +31,7 @@ type ClientMgr struct {
 	gamesSvcClient     v1s.GamesServiceClient
 	filestoreSvcClient v1s.FileStoreServiceClient
 	gameSyncSvcClient  v1s.GameSyncServiceClient
+	jobsSvcClient      v1s.JobsServiceClient
 	authSvc *goalservices.AuthService
 }
 
@@ -126,3 +127,15 @@ func (c *ClientMgr) GetGameSyncSvcClient() (out v1s.GameSyncServiceClient) {
 	}
 	return c.gameSyncSvcClient
 }
+
+func (c *ClientMgr) GetJobsSvcClient() (out v1s.JobsServiceClient) {
+	if c.jobsSvcClient == nil {
+		jobsSvcConn, err := grpc.NewClient(c.svcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			panic(fmt.Sprintf("cannot connect with server %v", err))
+		}
+
+		c.jobsSvcClient = v1s.NewJobsServiceClient(jobsSvcConn)
+	}
+	return c.jobsSvcClient
+}
@@ -0,0 +1,67 @@
+//go:build !wasm
+// +build !wasm
+
+// Package conformance is a test suite every storage backend's games and
+// worlds services must pass, so that fsbe, gormbe, sqlitebe and gaebe keep
+// the same semantics for CRUD, optimistic locking, move groups and listing.
+//
+// A backend plugs in by handing RunGames/RunWorlds a constructor:
+//
+//	conformance.RunGames(t, func(t *testing.T) conformance.GamesBackend {
+//		return fsbe.NewFSGamesService(t.TempDir(), nil)
+//	})
+//
+// Constructors may return services sharing one database - every case uses
+// its own IDs and owners so cases never see each other's data.
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	oagrpc "github.com/panyam/oneauth/grpc"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GamesBackend is a games service together with the storage contracts
+// BackendGamesService builds on
+type GamesBackend interface {
+	services.GamesService
+	services.GameStorageProvider
+	services.GameStateUpdater
+}
+
+// WorldsBackend is a worlds service together with its locking contract
+type WorldsBackend interface {
+	services.WorldsService
+	services.WorldDataUpdater
+}
+
+// Concurrency is how many requests race in the version conflict cases
+const Concurrency = 8
+
+var idCounter atomic.Int64
+
+// uniqueID returns an ID no other case (or earlier run against the same
+// database) uses
+func uniqueID(prefix string) string {
+	return fmt.Sprintf("%s-%d-%d", prefix, time.Now().UnixNano()%1_000_000_000, idCounter.Add(1))
+}
+
+// userContext is a request context authenticated as userID - what the auth
+// interceptor produces in production
+func userContext(userID string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(oagrpc.DefaultMetadataKeySubject, userID))
+}
+
+// baseTime is where the fixtures' timestamps start - in the past so listing
+// order never depends on the clock
+var baseTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func timestampAt(minutes int) *timestamppb.Timestamp {
+	return timestamppb.New(baseTime.Add(time.Duration(minutes) * time.Minute))
+}
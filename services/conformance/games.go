@@ -0,0 +1,466 @@
+//go:build !wasm
+// +build !wasm
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+)
+
+// RunGames runs every games case against a fresh backend from newBackend
+func RunGames(t *testing.T, newBackend func(t *testing.T) GamesBackend) {
+	for _, c := range gameCases {
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, newBackend(t))
+		})
+	}
+}
+
+var gameCases = []struct {
+	name string
+	run  func(t *testing.T, b GamesBackend)
+}{
+	{"SaveAndLoad", testGameSaveAndLoad},
+	{"LoadMissing", testGameLoadMissing},
+	{"Delete", testGameDelete},
+	{"MoveGroupHistory", testMoveGroupHistory},
+	{"OrphanMovesReplaced", testOrphanMovesReplaced},
+	{"HistoryReplaced", testHistoryReplaced},
+	{"StaleStateRejected", testStaleStateRejected},
+	{"ConcurrentMoveGroups", testConcurrentMoveGroups},
+	{"ScreenshotIndexInfoVersioned", testGameScreenshotIndexInfo},
+	{"ListGames", testListGames},
+}
+
+// gameFixture is a stored game with its initial state
+type gameFixture struct {
+	id    string
+	owner string
+}
+
+// saveGame stores a two player game owned by owner, updated minutes after
+// baseTime, along with its initial state
+func saveGame(t *testing.T, b GamesBackend, owner string, minutes int) *gameFixture {
+	t.Helper()
+	ctx := context.Background()
+	id := uniqueID("game")
+	game := &v1.Game{
+		Id:        id,
+		Name:      "Conformance " + id,
+		CreatorId: owner,
+		WorldId:   "conformance-world",
+		CreatedAt: timestampAt(minutes),
+		UpdatedAt: timestampAt(minutes),
+		Config: &v1.GameConfiguration{Players: []*v1.GamePlayer{
+			{PlayerId: 1, PlayerType: "human", UserId: owner, Name: "Player 1"},
+			{PlayerId: 2, PlayerType: "human", UserId: owner + "-rival", Name: "Player 2"},
+		}},
+	}
+	if err := b.SaveGame(ctx, id, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	state := &v1.GameState{
+		GameId:        id,
+		CurrentPlayer: 1,
+		TurnCounter:   1,
+		Status:        v1.GameStatus_GAME_STATUS_PLAYING,
+		WorldData: &v1.WorldData{
+			TilesMap: map[string]*v1.Tile{"0,0": {Q: 0, R: 0, TileType: 1, Player: 1}},
+			UnitsMap: map[string]*v1.Unit{"0,0": {Q: 0, R: 0, Player: 1, UnitType: 1}},
+		},
+	}
+	if err := b.SaveGameState(ctx, id, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+	return &gameFixture{id: id, owner: owner}
+}
+
+// nextGroup advances state to its next move group and returns the group -
+// numbered the way ProcessMoves numbers them
+func nextGroup(state *v1.GameState, moves ...*v1.GameMove) *v1.GameMoveGroup {
+	state.CurrentGroupNumber++
+	return &v1.GameMoveGroup{GroupNumber: state.CurrentGroupNumber, Moves: moves}
+}
+
+func moveUnit(q, r int32) *v1.GameMove {
+	return &v1.GameMove{Player: 1, MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{
+		From: &v1.Position{Q: q, R: r},
+		To:   &v1.Position{Q: q + 1, R: r},
+	}}}
+}
+
+func endTurn() *v1.GameMove {
+	return &v1.GameMove{Player: 1, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+}
+
+// describeGroups summarizes groups as "1:move,end 2:move" for comparisons
+func describeGroups(groups []*v1.GameMoveGroup) string {
+	out := ""
+	for i, group := range groups {
+		if i > 0 {
+			out += " "
+		}
+		out += fmt.Sprintf("%d:", group.GroupNumber)
+		for j, move := range group.Moves {
+			if j > 0 {
+				out += ","
+			}
+			switch {
+			case move.GetMoveUnit() != nil:
+				out += "move"
+			case move.GetEndTurn() != nil:
+				out += "end"
+			default:
+				out += "?"
+			}
+		}
+	}
+	return out
+}
+
+func testGameSaveAndLoad(t *testing.T, b GamesBackend) {
+	ctx := context.Background()
+	g := saveGame(t, b, uniqueID("alice"), 0)
+
+	game, err := b.LoadGame(ctx, g.id)
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	if game.Id != g.id || game.CreatorId != g.owner || len(game.Config.GetPlayers()) != 2 {
+		t.Errorf("Expected game %s owned by %s with 2 players, got %v", g.id, g.owner, game)
+	}
+
+	state, err := b.LoadGameState(ctx, g.id)
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+	if state.CurrentPlayer != 1 || len(state.WorldData.GetTilesMap()) != 1 || len(state.WorldData.GetUnitsMap()) != 1 {
+		t.Errorf("Expected the saved state back, got %v", state)
+	}
+
+	// Through the API - IDs must be populated by every backend
+	resp, err := b.GetGame(ctx, &v1.GetGameRequest{Id: g.id})
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	if resp.Game.GetId() != g.id || resp.State == nil || resp.History == nil {
+		t.Errorf("Expected game %s with state and history, got %v", g.id, resp)
+	}
+}
+
+func testGameLoadMissing(t *testing.T, b GamesBackend) {
+	ctx := context.Background()
+	if _, err := b.LoadGame(ctx, uniqueID("missing")); err == nil {
+		t.Error("Expected LoadGame of a missing game to fail")
+	}
+	if _, err := b.GetGame(ctx, &v1.GetGameRequest{Id: uniqueID("missing")}); err == nil {
+		t.Error("Expected GetGame of a missing game to fail")
+	}
+}
+
+func testGameDelete(t *testing.T, b GamesBackend) {
+	ctx := context.Background()
+	g := saveGame(t, b, uniqueID("alice"), 0)
+	state, _ := b.LoadGameState(ctx, g.id)
+	if err := b.SaveMoveGroup(ctx, g.id, state, nextGroup(state, moveUnit(0, 0))); err != nil {
+		t.Fatalf("SaveMoveGroup failed: %v", err)
+	}
+
+	if _, err := b.DeleteGame(userContext(g.owner+"-rival"), &v1.DeleteGameRequest{Id: g.id}); err == nil {
+		t.Error("Expected only the creator to be able to delete the game")
+	}
+	if _, err := b.DeleteGame(userContext(g.owner), &v1.DeleteGameRequest{Id: g.id}); err != nil {
+		t.Fatalf("DeleteGame failed: %v", err)
+	}
+	if _, err := b.LoadGame(ctx, g.id); err == nil {
+		t.Error("Expected the deleted game to be gone")
+	}
+	if history, err := b.LoadGameHistory(ctx, g.id); err == nil && len(history.Groups) > 0 {
+		t.Errorf("Expected the deleted game's moves to be gone, got %s", describeGroups(history.Groups))
+	}
+	resp, err := b.ListGames(ctx, &v1.ListGamesRequest{OwnerId: g.owner})
+	if err != nil {
+		t.Fatalf("ListGames failed: %v", err)
+	}
+	if len(resp.Items) != 0 {
+		t.Errorf("Expected the deleted game not to be listed, got %v", resp.Items)
+	}
+}
+
+func testMoveGroupHistory(t *testing.T, b GamesBackend) {
+	ctx := context.Background()
+	g := saveGame(t, b, uniqueID("alice"), 0)
+
+	state, _ := b.LoadGameState(ctx, g.id)
+	groups := [][]*v1.GameMove{
+		{moveUnit(0, 0), endTurn()},
+		{moveUnit(1, 0)},
+		{moveUnit(2, 0), moveUnit(3, 0), endTurn()},
+	}
+	for _, moves := range groups {
+		if err := b.SaveMoveGroup(ctx, g.id, state, nextGroup(state, moves...)); err != nil {
+			t.Fatalf("SaveMoveGroup failed: %v", err)
+		}
+	}
+
+	want := "1:move,end 2:move 3:move,move,end"
+	history, err := b.LoadGameHistory(ctx, g.id)
+	if err != nil {
+		t.Fatalf("LoadGameHistory failed: %v", err)
+	}
+	if got := describeGroups(history.Groups); got != want {
+		t.Errorf("Expected history %q, got %q", want, got)
+	}
+	for _, group := range history.Groups {
+		for i, move := range group.Moves {
+			if move.GroupNumber != group.GroupNumber || move.MoveNumber != int64(i) {
+				t.Errorf("Expected move %d of group %d to be numbered so, got %d/%d", i, group.GroupNumber, move.GroupNumber, move.MoveNumber)
+			}
+		}
+	}
+
+	saved, _ := b.LoadGameState(ctx, g.id)
+	if saved.CurrentGroupNumber != 3 {
+		t.Errorf("Expected the state to record group 3, got %d", saved.CurrentGroupNumber)
+	}
+
+	moves, err := b.ListMoves(ctx, &v1.ListMovesRequest{GameId: g.id, FromGroup: 2})
+	if err != nil {
+		t.Fatalf("ListMoves failed: %v", err)
+	}
+	if got := describeGroups(moves.MoveGroups); got != "2:move 3:move,move,end" || !moves.HasMore {
+		t.Errorf("Expected groups 2 and 3 with earlier ones remaining, got %q (has more %v)", got, moves.HasMore)
+	}
+}
+
+// A crash between saving moves and saving the state leaves orphan moves for
+// a group the state never reached - the retry must replace them
+func testOrphanMovesReplaced(t *testing.T, b GamesBackend) {
+	ctx := context.Background()
+	g := saveGame(t, b, uniqueID("alice"), 0)
+
+	state, _ := b.LoadGameState(ctx, g.id)
+	if err := b.SaveMoves(ctx, g.id, &v1.GameMoveGroup{GroupNumber: 1, Moves: []*v1.GameMove{moveUnit(0, 0), moveUnit(1, 0)}}, 1); err != nil {
+		t.Fatalf("SaveMoves failed: %v", err)
+	}
+	if err := b.SaveMoveGroup(ctx, g.id, state, nextGroup(state, endTurn())); err != nil {
+		t.Fatalf("SaveMoveGroup failed: %v", err)
+	}
+
+	history, _ := b.LoadGameHistory(ctx, g.id)
+	if got := describeGroups(history.Groups); got != "1:end" {
+		t.Errorf("Expected the orphan moves to be replaced, got %q", got)
+	}
+}
+
+// Restoring a game (ww migrate game) replaces its state and history through
+// UpdateGame - the history must be stored and the state kept as it was
+func testHistoryReplaced(t *testing.T, b GamesBackend) {
+	ctx := context.Background()
+	g := saveGame(t, b, uniqueID("alice"), 0)
+
+	state, _ := b.LoadGameState(ctx, g.id)
+	for range 3 {
+		if err := b.SaveMoveGroup(ctx, g.id, state, nextGroup(state, moveUnit(0, 0))); err != nil {
+			t.Fatalf("SaveMoveGroup failed: %v", err)
+		}
+	}
+
+	restored := &v1.GameState{
+		GameId:             g.id,
+		CurrentPlayer:      2,
+		TurnCounter:        3,
+		CurrentGroupNumber: 2,
+		Status:             v1.GameStatus_GAME_STATUS_PLAYING,
+		WorldData: &v1.WorldData{
+			TilesMap: map[string]*v1.Tile{"0,0": {Q: 0, R: 0, TileType: 1, Player: 1}},
+			UnitsMap: map[string]*v1.Unit{"0,0": {Q: 0, R: 0, Player: 1, UnitType: 1, AvailableHealth: 4, LastToppedupTurn: 2}},
+		},
+	}
+	history := &v1.GameMoveHistory{GameId: g.id, Groups: []*v1.GameMoveGroup{
+		{GroupNumber: 1, Moves: []*v1.GameMove{endTurn()}},
+		{GroupNumber: 2, Moves: []*v1.GameMove{moveUnit(0, 0), endTurn()}},
+	}}
+	if _, err := b.UpdateGame(ctx, &v1.UpdateGameRequest{GameId: g.id, NewState: restored, NewHistory: history}); err != nil {
+		t.Fatalf("UpdateGame failed: %v", err)
+	}
+
+	saved, err := b.LoadGameHistory(ctx, g.id)
+	if err != nil {
+		t.Fatalf("LoadGameHistory failed: %v", err)
+	}
+	if got := describeGroups(saved.Groups); got != "1:end 2:move,end" {
+		t.Errorf("Expected the history replaced, got %q", got)
+	}
+	state, _ = b.LoadGameState(ctx, g.id)
+	unit := state.WorldData.GetUnitsMap()["0,0"]
+	if unit.GetAvailableHealth() != 4 || unit.GetDistanceLeft() != 0 || unit.GetLastToppedupTurn() != 2 {
+		t.Errorf("Expected the restored unit saved as is, got %v", unit)
+	}
+}
+
+func requireTransactional(t *testing.T, b GamesBackend) {
+	t.Helper()
+	if _, ok := b.(services.TransactionalMoveSaver); !ok {
+		t.Skip("backend does not lock game state versions")
+	}
+}
+
+func testStaleStateRejected(t *testing.T, b GamesBackend) {
+	requireTransactional(t, b)
+	ctx := context.Background()
+	g := saveGame(t, b, uniqueID("alice"), 0)
+
+	first, _ := b.LoadGameState(ctx, g.id)
+	second, _ := b.LoadGameState(ctx, g.id)
+	if err := b.SaveMoveGroup(ctx, g.id, first, nextGroup(first, moveUnit(0, 0))); err != nil {
+		t.Fatalf("SaveMoveGroup failed: %v", err)
+	}
+	if first.Version != second.Version+1 {
+		t.Errorf("Expected saving to bump the version from %d, got %d", second.Version, first.Version)
+	}
+
+	err := b.SaveMoveGroup(ctx, g.id, second, nextGroup(second, endTurn()))
+	if !errors.Is(err, services.ErrStaleGameState) {
+		t.Fatalf("Expected ErrStaleGameState saving an outdated state, got %v", err)
+	}
+	history, _ := b.LoadGameHistory(ctx, g.id)
+	if got := describeGroups(history.Groups); got != "1:move" {
+		t.Errorf("Expected the rejected group to leave no moves, got %q", got)
+	}
+	saved, _ := b.LoadGameState(ctx, g.id)
+	if saved.Version != first.Version {
+		t.Errorf("Expected the stored version to stay %d, got %d", first.Version, saved.Version)
+	}
+}
+
+func testConcurrentMoveGroups(t *testing.T, b GamesBackend) {
+	requireTransactional(t, b)
+	ctx := context.Background()
+	g := saveGame(t, b, uniqueID("alice"), 0)
+
+	// Every request starts from the same state - only one may win
+	states := make([]*v1.GameState, Concurrency)
+	for i := range states {
+		states[i], _ = b.LoadGameState(ctx, g.id)
+	}
+	errs := make([]error, Concurrency)
+	var wg sync.WaitGroup
+	for i := range states {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = b.SaveMoveGroup(ctx, g.id, states[i], nextGroup(states[i], moveUnit(int32(i), 0)))
+		}(i)
+	}
+	wg.Wait()
+
+	won := 0
+	for _, err := range errs {
+		if err == nil {
+			won++
+		} else if !errors.Is(err, services.ErrStaleGameState) {
+			t.Errorf("Expected losers to get ErrStaleGameState, got %v", err)
+		}
+	}
+	if won != 1 {
+		t.Errorf("Expected exactly one concurrent save to win, got %d", won)
+	}
+	history, _ := b.LoadGameHistory(ctx, g.id)
+	if got := describeGroups(history.Groups); got != "1:move" {
+		t.Errorf("Expected only the winner's move, got %q", got)
+	}
+}
+
+func testGameScreenshotIndexInfo(t *testing.T, b GamesBackend) {
+	ctx := context.Background()
+	g := saveGame(t, b, uniqueID("alice"), 0)
+
+	version, err := b.GetGameStateVersion(ctx, g.id)
+	if err != nil {
+		t.Fatalf("GetGameStateVersion failed: %v", err)
+	}
+	if err := b.UpdateGameStateScreenshotIndexInfo(ctx, g.id, version+1, baseTime, false); err == nil {
+		t.Error("Expected a mismatched version to be rejected")
+	}
+	if err := b.UpdateGameStateScreenshotIndexInfo(ctx, g.id, version, baseTime, false); err != nil {
+		t.Errorf("UpdateGameStateScreenshotIndexInfo failed: %v", err)
+	}
+	if after, _ := b.GetGameStateVersion(ctx, g.id); after != version {
+		t.Errorf("Expected index bookkeeping to keep version %d, got %d", version, after)
+	}
+}
+
+func testListGames(t *testing.T, b GamesBackend) {
+	ctx := context.Background()
+	alice, bob := uniqueID("alice"), uniqueID("bob")
+	var want []string
+	for i := range 5 {
+		g := saveGame(t, b, alice, i)
+		want = append([]string{g.id}, want...)
+	}
+	saveGame(t, b, bob, 10)
+
+	// Newest first, every game exactly once across pages
+	var got []string
+	req := &v1.ListGamesRequest{OwnerId: alice, Pagination: &v1.Pagination{PageSize: 2}}
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatalf("Expected paging to end, got %v so far", got)
+		}
+		resp, err := b.ListGames(ctx, req)
+		if err != nil {
+			t.Fatalf("ListGames failed: %v", err)
+		}
+		for _, game := range resp.Items {
+			got = append(got, game.Id)
+		}
+		if !resp.Pagination.GetHasMore() {
+			break
+		}
+		req.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Expected alice's games %v, got %v", want, got)
+	}
+
+	resp, err := b.ListGames(ctx, &v1.ListGamesRequest{OwnerId: bob})
+	if err != nil {
+		t.Fatalf("ListGames failed: %v", err)
+	}
+	if len(resp.Items) != 1 || resp.Items[0].Id == "" || resp.Items[0].CreatorId != bob {
+		t.Errorf("Expected bob's one game, got %v", resp.Items)
+	}
+
+	// Status filters and later saves are seen by the next listing
+	ended, err := b.LoadGameState(ctx, want[3])
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+	ended.Status = v1.GameStatus_GAME_STATUS_ENDED
+	if err := b.SaveGameState(ctx, want[3], ended); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+	renamed, err := b.LoadGame(ctx, want[3])
+	if err != nil {
+		t.Fatalf("LoadGame failed: %v", err)
+	}
+	renamed.Name = "Renamed " + renamed.Id
+	if err := b.SaveGame(ctx, want[3], renamed); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	resp, err = b.ListGames(ctx, &v1.ListGamesRequest{OwnerId: alice, Status: v1.GameStatus_GAME_STATUS_ENDED})
+	if err != nil {
+		t.Fatalf("ListGames failed: %v", err)
+	}
+	if len(resp.Items) != 1 || resp.Items[0].Id != want[3] || resp.Items[0].Name != renamed.Name {
+		t.Errorf("Expected only the renamed ended game %s, got %v", want[3], resp.Items)
+	}
+}
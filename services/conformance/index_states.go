@@ -0,0 +1,133 @@
+//go:build !wasm
+// +build !wasm
+
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RunIndexStates runs every index state store case against a fresh store
+// from newStore
+func RunIndexStates(t *testing.T, newStore func(t *testing.T) services.IndexStateStore) {
+	for _, c := range indexStateCases {
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, newStore(t))
+		})
+	}
+}
+
+var indexStateCases = []struct {
+	name string
+	run  func(t *testing.T, s services.IndexStateStore)
+}{
+	{"IndexStateSaveAndLoad", testIndexStateSaveAndLoad},
+	{"ListIndexStates", testListIndexStates},
+}
+
+// newTestIndexState returns a pending state updated at minutes past baseTime
+func newTestIndexState(entityType, entityId, indexType string, minutes int) *v1.IndexState {
+	return &v1.IndexState{
+		EntityType:    entityType,
+		EntityId:      entityId,
+		IndexType:     indexType,
+		CreatedAt:     timestampAt(minutes),
+		UpdatedAt:     timestampAt(minutes),
+		NeedsIndexing: true,
+		Status:        v1.IndexStatus_INDEX_STATUS_PENDING,
+	}
+}
+
+func testIndexStateSaveAndLoad(t *testing.T, s services.IndexStateStore) {
+	ctx := context.Background()
+	entityType := uniqueID("type")
+	if _, err := s.LoadIndexState(ctx, entityType, "w1", "screenshots"); status.Code(err) != codes.NotFound {
+		t.Fatalf("Expected NotFound loading a missing state, got %v", err)
+	}
+	state := newTestIndexState(entityType, "w1", "screenshots", 5)
+	if err := s.SaveIndexState(ctx, state); err != nil {
+		t.Fatalf("SaveIndexState failed: %v", err)
+	}
+
+	// Saving again replaces the state rather than adding another
+	state.Status = v1.IndexStatus_INDEX_STATUS_FAILED
+	state.LastError = "render failed"
+	state.RetryCount = 2
+	state.IndexedAt = timestampAt(10)
+	if err := s.SaveIndexState(ctx, state); err != nil {
+		t.Fatalf("SaveIndexState failed: %v", err)
+	}
+	loaded, err := s.LoadIndexState(ctx, entityType, "w1", "screenshots")
+	if err != nil {
+		t.Fatalf("LoadIndexState failed: %v", err)
+	}
+	if loaded.Status != v1.IndexStatus_INDEX_STATUS_FAILED || loaded.LastError != "render failed" || loaded.RetryCount != 2 || !loaded.NeedsIndexing {
+		t.Errorf("Loaded state %v does not match saved %v", loaded, state)
+	}
+	if !loaded.UpdatedAt.AsTime().Equal(baseTime.Add(5*time.Minute)) || !loaded.IndexedAt.AsTime().Equal(baseTime.Add(10*time.Minute)) {
+		t.Errorf("Expected updated_at and indexed_at kept as saved, got %v and %v", loaded.UpdatedAt.AsTime(), loaded.IndexedAt.AsTime())
+	}
+	if states, err := s.ListIndexStates(ctx, services.IndexStateFilter{EntityType: entityType}); err != nil || len(states) != 1 {
+		t.Errorf("Expected one state after saving twice, got %v (%v)", states, err)
+	}
+	if _, err := s.LoadIndexState(ctx, entityType, "w1", "keywords"); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound loading another index type, got %v", err)
+	}
+}
+
+func testListIndexStates(t *testing.T, s services.IndexStateStore) {
+	ctx := context.Background()
+	entityType := uniqueID("type")
+	for i, entityId := range []string{"c", "a", "b"} {
+		state := newTestIndexState(entityType, entityId, "screenshots", i)
+		if entityId == "b" {
+			state.Status = v1.IndexStatus_INDEX_STATUS_FAILED
+		}
+		if err := s.SaveIndexState(ctx, state); err != nil {
+			t.Fatalf("SaveIndexState failed: %v", err)
+		}
+	}
+	for _, state := range []*v1.IndexState{
+		newTestIndexState(entityType, "a", "keywords", 0),
+		newTestIndexState(uniqueID("other"), "a", "screenshots", 0),
+	} {
+		if err := s.SaveIndexState(ctx, state); err != nil {
+			t.Fatalf("SaveIndexState failed: %v", err)
+		}
+	}
+
+	entityIds := func(filter services.IndexStateFilter) []string {
+		t.Helper()
+		filter.EntityType = entityType
+		states, err := s.ListIndexStates(ctx, filter)
+		if err != nil {
+			t.Fatalf("ListIndexStates failed: %v", err)
+		}
+		var ids []string
+		for _, state := range states {
+			ids = append(ids, state.EntityId+"/"+state.IndexType)
+		}
+		return ids
+	}
+	for _, tc := range []struct {
+		name   string
+		filter services.IndexStateFilter
+		want   []string
+	}{
+		{"all by entity", services.IndexStateFilter{}, []string{"a/keywords", "a/screenshots", "b/screenshots", "c/screenshots"}},
+		{"index type", services.IndexStateFilter{IndexType: "screenshots"}, []string{"a/screenshots", "b/screenshots", "c/screenshots"}},
+		{"failed", services.IndexStateFilter{IndexType: "screenshots", Status: v1.IndexStatus_INDEX_STATUS_FAILED}, []string{"b/screenshots"}},
+		{"limited", services.IndexStateFilter{IndexType: "screenshots", Limit: 2}, []string{"a/screenshots", "b/screenshots"}},
+	} {
+		if got := entityIds(tc.filter); !equalStrings(got, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+//go:build !wasm
+// +build !wasm
+
+package conformance
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RunJobs runs every job store case against a fresh store from newStore
+func RunJobs(t *testing.T, newStore func(t *testing.T) services.JobStore) {
+	for _, c := range jobCases {
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, newStore(t))
+		})
+	}
+}
+
+var jobCases = []struct {
+	name string
+	run  func(t *testing.T, s services.JobStore)
+}{
+	{"SaveAndLoad", testJobSaveAndLoad},
+	{"Versioned", testJobVersioned},
+	{"ListJobs", testListJobs},
+	{"Runs", testJobRuns},
+	{"ClaimedOnce", testJobClaimedOnce},
+}
+
+// newTestJob returns a pending job of jobType due at minutes past baseTime
+func newTestJob(jobType, entityId string, minutes int) *v1.Job {
+	return &v1.Job{
+		Id:         services.JobID(jobType, "games", entityId),
+		JobType:    jobType,
+		EntityType: "games",
+		EntityId:   entityId,
+		Status:     v1.JobStatus_JOB_STATUS_PENDING,
+		CreatedAt:  timestampAt(0),
+		UpdatedAt:  timestampAt(0),
+		RunAfter:   timestampAt(minutes),
+	}
+}
+
+func testJobSaveAndLoad(t *testing.T, s services.JobStore) {
+	ctx := context.Background()
+	job := newTestJob(uniqueID("type"), "g1", 5)
+	if _, err := s.LoadJob(ctx, job.Id); status.Code(err) != codes.NotFound {
+		t.Fatalf("Expected NotFound loading a missing job, got %v", err)
+	}
+	if err := s.SaveJob(ctx, job, 0); err != nil {
+		t.Fatalf("SaveJob failed: %v", err)
+	}
+	if job.Version != 1 {
+		t.Errorf("Expected saved job at version 1, got %d", job.Version)
+	}
+	loaded, err := s.LoadJob(ctx, job.Id)
+	if err != nil {
+		t.Fatalf("LoadJob failed: %v", err)
+	}
+	if loaded.JobType != job.JobType || loaded.EntityId != "g1" || loaded.Version != 1 ||
+		loaded.Status != v1.JobStatus_JOB_STATUS_PENDING || !loaded.RunAfter.AsTime().Equal(job.RunAfter.AsTime()) {
+		t.Errorf("Loaded job %v does not match saved %v", loaded, job)
+	}
+}
+
+func testJobVersioned(t *testing.T, s services.JobStore) {
+	ctx := context.Background()
+	job := newTestJob(uniqueID("type"), "g1", 5)
+	if err := s.SaveJob(ctx, job, 0); err != nil {
+		t.Fatalf("SaveJob failed: %v", err)
+	}
+	if err := s.SaveJob(ctx, newTestJob(job.JobType, "g1", 5), 0); err != services.ErrJobConflict {
+		t.Errorf("Expected ErrJobConflict creating an existing job, got %v", err)
+	}
+	job.Attempts = 2
+	if err := s.SaveJob(ctx, job, 1); err != nil {
+		t.Fatalf("SaveJob at version 1 failed: %v", err)
+	}
+	job.Attempts = 3
+	if err := s.SaveJob(ctx, job, 1); err != services.ErrJobConflict {
+		t.Errorf("Expected ErrJobConflict saving a stale job, got %v", err)
+	}
+	loaded, err := s.LoadJob(ctx, job.Id)
+	if err != nil {
+		t.Fatalf("LoadJob failed: %v", err)
+	}
+	if loaded.Version != 2 || loaded.Attempts != 2 {
+		t.Errorf("Expected version 2 with 2 attempts, got version %d with %d", loaded.Version, loaded.Attempts)
+	}
+	if err := s.SaveJob(ctx, newTestJob(uniqueID("type"), "g2", 5), 3); err != services.ErrJobConflict {
+		t.Errorf("Expected ErrJobConflict updating a missing job, got %v", err)
+	}
+}
+
+func testListJobs(t *testing.T, s services.JobStore) {
+	ctx := context.Background()
+	jobType := uniqueID("type")
+	for i, minutes := range []int{30, 10, 20} {
+		job := newTestJob(jobType, string(rune('a'+i)), minutes)
+		if i == 2 {
+			job.Status = v1.JobStatus_JOB_STATUS_FAILED
+		}
+		if err := s.SaveJob(ctx, job, 0); err != nil {
+			t.Fatalf("SaveJob failed: %v", err)
+		}
+	}
+	if err := s.SaveJob(ctx, newTestJob(uniqueID("other"), "a", 0), 0); err != nil {
+		t.Fatalf("SaveJob failed: %v", err)
+	}
+
+	entityIds := func(filter services.JobFilter) []string {
+		t.Helper()
+		filter.JobType = jobType
+		jobs, err := s.ListJobs(ctx, filter)
+		if err != nil {
+			t.Fatalf("ListJobs failed: %v", err)
+		}
+		var ids []string
+		for _, job := range jobs {
+			ids = append(ids, job.EntityId)
+		}
+		return ids
+	}
+	for _, tc := range []struct {
+		name   string
+		filter services.JobFilter
+		want   []string
+	}{
+		{"all by run_after", services.JobFilter{}, []string{"b", "c", "a"}},
+		{"pending", services.JobFilter{Status: v1.JobStatus_JOB_STATUS_PENDING}, []string{"b", "a"}},
+		{"due", services.JobFilter{DueBy: baseTime.Add(20 * time.Minute)}, []string{"b", "c"}},
+		{"pending and due", services.JobFilter{Status: v1.JobStatus_JOB_STATUS_PENDING, DueBy: baseTime.Add(time.Hour)}, []string{"b", "a"}},
+		{"limited", services.JobFilter{Limit: 1}, []string{"b"}},
+	} {
+		if got := entityIds(tc.filter); !equalStrings(got, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func testJobRuns(t *testing.T, s services.JobStore) {
+	ctx := context.Background()
+	jobId := services.JobID(uniqueID("type"), "games", "g1")
+	for i, runId := range []string{"r1", "r2", "r3"} {
+		run := &v1.Run{JobId: jobId, RunId: runId, CreatedAt: timestampAt(i), State: v1.RunState_RUN_STATE_STARTED}
+		if err := s.SaveRun(ctx, run); err != nil {
+			t.Fatalf("SaveRun failed: %v", err)
+		}
+	}
+	// Finishing a run replaces it
+	if err := s.SaveRun(ctx, &v1.Run{JobId: jobId, RunId: "r2", CreatedAt: timestampAt(1), State: v1.RunState_RUN_STATE_FAILED, LastError: "boom"}); err != nil {
+		t.Fatalf("SaveRun failed: %v", err)
+	}
+
+	runs, err := s.ListRuns(ctx, jobId, 0)
+	if err != nil {
+		t.Fatalf("ListRuns failed: %v", err)
+	}
+	if len(runs) != 3 || runs[0].RunId != "r3" || runs[1].RunId != "r2" || runs[2].RunId != "r1" {
+		t.Fatalf("Expected runs r3, r2, r1, got %v", runs)
+	}
+	if runs[1].State != v1.RunState_RUN_STATE_FAILED || runs[1].LastError != "boom" {
+		t.Errorf("Expected r2 to be replaced by its failed run, got %v", runs[1])
+	}
+	if runs, err := s.ListRuns(ctx, jobId, 2); err != nil || len(runs) != 2 || runs[0].RunId != "r3" {
+		t.Errorf("Expected the 2 newest runs, got %v (%v)", runs, err)
+	}
+}
+
+// testJobClaimedOnce tests that runners racing over the store run each due
+// job exactly once
+func testJobClaimedOnce(t *testing.T, s services.JobStore) {
+	ctx := context.Background()
+	jobType := uniqueID("type")
+	var calls atomic.Int64
+	newRunner := func() *services.JobRunner {
+		runner := services.NewJobRunner(s)
+		runner.Handle(jobType, func(ctx context.Context, job *v1.Job) error {
+			calls.Add(1)
+			return nil
+		})
+		return runner
+	}
+	const jobs = 3
+	for i := range jobs {
+		if _, err := newRunner().Enqueue(ctx, &v1.Job{JobType: jobType, EntityType: "games", EntityId: string(rune('a' + i))}); err != nil {
+			t.Fatalf("Enqueue failed: %v", err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for range Concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := newRunner().RunDue(ctx); err != nil {
+				t.Errorf("RunDue failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != jobs {
+		t.Errorf("Expected %d runs, got %d", jobs, calls.Load())
+	}
+	done, err := s.ListJobs(ctx, services.JobFilter{JobType: jobType, Status: v1.JobStatus_JOB_STATUS_SUCCEEDED})
+	if err != nil || len(done) != jobs {
+		t.Errorf("Expected %d succeeded jobs, got %d (%v)", jobs, len(done), err)
+	}
+}
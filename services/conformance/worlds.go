@@ -0,0 +1,540 @@
+//go:build !wasm
+// +build !wasm
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// RunWorlds runs every worlds case against a fresh backend from newBackend
+func RunWorlds(t *testing.T, newBackend func(t *testing.T) WorldsBackend) {
+	for _, c := range worldCases {
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, newBackend(t))
+		})
+	}
+}
+
+var worldCases = []struct {
+	name string
+	run  func(t *testing.T, b WorldsBackend)
+}{
+	{"CreateAndGet", testWorldCreateAndGet},
+	{"DuplicateID", testWorldDuplicateID},
+	{"UpdateVersioned", testWorldUpdateVersioned},
+	{"ConcurrentUpdates", testWorldConcurrentUpdates},
+	{"IndexInfoVersioned", testWorldIndexInfo},
+	{"Delete", testWorldDelete},
+	{"ListWorlds", testListWorlds},
+	{"Revisions", testWorldRevisions},
+	{"RevertWorld", testRevertWorld},
+	{"DeleteRevisions", testWorldDeleteRevisions},
+	{"CopyLineage", testWorldCopyLineage},
+	{"MergeUpstream", testMergeWorldUpstream},
+}
+
+// createWorld creates a one tile world owned by owner under a mixed case
+// ID, returning the ID the backend assigned
+func createWorld(t *testing.T, b WorldsBackend, owner, name string) string {
+	t.Helper()
+	resp, err := b.CreateWorld(userContext(owner), &v1.CreateWorldRequest{
+		World: &v1.World{
+			Id:        uniqueID("World"),
+			Name:      name,
+			CreatorId: owner,
+		},
+		WorldData: &v1.WorldData{
+			TilesMap: map[string]*v1.Tile{"0,0": {Q: 0, R: 0, TileType: 1}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateWorld failed: %v", err)
+	}
+	if len(resp.FieldErrors) > 0 {
+		t.Fatalf("CreateWorld rejected the world: %v", resp.FieldErrors)
+	}
+	return resp.World.Id
+}
+
+func testWorldCreateAndGet(t *testing.T, b WorldsBackend) {
+	owner := uniqueID("alice")
+	id := createWorld(t, b, owner, "Conformance")
+	if id != strings.ToLower(id) {
+		t.Errorf("Expected world IDs to be normalized to lower case, got %s", id)
+	}
+
+	// Lookups normalize too
+	resp, err := b.GetWorld(context.Background(), &v1.GetWorldRequest{Id: strings.ToUpper(id)})
+	if err != nil {
+		t.Fatalf("GetWorld failed: %v", err)
+	}
+	if resp.World.GetId() != id || resp.World.Name != "Conformance" || resp.World.CreatorId != owner {
+		t.Errorf("Expected world %s named Conformance, got %v", id, resp.World)
+	}
+	if len(resp.WorldData.GetTilesMap()) != 1 {
+		t.Errorf("Expected the world's tile back, got %v", resp.WorldData)
+	}
+
+	if _, err := b.GetWorld(context.Background(), &v1.GetWorldRequest{Id: uniqueID("missing")}); err == nil {
+		t.Error("Expected GetWorld of a missing world to fail")
+	}
+}
+
+// Creating a world under a taken ID either fails or suggests another ID -
+// it never overwrites the existing world
+func testWorldDuplicateID(t *testing.T, b WorldsBackend) {
+	owner := uniqueID("alice")
+	id := createWorld(t, b, owner, "Original")
+
+	resp, err := b.CreateWorld(userContext(owner), &v1.CreateWorldRequest{
+		World: &v1.World{Id: id, Name: "Duplicate", CreatorId: owner},
+	})
+	if err == nil && resp.FieldErrors["id"] == "" {
+		t.Errorf("Expected creating a taken ID to be refused, got %v", resp)
+	}
+	got, err := b.GetWorld(context.Background(), &v1.GetWorldRequest{Id: id})
+	if err != nil {
+		t.Fatalf("GetWorld failed: %v", err)
+	}
+	if got.World.Name != "Original" {
+		t.Errorf("Expected the original world to survive, got %v", got.World)
+	}
+}
+
+func updateWorldData(b WorldsBackend, owner, id string, version int64) (*v1.UpdateWorldResponse, error) {
+	return b.UpdateWorld(userContext(owner), &v1.UpdateWorldRequest{
+		World: &v1.World{Id: id},
+		WorldData: &v1.WorldData{
+			Version:  version,
+			TilesMap: map[string]*v1.Tile{"0,0": {Q: 0, R: 0, TileType: 2}},
+		},
+	})
+}
+
+func testWorldUpdateVersioned(t *testing.T, b WorldsBackend) {
+	owner := uniqueID("alice")
+	id := createWorld(t, b, owner, "Conformance")
+	ctx := context.Background()
+
+	version, err := b.GetWorldData(ctx, id)
+	if err != nil {
+		t.Fatalf("GetWorldData failed: %v", err)
+	}
+	resp, err := updateWorldData(b, owner, id, version)
+	if err != nil {
+		t.Fatalf("UpdateWorld failed: %v", err)
+	}
+	if resp.WorldData.GetVersion() != version+1 {
+		t.Errorf("Expected the update to bump the version to %d, got %d", version+1, resp.WorldData.GetVersion())
+	}
+
+	if _, err := updateWorldData(b, owner, id, version); err == nil {
+		t.Error("Expected an update from an outdated version to be rejected")
+	}
+	got, _ := b.GetWorld(ctx, &v1.GetWorldRequest{Id: id})
+	if got.WorldData.GetVersion() != version+1 || got.WorldData.GetTilesMap()["0,0"].GetTileType() != 2 {
+		t.Errorf("Expected version %d with the updated tile, got %v", version+1, got.WorldData)
+	}
+}
+
+func testWorldConcurrentUpdates(t *testing.T, b WorldsBackend) {
+	owner := uniqueID("alice")
+	id := createWorld(t, b, owner, "Conformance")
+	version, err := b.GetWorldData(context.Background(), id)
+	if err != nil {
+		t.Fatalf("GetWorldData failed: %v", err)
+	}
+
+	// Every editor saw the same version - only one may win
+	errs := make([]error, Concurrency)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = updateWorldData(b, owner, id, version)
+		}(i)
+	}
+	wg.Wait()
+
+	won := 0
+	for _, err := range errs {
+		if err == nil {
+			won++
+		}
+	}
+	if won != 1 {
+		t.Errorf("Expected exactly one concurrent update to win, got %d (%v)", won, errs)
+	}
+	if after, _ := b.GetWorldData(context.Background(), id); after != version+1 {
+		t.Errorf("Expected one version bump to %d, got %d", version+1, after)
+	}
+}
+
+func testWorldIndexInfo(t *testing.T, b WorldsBackend) {
+	ctx := context.Background()
+	id := createWorld(t, b, uniqueID("alice"), "Conformance")
+
+	version, err := b.GetWorldData(ctx, id)
+	if err != nil {
+		t.Fatalf("GetWorldData failed: %v", err)
+	}
+	if err := b.UpdateWorldDataIndexInfo(ctx, id, version+1, baseTime, false); err == nil {
+		t.Error("Expected a mismatched version to be rejected")
+	}
+	if err := b.UpdateWorldDataIndexInfo(ctx, id, version, baseTime, false); err != nil {
+		t.Errorf("UpdateWorldDataIndexInfo failed: %v", err)
+	}
+	if after, _ := b.GetWorldData(ctx, id); after != version {
+		t.Errorf("Expected index bookkeeping to keep version %d, got %d", version, after)
+	}
+}
+
+func testWorldDelete(t *testing.T, b WorldsBackend) {
+	owner := uniqueID("alice")
+	id := createWorld(t, b, owner, "Conformance")
+
+	if _, err := b.DeleteWorld(userContext(owner), &v1.DeleteWorldRequest{Id: strings.ToUpper(id)}); err != nil {
+		t.Fatalf("DeleteWorld failed: %v", err)
+	}
+	if _, err := b.GetWorld(context.Background(), &v1.GetWorldRequest{Id: id}); err == nil {
+		t.Error("Expected the deleted world to be gone")
+	}
+}
+
+func testListWorlds(t *testing.T, b WorldsBackend) {
+	ctx := context.Background()
+	alice, bob := uniqueID("alice"), uniqueID("bob")
+	var want []string
+	for i := range 5 {
+		want = append(want, createWorld(t, b, alice, fmt.Sprintf("Alice %d", i)))
+	}
+	createWorld(t, b, bob, "Bob 0")
+
+	// Worlds list A-Z by default, every world exactly once across pages
+	var got []string
+	req := &v1.ListWorldsRequest{OwnerId: alice, Pagination: &v1.Pagination{PageSize: 2}}
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatalf("Expected paging to end, got %v so far", got)
+		}
+		resp, err := b.ListWorlds(ctx, req)
+		if err != nil {
+			t.Fatalf("ListWorlds failed: %v", err)
+		}
+		for _, world := range resp.Items {
+			got = append(got, world.Id)
+		}
+		if !resp.Pagination.GetHasMore() {
+			break
+		}
+		req.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Expected alice's worlds %v, got %v", want, got)
+	}
+
+	resp, err := b.ListWorlds(ctx, &v1.ListWorldsRequest{OwnerId: bob})
+	if err != nil {
+		t.Fatalf("ListWorlds failed: %v", err)
+	}
+	if len(resp.Items) != 1 || resp.Items[0].CreatorId != bob {
+		t.Errorf("Expected bob's one world, got %v", resp.Items)
+	}
+}
+
+// Every save of a world's data is kept as an immutable revision that
+// GetWorld can fetch by version
+func testWorldRevisions(t *testing.T, b WorldsBackend) {
+	ctx := context.Background()
+	owner := uniqueID("alice")
+	id := createWorld(t, b, owner, "Conformance")
+	first, err := b.GetWorldData(ctx, id)
+	if err != nil {
+		t.Fatalf("GetWorldData failed: %v", err)
+	}
+	if first < 1 {
+		t.Errorf("Expected new worlds to start at version 1, got %d", first)
+	}
+	if _, err := updateWorldData(b, owner, id, first); err != nil {
+		t.Fatalf("UpdateWorld failed: %v", err)
+	}
+
+	resp, err := b.ListWorldRevisions(ctx, &v1.ListWorldRevisionsRequest{WorldId: strings.ToUpper(id)})
+	if err != nil {
+		t.Fatalf("ListWorldRevisions failed: %v", err)
+	}
+	if len(resp.Items) != 2 || resp.Items[0].Revision != first+1 || resp.Items[1].Revision != first {
+		t.Fatalf("Expected revisions %d and %d newest first, got %v", first+1, first, resp.Items)
+	}
+	if resp.Items[0].AuthorId != owner || resp.Items[0].TileCount != 1 || resp.Items[0].WorldData != nil {
+		t.Errorf("Expected a summary of alice's revision without its data, got %v", resp.Items[0])
+	}
+	if resp.Items[0].ContentHash == resp.Items[1].ContentHash {
+		t.Error("Expected revisions with different tiles to hash differently")
+	}
+
+	// The old revision still has the original tile
+	old, err := b.GetWorld(ctx, &v1.GetWorldRequest{Id: id, Version: fmt.Sprint(first)})
+	if err != nil {
+		t.Fatalf("GetWorld of revision %d failed: %v", first, err)
+	}
+	if old.WorldData.GetVersion() != first || old.WorldData.GetTilesMap()["0,0"].GetTileType() != 1 {
+		t.Errorf("Expected revision %d with the original tile, got %v", first, old.WorldData)
+	}
+	latest, _ := b.GetWorld(ctx, &v1.GetWorldRequest{Id: id})
+	if latest.WorldData.GetTilesMap()["0,0"].GetTileType() != 2 {
+		t.Errorf("Expected the latest data to keep the updated tile, got %v", latest.WorldData)
+	}
+	if _, err := b.GetWorld(ctx, &v1.GetWorldRequest{Id: id, Version: fmt.Sprint(first + 10)}); err == nil {
+		t.Error("Expected GetWorld of a missing revision to fail")
+	}
+	if _, err := b.GetWorld(ctx, &v1.GetWorldRequest{Id: id, Version: "draft"}); err == nil {
+		t.Error("Expected GetWorld of an invalid version to fail")
+	}
+}
+
+// Reverting saves the old data as a new revision and keeps the ones in
+// between
+func testRevertWorld(t *testing.T, b WorldsBackend) {
+	ctx := context.Background()
+	owner := uniqueID("alice")
+	id := createWorld(t, b, owner, "Conformance")
+	first, _ := b.GetWorldData(ctx, id)
+	if _, err := updateWorldData(b, owner, id, first); err != nil {
+		t.Fatalf("UpdateWorld failed: %v", err)
+	}
+
+	if _, err := b.RevertWorld(userContext(uniqueID("mallory")), &v1.RevertWorldRequest{WorldId: id, Revision: first}); err == nil {
+		t.Error("Expected only the world's creator to be able to revert it")
+	}
+	if _, err := b.RevertWorld(userContext(owner), &v1.RevertWorldRequest{WorldId: id, Revision: first + 1}); err == nil {
+		t.Error("Expected reverting to the latest revision to be refused")
+	}
+
+	resp, err := b.RevertWorld(userContext(owner), &v1.RevertWorldRequest{WorldId: id, Revision: first})
+	if err != nil {
+		t.Fatalf("RevertWorld failed: %v", err)
+	}
+	if resp.WorldData.GetVersion() != first+2 || resp.WorldData.GetTilesMap()["0,0"].GetTileType() != 1 {
+		t.Errorf("Expected revision %d with the original tile, got %v", first+2, resp.WorldData)
+	}
+
+	revisions, err := b.ListWorldRevisions(ctx, &v1.ListWorldRevisionsRequest{WorldId: id})
+	if err != nil {
+		t.Fatalf("ListWorldRevisions failed: %v", err)
+	}
+	if len(revisions.Items) != 3 || revisions.Items[0].RevertedFrom != first {
+		t.Fatalf("Expected three revisions, the newest reverting to %d, got %v", first, revisions.Items)
+	}
+	if revisions.Items[0].ContentHash != revisions.Items[2].ContentHash {
+		t.Error("Expected the revert to hash like the revision it restored")
+	}
+
+	// Revisions are immutable - the reverted one is unchanged
+	old, _ := b.GetWorld(ctx, &v1.GetWorldRequest{Id: id, Version: fmt.Sprint(first + 1)})
+	if old.WorldData.GetTilesMap()["0,0"].GetTileType() != 2 {
+		t.Errorf("Expected revision %d to keep its tile, got %v", first+1, old.WorldData)
+	}
+}
+
+// A world created under the ID of a deleted one starts without its
+// revisions.  Backends that never reuse IDs pass trivially.
+func testWorldDeleteRevisions(t *testing.T, b WorldsBackend) {
+	ctx := context.Background()
+	owner := uniqueID("alice")
+	id := createWorld(t, b, owner, "Conformance")
+	first, _ := b.GetWorldData(ctx, id)
+	if _, err := updateWorldData(b, owner, id, first); err != nil {
+		t.Fatalf("UpdateWorld failed: %v", err)
+	}
+	if _, err := b.DeleteWorld(userContext(owner), &v1.DeleteWorldRequest{Id: id}); err != nil {
+		t.Fatalf("DeleteWorld failed: %v", err)
+	}
+
+	resp, err := b.CreateWorld(userContext(owner), &v1.CreateWorldRequest{
+		World:     &v1.World{Id: id, Name: "Recreated", CreatorId: owner},
+		WorldData: &v1.WorldData{},
+	})
+	if err != nil || len(resp.FieldErrors) > 0 {
+		return
+	}
+	if _, err := b.GetWorld(ctx, &v1.GetWorldRequest{Id: id, Version: fmt.Sprint(first + 1)}); err == nil {
+		t.Error("Expected the deleted world's revisions to be gone")
+	}
+	revisions, err := b.ListWorldRevisions(ctx, &v1.ListWorldRevisionsRequest{WorldId: id})
+	if err != nil {
+		t.Fatalf("ListWorldRevisions failed: %v", err)
+	}
+	if len(revisions.Items) != 1 {
+		t.Errorf("Expected only the new world's revision, got %v", revisions.Items)
+	}
+}
+
+// copyWorld creates a copy of a world, with the parent's data
+func copyWorld(t *testing.T, b WorldsBackend, owner, parentId string) *v1.CreateWorldResponse {
+	t.Helper()
+	resp, err := b.CreateWorld(userContext(owner), &v1.CreateWorldRequest{
+		World: &v1.World{Id: uniqueID("Remix"), Name: "Remix", CreatorId: owner, ParentWorldId: parentId},
+	})
+	if err != nil {
+		t.Fatalf("CreateWorld of a copy failed: %v", err)
+	}
+	if len(resp.FieldErrors) > 0 {
+		t.Fatalf("CreateWorld rejected the copy: %v", resp.FieldErrors)
+	}
+	return resp
+}
+
+func testWorldCopyLineage(t *testing.T, b WorldsBackend) {
+	ctx := context.Background()
+	author := uniqueID("alice")
+	parentId := createWorld(t, b, author, "Original")
+	first, _ := b.GetWorldData(ctx, parentId)
+	if _, err := updateWorldData(b, author, parentId, first); err != nil {
+		t.Fatalf("UpdateWorld failed: %v", err)
+	}
+
+	remixer := uniqueID("bob")
+	remix := copyWorld(t, b, remixer, strings.ToUpper(parentId))
+	if remix.World.ParentWorldId != parentId || remix.World.ParentRevision != first+1 {
+		t.Errorf("Expected the copy to record %s at revision %d, got %v", parentId, first+1, remix.World)
+	}
+	got, err := b.GetWorld(ctx, &v1.GetWorldRequest{Id: remix.World.Id})
+	if err != nil {
+		t.Fatalf("GetWorld failed: %v", err)
+	}
+	if got.World.ParentWorldId != parentId || got.WorldData.GetTilesMap()["0,0"].GetTileType() != 2 {
+		t.Errorf("Expected the copy to keep its lineage and the parent's map, got %v %v", got.World, got.WorldData)
+	}
+
+	// Copying an older revision records it
+	pinned, err := b.CreateWorld(userContext(remixer), &v1.CreateWorldRequest{
+		World: &v1.World{Id: uniqueID("Remix"), CreatorId: remixer, ParentWorldId: parentId, ParentRevision: first},
+	})
+	if err != nil || len(pinned.FieldErrors) > 0 {
+		t.Fatalf("CreateWorld of a pinned copy failed: %v %v", err, pinned)
+	}
+	if pinned.World.ParentRevision != first || pinned.WorldData.GetTilesMap()["0,0"].GetTileType() != 1 {
+		t.Errorf("Expected a copy of revision %d, got %v %v", first, pinned.World, pinned.WorldData)
+	}
+
+	if _, err := b.CreateWorld(userContext(remixer), &v1.CreateWorldRequest{
+		World: &v1.World{Id: uniqueID("Remix"), CreatorId: remixer, ParentWorldId: uniqueID("missing")},
+	}); err == nil {
+		t.Error("Expected copying a missing world to fail")
+	}
+
+	derived, err := b.ListDerivedWorlds(ctx, &v1.ListDerivedWorldsRequest{WorldId: parentId})
+	if err != nil {
+		t.Fatalf("ListDerivedWorlds failed: %v", err)
+	}
+	if len(derived.Items) != 2 || derived.Items[0].Id != pinned.World.Id || derived.Items[1].Id != remix.World.Id {
+		t.Errorf("Expected both copies newest first, got %v", derived.Items)
+	}
+	if others, _ := b.ListDerivedWorlds(ctx, &v1.ListDerivedWorldsRequest{WorldId: remix.World.Id}); len(others.GetItems()) != 0 {
+		t.Errorf("Expected the copy to have no copies, got %v", others.GetItems())
+	}
+}
+
+func testMergeWorldUpstream(t *testing.T, b WorldsBackend) {
+	ctx := context.Background()
+	author := uniqueID("alice")
+	parentId := createWorld(t, b, author, "Original")
+	remixer := uniqueID("bob")
+	remix := copyWorld(t, b, remixer, parentId)
+	remixId := remix.World.Id
+
+	if _, err := b.MergeWorldUpstream(userContext(remixer), &v1.MergeWorldUpstreamRequest{WorldId: remixId}); err == nil {
+		t.Error("Expected merging with no upstream changes to be refused")
+	}
+
+	// Upstream fixes the tile at 0,0 and adds one; the remix adds a unit
+	version, _ := b.GetWorldData(ctx, parentId)
+	if _, err := b.UpdateWorld(userContext(author), &v1.UpdateWorldRequest{
+		World: &v1.World{Id: parentId},
+		WorldData: &v1.WorldData{Version: version, TilesMap: map[string]*v1.Tile{
+			"0,0": {Q: 0, R: 0, TileType: 2},
+			"1,0": {Q: 1, R: 0, TileType: 3},
+		}},
+	}); err != nil {
+		t.Fatalf("UpdateWorld of the parent failed: %v", err)
+	}
+	version, _ = b.GetWorldData(ctx, remixId)
+	if _, err := b.UpdateWorld(userContext(remixer), &v1.UpdateWorldRequest{
+		World: &v1.World{Id: remixId},
+		WorldData: &v1.WorldData{
+			Version:  version,
+			TilesMap: map[string]*v1.Tile{"0,0": {Q: 0, R: 0, TileType: 1}},
+			UnitsMap: map[string]*v1.Unit{"0,0": {Q: 0, R: 0, Player: 1, UnitType: 1}},
+		},
+	}); err != nil {
+		t.Fatalf("UpdateWorld of the copy failed: %v", err)
+	}
+
+	if _, err := b.MergeWorldUpstream(userContext(uniqueID("mallory")), &v1.MergeWorldUpstreamRequest{WorldId: remixId}); err == nil {
+		t.Error("Expected only the copy's creator to be able to merge into it")
+	}
+	dryRun, err := b.MergeWorldUpstream(ctx, &v1.MergeWorldUpstreamRequest{WorldId: remixId, DryRun: true})
+	if err != nil {
+		t.Fatalf("Dry run MergeWorldUpstream failed: %v", err)
+	}
+	if dryRun.Merged || len(dryRun.Applied) != 2 || len(dryRun.Conflicts) != 0 {
+		t.Errorf("Expected a dry run applying two upstream changes, got %v", dryRun)
+	}
+
+	merged, err := b.MergeWorldUpstream(userContext(remixer), &v1.MergeWorldUpstreamRequest{WorldId: remixId})
+	if err != nil {
+		t.Fatalf("MergeWorldUpstream failed: %v", err)
+	}
+	data := merged.WorldData
+	if !merged.Merged || merged.World.ParentRevision != merged.UpstreamRevision ||
+		data.GetTilesMap()["0,0"].GetTileType() != 2 || data.GetTilesMap()["1,0"] == nil || data.GetUnitsMap()["0,0"] == nil {
+		t.Errorf("Expected upstream's tiles merged with the copy's unit, got %v %v", merged.World, data)
+	}
+
+	// Both now change the new tile, differently
+	version, _ = b.GetWorldData(ctx, parentId)
+	if _, err := b.UpdateWorld(userContext(author), &v1.UpdateWorldRequest{
+		World:     &v1.World{Id: parentId},
+		WorldData: &v1.WorldData{Version: version, TilesMap: map[string]*v1.Tile{"0,0": {Q: 0, R: 0, TileType: 2}, "1,0": {Q: 1, R: 0, TileType: 4}}},
+	}); err != nil {
+		t.Fatalf("UpdateWorld of the parent failed: %v", err)
+	}
+	version, _ = b.GetWorldData(ctx, remixId)
+	if _, err := b.UpdateWorld(userContext(remixer), &v1.UpdateWorldRequest{
+		World: &v1.World{Id: remixId},
+		WorldData: &v1.WorldData{
+			Version:  version,
+			TilesMap: map[string]*v1.Tile{"0,0": {Q: 0, R: 0, TileType: 2}, "1,0": {Q: 1, R: 0, TileType: 5}},
+			UnitsMap: map[string]*v1.Unit{"0,0": {Q: 0, R: 0, Player: 1, UnitType: 1}},
+		},
+	}); err != nil {
+		t.Fatalf("UpdateWorld of the copy failed: %v", err)
+	}
+
+	conflicted, err := b.MergeWorldUpstream(userContext(remixer), &v1.MergeWorldUpstreamRequest{WorldId: remixId})
+	if err != nil {
+		t.Fatalf("MergeWorldUpstream failed: %v", err)
+	}
+	if conflicted.Merged || len(conflicted.Conflicts) != 1 || conflicted.Conflicts[0].Key != "1,0" {
+		t.Errorf("Expected the conflicting tile to stop the merge, got %v", conflicted)
+	}
+	resolved, err := b.MergeWorldUpstream(userContext(remixer), &v1.MergeWorldUpstreamRequest{
+		WorldId:  remixId,
+		Strategy: v1.WorldMergeStrategy_WORLD_MERGE_STRATEGY_THEIRS,
+	})
+	if err != nil {
+		t.Fatalf("MergeWorldUpstream taking theirs failed: %v", err)
+	}
+	if !resolved.Merged || resolved.WorldData.GetTilesMap()["1,0"].GetTileType() != 4 {
+		t.Errorf("Expected upstream's side of the conflict, got %v", resolved.WorldData)
+	}
+}
@@ -51,6 +51,11 @@ func (c *ConnectGamesClient) SaveMoveGroup(ctx context.Context, gameId string, s
 	panic("SaveMoveGroup should not be called on ConnectGamesClient - use ProcessMoves RPC instead")
 }
 
+func (c *ConnectGamesClient) SavePendingOrders(ctx context.Context, gameId string, state *v1.GameState) error {
+	// Orders are saved by the server's CommitOrders/RevealOrders handlers.
+	panic("SavePendingOrders should not be called on ConnectGamesClient - use CommitOrders/RevealOrders RPCs instead")
+}
+
 // CreateGame creates a new game via Connect
 func (c *ConnectGamesClient) CreateGame(ctx context.Context, req *v1.CreateGameRequest) (*v1.CreateGameResponse, error) {
 	resp, err := c.client.CreateGame(ctx, connect.NewRequest(req))
@@ -159,6 +164,51 @@ func (c *ConnectGamesClient) JoinGame(ctx context.Context, req *v1.JoinGameReque
 	return resp.Msg, nil
 }
 
+// CommitOrders commits a hash of a player's orders via Connect
+func (c *ConnectGamesClient) CommitOrders(ctx context.Context, req *v1.CommitOrdersRequest) (*v1.CommitOrdersResponse, error) {
+	resp, err := c.client.CommitOrders(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// RevealOrders reveals a player's committed orders via Connect
+func (c *ConnectGamesClient) RevealOrders(ctx context.Context, req *v1.RevealOrdersRequest) (*v1.RevealOrdersResponse, error) {
+	resp, err := c.client.RevealOrders(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ListMyGames lists the caller's games via Connect
+func (c *ConnectGamesClient) ListMyGames(ctx context.Context, req *v1.ListMyGamesRequest) (*v1.ListMyGamesResponse, error) {
+	resp, err := c.client.ListMyGames(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ExportGame downloads a game archive via Connect
+func (c *ConnectGamesClient) ExportGame(ctx context.Context, req *v1.ExportGameRequest) (*v1.ExportGameResponse, error) {
+	resp, err := c.client.ExportGame(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ImportGame uploads a game archive via Connect
+func (c *ConnectGamesClient) ImportGame(ctx context.Context, req *v1.ImportGameRequest) (*v1.ImportGameResponse, error) {
+	resp, err := c.client.ImportGame(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
 // GetRuntimeGame converts proto game data to runtime game
 // This is a local operation that doesn't require the server
 func (c *ConnectGamesClient) GetRuntimeGame(game *v1.Game, gameState *v1.GameState) (*lib.Game, error) {
@@ -0,0 +1,61 @@
+package connectclient
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services/lilbattlev1connect"
+)
+
+// ConnectJobsClient wraps a Connect client for the JobsService
+type ConnectJobsClient struct {
+	client lilbattlev1connect.JobsServiceClient
+}
+
+// NewConnectJobsClientWithAuth creates a new Connect client for the
+// JobsService authenticated with token
+func NewConnectJobsClientWithAuth(serverURL, token string) *ConnectJobsClient {
+	httpClient := http.DefaultClient
+	if token != "" {
+		httpClient = &http.Client{
+			Transport: &authTransport{
+				base:  http.DefaultTransport,
+				token: token,
+			},
+		}
+	}
+	client := lilbattlev1connect.NewJobsServiceClient(
+		httpClient,
+		serverURL,
+	)
+	return &ConnectJobsClient{client: client}
+}
+
+// ListJobs returns a page of jobs via Connect
+func (c *ConnectJobsClient) ListJobs(ctx context.Context, req *v1.ListJobsRequest) (*v1.ListJobsResponse, error) {
+	resp, err := c.client.ListJobs(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetJob returns a job with its recent runs via Connect
+func (c *ConnectJobsClient) GetJob(ctx context.Context, req *v1.GetJobRequest) (*v1.GetJobResponse, error) {
+	resp, err := c.client.GetJob(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// RetryJob makes a job due now via Connect
+func (c *ConnectJobsClient) RetryJob(ctx context.Context, req *v1.RetryJobRequest) (*v1.RetryJobResponse, error) {
+	resp, err := c.client.RetryJob(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
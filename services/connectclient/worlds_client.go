@@ -7,6 +7,7 @@ import (
 	"connectrpc.com/connect"
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	"github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services/lilbattlev1connect"
+	"google.golang.org/protobuf/proto"
 )
 
 // authTransport wraps an http.RoundTripper to add Authorization headers
@@ -68,6 +69,27 @@ func (c *ConnectWorldsClient) ListWorlds(ctx context.Context, req *v1.ListWorlds
 	return resp.Msg, nil
 }
 
+// ListAllWorlds follows page keys until every world matching the request
+// has been listed
+func (c *ConnectWorldsClient) ListAllWorlds(ctx context.Context, req *v1.ListWorldsRequest) ([]*v1.World, error) {
+	var worlds []*v1.World
+	pageReq := proto.Clone(req).(*v1.ListWorldsRequest)
+	if pageReq.Pagination == nil {
+		pageReq.Pagination = &v1.Pagination{}
+	}
+	for {
+		resp, err := c.ListWorlds(ctx, pageReq)
+		if err != nil {
+			return nil, err
+		}
+		worlds = append(worlds, resp.Items...)
+		if resp.Pagination == nil || !resp.Pagination.HasMore || resp.Pagination.NextPageKey == "" {
+			return worlds, nil
+		}
+		pageReq.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+}
+
 // CreateWorld creates a new world via Connect
 func (c *ConnectWorldsClient) CreateWorld(ctx context.Context, req *v1.CreateWorldRequest) (*v1.CreateWorldResponse, error) {
 	resp, err := c.client.CreateWorld(ctx, connect.NewRequest(req))
@@ -86,6 +108,51 @@ func (c *ConnectWorldsClient) UpdateWorld(ctx context.Context, req *v1.UpdateWor
 	return resp.Msg, nil
 }
 
+// ListWorldRevisions lists a world's revisions via Connect
+func (c *ConnectWorldsClient) ListWorldRevisions(ctx context.Context, req *v1.ListWorldRevisionsRequest) (*v1.ListWorldRevisionsResponse, error) {
+	resp, err := c.client.ListWorldRevisions(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// RevertWorld reverts a world to an older revision via Connect
+func (c *ConnectWorldsClient) RevertWorld(ctx context.Context, req *v1.RevertWorldRequest) (*v1.RevertWorldResponse, error) {
+	resp, err := c.client.RevertWorld(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ListDerivedWorlds lists the worlds copied from a world via Connect
+func (c *ConnectWorldsClient) ListDerivedWorlds(ctx context.Context, req *v1.ListDerivedWorldsRequest) (*v1.ListDerivedWorldsResponse, error) {
+	resp, err := c.client.ListDerivedWorlds(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// MergeWorldUpstream merges a world's parent changes into it via Connect
+func (c *ConnectWorldsClient) MergeWorldUpstream(ctx context.Context, req *v1.MergeWorldUpstreamRequest) (*v1.MergeWorldUpstreamResponse, error) {
+	resp, err := c.client.MergeWorldUpstream(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// SearchWorlds searches worlds via Connect
+func (c *ConnectWorldsClient) SearchWorlds(ctx context.Context, req *v1.SearchWorldsRequest) (*v1.SearchWorldsResponse, error) {
+	resp, err := c.client.SearchWorlds(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
 // DeleteWorld deletes a world via Connect
 func (c *ConnectWorldsClient) DeleteWorld(ctx context.Context, req *v1.DeleteWorldRequest) (*v1.DeleteWorldResponse, error) {
 	resp, err := c.client.DeleteWorld(ctx, connect.NewRequest(req))
@@ -230,6 +230,11 @@ func (s *FileStoreService) ListFiles(ctx context.Context, req *v1.ListFilesReque
 	info, err := os.Stat(fullPath)
 	if err != nil {
 		if os.IsNotExist(err) {
+			// Recursive listings are prefix listings, as they are in object
+			// stores, so a directory nothing was written under is just empty
+			if req.Recursive {
+				return resp, nil
+			}
 			return nil, fmt.Errorf("directory not found: %s", req.Path)
 		}
 		return nil, fmt.Errorf("failed to stat directory: %w", err)
@@ -239,6 +244,32 @@ func (s *FileStoreService) ListFiles(ctx context.Context, req *v1.ListFilesReque
 		return nil, fmt.Errorf("path is not a directory: %s", req.Path)
 	}
 
+	if req.Recursive {
+		err = filepath.WalkDir(fullPath, func(path string, entry os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if entry.IsDir() {
+				return nil
+			}
+			entryInfo, err := entry.Info()
+			if err != nil {
+				return nil
+			}
+			relativePath, err := filepath.Rel(s.BasePath, path)
+			if err != nil {
+				return err
+			}
+			resp.Items = append(resp.Items, localFile(filepath.ToSlash(relativePath), entryInfo))
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to walk directory: %w", err)
+		}
+		resp.Pagination.TotalResults = int32(len(resp.Items))
+		return resp, nil
+	}
+
 	entries, err := os.ReadDir(fullPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read directory: %w", err)
@@ -258,16 +289,19 @@ func (s *FileStoreService) ListFiles(ctx context.Context, req *v1.ListFilesReque
 		if req.Path != "" && req.Path != "." {
 			relativePath = filepath.Join(req.Path, entry.Name())
 		}
-
-		file := &v1.File{
-			Path:        relativePath,
-			FileSize:    uint64(entryInfo.Size()),
-			UpdatedAt:   tspb.New(entryInfo.ModTime()),
-			DownloadUrl: fmt.Sprintf("/files/%s", relativePath),
-		}
-		resp.Items = append(resp.Items, file)
+		resp.Items = append(resp.Items, localFile(relativePath, entryInfo))
 	}
 
 	resp.Pagination.TotalResults = int32(len(resp.Items))
 	return resp, nil
 }
+
+// localFile describes a file in the store from its info
+func localFile(relativePath string, info os.FileInfo) *v1.File {
+	return &v1.File{
+		Path:        relativePath,
+		FileSize:    uint64(info.Size()),
+		UpdatedAt:   tspb.New(info.ModTime()),
+		DownloadUrl: fmt.Sprintf("/files/%s", relativePath),
+	}
+}
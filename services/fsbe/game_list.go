@@ -0,0 +1,126 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/panyam/goutils/storage"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/protobuf/proto"
+)
+
+// gameListing keeps the metadata of every game in memory so listing a page
+// does not read every game file.  An entry is reloaded when its metadata
+// file changes - by size or mod time, so writes by other processes are
+// seen - and dropped by the service's own saves and deletes.  Game states
+// are not cached: ListGames only loads them for the candidates it walks.
+type gameListing struct {
+	mu      sync.Mutex
+	entries map[string]*gameListEntry
+}
+
+type gameListEntry struct {
+	game    *v1.Game
+	modTime time.Time
+	size    int64
+}
+
+// forget drops a game's entry so the next listing reloads it
+func (l *gameListing) forget(id string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.entries, id)
+}
+
+// games returns the metadata of every game in storageDir, loading only the
+// metadata files that changed since the last call
+func (l *gameListing) games(store *storage.FileStorage, storageDir string) ([]*v1.Game, error) {
+	dirs, err := os.ReadDir(storageDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.entries == nil {
+		l.entries = map[string]*gameListEntry{}
+	}
+	seen := map[string]bool{}
+	games := make([]*v1.Game, 0, len(dirs))
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		id := dir.Name()
+		info, err := os.Stat(filepath.Join(storageDir, id, "metadata.json"))
+		if err != nil {
+			continue
+		}
+		seen[id] = true
+		entry := l.entries[id]
+		if entry == nil || !entry.modTime.Equal(info.ModTime()) || entry.size != info.Size() {
+			game, err := storage.LoadFSArtifact[*v1.Game](store, id, "metadata")
+			if err != nil {
+				continue
+			}
+			entry = &gameListEntry{game: game, modTime: info.ModTime(), size: info.Size()}
+			l.entries[id] = entry
+		}
+		games = append(games, entry.game)
+	}
+	for id := range l.entries {
+		if !seen[id] {
+			delete(l.entries, id)
+		}
+	}
+	return games, nil
+}
+
+// pageGames pages games the way services.PageItems does, but sorted before
+// filtering so it can seek to the page key and stop once the page is full.
+// status loads a game's status and is only called for the candidates
+// walked when filtering by status.  The total is only known, and returned,
+// when it needs no statuses.
+func pageGames(q *services.ListQuery, games []*v1.Game, status func(id string) (v1.GameStatus, bool)) ([]*v1.Game, *v1.PaginationResponse) {
+	sort.Slice(games, func(i, j int) bool { return q.Less(games[i], games[j]) })
+
+	byStatus := q.Status != v1.GameStatus_GAME_STATUS_UNSPECIFIED
+	matches := func(game *v1.Game) bool {
+		if !q.MatchesGame(game, q.Status) {
+			return false
+		}
+		if !byStatus {
+			return true
+		}
+		gameStatus, ok := status(game.Id)
+		return ok && gameStatus == q.Status
+	}
+
+	page := services.NewListPage[*v1.Game](q, q.PageOffset)
+	start := sort.Search(len(games), func(i int) bool { return q.IsAfterCursor(games[i]) })
+	for _, game := range games[start:] {
+		if matches(game) {
+			page.Add(proto.Clone(game).(*v1.Game))
+			if page.Full() {
+				break
+			}
+		}
+	}
+
+	total := 0
+	if !byStatus {
+		for _, game := range games {
+			if q.MatchesGame(game, q.Status) {
+				total++
+			}
+		}
+	}
+	return page.Items(), page.Pagination(total)
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/panyam/goutils/storage"
@@ -28,7 +29,25 @@ var GAMES_STORAGE_DIR = ""
 // FSGamesService implements the GamesService gRPC interface
 type FSGamesService struct {
 	services.BackendGamesService
-	storage *storage.FileStorage // Storage area for all files
+	storage    *storage.FileStorage // Storage area for all files
+	storageDir string
+
+	// User game index (see user_games.go)
+	userGames   *storage.FileStorage
+	gameUsers   *storage.FileStorage
+	userGamesMu sync.Mutex
+
+	// Game metadata kept for ListGames (see game_list.go)
+	listing gameListing
+
+	// Serializes SaveMovesAndState so its version check and writes cannot
+	// interleave (within this process - the files are not locked)
+	movesMu sync.Mutex
+
+	// Guards the move logs (see move_log.go) and which of them have been
+	// recovered since startup
+	logMu         sync.Mutex
+	recoveredLogs map[string]bool
 }
 
 // NewGamesService creates a new GamesService implementation for server mode
@@ -40,12 +59,17 @@ func NewFSGamesService(storageDir string, clientMgr *services.ClientMgr) *FSGame
 		storageDir = GAMES_STORAGE_DIR
 	}
 	service := &FSGamesService{
-		storage: storage.NewFileStorage(storageDir),
+		storage:       storage.NewFileStorage(storageDir),
+		storageDir:    storageDir,
+		recoveredLogs: map[string]bool{},
 	}
+	service.userGames, service.gameUsers = newUserGameStorages(storageDir)
 	service.ClientMgr = clientMgr
 	service.Self = service
 	service.StorageProvider = service // FSGamesService implements GameStorageProvider
 	service.GameStateUpdater = service
+	service.UserGameIndex = service
+	service.RulesStore = service
 	service.InitializeCache() // Initialize cache at BackendGamesService level
 	service.InitializeScreenshotIndexer()
 	service.InitializeSyncBroadcast()
@@ -81,51 +105,119 @@ func (s *FSGamesService) LoadGameState(ctx context.Context, id string) (*v1.Game
 	return gameState, nil
 }
 
-// LoadGameHistory implements GameStorageProvider - loads game history directly from file storage
+// LoadGameHistory implements GameStorageProvider - loads the history
+// snapshot and replays the move log over it
 func (s *FSGamesService) LoadGameHistory(ctx context.Context, id string) (*v1.GameMoveHistory, error) {
-	gameHistory, err := storage.LoadFSArtifact[*v1.GameMoveHistory](s.storage, id, "history")
-	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return nil, status.Errorf(codes.NotFound, "game history for %s not found", id)
-		}
-		return nil, fmt.Errorf("failed to load game history: %w", err)
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	if err := s.recoverMoveLog(id); err != nil {
+		return nil, err
 	}
-	return gameHistory, nil
+	history, _, err := s.loadMoveHistory(id)
+	return history, err
 }
 
 // SaveGame implements GameStorageProvider - saves game metadata to file storage
 func (s *FSGamesService) SaveGame(ctx context.Context, id string, game *v1.Game) error {
+	defer s.listing.forget(id)
 	return s.storage.SaveArtifact(id, "metadata", game)
 }
 
-// SaveGameState implements GameStorageProvider - saves game state to file storage
+// SaveGameState implements GameStorageProvider - saves game state to file
+// storage.  The state commits move groups so it is written durably.
 func (s *FSGamesService) SaveGameState(ctx context.Context, id string, state *v1.GameState) error {
-	return s.storage.SaveArtifact(id, "state", state)
+	return s.syncSaveArtifact(id, "state", state)
 }
 
-// SaveGameHistory implements GameStorageProvider - saves game history to file storage
+// SaveGameHistory implements GameStorageProvider - replaces the history
+// snapshot and empties the move log
 func (s *FSGamesService) SaveGameHistory(ctx context.Context, id string, history *v1.GameMoveHistory) error {
-	return s.storage.SaveArtifact(id, "history", history)
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	if err := s.syncSaveArtifact(id, "history", history); err != nil {
+		return err
+	}
+	if err := os.Truncate(s.moveLogPath(id), 0); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to empty move log: %w", err)
+	}
+	s.recoveredLogs[id] = true
+	return nil
 }
 
 // DeleteFromStorage implements GameStorageProvider - deletes game from file storage
 func (s *FSGamesService) DeleteFromStorage(ctx context.Context, id string) error {
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	delete(s.recoveredLogs, id)
+	defer s.listing.forget(id)
 	return s.storage.DeleteEntity(id)
 }
 
-// SaveMoves implements GameStorageProvider - appends moves to history file
+// SaveMoves implements GameStorageProvider - appends the group to the move
+// log.  Orphan groups from previous failed ProcessMoves calls (groups the
+// state never reached) are superseded when the log is replayed.
 func (s *FSGamesService) SaveMoves(ctx context.Context, gameId string, group *v1.GameMoveGroup, currentGroupNumber int64) error {
-	// Load current history (or create empty)
-	history, _ := storage.LoadFSArtifact[*v1.GameMoveHistory](s.storage, gameId, "history")
-	if history == nil {
-		history = &v1.GameMoveHistory{GameId: gameId}
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	return s.appendGroup(gameId, group)
+}
+
+// appendGroup numbers the group's moves and appends it to the log.
+// Callers hold logMu.
+func (s *FSGamesService) appendGroup(gameId string, group *v1.GameMoveGroup) error {
+	if err := s.recoverMoveLog(gameId); err != nil {
+		return err
+	}
+	for i, move := range group.Moves {
+		move.GroupNumber = group.GroupNumber
+		move.MoveNumber = int64(i)
+	}
+	rec, err := groupRecord(group)
+	if err != nil {
+		return fmt.Errorf("failed to encode move group: %w", err)
+	}
+	return s.appendMoveLog(gameId, rec)
+}
+
+// SaveMovesAndState implements services.TransactionalMoveSaver - saves the
+// moves then the state, failing if the stored state is newer than the one
+// being saved
+func (s *FSGamesService) SaveMovesAndState(ctx context.Context, gameId string, group *v1.GameMoveGroup, state *v1.GameState) error {
+	s.movesMu.Lock()
+	defer s.movesMu.Unlock()
+
+	stored, err := s.LoadGameState(ctx, gameId)
+	if err != nil {
+		return err
+	}
+	if stored.Version != state.Version {
+		return services.ErrStaleGameState
 	}
 
-	// Append group to history
-	history.Groups = append(history.Groups, group)
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	if err := s.appendGroup(gameId, group); err != nil {
+		return err
+	}
 
-	// Save history
-	return s.storage.SaveArtifact(gameId, "history", history)
+	// The state is the commit point - moves past it are orphans the next
+	// save supersedes.  The marker after it lets the log be replayed
+	// without the state.
+	state.Version++
+	if err := s.SaveGameState(ctx, gameId, state); err != nil {
+		state.Version--
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	if err := s.appendMoveLog(gameId, moveLogRecord{Commit: state.CurrentGroupNumber}); err != nil {
+		// Committed already - replaying finds the state reached the group
+		log.Printf("Failed to write commit marker for game %s: %v", gameId, err)
+	}
+	if s.moveLogSize(gameId) > MoveLogCompactBytes {
+		if err := s.compactMoveLog(gameId); err != nil {
+			log.Printf("Failed to compact move log for game %s: %v", gameId, err)
+		}
+	}
+	return nil
 }
 
 // GetGameStateVersion implements GameStateUpdater interface
@@ -167,17 +259,31 @@ func (s *FSGamesService) UpdateGameStateScreenshotIndexInfo(ctx context.Context,
 	return nil
 }
 
-// ListGames returns all available games (metadata only for performance)
+// ListGames returns a page of games (metadata only for performance).  Game
+// metadata comes from the in-memory listing, which only rereads changed
+// files, and states are only loaded for the candidates walked when
+// filtering by status.
 func (s *FSGamesService) ListGames(ctx context.Context, req *v1.ListGamesRequest) (resp *v1.ListGamesResponse, err error) {
-	resp = &v1.ListGamesResponse{
-		Items: []*v1.Game{},
-		Pagination: &v1.PaginationResponse{
-			HasMore:      false,
-			TotalResults: 0,
-		},
+	query, err := services.NewGameListQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	games, err := s.listing.games(s.storage, s.storageDir)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &v1.ListGamesResponse{}
+	resp.Items, resp.Pagination = pageGames(query, games, func(id string) (v1.GameStatus, bool) {
+		state, err := storage.LoadFSArtifact[*v1.GameState](s.storage, id, "state")
+		if err != nil {
+			return v1.GameStatus_GAME_STATUS_UNSPECIFIED, false
+		}
+		return state.Status, true
+	})
+	if resp.Items == nil {
+		resp.Items = []*v1.Game{}
 	}
-	resp.Items, err = storage.ListFSEntities[*v1.Game](s.storage, nil)
-	resp.Pagination.TotalResults = int32(len(resp.Items))
 
 	// Populate screenshot URLs for all games
 	for _, game := range resp.Items {
@@ -193,10 +299,14 @@ func (s *FSGamesService) ListGames(ctx context.Context, req *v1.ListGamesRequest
 func (s *FSGamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest) (resp *v1.CreateGameResponse, err error) {
 	// Load world data first so we can validate players have units/tiles
 	worldsSvcClient := s.ClientMgr.GetWorldsSvcClient()
-	world, err := worldsSvcClient.GetWorld(ctx, &v1.GetWorldRequest{Id: req.Game.WorldId})
+	world, err := worldsSvcClient.GetWorld(ctx, &v1.GetWorldRequest{Id: req.Game.WorldId, Version: services.WorldRevisionVersion(req.Game.WorldRevision)})
 	if err != nil {
 		return nil, fmt.Errorf("Error loading world: %w", err)
 	}
+	// Pin the game to the world revision it starts from and the rules it
+	// is played with
+	req.Game.WorldRevision = world.WorldData.GetVersion()
+	req.Game.RulesVersion = lib.DefaultRulesVersion()
 
 	// Validate the request (duplicate players, players with units/tiles, etc.)
 	if err := s.ValidateCreateGameRequest(req.Game, world.WorldData); err != nil {
@@ -242,7 +352,12 @@ func (s *FSGamesService) CreateGame(ctx context.Context, req *v1.CreateGameReque
 	// Initialize player runtime state with starting coins + base income
 	s.InitializePlayerStates(gs, req.Game.Config)
 
+	// The state starts with the world's screenshot info - it needs its own
+	gs.WorldData.ScreenshotIndexInfo = nil
+	services.MarkScreenshotStale(gs)
+
 	// Save game metadata (after adding base income to player coins)
+	s.listing.forget(req.Game.Id)
 	if err := s.storage.SaveArtifact(req.Game.Id, "metadata", req.Game); err != nil {
 		return nil, fmt.Errorf("failed to create game: %w", err)
 	}
@@ -259,6 +374,9 @@ func (s *FSGamesService) CreateGame(ctx context.Context, req *v1.CreateGameReque
 		log.Printf("Failed to create state for game %s: %v", req.Game.Id, err)
 	}
 
+	s.UpdateUserGameIndex(ctx, req.Game, gs)
+	s.QueueGameScreenshot(req.Game.Id, gs)
+
 	resp = &v1.CreateGameResponse{
 		Game:      req.Game,
 		GameState: gs,
@@ -422,7 +540,7 @@ func (s *FSGamesService) ListMoves(ctx context.Context, req *v1.ListMovesRequest
 	}
 
 	// Load history
-	history, err := storage.LoadFSArtifact[*v1.GameMoveHistory](s.storage, req.GameId, "history")
+	history, err := s.LoadGameHistory(ctx, req.GameId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load history: %w", err)
 	}
@@ -0,0 +1,126 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/panyam/goutils/storage"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var INDEX_STATES_STORAGE_DIR = ""
+
+// An index state is stored as
+// <dir>/<entity type>/<escaped entity id>/<index type>.json
+
+// FSIndexStateStore implements services.IndexStateStore on the local file
+// system
+type FSIndexStateStore struct {
+	storage    *storage.FileStorage
+	storageDir string
+}
+
+// NewFSIndexStateStore creates an index state store under storageDir
+func NewFSIndexStateStore(storageDir string) *FSIndexStateStore {
+	if storageDir == "" {
+		if INDEX_STATES_STORAGE_DIR == "" {
+			INDEX_STATES_STORAGE_DIR = DevDataPath("storage/index_states")
+		}
+		storageDir = INDEX_STATES_STORAGE_DIR
+	}
+	return &FSIndexStateStore{storage: storage.NewFileStorage(storageDir), storageDir: storageDir}
+}
+
+func indexStateDir(entityType, entityId string) string {
+	return filepath.Join(url.PathEscape(entityType), url.PathEscape(entityId))
+}
+
+// LoadIndexState implements services.IndexStateStore
+func (s *FSIndexStateStore) LoadIndexState(ctx context.Context, entityType, entityId, indexType string) (*v1.IndexState, error) {
+	dir := indexStateDir(entityType, entityId)
+	if _, err := os.Stat(filepath.Join(s.storageDir, dir, indexType+".json")); errors.Is(err, os.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "no %s index state for %s %s", indexType, entityType, entityId)
+	}
+	state, err := storage.LoadFSArtifact[*v1.IndexState](s.storage, dir, indexType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s index state of %s %s: %w", indexType, entityType, entityId, err)
+	}
+	return state, nil
+}
+
+// SaveIndexState implements services.IndexStateStore
+func (s *FSIndexStateStore) SaveIndexState(ctx context.Context, state *v1.IndexState) error {
+	return s.storage.AtomicSaveArtifact(indexStateDir(state.EntityType, state.EntityId), state.IndexType, state)
+}
+
+// ListIndexStates implements services.IndexStateStore
+func (s *FSIndexStateStore) ListIndexStates(ctx context.Context, filter services.IndexStateFilter) ([]*v1.IndexState, error) {
+	var typeDirs []string
+	if filter.EntityType != "" {
+		typeDirs = []string{url.PathEscape(filter.EntityType)}
+	} else {
+		entries, err := os.ReadDir(s.storageDir)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				typeDirs = append(typeDirs, entry.Name())
+			}
+		}
+	}
+
+	var states []*v1.IndexState
+	for _, typeDir := range typeDirs {
+		entities, err := os.ReadDir(filepath.Join(s.storageDir, typeDir))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		for _, entity := range entities {
+			if !entity.IsDir() {
+				continue
+			}
+			files, err := os.ReadDir(filepath.Join(s.storageDir, typeDir, entity.Name()))
+			if err != nil {
+				return nil, err
+			}
+			for _, file := range files {
+				indexType, ok := strings.CutSuffix(file.Name(), ".json")
+				if !ok || (filter.IndexType != "" && indexType != filter.IndexType) {
+					continue
+				}
+				state, err := storage.LoadFSArtifact[*v1.IndexState](s.storage, filepath.Join(typeDir, entity.Name()), indexType)
+				if err != nil {
+					continue
+				}
+				if filter.Status != v1.IndexStatus_INDEX_STATUS_UNSPECIFIED && state.Status != filter.Status {
+					continue
+				}
+				states = append(states, state)
+			}
+		}
+	}
+	slices.SortFunc(states, func(a, b *v1.IndexState) int {
+		return cmp.Or(cmp.Compare(a.EntityType, b.EntityType), cmp.Compare(a.EntityId, b.EntityId), cmp.Compare(a.IndexType, b.IndexType))
+	})
+	if filter.Limit > 0 && len(states) > filter.Limit {
+		states = states[:filter.Limit]
+	}
+	return states, nil
+}
@@ -0,0 +1,160 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/panyam/goutils/storage"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var JOBS_STORAGE_DIR = ""
+
+// A job is stored as <dir>/<escaped job id>/job.json with its runs next to
+// it as run-<run id>.json
+
+const runPrefix = "run-"
+
+// FSJobStore implements services.JobStore on the local file system
+type FSJobStore struct {
+	storage    *storage.FileStorage
+	storageDir string
+
+	// Serializes the version check and write of jobs
+	mu sync.Mutex
+}
+
+// NewFSJobStore creates a job store under storageDir
+func NewFSJobStore(storageDir string) *FSJobStore {
+	if storageDir == "" {
+		if JOBS_STORAGE_DIR == "" {
+			JOBS_STORAGE_DIR = DevDataPath("storage/jobs")
+		}
+		storageDir = JOBS_STORAGE_DIR
+	}
+	return &FSJobStore{storage: storage.NewFileStorage(storageDir), storageDir: storageDir}
+}
+
+// jobDir is the directory name of a job.  Job IDs contain colons, which
+// not every file system allows.
+func jobDir(id string) string {
+	return strings.ReplaceAll(url.PathEscape(id), ":", "%3A")
+}
+
+// LoadJob implements services.JobStore
+func (s *FSJobStore) LoadJob(ctx context.Context, id string) (*v1.Job, error) {
+	if _, err := os.Stat(filepath.Join(s.storageDir, jobDir(id), "job.json")); errors.Is(err, os.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", id)
+	}
+	job, err := storage.LoadFSArtifact[*v1.Job](s.storage, jobDir(id), "job")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load job %s: %w", id, err)
+	}
+	return job, nil
+}
+
+// SaveJob implements services.JobStore
+func (s *FSJobStore) SaveJob(ctx context.Context, job *v1.Job, oldVersion int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var storedVersion int64
+	stored, err := s.LoadJob(ctx, job.Id)
+	if err == nil {
+		storedVersion = stored.Version
+	} else if status.Code(err) != codes.NotFound {
+		return err
+	}
+	if storedVersion != oldVersion {
+		return services.ErrJobConflict
+	}
+	job.Version = oldVersion + 1
+	return s.storage.AtomicSaveArtifact(jobDir(job.Id), "job", job)
+}
+
+// ListJobs implements services.JobStore
+func (s *FSJobStore) ListJobs(ctx context.Context, filter services.JobFilter) ([]*v1.Job, error) {
+	entries, err := os.ReadDir(s.storageDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var jobs []*v1.Job
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		job, err := storage.LoadFSArtifact[*v1.Job](s.storage, entry.Name(), "job")
+		if err != nil {
+			continue
+		}
+		if filter.JobType != "" && job.JobType != filter.JobType {
+			continue
+		}
+		if filter.Status != v1.JobStatus_JOB_STATUS_UNSPECIFIED && job.Status != filter.Status {
+			continue
+		}
+		if !filter.DueBy.IsZero() && job.RunAfter.AsTime().After(filter.DueBy) {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	slices.SortFunc(jobs, func(a, b *v1.Job) int {
+		return cmp.Or(a.RunAfter.AsTime().Compare(b.RunAfter.AsTime()), cmp.Compare(a.Id, b.Id))
+	})
+	if filter.Limit > 0 && len(jobs) > filter.Limit {
+		jobs = jobs[:filter.Limit]
+	}
+	return jobs, nil
+}
+
+// SaveRun implements services.JobStore
+func (s *FSJobStore) SaveRun(ctx context.Context, run *v1.Run) error {
+	return s.storage.AtomicSaveArtifact(jobDir(run.JobId), runPrefix+run.RunId, run)
+}
+
+// ListRuns implements services.JobStore
+func (s *FSJobStore) ListRuns(ctx context.Context, jobId string, limit int) ([]*v1.Run, error) {
+	entries, err := os.ReadDir(filepath.Join(s.storageDir, jobDir(jobId)))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var runs []*v1.Run
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || !strings.HasPrefix(name, runPrefix) {
+			continue
+		}
+		run, err := storage.LoadFSArtifact[*v1.Run](s.storage, jobDir(jobId), name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s of job %s: %w", name, jobId, err)
+		}
+		runs = append(runs, run)
+	}
+	slices.SortFunc(runs, func(a, b *v1.Run) int {
+		return cmp.Or(b.CreatedAt.AsTime().Compare(a.CreatedAt.AsTime()), cmp.Compare(b.RunId, a.RunId))
+	})
+	if limit > 0 && len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs, nil
+}
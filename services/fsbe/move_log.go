@@ -0,0 +1,298 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/panyam/goutils/storage"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// A game's moves live in two files in its directory:
+//
+//	history.json - a snapshot of the committed groups (what fsbe always stored)
+//	moves.jsonl  - an append-only log of what happened since the snapshot
+//
+// The log has one JSON record per line, either a move group or a commit
+// marker carrying the CurrentGroupNumber the state was saved with:
+//
+//	{"group":{...}}
+//	{"commit":3}
+//
+// Saving a group appends and fsyncs its record, saves the state (the commit
+// point), then appends and fsyncs the marker - so a save costs the size of
+// the group instead of the whole history.  Replaying applies groups the way
+// SaveMoves always has: a group replaces any groups numbered at or past it,
+// so orphans from failed saves and groups already in the snapshot are
+// superseded by later records.
+//
+// Once the log grows past MoveLogCompactBytes it is folded into the
+// snapshot and emptied.
+
+// MoveLogCompactBytes is the log size at which it is compacted into the
+// history snapshot
+var MoveLogCompactBytes int64 = 256 << 10
+
+const moveLogName = "moves.jsonl"
+
+// moveLogRecord is one line of the move log
+type moveLogRecord struct {
+	Group  json.RawMessage `json:"group,omitempty"`
+	Commit int64           `json:"commit,omitempty"`
+}
+
+var artifactMarshal = protojson.MarshalOptions{
+	Indent:            "  ",
+	UseProtoNames:     true,
+	EmitDefaultValues: true,
+}
+
+func (s *FSGamesService) gameDir(id string) string {
+	return filepath.Join(s.storageDir, id)
+}
+
+func (s *FSGamesService) moveLogPath(id string) string {
+	return filepath.Join(s.gameDir(id), moveLogName)
+}
+
+// syncSaveArtifact saves an artifact in the same format as
+// FileStorage.SaveArtifact, but durably - the file is written to a temp
+// file, fsynced and renamed over the old one so a crash leaves either
+// version intact
+func (s *FSGamesService) syncSaveArtifact(id, name string, m proto.Message) error {
+	data, err := artifactMarshal.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s for %s: %w", name, id, err)
+	}
+	dir := s.gameDir(id)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(dir, name+".json")); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir makes a rename or create in dir durable
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// appendMoveLog appends records to a game's log and fsyncs it
+func (s *FSGamesService) appendMoveLog(id string, records ...moveLogRecord) error {
+	var buf bytes.Buffer
+	for _, rec := range records {
+		line, err := json.Marshal(rec)
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	f, err := os.OpenFile(s.moveLogPath(id), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open move log: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to append to move log: %w", err)
+	}
+	return f.Sync()
+}
+
+func groupRecord(group *v1.GameMoveGroup) (moveLogRecord, error) {
+	data, err := protojson.Marshal(group)
+	return moveLogRecord{Group: data}, err
+}
+
+// applyGroup adds group to groups the way SaveMoves does - dropping the
+// groups it supersedes
+func applyGroup(groups []*v1.GameMoveGroup, group *v1.GameMoveGroup) []*v1.GameMoveGroup {
+	kept := groups[:0]
+	for _, g := range groups {
+		if g.GroupNumber < group.GroupNumber {
+			kept = append(kept, g)
+		}
+	}
+	return append(kept, group)
+}
+
+// moveLogReplay is what reading a move log found
+type moveLogReplay struct {
+	groups    []*v1.GameMoveGroup // snapshot plus committed groups
+	pending   []*v1.GameMoveGroup // groups after the last commit marker
+	committed int64               // group number of the last commit marker
+	validSize int64               // bytes up to the last complete record
+	size      int64               // bytes in the file
+}
+
+// readMoveLog replays a game's log over the groups in its snapshot.  A
+// torn or corrupt record ends the log - it and anything after it were
+// never acknowledged.
+func (s *FSGamesService) readMoveLog(id string, snapshot []*v1.GameMoveGroup) (*moveLogReplay, error) {
+	replay := &moveLogReplay{groups: snapshot}
+	f, err := os.Open(s.moveLogPath(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return replay, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open move log: %w", err)
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		replay.size += int64(len(line))
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read move log: %w", err)
+		}
+
+		var rec moveLogRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			log.Printf("Move log for game %s is corrupt at offset %d: %v", id, replay.validSize, err)
+			break
+		}
+		if rec.Group != nil {
+			group := &v1.GameMoveGroup{}
+			if err := protojson.Unmarshal(rec.Group, group); err != nil {
+				log.Printf("Move log for game %s has a bad group at offset %d: %v", id, replay.validSize, err)
+				break
+			}
+			replay.pending = append(replay.pending, group)
+		}
+		if rec.Commit > 0 {
+			replay.commit(rec.Commit)
+		}
+		replay.validSize = replay.size
+	}
+	// Whatever is left unread is torn
+	if rest, _ := io.Copy(io.Discard, reader); rest > 0 {
+		replay.size += rest
+	}
+	return replay, nil
+}
+
+// commit applies the pending groups up to the committed group number
+func (r *moveLogReplay) commit(upTo int64) {
+	for _, group := range r.pending {
+		r.groups = applyGroup(r.groups, group)
+	}
+	for len(r.groups) > 0 && r.groups[len(r.groups)-1].GroupNumber > upTo {
+		r.groups = r.groups[:len(r.groups)-1]
+	}
+	r.pending = nil
+	r.committed = upTo
+}
+
+// loadMoveHistory reads the snapshot and replays the log over it.  Pending
+// groups the state reached were committed by a save that crashed before
+// writing its marker; any others are orphans.
+func (s *FSGamesService) loadMoveHistory(id string) (*v1.GameMoveHistory, *moveLogReplay, error) {
+	history, err := storage.LoadFSArtifact[*v1.GameMoveHistory](s.storage, id, "history")
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, nil, fmt.Errorf("failed to load game history: %w", err)
+		}
+		// No moves yet - as in the database backends
+		history = &v1.GameMoveHistory{GameId: id}
+	}
+
+	replay, err := s.readMoveLog(id, history.Groups)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(replay.pending) > 0 {
+		if state, err := storage.LoadFSArtifact[*v1.GameState](s.storage, id, "state"); err == nil && state.CurrentGroupNumber > replay.committed {
+			replay.commit(state.CurrentGroupNumber)
+		}
+	}
+	history.Groups = replay.groups
+	return history, replay, nil
+}
+
+// recoverMoveLog repairs a game's log after a crash, the first time this
+// process touches it.  A torn tail or groups after the last commit marker
+// mean a save was interrupted - compacting keeps exactly what was
+// committed and leaves an empty log to append to.  Callers hold logMu.
+func (s *FSGamesService) recoverMoveLog(id string) error {
+	if s.recoveredLogs[id] {
+		return nil
+	}
+	_, replay, err := s.loadMoveHistory(id)
+	if err != nil {
+		return err
+	}
+	if len(replay.pending) > 0 || replay.validSize < replay.size {
+		log.Printf("Recovering move log for game %s: %d uncommitted groups, %d torn bytes",
+			id, len(replay.pending), replay.size-replay.validSize)
+		if err := s.compactMoveLog(id); err != nil {
+			return err
+		}
+	}
+	s.recoveredLogs[id] = true
+	return nil
+}
+
+// compactMoveLog folds the log into the history snapshot and empties it.
+// The snapshot is replaced atomically first, so a crash before the log is
+// emptied only leaves records the snapshot already has - replaying them
+// is harmless.  Callers hold logMu.
+func (s *FSGamesService) compactMoveLog(id string) error {
+	history, _, err := s.loadMoveHistory(id)
+	if err != nil {
+		return err
+	}
+	if err := s.syncSaveArtifact(id, "history", history); err != nil {
+		return fmt.Errorf("failed to save history snapshot: %w", err)
+	}
+	if err := os.Truncate(s.moveLogPath(id), 0); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to empty move log: %w", err)
+	}
+	return nil
+}
+
+// moveLogSize is the size of a game's log, 0 if it has none
+func (s *FSGamesService) moveLogSize(id string) int64 {
+	info, err := os.Stat(s.moveLogPath(id))
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
@@ -0,0 +1,72 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rules kept for imported games live next to the games directory:
+//
+//	rules/<version>/rules.json
+//	rules/<version>/damage.json
+func (s *FSGamesService) rulesDir(version string) string {
+	return filepath.Join(filepath.Dir(s.storageDir), "rules", url.PathEscape(version))
+}
+
+// SaveRules implements services.RulesStore.  Both files are written to a
+// temp directory that is renamed into place, so a version is never seen
+// half saved.
+func (s *FSGamesService) SaveRules(ctx context.Context, version string, rulesJSON, damageJSON []byte) error {
+	dir := s.rulesDir(version)
+	if _, err := os.Stat(dir); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(dir), ".tmp-rules-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	if err := os.WriteFile(filepath.Join(tmp, "rules.json"), rulesJSON, 0644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "damage.json"), damageJSON, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		// Saved by someone else in the meantime
+		if _, statErr := os.Stat(dir); statErr == nil {
+			return nil
+		}
+		return fmt.Errorf("failed to save rules version %s: %w", version, err)
+	}
+	return nil
+}
+
+// LoadRules implements services.RulesStore
+func (s *FSGamesService) LoadRules(ctx context.Context, version string) (rulesJSON, damageJSON []byte, err error) {
+	dir := s.rulesDir(version)
+	rulesJSON, err = os.ReadFile(filepath.Join(dir, "rules.json"))
+	if os.IsNotExist(err) {
+		return nil, nil, status.Errorf(codes.NotFound, "rules version %s not found", version)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	damageJSON, err = os.ReadFile(filepath.Join(dir, "damage.json"))
+	if err != nil {
+		return nil, nil, err
+	}
+	return rulesJSON, damageJSON, nil
+}
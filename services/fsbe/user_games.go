@@ -0,0 +1,90 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/panyam/goutils/storage"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// The user game index lives next to the games directory:
+//
+//	user_games/users/<user_id>/games.json   - the user's entries
+//	user_games/games/<game_id>/entries.json - the game's entries, so users
+//	                                          that left the game can be found
+//
+// Entries are kept per game as well as per user because a deleted game's
+// directory is gone by the time its entries are removed.
+func newUserGameStorages(gamesDir string) (byUser, byGame *storage.FileStorage) {
+	root := filepath.Join(filepath.Dir(gamesDir), "user_games")
+	return storage.NewFileStorage(filepath.Join(root, "users")), storage.NewFileStorage(filepath.Join(root, "games"))
+}
+
+// SaveUserGames implements services.UserGameIndex
+func (s *FSGamesService) SaveUserGames(ctx context.Context, gameId string, entries []*v1.UserGame) error {
+	s.userGamesMu.Lock()
+	defer s.userGamesMu.Unlock()
+
+	var previous v1.UserGameList
+	if err := s.gameUsers.LoadArtifact(gameId, "entries", &previous); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to load user game entries of game %s: %w", gameId, err)
+	}
+
+	// Rewrite the entries of current users and drop the game from users
+	// that are no longer in it
+	byUser := map[string]*v1.UserGame{}
+	for _, entry := range entries {
+		byUser[entry.UserId] = entry
+	}
+	for _, entry := range previous.Games {
+		if _, ok := byUser[entry.UserId]; !ok {
+			byUser[entry.UserId] = nil
+		}
+	}
+	for userId, entry := range byUser {
+		err := s.userGames.AtomicUpdate(userId, "games", func(m proto.Message) error {
+			list := m.(*v1.UserGameList)
+			games := list.Games[:0]
+			for _, g := range list.Games {
+				if g.GameId != gameId {
+					games = append(games, g)
+				}
+			}
+			if entry != nil {
+				games = append(games, entry)
+			}
+			list.Games = games
+			return nil
+		}, &v1.UserGameList{})
+		if err != nil {
+			return fmt.Errorf("failed to update games of user %s: %w", userId, err)
+		}
+	}
+
+	if len(entries) == 0 {
+		return s.gameUsers.DeleteEntity(gameId)
+	}
+	return s.gameUsers.AtomicSaveArtifact(gameId, "entries", &v1.UserGameList{Games: entries})
+}
+
+// ListUserGames implements services.UserGameIndex
+func (s *FSGamesService) ListUserGames(ctx context.Context, userId string) ([]*v1.UserGame, error) {
+	s.userGamesMu.Lock()
+	defer s.userGamesMu.Unlock()
+
+	var list v1.UserGameList
+	if err := s.userGames.LoadArtifact(userId, "games", &list); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load games of user %s: %w", userId, err)
+	}
+	return list.Games, nil
+}
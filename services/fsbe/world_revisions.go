@@ -0,0 +1,99 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/panyam/goutils/storage"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A world's revisions are stored next to its data as revision-<n>.json, so
+// deleting the world's directory deletes them too
+
+const revisionPrefix = "revision-"
+
+func revisionName(revision int64) string {
+	return revisionPrefix + strconv.FormatInt(revision, 10)
+}
+
+// SaveRevision implements services.WorldRevisionStore
+func (s *FSWorldsService) SaveRevision(ctx context.Context, revision *v1.WorldRevision) error {
+	s.revisionMu.Lock()
+	defer s.revisionMu.Unlock()
+
+	name := revisionName(revision.Revision)
+	if _, err := os.Stat(filepath.Join(s.storageDir, revision.WorldId, name+".json")); err == nil {
+		return nil
+	}
+	return s.storage.AtomicSaveArtifact(revision.WorldId, name, revision)
+}
+
+// LoadRevision implements services.WorldRevisionStore
+func (s *FSWorldsService) LoadRevision(ctx context.Context, worldId string, revision int64) (*v1.WorldRevision, error) {
+	stored, err := storage.LoadFSArtifact[*v1.WorldRevision](s.storage, worldId, revisionName(revision))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, status.Errorf(codes.NotFound, "world %s has no revision %d", worldId, revision)
+		}
+		return nil, fmt.Errorf("failed to load revision %d of world %s: %w", revision, worldId, err)
+	}
+	return stored, nil
+}
+
+// ListRevisions implements services.WorldRevisionStore
+func (s *FSWorldsService) ListRevisions(ctx context.Context, worldId string) ([]*v1.WorldRevision, error) {
+	entries, err := os.ReadDir(filepath.Join(s.storageDir, worldId))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var revisions []*v1.WorldRevision
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || !strings.HasPrefix(name, revisionPrefix) {
+			continue
+		}
+		revision, err := storage.LoadFSArtifact[*v1.WorldRevision](s.storage, worldId, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s of world %s: %w", name, worldId, err)
+		}
+		revision.WorldData = nil
+		revisions = append(revisions, revision)
+	}
+	slices.SortFunc(revisions, func(a, b *v1.WorldRevision) int {
+		return cmp.Compare(b.Revision, a.Revision)
+	})
+	return revisions, nil
+}
+
+// DeleteRevisions implements services.WorldRevisionStore
+func (s *FSWorldsService) DeleteRevisions(ctx context.Context, worldId string) error {
+	s.revisionMu.Lock()
+	defer s.revisionMu.Unlock()
+
+	matches, err := filepath.Glob(filepath.Join(s.storageDir, worldId, revisionPrefix+"*.json"))
+	if err != nil {
+		return err
+	}
+	for _, path := range matches {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+//go:build !wasm
+// +build !wasm
+
+package fsbe
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/panyam/goutils/storage"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+var WORLD_SEARCH_STORAGE_DIR = ""
+
+// A world search entry is stored as <dir>/<escaped world id>/metadata.json
+
+// FSWorldSearchStore implements services.WorldSearchStore on the local file
+// system.  It is the embedded store of the world search index, which each
+// server keeps for itself.
+type FSWorldSearchStore struct {
+	storage *storage.FileStorage
+}
+
+// NewFSWorldSearchStore creates a world search store under storageDir
+func NewFSWorldSearchStore(storageDir string) *FSWorldSearchStore {
+	if storageDir == "" {
+		if WORLD_SEARCH_STORAGE_DIR == "" {
+			WORLD_SEARCH_STORAGE_DIR = DevDataPath("storage/search/worlds")
+		}
+		storageDir = WORLD_SEARCH_STORAGE_DIR
+	}
+	return &FSWorldSearchStore{storage: storage.NewFileStorage(storageDir)}
+}
+
+// SaveWorldSearchEntry implements services.WorldSearchStore
+func (s *FSWorldSearchStore) SaveWorldSearchEntry(ctx context.Context, entry *v1.WorldSearchEntry) error {
+	return s.storage.AtomicSaveArtifact(url.PathEscape(entry.World.Id), "metadata", entry)
+}
+
+// DeleteWorldSearchEntry implements services.WorldSearchStore
+func (s *FSWorldSearchStore) DeleteWorldSearchEntry(ctx context.Context, worldId string) error {
+	return s.storage.DeleteEntity(url.PathEscape(worldId))
+}
+
+// ListWorldSearchEntries implements services.WorldSearchStore
+func (s *FSWorldSearchStore) ListWorldSearchEntries(ctx context.Context) ([]*v1.WorldSearchEntry, error) {
+	return storage.ListFSEntities(s.storage, func(entry *v1.WorldSearchEntry) bool {
+		return entry.World != nil
+	})
+}
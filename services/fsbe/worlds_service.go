@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/panyam/goutils/storage"
@@ -26,7 +27,14 @@ var WORLDS_STORAGE_DIR = ""
 // FSWorldsService implements the FSWorldsService gRPC interface
 type FSWorldsService struct {
 	services.BackendWorldsService
-	storage *storage.FileStorage
+	storage    *storage.FileStorage
+	storageDir string
+
+	// Serializes the version check and write of world data updates
+	dataMu sync.Mutex
+
+	// Serializes the existence check and write of revisions
+	revisionMu sync.Mutex
 }
 
 // NewFSWorldsService creates a new FSWorldsService implementation
@@ -37,10 +45,11 @@ func NewFSWorldsService(storageDir string, clientMgr *services.ClientMgr) *FSWor
 		}
 		storageDir = WORLDS_STORAGE_DIR
 	}
-	service := &FSWorldsService{storage: storage.NewFileStorage(storageDir)}
+	service := &FSWorldsService{storage: storage.NewFileStorage(storageDir), storageDir: storageDir}
 	service.ClientMgr = clientMgr
 	service.Self = service
 	service.WorldDataUpdater = service // Implement WorldDataUpdater interface
+	service.RevisionStore = service
 	service.InitializeScreenshotIndexer()
 	return service
 }
@@ -58,6 +67,9 @@ func (s *FSWorldsService) GetWorldData(ctx context.Context, id string) (int64, e
 // Note: This does NOT increment version - IndexInfo is internal bookkeeping
 // that shouldn't invalidate user's optimistic lock
 func (s *FSWorldsService) UpdateWorldDataIndexInfo(ctx context.Context, id string, oldVersion int64, lastIndexedAt time.Time, needsIndexing bool) error {
+	s.dataMu.Lock()
+	defer s.dataMu.Unlock()
+
 	worldData, err := storage.LoadFSArtifact[*v1.WorldData](s.storage, id, "data")
 	if err != nil {
 		return err
@@ -84,17 +96,24 @@ func (s *FSWorldsService) UpdateWorldDataIndexInfo(ctx context.Context, id strin
 	return nil
 }
 
-// ListWorlds returns all available worlds (metadata only for performance)
+// ListWorlds returns a page of worlds (metadata only for performance)
 func (s *FSWorldsService) ListWorlds(ctx context.Context, req *v1.ListWorldsRequest) (resp *v1.ListWorldsResponse, err error) {
-	resp = &v1.ListWorldsResponse{
-		Items: []*v1.World{},
-		Pagination: &v1.PaginationResponse{
-			HasMore:      false,
-			TotalResults: 0,
-		},
+	query, err := services.NewWorldListQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	worlds, err := storage.ListFSEntities[*v1.World](s.storage, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &v1.ListWorldsResponse{}
+	resp.Items, resp.Pagination = services.PageItems(query, worlds, func(world *v1.World) bool {
+		return query.MatchesWorld(world)
+	})
+	if resp.Items == nil {
+		resp.Items = []*v1.World{}
 	}
-	resp.Items, err = storage.ListFSEntities[*v1.World](s.storage, nil)
-	resp.Pagination.TotalResults = int32(len(resp.Items))
 
 	// Populate screenshot URLs for all worlds
 	for _, world := range resp.Items {
@@ -142,6 +161,9 @@ func (s *FSWorldsService) GetWorld(ctx context.Context, req *v1.GetWorldRequest)
 		World:     world,
 		WorldData: worldData,
 	}
+	if err := s.ResolveWorldRevision(ctx, req.Version, resp); err != nil {
+		return nil, err
+	}
 
 	return resp, nil
 }
@@ -184,12 +206,17 @@ func (s *FSWorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRe
 	if req.World.CreatorId != "" {
 		world.CreatorId = req.World.CreatorId
 	}
+	if req.World.ParentRevision > 0 {
+		world.ParentRevision = req.World.ParentRevision
+	}
 	world.UpdatedAt = tspb.New(time.Now())
 
 	if err := s.storage.SaveArtifact(req.World.Id, "metadata", world); err != nil {
 		return nil, fmt.Errorf("failed to update world metadata: %w", err)
 	}
 
+	s.dataMu.Lock()
+	defer s.dataMu.Unlock()
 	worldData, err := storage.LoadFSArtifact[*v1.WorldData](s.storage, req.World.Id, "data")
 	if err != nil {
 		return nil, fmt.Errorf("world not found: %w", err)
@@ -197,6 +224,7 @@ func (s *FSWorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRe
 
 	// Auto-migrate from old list-based format to new map-based format
 	lib.MigrateWorldData(worldData)
+	previous := worldData
 
 	// Update world data if provided
 	worldDataSaved := false
@@ -250,6 +278,7 @@ func (s *FSWorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRe
 			World:     world,
 			WorldData: worldData,
 		}
+		s.RecordWorldRevision(ctx, world.Id, previous, worldData)
 
 		// Queue it for being screenshotted
 		s.ScreenShotIndexer.Send("worlds", world.Id, worldData.Version, resp.WorldData)
@@ -283,6 +312,9 @@ func (s *FSWorldsService) DeleteWorld(ctx context.Context, req *v1.DeleteWorldRe
 	}
 
 	err = s.storage.DeleteEntity(req.Id)
+	if err == nil {
+		s.RemoveFromSearchIndex(ctx, req.Id)
+	}
 
 	resp = &v1.DeleteWorldResponse{}
 	return resp, err
@@ -295,6 +327,9 @@ func (s *FSWorldsService) CreateWorld(ctx context.Context, req *v1.CreateWorldRe
 		return nil, fmt.Errorf("world data is required")
 	}
 	req.World.Id = services.NormalizeWorldID(req.World.Id)
+	if err := s.PrepareWorldCopy(ctx, req); err != nil {
+		return nil, err
+	}
 
 	worldId, err := s.storage.CreateEntity(req.World.Id)
 	if err != nil {
@@ -327,9 +362,16 @@ func (s *FSWorldsService) CreateWorld(ctx context.Context, req *v1.CreateWorldRe
 	// Auto-migrate from old list-based format to new map-based format before saving
 	lib.MigrateWorldData(req.WorldData)
 
-	// Create world data with tiles and units from request
+	// Create world data with tiles and units from request.  It starts at
+	// version 1 so revision 0 can mean "no revision".
+	if req.WorldData == nil {
+		req.WorldData = &v1.WorldData{}
+	}
+	req.WorldData.Version = max(req.WorldData.Version, 1)
 	if err := s.storage.SaveArtifact(worldId, "data", req.WorldData); err != nil {
 		log.Printf("Failed to create data.json for world %s: %v", worldId, err)
+	} else {
+		s.RecordWorldRevision(ctx, worldId, nil, req.WorldData)
 	}
 
 	resp = &v1.CreateWorldResponse{
@@ -16,6 +16,7 @@ import (
 	v1dal "github.com/turnforge/lilbattle/gen/datastore/dal/lilbattle/v1/datastore"
 	"github.com/turnforge/lilbattle/lib"
 	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/api/iterator"
 	tspb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -42,6 +43,8 @@ func NewGamesService(client *datastore.Client, namespace string, clientMgr *serv
 	service.Self = service
 	service.StorageProvider = service
 	service.GameStateUpdater = service
+	service.UserGameIndex = service
+	service.RulesStore = service
 	service.InitializeCache()
 	service.InitializeScreenshotIndexer()
 	service.InitializeSyncBroadcast()
@@ -63,6 +66,7 @@ func (s *GamesService) LoadGame(ctx context.Context, id string) (*v1.Game, error
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert game: %w", err)
 	}
+	game.Id = id // Id has datastore:"-", populate from key
 
 	// Populate screenshot URL if not set
 	if len(game.PreviewUrls) == 0 {
@@ -114,7 +118,7 @@ func (s *GamesService) LoadGameHistory(ctx context.Context, id string) (*v1.Game
 	groupMap := make(map[int64]*v1.GameMoveGroup)
 
 	for _, entity := range entities {
-		move, err := v1ds.GameMoveFromGameMoveDatastore(nil, entity, nil)
+		move, err := v1ds.GameMoveFromGameMoveDatastore(nil, entity, gameMoveFromDatastore)
 		if err != nil {
 			log.Printf("Warning: failed to convert move: %v", err)
 			continue
@@ -174,61 +178,165 @@ func (s *GamesService) SaveGameState(ctx context.Context, id string, state *v1.G
 	return err
 }
 
-// SaveGameHistory implements GameStorageProvider
-// For Datastore, history is built from individual moves on read
+// SaveGameHistory implements GameStorageProvider - replaces the game's moves
+// with the history's.  Transactions are limited in how many entities they
+// write so each group is put in its own transaction and the moves the
+// history no longer has are deleted afterwards in batches.  A failure part
+// way leaves a mix of the two histories; saving the history again repairs it.
 func (s *GamesService) SaveGameHistory(ctx context.Context, id string, history *v1.GameMoveHistory) error {
-	// No-op - history is virtual, built from moves
+	// Every group is past group 0 so this is all of the game's moves
+	existing, err := s.orphanMoveKeys(ctx, id, 0)
+	if err != nil {
+		return err
+	}
+
+	kept := map[string]bool{}
+	for _, group := range history.GetGroups() {
+		_, err := s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+			return s.putMoves(tx, id, group, nil)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save move group %d: %w", group.GroupNumber, err)
+		}
+		for i := range group.Moves {
+			kept[fmt.Sprintf("%s-%d-%d", id, group.GroupNumber, i)] = true
+		}
+	}
+
+	var stale []*datastore.Key
+	for _, key := range existing {
+		if !kept[key.Name] {
+			stale = append(stale, key)
+		}
+	}
+	// Datastore deletes at most 500 keys per call
+	for len(stale) > 0 {
+		batch := stale[:min(len(stale), 500)]
+		stale = stale[len(batch):]
+		if err := s.client.DeleteMulti(ctx, batch); err != nil {
+			return fmt.Errorf("failed to delete replaced moves: %w", err)
+		}
+	}
 	return nil
 }
 
 // SaveMoves implements GameStorageProvider - saves moves with cross-entity transaction
 func (s *GamesService) SaveMoves(ctx context.Context, gameId string, group *v1.GameMoveGroup, currentGroupNumber int64) error {
+	orphanKeys, err := s.orphanMoveKeys(ctx, gameId, currentGroupNumber)
+	if err != nil {
+		return err
+	}
+
 	// Use transaction for atomicity
-	_, err := s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
-		// Delete any orphan moves from previous failed attempts
-		orphanQuery := NamespacedQuery("GameMove", s.namespace).
-			FilterField("game_id", "=", gameId).
-			FilterField("group_number", ">", currentGroupNumber-1).
-			KeysOnly()
+	_, err = s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+		return s.putMoves(tx, gameId, group, orphanKeys)
+	})
+	return err
+}
+
+// SaveMovesAndState implements services.TransactionalMoveSaver - saves the
+// moves and the game state in one transaction, failing if the stored state
+// is newer than the one being saved
+func (s *GamesService) SaveMovesAndState(ctx context.Context, gameId string, group *v1.GameMoveGroup, state *v1.GameState) error {
+	orphanKeys, err := s.orphanMoveKeys(ctx, gameId, state.CurrentGroupNumber)
+	if err != nil {
+		return err
+	}
+
+	oldVersion := state.Version
+	stateKey := NamespacedKey("GameState", gameId, s.namespace)
+	_, err = s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+		// Optimistic lock check
+		var stored v1ds.GameStateDatastore
+		if err := tx.Get(stateKey, &stored); err != nil {
+			return fmt.Errorf("failed to load game state: %w", err)
+		}
+		if stored.Version != oldVersion {
+			return services.ErrStaleGameState
+		}
 
-		orphanKeys, err := s.client.GetAll(ctx, orphanQuery, nil)
+		if err := s.putMoves(tx, gameId, group, orphanKeys); err != nil {
+			return err
+		}
+
+		state.Version = oldVersion + 1
+		stateDs, err := v1ds.GameStateToGameStateDatastore(state, nil, nil)
 		if err != nil {
-			return fmt.Errorf("failed to query orphan moves: %w", err)
+			return fmt.Errorf("failed to convert game state: %w", err)
 		}
+		stateDs.Key = stateKey
+		stateDs.GameId = gameId
+		_, err = tx.Put(stateKey, stateDs)
+		return err
+	})
+	if err != nil {
+		state.Version = oldVersion
+	}
+	return err
+}
 
-		if len(orphanKeys) > 0 {
-			if err := tx.DeleteMulti(orphanKeys); err != nil {
-				return fmt.Errorf("failed to delete orphan moves: %w", err)
-			}
+// orphanMoveKeys returns the keys of moves from previous failed attempts
+// (moves with group_number >= currentGroupNumber).  Queries cannot run inside
+// the transaction without an ancestor so this is looked up first.
+func (s *GamesService) orphanMoveKeys(ctx context.Context, gameId string, currentGroupNumber int64) ([]*datastore.Key, error) {
+	orphanQuery := NamespacedQuery("GameMove", s.namespace).
+		FilterField("game_id", "=", gameId).
+		FilterField("group_number", ">", currentGroupNumber-1).
+		KeysOnly()
+
+	orphanKeys, err := s.client.GetAll(ctx, orphanQuery, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orphan moves: %w", err)
+	}
+	return orphanKeys, nil
+}
+
+// putMoves deletes the orphan moves and saves the group's moves within tx
+func (s *GamesService) putMoves(tx *datastore.Transaction, gameId string, group *v1.GameMoveGroup, orphanKeys []*datastore.Key) error {
+	if len(orphanKeys) > 0 {
+		if err := tx.DeleteMulti(orphanKeys); err != nil {
+			return fmt.Errorf("failed to delete orphan moves: %w", err)
 		}
+	}
 
-		// Save each move in the group
-		for i, move := range group.Moves {
-			move.GroupNumber = group.GroupNumber
-			move.MoveNumber = int64(i)
+	// Save each move in the group
+	for i, move := range group.Moves {
+		move.GroupNumber = group.GroupNumber
+		move.MoveNumber = int64(i)
 
-			moveDs, err := v1ds.GameMoveToGameMoveDatastore(move, nil, func(src *v1.GameMove, dest *v1ds.GameMoveDatastore) error {
-				dest.GameId = gameId
-				return nil
-			})
+		moveDs, err := v1ds.GameMoveToGameMoveDatastore(move, nil, func(src *v1.GameMove, dest *v1ds.GameMoveDatastore) error {
+			dest.GameId = gameId
+			// The generated converter skips the move_type oneof
+			moveType, err := services.EncodeMoveType(src)
 			if err != nil {
-				return fmt.Errorf("failed to convert move %d: %w", i, err)
+				return fmt.Errorf("failed to serialize move_type: %w", err)
 			}
+			dest.MoveType = moveType
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to convert move %d: %w", i, err)
+		}
 
-			// Use composite key: gameId-groupNumber-moveNumber
-			keyName := fmt.Sprintf("%s-%d-%d", gameId, group.GroupNumber, i)
-			key := NamespacedKey("GameMove", keyName, s.namespace)
-			moveDs.Key = key
+		// Use composite key: gameId-groupNumber-moveNumber
+		keyName := fmt.Sprintf("%s-%d-%d", gameId, group.GroupNumber, i)
+		key := NamespacedKey("GameMove", keyName, s.namespace)
+		moveDs.Key = key
 
-			if _, err := tx.Put(key, moveDs); err != nil {
-				return fmt.Errorf("failed to save move %d: %w", i, err)
-			}
+		if _, err := tx.Put(key, moveDs); err != nil {
+			return fmt.Errorf("failed to save move %d: %w", i, err)
 		}
+	}
 
-		return nil
-	})
+	return nil
+}
 
-	return err
+// gameMoveFromDatastore restores the move_type the generated converter skips
+func gameMoveFromDatastore(dest *v1.GameMove, src *v1ds.GameMoveDatastore) error {
+	if err := services.DecodeMoveType(src.MoveType, dest); err != nil {
+		return fmt.Errorf("failed to deserialize move_type: %w", err)
+	}
+	return nil
 }
 
 // DeleteFromStorage implements GameStorageProvider
@@ -308,44 +416,91 @@ func (s *GamesService) UpdateGameStateScreenshotIndexInfo(ctx context.Context, i
 	return err
 }
 
-// ListGames returns all games
+// ListGames returns a page of games.  Owner or world filters are pushed to
+// Datastore for the default sort order; everything else is checked in Go
+// while streaming results until the page is filled.  TotalResults is not
+// counted.
 func (s *GamesService) ListGames(ctx context.Context, req *v1.ListGamesRequest) (*v1.ListGamesResponse, error) {
 	ctx, span := Tracer.Start(ctx, "ListGames")
 	defer span.End()
 
-	query := NamespacedQuery("Game", s.namespace).
-		Order("-updated_at")
-
-	var entities []*v1ds.GameDatastore
-	keys, err := s.client.GetAll(ctx, query, &entities)
+	query, err := services.NewGameListQuery(req)
 	if err != nil {
 		return nil, err
 	}
 
-	// Set keys on entities
-	for i, key := range keys {
-		entities[i].Key = key
+	dsq := NamespacedQuery("Game", s.namespace)
+	inQuery := 0
+	if pushDownEquality(query, services.SortByUpdatedAt, true) {
+		// One equality filter at a time - only world_id and creator_id
+		// each have an index with updated_at
+		if query.WorldId != "" {
+			dsq = dsq.FilterField("world_id", "=", query.WorldId)
+			inQuery++
+		} else if query.OwnerId != "" {
+			dsq = dsq.FilterField("creator_id", "=", query.OwnerId)
+			inQuery++
+		}
 	}
+	dsq = applyListOrder(dsq, query)
 
-	resp := &v1.ListGamesResponse{
-		Items: make([]*v1.Game, 0, len(entities)),
-		Pagination: &v1.PaginationResponse{
-			TotalResults: int32(len(entities)),
-		},
+	// Status lives on GameState so find the matching games up front
+	var statusMatches map[string]bool
+	if query.Status != v1.GameStatus_GAME_STATUS_UNSPECIFIED {
+		keys, err := s.client.GetAll(ctx, NamespacedQuery("GameState", s.namespace).
+			FilterField("status", "=", int64(query.Status)).KeysOnly(), nil)
+		if err != nil {
+			return nil, err
+		}
+		statusMatches = make(map[string]bool, len(keys))
+		for _, key := range keys {
+			statusMatches[key.Name] = true
+		}
 	}
 
-	for _, entity := range entities {
-		game, err := v1ds.GameFromGameDatastore(nil, entity, nil)
+	// Offsets can only be pushed down when Datastore sees every filter
+	skip := query.PageOffset
+	if countListFilters(query) == inQuery && skip > 0 {
+		dsq, skip = dsq.Offset(skip), 0
+	}
+
+	page := services.NewListPage[*v1.Game](query, skip)
+	it := s.client.Run(ctx, dsq)
+	for !page.Full() {
+		var entity v1ds.GameDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		entity.Key = key
+		entity.Id = key.Name
+
+		game, err := v1ds.GameFromGameDatastore(nil, &entity, nil)
 		if err != nil {
 			log.Printf("Warning: failed to convert game: %v", err)
 			continue
 		}
+		status := v1.GameStatus_GAME_STATUS_UNSPECIFIED
+		if statusMatches[game.Id] {
+			status = query.Status
+		}
+		if query.IsAfterCursor(game) && query.MatchesGame(game, status) {
+			page.Add(game)
+		}
+	}
+
+	resp := &v1.ListGamesResponse{Items: page.Items(), Pagination: page.Pagination(0)}
+	if resp.Items == nil {
+		resp.Items = []*v1.Game{}
+	}
+	for _, game := range resp.Items {
 		if len(game.PreviewUrls) == 0 {
 			game.PreviewUrls = []string{fmt.Sprintf("/screenshots/games/%s/default.png", game.Id)}
 		}
-		resp.Items = append(resp.Items, game)
 	}
-
 	return resp, nil
 }
 
@@ -356,10 +511,14 @@ func (s *GamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest
 
 	// Load world data for validation
 	worldsSvcClient := s.ClientMgr.GetWorldsSvcClient()
-	world, err := worldsSvcClient.GetWorld(ctx, &v1.GetWorldRequest{Id: req.Game.WorldId})
+	world, err := worldsSvcClient.GetWorld(ctx, &v1.GetWorldRequest{Id: req.Game.WorldId, Version: services.WorldRevisionVersion(req.Game.WorldRevision)})
 	if err != nil {
 		return nil, fmt.Errorf("error loading world: %w", err)
 	}
+	// Pin the game to the world revision it starts from and the rules it
+	// is played with
+	req.Game.WorldRevision = world.WorldData.GetVersion()
+	req.Game.RulesVersion = lib.DefaultRulesVersion()
 
 	// Validate request
 	if err := s.ValidateCreateGameRequest(req.Game, world.WorldData); err != nil {
@@ -389,6 +548,10 @@ func (s *GamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest
 	lib.EnsureShortcuts(gs.WorldData)
 	s.InitializePlayerStates(gs, req.Game.Config)
 
+	// The state starts with the world's screenshot info - it needs its own
+	gs.WorldData.ScreenshotIndexInfo = nil
+	services.MarkScreenshotStale(gs)
+
 	// Use transaction to save game + state atomically
 	_, err = s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
 		// Save game
@@ -420,6 +583,9 @@ func (s *GamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest
 		return nil, fmt.Errorf("failed to create game: %w", err)
 	}
 
+	s.UpdateUserGameIndex(ctx, req.Game, gs)
+	s.QueueGameScreenshot(req.Game.Id, gs)
+
 	return &v1.CreateGameResponse{
 		Game:      req.Game,
 		GameState: gs,
@@ -463,7 +629,7 @@ func (s *GamesService) ListMoves(ctx context.Context, req *v1.ListMovesRequest)
 	var groupNumbers []int64
 
 	for _, entity := range entities {
-		move, err := v1ds.GameMoveFromGameMoveDatastore(nil, entity, nil)
+		move, err := v1ds.GameMoveFromGameMoveDatastore(nil, entity, gameMoveFromDatastore)
 		if err != nil {
 			log.Printf("Warning: failed to convert move: %v", err)
 			continue
@@ -0,0 +1,113 @@
+//go:build !wasm
+// +build !wasm
+
+package gaebe
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cloud.google.com/go/datastore"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// indexStateEntity is an IndexState entity.  The state is kept as protojson
+// with the fields the reconciler filters on copied into indexed properties.
+type indexStateEntity struct {
+	EntityType string `datastore:"entity_type"`
+	IndexType  string `datastore:"index_type"`
+	Status     int32  `datastore:"status"`
+	Data       []byte `datastore:"data,noindex"`
+}
+
+// IndexStateStore implements services.IndexStateStore in Datastore
+type IndexStateStore struct {
+	client    *datastore.Client
+	namespace string
+}
+
+// NewIndexStateStore creates an index state store in a Datastore namespace
+func NewIndexStateStore(client *datastore.Client, namespace string) *IndexStateStore {
+	return &IndexStateStore{client: client, namespace: namespace}
+}
+
+func (s *IndexStateStore) key(entityType, entityId, indexType string) *datastore.Key {
+	return NamespacedKey("IndexState", entityType+"/"+entityId+"/"+indexType, s.namespace)
+}
+
+func decodeIndexState(data []byte) (*v1.IndexState, error) {
+	state := &v1.IndexState{}
+	if err := protojson.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to decode index state: %w", err)
+	}
+	return state, nil
+}
+
+// LoadIndexState implements services.IndexStateStore
+func (s *IndexStateStore) LoadIndexState(ctx context.Context, entityType, entityId, indexType string) (*v1.IndexState, error) {
+	var entity indexStateEntity
+	if err := s.client.Get(ctx, s.key(entityType, entityId, indexType), &entity); err != nil {
+		if errors.Is(err, datastore.ErrNoSuchEntity) {
+			return nil, status.Errorf(codes.NotFound, "no %s index state for %s %s", indexType, entityType, entityId)
+		}
+		return nil, err
+	}
+	return decodeIndexState(entity.Data)
+}
+
+// SaveIndexState implements services.IndexStateStore
+func (s *IndexStateStore) SaveIndexState(ctx context.Context, state *v1.IndexState) error {
+	data, err := protojson.Marshal(state)
+	if err != nil {
+		return err
+	}
+	entity := &indexStateEntity{
+		EntityType: state.EntityType,
+		IndexType:  state.IndexType,
+		Status:     int32(state.Status),
+		Data:       data,
+	}
+	_, err = s.client.Put(ctx, s.key(state.EntityType, state.EntityId, state.IndexType), entity)
+	return err
+}
+
+// ListIndexStates implements services.IndexStateStore.  They are sorted in
+// Go so the query needs no composite index.
+func (s *IndexStateStore) ListIndexStates(ctx context.Context, filter services.IndexStateFilter) ([]*v1.IndexState, error) {
+	query := NamespacedQuery("IndexState", s.namespace)
+	if filter.EntityType != "" {
+		query = query.FilterField("entity_type", "=", filter.EntityType)
+	}
+	if filter.IndexType != "" {
+		query = query.FilterField("index_type", "=", filter.IndexType)
+	}
+	if filter.Status != v1.IndexStatus_INDEX_STATUS_UNSPECIFIED {
+		query = query.FilterField("status", "=", int32(filter.Status))
+	}
+	var entities []*indexStateEntity
+	if _, err := s.client.GetAll(ctx, query, &entities); err != nil {
+		return nil, err
+	}
+	states := make([]*v1.IndexState, 0, len(entities))
+	for _, entity := range entities {
+		state, err := decodeIndexState(entity.Data)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	slices.SortFunc(states, func(a, b *v1.IndexState) int {
+		return cmp.Or(cmp.Compare(a.EntityType, b.EntityType), cmp.Compare(a.EntityId, b.EntityId), cmp.Compare(a.IndexType, b.IndexType))
+	})
+	if filter.Limit > 0 && len(states) > filter.Limit {
+		states = states[:filter.Limit]
+	}
+	return states, nil
+}
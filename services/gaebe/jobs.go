@@ -0,0 +1,186 @@
+//go:build !wasm
+// +build !wasm
+
+package gaebe
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// jobEntity is a Job entity.  The job is kept as protojson with the fields
+// the queue filters on copied into indexed properties.
+type jobEntity struct {
+	JobType  string    `datastore:"job_type"`
+	Status   int32     `datastore:"status"`
+	RunAfter time.Time `datastore:"run_after"`
+	Version  int64     `datastore:"version,noindex"`
+	Data     []byte    `datastore:"data,noindex"`
+}
+
+// jobRunEntity is a JobRun entity
+type jobRunEntity struct {
+	JobId     string    `datastore:"job_id"`
+	CreatedAt time.Time `datastore:"created_at,noindex"`
+	Data      []byte    `datastore:"data,noindex"`
+}
+
+// JobStore implements services.JobStore in Datastore
+type JobStore struct {
+	client    *datastore.Client
+	namespace string
+}
+
+// NewJobStore creates a job store in a Datastore namespace
+func NewJobStore(client *datastore.Client, namespace string) *JobStore {
+	return &JobStore{client: client, namespace: namespace}
+}
+
+func (s *JobStore) jobKey(id string) *datastore.Key {
+	return NamespacedKey("Job", id, s.namespace)
+}
+
+func (s *JobStore) runKey(jobId, runId string) *datastore.Key {
+	return NamespacedKey("JobRun", jobId+"/"+runId, s.namespace)
+}
+
+func decodeJob(data []byte) (*v1.Job, error) {
+	job := &v1.Job{}
+	if err := protojson.Unmarshal(data, job); err != nil {
+		return nil, fmt.Errorf("failed to decode job: %w", err)
+	}
+	return job, nil
+}
+
+// LoadJob implements services.JobStore
+func (s *JobStore) LoadJob(ctx context.Context, id string) (*v1.Job, error) {
+	var entity jobEntity
+	if err := s.client.Get(ctx, s.jobKey(id), &entity); err != nil {
+		if errors.Is(err, datastore.ErrNoSuchEntity) {
+			return nil, status.Errorf(codes.NotFound, "job %s not found", id)
+		}
+		return nil, err
+	}
+	return decodeJob(entity.Data)
+}
+
+// SaveJob implements services.JobStore
+func (s *JobStore) SaveJob(ctx context.Context, job *v1.Job, oldVersion int64) error {
+	saved := proto.Clone(job).(*v1.Job)
+	saved.Version = oldVersion + 1
+	data, err := protojson.Marshal(saved)
+	if err != nil {
+		return err
+	}
+	entity := &jobEntity{
+		JobType:  saved.JobType,
+		Status:   int32(saved.Status),
+		RunAfter: saved.RunAfter.AsTime(),
+		Version:  saved.Version,
+		Data:     data,
+	}
+	key := s.jobKey(saved.Id)
+	_, err = s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+		var existing jobEntity
+		if err := tx.Get(key, &existing); err == nil {
+			if existing.Version != oldVersion {
+				return services.ErrJobConflict
+			}
+		} else if !errors.Is(err, datastore.ErrNoSuchEntity) {
+			return err
+		} else if oldVersion != 0 {
+			return services.ErrJobConflict
+		}
+		_, err := tx.Put(key, entity)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	job.Version = saved.Version
+	return nil
+}
+
+// ListJobs implements services.JobStore.  Only equality filters go to
+// Datastore, so the query needs no composite index, and the rest are
+// applied in Go.
+func (s *JobStore) ListJobs(ctx context.Context, filter services.JobFilter) ([]*v1.Job, error) {
+	query := NamespacedQuery("Job", s.namespace)
+	if filter.JobType != "" {
+		query = query.FilterField("job_type", "=", filter.JobType)
+	}
+	if filter.Status != v1.JobStatus_JOB_STATUS_UNSPECIFIED {
+		query = query.FilterField("status", "=", int32(filter.Status))
+	}
+	var entities []*jobEntity
+	if _, err := s.client.GetAll(ctx, query, &entities); err != nil {
+		return nil, err
+	}
+	var jobs []*v1.Job
+	for _, entity := range entities {
+		if !filter.DueBy.IsZero() && entity.RunAfter.After(filter.DueBy) {
+			continue
+		}
+		job, err := decodeJob(entity.Data)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	slices.SortFunc(jobs, func(a, b *v1.Job) int {
+		return cmp.Or(a.RunAfter.AsTime().Compare(b.RunAfter.AsTime()), cmp.Compare(a.Id, b.Id))
+	})
+	if filter.Limit > 0 && len(jobs) > filter.Limit {
+		jobs = jobs[:filter.Limit]
+	}
+	return jobs, nil
+}
+
+// SaveRun implements services.JobStore
+func (s *JobStore) SaveRun(ctx context.Context, run *v1.Run) error {
+	data, err := protojson.Marshal(run)
+	if err != nil {
+		return err
+	}
+	entity := &jobRunEntity{JobId: run.JobId, CreatedAt: run.CreatedAt.AsTime(), Data: data}
+	_, err = s.client.Put(ctx, s.runKey(run.JobId, run.RunId), entity)
+	return err
+}
+
+// ListRuns implements services.JobStore.  They are sorted in Go so the
+// query needs no composite index.
+func (s *JobStore) ListRuns(ctx context.Context, jobId string, limit int) ([]*v1.Run, error) {
+	var entities []*jobRunEntity
+	_, err := s.client.GetAll(ctx, NamespacedQuery("JobRun", s.namespace).
+		FilterField("job_id", "=", jobId), &entities)
+	if err != nil {
+		return nil, err
+	}
+	runs := make([]*v1.Run, 0, len(entities))
+	for _, entity := range entities {
+		run := &v1.Run{}
+		if err := protojson.Unmarshal(entity.Data, run); err != nil {
+			return nil, fmt.Errorf("failed to decode run of job %s: %w", jobId, err)
+		}
+		runs = append(runs, run)
+	}
+	slices.SortFunc(runs, func(a, b *v1.Run) int {
+		return cmp.Or(b.CreatedAt.AsTime().Compare(a.CreatedAt.AsTime()), cmp.Compare(b.RunId, a.RunId))
+	})
+	if limit > 0 && len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs, nil
+}
@@ -0,0 +1,49 @@
+//go:build !wasm
+// +build !wasm
+
+package gaebe
+
+import (
+	"cloud.google.com/go/datastore"
+	"github.com/turnforge/lilbattle/services"
+)
+
+// applyListOrder adds the sort order of a list query and the keyset
+// condition for its page key.  Datastore only allows an inequality on the
+// sort property so the condition is inclusive - results tied with the page
+// key are returned again and dropped with IsAfterCursor.  Ties come back in
+// ascending key order which is the tie break ListQuery expects.
+func applyListOrder(dsq *datastore.Query, q *services.ListQuery) *datastore.Query {
+	order, op := q.SortBy, ">="
+	if q.Descending {
+		order, op = "-"+q.SortBy, "<="
+	}
+	if q.After != nil {
+		var value any = q.After.CursorTime()
+		if q.SortBy == services.SortByName {
+			value = q.After.Name
+		}
+		dsq = dsq.FilterField(q.SortBy, op, value)
+	}
+	return dsq.Order(order)
+}
+
+// pushDownEquality reports whether equality filters can be added to a list
+// query.  Only the default sort order has composite indexes (see index.yaml)
+// so other orders filter in Go instead.
+func pushDownEquality(q *services.ListQuery, defaultSortBy string, defaultDescending bool) bool {
+	return q.SortBy == defaultSortBy && q.Descending == defaultDescending
+}
+
+// countListFilters returns how many filters a list query has set
+func countListFilters(q *services.ListQuery) (n int) {
+	for _, set := range []bool{
+		q.OwnerId != "", q.PlayerUserId != "", q.WorldId != "", q.ParentWorldId != "", q.Tag != "",
+		q.Status != 0, q.Created != nil, q.Updated != nil,
+	} {
+		if set {
+			n++
+		}
+	}
+	return
+}
@@ -0,0 +1,91 @@
+//go:build !wasm
+// +build !wasm
+
+package gaebe
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rulesEntity is a Rules entity - the rules and damage data of a rules
+// version kept for imported games.  The data is gzipped as the damage
+// table alone is over the entity size limit.
+type rulesEntity struct {
+	CreatedAt  time.Time `datastore:"created_at"`
+	RulesJson  []byte    `datastore:"rules_json,noindex"`
+	DamageJson []byte    `datastore:"damage_json,noindex"`
+}
+
+// SaveRules implements services.RulesStore
+func (s *GamesService) SaveRules(ctx context.Context, version string, rulesJSON, damageJSON []byte) error {
+	entity := &rulesEntity{CreatedAt: time.Now()}
+	var err error
+	if entity.RulesJson, err = gzipBytes(rulesJSON); err != nil {
+		return err
+	}
+	if entity.DamageJson, err = gzipBytes(damageJSON); err != nil {
+		return err
+	}
+	key := NamespacedKey("Rules", version, s.namespace)
+	_, err = s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+		// Versions are content hashes - an existing one is kept
+		var existing rulesEntity
+		if err := tx.Get(key, &existing); err == nil {
+			return nil
+		} else if !errors.Is(err, datastore.ErrNoSuchEntity) {
+			return err
+		}
+		_, err := tx.Put(key, entity)
+		return err
+	})
+	return err
+}
+
+// LoadRules implements services.RulesStore
+func (s *GamesService) LoadRules(ctx context.Context, version string) (rulesJSON, damageJSON []byte, err error) {
+	var entity rulesEntity
+	if err := s.client.Get(ctx, NamespacedKey("Rules", version, s.namespace), &entity); err != nil {
+		if errors.Is(err, datastore.ErrNoSuchEntity) {
+			return nil, nil, status.Errorf(codes.NotFound, "rules version %s not found", version)
+		}
+		return nil, nil, err
+	}
+	if rulesJSON, err = gunzipBytes(entity.RulesJson); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode rules version %s: %w", version, err)
+	}
+	if damageJSON, err = gunzipBytes(entity.DamageJson); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode rules version %s: %w", version, err)
+	}
+	return rulesJSON, damageJSON, nil
+}
+
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gunzipBytes(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,94 @@
+//go:build !wasm
+// +build !wasm
+
+package gaebe
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/datastore"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1ds "github.com/turnforge/lilbattle/gen/datastore/lilbattle/v1/datastore"
+)
+
+// userGameKey returns the key of a user game index entry
+func (s *GamesService) userGameKey(userId, gameId string) *datastore.Key {
+	return NamespacedKey("UserGame", userId+"/"+gameId, s.namespace)
+}
+
+// SaveUserGames implements services.UserGameIndex - replaces the game's
+// UserGame entities in one transaction
+func (s *GamesService) SaveUserGames(ctx context.Context, gameId string, entries []*v1.UserGame) error {
+	// Queries cannot run inside the transaction without an ancestor so the
+	// old keys are looked up first
+	oldKeys, err := s.client.GetAll(ctx, NamespacedQuery("UserGame", s.namespace).
+		FilterField("game_id", "=", gameId).
+		KeysOnly(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to query user games of game %s: %w", gameId, err)
+	}
+
+	var keys []*datastore.Key
+	var rows []*v1ds.UserGameDatastore
+	for _, entry := range entries {
+		row, err := v1ds.UserGameToUserGameDatastore(entry, nil, nil)
+		if err != nil {
+			return fmt.Errorf("failed to convert user game: %w", err)
+		}
+		row.Key = s.userGameKey(entry.UserId, gameId)
+		keys = append(keys, row.Key)
+		rows = append(rows, row)
+	}
+
+	_, err = s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+		// Drop entries of users no longer in the game - the rest are overwritten
+		var stale []*datastore.Key
+		for _, key := range oldKeys {
+			if !containsKey(keys, key) {
+				stale = append(stale, key)
+			}
+		}
+		if len(stale) > 0 {
+			if err := tx.DeleteMulti(stale); err != nil {
+				return err
+			}
+		}
+		if len(rows) > 0 {
+			if _, err := tx.PutMulti(keys, rows); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// ListUserGames implements services.UserGameIndex
+func (s *GamesService) ListUserGames(ctx context.Context, userId string) ([]*v1.UserGame, error) {
+	var rows []*v1ds.UserGameDatastore
+	keys, err := s.client.GetAll(ctx, NamespacedQuery("UserGame", s.namespace).
+		FilterField("user_id", "=", userId), &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query games of user %s: %w", userId, err)
+	}
+	out := make([]*v1.UserGame, 0, len(rows))
+	for i, row := range rows {
+		row.Key = keys[i]
+		entry, err := v1ds.UserGameFromUserGameDatastore(nil, row, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert user game: %w", err)
+		}
+		out = append(out, entry)
+	}
+	return out, nil
+}
+
+func containsKey(keys []*datastore.Key, key *datastore.Key) bool {
+	for _, k := range keys {
+		if k.Equal(key) {
+			return true
+		}
+	}
+	return false
+}
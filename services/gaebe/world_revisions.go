@@ -0,0 +1,146 @@
+//go:build !wasm
+// +build !wasm
+
+package gaebe
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// worldRevisionEntity is a WorldRevision entity.  The revision's world data
+// is kept as protojson since it is only ever loaded whole.
+type worldRevisionEntity struct {
+	WorldId      string    `datastore:"world_id"`
+	Revision     int64     `datastore:"revision"`
+	CreatedAt    time.Time `datastore:"created_at"`
+	AuthorId     string    `datastore:"author_id"`
+	RevertedFrom int64     `datastore:"reverted_from"`
+	ContentHash  string    `datastore:"content_hash,noindex"`
+	TileCount    int32     `datastore:"tile_count,noindex"`
+	UnitCount    int32     `datastore:"unit_count,noindex"`
+	Data         []byte    `datastore:"data,noindex"`
+}
+
+// worldRevisionKey returns the key of a world revision entity
+func (s *WorldsService) worldRevisionKey(worldId string, revision int64) *datastore.Key {
+	return NamespacedKey("WorldRevision", worldId+"/"+strconv.FormatInt(revision, 10), s.namespace)
+}
+
+func (e *worldRevisionEntity) toProto(withData bool) (*v1.WorldRevision, error) {
+	revision := &v1.WorldRevision{
+		WorldId:      e.WorldId,
+		Revision:     e.Revision,
+		CreatedAt:    tspb.New(e.CreatedAt),
+		AuthorId:     e.AuthorId,
+		RevertedFrom: e.RevertedFrom,
+		ContentHash:  e.ContentHash,
+		TileCount:    e.TileCount,
+		UnitCount:    e.UnitCount,
+	}
+	if withData {
+		revision.WorldData = &v1.WorldData{}
+		if err := protojson.Unmarshal(e.Data, revision.WorldData); err != nil {
+			return nil, fmt.Errorf("failed to decode revision %d of world %s: %w", e.Revision, e.WorldId, err)
+		}
+	}
+	return revision, nil
+}
+
+// SaveRevision implements services.WorldRevisionStore
+func (s *WorldsService) SaveRevision(ctx context.Context, revision *v1.WorldRevision) error {
+	data, err := protojson.Marshal(revision.WorldData)
+	if err != nil {
+		return err
+	}
+	entity := &worldRevisionEntity{
+		WorldId:      revision.WorldId,
+		Revision:     revision.Revision,
+		CreatedAt:    revision.CreatedAt.AsTime(),
+		AuthorId:     revision.AuthorId,
+		RevertedFrom: revision.RevertedFrom,
+		ContentHash:  revision.ContentHash,
+		TileCount:    revision.TileCount,
+		UnitCount:    revision.UnitCount,
+		Data:         data,
+	}
+	key := s.worldRevisionKey(revision.WorldId, revision.Revision)
+	_, err = s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+		// Revisions are immutable - an existing one is kept
+		var existing worldRevisionEntity
+		if err := tx.Get(key, &existing); err == nil {
+			return nil
+		} else if !errors.Is(err, datastore.ErrNoSuchEntity) {
+			return err
+		}
+		_, err := tx.Put(key, entity)
+		return err
+	})
+	return err
+}
+
+// LoadRevision implements services.WorldRevisionStore
+func (s *WorldsService) LoadRevision(ctx context.Context, worldId string, revision int64) (*v1.WorldRevision, error) {
+	var entity worldRevisionEntity
+	if err := s.client.Get(ctx, s.worldRevisionKey(worldId, revision), &entity); err != nil {
+		if errors.Is(err, datastore.ErrNoSuchEntity) {
+			return nil, status.Errorf(codes.NotFound, "world %s has no revision %d", worldId, revision)
+		}
+		return nil, err
+	}
+	return entity.toProto(true)
+}
+
+// ListRevisions implements services.WorldRevisionStore.  They are sorted in
+// Go so the query needs no composite index.
+func (s *WorldsService) ListRevisions(ctx context.Context, worldId string) ([]*v1.WorldRevision, error) {
+	var entities []*worldRevisionEntity
+	_, err := s.client.GetAll(ctx, NamespacedQuery("WorldRevision", s.namespace).
+		FilterField("world_id", "=", worldId), &entities)
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]*v1.WorldRevision, 0, len(entities))
+	for _, entity := range entities {
+		revision, err := entity.toProto(false)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	slices.SortFunc(revisions, func(a, b *v1.WorldRevision) int {
+		return cmp.Compare(b.Revision, a.Revision)
+	})
+	return revisions, nil
+}
+
+// DeleteRevisions implements services.WorldRevisionStore
+func (s *WorldsService) DeleteRevisions(ctx context.Context, worldId string) error {
+	keys, err := s.client.GetAll(ctx, NamespacedQuery("WorldRevision", s.namespace).
+		FilterField("world_id", "=", worldId).
+		KeysOnly(), nil)
+	if err != nil {
+		return err
+	}
+	// Datastore deletes at most 500 keys per call
+	for len(keys) > 0 {
+		batch := keys[:min(len(keys), 500)]
+		if err := s.client.DeleteMulti(ctx, batch); err != nil {
+			return err
+		}
+		keys = keys[len(batch):]
+	}
+	return nil
+}
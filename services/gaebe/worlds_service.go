@@ -15,6 +15,7 @@ import (
 	v1dal "github.com/turnforge/lilbattle/gen/datastore/dal/lilbattle/v1/datastore"
 	"github.com/turnforge/lilbattle/lib"
 	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	tspb "google.golang.org/protobuf/types/known/timestamppb"
@@ -42,6 +43,7 @@ func NewWorldsService(client *datastore.Client, namespace string, clientMgr *ser
 	service.ClientMgr = clientMgr
 	service.Self = service
 	service.WorldDataUpdater = service
+	service.RevisionStore = service
 	service.InitializeScreenshotIndexer()
 
 	return service
@@ -97,6 +99,10 @@ func (s *WorldsService) CreateWorld(ctx context.Context, req *v1.CreateWorldRequ
 	if req.World == nil {
 		return nil, fmt.Errorf("world data is required")
 	}
+	req.World.Id = services.NormalizeWorldID(req.World.Id)
+	if err = s.PrepareWorldCopy(ctx, req); err != nil {
+		return nil, err
+	}
 
 	// Try to assign ID (custom or generated)
 	assignedId := NewID(ctx, s.client, s.namespace, "worlds", req.World.Id)
@@ -112,6 +118,12 @@ func (s *WorldsService) CreateWorld(ctx context.Context, req *v1.CreateWorldRequ
 	// Auto-migrate from old list-based format to new map-based format before saving
 	lib.MigrateWorldData(req.WorldData)
 
+	// World data starts at version 1 so revision 0 can mean "no revision"
+	if req.WorldData == nil {
+		req.WorldData = &v1.WorldData{}
+	}
+	req.WorldData.Version = max(req.WorldData.Version, 1)
+
 	// Use transaction to save world + world data atomically
 	_, err = s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
 		// Save world metadata
@@ -146,55 +158,75 @@ func (s *WorldsService) CreateWorld(ctx context.Context, req *v1.CreateWorldRequ
 	if err != nil {
 		return nil, fmt.Errorf("failed to create world: %w", err)
 	}
+	s.RecordWorldRevision(ctx, req.World.Id, nil, req.WorldData)
 
 	resp.World = req.World
 	resp.WorldData = req.WorldData
 	return resp, nil
 }
 
-// ListWorlds returns all available worlds (metadata only for performance)
+// ListWorlds returns a page of worlds (metadata only for performance).  An
+// owner filter is pushed to Datastore for the default sort order; other
+// filters are checked in Go while streaming results until the page is
+// filled.  TotalResults is not counted.
 func (s *WorldsService) ListWorlds(ctx context.Context, req *v1.ListWorldsRequest) (resp *v1.ListWorldsResponse, err error) {
 	ctx, span := Tracer.Start(ctx, "ListWorlds")
 	defer span.End()
 
-	resp = &v1.ListWorldsResponse{
-		Pagination: &v1.PaginationResponse{
-			HasMore:      false,
-			TotalResults: 0,
-		},
-	}
-
-	query := NamespacedQuery("World", s.namespace).
-		Order("name")
-
-	var entities []*v1ds.WorldDatastore
-	keys, err := s.client.GetAll(ctx, query, &entities)
+	query, err := services.NewWorldListQuery(req)
 	if err != nil {
 		return nil, err
 	}
+	if s.MaxPageSize > 0 {
+		query.PageSize = min(query.PageSize, s.MaxPageSize)
+	}
+
+	dsq := NamespacedQuery("World", s.namespace)
+	inQuery := 0
+	if query.OwnerId != "" && pushDownEquality(query, services.SortByName, false) {
+		dsq = dsq.FilterField("creator_id", "=", query.OwnerId)
+		inQuery++
+	}
+	dsq = applyListOrder(dsq, query)
 
-	// Set keys and IDs on entities (Id has datastore:"-" so must be populated from key)
-	for i, key := range keys {
-		entities[i].Key = key
-		entities[i].Id = key.Name
+	// Offsets can only be pushed down when Datastore sees every filter
+	skip := query.PageOffset
+	if countListFilters(query) == inQuery && skip > 0 {
+		dsq, skip = dsq.Offset(skip), 0
 	}
 
-	// Convert to proto
-	for _, entity := range entities {
-		world, err := v1ds.WorldFromWorldDatastore(nil, entity, nil)
+	page := services.NewListPage[*v1.World](query, skip)
+	it := s.client.Run(ctx, dsq)
+	for !page.Full() {
+		var entity v1ds.WorldDatastore
+		key, err := it.Next(&entity)
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// Id has datastore:"-" so must be populated from key
+		entity.Key = key
+		entity.Id = key.Name
+
+		world, err := v1ds.WorldFromWorldDatastore(nil, &entity, nil)
 		if err != nil {
 			log.Printf("Warning: failed to convert world: %v", err)
 			continue
 		}
+		if query.IsAfterCursor(world) && query.MatchesWorld(world) {
+			page.Add(world)
+		}
+	}
 
+	resp = &v1.ListWorldsResponse{Items: page.Items(), Pagination: page.Pagination(0)}
+	for _, world := range resp.Items {
 		// Populate screenshot URL if not set
 		if len(world.PreviewUrls) == 0 {
 			world.PreviewUrls = []string{fmt.Sprintf("/screenshots/worlds/%s/default.png", world.Id)}
 		}
-		resp.Items = append(resp.Items, world)
 	}
-
-	resp.Pagination.TotalResults = int32(len(resp.Items))
 	return resp, nil
 }
 
@@ -229,6 +261,9 @@ func (s *WorldsService) GetWorld(ctx context.Context, req *v1.GetWorldRequest) (
 	// Auto-migrate from old list-based format to new map-based format
 	lib.MigrateWorldData(resp.WorldData)
 
+	if err := s.ResolveWorldRevision(ctx, req.Version, resp); err != nil {
+		return nil, err
+	}
 	return resp, nil
 }
 
@@ -247,6 +282,7 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 	worldKey := NamespacedKey("World", req.World.Id, s.namespace)
 	worldDataKey := NamespacedKey("WorldData", req.World.Id, s.namespace)
 
+	var previous *v1.WorldData
 	_, err = s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
 		// Load existing world
 		var worldDs v1ds.WorldDatastore
@@ -269,6 +305,10 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 			}
 		}
 		worldDataDs.Key = worldDataKey
+		previous, err = v1ds.WorldDataFromWorldDataDatastore(nil, &worldDataDs, nil)
+		if err != nil {
+			return err
+		}
 
 		// Update metadata fields
 		if req.World.Name != "" {
@@ -283,6 +323,9 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 		if req.World.Difficulty != "" {
 			worldDs.Difficulty = req.World.Difficulty
 		}
+		if req.World.ParentRevision > 0 {
+			worldDs.ParentRevision = req.World.ParentRevision
+		}
 		worldDs.UpdatedAt = time.Now()
 
 		// Update world data if provided
@@ -363,6 +406,9 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 		resp.World, err = v1ds.WorldFromWorldDatastore(nil, &worldDs, nil)
 		return err
 	})
+	if err == nil && resp.WorldData != nil {
+		s.RecordWorldRevision(ctx, req.World.Id, previous, resp.WorldData)
+	}
 
 	return resp, err
 }
@@ -391,6 +437,12 @@ func (s *WorldsService) DeleteWorld(ctx context.Context, req *v1.DeleteWorldRequ
 
 		return nil
 	})
+	if err == nil {
+		err = s.DeleteRevisions(ctx, req.Id)
+	}
+	if err == nil {
+		s.RemoveFromSearchIndex(ctx, req.Id)
+	}
 
 	return resp, err
 }
@@ -0,0 +1,184 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"strconv"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExportGame bundles a game with the world revision it started from and
+// the rules it is played with into a game archive (see lib.GameArchive).
+// The game is replayed before it is exported so an archive that will not
+// verify on import - eg because a game from before worlds kept revisions
+// had its world edited after it started - is reported here instead.
+func (s *BackendGamesService) ExportGame(ctx context.Context, req *v1.ExportGameRequest) (*v1.ExportGameResponse, error) {
+	if req.GameId == "" {
+		return nil, fmt.Errorf("game ID is required")
+	}
+	if s.StorageProvider == nil {
+		return nil, fmt.Errorf("storage provider not configured")
+	}
+	if s.ClientMgr == nil {
+		return nil, fmt.Errorf("worlds service not configured")
+	}
+
+	game, err := s.StorageProvider.LoadGame(ctx, req.GameId)
+	if err != nil {
+		return nil, fmt.Errorf("game not found: %w", err)
+	}
+	state, err := s.StorageProvider.LoadGameState(ctx, req.GameId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load game state: %w", err)
+	}
+	history, err := s.StorageProvider.LoadGameHistory(ctx, req.GameId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load game history: %w", err)
+	}
+	world, err := s.ClientMgr.GetWorldsSvcClient().GetWorld(ctx, &v1.GetWorldRequest{
+		Id:      game.WorldId,
+		Version: WorldRevisionVersion(game.WorldRevision),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load world %s: %w", game.WorldId, err)
+	}
+
+	// Games from before games recorded their rules are taken to be played
+	// with the current ones if they replay under them
+	if game.RulesVersion == "" {
+		game.RulesVersion = lib.DefaultRulesVersion()
+	}
+	rulesJSON, damageJSON, err := s.rulesJSONFor(ctx, game)
+	if err != nil {
+		return nil, err
+	}
+	archive := &lib.GameArchive{
+		Game:       game,
+		State:      state,
+		History:    history,
+		World:      world.WorldData,
+		RulesJSON:  rulesJSON,
+		DamageJSON: damageJSON,
+		ExportedAt: time.Now(),
+	}
+	if _, err := archive.Verify(); err != nil {
+		return nil, fmt.Errorf("game %s does not replay from world %s - was the world edited after the game started? %w",
+			game.Id, game.WorldId, err)
+	}
+	data, hash, err := archive.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write game archive: %w", err)
+	}
+	return &v1.ExportGameResponse{Archive: data, ContentHash: hash}, nil
+}
+
+// ImportGame stores the game in an archive after checking its hashes and
+// replaying its history with the archived rules.  Rules other than the
+// server's are kept in the RulesStore so the game goes on being played
+// with them.  The game keeps its ID unless req.GameId is set; an ID that is
+// taken is reported in FieldErrors["id"] with a suggestion, as CreateGame
+// does.
+// Authorization: User must be authenticated and becomes the game's creator.
+func (s *BackendGamesService) ImportGame(ctx context.Context, req *v1.ImportGameRequest) (*v1.ImportGameResponse, error) {
+	userID, err := authz.RequireAuthenticated(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.StorageProvider == nil {
+		return nil, fmt.Errorf("storage provider not configured")
+	}
+
+	archive, hash, err := lib.ReadGameArchive(req.Archive)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := archive.Verify()
+	if err != nil {
+		return nil, fmt.Errorf("game archive failed verification: %w", err)
+	}
+
+	gameId := archive.Game.Id
+	if req.GameId != "" {
+		gameId = req.GameId
+	}
+	if existing, err := s.StorageProvider.LoadGame(ctx, gameId); err == nil && existing != nil {
+		return &v1.ImportGameResponse{
+			FieldErrors: map[string]string{"id": gameId + "-" + shortRandSuffix()},
+		}, nil
+	}
+
+	rulesVersion := lib.RulesVersion(archive.RulesJSON, archive.DamageJSON)
+	rulesMatch := rulesVersion == lib.DefaultRulesVersion()
+	if !rulesMatch {
+		if s.RulesStore == nil {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"archive is played with rules version %s but this server has %s and cannot keep other rules",
+				rulesVersion, lib.DefaultRulesVersion())
+		}
+		if err := s.RulesStore.SaveRules(ctx, rulesVersion, archive.RulesJSON, archive.DamageJSON); err != nil {
+			return nil, fmt.Errorf("failed to save archived rules: %w", err)
+		}
+	}
+
+	game, state, history := archive.Game, archive.State, archive.History
+	game.RulesVersion = rulesVersion
+	game.Id, state.GameId, history.GameId = gameId, gameId, gameId
+	game.CreatorId = userID
+	game.PreviewUrls = nil
+	// The pinned revision belongs to the exporting server's copy of the world
+	game.WorldRevision = 0
+	game.UpdatedAt = timestamppb.New(time.Now())
+	if state.WorldData != nil {
+		state.WorldData.ScreenshotIndexInfo = nil
+	}
+	MarkScreenshotStale(state)
+
+	// Saved as separate writes, so a failure part way removes what was
+	// saved rather than leaving a half imported game holding the ID
+	if err := s.StorageProvider.SaveGame(ctx, gameId, game); err != nil {
+		return nil, fmt.Errorf("failed to save game: %w", err)
+	}
+	if err := s.StorageProvider.SaveGameState(ctx, gameId, state); err != nil {
+		return nil, s.abandonImport(ctx, gameId, fmt.Errorf("failed to save game state: %w", err))
+	}
+	if err := s.StorageProvider.SaveGameHistory(ctx, gameId, history); err != nil {
+		return nil, s.abandonImport(ctx, gameId, fmt.Errorf("failed to save game history: %w", err))
+	}
+	s.invalidateCache(gameId)
+	s.UpdateUserGameIndex(ctx, game, state)
+	s.QueueGameScreenshot(gameId, state)
+
+	return &v1.ImportGameResponse{
+		Game:           game,
+		ContentHash:    hash,
+		ReplayedGroups: groups,
+		RulesMatch:     rulesMatch,
+	}, nil
+}
+
+// abandonImport deletes a game whose import failed part way and returns the
+// error it failed with
+func (s *BackendGamesService) abandonImport(ctx context.Context, gameId string, err error) error {
+	if delErr := s.StorageProvider.DeleteFromStorage(ctx, gameId); delErr != nil {
+		log.Printf("Failed to delete partly imported game %s: %v", gameId, delErr)
+	}
+	s.invalidateCache(gameId)
+	return err
+}
+
+// shortRandSuffix generates a 4-character random suffix for ID suggestions
+func shortRandSuffix() string {
+	return strconv.FormatInt(rand.Int63()%(36*36*36*36), 36)
+}
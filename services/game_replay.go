@@ -0,0 +1,74 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// Game replays animate a game's move history a frame per move, with the
+// paths units moved along, flashes where attacks landed and the turn and
+// player to move.  They are rendered on demand (ww replay and
+// /games/{id}/replay.gif) rather than stored.
+
+// RenderGameReplay renders a game's history in a theme as an animated GIF
+// or APNG (themes.ReplayFormatGIF/APNG).  Animations need a PNG asset theme.
+func RenderGameReplay(game *v1.Game, state *v1.GameState, history *v1.GameMoveHistory, themeName, format string, options *themes.ReplayOptions) ([]byte, string, error) {
+	renderer, options, err := replayRenderer(game, state, themeName, options)
+	if err != nil {
+		return nil, "", err
+	}
+	return themes.EncodeReplay(renderer, lib.ReplayFrames(state, history), format, options)
+}
+
+// RenderGameReplayFrames renders a game's history in a theme as a sequence
+// of images, one per move after the starting one
+func RenderGameReplayFrames(game *v1.Game, state *v1.GameState, history *v1.GameMoveHistory, themeName string, options *themes.ReplayOptions) ([][]byte, string, error) {
+	renderer, options, err := replayRenderer(game, state, themeName, options)
+	if err != nil {
+		return nil, "", err
+	}
+	return themes.RenderReplayFrames(renderer, lib.ReplayFrames(state, history), options)
+}
+
+// replayRenderer creates the renderer for a theme and fills in the game's
+// players for the caption, its crossings and unit health bars
+func replayRenderer(game *v1.Game, state *v1.GameState, themeName string, options *themes.ReplayOptions) (themes.WorldRenderer, *themes.ReplayOptions, error) {
+	if themeName == "" {
+		themeName = "default"
+	}
+	theme, err := themes.CreateTheme(themeName, lib.DefaultRulesEngine().GetCityTerrains())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create theme %s: %w", themeName, err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create renderer for theme %s: %w", themeName, err)
+	}
+
+	out := &themes.ReplayOptions{}
+	if options != nil {
+		*out = *options
+	}
+	render := lib.DefaultRenderOptions()
+	if out.Render != nil {
+		copied := *out.Render
+		render = &copied
+	}
+	if render.Crossings == nil {
+		render.Crossings = state.GetWorldData().GetCrossings()
+	}
+	render.ShowHealthBars = true
+	out.Render = render
+	if len(out.Players) == 0 {
+		for _, player := range game.GetConfig().GetPlayers() {
+			out.Players = append(out.Players, player.PlayerId)
+		}
+	}
+	return renderer, out, nil
+}
@@ -0,0 +1,70 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Game screenshots show a game's current map with its turn and players
+// drawn over it, at screenshots/games/{id}/{theme}.{ext} (the game's
+// PreviewUrls).  They are rendered when a game is created or imported and
+// after a turn changes rather than on every move.  Turns that change
+// quickly, eg between AI players, are debounced by the indexer's flush
+// period which keeps only the latest state of a game.
+
+// GameScreenshotOverlay returns the turn and player swatches drawn over a
+// game's screenshots.  The current player is outlined - or the winner once
+// the game has finished.
+func GameScreenshotOverlay(game *v1.Game, state *v1.GameState) *lib.RenderOverlay {
+	overlay := &lib.RenderOverlay{
+		Caption:       fmt.Sprintf("Turn %d", state.TurnCounter),
+		CurrentPlayer: state.CurrentPlayer,
+	}
+	if state.Finished {
+		overlay.Caption += " - finished"
+		overlay.CurrentPlayer = state.WinningPlayer
+	}
+	for _, player := range game.GetConfig().GetPlayers() {
+		overlay.Players = append(overlay.Players, player.PlayerId)
+	}
+	return overlay
+}
+
+// MarkScreenshotStale flags a game state's screenshot for rendering.  It is
+// set before the state is saved so the IndexReconciler finds screenshots
+// that were queued but lost, eg by a restart.
+func MarkScreenshotStale(state *v1.GameState) {
+	if state.WorldData == nil {
+		return
+	}
+	if state.WorldData.ScreenshotIndexInfo == nil {
+		state.WorldData.ScreenshotIndexInfo = &v1.IndexInfo{}
+	}
+	state.WorldData.ScreenshotIndexInfo.LastUpdatedAt = timestamppb.New(time.Now())
+	state.WorldData.ScreenshotIndexInfo.NeedsIndexing = true
+}
+
+// QueueGameScreenshot sends a saved game state to be screenshotted.  It is
+// dropped when screenshots are disabled.
+func (s *BackendGamesService) QueueGameScreenshot(gameId string, state *v1.GameState) {
+	s.ScreenShotIndexer.Send("games", gameId, state.Version, state.WorldData)
+}
+
+// gameScreenshotOverlay implements ScreenShotIndexer.Overlay for games
+func (s *BackendGamesService) gameScreenshotOverlay(ctx context.Context, item *ScreenShotItem) *lib.RenderOverlay {
+	resp, err := s.Self.GetGame(ctx, &v1.GetGameRequest{Id: item.Id})
+	if err != nil || resp.Game == nil || resp.State == nil {
+		log.Printf("Failed to load game %s for its screenshot overlay: %v", item.Id, err)
+		return nil
+	}
+	return GameScreenshotOverlay(resp.Game, resp.State)
+}
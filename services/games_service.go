@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
@@ -47,6 +48,16 @@ type GamesService interface {
 	SimulateFix(context.Context, *v1.SimulateFixRequest) (*v1.SimulateFixResponse, error)
 	// Join a game as an open player slot
 	JoinGame(context.Context, *v1.JoinGameRequest) (*v1.JoinGameResponse, error)
+	// Commit a hash of the caller's orders for the current simultaneous turn
+	CommitOrders(context.Context, *v1.CommitOrdersRequest) (*v1.CommitOrdersResponse, error)
+	// Reveal committed orders, resolving the turn once all players have revealed
+	RevealOrders(context.Context, *v1.RevealOrdersRequest) (*v1.RevealOrdersResponse, error)
+	// List the games the caller is a player in from the user game index
+	ListMyGames(context.Context, *v1.ListMyGamesRequest) (*v1.ListMyGamesResponse, error)
+	// Export a game as a portable archive
+	ExportGame(context.Context, *v1.ExportGameRequest) (*v1.ExportGameResponse, error)
+	// Import a game archive after verifying it by replaying its history
+	ImportGame(context.Context, *v1.ImportGameRequest) (*v1.ImportGameResponse, error)
 	GetRuntimeGame(game *v1.Game, gameState *v1.GameState) (*lib.Game, error)
 
 	// SaveMoveGroup saves a move group atomically with the game state.
@@ -55,6 +66,10 @@ type GamesService interface {
 	// - FS: writes history then state (pseudo-atomic)
 	// - Non-transactional: writes moves first, then commits via state update (checkpoint pattern)
 	SaveMoveGroup(ctx context.Context, gameId string, state *v1.GameState, group *v1.GameMoveGroup) error
+
+	// SavePendingOrders saves the game state after simultaneous turn orders
+	// are committed or revealed.  No moves are recorded until the turn resolves.
+	SavePendingOrders(ctx context.Context, gameId string, state *v1.GameState) error
 }
 
 // MovesSavedCallback is called after moves are saved.
@@ -64,6 +79,16 @@ type MovesSavedCallback func(ctx context.Context, gameId string, moves []*v1.Gam
 type BaseGamesService struct {
 	Self         GamesService // The actual implementation
 	OnMovesSaved MovesSavedCallback
+
+	// OnOrdersDeadline is called whenever a simultaneous turn enters a new
+	// orders phase with the time it ends (see ForfeitLateOrders)
+	OnOrdersDeadline func(ctx context.Context, gameId string, deadline time.Time)
+
+	// Now is the clock orders deadlines are checked against - time.Now if nil
+	Now func() time.Time
+
+	// Serializes commit/reveal of simultaneous turn orders
+	ordersMu sync.Mutex
 }
 
 func (s *BaseGamesService) ListMoves(ctx context.Context, req *v1.ListMovesRequest) (resp *v1.ListMovesResponse, err error) {
@@ -85,9 +110,12 @@ func (s *BaseGamesService) ProcessMoves(ctx context.Context, req *v1.ProcessMove
 	if gameresp.State == nil {
 		return nil, fmt.Errorf("game state cannot be nil")
 	}
+	if lib.IsSimultaneous(gameresp.Game) {
+		return nil, fmt.Errorf("game %s uses simultaneous turns - submit orders with CommitOrders and RevealOrders", req.GameId)
+	}
 
 	// Authorization: user must be a player in the game AND it must be their turn
-	if err := authz.CanSubmitMoves(ctx, gameresp.Game, gameresp.State.CurrentPlayer); err != nil {
+	if err := authz.CanSubmitMoves(ctx, gameresp.Game, gameresp.State.CurrentPlayer, req.Moves); err != nil {
 		return nil, err
 	}
 
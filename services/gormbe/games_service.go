@@ -10,13 +10,13 @@ import (
 	"log"
 	"time"
 
-	gfn "github.com/panyam/goutils/fn"
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	v1gorm "github.com/turnforge/lilbattle/gen/gorm/lilbattle/v1/gorm"
 	v1dal "github.com/turnforge/lilbattle/gen/gorm/dal/lilbattle/v1/gorm"
 	"github.com/turnforge/lilbattle/lib"
 	"github.com/turnforge/lilbattle/services"
-	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	tspb "google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
@@ -29,6 +29,7 @@ type GamesService struct {
 	GameDAL      v1dal.GameGORMDAL
 	GameStateDAL v1dal.GameStateGORMDAL
 	GameMoveDAL  v1dal.GameMoveGORMDAL
+	UserGameDAL  v1dal.UserGameGORMDAL
 }
 
 // NewGamesService creates a new GamesService implementation
@@ -37,6 +38,8 @@ func NewGamesService(db *gorm.DB, clientMgr *services.ClientMgr) *GamesService {
 	db.AutoMigrate(&v1gorm.GameGORM{})
 	db.AutoMigrate(&v1gorm.GameStateGORM{})
 	db.AutoMigrate(&v1gorm.GameMoveGORM{})
+	db.AutoMigrate(&v1gorm.UserGameGORM{})
+	db.AutoMigrate(&RulesRow{})
 
 	service := &GamesService{
 		storage:     db,
@@ -44,13 +47,20 @@ func NewGamesService(db *gorm.DB, clientMgr *services.ClientMgr) *GamesService {
 	}
 	service.ClientMgr = clientMgr
 	service.GameDAL.WillCreate = func(ctx context.Context, game *v1gorm.GameGORM) error {
-		game.UpdatedAt = time.Now()
-		game.CreatedAt = time.Now()
+		// Keep the times of games being restored or imported
+		if game.CreatedAt.IsZero() {
+			game.CreatedAt = time.Now().UTC()
+		}
+		if game.UpdatedAt.IsZero() {
+			game.UpdatedAt = game.CreatedAt
+		}
 		return nil
 	}
 	service.Self = service
 	service.StorageProvider = service // GamesService implements GameStorageProvider
 	service.GameStateUpdater = service
+	service.UserGameIndex = service
+	service.RulesStore = service
 	service.InitializeCache() // Enable caching (optional - can be disabled via CacheEnabled = false)
 	service.InitializeScreenshotIndexer()
 	service.InitializeSyncBroadcast()
@@ -64,6 +74,9 @@ func (s *GamesService) LoadGame(ctx context.Context, id string) (*v1.Game, error
 	if err != nil {
 		return nil, fmt.Errorf("game not found: %w", err)
 	}
+	if gameGorm == nil {
+		return nil, status.Errorf(codes.NotFound, "game %s not found", id)
+	}
 	game, err := v1gorm.GameFromGameGORM(nil, gameGorm, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert game: %w", err)
@@ -81,6 +94,9 @@ func (s *GamesService) LoadGameState(ctx context.Context, id string) (*v1.GameSt
 	if err != nil {
 		return nil, fmt.Errorf("game state not found: %w", err)
 	}
+	if stateGorm == nil {
+		return nil, status.Errorf(codes.NotFound, "game state for %s not found", id)
+	}
 	state, err := v1gorm.GameStateFromGameStateGORM(nil, stateGorm, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert game state: %w", err)
@@ -91,33 +107,28 @@ func (s *GamesService) LoadGameState(ctx context.Context, id string) (*v1.GameSt
 // LoadGameHistory implements GameStorageProvider - loads game history directly from database
 func (s *GamesService) LoadGameHistory(ctx context.Context, id string) (*v1.GameMoveHistory, error) {
 	// Load moves and convert to history format
-	moves, err := s.GameMoveDAL.List(ctx, s.storage.Where("game_id = ?", id).Order("group_number asc").Order("timestamp asc"))
+	moves, err := s.GameMoveDAL.List(ctx, s.storage.Where("game_id = ?", id).Order("group_number asc").Order("move_number asc"))
 	if err != nil {
 		return nil, fmt.Errorf("failed to load moves: %w", err)
 	}
 
-	// Group moves into GameMoveHistory
+	// Group moves into GameMoveHistory - moves are sorted so each group's
+	// moves are contiguous
 	history := &v1.GameMoveHistory{GameId: id}
-	groupMap := make(map[int64]*v1.GameMoveGroup)
-
+	var group *v1.GameMoveGroup
 	for _, moveGorm := range moves {
-		move, err := v1gorm.GameMoveFromGameMoveGORM(nil, moveGorm, nil)
+		move, err := v1gorm.GameMoveFromGameMoveGORM(nil, moveGorm, gameMoveFromGORM)
 		if err != nil {
 			continue
 		}
-		groupNum := move.GroupNumber
-		if _, exists := groupMap[groupNum]; !exists {
-			groupMap[groupNum] = &v1.GameMoveGroup{
-				GroupNumber: groupNum,
+		if group == nil || group.GroupNumber != move.GroupNumber {
+			group = &v1.GameMoveGroup{
+				GroupNumber: move.GroupNumber,
 				Moves:       []*v1.GameMove{},
 			}
+			history.Groups = append(history.Groups, group)
 		}
-		groupMap[groupNum].Moves = append(groupMap[groupNum].Moves, move)
-	}
-
-	// Convert map to sorted slice
-	for _, group := range groupMap {
-		history.Groups = append(history.Groups, group)
+		group.Moves = append(group.Moves, move)
 	}
 
 	return history, nil
@@ -143,13 +154,21 @@ func (s *GamesService) SaveGameState(ctx context.Context, id string, state *v1.G
 	return s.GameStateDAL.Save(ctx, s.storage, stateGorm)
 }
 
-// SaveGameHistory implements GameStorageProvider - saves game history to database
-// For GORM backend, this saves individual moves (history is virtual, built from moves)
+// SaveGameHistory implements GameStorageProvider - replaces the game's moves
+// with the history's in one transaction.  The history is virtual, built from
+// the moves on read.
 func (s *GamesService) SaveGameHistory(ctx context.Context, id string, history *v1.GameMoveHistory) error {
-	// For GORM, we save individual moves - the history is built from moves on read
-	// This is called after SaveMoveGroup has already saved the moves, so it's a no-op
-	// unless we need to rebuild the entire history
-	return nil
+	return s.storage.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("game_id = ?", id).Delete(&v1gorm.GameMoveGORM{}).Error; err != nil {
+			return fmt.Errorf("failed to delete moves: %w", err)
+		}
+		for _, group := range history.GetGroups() {
+			if err := createMoves(tx, id, group); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 // DeleteFromStorage implements GameStorageProvider - deletes game from database
@@ -160,16 +179,63 @@ func (s *GamesService) DeleteFromStorage(ctx context.Context, id string) error {
 	return err
 }
 
-// SaveMoves implements GameStorageProvider - saves moves as individual rows with orphan cleanup
+// SaveMoves implements GameStorageProvider - saves moves as individual rows
+// with orphan cleanup in one transaction
 func (s *GamesService) SaveMoves(ctx context.Context, gameId string, group *v1.GameMoveGroup, currentGroupNumber int64) error {
+	return s.storage.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return s.saveMoves(tx, gameId, group, currentGroupNumber)
+	})
+}
+
+// SaveMovesAndState implements services.TransactionalMoveSaver - saves the
+// moves and the game state in one transaction, failing if the stored state
+// is newer than the one being saved
+func (s *GamesService) SaveMovesAndState(ctx context.Context, gameId string, group *v1.GameMoveGroup, state *v1.GameState) error {
+	oldVersion := state.Version
+	err := s.storage.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.saveMoves(tx, gameId, group, state.CurrentGroupNumber); err != nil {
+			return err
+		}
+
+		state.Version = oldVersion + 1
+		stateGorm, err := v1gorm.GameStateToGameStateGORM(state, nil, nil)
+		if err != nil {
+			return fmt.Errorf("failed to convert game state: %w", err)
+		}
+		stateGorm.GameId = gameId
+
+		// Optimistic lock: update only if version hasn't changed
+		result := tx.Model(&v1gorm.GameStateGORM{}).
+			Where("game_id = ? AND version = ?", gameId, oldVersion).
+			Select("*").
+			Updates(stateGorm)
+		if result.Error != nil {
+			return fmt.Errorf("failed to save state: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return services.ErrStaleGameState
+		}
+		return nil
+	})
+	if err != nil {
+		state.Version = oldVersion
+	}
+	return err
+}
+
+// saveMoves deletes orphan moves and saves the group's moves within tx
+func (s *GamesService) saveMoves(tx *gorm.DB, gameId string, group *v1.GameMoveGroup, currentGroupNumber int64) error {
 	// Delete any orphan moves from previous failed ProcessMoves calls
 	// (moves with group_number > current_group_number are orphans)
-	if err := s.storage.Where("game_id = ? AND group_number > ?", gameId, currentGroupNumber-1).
+	if err := tx.Where("game_id = ? AND group_number > ?", gameId, currentGroupNumber-1).
 		Delete(&v1gorm.GameMoveGORM{}).Error; err != nil {
 		return fmt.Errorf("failed to delete orphan moves: %w", err)
 	}
+	return createMoves(tx, gameId, group)
+}
 
-	// Save each move in the group as individual rows
+// createMoves saves each move in the group as an individual row
+func createMoves(tx *gorm.DB, gameId string, group *v1.GameMoveGroup) error {
 	for i, move := range group.Moves {
 		move.GroupNumber = group.GroupNumber
 		move.MoveNumber = int64(i)
@@ -177,20 +243,18 @@ func (s *GamesService) SaveMoves(ctx context.Context, gameId string, group *v1.G
 		moveGorm, err := v1gorm.GameMoveToGameMoveGORM(move, nil, func(src *v1.GameMove, dest *v1gorm.GameMoveGORM) error {
 			dest.GameId = gameId
 			// Handle oneof move_type by serializing to JSON bytes
-			if src.GetMoveType() != nil {
-				moveTypeBytes, err := protojson.Marshal(src)
-				if err != nil {
-					return fmt.Errorf("failed to serialize move_type: %w", err)
-				}
-				dest.MoveType = moveTypeBytes
+			moveType, err := services.EncodeMoveType(src)
+			if err != nil {
+				return fmt.Errorf("failed to serialize move_type: %w", err)
 			}
+			dest.MoveType = moveType
 			return nil
 		})
 		if err != nil {
 			return fmt.Errorf("failed to convert move %d: %w", i, err)
 		}
 
-		if err := s.storage.Create(moveGorm).Error; err != nil {
+		if err := tx.Create(moveGorm).Error; err != nil {
 			return fmt.Errorf("failed to save move %d: %w", i, err)
 		}
 	}
@@ -198,6 +262,14 @@ func (s *GamesService) SaveMoves(ctx context.Context, gameId string, group *v1.G
 	return nil
 }
 
+// gameMoveFromGORM restores the move_type the generated converter skips
+func gameMoveFromGORM(dest *v1.GameMove, src *v1gorm.GameMoveGORM) error {
+	if err := services.DecodeMoveType(src.MoveType, dest); err != nil {
+		return fmt.Errorf("failed to deserialize move_type: %w", err)
+	}
+	return nil
+}
+
 // GetGameStateVersion implements GameStateUpdater interface
 func (s *GamesService) GetGameStateVersion(ctx context.Context, id string) (int64, error) {
 	gameState, err := s.GameStateDAL.Get(ctx, s.storage, id)
@@ -211,57 +283,103 @@ func (s *GamesService) GetGameStateVersion(ctx context.Context, id string) (int6
 // Note: This does NOT increment version - IndexInfo is internal bookkeeping
 // that shouldn't invalidate user's optimistic lock
 func (s *GamesService) UpdateGameStateScreenshotIndexInfo(ctx context.Context, id string, oldVersion int64, lastIndexedAt time.Time, needsIndexing bool) error {
-	gameState, err := s.GameStateDAL.Get(ctx, s.storage, id)
-	if err != nil {
-		return err
+	// Update only IndexInfo fields, don't touch version.  The version check
+	// ensures we're updating the state that was screenshotted.
+	result := s.storage.WithContext(ctx).Model(&v1gorm.GameStateGORM{}).
+		Where("game_id = ? AND version = ?", id, oldVersion).
+		Updates(map[string]any{
+			"world_data_screenshot_index_last_indexed_at": lastIndexedAt,
+			"world_data_screenshot_index_needs_indexing":  needsIndexing,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update IndexInfo: %w", result.Error)
 	}
-
-	// Check version matches - if not, content was updated and we'll re-index later
-	if gameState.Version != oldVersion {
+	if result.RowsAffected == 0 {
+		// Content was updated (or the game deleted) - we'll re-index later
 		return fmt.Errorf("version mismatch - content was updated, will re-index later")
 	}
-
-	// Update only IndexInfo fields, don't touch version
-	gameState.WorldData.ScreenshotIndexInfo.LastIndexedAt = lastIndexedAt
-	gameState.WorldData.ScreenshotIndexInfo.NeedsIndexing = needsIndexing
-	// Note: NOT incrementing version - this is internal bookkeeping
-
-	// Save - use version check to ensure we're updating the right state
-	err = s.GameStateDAL.Save(ctx, s.storage.Where("game_id = ? AND version = ?", id, oldVersion), gameState)
-	if err != nil {
-		return fmt.Errorf("failed to update IndexInfo: %w", err)
-	}
 	return nil
 }
 
-// ListGames returns all available games (metadata only for performance)
+// ListGames returns a page of games (metadata only for performance).  Tag
+// and player filters live in JSON columns so are checked in Go, reading
+// further batches until the page is filled.
 func (s *GamesService) ListGames(ctx context.Context, req *v1.ListGamesRequest) (resp *v1.ListGamesResponse, err error) {
 	ctx, span := Tracer.Start(ctx, "ListGames")
 	defer span.End()
-	resp = &v1.ListGamesResponse{
-		Items: []*v1.Game{},
-		Pagination: &v1.PaginationResponse{
-			HasMore:      false,
-			TotalResults: 0,
-		},
-	}
-	games, err := s.GameDAL.List(ctx, s.storage.Order("updated_at desc").Order("name asc"))
+
+	query, err := services.NewGameListQuery(req)
 	if err != nil {
-		return
+		return nil, err
 	}
-	resp.Items = gfn.Map(games, func(g *v1gorm.GameGORM) *v1.Game {
-		out, _ := v1gorm.GameFromGameGORM(nil, g, nil)
-		if len(out.PreviewUrls) == 0 {
-			out.PreviewUrls = []string{fmt.Sprintf("/screenshots/games/%s/default.png", out.Id)}
+	if s.MaxPageSize > 0 {
+		query.PageSize = min(query.PageSize, s.MaxPageSize)
+	}
+
+	filterInGo := query.Tag != "" || query.PlayerUserId != ""
+	gameFilters := func(db *gorm.DB) *gorm.DB {
+		if query.WorldId != "" {
+			db = db.Where("world_id = ?", query.WorldId)
 		}
-		return out
-	})
-	resp.Pagination.TotalResults = int32(len(resp.Items))
+		if query.Status != v1.GameStatus_GAME_STATUS_UNSPECIFIED {
+			db = db.Where("id IN (?)", s.storage.Model(&v1gorm.GameStateGORM{}).Select("game_id").Where("status = ?", query.Status))
+		}
+		return applyListFilters(db, query)
+	}
+
+	// Offsets can only be pushed down when SQL sees every filter
+	skip, offset := query.PageOffset, 0
+	if !filterInGo {
+		skip, offset = 0, query.PageOffset
+	}
+	page := services.NewListPage[*v1.Game](query, skip)
+	batchSize := query.PageSize + 1
+	for after := query.After; !page.Full(); offset = 0 {
+		games, err := s.GameDAL.List(ctx, applyListOrder(gameFilters(s.storage), query, after).Offset(offset).Limit(batchSize))
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range games {
+			game, err := v1gorm.GameFromGameGORM(nil, g, nil)
+			if err != nil {
+				log.Println("Error converting game: ", err, g.Id)
+				continue
+			}
+			if query.MatchesGame(game, query.Status) {
+				page.Add(game)
+				if page.Full() {
+					break
+				}
+			}
+		}
+		if len(games) < batchSize {
+			break
+		}
+		last := games[len(games)-1]
+		after = query.CursorOf(last.Id, last.Name, last.CreatedAt, last.UpdatedAt)
+	}
 
+	total := 0
+	if !filterInGo {
+		var count int64
+		if err := gameFilters(s.storage.Model(&v1gorm.GameGORM{})).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		total = int(count)
+	}
+
+	resp = &v1.ListGamesResponse{Items: page.Items(), Pagination: page.Pagination(total)}
+	if resp.Items == nil {
+		resp.Items = []*v1.Game{}
+	}
+	for _, game := range resp.Items {
+		if len(game.PreviewUrls) == 0 {
+			game.PreviewUrls = []string{fmt.Sprintf("/screenshots/games/%s/default.png", game.Id)}
+		}
+	}
 	return resp, nil
 }
 
-
 // CreateGame creates a new game
 func (s *GamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest) (resp *v1.CreateGameResponse, err error) {
 	ctx, span := Tracer.Start(ctx, "CreateGames")
@@ -269,17 +387,21 @@ func (s *GamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest
 	resp = &v1.CreateGameResponse{}
 
 	// Make sure world exists - for now we must be given a worldId to create game from
-	world, err := s.ClientMgr.GetWorldsSvcClient().GetWorld(ctx, &v1.GetWorldRequest{Id: req.Game.WorldId})
+	world, err := s.ClientMgr.GetWorldsSvcClient().GetWorld(ctx, &v1.GetWorldRequest{Id: req.Game.WorldId, Version: services.WorldRevisionVersion(req.Game.WorldRevision)})
 	if err != nil {
 		return nil, fmt.Errorf("Error loading world: %w", err)
 	}
+	// Pin the game to the world revision it starts from and the rules it
+	// is played with
+	req.Game.WorldRevision = world.WorldData.GetVersion()
+	req.Game.RulesVersion = lib.DefaultRulesVersion()
 
 	// Validate the request (duplicate players, players with units/tiles, etc.)
 	if err := s.ValidateCreateGameRequest(req.Game, world.WorldData); err != nil {
 		return nil, err
 	}
 
-	now := time.Now()
+	now := time.Now().UTC()
 	req.Game.CreatedAt = tspb.New(now)
 	req.Game.UpdatedAt = tspb.New(now)
 
@@ -325,6 +447,10 @@ func (s *GamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest
 	// Initialize player runtime state with starting coins + base income
 	s.InitializePlayerStates(gs, req.Game.Config)
 
+	// The state starts with the world's screenshot info - it needs its own
+	gs.WorldData.ScreenshotIndexInfo = nil
+	services.MarkScreenshotStale(gs)
+
 	gameStateGorm, err := v1gorm.GameStateToGameStateGORM(gs, nil, nil)
 	if err != nil {
 		log.Println("Here 1 ????: ", err)
@@ -340,6 +466,9 @@ func (s *GamesService) CreateGame(ctx context.Context, req *v1.CreateGameRequest
 	// They will be lazily topped-up when accessed if unit.current_turn < game.turn_counter
 	// This eliminates the need to initialize all units at game creation
 
+	s.UpdateUserGameIndex(ctx, req.Game, gs)
+	s.QueueGameScreenshot(req.Game.Id, gs)
+
 	resp = &v1.CreateGameResponse{
 		Game:      req.Game,
 		GameState: gs,
@@ -378,7 +507,7 @@ func (s *GamesService) ListMoves(ctx context.Context, req *v1.ListMovesRequest)
 	var groupNumbers []int64
 
 	for _, moveGorm := range moves {
-		move, err := v1gorm.GameMoveFromGameMoveGORM(nil, moveGorm, nil)
+		move, err := v1gorm.GameMoveFromGameMoveGORM(nil, moveGorm, gameMoveFromGORM)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert move: %w", err)
 		}
@@ -122,7 +122,7 @@ func NewIDs(storage *gorm.DB, cls string, numids int) (out []string) {
 func VerifyID(storage *gorm.DB, cls string, id string) error {
 	var gid GenId
 	id = strings.ToLower(id)
-	err := storage.First(&gid, "cls = ? and id = ?", cls, id).Error
+	err := storage.First(&gid, "class = ? and id = ?", cls, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil
@@ -130,13 +130,13 @@ func VerifyID(storage *gorm.DB, cls string, id string) error {
 		return err
 	}
 	gid.VerifiedAt = time.Now()
-	return storage.Updates(gid).Error
+	return storage.Save(&gid).Error
 }
 
 func ReleaseID(storage *gorm.DB, cls string, id string) error {
 	var gid GenId
 	id = strings.ToLower(id)
-	err := storage.First(&gid, "cls = ? and id = ?", cls, id).Error
+	err := storage.First(&gid, "class = ? and id = ?", cls, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil
@@ -145,5 +145,5 @@ func ReleaseID(storage *gorm.DB, cls string, id string) error {
 	}
 	gid.Released = true
 	gid.VerifiedAt = time.Now()
-	return storage.Updates(gid).Error
+	return storage.Save(&gid).Error
 }
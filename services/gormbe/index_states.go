@@ -0,0 +1,95 @@
+//go:build !wasm
+// +build !wasm
+
+package gormbe
+
+import (
+	"context"
+	"errors"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1gorm "github.com/turnforge/lilbattle/gen/gorm/lilbattle/v1/gorm"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// IndexStateStore implements services.IndexStateStore in the index_states
+// table shared with IndexerService
+type IndexStateStore struct {
+	storage *gorm.DB
+}
+
+// NewIndexStateStore creates an index state store in db, creating its
+// table if needed
+func NewIndexStateStore(db *gorm.DB) *IndexStateStore {
+	db.AutoMigrate(&v1gorm.IndexStateGORM{})
+	return &IndexStateStore{storage: db}
+}
+
+func whereIndexState(db *gorm.DB, entityType, entityId, indexType string) *gorm.DB {
+	return db.Where("entity_type = ? AND entity_id = ? AND index_type = ?", entityType, entityId, indexType)
+}
+
+// LoadIndexState implements services.IndexStateStore
+func (s *IndexStateStore) LoadIndexState(ctx context.Context, entityType, entityId, indexType string) (*v1.IndexState, error) {
+	var row v1gorm.IndexStateGORM
+	err := whereIndexState(s.storage.WithContext(ctx), entityType, entityId, indexType).First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no %s index state for %s %s", indexType, entityType, entityId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v1gorm.IndexStateFromIndexStateGORM(nil, &row, nil)
+}
+
+// SaveIndexState implements services.IndexStateStore.  The table has no
+// primary key so the state is updated in place, or inserted when it is new.
+// UpdateColumns keeps GORM from stamping updated_at, which is the entity's
+// update time here.
+func (s *IndexStateStore) SaveIndexState(ctx context.Context, state *v1.IndexState) error {
+	row, err := v1gorm.IndexStateToIndexStateGORM(state, nil, nil)
+	if err != nil {
+		return err
+	}
+	return s.storage.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := whereIndexState(tx.Model(&v1gorm.IndexStateGORM{}), row.EntityType, row.EntityId, row.IndexType).
+			Select("*").UpdateColumns(row)
+		if result.Error != nil || result.RowsAffected > 0 {
+			return result.Error
+		}
+		return tx.Create(row).Error
+	})
+}
+
+// ListIndexStates implements services.IndexStateStore
+func (s *IndexStateStore) ListIndexStates(ctx context.Context, filter services.IndexStateFilter) ([]*v1.IndexState, error) {
+	query := s.storage.WithContext(ctx).Model(&v1gorm.IndexStateGORM{})
+	if filter.EntityType != "" {
+		query = query.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.IndexType != "" {
+		query = query.Where("index_type = ?", filter.IndexType)
+	}
+	if filter.Status != v1.IndexStatus_INDEX_STATUS_UNSPECIFIED {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	var rows []*v1gorm.IndexStateGORM
+	if err := query.Order("entity_type, entity_id, index_type").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	states := make([]*v1.IndexState, 0, len(rows))
+	for _, row := range rows {
+		state, err := v1gorm.IndexStateFromIndexStateGORM(nil, row, nil)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
@@ -50,7 +50,7 @@ func (i *IndexerService) GetIndexStates(ctx context.Context, req *v1.GetIndexSta
 	var out []*v1gorm.IndexStateGORM
 	query := i.storage.Where("entity_type = ?", req.EntityType).Where("entity_id = ?", req.EntityId)
 	err = query.Find(&out).Error
-	if err != nil {
+	if err == nil {
 		resp.States = map[string]*v1.IndexState{}
 		for _, input := range out {
 			output, err := v1gorm.IndexStateFromIndexStateGORM(nil, input, nil)
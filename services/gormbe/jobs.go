@@ -0,0 +1,189 @@
+//go:build !wasm
+// +build !wasm
+
+package gormbe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// JobRow is a row of the jobs table.  The job itself is kept as protojson
+// with the fields the queue filters on copied into indexed columns.
+type JobRow struct {
+	Id       string    `gorm:"primaryKey"`
+	JobType  string    `gorm:"index"`
+	Status   int32     `gorm:"index:idx_jobs_status_run_after"`
+	RunAfter time.Time `gorm:"index:idx_jobs_status_run_after"`
+	Version  int64
+	Data     []byte
+}
+
+// TableName returns the table name for JobRow
+func (JobRow) TableName() string {
+	return "jobs"
+}
+
+// JobRunRow is a row of the job_runs table
+type JobRunRow struct {
+	JobId     string `gorm:"primaryKey"`
+	RunId     string `gorm:"primaryKey"`
+	CreatedAt time.Time
+	Data      []byte
+}
+
+// TableName returns the table name for JobRunRow
+func (JobRunRow) TableName() string {
+	return "job_runs"
+}
+
+// JobStore implements services.JobStore in a GORM database
+type JobStore struct {
+	storage *gorm.DB
+}
+
+// NewJobStore creates a job store in db, creating its tables if needed
+func NewJobStore(db *gorm.DB) *JobStore {
+	db.AutoMigrate(&JobRow{})
+	db.AutoMigrate(&JobRunRow{})
+	return &JobStore{storage: db}
+}
+
+func decodeJob(data []byte) (*v1.Job, error) {
+	job := &v1.Job{}
+	if err := protojson.Unmarshal(data, job); err != nil {
+		return nil, fmt.Errorf("failed to decode job: %w", err)
+	}
+	return job, nil
+}
+
+// LoadJob implements services.JobStore
+func (s *JobStore) LoadJob(ctx context.Context, id string) (*v1.Job, error) {
+	var row JobRow
+	err := s.storage.WithContext(ctx).Where("id = ?", id).First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeJob(row.Data)
+}
+
+// SaveJob implements services.JobStore
+func (s *JobStore) SaveJob(ctx context.Context, job *v1.Job, oldVersion int64) error {
+	saved := proto.Clone(job).(*v1.Job)
+	saved.Version = oldVersion + 1
+	data, err := protojson.Marshal(saved)
+	if err != nil {
+		return err
+	}
+	row := &JobRow{
+		Id:       saved.Id,
+		JobType:  saved.JobType,
+		Status:   int32(saved.Status),
+		RunAfter: saved.RunAfter.AsTime().UTC(),
+		Version:  saved.Version,
+		Data:     data,
+	}
+
+	var result *gorm.DB
+	if oldVersion == 0 {
+		result = s.storage.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(row)
+	} else {
+		result = s.storage.WithContext(ctx).Model(&JobRow{}).
+			Where("id = ? AND version = ?", row.Id, oldVersion).
+			Updates(map[string]any{
+				"job_type":  row.JobType,
+				"status":    row.Status,
+				"run_after": row.RunAfter,
+				"version":   row.Version,
+				"data":      row.Data,
+			})
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return services.ErrJobConflict
+	}
+	job.Version = saved.Version
+	return nil
+}
+
+// ListJobs implements services.JobStore
+func (s *JobStore) ListJobs(ctx context.Context, filter services.JobFilter) ([]*v1.Job, error) {
+	query := s.storage.WithContext(ctx).Model(&JobRow{})
+	if filter.JobType != "" {
+		query = query.Where("job_type = ?", filter.JobType)
+	}
+	if filter.Status != v1.JobStatus_JOB_STATUS_UNSPECIFIED {
+		query = query.Where("status = ?", int32(filter.Status))
+	}
+	if !filter.DueBy.IsZero() {
+		query = query.Where("run_after <= ?", filter.DueBy.UTC())
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	var rows []*JobRow
+	if err := query.Order("run_after, id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	jobs := make([]*v1.Job, 0, len(rows))
+	for _, row := range rows {
+		job, err := decodeJob(row.Data)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// SaveRun implements services.JobStore
+func (s *JobStore) SaveRun(ctx context.Context, run *v1.Run) error {
+	data, err := protojson.Marshal(run)
+	if err != nil {
+		return err
+	}
+	row := &JobRunRow{
+		JobId:     run.JobId,
+		RunId:     run.RunId,
+		CreatedAt: run.CreatedAt.AsTime().UTC(),
+		Data:      data,
+	}
+	return s.storage.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(row).Error
+}
+
+// ListRuns implements services.JobStore
+func (s *JobStore) ListRuns(ctx context.Context, jobId string, limit int) ([]*v1.Run, error) {
+	query := s.storage.WithContext(ctx).Where("job_id = ?", jobId).Order("created_at desc, run_id desc")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var rows []*JobRunRow
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	runs := make([]*v1.Run, 0, len(rows))
+	for _, row := range rows {
+		run := &v1.Run{}
+		if err := protojson.Unmarshal(row.Data, run); err != nil {
+			return nil, fmt.Errorf("failed to decode run %s of job %s: %w", row.RunId, jobId, err)
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
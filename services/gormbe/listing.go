@@ -0,0 +1,58 @@
+//go:build !wasm
+// +build !wasm
+
+package gormbe
+
+import (
+	"fmt"
+
+	"github.com/turnforge/lilbattle/services"
+	"gorm.io/gorm"
+)
+
+// applyListFilters adds the filters of a list query SQL can handle to db -
+// the owner and the created/updated time ranges
+func applyListFilters(db *gorm.DB, q *services.ListQuery) *gorm.DB {
+	if q.OwnerId != "" {
+		db = db.Where("creator_id = ?", q.OwnerId)
+	}
+	if q.ParentWorldId != "" {
+		db = db.Where("parent_world_id = ?", q.ParentWorldId)
+	}
+	if r := q.Created; r != nil {
+		if r.Start != nil {
+			db = db.Where("created_at >= ?", r.Start.AsTime())
+		}
+		if r.End != nil {
+			db = db.Where("created_at < ?", r.End.AsTime())
+		}
+	}
+	if r := q.Updated; r != nil {
+		if r.Start != nil {
+			db = db.Where("updated_at >= ?", r.Start.AsTime())
+		}
+		if r.End != nil {
+			db = db.Where("updated_at < ?", r.End.AsTime())
+		}
+	}
+	return db
+}
+
+// applyListOrder adds the sort order (ties broken by ascending id, see
+// ListQuery) and the keyset condition resuming after the given cursor
+func applyListOrder(db *gorm.DB, q *services.ListQuery, after *services.ListCursor) *gorm.DB {
+	// SortBy is validated by NewGameListQuery/NewWorldListQuery so is safe
+	// to use as a column name
+	column, dir, op := q.SortBy, "asc", ">"
+	if q.Descending {
+		dir, op = "desc", "<"
+	}
+	if after != nil {
+		var value any = after.CursorTime()
+		if q.SortBy == services.SortByName {
+			value = after.Name
+		}
+		db = db.Where(fmt.Sprintf("((%s %s ?) OR (%s = ? AND id > ?))", column, op, column), value, value, after.Id)
+	}
+	return db.Order(column + " " + dir).Order("id asc")
+}
@@ -0,0 +1,54 @@
+//go:build !wasm
+// +build !wasm
+
+package gormbe
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RulesRow is a row of the rules table - the rules and damage data of a
+// rules version kept for imported games
+type RulesRow struct {
+	Version    string `gorm:"primaryKey"`
+	CreatedAt  time.Time
+	RulesJson  []byte
+	DamageJson []byte
+}
+
+// TableName returns the table name for RulesRow
+func (RulesRow) TableName() string {
+	return "rules"
+}
+
+// SaveRules implements services.RulesStore
+func (s *GamesService) SaveRules(ctx context.Context, version string, rulesJSON, damageJSON []byte) error {
+	row := &RulesRow{
+		Version:    version,
+		CreatedAt:  time.Now().UTC(),
+		RulesJson:  rulesJSON,
+		DamageJson: damageJSON,
+	}
+	// Versions are content hashes - an existing one is kept
+	return s.storage.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(row).Error
+}
+
+// LoadRules implements services.RulesStore
+func (s *GamesService) LoadRules(ctx context.Context, version string) (rulesJSON, damageJSON []byte, err error) {
+	var row RulesRow
+	err = s.storage.WithContext(ctx).Where("version = ?", version).First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, status.Errorf(codes.NotFound, "rules version %s not found", version)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return row.RulesJson, row.DamageJson, nil
+}
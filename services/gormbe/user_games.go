@@ -0,0 +1,50 @@
+//go:build !wasm
+// +build !wasm
+
+package gormbe
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1gorm "github.com/turnforge/lilbattle/gen/gorm/lilbattle/v1/gorm"
+	"gorm.io/gorm"
+)
+
+// SaveUserGames implements services.UserGameIndex - replaces the game's rows
+// in the user_games table in one transaction
+func (s *GamesService) SaveUserGames(ctx context.Context, gameId string, entries []*v1.UserGame) error {
+	return s.storage.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("game_id = ?", gameId).Delete(&v1gorm.UserGameGORM{}).Error; err != nil {
+			return fmt.Errorf("failed to delete user games of game %s: %w", gameId, err)
+		}
+		for _, entry := range entries {
+			row, err := v1gorm.UserGameToUserGameGORM(entry, nil, nil)
+			if err != nil {
+				return fmt.Errorf("failed to convert user game: %w", err)
+			}
+			if err := s.UserGameDAL.Create(ctx, tx, row); err != nil {
+				return fmt.Errorf("failed to save user game %s/%s: %w", entry.UserId, gameId, err)
+			}
+		}
+		return nil
+	})
+}
+
+// ListUserGames implements services.UserGameIndex
+func (s *GamesService) ListUserGames(ctx context.Context, userId string) ([]*v1.UserGame, error) {
+	rows, err := s.UserGameDAL.List(ctx, s.storage.WithContext(ctx).Where("user_id = ?", userId))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*v1.UserGame, 0, len(rows))
+	for _, row := range rows {
+		entry, err := v1gorm.UserGameFromUserGameGORM(nil, row, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert user game: %w", err)
+		}
+		out = append(out, entry)
+	}
+	return out, nil
+}
@@ -0,0 +1,116 @@
+//go:build !wasm
+// +build !wasm
+
+package gormbe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WorldRevisionRow is a row of the world_revisions table.  The revision's
+// world data is kept as protojson since it is only ever loaded whole.
+type WorldRevisionRow struct {
+	WorldId      string `gorm:"primaryKey"`
+	Revision     int64  `gorm:"primaryKey"`
+	CreatedAt    time.Time
+	AuthorId     string
+	RevertedFrom int64
+	ContentHash  string
+	TileCount    int32
+	UnitCount    int32
+	Data         []byte
+}
+
+// TableName returns the table name for WorldRevisionRow
+func (WorldRevisionRow) TableName() string {
+	return "world_revisions"
+}
+
+func (row *WorldRevisionRow) toProto(withData bool) (*v1.WorldRevision, error) {
+	revision := &v1.WorldRevision{
+		WorldId:      row.WorldId,
+		Revision:     row.Revision,
+		CreatedAt:    tspb.New(row.CreatedAt),
+		AuthorId:     row.AuthorId,
+		RevertedFrom: row.RevertedFrom,
+		ContentHash:  row.ContentHash,
+		TileCount:    row.TileCount,
+		UnitCount:    row.UnitCount,
+	}
+	if withData {
+		revision.WorldData = &v1.WorldData{}
+		if err := protojson.Unmarshal(row.Data, revision.WorldData); err != nil {
+			return nil, fmt.Errorf("failed to decode revision %d of world %s: %w", row.Revision, row.WorldId, err)
+		}
+	}
+	return revision, nil
+}
+
+// SaveRevision implements services.WorldRevisionStore
+func (s *WorldsService) SaveRevision(ctx context.Context, revision *v1.WorldRevision) error {
+	data, err := protojson.Marshal(revision.WorldData)
+	if err != nil {
+		return err
+	}
+	row := &WorldRevisionRow{
+		WorldId:      revision.WorldId,
+		Revision:     revision.Revision,
+		CreatedAt:    revision.CreatedAt.AsTime().UTC(),
+		AuthorId:     revision.AuthorId,
+		RevertedFrom: revision.RevertedFrom,
+		ContentHash:  revision.ContentHash,
+		TileCount:    revision.TileCount,
+		UnitCount:    revision.UnitCount,
+		Data:         data,
+	}
+	// Revisions are immutable - an existing one is kept
+	return s.storage.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(row).Error
+}
+
+// LoadRevision implements services.WorldRevisionStore
+func (s *WorldsService) LoadRevision(ctx context.Context, worldId string, revision int64) (*v1.WorldRevision, error) {
+	var row WorldRevisionRow
+	err := s.storage.WithContext(ctx).Where("world_id = ? AND revision = ?", worldId, revision).First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "world %s has no revision %d", worldId, revision)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return row.toProto(true)
+}
+
+// ListRevisions implements services.WorldRevisionStore
+func (s *WorldsService) ListRevisions(ctx context.Context, worldId string) ([]*v1.WorldRevision, error) {
+	var rows []*WorldRevisionRow
+	err := s.storage.WithContext(ctx).Omit("data").
+		Where("world_id = ?", worldId).Order("revision desc").Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]*v1.WorldRevision, 0, len(rows))
+	for _, row := range rows {
+		revision, err := row.toProto(false)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// DeleteRevisions implements services.WorldRevisionStore
+func (s *WorldsService) DeleteRevisions(ctx context.Context, worldId string) error {
+	return s.storage.WithContext(ctx).Where("world_id = ?", worldId).Delete(&WorldRevisionRow{}).Error
+}
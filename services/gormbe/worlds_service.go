@@ -34,6 +34,8 @@ func NewWorldsService(db *gorm.DB, clientMgr *services.ClientMgr) *WorldsService
 	// db.AutoMigrate(&v1gorm.IndexRecordsLROGORM{})
 	db.AutoMigrate(&v1gorm.WorldGORM{})
 	db.AutoMigrate(&v1gorm.WorldDataGORM{})
+	db.AutoMigrate(&GenId{}) // CreateWorld reserves IDs through it
+	db.AutoMigrate(&WorldRevisionRow{})
 
 	service := &WorldsService{
 		storage:     db,
@@ -41,12 +43,13 @@ func NewWorldsService(db *gorm.DB, clientMgr *services.ClientMgr) *WorldsService
 	}
 	service.ClientMgr = clientMgr
 	service.WorldDAL.WillCreate = func(ctx context.Context, world *v1gorm.WorldGORM) error {
-		world.UpdatedAt = time.Now()
-		world.CreatedAt = time.Now()
+		world.UpdatedAt = time.Now().UTC()
+		world.CreatedAt = time.Now().UTC()
 		return nil
 	}
 	service.Self = service
 	service.WorldDataUpdater = service // Implement WorldDataUpdater interface
+	service.RevisionStore = service
 	service.InitializeScreenshotIndexer()
 
 	return service
@@ -95,6 +98,9 @@ func (s *WorldsService) CreateWorld(ctx context.Context, req *v1.CreateWorldRequ
 		return nil, fmt.Errorf("world data is required")
 	}
 	req.World.Id = services.NormalizeWorldID(req.World.Id)
+	if err = s.PrepareWorldCopy(ctx, req); err != nil {
+		return nil, err
+	}
 
 	worldGorm, err := v1gorm.WorldToWorldGORM(req.World, nil, nil)
 	if err != nil {
@@ -131,49 +137,88 @@ func (s *WorldsService) CreateWorld(ctx context.Context, req *v1.CreateWorldRequ
 		worldDataGorm = &v1gorm.WorldDataGORM{}
 	}
 	worldDataGorm.WorldId = worldGorm.Id
+	// Starts at version 1 so revision 0 can mean "no revision"
+	worldDataGorm.Version = max(worldDataGorm.Version, 1)
 	if err = s.WorldDataDAL.Save(ctx, s.storage, worldDataGorm); err != nil {
 		return
 	}
 	resp.WorldData, err = v1gorm.WorldDataFromWorldDataGORM(nil, worldDataGorm, nil)
 	if err == nil {
 		go VerifyID(s.storage, "worlds", worldGorm.Id)
+		s.RecordWorldRevision(ctx, worldGorm.Id, nil, resp.WorldData)
 	}
 	return
 }
 
-// ListWorlds returns all available worlds (metadata only for performance)
+// ListWorlds returns a page of worlds (metadata only for performance).  Tags
+// live in a JSON column so a tag filter is checked in Go, reading further
+// batches until the page is filled.
 func (s *WorldsService) ListWorlds(ctx context.Context, req *v1.ListWorldsRequest) (resp *v1.ListWorldsResponse, err error) {
 	ctx, span := Tracer.Start(ctx, "ListWorlds")
 	defer span.End()
 
 	// Step 0: Preamble + Auth + Validate request
-
-	resp = &v1.ListWorldsResponse{
-		Pagination: &v1.PaginationResponse{
-			HasMore:      false,
-			TotalResults: 0,
-		},
-	}
-	gormWorlds, err := s.WorldDAL.List(ctx, s.storage.Order("name asc"))
+	query, err := services.NewWorldListQuery(req)
 	if err != nil {
-		return
+		return nil, err
+	}
+	if s.MaxPageSize > 0 {
+		query.PageSize = min(query.PageSize, s.MaxPageSize)
 	}
 
-	// Step 3: Convert query results to proto results
-	for _, input := range gormWorlds {
-		output, err := v1gorm.WorldFromWorldGORM(nil, input, nil)
-		if err == nil {
-			// Populate screenshot URLs for all worlds
-			if len(output.PreviewUrls) == 0 {
-				output.PreviewUrls = []string{fmt.Sprintf("/screenshots/worlds/%s/default.png", output.Id)}
+	// Offsets can only be pushed down when SQL sees every filter
+	filterInGo := query.Tag != ""
+	skip, offset := query.PageOffset, 0
+	if !filterInGo {
+		skip, offset = 0, query.PageOffset
+	}
+
+	// Step 1: Query batches until the page is full
+	page := services.NewListPage[*v1.World](query, skip)
+	batchSize := query.PageSize + 1
+	for after := query.After; !page.Full(); offset = 0 {
+		gormWorlds, err := s.WorldDAL.List(ctx, applyListOrder(applyListFilters(s.storage, query), query, after).Offset(offset).Limit(batchSize))
+		if err != nil {
+			return nil, err
+		}
+
+		// Step 2: Convert query results to proto results
+		for _, input := range gormWorlds {
+			output, err := v1gorm.WorldFromWorldGORM(nil, input, nil)
+			if err != nil {
+				log.Println("Error converting world: ", err, input)
+				continue
+			}
+			if query.MatchesWorld(output) {
+				page.Add(output)
+				if page.Full() {
+					break
+				}
 			}
-			resp.Items = append(resp.Items, output)
-		} else {
-			log.Println("Error converting world: ", err, input)
 		}
+		if len(gormWorlds) < batchSize {
+			break
+		}
+		last := gormWorlds[len(gormWorlds)-1]
+		after = query.CursorOf(last.Id, last.Name, last.CreatedAt, last.UpdatedAt)
+	}
+
+	total := 0
+	if !filterInGo {
+		var count int64
+		if err := applyListFilters(s.storage.Model(&v1gorm.WorldGORM{}), query).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		total = int(count)
 	}
-	resp.Pagination.TotalResults = int32(len(resp.Items))
 
+	resp = &v1.ListWorldsResponse{Items: page.Items(), Pagination: page.Pagination(total)}
+	for _, world := range resp.Items {
+		// Populate screenshot URLs for all worlds
+		if len(world.PreviewUrls) == 0 {
+			world.PreviewUrls = []string{fmt.Sprintf("/screenshots/worlds/%s/default.png", world.Id)}
+		}
+	}
 	return resp, nil
 }
 
@@ -217,6 +262,7 @@ func (s *WorldsService) GetWorld(ctx context.Context, req *v1.GetWorldRequest) (
 	// This does not persist the migration - subsequent writes will save the new format
 	lib.MigrateWorldData(resp.WorldData)
 
+	err = s.ResolveWorldRevision(ctx, req.Version, resp)
 	return
 }
 
@@ -236,6 +282,10 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 	if err != nil {
 		return
 	}
+	previous, err := v1gorm.WorldDataFromWorldDataGORM(nil, worldData, nil)
+	if err != nil {
+		return
+	}
 
 	// Update metadata fields
 	if req.World.Name != "" {
@@ -253,7 +303,10 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 	if req.World.CreatorId != "" {
 		world.CreatorId = req.World.CreatorId
 	}
-	world.UpdatedAt = time.Now()
+	if req.World.ParentRevision > 0 {
+		world.ParentRevision = req.World.ParentRevision
+	}
+	world.UpdatedAt = time.Now().UTC()
 
 	// Update world data if provided
 	worldDataSaved := false
@@ -305,7 +358,7 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 
 	if err == nil && worldDataSaved {
 		oldVersion := worldData.Version
-		worldData.ScreenshotIndexInfo.LastUpdatedAt = time.Now()
+		worldData.ScreenshotIndexInfo.LastUpdatedAt = time.Now().UTC()
 		worldData.ScreenshotIndexInfo.NeedsIndexing = true
 		worldData.Version = worldData.Version + 1
 
@@ -324,6 +377,8 @@ func (s *WorldsService) UpdateWorld(ctx context.Context, req *v1.UpdateWorldRequ
 		resp.World, err = v1gorm.WorldFromWorldGORM(nil, world, nil)
 		resp.WorldData, err = v1gorm.WorldDataFromWorldDataGORM(nil, worldData, nil)
 
+		s.RecordWorldRevision(ctx, worldData.WorldId, previous, resp.WorldData)
+
 		// Queue it for being screenshotted
 		s.ScreenShotIndexer.Send("worlds", worldData.WorldId, worldData.Version, resp.WorldData)
 	}
@@ -336,6 +391,10 @@ func (s *WorldsService) DeleteWorld(ctx context.Context, req *v1.DeleteWorldRequ
 	req.Id = services.NormalizeWorldID(req.Id)
 	err = s.WorldDAL.Delete(ctx, s.storage, req.Id)
 	err = errors.Join(err, s.WorldDataDAL.Delete(ctx, s.storage, req.Id))
+	err = errors.Join(err, s.DeleteRevisions(ctx, req.Id))
+	if err == nil {
+		s.RemoveFromSearchIndex(ctx, req.Id)
+	}
 	resp = &v1.DeleteWorldResponse{}
 	return resp, err
 }
@@ -1,12 +1,22 @@
+//go:build !wasm
+// +build !wasm
+
 package services
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type IndexerService interface {
@@ -16,7 +26,6 @@ type IndexerService interface {
 type BaseIndexerService struct {
 	Self IndexerService // The actual implementation
 	v1s.UnimplementedIndexerServiceServer
-	indexStateChan chan *v1.IndexState
 }
 
 /*
@@ -41,13 +50,68 @@ func (b *BaseIndexerService) UpdateIndexRecordsLRO(context.Context, *v1.UpdateIn
 }
 */
 
-// Starts the bootstrapping loop where we identify which records need indexing and post it to the indexer for eventual
-// indexing.  This will only run once.  After this is run on startup, new creations/updates will come to the indexer
-// directly.  Ideally this should be limited in how many records it "finds".   So as to not DDOS our indexer on
-// startup.  Only elements that are created or updated but not indexed since the last indexing should be considered
-func (b *BaseIndexerService) Bootstrap() {
-	// Find all games
-	// for game := findGamesToIndex() { s.EnsureIndexState }
+// ScreenshotIndexType is the index type of world and game screenshots
+const ScreenshotIndexType = "screenshots"
+
+// IndexStateFilter selects the states ListIndexStates returns.  Zero
+// fields match everything.
+type IndexStateFilter struct {
+	EntityType string
+	IndexType  string
+	Status     v1.IndexStatus
+	Limit      int
+}
+
+// IndexStateStore keeps the IndexState of every entity and index type
+type IndexStateStore interface {
+	// LoadIndexState returns a NotFound error for an entity that has never
+	// been queued for indexing
+	LoadIndexState(ctx context.Context, entityType, entityId, indexType string) (*v1.IndexState, error)
+
+	// SaveIndexState creates or replaces the state of an entity and index type
+	SaveIndexState(ctx context.Context, state *v1.IndexState) error
+
+	// ListIndexStates returns the matching states ordered by entity ID
+	ListIndexStates(ctx context.Context, filter IndexStateFilter) ([]*v1.IndexState, error)
+}
+
+// IndexEntity is an entity of an IndexSource and when it last changed
+type IndexEntity struct {
+	Id        string
+	UpdatedAt time.Time
+}
+
+// IndexSource is a source of truth whose index the IndexReconciler keeps up
+// to date.  BackendWorldsService and BackendGamesService are the sources of
+// screenshots and WorldSearchIndexer is the source of the world search
+// index.
+type IndexSource interface {
+	// IndexEntityType is the kind of entity - "worlds" or "games"
+	IndexEntityType() string
+
+	// IndexType is the index kept up to date - eg ScreenshotIndexType
+	IndexType() string
+
+	// ListIndexEntities returns the entities updated since a time, or
+	// every entity when since is zero
+	ListIndexEntities(ctx context.Context, since time.Time) ([]IndexEntity, error)
+
+	// LoadIndexItem loads the version and world of an entity to index, and
+	// whether it already is
+	LoadIndexItem(ctx context.Context, id string) (version int64, worldData *v1.WorldData, info *v1.IndexInfo, err error)
+
+	// IndexQueue indexes the entities.  It is nil when the index is off.
+	IndexQueue() IndexQueue
+}
+
+// IndexQueue is where entities are sent to be indexed - a ScreenShotIndexer
+// or WorldSearchIndexer
+type IndexQueue interface {
+	Send(kind string, id string, version int64, worldData *v1.WorldData)
+
+	// OnIndexed adds fn to what is called with each batch of items once
+	// they have been indexed
+	OnIndexed(fn func(items []ScreenShotItem))
 }
 
 // The indexer loop is key - it is the liason between the bookkeeper and the workers.
@@ -122,19 +186,298 @@ func (b *BaseIndexerService) Bootstrap() {
 //     have an individual one for each field (and only update that when a particular type of work changes)
 //
 // Then by having a partial index on this field we can find items needing indexing (even for specific types)
-func (b *BaseIndexerService) StartIndexer() {
-	pickedInWindow := map[string]*v1.IndexState{}   // collects all updated items and stores entries that have updated in this window
-	windowTimer := time.NewTimer(10 * time.Second)  // does the actual actioning the index update
-	checkerTimer := time.NewTimer(10 * time.Second) // we also see which items have "changed" but missed the indexing through some loss
-	for {
-		select {
-		case <-windowTimer.C:
-			log.Println("Time to update index on batch")
-		case newItem := <-b.indexStateChan:
-			itemkey := fmt.Sprintf("%s:%s:%s", newItem.EntityType, newItem.EntityId, newItem.IndexType)
-			pickedInWindow[itemkey] = newItem
-		case <-checkerTimer.C:
-			// log.Println("Time to proactively find items that need to be re-indexed")
+//
+// The IndexReconciler takes both flows.  Its first pass after a (re)start
+// bootstraps by scanning every entity at its source of truth, cross
+// referenced with the index states.  Later passes only list entities updated
+// since the previous pass, plus the states that failed or were lost while
+// queued.  Whether an entity needs indexing is decided from the IndexInfo
+// its source loads (eg ScreenshotIndexInfo) so a change that did not touch
+// the map, or a screenshot taken before index states were kept, is recorded
+// without rendering it again.
+type IndexReconciler struct {
+	States  IndexStateStore
+	Sources []IndexSource
+
+	// At most this many entities are queued per pass so a restart after an
+	// outage does not flood the renderer.  A pass that hits the cap is
+	// carried on by the next one.
+	MaxPerPass int
+
+	// Entities whose screenshots failed are retried this many times,
+	// RetryBackoff apart, until they are next updated
+	MaxRetries   int
+	RetryBackoff time.Duration
+
+	// Entities queued for longer than this (eg lost in a restart) are
+	// queued again
+	StaleAfter time.Duration
+
+	// How often Start reconciles
+	Interval time.Duration
+
+	// Clock - overridable for tests
+	Now func() time.Time
+
+	mu   sync.Mutex
+	stop chan bool
+
+	// When the last pass that saw every entity updated before it started
+	// began.  Zero until a bootstrap pass has finished.
+	scannedAt time.Time
+}
+
+// reconcileOverlap is how far back a pass looks before the previous one
+// began, to allow for clock skew between servers
+const reconcileOverlap = time.Minute
+
+// NewIndexReconciler creates a reconciler of the sources that runs every
+// minute, and records how each of their entities was indexed in states
+func NewIndexReconciler(states IndexStateStore, sources ...IndexSource) *IndexReconciler {
+	r := &IndexReconciler{
+		States:       states,
+		Sources:      sources,
+		MaxPerPass:   20,
+		MaxRetries:   3,
+		RetryBackoff: 5 * time.Minute,
+		StaleAfter:   15 * time.Minute,
+		Interval:     time.Minute,
+		Now:          time.Now,
+	}
+	for _, source := range sources {
+		if queue := source.IndexQueue(); queue != nil {
+			indexType := source.IndexType()
+			queue.OnIndexed(func(items []ScreenShotItem) {
+				r.RecordIndexed(context.Background(), indexType, items)
+			})
+		}
+	}
+	return r
+}
+
+// Start bootstraps and then launches the background loop reconciling every
+// Interval
+func (r *IndexReconciler) Start() {
+	r.mu.Lock()
+	if r.stop != nil {
+		r.mu.Unlock()
+		return
+	}
+	r.stop = make(chan bool)
+	stop := r.stop
+	r.mu.Unlock()
+
+	go func() {
+		if _, err := r.Bootstrap(context.Background()); err != nil {
+			log.Printf("Index bootstrap failed: %v", err)
+		}
+		ticker := time.NewTicker(r.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if _, err := r.Reconcile(context.Background()); err != nil {
+					log.Printf("Index reconciliation failed: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop halts the background loop
+func (r *IndexReconciler) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stop != nil {
+		close(r.stop)
+		r.stop = nil
+	}
+}
+
+// Bootstrap scans every entity of every source, however recently the
+// previous pass ran, and returns how many were queued
+func (r *IndexReconciler) Bootstrap(ctx context.Context) (int, error) {
+	r.mu.Lock()
+	r.scannedAt = time.Time{}
+	r.mu.Unlock()
+	return r.Reconcile(ctx)
+}
+
+// Reconcile queues the entities that need indexing, up to MaxPerPass, and
+// returns how many were queued.  An entity that cannot be checked is
+// logged and skipped; an error is only returned when a source or the
+// states could not be listed.
+func (r *IndexReconciler) Reconcile(ctx context.Context) (int, error) {
+	now := r.Now()
+	r.mu.Lock()
+	since := r.scannedAt
+	r.mu.Unlock()
+	if !since.IsZero() {
+		since = since.Add(-reconcileOverlap)
+	}
+
+	queued := 0
+	complete := true
+	for _, source := range r.Sources {
+		if source.IndexQueue() == nil {
+			continue
+		}
+		kind, indexType := source.IndexEntityType(), source.IndexType()
+		entities, err := source.ListIndexEntities(ctx, since)
+		if err != nil {
+			return queued, fmt.Errorf("failed to list %s: %w", kind, err)
+		}
+
+		// Entities that failed or were lost once queued are retried whether
+		// or not they changed
+		for _, indexStatus := range []v1.IndexStatus{v1.IndexStatus_INDEX_STATUS_FAILED, v1.IndexStatus_INDEX_STATUS_PENDING} {
+			states, err := r.States.ListIndexStates(ctx, IndexStateFilter{EntityType: kind, IndexType: indexType, Status: indexStatus})
+			if err != nil {
+				return queued, fmt.Errorf("failed to list %s index states: %w", kind, err)
+			}
+			for _, state := range states {
+				entities = append(entities, IndexEntity{Id: state.EntityId, UpdatedAt: state.UpdatedAt.AsTime()})
+			}
+		}
+
+		seen := map[string]bool{}
+		for _, entity := range entities {
+			if seen[entity.Id] {
+				continue
+			}
+			seen[entity.Id] = true
+			if queued >= r.MaxPerPass {
+				complete = false
+				break
+			}
+			ok, err := r.reconcile(ctx, source, entity, now)
+			if err != nil {
+				log.Printf("Failed to reconcile %s index of %s %s: %v", indexType, kind, entity.Id, err)
+				continue
+			}
+			if ok {
+				queued++
+			}
+		}
+	}
+
+	if complete {
+		r.mu.Lock()
+		r.scannedAt = now
+		r.mu.Unlock()
+	}
+	if queued > 0 {
+		log.Printf("Index reconciler queued %d entities for indexing", queued)
+	}
+	return queued, nil
+}
+
+// reconcile queues an entity if it needs indexing and reports whether it did
+func (r *IndexReconciler) reconcile(ctx context.Context, source IndexSource, entity IndexEntity, now time.Time) (bool, error) {
+	kind, indexType := source.IndexEntityType(), source.IndexType()
+	state, err := r.States.LoadIndexState(ctx, kind, entity.Id, indexType)
+	if status.Code(err) == codes.NotFound {
+		state = nil
+	} else if err != nil {
+		return false, err
+	}
+
+	updated := state == nil || entity.UpdatedAt.After(state.UpdatedAt.AsTime())
+	if !updated {
+		switch state.Status {
+		case v1.IndexStatus_INDEX_STATUS_PENDING, v1.IndexStatus_INDEX_STATUS_INDEXING:
+			if now.Sub(state.CreatedAt.AsTime()) < r.StaleAfter {
+				return false, nil
+			}
+		case v1.IndexStatus_INDEX_STATUS_FAILED:
+			if int(state.RetryCount) >= r.MaxRetries || now.Before(state.IndexedAt.AsTime().Add(r.RetryBackoff)) {
+				return false, nil
+			}
+		default:
+			return false, nil
+		}
+	}
+
+	version, worldData, info, err := source.LoadIndexItem(ctx, entity.Id)
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if worldData == nil {
+		return false, nil
+	}
+
+	if state == nil {
+		state = &v1.IndexState{EntityType: kind, EntityId: entity.Id, IndexType: indexType}
+	}
+	if updated {
+		state.UpdatedAt = tspb.New(entity.UpdatedAt)
+		state.RetryCount = 0
+
+		// Entities already indexed, eg screenshots already taken, are
+		// recorded rather than indexed again
+		indexed := info != nil && !info.NeedsIndexing && info.LastIndexedAt != nil &&
+			!info.LastIndexedAt.AsTime().Before(info.LastUpdatedAt.AsTime())
+		if indexed && state.Status != v1.IndexStatus_INDEX_STATUS_FAILED {
+			state.Status = v1.IndexStatus_INDEX_STATUS_COMPLETED
+			state.NeedsIndexing = false
+			state.IndexedAt = info.LastIndexedAt
+			return false, r.States.SaveIndexState(ctx, state)
+		}
+	}
+
+	state.Status = v1.IndexStatus_INDEX_STATUS_PENDING
+	state.NeedsIndexing = true
+	state.CreatedAt = tspb.New(now)
+	if err := r.States.SaveIndexState(ctx, state); err != nil {
+		return false, err
+	}
+	source.IndexQueue().Send(kind, entity.Id, version, worldData)
+	return true, nil
+}
+
+// RecordIndexed records how the indexing of items went.  It is called back
+// by every source's IndexQueue so screenshots queued by updates are
+// recorded as well as those the reconciler queued.
+func (r *IndexReconciler) RecordIndexed(ctx context.Context, indexType string, items []ScreenShotItem) {
+	now := r.Now()
+	for _, item := range items {
+		state, err := r.States.LoadIndexState(ctx, item.Kind, item.Id, indexType)
+		if status.Code(err) == codes.NotFound {
+			state = &v1.IndexState{EntityType: item.Kind, EntityId: item.Id, IndexType: indexType, CreatedAt: tspb.New(now)}
+		} else if err != nil {
+			log.Printf("Failed to load %s index state of %s %s: %v", indexType, item.Kind, item.Id, err)
+			continue
+		}
+		updatedAt := item.UpdatedAt
+		if updatedAt == nil {
+			updatedAt = item.WorldData.GetScreenshotIndexInfo().GetLastUpdatedAt()
+		}
+		if updatedAt != nil && updatedAt.AsTime().After(state.UpdatedAt.AsTime()) {
+			state.UpdatedAt = updatedAt
+		}
+		state.IndexedAt = tspb.New(now)
+		if len(item.ThemeErrors) == 0 {
+			state.Status = v1.IndexStatus_INDEX_STATUS_COMPLETED
+			state.NeedsIndexing = false
+			state.LastError = ""
+			state.RetryCount = 0
+		} else {
+			var errs []string
+			for theme, err := range item.ThemeErrors {
+				errs = append(errs, fmt.Sprintf("%s: %v", theme, err))
+			}
+			slices.Sort(errs)
+			state.Status = v1.IndexStatus_INDEX_STATUS_FAILED
+			state.NeedsIndexing = true
+			state.LastError = strings.Join(errs, "; ")
+			state.RetryCount++
+		}
+		if err := r.States.SaveIndexState(ctx, state); err != nil {
+			log.Printf("Failed to save %s index state of %s %s: %v", indexType, item.Kind, item.Id, err)
 		}
 	}
 }
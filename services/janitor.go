@@ -0,0 +1,534 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	oagrpc "github.com/panyam/oneauth/grpc"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"google.golang.org/grpc/metadata"
+)
+
+// What the janitor does with a game a retention rule matches
+const (
+	RetentionKeep    = "keep"
+	RetentionArchive = "archive"
+	RetentionDelete  = "delete"
+)
+
+// Why a game is cleaned up
+const (
+	JanitorReasonUnstarted = "unstarted"
+	JanitorReasonInactive  = "inactive"
+)
+
+// GameArchivesPath is where the janitor keeps the archives of the games it
+// removes - <GameArchivesPath>/<gameId>.zip in the file store
+const GameArchivesPath = "archives/games"
+
+// ScreenshotsPath is the file store directory of world and game screenshots,
+// laid out as <ScreenshotsPath>/<kind>/<id>/<theme>.<ext>
+const ScreenshotsPath = "screenshots"
+
+// janitorUserID is who the janitor acts as on games that have no creator
+const janitorUserID = "janitor"
+
+// RetentionRule says what to do with games left alone for longer than After.
+// A zero After or the keep action turns the rule off.
+type RetentionRule struct {
+	Action string
+	After  time.Duration
+}
+
+func (r RetentionRule) enabled() bool {
+	return r.After > 0 && r.Action != "" && r.Action != RetentionKeep
+}
+
+// RetentionPolicy says what the Janitor cleans up
+type RetentionPolicy struct {
+	// Games with an open seat that nobody has made a move in
+	Unstarted RetentionRule
+
+	// Games under way (not ended) with no move for a while
+	Inactive RetentionRule
+
+	// Screenshots of worlds and games that no longer exist are deleted
+	// once they are this old.  The grace period spares the screenshots of a
+	// world created while the janitor runs.  Zero keeps orphaned files.
+	OrphanFilesAfter time.Duration
+}
+
+// ParseRetentionRule parses a rule written as <action>:<age>, eg
+// "archive:30d" or "delete:2160h".  "keep" (or "") is a rule that is off.
+func ParseRetentionRule(value string) (RetentionRule, error) {
+	if value == "" || value == RetentionKeep {
+		return RetentionRule{Action: RetentionKeep}, nil
+	}
+	action, age, ok := strings.Cut(value, ":")
+	if !ok {
+		return RetentionRule{}, fmt.Errorf("invalid retention rule %q: expected <action>:<age>", value)
+	}
+	switch action {
+	case RetentionKeep, RetentionArchive, RetentionDelete:
+	default:
+		return RetentionRule{}, fmt.Errorf("invalid retention action %q: must be %s, %s or %s", action, RetentionKeep, RetentionArchive, RetentionDelete)
+	}
+	after, err := ParseRetentionAge(age)
+	if err != nil {
+		return RetentionRule{}, err
+	}
+	return RetentionRule{Action: action, After: after}, nil
+}
+
+// ParseRetentionAge parses an age as a Go duration or a number of days
+// ("30d")
+func ParseRetentionAge(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid age %q", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	age, err := time.ParseDuration(value)
+	if err != nil || age < 0 {
+		return 0, fmt.Errorf("invalid age %q", value)
+	}
+	return age, nil
+}
+
+// JanitorGame is a game a janitor run archived or deleted - or would have
+// in a dry run
+type JanitorGame struct {
+	GameId       string
+	Name         string
+	CreatorId    string
+	Reason       string
+	Action       string
+	LastActivity time.Time
+
+	// Where the game's archive is stored when it is archived
+	ArchivePath string
+
+	// Why the game could not be cleaned up.  The game is left as it was.
+	Error error
+}
+
+// JanitorFile is an orphaned file a janitor run deleted - or would have in
+// a dry run
+type JanitorFile struct {
+	Path string
+	Size uint64
+
+	// Kind (worlds or games) and ID of what the file belonged to
+	Kind    string
+	OwnerId string
+
+	Error error
+}
+
+// JanitorReport is what a janitor run found and did
+type JanitorReport struct {
+	DryRun       bool
+	StartedAt    time.Time
+	GamesScanned int
+	FilesScanned int
+	Games        []*JanitorGame
+	OrphanFiles  []*JanitorFile
+}
+
+// Summary describes the run in a line
+func (r *JanitorReport) Summary() string {
+	archived, deleted, orphans, failed := 0, 0, 0, 0
+	for _, game := range r.Games {
+		switch {
+		case game.Error != nil:
+			failed++
+		case game.Action == RetentionArchive:
+			archived++
+		default:
+			deleted++
+		}
+	}
+	var orphanBytes uint64
+	for _, file := range r.OrphanFiles {
+		if file.Error != nil {
+			failed++
+		} else {
+			orphans++
+			orphanBytes += file.Size
+		}
+	}
+	verb := "removed"
+	if r.DryRun {
+		verb = "to remove"
+	}
+	return fmt.Sprintf("scanned %d games and %d files: %d games archived and %d deleted, %d orphaned files (%d bytes) %s, %d errors",
+		r.GamesScanned, r.FilesScanned, archived, deleted, orphans, orphanBytes, verb, failed)
+}
+
+// Log writes the report to the log, a line for every game and file
+func (r *JanitorReport) Log() {
+	prefix := "Janitor"
+	if r.DryRun {
+		prefix = "Janitor (dry run)"
+	}
+	for _, game := range r.Games {
+		line := fmt.Sprintf("%s: %s game %s (%s, last active %s)", prefix, game.Action, game.GameId, game.Reason, game.LastActivity.Format(time.DateOnly))
+		if game.ArchivePath != "" {
+			line += " to " + game.ArchivePath
+		}
+		if game.Error != nil {
+			line += fmt.Sprintf(": %v", game.Error)
+		}
+		log.Println(line)
+	}
+	for _, file := range r.OrphanFiles {
+		line := fmt.Sprintf("%s: delete %s (%s %s no longer exists)", prefix, file.Path, strings.TrimSuffix(file.Kind, "s"), file.OwnerId)
+		if file.Error != nil {
+			line += fmt.Sprintf(": %v", file.Error)
+		}
+		log.Println(line)
+	}
+	log.Printf("%s: %s", prefix, r.Summary())
+}
+
+// Janitor periodically cleans up games nobody is playing and files in the
+// file store that nothing refers to any more, as set by its RetentionPolicy.
+// Games are archived to the file store (see ExportGame) or deleted; orphaned
+// screenshots are found by reconciling ListFiles against the games and
+// worlds that exist.
+type Janitor struct {
+	Games  v1s.GamesServiceServer
+	Worlds v1s.WorldsServiceServer
+	Files  v1s.FileStoreServiceServer
+	Policy RetentionPolicy
+
+	// Only report what would be cleaned up
+	DryRun bool
+
+	// How often Start runs the janitor
+	Interval time.Duration
+
+	// Clock - overridable for tests
+	Now func() time.Time
+
+	mu   sync.Mutex
+	stop chan bool
+}
+
+// NewJanitor creates a janitor that runs daily.  It starts in dry run mode
+// so a new policy is reported on before anything is removed.
+func NewJanitor(games v1s.GamesServiceServer, worlds v1s.WorldsServiceServer, files v1s.FileStoreServiceServer, policy RetentionPolicy) *Janitor {
+	return &Janitor{
+		Games:    games,
+		Worlds:   worlds,
+		Files:    files,
+		Policy:   policy,
+		DryRun:   true,
+		Interval: 24 * time.Hour,
+		Now:      time.Now,
+	}
+}
+
+// JanitorJobType is the job type of the janitor's scheduled runs
+const JanitorJobType = "janitor"
+
+// Schedule runs the janitor as a job repeating every Interval on runner,
+// instead of the janitor's own loop (Start)
+func (j *Janitor) Schedule(ctx context.Context, runner *JobRunner) error {
+	runner.Handle(JanitorJobType, func(ctx context.Context, job *v1.Job) error {
+		report, err := j.Run(ctx)
+		report.Log()
+		return err
+	})
+	_, err := runner.Schedule(ctx, &v1.Job{
+		JobType:    JanitorJobType,
+		RepeatInfo: &v1.RepeatInfo{IntervalSeconds: int32(j.Interval / time.Second)},
+		// The first run waits an interval, as with Start
+		DebounceWindowSeconds: int32(j.Interval / time.Second),
+	})
+	return err
+}
+
+// Start launches the background loop running the janitor every Interval
+func (j *Janitor) Start() {
+	j.mu.Lock()
+	if j.stop != nil {
+		j.mu.Unlock()
+		return
+	}
+	j.stop = make(chan bool)
+	stop := j.stop
+	j.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(j.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				report, err := j.Run(context.Background())
+				if err != nil {
+					log.Printf("Janitor run failed: %v", err)
+				}
+				report.Log()
+			}
+		}
+	}()
+}
+
+// Stop halts the background loop
+func (j *Janitor) Stop() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.stop != nil {
+		close(j.stop)
+		j.stop = nil
+	}
+}
+
+// Run cleans up once and reports what it did.  A game or file that cannot
+// be cleaned up is reported with its error and the run carries on; an error
+// is only returned when the run could not see what exists, in which case
+// the report covers what was done before that.
+func (j *Janitor) Run(ctx context.Context) (*JanitorReport, error) {
+	now := j.Now()
+	report := &JanitorReport{DryRun: j.DryRun, StartedAt: now}
+
+	games, err := j.listGames(ctx)
+	if err != nil {
+		return report, err
+	}
+	report.GamesScanned = len(games)
+	live := map[string]map[string]bool{"games": {}, "worlds": {}}
+	for _, game := range games {
+		live["games"][game.Id] = true
+	}
+
+	for _, game := range games {
+		item := j.checkGame(ctx, game, now)
+		if item == nil {
+			continue
+		}
+		if !j.DryRun {
+			item.Error = j.cleanUpGame(ctx, item)
+		}
+		report.Games = append(report.Games, item)
+		if item.Error == nil {
+			// Its screenshots go with it
+			delete(live["games"], game.Id)
+		}
+	}
+
+	if j.Policy.OrphanFilesAfter <= 0 {
+		return report, nil
+	}
+	worldIds, err := j.listWorldIds(ctx)
+	if err != nil {
+		return report, err
+	}
+	for _, id := range worldIds {
+		live["worlds"][id] = true
+	}
+	files, err := j.listFiles(ctx, ScreenshotsPath)
+	if err != nil {
+		return report, err
+	}
+	report.FilesScanned = len(files)
+	for _, file := range files {
+		kind, ownerId, ok := parseScreenshotPath(file.Path)
+		if !ok || live[kind][ownerId] || now.Sub(file.UpdatedAt.AsTime()) < j.Policy.OrphanFilesAfter {
+			continue
+		}
+		item := &JanitorFile{Path: file.Path, Size: file.FileSize, Kind: kind, OwnerId: ownerId}
+		if !j.DryRun {
+			_, item.Error = j.Files.DeleteFile(ctx, &v1.DeleteFileRequest{Path: file.Path})
+		}
+		report.OrphanFiles = append(report.OrphanFiles, item)
+	}
+	return report, nil
+}
+
+// checkGame returns the cleanup due for a game, nil if there is none
+func (j *Janitor) checkGame(ctx context.Context, game *v1.Game, now time.Time) *JanitorGame {
+	unstarted, inactive := j.Policy.Unstarted, j.Policy.Inactive
+	if !unstarted.enabled() && !inactive.enabled() {
+		return nil
+	}
+	// Games are only loaded when their own timestamps are old enough for
+	// some rule - moves never make a game look older than it is
+	lastActivity := latestTime(game.CreatedAt.AsTime(), game.UpdatedAt.AsTime())
+	idle := now.Sub(lastActivity)
+	if (!unstarted.enabled() || idle < unstarted.After) && (!inactive.enabled() || idle < inactive.After) {
+		return nil
+	}
+
+	resp, err := j.Games.GetGame(ctx, &v1.GetGameRequest{Id: game.Id})
+	if err != nil {
+		log.Printf("Janitor: skipping game %s: %v", game.Id, err)
+		return nil
+	}
+	state := resp.State
+	if state.GetFinished() || state.GetStatus() == v1.GameStatus_GAME_STATUS_ENDED {
+		return nil
+	}
+	if state.GetUpdatedAt() != nil {
+		lastActivity = latestTime(lastActivity, state.UpdatedAt.AsTime())
+	}
+
+	rule, reason := inactive, JanitorReasonInactive
+	if state.GetCurrentGroupNumber() == 0 && hasOpenSeat(game) {
+		rule, reason = unstarted, JanitorReasonUnstarted
+	}
+	if !rule.enabled() || now.Sub(lastActivity) < rule.After {
+		return nil
+	}
+	item := &JanitorGame{
+		GameId:       game.Id,
+		Name:         game.Name,
+		CreatorId:    game.CreatorId,
+		Reason:       reason,
+		Action:       rule.Action,
+		LastActivity: lastActivity,
+	}
+	if rule.Action == RetentionArchive {
+		item.ArchivePath = fmt.Sprintf("%s/%s.zip", GameArchivesPath, game.Id)
+	}
+	return item
+}
+
+// cleanUpGame archives and/or deletes a game.  A game is only deleted once
+// its archive is safely stored.
+func (j *Janitor) cleanUpGame(ctx context.Context, item *JanitorGame) error {
+	ctx = actingAs(ctx, item.CreatorId)
+	if item.Action == RetentionArchive {
+		exported, err := j.Games.ExportGame(ctx, &v1.ExportGameRequest{GameId: item.GameId})
+		if err != nil {
+			return fmt.Errorf("failed to export game: %w", err)
+		}
+		_, err = j.Files.PutFile(ctx, &v1.PutFileRequest{
+			File:    &v1.File{Path: item.ArchivePath, ContentType: "application/zip"},
+			Content: exported.Archive,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to store archive: %w", err)
+		}
+	}
+	if _, err := j.Games.DeleteGame(ctx, &v1.DeleteGameRequest{Id: item.GameId}); err != nil {
+		return fmt.Errorf("failed to delete game: %w", err)
+	}
+	return nil
+}
+
+// listGames returns every game, oldest first
+func (j *Janitor) listGames(ctx context.Context) ([]*v1.Game, error) {
+	var games []*v1.Game
+	req := &v1.ListGamesRequest{
+		Pagination: &v1.Pagination{PageSize: MaxListPageSize},
+		SortBy:     SortByCreatedAt,
+		SortOrder:  "asc",
+	}
+	for {
+		resp, err := j.Games.ListGames(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list games: %w", err)
+		}
+		games = append(games, resp.Items...)
+		if !resp.Pagination.GetHasMore() || resp.Pagination.NextPageKey == "" {
+			return games, nil
+		}
+		req.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+}
+
+// listWorldIds returns the ID of every world
+func (j *Janitor) listWorldIds(ctx context.Context) ([]string, error) {
+	var ids []string
+	req := &v1.ListWorldsRequest{
+		Pagination: &v1.Pagination{PageSize: MaxListPageSize},
+		SortBy:     SortByCreatedAt,
+		SortOrder:  "asc",
+	}
+	for {
+		resp, err := j.Worlds.ListWorlds(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list worlds: %w", err)
+		}
+		for _, world := range resp.Items {
+			ids = append(ids, world.Id)
+		}
+		if !resp.Pagination.GetHasMore() || resp.Pagination.NextPageKey == "" {
+			return ids, nil
+		}
+		req.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+}
+
+// listFiles returns every file under a directory of the file store
+func (j *Janitor) listFiles(ctx context.Context, path string) ([]*v1.File, error) {
+	var files []*v1.File
+	req := &v1.ListFilesRequest{Path: path, Recursive: true, Pagination: &v1.Pagination{}}
+	for {
+		resp, err := j.Files.ListFiles(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files in %s: %w", path, err)
+		}
+		files = append(files, resp.Items...)
+		if !resp.Pagination.GetHasMore() || resp.Pagination.NextPageKey == "" {
+			return files, nil
+		}
+		req.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+}
+
+// parseScreenshotPath returns the kind and ID of what a screenshot is of
+func parseScreenshotPath(path string) (kind, id string, ok bool) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 4 || parts[0] != ScreenshotsPath || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// hasOpenSeat reports whether a game still has a player slot to join
+func hasOpenSeat(game *v1.Game) bool {
+	for _, player := range game.GetConfig().GetPlayers() {
+		if player.PlayerType == "open" {
+			return true
+		}
+	}
+	return false
+}
+
+func latestTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// actingAs returns a context authenticated as a user the way the auth
+// interceptor would, for calling the in-process services on their behalf.
+// Games without a creator can be changed by anyone so the janitor acts as
+// itself.
+func actingAs(ctx context.Context, userID string) context.Context {
+	if userID == "" {
+		userID = janitorUserID
+	}
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(oagrpc.DefaultMetadataKeySubject, userID))
+}
@@ -0,0 +1,630 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrJobConflict is returned by JobStore.SaveJob when the job was saved by
+// someone else since it was loaded
+var ErrJobConflict = status.Error(codes.Aborted, "job was modified concurrently")
+
+// MaxDueJobs is how many due jobs of each type RunDue picks up at a time
+const MaxDueJobs = 100
+
+// JobFilter selects the jobs JobStore.ListJobs returns.  Zero fields do not
+// filter.
+type JobFilter struct {
+	JobType string
+	Status  v1.JobStatus
+
+	// DueBy only returns jobs whose run_after is at or before it
+	DueBy time.Time
+
+	// Limit caps how many jobs are returned
+	Limit int
+}
+
+// JobStore keeps the jobs queue and the history of its runs
+type JobStore interface {
+	// LoadJob returns a job or a NotFound error
+	LoadJob(ctx context.Context, id string) (*v1.Job, error)
+
+	// SaveJob creates (oldVersion 0) or replaces a job if its stored
+	// version is still oldVersion, returning ErrJobConflict otherwise.
+	// The saved job's version is set to oldVersion+1.
+	SaveJob(ctx context.Context, job *v1.Job, oldVersion int64) error
+
+	// ListJobs returns the jobs matching filter ordered by run_after then id
+	ListJobs(ctx context.Context, filter JobFilter) ([]*v1.Job, error)
+
+	// SaveRun creates or replaces a run
+	SaveRun(ctx context.Context, run *v1.Run) error
+
+	// ListRuns returns up to limit (0 for all) of a job's runs newest first
+	ListRuns(ctx context.Context, jobId string, limit int) ([]*v1.Run, error)
+}
+
+// JobID is the ID of the job doing jobType work on an entity.  Enqueueing
+// the same work again finds the same job.
+func JobID(jobType, entityType, entityId string) string {
+	return jobType + ":" + entityType + ":" + entityId
+}
+
+// NewJob returns a job to enqueue, with data packed into its job_data
+func NewJob(jobType, entityType, entityId string, data proto.Message) (*v1.Job, error) {
+	job := &v1.Job{JobType: jobType, EntityType: entityType, EntityId: entityId}
+	if data != nil {
+		packed, err := anypb.New(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to pack data of %s job: %w", jobType, err)
+		}
+		job.JobData = packed
+	}
+	return job, nil
+}
+
+// JobHandler does the work of a job.  A returned error fails the run and
+// the job is retried with backoff.
+type JobHandler func(ctx context.Context, job *v1.Job) error
+
+// RegisterJobHandler registers a handler for jobType that is handed the
+// job's data already unpacked.  Jobs without data get an empty T.
+func RegisterJobHandler[T proto.Message](r *JobRunner, jobType string, handle func(ctx context.Context, job *v1.Job, data T) error) {
+	r.Handle(jobType, func(ctx context.Context, job *v1.Job) error {
+		var zero T
+		data := zero.ProtoReflect().New().Interface().(T)
+		if job.JobData != nil {
+			if err := job.JobData.UnmarshalTo(data); err != nil {
+				return fmt.Errorf("invalid data for %s job: %w", jobType, err)
+			}
+		}
+		return handle(ctx, job, data)
+	})
+}
+
+// JobRunner runs the jobs in a JobStore with the handlers registered for
+// their types.
+//
+// A job is PENDING until its run_after, then RUNNING while a worker has it
+// and SUCCEEDED or FAILED afterwards.  Enqueueing work that already has a
+// pending job updates that job instead of adding another, and the job runs
+// the debounce window after the first of them, so a burst of changes to an
+// entity runs once and an entity that keeps changing still runs.  A failed
+// run is retried after a backoff that doubles each attempt
+// until the job's max_retries is used up.  Jobs with repeat_info go back to
+// PENDING after every run, succeeded or not.
+//
+// Claiming a job is an optimistic SaveJob, so runners sharing a store never
+// run a job twice at once.  A claim is a lease: while a job is RUNNING its
+// run_after is when the lease expires, and the runner extends it every
+// LeaseDuration/3 until the run ends.  A job whose lease has expired - its
+// runner died, hung or lost the store - is taken back by the next RunDue
+// of any runner and counted as a failed run.
+type JobRunner struct {
+	Store JobStore
+
+	// Workers is how many jobs run at once
+	Workers int
+
+	// PollInterval is how often the background loop looks for due jobs
+	PollInterval time.Duration
+
+	// RetryBackoff is the wait before the first retry of a failed job,
+	// doubling each further attempt up to MaxBackoff
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+
+	// DefaultMaxRetries applies to jobs with no max_retries of their own
+	DefaultMaxRetries int32
+
+	// LeaseDuration is how long a claimed job is held without a heartbeat
+	// before other runners may take it back
+	LeaseDuration time.Duration
+
+	// Now returns the current time - replaceable for tests
+	Now func() time.Time
+
+	handlers map[string]JobHandler
+	runCount atomic.Int64
+	mu       sync.Mutex
+	stop     chan bool
+}
+
+// NewJobRunner creates a runner over store with no handlers registered
+func NewJobRunner(store JobStore) *JobRunner {
+	return &JobRunner{
+		Store:             store,
+		Workers:           4,
+		PollInterval:      5 * time.Second,
+		RetryBackoff:      30 * time.Second,
+		MaxBackoff:        time.Hour,
+		DefaultMaxRetries: 3,
+		LeaseDuration:     2 * time.Minute,
+		Now:               time.Now,
+		handlers:          map[string]JobHandler{},
+	}
+}
+
+// Handle registers the handler of a job type, replacing any earlier one
+func (r *JobRunner) Handle(jobType string, handler JobHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[jobType] = handler
+}
+
+func (r *JobRunner) handler(jobType string) JobHandler {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.handlers[jobType]
+}
+
+// Enqueue schedules the work described by job (its type, entity, data,
+// debounce window, repeat info and max retries) and returns the stored job.
+// Work already pending is updated rather than added again; work that is
+// running is run again once the current run ends.
+func (r *JobRunner) Enqueue(ctx context.Context, job *v1.Job) (*v1.Job, error) {
+	return r.EnqueueMerged(ctx, job, nil)
+}
+
+// EnqueueMerged is Enqueue with the job to store built by merge from job and
+// the stored one (nil when there is none), so new work can be folded into
+// work already queued for the entity.  merge may be called more than once
+// if the job is saved concurrently and must not change its arguments.
+func (r *JobRunner) EnqueueMerged(ctx context.Context, job *v1.Job, merge func(job, stored *v1.Job) (*v1.Job, error)) (*v1.Job, error) {
+	if job.JobType == "" {
+		return nil, fmt.Errorf("job type is required")
+	}
+	id := JobID(job.JobType, job.EntityType, job.EntityId)
+	for {
+		now := r.Now()
+		stored, err := r.Store.LoadJob(ctx, id)
+		queued := job
+		if status.Code(err) == codes.NotFound {
+			if merge != nil {
+				if queued, err = merge(job, nil); err != nil {
+					return nil, err
+				}
+			}
+			stored = &v1.Job{
+				Id:         id,
+				JobType:    job.JobType,
+				EntityType: job.EntityType,
+				EntityId:   job.EntityId,
+				CreatedAt:  tspb.New(now),
+			}
+		} else if err != nil {
+			return nil, err
+		} else if merge != nil {
+			if queued, err = merge(job, stored); err != nil {
+				return nil, err
+			}
+		}
+		oldVersion := stored.Version
+
+		// Later work merged into a pending job does not push its run back
+		// past the debounce window of the first
+		runAfter := tspb.New(now.Add(time.Duration(queued.DebounceWindowSeconds) * time.Second))
+		if stored.Status == v1.JobStatus_JOB_STATUS_PENDING && stored.RunAfter != nil &&
+			stored.RunAfter.AsTime().Before(runAfter.AsTime()) {
+			runAfter = stored.RunAfter
+		}
+		stored.JobData = queued.JobData
+		stored.DebounceWindowSeconds = queued.DebounceWindowSeconds
+		stored.RepeatInfo = queued.RepeatInfo
+		stored.MaxRetries = queued.MaxRetries
+		stored.UpdatedAt = tspb.New(now)
+		if stored.Status != v1.JobStatus_JOB_STATUS_RUNNING {
+			// A running job stays running, its run_after holding its
+			// lease - the runner sees the new version when the run ends
+			// and pends it again
+			stored.Status = v1.JobStatus_JOB_STATUS_PENDING
+			stored.Attempts = 0
+			stored.RunAfter = runAfter
+		}
+
+		err = r.Store.SaveJob(ctx, stored, oldVersion)
+		if err == ErrJobConflict {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return stored, nil
+	}
+}
+
+// Schedule enqueues a repeating job unless it is already scheduled with the
+// same interval, so restarting a server does not push the next run back
+func (r *JobRunner) Schedule(ctx context.Context, job *v1.Job) (*v1.Job, error) {
+	stored, err := r.Store.LoadJob(ctx, JobID(job.JobType, job.EntityType, job.EntityId))
+	if err == nil && stored.Status == v1.JobStatus_JOB_STATUS_PENDING &&
+		stored.RepeatInfo.GetIntervalSeconds() == job.RepeatInfo.GetIntervalSeconds() {
+		return stored, nil
+	}
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+	return r.Enqueue(ctx, job)
+}
+
+// EnqueueOnce enqueues one-off work unless there is already a job for it,
+// whatever its status, so the work is done once per store rather than once
+// per server start
+func (r *JobRunner) EnqueueOnce(ctx context.Context, job *v1.Job) (*v1.Job, error) {
+	stored, err := r.Store.LoadJob(ctx, JobID(job.JobType, job.EntityType, job.EntityId))
+	if status.Code(err) != codes.NotFound {
+		return stored, err
+	}
+	return r.Enqueue(ctx, job)
+}
+
+// RunDue runs the pending jobs that are due, Workers at a time, and returns
+// how many it ran.  Only jobs of the types with a registered handler are
+// run, so runners with different handlers can share a store.
+func (r *JobRunner) RunDue(ctx context.Context) (int, error) {
+	r.mu.Lock()
+	jobTypes := slices.Sorted(maps.Keys(r.handlers))
+	r.mu.Unlock()
+
+	now := r.Now()
+	var jobs []*v1.Job
+	for _, jobType := range jobTypes {
+		if err := r.reclaimExpired(ctx, jobType, now); err != nil {
+			return 0, err
+		}
+		due, err := r.Store.ListJobs(ctx, JobFilter{
+			JobType: jobType,
+			Status:  v1.JobStatus_JOB_STATUS_PENDING,
+			DueBy:   now,
+			Limit:   MaxDueJobs,
+		})
+		if err != nil {
+			return 0, err
+		}
+		jobs = append(jobs, due...)
+	}
+
+	var ran atomic.Int64
+	var wg sync.WaitGroup
+	slots := make(chan bool, max(r.Workers, 1))
+	for _, job := range jobs {
+		slots <- true
+		wg.Add(1)
+		go func(job *v1.Job) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			ok, err := r.runJob(ctx, job)
+			if err != nil {
+				log.Printf("Job %s: %v", job.Id, err)
+			}
+			if ok {
+				ran.Add(1)
+			}
+		}(job)
+	}
+	wg.Wait()
+	return int(ran.Load()), nil
+}
+
+// runJob claims a job and runs it.  Returns false if another runner
+// claimed it first.
+func (r *JobRunner) runJob(ctx context.Context, job *v1.Job) (bool, error) {
+	now := r.Now()
+	run := &v1.Run{
+		JobId:      job.Id,
+		RunId:      strconv.FormatInt(now.UnixNano(), 36) + "-" + strconv.FormatInt(r.runCount.Add(1), 36),
+		JobType:    job.JobType,
+		CreatedAt:  tspb.New(now),
+		StartedAt:  tspb.New(now),
+		UpdatedAt:  tspb.New(now),
+		State:      v1.RunState_RUN_STATE_STARTED,
+		RetryCount: job.Attempts,
+	}
+
+	claimed := proto.Clone(job).(*v1.Job)
+	claimed.Status = v1.JobStatus_JOB_STATUS_RUNNING
+	claimed.LastRunId = run.RunId
+	claimed.UpdatedAt = tspb.New(now)
+	claimed.RunAfter = tspb.New(now.Add(r.LeaseDuration))
+	if err := r.Store.SaveJob(ctx, claimed, job.Version); err != nil {
+		if err == ErrJobConflict {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to claim job: %w", err)
+	}
+	if err := r.Store.SaveRun(ctx, run); err != nil {
+		log.Printf("Failed to record run %s of job %s: %v", run.RunId, job.Id, err)
+	}
+
+	lease := &jobLease{job: claimed}
+	stopHeartbeat := r.startHeartbeat(ctx, lease)
+	runErr := r.callHandler(ctx, claimed)
+	stopHeartbeat()
+
+	finished := r.Now()
+	run.UpdatedAt = tspb.New(finished)
+	run.FinishedAt = tspb.New(finished)
+	run.State = v1.RunState_RUN_STATE_FINISHED
+	if runErr != nil {
+		run.State = v1.RunState_RUN_STATE_FAILED
+		run.LastError = runErr.Error()
+	}
+	if err := r.Store.SaveRun(ctx, run); err != nil {
+		log.Printf("Failed to record run %s of job %s: %v", run.RunId, job.Id, err)
+	}
+	if lease.requeued {
+		return true, r.requeueJob(ctx, lease.job, runErr, finished)
+	}
+	return true, r.finishJob(ctx, lease.job, runErr, finished)
+}
+
+// jobLease is a runner's claim on a running job - the version of the job it
+// last saved and whether the job was enqueued again since it was claimed
+type jobLease struct {
+	job      *v1.Job
+	requeued bool
+}
+
+// startHeartbeat extends a running job's lease every LeaseDuration/3 until
+// the returned func is called.  It returns once the heartbeat has stopped.
+func (r *JobRunner) startHeartbeat(ctx context.Context, lease *jobLease) func() {
+	done := make(chan bool)
+	stopped := make(chan bool)
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(max(r.LeaseDuration/3, time.Millisecond))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := r.extendLease(ctx, lease); err != nil {
+					log.Printf("Failed to extend the lease of job %s: %v", lease.job.Id, err)
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// extendLease pushes a running job's lease expiry to LeaseDuration from now,
+// noting if the job was enqueued again since the lease last saved it
+func (r *JobRunner) extendLease(ctx context.Context, lease *jobLease) error {
+	for {
+		current, err := r.Store.LoadJob(ctx, lease.job.Id)
+		if err != nil {
+			return err
+		}
+		if current.Status != v1.JobStatus_JOB_STATUS_RUNNING || current.LastRunId != lease.job.LastRunId {
+			return fmt.Errorf("lease was lost")
+		}
+		if current.Version != lease.job.Version {
+			lease.requeued = true
+		}
+		oldVersion := current.Version
+		current.RunAfter = tspb.New(r.Now().Add(r.LeaseDuration))
+		err = r.Store.SaveJob(ctx, current, oldVersion)
+		if err == ErrJobConflict {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		lease.job = current
+		return nil
+	}
+}
+
+// callHandler runs a job's handler, turning a panic into an error
+func (r *JobRunner) callHandler(ctx context.Context, job *v1.Job) (err error) {
+	handler := r.handler(job.JobType)
+	if handler == nil {
+		return fmt.Errorf("no handler registered for job type %q", job.JobType)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("job panicked: %v\n%s", p, debug.Stack())
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// finishJob records the outcome of a run on its job
+func (r *JobRunner) finishJob(ctx context.Context, job *v1.Job, runErr error, now time.Time) error {
+	finished := r.settleJob(job, runErr, now)
+	err := r.Store.SaveJob(ctx, finished, job.Version)
+	if err != ErrJobConflict {
+		return err
+	}
+	return r.requeueJob(ctx, job, runErr, now)
+}
+
+// settleJob returns a copy of a job with the outcome of its run applied: a
+// success resets its attempts, a failure is retried after a backoff until
+// the job is out of retries, and repeating jobs are pended for their next
+// run either way
+func (r *JobRunner) settleJob(job *v1.Job, runErr error, now time.Time) *v1.Job {
+	finished := proto.Clone(job).(*v1.Job)
+	finished.UpdatedAt = tspb.New(now)
+	repeat := time.Duration(job.RepeatInfo.GetIntervalSeconds()) * time.Second
+	if runErr == nil {
+		finished.Attempts = 0
+		finished.LastError = ""
+		finished.Status = v1.JobStatus_JOB_STATUS_SUCCEEDED
+		if repeat > 0 {
+			finished.Status = v1.JobStatus_JOB_STATUS_PENDING
+			finished.RunAfter = tspb.New(now.Add(repeat))
+		}
+	} else {
+		finished.Attempts++
+		finished.LastError = runErr.Error()
+		maxRetries := job.MaxRetries
+		if maxRetries <= 0 {
+			maxRetries = r.DefaultMaxRetries
+		}
+		switch {
+		case finished.Attempts <= maxRetries:
+			finished.Status = v1.JobStatus_JOB_STATUS_PENDING
+			finished.RunAfter = tspb.New(now.Add(r.backoff(finished.Attempts)))
+		case repeat > 0:
+			// Out of retries - wait for the next scheduled run
+			finished.Attempts = 0
+			finished.Status = v1.JobStatus_JOB_STATUS_PENDING
+			finished.RunAfter = tspb.New(now.Add(repeat))
+		default:
+			finished.Status = v1.JobStatus_JOB_STATUS_FAILED
+		}
+	}
+	return finished
+}
+
+// requeueJob pends a job that was enqueued again while it ran, keeping the
+// new data, to run again when its debounce window is up.  A job another
+// runner has since taken back is left to it.
+func (r *JobRunner) requeueJob(ctx context.Context, job *v1.Job, runErr error, now time.Time) error {
+	for {
+		current, err := r.Store.LoadJob(ctx, job.Id)
+		if err != nil {
+			return err
+		}
+		if current.LastRunId != job.LastRunId {
+			return nil
+		}
+		oldVersion := current.Version
+		current.Status = v1.JobStatus_JOB_STATUS_PENDING
+		current.Attempts = 0
+		current.LastError = ""
+		if runErr != nil {
+			current.LastError = runErr.Error()
+		}
+		current.UpdatedAt = tspb.New(now)
+		current.RunAfter = tspb.New(now.Add(time.Duration(current.DebounceWindowSeconds) * time.Second))
+		if err := r.Store.SaveJob(ctx, current, oldVersion); err != ErrJobConflict {
+			return err
+		}
+	}
+}
+
+// backoff returns the wait before retry number attempt
+func (r *JobRunner) backoff(attempt int32) time.Duration {
+	wait := r.RetryBackoff
+	for i := int32(1); i < attempt && wait < r.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if r.MaxBackoff > 0 {
+		wait = min(wait, r.MaxBackoff)
+	}
+	return wait
+}
+
+// Retry makes a job due now with its retries reset
+func (r *JobRunner) Retry(ctx context.Context, id string) (*v1.Job, error) {
+	job, err := r.Store.LoadJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status == v1.JobStatus_JOB_STATUS_RUNNING {
+		return nil, status.Errorf(codes.FailedPrecondition, "job %s is running", id)
+	}
+	oldVersion := job.Version
+	now := r.Now()
+	job.Status = v1.JobStatus_JOB_STATUS_PENDING
+	job.Attempts = 0
+	job.RunAfter = tspb.New(now)
+	job.UpdatedAt = tspb.New(now)
+	if err := r.Store.SaveJob(ctx, job, oldVersion); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// errLeaseExpired fails the run of a job whose runner stopped renewing its
+// lease before the run ended
+var errLeaseExpired = errors.New("lease expired before the run finished")
+
+// reclaimExpired takes back jobs of a type whose lease expired by now - left
+// RUNNING by a runner that died or hung mid-run - and settles them as failed
+// runs, so a job that keeps crashing its runner is retried with backoff and
+// eventually failed like any other.  A job that another runner reclaims or
+// extends first is skipped.
+func (r *JobRunner) reclaimExpired(ctx context.Context, jobType string, now time.Time) error {
+	jobs, err := r.Store.ListJobs(ctx, JobFilter{
+		JobType: jobType,
+		Status:  v1.JobStatus_JOB_STATUS_RUNNING,
+		DueBy:   now,
+		Limit:   MaxDueJobs,
+	})
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		reclaimed := r.settleJob(job, errLeaseExpired, now)
+		if err := r.Store.SaveJob(ctx, reclaimed, job.Version); err != nil && err != ErrJobConflict {
+			return err
+		}
+	}
+	return nil
+}
+
+// Start runs due jobs every PollInterval until Stop is called
+func (r *JobRunner) Start() {
+	r.mu.Lock()
+	if r.stop != nil {
+		r.mu.Unlock()
+		return
+	}
+	r.stop = make(chan bool)
+	stop := r.stop
+	r.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(r.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if _, err := r.RunDue(context.Background()); err != nil {
+					log.Printf("Failed to run due jobs: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop halts the background loop.  Runs in progress are not interrupted.
+func (r *JobRunner) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stop != nil {
+		close(r.stop)
+		r.stop = nil
+	}
+}
@@ -0,0 +1,99 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"github.com/turnforge/lilbattle/services/authz"
+)
+
+// DefaultJobRunLimit is how many runs GetJob returns when the request does
+// not say
+const DefaultJobRunLimit = 20
+
+// JobsService lets operators inspect the jobs queue and retry failed jobs
+type JobsService struct {
+	v1s.UnimplementedJobsServiceServer
+	Runner *JobRunner
+
+	// Operators are the user IDs allowed to use the service - any
+	// authenticated user when empty
+	Operators []string
+}
+
+// NewJobsService creates the service over a runner's queue
+func NewJobsService(runner *JobRunner, operators []string) *JobsService {
+	return &JobsService{Runner: runner, Operators: operators}
+}
+
+// ListJobs returns a page of jobs, optionally of one type and status
+func (s *JobsService) ListJobs(ctx context.Context, req *v1.ListJobsRequest) (*v1.ListJobsResponse, error) {
+	if err := authz.RequireOperator(ctx, s.Operators); err != nil {
+		return nil, err
+	}
+	jobs, err := s.Runner.Store.ListJobs(ctx, JobFilter{JobType: req.JobType, Status: req.Status})
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := DefaultListPageSize
+	if size := int(req.Pagination.GetPageSize()); size > 0 {
+		pageSize = min(size, MaxListPageSize)
+	}
+	offset := min(max(int(req.Pagination.GetPageOffset()), 0), len(jobs))
+	end := min(offset+pageSize, len(jobs))
+	resp := &v1.ListJobsResponse{
+		Items: jobs[offset:end],
+		Pagination: &v1.PaginationResponse{
+			HasMore:      end < len(jobs),
+			TotalResults: int32(len(jobs)),
+		},
+	}
+	if resp.Pagination.HasMore {
+		resp.Pagination.NextPageOffset = int32(end)
+	}
+	return resp, nil
+}
+
+// GetJob returns a job with its most recent runs
+func (s *JobsService) GetJob(ctx context.Context, req *v1.GetJobRequest) (*v1.GetJobResponse, error) {
+	if err := authz.RequireOperator(ctx, s.Operators); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, fmt.Errorf("job ID is required")
+	}
+	job, err := s.Runner.Store.LoadJob(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.RunLimit)
+	if limit <= 0 {
+		limit = DefaultJobRunLimit
+	}
+	runs, err := s.Runner.Store.ListRuns(ctx, req.Id, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetJobResponse{Job: job, Runs: runs}, nil
+}
+
+// RetryJob makes a job due now with its retries reset
+func (s *JobsService) RetryJob(ctx context.Context, req *v1.RetryJobRequest) (*v1.RetryJobResponse, error) {
+	if err := authz.RequireOperator(ctx, s.Operators); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, fmt.Errorf("job ID is required")
+	}
+	job, err := s.Runner.Retry(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RetryJobResponse{Job: job}, nil
+}
@@ -0,0 +1,340 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultListPageSize is used when a list request does not set a page size
+	DefaultListPageSize = 50
+
+	// MaxListPageSize caps the page size a list request can ask for
+	MaxListPageSize = 1000
+)
+
+// Fields list results can be sorted by
+const (
+	SortByUpdatedAt = "updated_at"
+	SortByCreatedAt = "created_at"
+	SortByName      = "name"
+)
+
+// ListItem is what Games and Worlds have in common for filtering, sorting
+// and paging list results
+type ListItem interface {
+	GetId() string
+	GetCreatorId() string
+	GetName() string
+	GetTags() []string
+	GetCreatedAt() *timestamppb.Timestamp
+	GetUpdatedAt() *timestamppb.Timestamp
+}
+
+// ListCursor is the position encoded in a page key - the sort value and ID
+// of the last item returned.  Results resume strictly after it, so items
+// created or deleted between pages do not shift later pages the way an
+// offset would.
+type ListCursor struct {
+	Name string `json:"n,omitempty"`
+	Time int64  `json:"t,omitempty"` // unix nanos of the sort timestamp
+	Id   string `json:"id"`
+}
+
+// ListQuery is the backend independent form of a ListGames/ListWorlds
+// request.  Backends push down whatever their store can filter and sort on
+// and use Matches/IsAfterCursor for the rest so every backend returns the
+// same pages.
+type ListQuery struct {
+	OwnerId       string
+	PlayerUserId  string
+	WorldId       string
+	ParentWorldId string
+	Tag           string
+	Status        v1.GameStatus
+	Created       *v1.TimeRange
+	Updated       *v1.TimeRange
+
+	SortBy     string
+	Descending bool
+
+	PageSize   int
+	PageOffset int
+
+	// Decoded page key - nil for the first page (or offset based paging)
+	After *ListCursor
+}
+
+// NewGameListQuery validates and normalizes a ListGames request
+func NewGameListQuery(req *v1.ListGamesRequest) (*ListQuery, error) {
+	q := &ListQuery{
+		OwnerId:      req.OwnerId,
+		PlayerUserId: req.PlayerUserId,
+		WorldId:      req.WorldId,
+		Tag:          req.Tag,
+		Status:       req.Status,
+		Created:      req.Created,
+		Updated:      req.Updated,
+	}
+	return q, q.init(req.Pagination, req.SortBy, req.SortOrder, SortByUpdatedAt)
+}
+
+// NewWorldListQuery validates and normalizes a ListWorlds request
+func NewWorldListQuery(req *v1.ListWorldsRequest) (*ListQuery, error) {
+	q := &ListQuery{
+		OwnerId:       req.OwnerId,
+		ParentWorldId: req.ParentWorldId,
+		Tag:           req.Tag,
+		Created:       req.Created,
+		Updated:       req.Updated,
+	}
+	return q, q.init(req.Pagination, req.SortBy, req.SortOrder, SortByName)
+}
+
+func (q *ListQuery) init(pagination *v1.Pagination, sortBy, sortOrder, defaultSortBy string) error {
+	q.SortBy = sortBy
+	if q.SortBy == "" {
+		q.SortBy = defaultSortBy
+	}
+	switch q.SortBy {
+	case SortByUpdatedAt, SortByCreatedAt, SortByName:
+	default:
+		return fmt.Errorf("invalid sort_by %q: must be one of %s, %s or %s", sortBy, SortByUpdatedAt, SortByCreatedAt, SortByName)
+	}
+
+	// Newest first for times, A-Z for names
+	switch sortOrder {
+	case "":
+		q.Descending = q.SortBy != SortByName
+	case "asc":
+		q.Descending = false
+	case "desc":
+		q.Descending = true
+	default:
+		return fmt.Errorf("invalid sort_order %q: must be asc or desc", sortOrder)
+	}
+
+	q.PageSize = DefaultListPageSize
+	if pagination != nil {
+		if pagination.PageSize > 0 {
+			q.PageSize = min(int(pagination.PageSize), MaxListPageSize)
+		}
+		if pagination.PageKey != "" {
+			cursor, err := decodeListCursor(pagination.PageKey)
+			if err != nil {
+				return err
+			}
+			q.After = cursor
+		} else if pagination.PageOffset > 0 {
+			q.PageOffset = int(pagination.PageOffset)
+		}
+	}
+	return nil
+}
+
+func decodeListCursor(pageKey string) (*ListCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(pageKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page_key")
+	}
+	var cursor ListCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Id == "" {
+		return nil, fmt.Errorf("invalid page_key")
+	}
+	return &cursor, nil
+}
+
+// Cursor returns the position of an item in this query's sort order
+func (q *ListQuery) Cursor(item ListItem) *ListCursor {
+	return q.CursorOf(item.GetId(), item.GetName(), item.GetCreatedAt().AsTime(), item.GetUpdatedAt().AsTime())
+}
+
+// CursorOf returns the position of a stored row in this query's sort order
+// without converting it to a proto first
+func (q *ListQuery) CursorOf(id, name string, createdAt, updatedAt time.Time) *ListCursor {
+	cursor := &ListCursor{Id: id}
+	switch q.SortBy {
+	case SortByName:
+		cursor.Name = name
+	case SortByCreatedAt:
+		cursor.Time = createdAt.UnixNano()
+	default:
+		cursor.Time = updatedAt.UnixNano()
+	}
+	return cursor
+}
+
+// PageKey encodes the position of an item as an opaque page key
+func (q *ListQuery) PageKey(item ListItem) string {
+	data, _ := json.Marshal(q.Cursor(item))
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// CursorTime is the sort timestamp of the page key for time sorted queries
+func (c *ListCursor) CursorTime() time.Time {
+	return time.Unix(0, c.Time).UTC()
+}
+
+// compare orders two cursors in this query's sort order.  Ties are broken
+// by ascending ID whatever the direction as that is the order Datastore
+// returns equal values in.
+func (q *ListQuery) compare(a, b *ListCursor) int {
+	var c int
+	if q.SortBy == SortByName {
+		c = strings.Compare(a.Name, b.Name)
+	} else if a.Time < b.Time {
+		c = -1
+	} else if a.Time > b.Time {
+		c = 1
+	}
+	if q.Descending {
+		c = -c
+	}
+	if c == 0 {
+		c = strings.Compare(a.Id, b.Id)
+	}
+	return c
+}
+
+// Less reports whether a sorts before b
+func (q *ListQuery) Less(a, b ListItem) bool {
+	return q.compare(q.Cursor(a), q.Cursor(b)) < 0
+}
+
+// IsAfterCursor reports whether an item belongs on the requested page, ie
+// it sorts strictly after the page key (always true without a page key)
+func (q *ListQuery) IsAfterCursor(item ListItem) bool {
+	return q.After == nil || q.compare(q.Cursor(item), q.After) > 0
+}
+
+// Matches checks the filters common to games and worlds
+func (q *ListQuery) Matches(item ListItem) bool {
+	if q.OwnerId != "" && item.GetCreatorId() != q.OwnerId {
+		return false
+	}
+	if q.Tag != "" && !slices.Contains(item.GetTags(), q.Tag) {
+		return false
+	}
+	return inTimeRange(item.GetCreatedAt(), q.Created) && inTimeRange(item.GetUpdatedAt(), q.Updated)
+}
+
+// MatchesWorld checks every world filter
+func (q *ListQuery) MatchesWorld(world *v1.World) bool {
+	return q.Matches(world) && (q.ParentWorldId == "" || world.ParentWorldId == q.ParentWorldId)
+}
+
+// MatchesGame checks every game filter.  The status is the game state's
+// status and is only looked at when filtering by status.
+func (q *ListQuery) MatchesGame(game *v1.Game, status v1.GameStatus) bool {
+	if !q.Matches(game) {
+		return false
+	}
+	if q.WorldId != "" && game.WorldId != q.WorldId {
+		return false
+	}
+	if q.Status != v1.GameStatus_GAME_STATUS_UNSPECIFIED && status != q.Status {
+		return false
+	}
+	return q.PlayerUserId == "" || slices.ContainsFunc(game.GetConfig().GetPlayers(), func(p *v1.GamePlayer) bool {
+		return p.UserId == q.PlayerUserId
+	})
+}
+
+func inTimeRange(ts *timestamppb.Timestamp, r *v1.TimeRange) bool {
+	if r == nil {
+		return true
+	}
+	t := ts.AsTime()
+	if r.Start != nil && t.Before(r.Start.AsTime()) {
+		return false
+	}
+	if r.End != nil && !t.Before(r.End.AsTime()) {
+		return false
+	}
+	return true
+}
+
+// ListPage collects the matching items of one page.  Backends feed it
+// candidates in sort order (already past the page key) until it is Full.
+type ListPage[T ListItem] struct {
+	Query *ListQuery
+
+	// Matches still to skip for offset based paging
+	skip    int
+	items   []T
+	hasMore bool
+}
+
+// NewListPage starts collecting a page.  skip is the number of matches to
+// drop first - the query's PageOffset if the backend could not push the
+// offset down to its store, otherwise 0.
+func NewListPage[T ListItem](q *ListQuery, skip int) *ListPage[T] {
+	return &ListPage[T]{Query: q, skip: skip}
+}
+
+// Add adds a matching item to the page
+func (p *ListPage[T]) Add(item T) {
+	if p.skip > 0 {
+		p.skip--
+		return
+	}
+	if len(p.items) < p.Query.PageSize {
+		p.items = append(p.items, item)
+	} else {
+		p.hasMore = true
+	}
+}
+
+// Full reports whether the page has all its items and it is known whether
+// more follow
+func (p *ListPage[T]) Full() bool {
+	return p.hasMore
+}
+
+// Items returns the items collected so far
+func (p *ListPage[T]) Items() []T {
+	return p.items
+}
+
+// Pagination returns the pagination response for the page.  total is the
+// number of matching items if the backend knows it, otherwise 0.
+func (p *ListPage[T]) Pagination(total int) *v1.PaginationResponse {
+	resp := &v1.PaginationResponse{HasMore: p.hasMore, TotalResults: int32(total)}
+	if p.hasMore && len(p.items) > 0 {
+		resp.NextPageKey = p.Query.PageKey(p.items[len(p.items)-1])
+		if p.Query.After == nil {
+			resp.NextPageOffset = int32(p.Query.PageOffset + len(p.items))
+		}
+	}
+	return resp
+}
+
+// PageItems filters, sorts and pages items held in memory
+func PageItems[T ListItem](q *ListQuery, items []T, matches func(T) bool) ([]T, *v1.PaginationResponse) {
+	var matching []T
+	for _, item := range items {
+		if matches(item) {
+			matching = append(matching, item)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool { return q.Less(matching[i], matching[j]) })
+
+	page := NewListPage[T](q, q.PageOffset)
+	for _, item := range matching {
+		if q.IsAfterCursor(item) {
+			page.Add(item)
+			if page.Full() {
+				break
+			}
+		}
+	}
+	return page.Items(), page.Pagination(len(matching))
+}
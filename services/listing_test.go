@@ -0,0 +1,150 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// listTestGames returns 5 games updated a minute apart (g0 oldest).  g1 and
+// g2 share an update time to exercise the ID tie break.
+func listTestGames() []*v1.Game {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	minutes := []int{0, 1, 1, 3, 4}
+	var games []*v1.Game
+	for i, m := range minutes {
+		ts := timestamppb.New(base.Add(time.Duration(m) * time.Minute))
+		game := &v1.Game{
+			Id:        string(rune('a'+i)) + "-game",
+			Name:      []string{"delta", "alpha", "echo", "charlie", "bravo"}[i],
+			CreatorId: []string{"u1", "u2"}[i%2],
+			WorldId:   "w1",
+			CreatedAt: ts,
+			UpdatedAt: ts,
+			Config: &v1.GameConfiguration{Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: "u1"},
+				{PlayerId: 2, UserId: []string{"u2", "u3"}[i%2]},
+			}},
+		}
+		if i%2 == 0 {
+			game.Tags = []string{"ranked"}
+		}
+		games = append(games, game)
+	}
+	return games
+}
+
+func gameIds(games []*v1.Game) (ids []string) {
+	for _, g := range games {
+		ids = append(ids, g.Id)
+	}
+	return
+}
+
+// listAllPages follows page keys and returns every page's IDs
+func listAllPages(t *testing.T, req *v1.ListGamesRequest, games []*v1.Game) (pages [][]string) {
+	t.Helper()
+	for {
+		query, err := NewGameListQuery(req)
+		if err != nil {
+			t.Fatalf("NewGameListQuery failed: %v", err)
+		}
+		items, pagination := PageItems(query, games, func(g *v1.Game) bool { return query.MatchesGame(g, 0) })
+		pages = append(pages, gameIds(items))
+		if !pagination.HasMore {
+			return
+		}
+		req.Pagination.PageKey = pagination.NextPageKey
+	}
+}
+
+func TestListQuery_PagesByKey(t *testing.T) {
+	games := listTestGames()
+	pages := listAllPages(t, &v1.ListGamesRequest{Pagination: &v1.Pagination{PageSize: 2}}, games)
+
+	// Newest first, ties in ascending ID order
+	want := [][]string{{"e-game", "d-game"}, {"b-game", "c-game"}, {"a-game"}}
+	if fmt.Sprint(pages) != fmt.Sprint(want) {
+		t.Errorf("Expected pages %v, got %v", want, pages)
+	}
+}
+
+func TestListQuery_PageKeySurvivesInserts(t *testing.T) {
+	games := listTestGames()
+	req := &v1.ListGamesRequest{Pagination: &v1.Pagination{PageSize: 2}}
+	query, _ := NewGameListQuery(req)
+	_, pagination := PageItems(query, games, func(g *v1.Game) bool { return true })
+
+	// A game updated after the first page was read must not shift page 2
+	newest := &v1.Game{Id: "z-game", UpdatedAt: timestamppb.Now(), CreatedAt: timestamppb.Now()}
+	req.Pagination.PageKey = pagination.NextPageKey
+	query, _ = NewGameListQuery(req)
+	items, _ := PageItems(query, append(games, newest), func(g *v1.Game) bool { return true })
+	if ids := gameIds(items); len(ids) != 2 || ids[0] != "b-game" {
+		t.Errorf("Expected page 2 to resume at b-game, got %v", ids)
+	}
+}
+
+func TestListQuery_Filters(t *testing.T) {
+	games := listTestGames()
+	tests := []struct {
+		name string
+		req  *v1.ListGamesRequest
+		want int
+	}{
+		{"owner", &v1.ListGamesRequest{OwnerId: "u1"}, 3},
+		{"player", &v1.ListGamesRequest{PlayerUserId: "u3"}, 2},
+		{"tag", &v1.ListGamesRequest{Tag: "ranked"}, 3},
+		{"world", &v1.ListGamesRequest{WorldId: "w2"}, 0},
+		{"updated range", &v1.ListGamesRequest{Updated: &v1.TimeRange{
+			Start: games[1].UpdatedAt,
+			End:   games[4].UpdatedAt,
+		}}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := NewGameListQuery(tt.req)
+			if err != nil {
+				t.Fatalf("NewGameListQuery failed: %v", err)
+			}
+			items, pagination := PageItems(query, games, func(g *v1.Game) bool { return query.MatchesGame(g, 0) })
+			if len(items) != tt.want || int(pagination.TotalResults) != tt.want {
+				t.Errorf("Expected %d games, got %v (total %d)", tt.want, gameIds(items), pagination.TotalResults)
+			}
+		})
+	}
+
+	// Status is only checked when filtering by status
+	query, _ := NewGameListQuery(&v1.ListGamesRequest{Status: v1.GameStatus_GAME_STATUS_ENDED})
+	if query.MatchesGame(games[0], v1.GameStatus_GAME_STATUS_PLAYING) || !query.MatchesGame(games[0], v1.GameStatus_GAME_STATUS_ENDED) {
+		t.Error("Status filter should only match games in that status")
+	}
+}
+
+func TestListQuery_SortAndOffset(t *testing.T) {
+	games := listTestGames()
+	query, err := NewGameListQuery(&v1.ListGamesRequest{
+		SortBy:     "name",
+		Pagination: &v1.Pagination{PageSize: 2, PageOffset: 2},
+	})
+	if err != nil {
+		t.Fatalf("NewGameListQuery failed: %v", err)
+	}
+	items, pagination := PageItems(query, games, func(g *v1.Game) bool { return true })
+	if ids := gameIds(items); len(ids) != 2 || items[0].Name != "charlie" || items[1].Name != "delta" {
+		t.Errorf("Expected charlie and delta on page 2 by name, got %v", ids)
+	}
+	if !pagination.HasMore || pagination.NextPageOffset != 4 {
+		t.Errorf("Expected more results at offset 4, got %+v", pagination)
+	}
+
+	if _, err := NewGameListQuery(&v1.ListGamesRequest{SortBy: "difficulty"}); err == nil {
+		t.Error("Unknown sort_by should be rejected")
+	}
+	if _, err := NewGameListQuery(&v1.ListGamesRequest{Pagination: &v1.Pagination{PageKey: "not-a-key"}}); err == nil {
+		t.Error("Malformed page_key should be rejected")
+	}
+}
@@ -0,0 +1,29 @@
+package services
+
+import (
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// EncodeMoveType serializes a move's action (its move_type oneof) for the
+// backends that store it in an opaque column.  The whole move is encoded
+// as JSON, which is what gormbe has always stored.
+func EncodeMoveType(move *v1.GameMove) ([]byte, error) {
+	if move.GetMoveType() == nil {
+		return nil, nil
+	}
+	return protojson.Marshal(move)
+}
+
+// DecodeMoveType restores the action saved by EncodeMoveType into move
+func DecodeMoveType(data []byte, move *v1.GameMove) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var saved v1.GameMove
+	if err := protojson.Unmarshal(data, &saved); err != nil {
+		return err
+	}
+	move.MoveType = saved.MoveType
+	return nil
+}
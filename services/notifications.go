@@ -0,0 +1,713 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// NotificationProfileKey is the key under which notification preferences are
+// stored in the user profile map.
+const NotificationProfileKey = "notifications"
+
+// NotificationPrefs are the per-user turn notification settings.
+// They are stored as a JSON object under NotificationProfileKey in the user
+// profile so no extra storage is needed by any backend.
+type NotificationPrefs struct {
+	// Send "your turn" emails to the profile email address
+	EmailEnabled bool `json:"email_enabled"`
+
+	// Optional webhook that receives a JSON POST for each delivery
+	WebhookURL string `json:"webhook_url,omitempty"`
+
+	// If set, webhook bodies are signed with HMAC-SHA256 in X-LilBattle-Signature
+	WebhookSecret string `json:"webhook_secret,omitempty"`
+
+	// If > 0, notifications are batched and delivered at most once per window
+	DigestMinutes int `json:"digest_minutes,omitempty"`
+
+	// Quiet hours as "HH:MM" in Timezone. Nothing is delivered between start
+	// and end; held notifications go out when quiet hours end. Windows may wrap
+	// midnight (eg 22:00 - 07:00). Empty or equal values disable quiet hours.
+	QuietHoursStart string `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string `json:"quiet_hours_end,omitempty"`
+
+	// IANA timezone name for quiet hours, defaults to UTC
+	Timezone string `json:"timezone,omitempty"`
+
+	// Games the user has opted out of notifications for
+	MutedGames []string `json:"muted_games,omitempty"`
+}
+
+// DefaultNotificationPrefs returns the preferences used when a user has not
+// configured anything: email on, no digest, no quiet hours.
+func DefaultNotificationPrefs() NotificationPrefs {
+	return NotificationPrefs{EmailEnabled: true}
+}
+
+// NotificationPrefsFromProfile extracts notification preferences from a user
+// profile map, falling back to defaults when missing or malformed.
+func NotificationPrefsFromProfile(profile map[string]any) NotificationPrefs {
+	prefs := DefaultNotificationPrefs()
+	raw, ok := profile[NotificationProfileKey]
+	if !ok || raw == nil {
+		return prefs
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return prefs
+	}
+	if err := json.Unmarshal(data, &prefs); err != nil {
+		return DefaultNotificationPrefs()
+	}
+	return prefs
+}
+
+// ToProfileValue converts prefs into a generic map suitable for storing in
+// the user profile.
+func (p NotificationPrefs) ToProfileValue() map[string]any {
+	out := map[string]any{}
+	data, _ := json.Marshal(p)
+	json.Unmarshal(data, &out)
+	return out
+}
+
+// Validate checks that the preferences are well formed.
+func (p NotificationPrefs) Validate() error {
+	if p.DigestMinutes < 0 || p.DigestMinutes > 24*60 {
+		return fmt.Errorf("digest_minutes must be between 0 and 1440")
+	}
+	if p.WebhookURL != "" {
+		if err := checkWebhookURL(p.WebhookURL); err != nil {
+			return fmt.Errorf("invalid webhook_url: %w", err)
+		}
+	}
+	if (p.QuietHoursStart == "") != (p.QuietHoursEnd == "") {
+		return fmt.Errorf("quiet hours need both a start and an end")
+	}
+	if p.QuietHoursStart != "" {
+		if _, err := parseClock(p.QuietHoursStart); err != nil {
+			return fmt.Errorf("invalid quiet_hours_start: %w", err)
+		}
+		if _, err := parseClock(p.QuietHoursEnd); err != nil {
+			return fmt.Errorf("invalid quiet_hours_end: %w", err)
+		}
+	}
+	if _, err := p.location(); err != nil {
+		return fmt.Errorf("invalid timezone: %w", err)
+	}
+	return nil
+}
+
+// IsMuted returns true if the user has opted out of notifications for gameId.
+func (p NotificationPrefs) IsMuted(gameId string) bool {
+	return slices.Contains(p.MutedGames, gameId)
+}
+
+// QuietUntil returns the time quiet hours end if now falls inside them,
+// otherwise the zero time.
+func (p NotificationPrefs) QuietUntil(now time.Time) time.Time {
+	if p.QuietHoursStart == "" || p.QuietHoursStart == p.QuietHoursEnd {
+		return time.Time{}
+	}
+	start, err1 := parseClock(p.QuietHoursStart)
+	end, err2 := parseClock(p.QuietHoursEnd)
+	loc, err3 := p.location()
+	if err1 != nil || err2 != nil || err3 != nil {
+		return time.Time{}
+	}
+
+	local := now.In(loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	minute := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute
+
+	if start < end {
+		// Same-day window, eg 13:00 - 15:00
+		if minute >= start && minute < end {
+			return midnight.Add(end)
+		}
+		return time.Time{}
+	}
+
+	// Window wraps midnight, eg 22:00 - 07:00
+	if minute >= start {
+		return midnight.AddDate(0, 0, 1).Add(end)
+	}
+	if minute < end {
+		return midnight.Add(end)
+	}
+	return time.Time{}
+}
+
+func (p NotificationPrefs) location() (*time.Location, error) {
+	if p.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(p.Timezone)
+}
+
+// parseClock parses "HH:MM" into an offset from midnight.
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// UserProfileProvider looks up user profiles by user ID.
+// The web layer implements this on top of the auth service user store.
+type UserProfileProvider interface {
+	GetUserProfile(ctx context.Context, userId string) (map[string]any, error)
+}
+
+// NotificationEmailSender sends a notification email.
+// ResendEmailSender implements this for production; ConsoleNotificationSender
+// is used for local runs.
+type NotificationEmailSender interface {
+	SendNotificationEmail(to string, subject string, htmlBody string) error
+}
+
+// ConsoleNotificationSender logs notification emails instead of sending them.
+type ConsoleNotificationSender struct{}
+
+func (c *ConsoleNotificationSender) SendNotificationEmail(to string, subject string, htmlBody string) error {
+	log.Printf("[notification email] to=%s subject=%q\n%s", to, subject, htmlBody)
+	return nil
+}
+
+// TurnNotification describes a single "your turn" event.
+type TurnNotification struct {
+	UserId      string    `json:"user_id"`
+	GameId      string    `json:"game_id"`
+	GameName    string    `json:"game_name"`
+	PlayerId    int32     `json:"player_id"`
+	TurnCounter int32     `json:"turn_counter"`
+	GameURL     string    `json:"game_url"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TurnNotificationJobType is the job delivering a user's turn notifications
+// when the notifier runs on a JobRunner
+const TurnNotificationJobType = "turn_notifications"
+
+// turnDigest is the data of a turn notification job - the notifications
+// waiting for a user.  Notifications that come in while the job runs are
+// marked with that run's ID so they are kept apart from the ones it is
+// delivering.
+type turnDigest struct {
+	Items []*TurnNotification `json:"items"`
+	RunId string              `json:"run_id,omitempty"`
+}
+
+// pendingDigest holds notifications for a user that are waiting for a digest
+// window or quiet hours to end.
+type pendingDigest struct {
+	items   []*TurnNotification
+	flushAt time.Time
+}
+
+// TurnNotifier sends "your turn" notifications over email and webhooks.
+// Deliveries respect per-user digest windows, quiet hours and per-game
+// opt-outs read from the user profile at notification time.
+type TurnNotifier struct {
+	Profiles   UserProfileProvider
+	Email      NotificationEmailSender
+	HTTPClient *http.Client
+
+	// Base URL used to build game links, eg https://lilbattle.com
+	BaseURL string
+
+	// How often held notifications are checked for delivery
+	FlushInterval time.Duration
+
+	// Clock - overridable for tests
+	Now func() time.Time
+
+	// Jobs, when set by UseJobRunner, holds and delivers notifications as a
+	// job per user instead of in memory
+	Jobs *JobRunner
+
+	mu      sync.Mutex
+	pending map[string]*pendingDigest
+	stop    chan bool
+}
+
+// NewTurnNotifier creates a notifier. Call Start to begin delivering held
+// (digested or quiet-hour) notifications.
+func NewTurnNotifier(profiles UserProfileProvider, email NotificationEmailSender, baseURL string) *TurnNotifier {
+	return &TurnNotifier{
+		Profiles:      profiles,
+		Email:         email,
+		HTTPClient:    NewWebhookClient(),
+		BaseURL:       strings.TrimSuffix(baseURL, "/"),
+		FlushInterval: time.Minute,
+		Now:           time.Now,
+		pending:       make(map[string]*pendingDigest),
+	}
+}
+
+// UseJobRunner delivers notifications as a job per user on runner, so held
+// digests survive restarts and failed deliveries are retried.
+func (n *TurnNotifier) UseJobRunner(runner *JobRunner) {
+	n.Jobs = runner
+	RegisterJobHandler(runner, TurnNotificationJobType, n.deliverDigest)
+}
+
+// Start launches the background loop delivering held notifications.
+func (n *TurnNotifier) Start() {
+	n.mu.Lock()
+	if n.stop != nil {
+		n.mu.Unlock()
+		return
+	}
+	n.stop = make(chan bool)
+	stop := n.stop
+	n.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(n.FlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				n.FlushDue(context.Background())
+			}
+		}
+	}()
+}
+
+// Stop halts the background delivery loop. Held notifications stay queued.
+func (n *TurnNotifier) Stop() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.stop != nil {
+		close(n.stop)
+		n.stop = nil
+	}
+}
+
+// NotifyTurn notifies the user controlling currentPlayer that it is their turn.
+// AI players, open slots and games the user has muted are skipped.
+func (n *TurnNotifier) NotifyTurn(ctx context.Context, game *v1.Game, currentPlayer int32, turnCounter int32) error {
+	if game == nil || game.Config == nil {
+		return nil
+	}
+	var player *v1.GamePlayer
+	for _, p := range game.Config.Players {
+		if p.PlayerId == currentPlayer {
+			player = p
+			break
+		}
+	}
+	if player == nil || player.UserId == "" || player.PlayerType != "human" {
+		return nil
+	}
+
+	return n.Enqueue(ctx, &TurnNotification{
+		UserId:      player.UserId,
+		GameId:      game.Id,
+		GameName:    game.Name,
+		PlayerId:    currentPlayer,
+		TurnCounter: turnCounter,
+		GameURL:     fmt.Sprintf("%s/games/%s/view", n.BaseURL, game.Id),
+		CreatedAt:   n.Now(),
+	})
+}
+
+// Enqueue delivers a notification now or holds it for a digest window or
+// until quiet hours end, depending on the user's preferences.
+func (n *TurnNotifier) Enqueue(ctx context.Context, notif *TurnNotification) error {
+	profile, err := n.Profiles.GetUserProfile(ctx, notif.UserId)
+	if err != nil {
+		return fmt.Errorf("failed to load profile for %s: %w", notif.UserId, err)
+	}
+	prefs := NotificationPrefsFromProfile(profile)
+	if prefs.IsMuted(notif.GameId) {
+		return nil
+	}
+
+	now := n.Now()
+	if n.Jobs != nil {
+		flushAt := now.Add(time.Duration(prefs.DigestMinutes) * time.Minute)
+		if quietUntil := prefs.QuietUntil(now); quietUntil.After(flushAt) {
+			flushAt = quietUntil
+		}
+		return n.queueDigest(ctx, notif.UserId, []*TurnNotification{notif}, flushAt)
+	}
+
+	n.mu.Lock()
+	digest := n.pending[notif.UserId]
+	if digest == nil {
+		quietUntil := prefs.QuietUntil(now)
+		if prefs.DigestMinutes <= 0 && quietUntil.IsZero() {
+			n.mu.Unlock()
+			return n.deliver(ctx, notif.UserId, profile, prefs, []*TurnNotification{notif})
+		}
+		flushAt := now.Add(time.Duration(prefs.DigestMinutes) * time.Minute)
+		if quietUntil.After(flushAt) {
+			flushAt = quietUntil
+		}
+		digest = &pendingDigest{flushAt: flushAt}
+		n.pending[notif.UserId] = digest
+	}
+
+	// Only the latest turn matters per game
+	digest.items = slices.DeleteFunc(digest.items, func(item *TurnNotification) bool {
+		return item.GameId == notif.GameId
+	})
+	digest.items = append(digest.items, notif)
+	n.mu.Unlock()
+	return nil
+}
+
+// queueDigest adds items to the user's turn notification job.  A new job
+// runs at flushAt; a pending one keeps its time so a digest window is not
+// pushed back by every turn.
+func (n *TurnNotifier) queueDigest(ctx context.Context, userId string, items []*TurnNotification, flushAt time.Time) error {
+	job := &v1.Job{JobType: TurnNotificationJobType, EntityType: "users", EntityId: userId}
+	_, err := n.Jobs.EnqueueMerged(ctx, job, func(job, stored *v1.Job) (*v1.Job, error) {
+		now := n.Jobs.Now()
+		wait := secondsUntil(now, flushAt)
+		var digest turnDigest
+		switch stored.GetStatus() {
+		case v1.JobStatus_JOB_STATUS_PENDING:
+			digest = decodeTurnDigest(stored)
+			wait = secondsUntil(now, stored.RunAfter.AsTime())
+		case v1.JobStatus_JOB_STATUS_RUNNING:
+			// The job's own items are being delivered - only add to those
+			// that came in during this run
+			if current := decodeTurnDigest(stored); current.RunId == stored.LastRunId {
+				digest = current
+				wait = max(wait, stored.DebounceWindowSeconds)
+			}
+			digest.RunId = stored.LastRunId
+		}
+
+		// Only the latest turn matters per game
+		for _, item := range items {
+			digest.Items = slices.DeleteFunc(digest.Items, func(held *TurnNotification) bool {
+				return held.GameId == item.GameId
+			})
+			digest.Items = append(digest.Items, item)
+		}
+		body, err := json.Marshal(digest)
+		if err != nil {
+			return nil, err
+		}
+		queued, err := NewJob(job.JobType, job.EntityType, job.EntityId, &wrapperspb.BytesValue{Value: body})
+		if err != nil {
+			return nil, err
+		}
+		queued.DebounceWindowSeconds = wait
+		return queued, nil
+	})
+	return err
+}
+
+// deliverDigest runs a turn notification job, delivering the user's held
+// notifications or holding them again if quiet hours have started
+func (n *TurnNotifier) deliverDigest(ctx context.Context, job *v1.Job, data *wrapperspb.BytesValue) error {
+	var digest turnDigest
+	if err := json.Unmarshal(data.Value, &digest); err != nil {
+		return fmt.Errorf("invalid turn notifications: %w", err)
+	}
+	userId := job.EntityId
+	profile, err := n.Profiles.GetUserProfile(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("failed to load profile for %s: %w", userId, err)
+	}
+	prefs := NotificationPrefsFromProfile(profile)
+
+	// Preferences may have changed while the digest was held
+	items := slices.DeleteFunc(digest.Items, func(item *TurnNotification) bool {
+		return prefs.IsMuted(item.GameId)
+	})
+	if len(items) == 0 {
+		return nil
+	}
+	if quietUntil := prefs.QuietUntil(n.Now()); !quietUntil.IsZero() {
+		return n.queueDigest(ctx, userId, items, quietUntil)
+	}
+	return n.deliver(ctx, userId, profile, prefs, items)
+}
+
+// decodeTurnDigest returns the notifications held in a turn notification job
+func decodeTurnDigest(job *v1.Job) (digest turnDigest) {
+	var data wrapperspb.BytesValue
+	if job.JobData == nil || job.JobData.UnmarshalTo(&data) != nil {
+		return digest
+	}
+	if err := json.Unmarshal(data.Value, &digest); err != nil {
+		log.Printf("Dropping invalid turn notifications of job %s: %v", job.Id, err)
+	}
+	return digest
+}
+
+// secondsUntil returns the whole seconds from now until t, rounded up and
+// at least 0
+func secondsUntil(now, t time.Time) int32 {
+	return int32(max((t.Sub(now)+time.Second-1)/time.Second, 0))
+}
+
+// FlushDue delivers all held notifications whose window has elapsed.
+func (n *TurnNotifier) FlushDue(ctx context.Context) {
+	now := n.Now()
+	due := map[string][]*TurnNotification{}
+	n.mu.Lock()
+	for userId, digest := range n.pending {
+		if !digest.flushAt.After(now) {
+			due[userId] = digest.items
+			delete(n.pending, userId)
+		}
+	}
+	n.mu.Unlock()
+
+	for userId, items := range due {
+		profile, err := n.Profiles.GetUserProfile(ctx, userId)
+		if err != nil {
+			log.Printf("Failed to load profile for %s, dropping %d notifications: %v", userId, len(items), err)
+			continue
+		}
+		prefs := NotificationPrefsFromProfile(profile)
+
+		// Preferences may have changed while the digest was held
+		items = slices.DeleteFunc(items, func(item *TurnNotification) bool {
+			return prefs.IsMuted(item.GameId)
+		})
+		if len(items) == 0 {
+			continue
+		}
+		if quietUntil := prefs.QuietUntil(now); !quietUntil.IsZero() {
+			n.mu.Lock()
+			n.pending[userId] = &pendingDigest{items: items, flushAt: quietUntil}
+			n.mu.Unlock()
+			continue
+		}
+		if err := n.deliver(ctx, userId, profile, prefs, items); err != nil {
+			log.Printf("Failed to deliver notifications to %s: %v", userId, err)
+		}
+	}
+}
+
+// PendingCount returns the number of notifications held for a user.
+func (n *TurnNotifier) PendingCount(userId string) int {
+	if n.Jobs != nil {
+		job, err := n.Jobs.Store.LoadJob(context.Background(), JobID(TurnNotificationJobType, "users", userId))
+		if err != nil || job.Status != v1.JobStatus_JOB_STATUS_PENDING {
+			return 0
+		}
+		return len(decodeTurnDigest(job).Items)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if digest := n.pending[userId]; digest != nil {
+		return len(digest.items)
+	}
+	return 0
+}
+
+// deliver sends the notifications over every channel the user has enabled.
+// Errors from individual channels are collected so one failing channel does
+// not block the others.
+func (n *TurnNotifier) deliver(ctx context.Context, userId string, profile map[string]any, prefs NotificationPrefs, items []*TurnNotification) error {
+	var errs []string
+
+	if prefs.EmailEnabled && n.Email != nil {
+		if email, _ := profile["email"].(string); email != "" {
+			subject, body := renderTurnEmail(items)
+			if err := n.Email.SendNotificationEmail(email, subject, body); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+	}
+
+	if prefs.WebhookURL != "" {
+		if err := n.postWebhook(ctx, userId, prefs, items); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("notification delivery failed: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// postWebhook POSTs the notifications as JSON to the user's webhook.
+func (n *TurnNotifier) postWebhook(ctx context.Context, userId string, prefs NotificationPrefs, items []*TurnNotification) error {
+	body, err := json.Marshal(map[string]any{
+		"event":         "turn",
+		"user_id":       userId,
+		"notifications": items,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Prefs saved before webhooks were restricted may still hold a bad URL
+	if err := checkWebhookURL(prefs.WebhookURL); err != nil {
+		return fmt.Errorf("invalid webhook_url: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, prefs.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("invalid webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if prefs.WebhookSecret != "" {
+		req.Header.Set("X-LilBattle-Signature", "sha256="+SignWebhookBody(prefs.WebhookSecret, body))
+	}
+
+	resp, err := n.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// checkWebhookURL checks a webhook is an https URL whose host is not a
+// loopback, private, link-local or metadata address.  Hostnames are checked
+// again when dialing (see NewWebhookClient) as they can resolve to anything.
+func checkWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("must be an https URL")
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || host == "metadata.google.internal" {
+		return fmt.Errorf("host %s is not allowed", host)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && isBlockedWebhookAddr(addr) {
+		return fmt.Errorf("address %s is not allowed", host)
+	}
+	return nil
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598)
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// isBlockedWebhookAddr returns true for addresses webhooks must not reach:
+// loopback, private, link-local (including the 169.254.169.254 cloud
+// metadata service), unspecified, multicast and shared address space.
+func isBlockedWebhookAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() || sharedAddressSpace.Contains(addr)
+}
+
+// NewWebhookClient returns the HTTP client webhooks are delivered with.  The
+// address each connection is made to is checked after DNS resolution, so a
+// hostname resolving (or rebinding) to an internal address is refused, as
+// are redirects away from https.  Proxies are not used as they would dial
+// on the client's behalf.
+func NewWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if isBlockedWebhookAddr(addrPort.Addr()) {
+				return fmt.Errorf("webhook address %s is not allowed", addrPort.Addr())
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 3 {
+				return fmt.Errorf("too many webhook redirects")
+			}
+			return checkWebhookURL(req.URL.String())
+		},
+	}
+}
+
+// SignWebhookBody returns the hex HMAC-SHA256 of body with secret.
+// Receivers compare this against the X-LilBattle-Signature header.
+func SignWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// renderTurnEmail builds the subject and HTML body for a set of notifications.
+func renderTurnEmail(items []*TurnNotification) (subject string, body string) {
+	if len(items) == 1 {
+		subject = fmt.Sprintf("It's your turn in %s", gameDisplayName(items[0]))
+	} else {
+		subject = fmt.Sprintf("It's your turn in %d games", len(items))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<div style="font-family: sans-serif; max-width: 600px; margin: 0 auto;">`)
+	sb.WriteString("\n  <h2>It's your turn</h2>\n  <ul>\n")
+	for _, item := range items {
+		fmt.Fprintf(&sb, `    <li><a href="%s">%s</a> (turn %d)</li>`+"\n",
+			html.EscapeString(item.GameURL), html.EscapeString(gameDisplayName(item)), item.TurnCounter)
+	}
+	sb.WriteString("  </ul>\n")
+	sb.WriteString(`  <p style="color: #666; font-size: 14px;">You can change notification settings on your profile page.</p>`)
+	sb.WriteString("\n</div>")
+	return subject, sb.String()
+}
+
+func gameDisplayName(item *TurnNotification) string {
+	if item.GameName != "" {
+		return item.GameName
+	}
+	return item.GameId
+}
+
+// MovesChangeTurn returns true if any move in the group handed the turn to
+// another player.
+func MovesChangeTurn(moves []*v1.GameMove) bool {
+	for _, move := range moves {
+		for _, change := range move.Changes {
+			if change.GetPlayerChanged() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,300 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+type mapProfileProvider map[string]map[string]any
+
+func (m mapProfileProvider) GetUserProfile(_ context.Context, userId string) (map[string]any, error) {
+	return m[userId], nil
+}
+
+type recordingEmailSender struct {
+	mu       sync.Mutex
+	to       []string
+	subjects []string
+}
+
+func (r *recordingEmailSender) SendNotificationEmail(to string, subject string, htmlBody string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.to = append(r.to, to)
+	r.subjects = append(r.subjects, subject)
+	return nil
+}
+
+func notifyGame() *v1.Game {
+	return &v1.Game{
+		Id:   "g1",
+		Name: "Island Duel",
+		Config: &v1.GameConfiguration{
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: "alice", PlayerType: "human"},
+				{PlayerId: 2, UserId: "bob", PlayerType: "human"},
+				{PlayerId: 3, PlayerType: "ai"},
+			},
+		},
+	}
+}
+
+func newTestNotifier(profiles mapProfileProvider, now time.Time) (*TurnNotifier, *recordingEmailSender, *time.Time) {
+	email := &recordingEmailSender{}
+	clock := now
+	n := NewTurnNotifier(profiles, email, "https://lilbattle.test")
+	n.Now = func() time.Time { return clock }
+	return n, email, &clock
+}
+
+func TestNotificationPrefsFromProfile_Defaults(t *testing.T) {
+	prefs := NotificationPrefsFromProfile(map[string]any{"email": "a@b.c"})
+	if !prefs.EmailEnabled {
+		t.Errorf("email should be enabled by default")
+	}
+	if prefs.DigestMinutes != 0 || prefs.WebhookURL != "" {
+		t.Errorf("unexpected defaults: %+v", prefs)
+	}
+
+	stored := NotificationPrefs{EmailEnabled: false, DigestMinutes: 30, MutedGames: []string{"g1"}}
+	prefs = NotificationPrefsFromProfile(map[string]any{NotificationProfileKey: stored.ToProfileValue()})
+	if prefs.EmailEnabled || prefs.DigestMinutes != 30 || !prefs.IsMuted("g1") {
+		t.Errorf("round trip mismatch: %+v", prefs)
+	}
+}
+
+func TestNotificationPrefs_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefs   NotificationPrefs
+		wantErr bool
+	}{
+		{"defaults", DefaultNotificationPrefs(), false},
+		{"quiet hours", NotificationPrefs{QuietHoursStart: "22:00", QuietHoursEnd: "07:00", Timezone: "Europe/London"}, false},
+		{"half quiet hours", NotificationPrefs{QuietHoursStart: "22:00"}, true},
+		{"bad clock", NotificationPrefs{QuietHoursStart: "25:00", QuietHoursEnd: "07:00"}, true},
+		{"bad timezone", NotificationPrefs{Timezone: "Mars/Olympus"}, true},
+		{"negative digest", NotificationPrefs{DigestMinutes: -1}, true},
+		{"non http webhook", NotificationPrefs{WebhookURL: "ftp://x"}, true},
+		{"https webhook", NotificationPrefs{WebhookURL: "https://hooks.example.com/lilbattle"}, false},
+		{"plain http webhook", NotificationPrefs{WebhookURL: "http://hooks.example.com/lilbattle"}, true},
+		{"loopback webhook", NotificationPrefs{WebhookURL: "https://127.0.0.1:8080/"}, true},
+		{"localhost webhook", NotificationPrefs{WebhookURL: "https://localhost/"}, true},
+		{"private webhook", NotificationPrefs{WebhookURL: "https://10.1.2.3/"}, true},
+		{"metadata webhook", NotificationPrefs{WebhookURL: "https://169.254.169.254/latest/meta-data"}, true},
+		{"mapped loopback webhook", NotificationPrefs{WebhookURL: "https://[::ffff:127.0.0.1]/"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.prefs.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNotificationPrefs_QuietUntil(t *testing.T) {
+	prefs := NotificationPrefs{QuietHoursStart: "22:00", QuietHoursEnd: "07:00"}
+	tests := []struct {
+		now  time.Time
+		want time.Time
+	}{
+		{time.Date(2026, 1, 1, 23, 30, 0, 0, time.UTC), time.Date(2026, 1, 2, 7, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC), time.Date(2026, 1, 2, 7, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC), time.Time{}},
+		{time.Date(2026, 1, 2, 7, 0, 0, 0, time.UTC), time.Time{}},
+	}
+	for _, tt := range tests {
+		got := prefs.QuietUntil(tt.now)
+		if !got.Equal(tt.want) {
+			t.Errorf("QuietUntil(%v) = %v, want %v", tt.now, got, tt.want)
+		}
+	}
+
+	sameDay := NotificationPrefs{QuietHoursStart: "13:00", QuietHoursEnd: "15:00"}
+	if got := sameDay.QuietUntil(time.Date(2026, 1, 2, 14, 0, 0, 0, time.UTC)); !got.Equal(time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)) {
+		t.Errorf("same day window: got %v", got)
+	}
+}
+
+func TestTurnNotifier_ImmediateEmail(t *testing.T) {
+	profiles := mapProfileProvider{"bob": {"email": "bob@example.com"}}
+	n, email, _ := newTestNotifier(profiles, time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC))
+
+	if err := n.NotifyTurn(context.Background(), notifyGame(), 2, 5); err != nil {
+		t.Fatalf("NotifyTurn: %v", err)
+	}
+	if len(email.to) != 1 || email.to[0] != "bob@example.com" {
+		t.Fatalf("expected one email to bob, got %v", email.to)
+	}
+	if email.subjects[0] != "It's your turn in Island Duel" {
+		t.Errorf("subject = %q", email.subjects[0])
+	}
+}
+
+func TestTurnNotifier_SkipsAIAndMuted(t *testing.T) {
+	mutedPrefs := NotificationPrefs{EmailEnabled: true, MutedGames: []string{"g1"}}
+	profiles := mapProfileProvider{
+		"bob": {"email": "bob@example.com", NotificationProfileKey: mutedPrefs.ToProfileValue()},
+	}
+	n, email, _ := newTestNotifier(profiles, time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC))
+
+	n.NotifyTurn(context.Background(), notifyGame(), 3, 5) // AI player
+	n.NotifyTurn(context.Background(), notifyGame(), 2, 5) // muted
+	if len(email.to) != 0 {
+		t.Errorf("expected no emails, got %v", email.to)
+	}
+}
+
+func TestTurnNotifier_DigestBatchesAndDedupes(t *testing.T) {
+	digestPrefs := NotificationPrefs{EmailEnabled: true, DigestMinutes: 30}
+	profiles := mapProfileProvider{
+		"bob": {"email": "bob@example.com", NotificationProfileKey: digestPrefs.ToProfileValue()},
+	}
+	n, email, clock := newTestNotifier(profiles, time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC))
+
+	other := notifyGame()
+	other.Id = "g2"
+	n.NotifyTurn(context.Background(), notifyGame(), 2, 5)
+	n.NotifyTurn(context.Background(), notifyGame(), 2, 6)
+	n.NotifyTurn(context.Background(), other, 2, 1)
+
+	if len(email.to) != 0 {
+		t.Fatalf("digest should hold emails, got %v", email.to)
+	}
+	if got := n.PendingCount("bob"); got != 2 {
+		t.Fatalf("PendingCount = %d, want 2 (one per game)", got)
+	}
+
+	*clock = clock.Add(10 * time.Minute)
+	n.FlushDue(context.Background())
+	if len(email.to) != 0 {
+		t.Fatalf("flushed before digest window elapsed")
+	}
+
+	*clock = clock.Add(25 * time.Minute)
+	n.FlushDue(context.Background())
+	if len(email.to) != 1 || email.subjects[0] != "It's your turn in 2 games" {
+		t.Fatalf("expected one digest email, got %v %v", email.to, email.subjects)
+	}
+	if n.PendingCount("bob") != 0 {
+		t.Errorf("digest should be cleared after flush")
+	}
+}
+
+func TestTurnNotifier_QuietHoursHold(t *testing.T) {
+	quietPrefs := NotificationPrefs{EmailEnabled: true, QuietHoursStart: "22:00", QuietHoursEnd: "07:00"}
+	profiles := mapProfileProvider{
+		"bob": {"email": "bob@example.com", NotificationProfileKey: quietPrefs.ToProfileValue()},
+	}
+	n, email, clock := newTestNotifier(profiles, time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC))
+
+	n.NotifyTurn(context.Background(), notifyGame(), 2, 5)
+	n.FlushDue(context.Background())
+	if len(email.to) != 0 {
+		t.Fatalf("email sent during quiet hours")
+	}
+
+	*clock = time.Date(2026, 1, 2, 7, 1, 0, 0, time.UTC)
+	n.FlushDue(context.Background())
+	if len(email.to) != 1 {
+		t.Fatalf("expected email after quiet hours, got %d", len(email.to))
+	}
+}
+
+func TestTurnNotifier_SignedWebhook(t *testing.T) {
+	var gotSig string
+	var gotBody []byte
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSig = r.Header.Get("X-LilBattle-Signature")
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	// The test server is on loopback, which the webhook client refuses, so
+	// the test server's own client is used; the URL is rewritten from an
+	// allowed host
+	hookPrefs := NotificationPrefs{WebhookURL: strings.Replace(srv.URL, "127.0.0.1", "hooks.example.com", 1), WebhookSecret: "s3cret"}
+	profiles := mapProfileProvider{"bob": {NotificationProfileKey: hookPrefs.ToProfileValue()}}
+	n, email, _ := newTestNotifier(profiles, time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC))
+	client := srv.Client()
+	client.Transport.(*http.Transport).DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, srv.Listener.Addr().String())
+	}
+	n.HTTPClient = client
+
+	if err := n.NotifyTurn(context.Background(), notifyGame(), 2, 5); err != nil {
+		t.Fatalf("NotifyTurn: %v", err)
+	}
+	if len(email.to) != 0 {
+		t.Errorf("email disabled but sent")
+	}
+	if gotSig != "sha256="+SignWebhookBody("s3cret", gotBody) {
+		t.Errorf("signature mismatch: %q", gotSig)
+	}
+
+	var payload struct {
+		Event         string              `json:"event"`
+		Notifications []*TurnNotification `json:"notifications"`
+	}
+	if err := json.Unmarshal(gotBody, &payload); err != nil {
+		t.Fatalf("bad payload: %v", err)
+	}
+	if payload.Event != "turn" || len(payload.Notifications) != 1 || payload.Notifications[0].GameURL != "https://lilbattle.test/games/g1/view" {
+		t.Errorf("unexpected payload: %s", gotBody)
+	}
+}
+
+// TestWebhookClient_BlocksInternalAddresses tests that webhooks are not
+// delivered to internal addresses however the URL reached them
+func TestWebhookClient_BlocksInternalAddresses(t *testing.T) {
+	var hits int
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	// The client checks the address it dials, whatever the URL's host
+	// resolved to
+	client := NewWebhookClient()
+	client.Transport.(*http.Transport).TLSClientConfig = srv.Client().Transport.(*http.Transport).TLSClientConfig
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader("{}"))
+	if err == nil {
+		resp.Body.Close()
+	}
+	if err == nil || !strings.Contains(err.Error(), "not allowed") || hits != 0 {
+		t.Errorf("Expected the loopback dial refused, got %v with %d hits", err, hits)
+	}
+
+	// Stored prefs with a bad URL are refused before any request
+	n, _, _ := newTestNotifier(mapProfileProvider{}, time.Now())
+	n.HTTPClient = srv.Client()
+	if err := n.postWebhook(context.Background(), "bob", NotificationPrefs{WebhookURL: srv.URL}, nil); err == nil || hits != 0 {
+		t.Errorf("Expected the http loopback webhook refused, got %v with %d hits", err, hits)
+	}
+}
+
+func TestMovesChangeTurn(t *testing.T) {
+	noTurn := []*v1.GameMove{{Changes: []*v1.WorldChange{{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: &v1.UnitMovedChange{}}}}}}
+	if MovesChangeTurn(noTurn) {
+		t.Errorf("unit move should not count as a turn change")
+	}
+	withTurn := append(noTurn, &v1.GameMove{Changes: []*v1.WorldChange{{ChangeType: &v1.WorldChange_PlayerChanged{PlayerChanged: &v1.PlayerChangedChange{NewPlayer: 2}}}}})
+	if !MovesChangeTurn(withTurn) {
+		t.Errorf("player change should count as a turn change")
+	}
+}
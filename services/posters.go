@@ -0,0 +1,48 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// Posters are printable maps of a world or game position (ww worlds export
+// and /worlds/{id}/poster.pdf).  Like replays they are rendered on demand
+// rather than stored.
+
+// RenderWorldPoster renders a world's map in a theme as a PDF or SVG poster
+// (themes.PosterFormatPDF/SVG).  The world's crossings are drawn unless the
+// options set their own.  PDF posters need a PNG asset theme.
+func RenderWorldPoster(worldData *v1.WorldData, themeName, format string, options *themes.PosterOptions) ([]byte, string, error) {
+	if themeName == "" {
+		themeName = "default"
+	}
+	theme, err := themes.CreateTheme(themeName, lib.DefaultRulesEngine().GetCityTerrains())
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create theme %s: %w", themeName, err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create renderer for theme %s: %w", themeName, err)
+	}
+
+	out := themes.DefaultPosterOptions()
+	if options != nil {
+		*out = *options
+	}
+	render := lib.DefaultRenderOptions()
+	if out.Render != nil {
+		copied := *out.Render
+		render = &copied
+	}
+	if render.Crossings == nil {
+		render.Crossings = worldData.GetCrossings()
+	}
+	out.Render = render
+	return themes.RenderPoster(renderer, worldData.GetTilesMap(), worldData.GetUnitsMap(), format, out)
+}
@@ -169,7 +169,8 @@ func (s *R2FileStoreService) DeleteFile(ctx context.Context, req *v1.DeleteFileR
 	}, nil
 }
 
-// ListFiles lists files in R2 with a given prefix
+// ListFiles lists files in R2 with a given prefix.  Page keys are R2
+// continuation tokens.
 func (s *R2FileStoreService) ListFiles(ctx context.Context, req *v1.ListFilesRequest) (*v1.ListFilesResponse, error) {
 	prefix := req.Path
 	if prefix != "" {
@@ -188,7 +189,12 @@ func (s *R2FileStoreService) ListFiles(ctx context.Context, req *v1.ListFilesReq
 		maxKeys = req.Pagination.PageSize
 	}
 
-	objects, err := s.Client.ListObjects(ctx, prefix, maxKeys)
+	pageKey := ""
+	if req.Pagination != nil {
+		pageKey = req.Pagination.PageKey
+	}
+
+	objects, nextPageKey, err := s.Client.ListObjectsPage(ctx, prefix, req.Recursive, maxKeys, pageKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list files: %w", err)
 	}
@@ -221,7 +227,8 @@ func (s *R2FileStoreService) ListFiles(ctx context.Context, req *v1.ListFilesReq
 		Items: items,
 		Pagination: &v1.PaginationResponse{
 			TotalResults: int32(len(items)),
-			HasMore:      len(items) >= int(maxKeys),
+			HasMore:      nextPageKey != "",
+			NextPageKey:  nextPageKey,
 		},
 	}, nil
 }
@@ -145,13 +145,28 @@ func (r *R2Client) List(ctx context.Context, prefix string, maxKeys int32) ([]st
 
 // ListObjects lists objects with a given prefix and returns full metadata
 func (r *R2Client) ListObjects(ctx context.Context, prefix string, maxKeys int32) ([]ObjectInfo, error) {
-	output, err := r.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+	objects, _, err := r.ListObjectsPage(ctx, prefix, true, maxKeys, "")
+	return objects, err
+}
+
+// ListObjectsPage lists a page of the objects with a given prefix.  Unless
+// recursive only objects directly under the prefix are listed.  The returned
+// token continues the listing and is empty after the last page.
+func (r *R2Client) ListObjectsPage(ctx context.Context, prefix string, recursive bool, maxKeys int32, pageToken string) ([]ObjectInfo, string, error) {
+	input := &s3.ListObjectsV2Input{
 		Bucket:  aws.String(r.bucket),
 		Prefix:  aws.String(prefix),
 		MaxKeys: aws.Int32(maxKeys),
-	})
+	}
+	if !recursive {
+		input.Delimiter = aws.String("/")
+	}
+	if pageToken != "" {
+		input.ContinuationToken = aws.String(pageToken)
+	}
+	output, err := r.client.ListObjectsV2(ctx, input)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list objects: %w", err)
+		return nil, "", fmt.Errorf("failed to list objects: %w", err)
 	}
 
 	objects := make([]ObjectInfo, 0, len(output.Contents))
@@ -167,5 +182,9 @@ func (r *R2Client) ListObjects(ctx context.Context, prefix string, maxKeys int32
 		}
 		objects = append(objects, info)
 	}
-	return objects, nil
+	nextToken := ""
+	if aws.ToBool(output.IsTruncated) && output.NextContinuationToken != nil {
+		nextToken = *output.NextContinuationToken
+	}
+	return objects, nextToken, nil
 }
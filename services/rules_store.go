@@ -0,0 +1,72 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RulesStore keeps rules and damage data other than the server's own,
+// keyed by their rules version (lib.RulesVersion), so games imported from
+// archives are played with the rules they were played with after the
+// server's rules change.
+type RulesStore interface {
+	// SaveRules stores rules under their version.  Versions are content
+	// hashes so saving one that already exists leaves it alone.
+	SaveRules(ctx context.Context, version string, rulesJSON, damageJSON []byte) error
+
+	// LoadRules returns the rules of a version, or a NotFound error
+	LoadRules(ctx context.Context, version string) (rulesJSON, damageJSON []byte, err error)
+}
+
+// rulesEngines caches the rules engines loaded from a RulesStore by version
+var rulesEngines sync.Map
+
+// RulesEngineFor returns the rules engine a game is played with - the
+// default one unless the game records another rules version, which is then
+// loaded from the RulesStore
+func (s *BackendGamesService) RulesEngineFor(ctx context.Context, game *v1.Game) (*lib.RulesEngine, error) {
+	version := game.GetRulesVersion()
+	if version == "" || version == lib.DefaultRulesVersion() {
+		return lib.DefaultRulesEngine(), nil
+	}
+	if re, ok := rulesEngines.Load(version); ok {
+		return re.(*lib.RulesEngine), nil
+	}
+	if s.RulesStore == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "game %s is played with rules version %s which this server does not keep",
+			game.Id, version)
+	}
+	rulesJSON, damageJSON, err := s.RulesStore.LoadRules(ctx, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rules version %s of game %s: %w", version, game.Id, err)
+	}
+	re, err := lib.LoadRulesEngineFromJSON(rulesJSON, damageJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rules version %s: %w", version, err)
+	}
+	actual, _ := rulesEngines.LoadOrStore(version, re)
+	return actual.(*lib.RulesEngine), nil
+}
+
+// rulesJSONFor returns the rules and damage data a game is played with
+func (s *BackendGamesService) rulesJSONFor(ctx context.Context, game *v1.Game) (rulesJSON, damageJSON []byte, err error) {
+	version := game.GetRulesVersion()
+	if version == "" || version == lib.DefaultRulesVersion() {
+		rulesJSON, damageJSON = lib.DefaultRulesJSON()
+		return rulesJSON, damageJSON, nil
+	}
+	if s.RulesStore == nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "game %s is played with rules version %s which this server does not keep",
+			game.Id, version)
+	}
+	return s.RulesStore.LoadRules(ctx, version)
+}
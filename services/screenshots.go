@@ -5,14 +5,39 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/panyam/gocurrent"
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	lib "github.com/turnforge/lilbattle/lib"
 	"github.com/turnforge/lilbattle/web/assets/themes"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScreenshotThemes are the themes every world and game is rendered in
+var ScreenshotThemes = []string{"default", "modern", "fantasy"}
+
+// Screenshot render metrics, exported through the global OpenTelemetry
+// meter provider
+var (
+	screenshotRenderDuration, _ = otel.Meter("github.com/turnforge/lilbattle/services").Float64Histogram(
+		"lilbattle.screenshot.render.duration",
+		metric.WithDescription("Time taken by one attempt to render and upload a screenshot"),
+		metric.WithUnit("s"),
+	)
+	screenshotRenders, _ = otel.Meter("github.com/turnforge/lilbattle/services").Int64Counter(
+		"lilbattle.screenshot.renders",
+		metric.WithDescription("Screenshots rendered, by theme and outcome (ok, retried or failed)"),
+	)
 )
 
 type ScreenShotItem struct {
@@ -21,10 +46,27 @@ type ScreenShotItem struct {
 	Version   int64
 	WorldData *v1.WorldData
 
+	// Drawn over the screenshot, eg a game's turn and players
+	Overlay *lib.RenderOverlay
+
+	// When the entity was last updated, for indexes that do not track it in
+	// WorldData's ScreenshotIndexInfo
+	UpdatedAt *tspb.Timestamp
+
 	ThemeErrors map[string]error
 	ThemeFiles  map[string]*v1.File
 }
 
+// ScreenshotJobType is the job rendering the screenshots of one item of a
+// kind, eg "screenshot_games"
+func ScreenshotJobType(kind string) string {
+	return "screenshot_" + kind
+}
+
+// screenshotDebounceSeconds is how long a screenshot job waits for further
+// changes to its item before rendering
+const screenshotDebounceSeconds = 5
+
 // ScreenshotCompletionCallback is called after all screenshots for an item are complete
 type ScreenshotCompletionCallback func([]ScreenShotItem) error
 
@@ -36,10 +78,36 @@ type ScreenShotIndexer struct {
 
 	// Callback invoked after all screenshots for an item are complete
 	OnComplete ScreenshotCompletionCallback
+
+	// How many themes are rendered at once across a batch
+	Workers int
+
+	// Each theme is attempted this many times, RetryBackoff apart (doubling
+	// on every attempt), before its error is recorded in ThemeErrors
+	MaxAttempts  int
+	RetryBackoff time.Duration
+
+	// RenderTheme renders and uploads one theme of an item - overridable
+	// for tests
+	RenderTheme func(ctx context.Context, themeName string, item *ScreenShotItem) (*v1.File, error)
+
+	// Overlay, when set, returns what is drawn over an item's screenshots
+	Overlay func(ctx context.Context, item *ScreenShotItem) *lib.RenderOverlay
+
+	// Jobs, when set by UseJobRunner, renders items as jobs loading them
+	// from source instead of in the reducer's batches
+	Jobs   *JobRunner
+	source IndexSource
 }
 
 func NewScreenShotIndexer(clientMgr *ClientMgr) *ScreenShotIndexer {
-	s := ScreenShotIndexer{ClientMgr: clientMgr}
+	s := ScreenShotIndexer{
+		ClientMgr:    clientMgr,
+		Workers:      4,
+		MaxAttempts:  3,
+		RetryBackoff: time.Second,
+	}
+	s.RenderTheme = s.renderScreenshot
 	s.reducer = gocurrent.NewReducer2(
 		gocurrent.WithFlushPeriod2[ScreenShotItem, map[string]ScreenShotItem](5 * time.Second),
 	)
@@ -65,71 +133,214 @@ func NewScreenShotIndexer(clientMgr *ClientMgr) *ScreenShotIndexer {
 	return &s
 }
 
+// start renders batches as the reducer flushes them.  Items that changed
+// but missed indexing (eg while the server was down) are found and sent
+// here by the IndexReconciler.
 func (s *ScreenShotIndexer) start() {
-	checkerTicker := time.NewTicker(60 * time.Second) // we also see which items have "changed" but missed the indexing through some loss
-
-	for {
-		select {
-		case newItems := <-s.reducer.OutputChan():
-			s.startBatchProcessing(newItems)
-		case <-checkerTicker.C:
-			// log.Println("Time to proactively find items that need to be re-indexed")
-		}
+	for newItems := range s.reducer.OutputChan() {
+		s.startBatchProcessing(newItems)
 	}
 }
 
-// TODO - Put this into a worker pool later and/or with rate limiting
 func (s *ScreenShotIndexer) startBatchProcessing(batch map[string]ScreenShotItem) {
-	results := []ScreenShotItem{}
+	items := make([]ScreenShotItem, 0, len(batch))
 	for _, item := range batch {
+		items = append(items, item)
+	}
+	results := s.RenderItems(context.Background(), items)
+
+	// Notify completion with all results
+	if s.OnComplete != nil {
+		if err := s.OnComplete(results); err != nil {
+			log.Printf("Failed to process completion callback: %v", err)
+		}
+	}
+}
+
+// renderTask is one theme of one item to render
+type renderTask struct {
+	item  *ScreenShotItem
+	theme string
+}
+
+// RenderItems renders every theme of items on a pool of Workers and returns
+// them with the file or error of each theme in ThemeFiles and ThemeErrors.
+// Themes are independent - one failing does not stop the others.
+func (s *ScreenShotIndexer) RenderItems(ctx context.Context, items []ScreenShotItem) []ScreenShotItem {
+	tasks := make(chan renderTask)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for range min(max(s.Workers, 1), len(items)*len(ScreenshotThemes)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range tasks {
+				file, err := s.renderWithRetries(ctx, task.theme, task.item)
+				mu.Lock()
+				if err != nil {
+					task.item.ThemeErrors[task.theme] = err
+				} else {
+					task.item.ThemeFiles[task.theme] = file
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for i := range items {
+		item := &items[i]
+		if item.ThemeErrors == nil {
+			item.ThemeErrors = map[string]error{}
+		}
+		if item.ThemeFiles == nil {
+			item.ThemeFiles = map[string]*v1.File{}
+		}
+		if item.Overlay == nil && s.Overlay != nil {
+			item.Overlay = s.Overlay(ctx, item)
+		}
 		log.Printf("Creating screenshots for %s: %s", item.Kind, item.Id)
+		for _, theme := range ScreenshotThemes {
+			tasks <- renderTask{item: item, theme: theme}
+		}
+	}
+	close(tasks)
+	wg.Wait()
+	return items
+}
+
+// renderWithRetries renders a theme, retrying failures with a doubling
+// backoff, and records the latency and outcome of every attempt
+func (s *ScreenShotIndexer) renderWithRetries(ctx context.Context, themeName string, item *ScreenShotItem) (file *v1.File, err error) {
+	backoff := s.RetryBackoff
+	attempts := max(s.MaxAttempts, 1)
+	for attempt := 1; attempt <= attempts; attempt++ {
+		started := time.Now()
+		file, err = s.RenderTheme(ctx, themeName, item)
+		elapsed := time.Since(started)
 
-		// Render all themes for this item
-		for _, themeName := range []string{"default", "modern", "fantasy"} {
-			err := s.renderScreenshot(themeName, &item)
-			if err != nil {
-				log.Printf("Failed to render %s screenshot for %s/%s: %v", themeName, item.Kind, item.Id, err)
-				item.ThemeErrors[themeName] = err
-				break // Stop processing other themes if one fails
+		outcome := "ok"
+		if err != nil && attempt < attempts {
+			outcome = "retried"
+		} else if err != nil {
+			outcome = "failed"
+		}
+		attrs := metric.WithAttributes(attribute.String("kind", item.Kind), attribute.String("theme", themeName), attribute.String("outcome", outcome))
+		screenshotRenderDuration.Record(ctx, elapsed.Seconds(), attrs)
+		screenshotRenders.Add(ctx, 1, attrs)
+
+		if err == nil {
+			return file, nil
+		}
+		log.Printf("Failed to render %s screenshot for %s/%s (attempt %d of %d, %s): %v", themeName, item.Kind, item.Id, attempt, attempts, elapsed, err)
+		if attempt < attempts {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoff):
 			}
+			backoff *= 2
 		}
+	}
+	return nil, err
+}
 
-		results = append(results, item)
+// OnIndexed implements IndexQueue by chaining fn after OnComplete
+func (s *ScreenShotIndexer) OnIndexed(fn func(items []ScreenShotItem)) {
+	prev := s.OnComplete
+	s.OnComplete = func(items []ScreenShotItem) error {
+		var err error
+		if prev != nil {
+			err = prev(items)
+		}
+		fn(items)
+		return err
 	}
+}
 
-	// Notify completion with all results
+// UseJobRunner renders the screenshots of source's items as jobs on runner,
+// so queued renders survive restarts and failed ones are retried.  A job
+// loads the latest world data of its item from source when it runs.
+func (s *ScreenShotIndexer) UseJobRunner(runner *JobRunner, source IndexSource) {
+	s.Jobs = runner
+	s.source = source
+	runner.Handle(ScreenshotJobType(source.IndexEntityType()), s.renderJob)
+}
+
+// renderJob renders and records the screenshots of a job's item, failing
+// the run if any theme failed so it is retried
+func (s *ScreenShotIndexer) renderJob(ctx context.Context, job *v1.Job) error {
+	version, worldData, _, err := s.source.LoadIndexItem(ctx, job.EntityId)
+	if status.Code(err) == codes.NotFound {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to load %s %s: %w", job.EntityType, job.EntityId, err)
+	}
+	results := s.RenderItems(ctx, []ScreenShotItem{{Kind: job.EntityType, Id: job.EntityId, Version: version, WorldData: worldData}})
 	if s.OnComplete != nil {
 		if err := s.OnComplete(results); err != nil {
-			log.Printf("Failed to process completion callback: %v", err)
+			return err
+		}
+	}
+	var errs []error
+	for _, theme := range ScreenshotThemes {
+		if err := results[0].ThemeErrors[theme]; err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", theme, err))
 		}
 	}
+	return errors.Join(errs...)
 }
 
+// Send queues an item for rendering.  A nil indexer (screenshots disabled,
+// see InitializeScreenshotIndexer) drops it.
 func (s *ScreenShotIndexer) Send(kind string, id string, version int64, worldData *v1.WorldData) {
-	s.reducer.InputChan() <- ScreenShotItem{kind, id, version, worldData, make(map[string]error), make(map[string]*v1.File)}
+	if s == nil {
+		return
+	}
+	if s.Jobs != nil {
+		// The job renders the item as it is when it runs
+		job := &v1.Job{JobType: ScreenshotJobType(kind), EntityType: kind, EntityId: id, DebounceWindowSeconds: screenshotDebounceSeconds}
+		if _, err := s.Jobs.Enqueue(context.Background(), job); err != nil {
+			log.Printf("Failed to queue screenshots of %s %s: %v", kind, id, err)
+		}
+		return
+	}
+	s.reducer.InputChan() <- ScreenShotItem{
+		Kind:        kind,
+		Id:          id,
+		Version:     version,
+		WorldData:   worldData,
+		ThemeErrors: make(map[string]error),
+		ThemeFiles:  make(map[string]*v1.File),
+	}
 }
 
-func (s *ScreenShotIndexer) renderScreenshot(themeName string, item *ScreenShotItem) error {
+// renderScreenshot renders one theme of an item and uploads it to the file
+// store
+func (s *ScreenShotIndexer) renderScreenshot(ctx context.Context, themeName string, item *ScreenShotItem) (*v1.File, error) {
 	// Create theme
 	re := lib.DefaultRulesEngine()
 	theme, err := themes.CreateTheme(themeName, re.GetCityTerrains())
 	if err != nil {
 		log.Printf("Failed to create theme %s: %v", themeName, err)
-		return err
+		return nil, err
 	}
 
 	// Create renderer for this theme
 	renderer, err := themes.CreateWorldRenderer(theme)
 	if err != nil {
 		log.Printf("Failed to create renderer for theme %s: %v", themeName, err)
-		return err
+		return nil, err
 	}
 
 	// Render the image
-	imageBytes, contentType, err := renderer.Render(item.WorldData.TilesMap, item.WorldData.UnitsMap, nil)
+	options := lib.DefaultRenderOptions()
+	options.Overlay = item.Overlay
+	options.Crossings = item.WorldData.Crossings
+	options.ShowHealthBars = item.Kind == "games"
+	imageBytes, contentType, err := renderer.Render(item.WorldData.TilesMap, item.WorldData.UnitsMap, options)
 	if err != nil {
 		log.Printf("Failed to render screenshot: %v", err)
-		return err
+		return nil, err
 	}
 
 	// Determine file extension from content type
@@ -143,7 +354,7 @@ func (s *ScreenShotIndexer) renderScreenshot(themeName string, item *ScreenShotI
 
 	// Upload to filestore
 	filestoreSvcClient := s.ClientMgr.GetFileStoreSvcClient()
-	resp, err := filestoreSvcClient.PutFile(context.Background(), &v1.PutFileRequest{
+	resp, err := filestoreSvcClient.PutFile(ctx, &v1.PutFileRequest{
 		File: &v1.File{
 			Path:        filePath,
 			ContentType: contentType,
@@ -152,12 +363,9 @@ func (s *ScreenShotIndexer) renderScreenshot(themeName string, item *ScreenShotI
 	})
 	if err != nil {
 		log.Printf("Failed to upload screenshot to filestore: %v", err)
-		return err
+		return nil, err
 	}
 
-	// Store the file in ThemeFiles map
-	item.ThemeFiles[themeName] = resp.File
-
 	log.Printf("Successfully uploaded screenshot: %s", filePath)
-	return nil
+	return resp.File, nil
 }
@@ -0,0 +1,351 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	lib "github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/authz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// loadSimultaneousGame loads a game and its state and checks it is an active
+// simultaneous turn game.
+func (s *BaseGamesService) loadSimultaneousGame(ctx context.Context, gameId string) (*v1.Game, *v1.GameState, error) {
+	gameresp, err := s.Self.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		return nil, nil, err
+	}
+	if gameresp.Game == nil || gameresp.State == nil {
+		return nil, nil, fmt.Errorf("game not found: %s", gameId)
+	}
+	if !lib.IsSimultaneous(gameresp.Game) {
+		return nil, nil, fmt.Errorf("game %s does not use simultaneous turns", gameId)
+	}
+	if gameresp.State.Finished {
+		return nil, nil, fmt.Errorf("game %s has already finished", gameId)
+	}
+	return gameresp.Game, gameresp.State, nil
+}
+
+// ordersPlayers returns the IDs of the players expected to submit orders each
+// turn - every configured player that has not been marked inactive.
+func ordersPlayers(game *v1.Game, state *v1.GameState) (out []int32) {
+	for _, player := range game.Config.Players {
+		if ps := state.PlayerStates[player.PlayerId]; ps != nil && !ps.IsActive {
+			continue
+		}
+		out = append(out, player.PlayerId)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return
+}
+
+// waitingOnCommits returns the players that have not committed orders yet
+func waitingOnCommits(game *v1.Game, state *v1.GameState) (out []int32) {
+	for _, playerId := range ordersPlayers(game, state) {
+		if orders := state.PendingOrders[playerId]; orders == nil || orders.Commitment == "" {
+			out = append(out, playerId)
+		}
+	}
+	return
+}
+
+// waitingOnReveals returns the players that have not revealed orders yet
+func waitingOnReveals(game *v1.Game, state *v1.GameState) (out []int32) {
+	for _, playerId := range ordersPlayers(game, state) {
+		if orders := state.PendingOrders[playerId]; orders == nil || !orders.Revealed {
+			out = append(out, playerId)
+		}
+	}
+	return
+}
+
+// DefaultOrdersTimeLimit is how long each phase of a simultaneous turn waits
+// for players when the game has no turn_time_limit
+const DefaultOrdersTimeLimit = 24 * time.Hour
+
+// ForfeitedCommitment is recorded for players who did not commit orders in
+// time.  It can never match a real (hex SHA-256) commitment.
+const ForfeitedCommitment = "forfeited"
+
+// ordersTimeLimit returns how long players have to commit, and then to
+// reveal, their orders each turn
+func ordersTimeLimit(game *v1.Game) time.Duration {
+	if seconds := game.GetConfig().GetSettings().GetTurnTimeLimit(); seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return DefaultOrdersTimeLimit
+}
+
+// OrdersDeadline returns when the current orders phase of a simultaneous
+// turn ends.  Players have the time limit from the start of the turn to
+// commit - the state's updated_at, which only changes as turns resolve, or
+// the game's creation for the first turn - and then from the last commitment
+// to reveal.  Zero if the phase start is not known.
+func OrdersDeadline(game *v1.Game, state *v1.GameState) time.Time {
+	limit := ordersTimeLimit(game)
+	if len(waitingOnCommits(game, state)) > 0 {
+		started := state.GetUpdatedAt()
+		if started == nil {
+			started = game.GetCreatedAt()
+		}
+		if started == nil {
+			return time.Time{}
+		}
+		return started.AsTime().Add(limit)
+	}
+	var lastCommit time.Time
+	for _, orders := range state.PendingOrders {
+		if committedAt := orders.GetCommittedAt(); committedAt != nil && committedAt.AsTime().After(lastCommit) {
+			lastCommit = committedAt.AsTime()
+		}
+	}
+	if lastCommit.IsZero() {
+		return time.Time{}
+	}
+	return lastCommit.Add(limit)
+}
+
+func (s *BaseGamesService) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// scheduleOrdersDeadline tells OnOrdersDeadline when the game's current
+// orders phase ends
+func (s *BaseGamesService) scheduleOrdersDeadline(ctx context.Context, game *v1.Game, state *v1.GameState) {
+	if s.OnOrdersDeadline == nil || state.Finished {
+		return
+	}
+	if deadline := OrdersDeadline(game, state); !deadline.IsZero() {
+		s.OnOrdersDeadline(ctx, game.Id, deadline)
+	}
+}
+
+// ForfeitLateOrders ends the current orders phase of a simultaneous turn
+// once its deadline has passed so one absent player cannot stall the game.
+// Players who have not committed forfeit the turn, as if they had committed
+// and revealed no orders, and players who have not revealed lose their
+// orders.  The turn then resolves unless somebody is still to reveal.
+// Returns whether anybody forfeited; games that are finished or not
+// simultaneous are left alone.
+func (s *BaseGamesService) ForfeitLateOrders(ctx context.Context, gameId string) (bool, error) {
+	s.ordersMu.Lock()
+	defer s.ordersMu.Unlock()
+
+	gameresp, err := s.Self.GetGame(ctx, &v1.GetGameRequest{Id: gameId})
+	if err != nil {
+		return false, err
+	}
+	game, state := gameresp.Game, gameresp.State
+	if game == nil || state == nil || !lib.IsSimultaneous(game) || state.Finished {
+		return false, nil
+	}
+
+	now := s.now()
+	deadline := OrdersDeadline(game, state)
+	if deadline.IsZero() || now.Before(deadline) {
+		s.scheduleOrdersDeadline(ctx, game, state)
+		return false, nil
+	}
+
+	late := waitingOnCommits(game, state)
+	if len(late) == 0 {
+		late = waitingOnReveals(game, state)
+	}
+	if len(late) == 0 {
+		return false, nil
+	}
+	if state.PendingOrders == nil {
+		state.PendingOrders = make(map[int32]*v1.PlayerOrders)
+	}
+	for _, playerId := range late {
+		orders := state.PendingOrders[playerId]
+		if orders == nil || orders.Commitment == "" {
+			orders = &v1.PlayerOrders{
+				PlayerId:    playerId,
+				Commitment:  ForfeitedCommitment,
+				CommittedAt: timestamppb.New(now),
+			}
+			state.PendingOrders[playerId] = orders
+		}
+		orders.Revealed = true
+		orders.Moves = nil
+		orders.Salt = ""
+	}
+
+	if len(waitingOnReveals(game, state)) > 0 {
+		if err := s.Self.SavePendingOrders(ctx, gameId, state); err != nil {
+			return false, fmt.Errorf("failed to save orders: %w", err)
+		}
+		s.scheduleOrdersDeadline(ctx, game, state)
+		return true, nil
+	}
+	if _, err := s.resolveTurn(ctx, game, state); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CommitOrders records a player's commitment to their orders for the current
+// turn of a simultaneous turn game.  A player may replace their commitment
+// until every player has committed after which orders must be revealed.
+// Authorization: User must own the player slot the orders are for.
+func (s *BaseGamesService) CommitOrders(ctx context.Context, req *v1.CommitOrdersRequest) (*v1.CommitOrdersResponse, error) {
+	if req.Commitment == "" {
+		return nil, fmt.Errorf("commitment is required")
+	}
+
+	s.ordersMu.Lock()
+	defer s.ordersMu.Unlock()
+
+	game, state, err := s.loadSimultaneousGame(ctx, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	playerId, err := authz.CanSubmitOrders(ctx, game, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(waitingOnCommits(game, state)) == 0 {
+		return nil, fmt.Errorf("all players have committed orders for this turn - reveal orders instead")
+	}
+
+	if state.PendingOrders == nil {
+		state.PendingOrders = make(map[int32]*v1.PlayerOrders)
+	}
+	state.PendingOrders[playerId] = &v1.PlayerOrders{
+		PlayerId:    playerId,
+		Commitment:  req.Commitment,
+		CommittedAt: timestamppb.New(s.now()),
+	}
+
+	if err := s.Self.SavePendingOrders(ctx, req.GameId, state); err != nil {
+		return nil, fmt.Errorf("failed to save orders: %w", err)
+	}
+	s.scheduleOrdersDeadline(ctx, game, state)
+
+	return &v1.CommitOrdersResponse{
+		PlayerId:  playerId,
+		WaitingOn: waitingOnCommits(game, state),
+	}, nil
+}
+
+// RevealOrders reveals a player's previously committed orders.  The orders
+// must match the commitment.  Once every player has revealed, the turn is
+// resolved for everyone at once and the resulting moves are saved as a single
+// move group.
+// Authorization: User must own the player slot the orders are for.
+func (s *BaseGamesService) RevealOrders(ctx context.Context, req *v1.RevealOrdersRequest) (*v1.RevealOrdersResponse, error) {
+	s.ordersMu.Lock()
+	defer s.ordersMu.Unlock()
+
+	game, state, err := s.loadSimultaneousGame(ctx, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	playerId, err := authz.CanSubmitOrders(ctx, game, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if waiting := waitingOnCommits(game, state); len(waiting) > 0 {
+		return nil, fmt.Errorf("orders cannot be revealed until all players commit (waiting on %v)", waiting)
+	}
+
+	orders := state.PendingOrders[playerId]
+	if orders == nil {
+		return nil, fmt.Errorf("player %d has not committed orders", playerId)
+	}
+	if orders.Revealed {
+		return nil, fmt.Errorf("player %d has already revealed orders", playerId)
+	}
+
+	commitment, err := lib.OrdersCommitment(req.Moves, req.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if commitment != orders.Commitment {
+		return nil, fmt.Errorf("revealed orders do not match the commitment for player %d", playerId)
+	}
+
+	orders.Revealed = true
+	orders.Moves = req.Moves
+	orders.Salt = req.Salt
+
+	resp := &v1.RevealOrdersResponse{
+		PlayerId:  playerId,
+		WaitingOn: waitingOnReveals(game, state),
+	}
+	if len(resp.WaitingOn) > 0 {
+		if err := s.Self.SavePendingOrders(ctx, req.GameId, state); err != nil {
+			return nil, fmt.Errorf("failed to save orders: %w", err)
+		}
+		s.scheduleOrdersDeadline(ctx, game, state)
+		return resp, nil
+	}
+
+	resp.Moves, err = s.resolveTurn(ctx, game, state)
+	if err != nil {
+		return nil, err
+	}
+	resp.Resolved = true
+	return resp, nil
+}
+
+// resolveTurn resolves the revealed orders of all players and saves the
+// resulting moves as a single move group.
+func (s *BaseGamesService) resolveTurn(ctx context.Context, game *v1.Game, state *v1.GameState) ([]*v1.GameMove, error) {
+	rtGame, err := s.Self.GetRuntimeGame(game, state)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve in a transaction layer - ApplyChangeResults applies to the original
+	rtGame.World = rtGame.World.Push()
+
+	orders := make(map[int32][]*v1.GameMove)
+	for playerId, pending := range state.PendingOrders {
+		orders[playerId] = pending.Moves
+	}
+
+	startTime := time.Now()
+	moves, err := rtGame.ResolveSimultaneousTurn(orders)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve turn: %w", err)
+	}
+
+	nextGroupNumber := state.CurrentGroupNumber + 1
+	moveGroup := &v1.GameMoveGroup{
+		StartedAt:   timestamppb.New(startTime),
+		Moves:       moves,
+		GroupNumber: nextGroupNumber,
+	}
+
+	if err := s.ApplyChangeResults(moves, rtGame, game, state); err != nil {
+		return nil, err
+	}
+	state.CurrentGroupNumber = nextGroupNumber
+	state.PendingOrders = nil
+	moveGroup.EndedAt = timestamppb.New(time.Now())
+
+	if err := s.Self.SaveMoveGroup(ctx, game.Id, state, moveGroup); err != nil {
+		return nil, fmt.Errorf("failed to save move group: %w", err)
+	}
+
+	if s.OnMovesSaved != nil {
+		s.OnMovesSaved(ctx, game.Id, moves, nextGroupNumber)
+	}
+	// The next turn's commit phase starts now
+	s.scheduleOrdersDeadline(ctx, game, state)
+	return moves, nil
+}
@@ -52,6 +52,13 @@ func (w *SingletonGamesService) SaveMoveGroup(ctx context.Context, gameId string
 	return w.Persister.Save(ctx, gameId, state, group)
 }
 
+// SavePendingOrders keeps the orders in the singleton state - there is no
+// separate persistence for them in the browser.
+func (w *SingletonGamesService) SavePendingOrders(ctx context.Context, gameId string, state *v1.GameState) error {
+	w.SingletonGameState = state
+	return nil
+}
+
 func (w *SingletonGamesService) GetRuntimeGame(game *v1.Game, gameState *v1.GameState) (out *lib.Game, err error) {
 	if w.RuntimeGame == nil {
 		w.RuntimeGame = lib.ProtoToRuntimeGame(w.SingletonGame, w.SingletonGameState)
@@ -84,6 +91,15 @@ func (w *SingletonGamesService) Load(
 	}
 }
 
+// LoadSaveFile replaces the singleton data with a saved game (eg a hot-seat
+// game restored from local storage) and drops the cached runtime game.
+func (w *SingletonGamesService) LoadSaveFile(save *lib.SaveFile) {
+	w.SingletonGame = save.Game
+	w.SingletonGameState = save.State
+	w.SingletonGameMoveHistory = save.History
+	w.RuntimeGame = nil
+}
+
 // WASM-specific implementations that operate on singleton data
 
 func (w *SingletonGamesService) GetGame(ctx context.Context, req *v1.GetGameRequest) (*v1.GetGameResponse, error) {
@@ -146,3 +162,20 @@ func (w *SingletonGamesService) ListMoves(ctx context.Context, req *v1.ListMoves
 func (w *SingletonGamesService) JoinGame(ctx context.Context, req *v1.JoinGameRequest) (*v1.JoinGameResponse, error) {
 	return nil, services.ErrNotImplemented
 }
+
+// ListMyGames is not supported in WASM singleton context - the user game
+// index is kept by the server backends
+func (w *SingletonGamesService) ListMyGames(ctx context.Context, req *v1.ListMyGamesRequest) (*v1.ListMyGamesResponse, error) {
+	return nil, services.ErrNotImplemented
+}
+
+// ExportGame is not supported in WASM singleton context - local games are
+// exported as save files
+func (w *SingletonGamesService) ExportGame(ctx context.Context, req *v1.ExportGameRequest) (*v1.ExportGameResponse, error) {
+	return nil, services.ErrNotImplemented
+}
+
+// ImportGame is not supported in WASM singleton context - it's a server-side operation
+func (w *SingletonGamesService) ImportGame(ctx context.Context, req *v1.ImportGameRequest) (*v1.ImportGameResponse, error) {
+	return nil, services.ErrNotImplemented
+}
@@ -0,0 +1,225 @@
+package singleton
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// LocalStore is the key/value storage a LocalPersister keeps saved games in.
+// In the browser it is backed by localStorage; MemoryStore backs it in tests
+// and anywhere else a throwaway store will do.
+//
+// Implementations must be safe to call from any goroutine.
+type LocalStore interface {
+	// Get returns the value for a key and whether it was found
+	Get(key string) ([]byte, bool, error)
+
+	// Put creates or replaces the value for a key
+	Put(key string, value []byte) error
+
+	// Delete removes a key.  Deleting a missing key is not an error.
+	Delete(key string) error
+
+	// Keys returns all keys starting with the prefix
+	Keys(prefix string) ([]string, error)
+}
+
+// MemoryStore is an in-memory LocalStore
+type MemoryStore struct {
+	mu   sync.RWMutex
+	data map[string][]byte
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{data: map[string][]byte{}}
+}
+
+// Get returns a copy of the value for a key
+func (m *MemoryStore) Get(key string) ([]byte, bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	value, ok := m.data[key]
+	if !ok {
+		return nil, false, nil
+	}
+	return append([]byte(nil), value...), true, nil
+}
+
+// Put stores a copy of the value for a key
+func (m *MemoryStore) Put(key string, value []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[key] = append([]byte(nil), value...)
+	return nil
+}
+
+// Delete removes a key
+func (m *MemoryStore) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.data, key)
+	return nil
+}
+
+// Keys returns the keys starting with the prefix in sorted order
+func (m *MemoryStore) Keys(prefix string) (out []string, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for key := range m.data {
+		if strings.HasPrefix(key, prefix) {
+			out = append(out, key)
+		}
+	}
+	sort.Strings(out)
+	return
+}
+
+// LocalGameKeyPrefix prefixes the store keys of games saved by a LocalPersister
+const LocalGameKeyPrefix = "lilbattle/games/"
+
+// LocalPersister is a MovePersister for hot-seat games played locally.  It
+// keeps the game, its state and its move history in a LocalStore as a
+// lib.SaveFile so the same bytes can be exported and loaded with
+// lib.LoadGame, or imported back into another browser.
+type LocalPersister struct {
+	Store LocalStore
+
+	// Serializes read-modify-write of saved games
+	mu sync.Mutex
+}
+
+// NewLocalPersister creates a persister saving into the given store
+func NewLocalPersister(store LocalStore) *LocalPersister {
+	return &LocalPersister{Store: store}
+}
+
+func localGameKey(gameId string) string {
+	return LocalGameKeyPrefix + gameId
+}
+
+// Create saves a new local game (or replaces a saved one) with its current
+// state and history
+func (p *LocalPersister) Create(game *v1.Game, state *v1.GameState, history *v1.GameMoveHistory) error {
+	if game == nil || game.Id == "" {
+		return fmt.Errorf("game ID is required")
+	}
+	if state == nil {
+		return fmt.Errorf("game state is required")
+	}
+	if history == nil {
+		history = &v1.GameMoveHistory{GameId: game.Id}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.put(&lib.SaveFile{Game: game, State: state, History: history})
+}
+
+// Save records a completed move group and the resulting state for a game
+// previously saved with Create or Import.  Saving a group that is already
+// in the history (eg a retry) only updates the state.
+func (p *LocalPersister) Save(ctx context.Context, gameId string, state *v1.GameState, group *v1.GameMoveGroup) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	save, err := p.load(gameId)
+	if err != nil {
+		return err
+	}
+	save.State = state
+	if group != nil {
+		groups := save.History.Groups
+		if n := len(groups); n == 0 || groups[n-1].GroupNumber < group.GroupNumber {
+			save.History.Groups = append(groups, group)
+		}
+	}
+	return p.put(save)
+}
+
+// Exists returns true if a game has been saved
+func (p *LocalPersister) Exists(gameId string) (bool, error) {
+	_, found, err := p.Store.Get(localGameKey(gameId))
+	return found, err
+}
+
+// Load returns a saved game
+func (p *LocalPersister) Load(gameId string) (*lib.SaveFile, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.load(gameId)
+}
+
+// Export returns a saved game as a save file that can be loaded with
+// lib.LoadGame or imported with Import
+func (p *LocalPersister) Export(gameId string) ([]byte, error) {
+	data, found, err := p.Store.Get(localGameKey(gameId))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("local game not found: %s", gameId)
+	}
+	return data, nil
+}
+
+// Import saves an exported save file as a local game (replacing any saved
+// game with the same ID) and returns the game's ID
+func (p *LocalPersister) Import(data []byte) (string, error) {
+	// Make sure the file can actually be played before accepting it
+	if _, err := lib.LoadGame(data); err != nil {
+		return "", fmt.Errorf("invalid save file: %w", err)
+	}
+	save, err := lib.ParseSaveFile(data)
+	if err != nil {
+		return "", err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.put(save); err != nil {
+		return "", err
+	}
+	return save.Game.Id, nil
+}
+
+// List returns the IDs of all saved local games
+func (p *LocalPersister) List() ([]string, error) {
+	keys, err := p.Store.Keys(LocalGameKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, strings.TrimPrefix(key, LocalGameKeyPrefix))
+	}
+	return ids, nil
+}
+
+// Delete removes a saved local game
+func (p *LocalPersister) Delete(gameId string) error {
+	return p.Store.Delete(localGameKey(gameId))
+}
+
+func (p *LocalPersister) load(gameId string) (*lib.SaveFile, error) {
+	data, found, err := p.Store.Get(localGameKey(gameId))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("local game not found: %s", gameId)
+	}
+	return lib.ParseSaveFile(data)
+}
+
+func (p *LocalPersister) put(save *lib.SaveFile) error {
+	data, err := save.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return p.Store.Put(localGameKey(save.Game.Id), data)
+}
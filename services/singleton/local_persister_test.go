@@ -0,0 +1,117 @@
+//go:build !wasm
+// +build !wasm
+
+package singleton
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// newHotSeatService creates a 2 player game where both players share one
+// device, persisted to an in-memory store
+func newHotSeatService(t *testing.T) (*SingletonGamesService, *LocalPersister) {
+	t.Helper()
+	svc, _ := newSimultaneousService()
+	svc.SingletonGame.Id = "hotseat-game"
+	svc.SingletonGame.Config.Settings = &v1.GameSettings{}
+	svc.SingletonGameState.GameId = "hotseat-game"
+
+	persister := NewLocalPersister(NewMemoryStore())
+	if err := persister.Create(svc.SingletonGame, svc.SingletonGameState, nil); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	svc.Persister = persister
+	return svc, persister
+}
+
+// endTurn ends the current player's turn.  Outside WASM moves are authorized
+// so they are made as the user in the current player's seat.
+func endTurn(t *testing.T, svc *SingletonGamesService) {
+	t.Helper()
+	user := fmt.Sprintf("u%d", svc.SingletonGameState.CurrentPlayer)
+	_, err := svc.ProcessMoves(userContext(user), &v1.ProcessMovesRequest{
+		GameId: "hotseat-game",
+		Moves:  []*v1.GameMove{{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}},
+	})
+	if err != nil {
+		t.Fatalf("ProcessMoves failed: %v", err)
+	}
+}
+
+// TestLocalPersister_SavesMovesAndState checks every processed move group is
+// appended to the stored history along with the latest state
+func TestLocalPersister_SavesMovesAndState(t *testing.T) {
+	svc, persister := newHotSeatService(t)
+
+	endTurn(t, svc)
+	endTurn(t, svc)
+
+	save, err := persister.Load("hotseat-game")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(save.History.Groups) != 2 || save.History.Groups[1].GroupNumber != 2 {
+		t.Errorf("Expected 2 saved move groups, got %d", len(save.History.Groups))
+	}
+	if save.State.CurrentPlayer != 1 || save.State.TurnCounter != 2 {
+		t.Errorf("Expected player 1 on turn 2, got player %d on turn %d", save.State.CurrentPlayer, save.State.TurnCounter)
+	}
+
+	// Re-saving the same group (eg a retry) must not duplicate it
+	if err := persister.Save(context.Background(), "hotseat-game", save.State, save.History.Groups[1]); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if save, _ = persister.Load("hotseat-game"); len(save.History.Groups) != 2 {
+		t.Errorf("Retried group should not be appended again, got %d groups", len(save.History.Groups))
+	}
+}
+
+// TestLocalPersister_ExportImport checks exported games load with
+// lib.LoadGame and can be imported into another store and resumed
+func TestLocalPersister_ExportImport(t *testing.T) {
+	svc, persister := newHotSeatService(t)
+	endTurn(t, svc)
+
+	data, err := persister.Export("hotseat-game")
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	game, err := lib.LoadGame(data)
+	if err != nil {
+		t.Fatalf("Exported save should load with lib.LoadGame: %v", err)
+	}
+	if game.CurrentPlayer != 2 || len(game.Groups) != 1 {
+		t.Errorf("Loaded game should be on player 2 with 1 move group, got player %d with %d", game.CurrentPlayer, len(game.Groups))
+	}
+
+	other := NewLocalPersister(NewMemoryStore())
+	gameId, err := other.Import(data)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if ids, _ := other.List(); len(ids) != 1 || ids[0] != gameId {
+		t.Errorf("Expected imported game to be listed, got %v", ids)
+	}
+
+	// Resume the imported game on a fresh service
+	save, err := other.Load(gameId)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	resumed := NewSingletonGamesService()
+	resumed.Persister = other
+	resumed.LoadSaveFile(save)
+	endTurn(t, resumed)
+	if save, _ = other.Load(gameId); len(save.History.Groups) != 2 || save.State.CurrentPlayer != 1 {
+		t.Errorf("Resumed game should continue the saved history")
+	}
+
+	if _, err := other.Import([]byte(`{"version":1}`)); err == nil {
+		t.Error("Importing an invalid save file should fail")
+	}
+}
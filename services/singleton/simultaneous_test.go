@@ -0,0 +1,241 @@
+//go:build !wasm
+// +build !wasm
+
+package singleton
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	oagrpc "github.com/panyam/oneauth/grpc"
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func userContext(userID string) context.Context {
+	md := metadata.Pairs(oagrpc.DefaultMetadataKeySubject, userID)
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// newSimultaneousService creates a 2 player simultaneous turn game with one
+// soldier per player on a small grass map.
+func newSimultaneousService() (*SingletonGamesService, *recordingPersister) {
+	tiles := map[string]*v1.Tile{}
+	for q := int32(-3); q <= 3; q++ {
+		for r := int32(-3); r <= 3; r++ {
+			tiles[lib.CoordKey(q, r)] = &v1.Tile{Q: q, R: r, TileType: lib.TileTypeGrass}
+		}
+	}
+	units := map[string]*v1.Unit{
+		lib.CoordKey(0, 0): {Q: 0, R: 0, Player: 1, UnitType: 1, AvailableHealth: 10},
+		lib.CoordKey(3, 0): {Q: 3, R: 0, Player: 2, UnitType: 1, AvailableHealth: 10},
+	}
+
+	svc := NewSingletonGamesService()
+	rec := &recordingPersister{}
+	svc.Persister = rec
+	svc.SingletonGame = &v1.Game{
+		Id: "sim-game",
+		Config: &v1.GameConfiguration{
+			Players: []*v1.GamePlayer{
+				{PlayerId: 1, UserId: "u1", PlayerType: "human"},
+				{PlayerId: 2, UserId: "u2", PlayerType: "human"},
+			},
+			Settings: &v1.GameSettings{TurnMode: lib.TurnModeSimultaneous},
+		},
+	}
+	svc.SingletonGameState = &v1.GameState{
+		GameId:        "sim-game",
+		CurrentPlayer: 1,
+		TurnCounter:   1,
+		WorldData:     &v1.WorldData{TilesMap: tiles, UnitsMap: units},
+		PlayerStates: map[int32]*v1.PlayerState{
+			1: {Coins: 100, IsActive: true},
+			2: {Coins: 100, IsActive: true},
+		},
+	}
+	return svc, rec
+}
+
+func moveOrders(fromQ, toQ int32) []*v1.GameMove {
+	return []*v1.GameMove{{
+		MoveType: &v1.GameMove_MoveUnit{
+			MoveUnit: &v1.MoveUnitAction{
+				From: &v1.Position{Q: fromQ, R: 0},
+				To:   &v1.Position{Q: toQ, R: 0},
+			},
+		},
+	}}
+}
+
+func commit(t *testing.T, svc *SingletonGamesService, user string, moves []*v1.GameMove, salt string) *v1.CommitOrdersResponse {
+	t.Helper()
+	commitment, err := lib.OrdersCommitment(moves, salt)
+	if err != nil {
+		t.Fatalf("OrdersCommitment failed: %v", err)
+	}
+	resp, err := svc.CommitOrders(userContext(user), &v1.CommitOrdersRequest{GameId: "sim-game", Commitment: commitment})
+	if err != nil {
+		t.Fatalf("CommitOrders for %s failed: %v", user, err)
+	}
+	return resp
+}
+
+// TestSimultaneousTurn_CommitRevealResolve walks a full commit/reveal turn
+// and checks the turn only resolves once every player has revealed.
+func TestSimultaneousTurn_CommitRevealResolve(t *testing.T) {
+	svc, rec := newSimultaneousService()
+	p1Moves, p2Moves := moveOrders(0, 1), moveOrders(3, 2)
+
+	if resp := commit(t, svc, "u1", p1Moves, "s1"); resp.PlayerId != 1 || len(resp.WaitingOn) != 1 || resp.WaitingOn[0] != 2 {
+		t.Errorf("Expected player 1 waiting on player 2, got %v", resp)
+	}
+
+	// Reveals are only allowed once everyone has committed
+	_, err := svc.RevealOrders(userContext("u1"), &v1.RevealOrdersRequest{GameId: "sim-game", Moves: moveOrders(0, 1), Salt: "s1"})
+	if err == nil {
+		t.Error("Reveal before all commits should fail")
+	}
+
+	commit(t, svc, "u2", p2Moves, "s2")
+
+	// Orders must match the commitment
+	_, err = svc.RevealOrders(userContext("u1"), &v1.RevealOrdersRequest{GameId: "sim-game", Moves: moveOrders(0, 1), Salt: "wrong"})
+	if err == nil {
+		t.Error("Reveal with mismatched salt should fail")
+	}
+
+	resp, err := svc.RevealOrders(userContext("u1"), &v1.RevealOrdersRequest{GameId: "sim-game", Moves: moveOrders(0, 1), Salt: "s1"})
+	if err != nil {
+		t.Fatalf("RevealOrders for u1 failed: %v", err)
+	}
+	if resp.Resolved || len(resp.WaitingOn) != 1 {
+		t.Errorf("Turn should wait on player 2, got %v", resp)
+	}
+	if rec.saved != 0 {
+		t.Errorf("No moves should be saved before the turn resolves, got %d", rec.saved)
+	}
+
+	resp, err = svc.RevealOrders(userContext("u2"), &v1.RevealOrdersRequest{GameId: "sim-game", Moves: moveOrders(3, 2), Salt: "s2"})
+	if err != nil {
+		t.Fatalf("RevealOrders for u2 failed: %v", err)
+	}
+	if !resp.Resolved {
+		t.Fatal("Turn should resolve once all players reveal")
+	}
+	if rec.saved != 1 || rec.gotGroup.GroupNumber != 1 {
+		t.Errorf("Expected a single saved move group, got %d", rec.saved)
+	}
+
+	state := svc.SingletonGameState
+	if state.TurnCounter != 2 {
+		t.Errorf("Turn counter should advance to 2, got %d", state.TurnCounter)
+	}
+	if len(state.PendingOrders) != 0 {
+		t.Errorf("Pending orders should be cleared, got %d", len(state.PendingOrders))
+	}
+	if state.WorldData.UnitsMap[lib.CoordKey(1, 0)] == nil || state.WorldData.UnitsMap[lib.CoordKey(2, 0)] == nil {
+		t.Error("Both players' units should have moved")
+	}
+}
+
+// TestSimultaneousTurn_RejectsOtherSlotsAndProcessMoves checks players can
+// only act for their own slot and sequential moves are refused.
+func TestSimultaneousTurn_RejectsOtherSlotsAndProcessMoves(t *testing.T) {
+	svc, _ := newSimultaneousService()
+
+	_, err := svc.CommitOrders(userContext("u1"), &v1.CommitOrdersRequest{GameId: "sim-game", PlayerId: 2, Commitment: "abc"})
+	if err == nil {
+		t.Error("Committing for another player's slot should fail")
+	}
+
+	_, err = svc.ProcessMoves(userContext("u1"), &v1.ProcessMovesRequest{GameId: "sim-game", Moves: moveOrders(0, 1)})
+	if err == nil {
+		t.Error("ProcessMoves should be rejected for simultaneous games")
+	}
+}
+
+// TestSimultaneousTurn_ForfeitsMissingCommits tests that a player who never
+// commits forfeits the turn once the deadline passes, so the others can
+// reveal and the turn resolves without them
+func TestSimultaneousTurn_ForfeitsMissingCommits(t *testing.T) {
+	svc, rec := newSimultaneousService()
+	started := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc.SingletonGameState.UpdatedAt = timestamppb.New(started)
+	svc.SingletonGame.Config.Settings.TurnTimeLimit = 3600
+	clock := started.Add(10 * time.Minute)
+	svc.Now = func() time.Time { return clock }
+	var deadlines []time.Time
+	svc.OnOrdersDeadline = func(ctx context.Context, gameId string, deadline time.Time) {
+		deadlines = append(deadlines, deadline)
+	}
+
+	commit(t, svc, "u1", moveOrders(0, 1), "s1")
+	if len(deadlines) != 1 || !deadlines[0].Equal(started.Add(time.Hour)) {
+		t.Errorf("Expected the commit deadline an hour after the turn started, got %v", deadlines)
+	}
+
+	// Nothing happens before the deadline
+	if forfeited, err := svc.ForfeitLateOrders(context.Background(), "sim-game"); err != nil || forfeited {
+		t.Fatalf("Expected no forfeits before the deadline, got %v %v", forfeited, err)
+	}
+
+	clock = started.Add(time.Hour + time.Second)
+	if forfeited, err := svc.ForfeitLateOrders(context.Background(), "sim-game"); err != nil || !forfeited {
+		t.Fatalf("Expected player 2 to forfeit, got %v %v", forfeited, err)
+	}
+	if orders := svc.SingletonGameState.PendingOrders[2]; orders == nil || orders.Commitment != services.ForfeitedCommitment || !orders.Revealed {
+		t.Errorf("Expected player 2's turn forfeited, got %v", orders)
+	}
+	if _, err := svc.CommitOrders(userContext("u2"), &v1.CommitOrdersRequest{GameId: "sim-game", Commitment: "late"}); err == nil {
+		t.Error("Expected a late commitment refused")
+	}
+
+	resp, err := svc.RevealOrders(userContext("u1"), &v1.RevealOrdersRequest{GameId: "sim-game", Moves: moveOrders(0, 1), Salt: "s1"})
+	if err != nil {
+		t.Fatalf("RevealOrders for u1 failed: %v", err)
+	}
+	if !resp.Resolved || rec.saved != 1 || svc.SingletonGameState.TurnCounter != 2 {
+		t.Fatalf("Expected the turn resolved once player 1 revealed, got %v", resp)
+	}
+	units := svc.SingletonGameState.WorldData.UnitsMap
+	if units[lib.CoordKey(1, 0)] == nil || units[lib.CoordKey(3, 0)] == nil {
+		t.Error("Expected only player 1's unit moved")
+	}
+}
+
+// TestSimultaneousTurn_ForfeitsMissingReveals tests that orders not revealed
+// by the deadline are dropped and the turn resolves
+func TestSimultaneousTurn_ForfeitsMissingReveals(t *testing.T) {
+	svc, rec := newSimultaneousService()
+	clock := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc.SingletonGameState.UpdatedAt = timestamppb.New(clock)
+	svc.Now = func() time.Time { return clock }
+
+	commit(t, svc, "u1", moveOrders(0, 1), "s1")
+	commit(t, svc, "u2", moveOrders(3, 2), "s2")
+	if _, err := svc.RevealOrders(userContext("u1"), &v1.RevealOrdersRequest{GameId: "sim-game", Moves: moveOrders(0, 1), Salt: "s1"}); err != nil {
+		t.Fatalf("RevealOrders for u1 failed: %v", err)
+	}
+
+	// Without a turn_time_limit players get the default to reveal
+	clock = clock.Add(services.DefaultOrdersTimeLimit - time.Minute)
+	if forfeited, _ := svc.ForfeitLateOrders(context.Background(), "sim-game"); forfeited {
+		t.Fatal("Expected no forfeits before the default deadline")
+	}
+	clock = clock.Add(2 * time.Minute)
+	if forfeited, err := svc.ForfeitLateOrders(context.Background(), "sim-game"); err != nil || !forfeited {
+		t.Fatalf("Expected player 2's orders forfeited, got %v %v", forfeited, err)
+	}
+	if rec.saved != 1 || svc.SingletonGameState.TurnCounter != 2 || len(svc.SingletonGameState.PendingOrders) != 0 {
+		t.Fatalf("Expected the turn resolved, got turn %d", svc.SingletonGameState.TurnCounter)
+	}
+	units := svc.SingletonGameState.WorldData.UnitsMap
+	if units[lib.CoordKey(1, 0)] == nil || units[lib.CoordKey(3, 0)] == nil {
+		t.Error("Expected only player 1's revealed orders carried out")
+	}
+}
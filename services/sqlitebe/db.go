@@ -0,0 +1,99 @@
+//go:build !wasm && sqlite
+// +build !wasm,sqlite
+
+// Package sqlitebe stores games and worlds in an embedded SQLite database
+// for single binary self-hosting.
+//
+// The schema and queries are the GORM ones in gormbe - this package opens
+// the database with the settings SQLite needs to be a durable multi-user
+// store (WAL journaling, a busy timeout, immediate write transactions) and
+// adds the indexes listing relies on.
+//
+// The SQLite driver needs CGO so the package is only built with -tags sqlite.
+package sqlitebe
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/panyam/goutils/utils"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// DefaultDBPath is where the database lives when neither a path nor
+// LILBATTLE_SQLITE_PATH is given
+func DefaultDBPath() string {
+	return fsbe.DevDataPath("storage/lilbattle.db")
+}
+
+// OpenDB opens (creating if needed) the SQLite database at path
+func OpenDB(path string) (*gorm.DB, error) {
+	if path == "" {
+		path = os.Getenv("LILBATTLE_SQLITE_PATH")
+		if path == "" {
+			path = DefaultDBPath()
+		}
+	}
+	path = utils.ExpandUserPath(path)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create database directory: %w", err)
+	}
+
+	// WAL lets readers run alongside the writer, the busy timeout makes
+	// concurrent writers wait instead of failing and immediate transactions
+	// take the write lock up front so read-then-write transactions cannot
+	// deadlock upgrading their lock.
+	dsn := fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000&_synchronous=NORMAL&_foreign_keys=on&_txlock=immediate", path)
+	log.Println("Opening SQLite DB: ", path)
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		// Times are stored as text so must all be in one zone (gormbe uses
+		// UTC too) to compare and sort correctly
+		NowFunc: func() time.Time { return time.Now().UTC() },
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SQLite DB %s: %w", path, err)
+	}
+	return db, nil
+}
+
+// OpenLilBattleDB opens the database or exits - the sqlite counterpart of
+// gormbe.OpenLilBattleDB
+func OpenLilBattleDB(path string) *gorm.DB {
+	db, err := OpenDB(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return db
+}
+
+// listIndexes are the indexes behind ListGames/ListWorlds keyset paging
+// (see gormbe/listing.go) - one per sort column with the id tie break, plus
+// the owner filter on the default sort.
+var listIndexes = map[string][]string{
+	"games": {
+		"CREATE INDEX IF NOT EXISTS idx_games_updated_at ON games(updated_at, id)",
+		"CREATE INDEX IF NOT EXISTS idx_games_created_at ON games(created_at, id)",
+		"CREATE INDEX IF NOT EXISTS idx_games_name ON games(name, id)",
+		"CREATE INDEX IF NOT EXISTS idx_games_creator_updated_at ON games(creator_id, updated_at, id)",
+	},
+	"worlds": {
+		"CREATE INDEX IF NOT EXISTS idx_worlds_updated_at ON worlds(updated_at, id)",
+		"CREATE INDEX IF NOT EXISTS idx_worlds_created_at ON worlds(created_at, id)",
+		"CREATE INDEX IF NOT EXISTS idx_worlds_name ON worlds(name, id)",
+		"CREATE INDEX IF NOT EXISTS idx_worlds_creator_name ON worlds(creator_id, name, id)",
+	},
+}
+
+func createListIndexes(db *gorm.DB, table string) error {
+	for _, stmt := range listIndexes[table] {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to create index on %s: %w", table, err)
+		}
+	}
+	return nil
+}
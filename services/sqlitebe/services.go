@@ -0,0 +1,40 @@
+//go:build !wasm && sqlite
+// +build !wasm,sqlite
+
+package sqlitebe
+
+import (
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/gormbe"
+	"gorm.io/gorm"
+)
+
+// NewGamesService returns a games service stored in the SQLite database db
+// (see OpenDB). Moves and their state are saved in one transaction guarded
+// by the state's Version (gormbe.GamesService.SaveMovesAndState).
+func NewGamesService(db *gorm.DB, clientMgr *services.ClientMgr) (*gormbe.GamesService, error) {
+	service := gormbe.NewGamesService(db, clientMgr)
+	if err := createListIndexes(db, "games"); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// NewWorldsService returns a worlds service stored in the SQLite database db
+func NewWorldsService(db *gorm.DB, clientMgr *services.ClientMgr) (*gormbe.WorldsService, error) {
+	service := gormbe.NewWorldsService(db, clientMgr)
+	if err := createListIndexes(db, "worlds"); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// NewJobStore returns a background job store in the SQLite database db
+func NewJobStore(db *gorm.DB) *gormbe.JobStore {
+	return gormbe.NewJobStore(db)
+}
+
+// NewIndexStateStore returns an index state store in the SQLite database db
+func NewIndexStateStore(db *gorm.DB) *gormbe.IndexStateStore {
+	return gormbe.NewIndexStateStore(db)
+}
@@ -33,14 +33,26 @@ type GameSyncService struct {
 	// Per-game sequence numbers for ordering
 	sequences map[string]int64
 
+	// Per-game ring of recent updates so reconnecting clients (from_sequence)
+	// can catch up on what they missed. Bounded by HistorySize.
+	history map[string][]*v1.GameUpdate
+
+	// HistorySize is the number of recent updates retained per game for replay
+	HistorySize int
+
 	mu sync.RWMutex
 }
 
+// DefaultSyncHistorySize is the number of updates kept per game for reconnects
+const DefaultSyncHistorySize = 256
+
 // NewGameSyncService creates a new sync service
 func NewGameSyncService() *GameSyncService {
 	return &GameSyncService{
-		fanOuts:   make(map[string]*gocurrent.AsyncFanOut[*v1.GameUpdate]),
-		sequences: make(map[string]int64),
+		fanOuts:     make(map[string]*gocurrent.AsyncFanOut[*v1.GameUpdate]),
+		sequences:   make(map[string]int64),
+		history:     make(map[string][]*v1.GameUpdate),
+		HistorySize: DefaultSyncHistorySize,
 	}
 }
 
@@ -67,14 +79,24 @@ func (s *GameSyncService) Subscribe(req *v1.SubscribeRequest, stream grpc.Server
 	gameId := req.GameId
 	playerId := req.PlayerId
 
-	// Get current sequence
-	s.mu.RLock()
-	currentSeq := s.sequences[gameId]
-	s.mu.RUnlock()
+	// Get or create FanOut for this game
+	fanOut := s.getFanOut(gameId)
+
+	// Create output channel for this subscriber before looking at the
+	// sequence and history so nothing broadcast in between is lost.
+	// Duplicates are skipped below.
+	outputChan := fanOut.New(nil)
+	defer func() {
+		<-fanOut.Remove(outputChan, true)
+		s.pruneHistory(gameId)
+	}()
+
+	currentSeq, missed, resumable := s.resumeFrom(gameId, req.FromSequence)
 
 	// Send initial state (game state should be loaded separately by client via GetGame)
 	initialState := &v1.SubscribeResponse{
 		CurrentSequence: currentSeq,
+		ReloadRequired:  !resumable,
 	}
 
 	err := stream.Send(&v1.GameUpdate{
@@ -87,17 +109,14 @@ func (s *GameSyncService) Subscribe(req *v1.SubscribeRequest, stream grpc.Server
 		return fmt.Errorf("failed to send initial state: %w", err)
 	}
 
-	// TODO: If req.FromSequence > 0, send missed updates from history
-	// For now, we only support fresh subscriptions
-
-	// Get or create FanOut for this game
-	fanOut := s.getFanOut(gameId)
-
-	// Create output channel for this subscriber
-	outputChan := fanOut.New(nil)
-	defer func() {
-		<-fanOut.Remove(outputChan, true)
-	}()
+	// Replay anything the client missed since from_sequence
+	lastSent := currentSeq
+	for _, update := range missed {
+		if err := stream.Send(update); err != nil {
+			return err
+		}
+		lastSent = update.Sequence
+	}
 
 	// Broadcast player joined
 	s.broadcastInternal(gameId, &v1.GameUpdate{
@@ -130,6 +149,10 @@ func (s *GameSyncService) Subscribe(req *v1.SubscribeRequest, stream grpc.Server
 				// Channel closed (FanOut stopped)
 				return nil
 			}
+			if update.Sequence > 0 && update.Sequence <= lastSent {
+				// Already delivered during replay
+				continue
+			}
 			if err := stream.Send(update); err != nil {
 				return err
 			}
@@ -158,15 +181,19 @@ func (s *GameSyncService) Broadcast(ctx context.Context, req *v1.BroadcastReques
 
 // broadcastInternal sends a GameUpdate to all subscribers (internal use)
 func (s *GameSyncService) broadcastInternal(gameId string, update *v1.GameUpdate) int {
-	s.mu.RLock()
+	s.mu.Lock()
 	fo, exists := s.fanOuts[gameId]
-	s.mu.RUnlock()
-
-	if !exists || fo == nil {
-		return 0
+	count := 0
+	if exists && fo != nil {
+		count = fo.Count()
 	}
+	// Only games someone is watching keep a replay ring - reconnecting to
+	// anything else finds the gap and reloads
+	if count > 0 {
+		s.recordHistory(gameId, update)
+	}
+	s.mu.Unlock()
 
-	count := fo.Count()
 	log.Println("Broadcasting to: ", count, update)
 	if count > 0 {
 		fo.Send(update)
@@ -174,6 +201,73 @@ func (s *GameSyncService) broadcastInternal(gameId string, update *v1.GameUpdate
 	return count
 }
 
+// recordHistory appends an update to the game's replay ring. Caller holds s.mu.
+func (s *GameSyncService) recordHistory(gameId string, update *v1.GameUpdate) {
+	if s.HistorySize <= 0 || update.Sequence <= 0 {
+		return
+	}
+	h := append(s.history[gameId], update)
+	if len(h) > s.HistorySize {
+		h = h[len(h)-s.HistorySize:]
+	}
+	s.history[gameId] = h
+}
+
+// pruneHistory drops a game's replay ring once it has no subscribers left.
+// The sequence is kept so later reconnects still see they missed updates.
+func (s *GameSyncService) pruneHistory(gameId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if fo, exists := s.fanOuts[gameId]; !exists || fo == nil || fo.Count() == 0 {
+		delete(s.history, gameId)
+	}
+}
+
+// resumeFrom works out where a subscriber asking for updates after
+// fromSequence starts.  It returns the current sequence, the retained
+// updates the subscriber missed and whether those are all it missed.
+// Sequences live in memory and restart at 0 with the service, so a
+// fromSequence past the current one is from before a restart - the
+// subscriber starts afresh but may have missed anything, as it has when
+// updates since fromSequence have already left the replay ring.
+func (s *GameSyncService) resumeFrom(gameId string, fromSequence int64) (int64, []*v1.GameUpdate, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	currentSeq := s.sequences[gameId]
+	if fromSequence <= 0 || fromSequence == currentSeq {
+		return currentSeq, nil, true
+	}
+	if fromSequence > currentSeq {
+		return currentSeq, nil, false
+	}
+	h := s.history[gameId]
+	if len(h) == 0 || h[0].Sequence > fromSequence+1 {
+		return currentSeq, nil, false
+	}
+	var missed []*v1.GameUpdate
+	for _, update := range h {
+		if update.Sequence > fromSequence {
+			missed = append(missed, update)
+		}
+	}
+	return currentSeq, missed, true
+}
+
+// UpdatesSince returns retained updates for a game with a sequence greater
+// than fromSequence, oldest first. Updates older than the retention window
+// are not available - clients should reload via GetGame in that case.
+func (s *GameSyncService) UpdatesSince(gameId string, fromSequence int64) []*v1.GameUpdate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var out []*v1.GameUpdate
+	for _, update := range s.history[gameId] {
+		if update.Sequence > fromSequence {
+			out = append(out, update)
+		}
+	}
+	return out
+}
+
 // nextSequence atomically increments and returns the next sequence number for a game
 func (s *GameSyncService) nextSequence(gameId string) int64 {
 	s.mu.Lock()
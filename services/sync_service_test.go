@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+func broadcastMoves(t *testing.T, s *GameSyncService, gameId string, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		_, err := s.Broadcast(context.Background(), &v1.BroadcastRequest{
+			GameId: gameId,
+			Update: &v1.GameUpdate{UpdateType: &v1.GameUpdate_MovesPublished{MovesPublished: &v1.MovesPublished{}}},
+		})
+		if err != nil {
+			t.Fatalf("Broadcast: %v", err)
+		}
+	}
+}
+
+// watchGame adds a subscriber to a game that drains its updates, removing
+// it when the test ends unless the returned func does so first
+func watchGame(t *testing.T, s *GameSyncService, gameId string) func() {
+	t.Helper()
+	fanOut := s.getFanOut(gameId)
+	// Added rather than created with New so removing it does not close it
+	// while the fan out may still be delivering to it
+	ch := make(chan *v1.GameUpdate)
+	<-fanOut.Add(ch, nil, true)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ch:
+			case <-done:
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			<-fanOut.Remove(ch, true)
+			close(done)
+			s.pruneHistory(gameId)
+		})
+	}
+	t.Cleanup(stop)
+	return stop
+}
+
+func TestGameSyncService_UpdatesSince(t *testing.T) {
+	s := NewGameSyncService()
+	watchGame(t, s, "g1")
+	watchGame(t, s, "g2")
+	broadcastMoves(t, s, "g1", 5)
+	broadcastMoves(t, s, "g2", 2)
+
+	tests := []struct {
+		name  string
+		game  string
+		from  int64
+		first int64
+		count int
+	}{
+		{"all", "g1", 0, 1, 5},
+		{"missed tail", "g1", 3, 4, 2},
+		{"up to date", "g1", 5, 0, 0},
+		{"other game", "g2", 1, 2, 1},
+		{"unknown game", "nope", 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.UpdatesSince(tt.game, tt.from)
+			if len(got) != tt.count {
+				t.Fatalf("got %d updates, want %d", len(got), tt.count)
+			}
+			if tt.count > 0 && got[0].Sequence != tt.first {
+				t.Errorf("first sequence = %d, want %d", got[0].Sequence, tt.first)
+			}
+		})
+	}
+}
+
+func TestGameSyncService_HistoryIsBounded(t *testing.T) {
+	s := NewGameSyncService()
+	s.HistorySize = 3
+	watchGame(t, s, "g1")
+	broadcastMoves(t, s, "g1", 10)
+
+	got := s.UpdatesSince("g1", 0)
+	if len(got) != 3 || got[0].Sequence != 8 || got[2].Sequence != 10 {
+		t.Fatalf("expected sequences 8..10, got %d updates starting at %d", len(got), got[0].Sequence)
+	}
+}
+
+func TestGameSyncService_ResumeFrom(t *testing.T) {
+	s := NewGameSyncService()
+	s.HistorySize = 3
+	watchGame(t, s, "g1")
+	broadcastMoves(t, s, "g1", 10)
+
+	tests := []struct {
+		name      string
+		from      int64
+		first     int64
+		count     int
+		resumable bool
+	}{
+		{"fresh", 0, 0, 0, true},
+		{"up to date", 10, 0, 0, true},
+		{"missed tail", 8, 9, 2, true},
+		{"oldest retained", 7, 8, 3, true},
+		{"older than ring", 6, 0, 0, false},
+		{"from before a restart", 25, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, missed, resumable := s.resumeFrom("g1", tt.from)
+			if current != 10 {
+				t.Errorf("current sequence = %d, want 10", current)
+			}
+			if resumable != tt.resumable {
+				t.Errorf("resumable = %v, want %v", resumable, tt.resumable)
+			}
+			if len(missed) != tt.count {
+				t.Fatalf("got %d missed updates, want %d", len(missed), tt.count)
+			}
+			if tt.count > 0 && missed[0].Sequence != tt.first {
+				t.Errorf("first missed sequence = %d, want %d", missed[0].Sequence, tt.first)
+			}
+		})
+	}
+}
+
+func TestGameSyncService_HistoryPrunedWithoutSubscribers(t *testing.T) {
+	s := NewGameSyncService()
+	stop := watchGame(t, s, "g1")
+	broadcastMoves(t, s, "g1", 3)
+	if got := len(s.UpdatesSince("g1", 0)); got != 3 {
+		t.Fatalf("expected 3 retained updates while watched, got %d", got)
+	}
+
+	stop()
+	if got := len(s.UpdatesSince("g1", 0)); got != 0 {
+		t.Errorf("expected history dropped with no subscribers, got %d updates", got)
+	}
+	broadcastMoves(t, s, "g1", 2)
+	if got := len(s.UpdatesSince("g1", 0)); got != 0 {
+		t.Errorf("expected no history kept for an unwatched game, got %d updates", got)
+	}
+	if _, _, resumable := s.resumeFrom("g1", 3); resumable {
+		t.Error("Reconnecting after unwatched updates should require a reload")
+	}
+}
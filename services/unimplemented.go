@@ -50,3 +50,23 @@ func (s *BaseWorldsService) CreateWorld(ctx context.Context, req *v1.CreateWorld
 func (s *BaseWorldsService) DeleteWorld(ctx context.Context, req *v1.DeleteWorldRequest) (resp *v1.DeleteWorldResponse, err error) {
 	return nil, nil
 }
+
+func (s *BaseWorldsService) ListWorldRevisions(ctx context.Context, req *v1.ListWorldRevisionsRequest) (resp *v1.ListWorldRevisionsResponse, err error) {
+	return nil, nil
+}
+
+func (s *BaseWorldsService) RevertWorld(ctx context.Context, req *v1.RevertWorldRequest) (resp *v1.RevertWorldResponse, err error) {
+	return nil, nil
+}
+
+func (s *BaseWorldsService) ListDerivedWorlds(ctx context.Context, req *v1.ListDerivedWorldsRequest) (resp *v1.ListDerivedWorldsResponse, err error) {
+	return nil, nil
+}
+
+func (s *BaseWorldsService) MergeWorldUpstream(ctx context.Context, req *v1.MergeWorldUpstreamRequest) (resp *v1.MergeWorldUpstreamResponse, err error) {
+	return nil, nil
+}
+
+func (s *BaseWorldsService) SearchWorlds(ctx context.Context, req *v1.SearchWorldsRequest) (resp *v1.SearchWorldsResponse, err error) {
+	return nil, nil
+}
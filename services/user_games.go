@@ -0,0 +1,108 @@
+package services
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	lib "github.com/turnforge/lilbattle/lib"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UserGameEntries returns the per-user game index entries for a game - one
+// for every user holding a player slot.  Open and AI slots have no user and
+// are not indexed.
+func UserGameEntries(game *v1.Game, state *v1.GameState) (out []*v1.UserGame) {
+	if game == nil || game.Config == nil {
+		return nil
+	}
+
+	// Players the game is waiting on
+	var due []int32
+	if state != nil && !state.Finished {
+		if lib.IsSimultaneous(game) {
+			if due = waitingOnCommits(game, state); len(due) == 0 {
+				due = waitingOnReveals(game, state)
+			}
+		} else {
+			due = []int32{state.CurrentPlayer}
+		}
+	}
+
+	now := timestamppb.New(time.Now())
+	byUser := map[string]*v1.UserGame{}
+	for _, player := range game.Config.Players {
+		if player.UserId == "" {
+			continue
+		}
+		entry := byUser[player.UserId]
+		if entry == nil {
+			entry = &v1.UserGame{
+				UserId:    player.UserId,
+				GameId:    game.Id,
+				GameName:  game.Name,
+				WorldId:   game.WorldId,
+				UpdatedAt: now,
+			}
+			if state != nil {
+				entry.Status = state.Status
+				entry.CurrentPlayer = state.CurrentPlayer
+				entry.TurnCounter = state.TurnCounter
+			}
+			byUser[player.UserId] = entry
+			out = append(out, entry)
+		}
+		entry.PlayerIds = append(entry.PlayerIds, player.PlayerId)
+		if slices.Contains(due, player.PlayerId) {
+			entry.IsMyTurn = true
+		}
+	}
+	return
+}
+
+// PageUserGames filters a user's index entries by a ListMyGames request and
+// returns the requested page - games waiting on the user first, then the
+// most recently updated.  A user is in few enough games that the whole
+// index is read and paged in memory with offsets.
+func PageUserGames(entries []*v1.UserGame, req *v1.ListMyGamesRequest) ([]*v1.UserGame, *v1.PaginationResponse) {
+	var matching []*v1.UserGame
+	for _, entry := range entries {
+		if req.OnlyMyTurn && !entry.IsMyTurn {
+			continue
+		}
+		if req.Status != v1.GameStatus_GAME_STATUS_UNSPECIFIED && entry.Status != req.Status {
+			continue
+		}
+		matching = append(matching, entry)
+	}
+	sort.Slice(matching, func(i, j int) bool {
+		a, b := matching[i], matching[j]
+		if a.IsMyTurn != b.IsMyTurn {
+			return a.IsMyTurn
+		}
+		if ta, tb := a.UpdatedAt.AsTime(), b.UpdatedAt.AsTime(); !ta.Equal(tb) {
+			return ta.After(tb)
+		}
+		return a.GameId < b.GameId
+	})
+
+	pageSize, offset := DefaultListPageSize, 0
+	if req.Pagination != nil {
+		if req.Pagination.PageSize > 0 {
+			pageSize = min(int(req.Pagination.PageSize), MaxListPageSize)
+		}
+		offset = int(req.Pagination.PageOffset)
+	}
+	start := min(offset, len(matching))
+	end := min(start+pageSize, len(matching))
+
+	pagination := &v1.PaginationResponse{
+		HasMore:      end < len(matching),
+		TotalResults: int32(len(matching)),
+	}
+	if pagination.HasMore {
+		pagination.NextPageOffset = int32(end)
+	}
+	return matching[start:end], pagination
+}
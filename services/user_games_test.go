@@ -0,0 +1,73 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	lib "github.com/turnforge/lilbattle/lib"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUserGameEntries(t *testing.T) {
+	game := &v1.Game{Id: "g1", Name: "Game", Config: &v1.GameConfiguration{Players: []*v1.GamePlayer{
+		{PlayerId: 1, UserId: "u1"},
+		{PlayerId: 2, UserId: "u2"},
+		{PlayerId: 3, UserId: "u1"},
+		{PlayerId: 4, PlayerType: "ai"},
+	}}}
+	state := &v1.GameState{CurrentPlayer: 3, TurnCounter: 5}
+
+	entries := UserGameEntries(game, state)
+	if len(entries) != 2 {
+		t.Fatalf("Expected an entry per user, got %v", entries)
+	}
+	if u1 := entries[0]; u1.UserId != "u1" || len(u1.PlayerIds) != 2 || !u1.IsMyTurn || u1.TurnCounter != 5 {
+		t.Errorf("Expected u1 to hold players 1 and 3 and be up, got %v", u1)
+	}
+	if u2 := entries[1]; u2.IsMyTurn {
+		t.Errorf("Expected u2 to be waiting, got %v", u2)
+	}
+
+	// In simultaneous mode everybody that has not committed is up
+	game.Config.Settings = &v1.GameSettings{TurnMode: lib.TurnModeSimultaneous}
+	state.PendingOrders = map[int32]*v1.PlayerOrders{2: {PlayerId: 2, Commitment: "abc"}}
+	entries = UserGameEntries(game, state)
+	if !entries[0].IsMyTurn || entries[1].IsMyTurn {
+		t.Errorf("Expected only u1 to owe orders, got %v", entries)
+	}
+
+	state.Finished = true
+	for _, entry := range UserGameEntries(game, state) {
+		if entry.IsMyTurn {
+			t.Errorf("Finished games wait on nobody, got %v", entry)
+		}
+	}
+}
+
+func TestPageUserGames(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var entries []*v1.UserGame
+	for i, myTurn := range []bool{false, true, false, true} {
+		entries = append(entries, &v1.UserGame{
+			GameId:    string(rune('a' + i)),
+			IsMyTurn:  myTurn,
+			UpdatedAt: timestamppb.New(base.Add(time.Duration(i) * time.Minute)),
+		})
+	}
+
+	items, pagination := PageUserGames(entries, &v1.ListMyGamesRequest{Pagination: &v1.Pagination{PageSize: 3}})
+	var ids string
+	for _, item := range items {
+		ids += item.GameId
+	}
+	// Waiting on the user first, then newest first
+	if ids != "dbc" || !pagination.HasMore || pagination.NextPageOffset != 3 || pagination.TotalResults != 4 {
+		t.Errorf("Expected page dbc with more at 3, got %s %+v", ids, pagination)
+	}
+
+	items, _ = PageUserGames(entries, &v1.ListMyGamesRequest{OnlyMyTurn: true})
+	if len(items) != 2 {
+		t.Errorf("Expected 2 games waiting on the user, got %v", items)
+	}
+}
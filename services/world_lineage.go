@@ -0,0 +1,139 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// PrepareWorldCopy fills in the lineage of a world created as a copy of
+// another.  The parent revision defaults to the parent's latest and, if the
+// request has no world data, the parent's data at that revision is copied.
+// A parent this server does not have is only allowed when the data comes
+// with the request, so a world moved from another server keeps the lineage
+// it had there.
+func (s *BackendWorldsService) PrepareWorldCopy(ctx context.Context, req *v1.CreateWorldRequest) error {
+	world := req.World
+	if world.ParentWorldId == "" {
+		world.ParentRevision = 0
+		return nil
+	}
+	world.ParentWorldId = NormalizeWorldID(world.ParentWorldId)
+	parent, err := s.Self.GetWorld(ctx, &v1.GetWorldRequest{
+		Id:      world.ParentWorldId,
+		Version: WorldRevisionVersion(world.ParentRevision),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound && req.WorldData != nil {
+			return nil
+		}
+		return err
+	}
+	world.ParentRevision = parent.WorldData.GetVersion()
+	if req.WorldData == nil {
+		data := proto.Clone(parent.WorldData).(*v1.WorldData)
+		data.Version = 0
+		data.ScreenshotIndexInfo = nil
+		req.WorldData = data
+	}
+	return nil
+}
+
+// ListDerivedWorlds returns a page of the worlds copied from a world,
+// newest first
+func (s *BackendWorldsService) ListDerivedWorlds(ctx context.Context, req *v1.ListDerivedWorldsRequest) (*v1.ListDerivedWorldsResponse, error) {
+	if req.WorldId == "" {
+		return nil, fmt.Errorf("world ID is required")
+	}
+	resp, err := s.Self.ListWorlds(ctx, &v1.ListWorldsRequest{
+		Pagination:    req.Pagination,
+		ParentWorldId: NormalizeWorldID(req.WorldId),
+		SortBy:        SortByCreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListDerivedWorldsResponse{Items: resp.Items, Pagination: resp.Pagination}, nil
+}
+
+// MergeWorldUpstream pulls the changes made to a world's parent since the
+// parent revision the world records.  That revision is the base of a
+// three-way merge with the world's latest data and the parent's upstream
+// revision.  The merge is saved with an ordinary UpdateWorld (making a new
+// revision) and the world then records the upstream revision, so the next
+// merge only brings in later changes.
+// Authorization: Only the world creator can merge into a world, anyone can
+// do a dry run.
+func (s *BackendWorldsService) MergeWorldUpstream(ctx context.Context, req *v1.MergeWorldUpstreamRequest) (*v1.MergeWorldUpstreamResponse, error) {
+	if req.WorldId == "" {
+		return nil, fmt.Errorf("world ID is required")
+	}
+	worldId := NormalizeWorldID(req.WorldId)
+	current, err := s.Self.GetWorld(ctx, &v1.GetWorldRequest{Id: worldId})
+	if err != nil {
+		return nil, err
+	}
+	world := current.World
+	if world.ParentWorldId == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "world %s is not a copy of another world", worldId)
+	}
+	if world.ParentRevision <= 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "world %s does not record the revision of %s it was copied from", worldId, world.ParentWorldId)
+	}
+	if !req.DryRun {
+		if err := authz.CanModifyWorld(ctx, world); err != nil {
+			return nil, err
+		}
+	}
+
+	upstream, err := s.Self.GetWorld(ctx, &v1.GetWorldRequest{
+		Id:      world.ParentWorldId,
+		Version: WorldRevisionVersion(req.UpstreamRevision),
+	})
+	if err != nil {
+		return nil, err
+	}
+	upstreamRevision := upstream.WorldData.GetVersion()
+	if upstreamRevision <= world.ParentRevision {
+		return nil, status.Errorf(codes.FailedPrecondition, "world %s already includes revision %d of %s", worldId, upstreamRevision, world.ParentWorldId)
+	}
+	base, err := s.Self.GetWorld(ctx, &v1.GetWorldRequest{
+		Id:      world.ParentWorldId,
+		Version: WorldRevisionVersion(world.ParentRevision),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	merge := lib.MergeWorldData(base.WorldData, current.WorldData, upstream.WorldData, req.Strategy)
+	resp := &v1.MergeWorldUpstreamResponse{
+		World:            world,
+		WorldData:        merge.Data,
+		BaseRevision:     world.ParentRevision,
+		UpstreamRevision: upstreamRevision,
+		Applied:          merge.Applied,
+		Conflicts:        merge.Conflicts,
+	}
+	if req.DryRun || (len(merge.Conflicts) > 0 && req.Strategy == v1.WorldMergeStrategy_WORLD_MERGE_STRATEGY_UNSPECIFIED) {
+		return resp, nil
+	}
+
+	updated, err := s.Self.UpdateWorld(ctx, &v1.UpdateWorldRequest{
+		World:     &v1.World{Id: worldId, ParentRevision: upstreamRevision},
+		WorldData: merge.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.World, resp.WorldData, resp.Merged = updated.World, updated.WorldData, true
+	return resp, nil
+}
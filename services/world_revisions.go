@@ -0,0 +1,239 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WorldRevisionStore keeps the immutable revisions of worlds.  A revision
+// is numbered by the WorldData version it captures.
+type WorldRevisionStore interface {
+	// SaveRevision stores a revision.  Revisions are immutable so
+	// saving one that already exists leaves the stored one alone.
+	SaveRevision(ctx context.Context, revision *v1.WorldRevision) error
+
+	// LoadRevision returns a revision with its world data, or a
+	// NotFound error
+	LoadRevision(ctx context.Context, worldId string, revision int64) (*v1.WorldRevision, error)
+
+	// ListRevisions returns a world's revisions newest first, without
+	// their world data
+	ListRevisions(ctx context.Context, worldId string) ([]*v1.WorldRevision, error)
+
+	// DeleteRevisions removes every revision of a world
+	DeleteRevisions(ctx context.Context, worldId string) error
+}
+
+// revertKey marks the context of the UpdateWorld a RevertWorld makes
+type revertKey struct{}
+
+// NewWorldRevision snapshots world data as a revision.  The data is copied
+// and its screenshot bookkeeping dropped.
+func NewWorldRevision(worldId string, data *v1.WorldData, authorId string) *v1.WorldRevision {
+	snapshot := proto.Clone(data).(*v1.WorldData)
+	snapshot.ScreenshotIndexInfo = nil
+	return &v1.WorldRevision{
+		WorldId:     worldId,
+		Revision:    snapshot.Version,
+		CreatedAt:   tspb.New(time.Now()),
+		AuthorId:    authorId,
+		ContentHash: WorldContentHash(snapshot),
+		TileCount:   int32(len(snapshot.TilesMap)),
+		UnitCount:   int32(len(snapshot.UnitsMap)),
+		WorldData:   snapshot,
+	}
+}
+
+// WorldContentHash hashes the tiles, units and crossings of world data, so
+// two revisions with the same content hash the same
+func WorldContentHash(data *v1.WorldData) string {
+	content := &v1.WorldData{
+		TilesMap:  data.GetTilesMap(),
+		UnitsMap:  data.GetUnitsMap(),
+		Crossings: data.GetCrossings(),
+	}
+	bytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(content)
+	if err != nil {
+		return ""
+	}
+	hash := sha256.Sum256(bytes)
+	return hex.EncodeToString(hash[:])
+}
+
+// RecordWorldRevision records the revision a save of a world's data made.
+// Worlds saved before revisions were kept have no revision for the data
+// the save replaced, so previous (if given) is recorded first.  Failures
+// are logged rather than failing the save that already happened.
+func (s *BackendWorldsService) RecordWorldRevision(ctx context.Context, worldId string, previous, data *v1.WorldData) {
+	if s.RevisionStore == nil || data == nil {
+		return
+	}
+	if previous != nil && previous.Version != data.Version {
+		s.ensureWorldRevision(ctx, worldId, previous)
+	}
+	revision := NewWorldRevision(worldId, data, authz.GetUserIDFromContext(ctx))
+	if from, ok := ctx.Value(revertKey{}).(int64); ok {
+		revision.RevertedFrom = from
+	}
+	if err := s.RevisionStore.SaveRevision(ctx, revision); err != nil {
+		log.Printf("Failed to record revision %d of world %s: %v", revision.Revision, worldId, err)
+	}
+}
+
+// ensureWorldRevision records data as a revision with no author if it has
+// not been recorded yet
+func (s *BackendWorldsService) ensureWorldRevision(ctx context.Context, worldId string, data *v1.WorldData) {
+	if err := s.RevisionStore.SaveRevision(ctx, NewWorldRevision(worldId, data, "")); err != nil {
+		log.Printf("Failed to record revision %d of world %s: %v", data.Version, worldId, err)
+	}
+}
+
+// ParseWorldRevision parses GetWorldRequest.version.  An empty version or
+// "latest" returns 0.
+func ParseWorldRevision(version string) (int64, error) {
+	if version == "" || version == "latest" {
+		return 0, nil
+	}
+	revision, err := strconv.ParseInt(version, 10, 64)
+	if err != nil || revision < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid world version %q", version)
+	}
+	return revision, nil
+}
+
+// ResolveWorldRevision replaces the latest world data in a GetWorld
+// response with the data of the requested version.  Asking for the latest
+// version by number leaves the response as it is.
+func (s *BackendWorldsService) ResolveWorldRevision(ctx context.Context, version string, resp *v1.GetWorldResponse) error {
+	revision, err := ParseWorldRevision(version)
+	if err != nil {
+		return err
+	}
+	if revision == 0 || revision == resp.WorldData.GetVersion() {
+		return nil
+	}
+	if s.RevisionStore == nil {
+		return status.Errorf(codes.NotFound, "world %s has no revision %d", resp.World.GetId(), revision)
+	}
+	stored, err := s.RevisionStore.LoadRevision(ctx, resp.World.GetId(), revision)
+	if err != nil {
+		return err
+	}
+	resp.WorldData = stored.WorldData
+	return nil
+}
+
+// ListWorldRevisions returns a page of a world's revisions, newest first
+func (s *BackendWorldsService) ListWorldRevisions(ctx context.Context, req *v1.ListWorldRevisionsRequest) (*v1.ListWorldRevisionsResponse, error) {
+	if req.WorldId == "" {
+		return nil, fmt.Errorf("world ID is required")
+	}
+	if s.RevisionStore == nil {
+		return nil, fmt.Errorf("world revisions not configured")
+	}
+	worldId := NormalizeWorldID(req.WorldId)
+	current, err := s.Self.GetWorld(ctx, &v1.GetWorldRequest{Id: worldId})
+	if err != nil {
+		return nil, err
+	}
+	// Worlds untouched since revisions were introduced get one now
+	s.ensureWorldRevision(ctx, worldId, current.WorldData)
+
+	revisions, err := s.RevisionStore.ListRevisions(ctx, worldId)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := DefaultListPageSize
+	if size := int(req.Pagination.GetPageSize()); size > 0 {
+		pageSize = min(size, MaxListPageSize)
+	}
+	offset := min(max(int(req.Pagination.GetPageOffset()), 0), len(revisions))
+	end := min(offset+pageSize, len(revisions))
+	resp := &v1.ListWorldRevisionsResponse{
+		Items: revisions[offset:end],
+		Pagination: &v1.PaginationResponse{
+			HasMore:      end < len(revisions),
+			TotalResults: int32(len(revisions)),
+		},
+	}
+	if resp.Pagination.HasMore {
+		resp.Pagination.NextPageOffset = int32(end)
+	}
+	return resp, nil
+}
+
+// RevertWorld saves the data of an older revision as the world's latest
+// data.  This is an ordinary UpdateWorld so it makes a new revision (with
+// reverted_from set) and the revisions after the one reverted to are kept.
+// Authorization: Only the world creator can revert a world.
+func (s *BackendWorldsService) RevertWorld(ctx context.Context, req *v1.RevertWorldRequest) (*v1.RevertWorldResponse, error) {
+	if req.WorldId == "" {
+		return nil, fmt.Errorf("world ID is required")
+	}
+	if s.RevisionStore == nil {
+		return nil, fmt.Errorf("world revisions not configured")
+	}
+	worldId := NormalizeWorldID(req.WorldId)
+	current, err := s.Self.GetWorld(ctx, &v1.GetWorldRequest{Id: worldId})
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.CanModifyWorld(ctx, current.World); err != nil {
+		return nil, err
+	}
+	if req.Revision == current.WorldData.GetVersion() {
+		return nil, status.Errorf(codes.FailedPrecondition, "world %s is already at revision %d", worldId, req.Revision)
+	}
+	revision, err := s.RevisionStore.LoadRevision(ctx, worldId, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty maps rather than nil so UpdateWorld does not keep the current
+	// tiles, units or crossings
+	data := proto.Clone(revision.WorldData).(*v1.WorldData)
+	data.Version = current.WorldData.GetVersion()
+	if data.TilesMap == nil {
+		data.TilesMap = map[string]*v1.Tile{}
+	}
+	if data.UnitsMap == nil {
+		data.UnitsMap = map[string]*v1.Unit{}
+	}
+	if data.Crossings == nil {
+		data.Crossings = map[string]*v1.Crossing{}
+	}
+
+	updated, err := s.Self.UpdateWorld(context.WithValue(ctx, revertKey{}, req.Revision), &v1.UpdateWorldRequest{
+		World:     &v1.World{Id: worldId},
+		WorldData: data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RevertWorldResponse{World: updated.World, WorldData: updated.WorldData}, nil
+}
+
+// WorldRevisionVersion returns the GetWorldRequest.version that fetches a
+// revision - the latest one for 0
+func WorldRevisionVersion(revision int64) string {
+	if revision <= 0 {
+		return ""
+	}
+	return strconv.FormatInt(revision, 10)
+}
@@ -0,0 +1,628 @@
+//go:build !wasm
+// +build !wasm
+
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// KeywordsIndexType is the index type of the world search index
+const KeywordsIndexType = "keywords"
+
+// Fields search results can be sorted by besides SortByName and
+// SortByUpdatedAt
+const (
+	SortByRelevance  = "relevance"
+	SortByPopularity = "popularity"
+)
+
+// How much a query word matching each field of a world adds to its
+// relevance.  A word matching only as a prefix adds half as much.
+const (
+	nameWordWeight        = 8
+	tagWordWeight         = 4
+	difficultyWordWeight  = 2
+	descriptionWordWeight = 1
+)
+
+// WorldSearchStore keeps the entries of the world search index
+type WorldSearchStore interface {
+	// SaveWorldSearchEntry creates or replaces the entry of a world
+	SaveWorldSearchEntry(ctx context.Context, entry *v1.WorldSearchEntry) error
+
+	// DeleteWorldSearchEntry removes the entry of a world if there is one
+	DeleteWorldSearchEntry(ctx context.Context, worldId string) error
+
+	// ListWorldSearchEntries returns every entry
+	ListWorldSearchEntries(ctx context.Context) ([]*v1.WorldSearchEntry, error)
+}
+
+// WorldSearchIndex is an embedded full-text and faceted index of worlds.
+// Entries are held in memory with an inverted index of the words in their
+// names, tags, difficulty and description, and are kept in a
+// WorldSearchStore so a restart does not have to index every world again.
+type WorldSearchIndex struct {
+	Store WorldSearchStore
+
+	mu      sync.Mutex
+	entries map[string]*v1.WorldSearchEntry
+
+	// word -> world id -> weight of the field the word is in
+	words map[string]map[string]float64
+
+	// The words in order for prefix lookups - nil when words changed
+	sortedWords []string
+}
+
+// NewWorldSearchIndex creates an empty index kept in store.  A nil store
+// keeps the index in memory only.
+func NewWorldSearchIndex(store WorldSearchStore) *WorldSearchIndex {
+	return &WorldSearchIndex{
+		Store:   store,
+		entries: map[string]*v1.WorldSearchEntry{},
+		words:   map[string]map[string]float64{},
+	}
+}
+
+// Load adds the entries in the store to the index
+func (x *WorldSearchIndex) Load(ctx context.Context) error {
+	if x.Store == nil {
+		return nil
+	}
+	entries, err := x.Store.ListWorldSearchEntries(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list world search entries: %w", err)
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for _, entry := range entries {
+		x.remove(entry.World.Id)
+		x.add(entry)
+	}
+	return nil
+}
+
+// Entry returns the entry of a world or nil if it is not indexed
+func (x *WorldSearchIndex) Entry(worldId string) *v1.WorldSearchEntry {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.entries[worldId]
+}
+
+// Entries returns every entry
+func (x *WorldSearchIndex) Entries() []*v1.WorldSearchEntry {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	entries := make([]*v1.WorldSearchEntry, 0, len(x.entries))
+	for _, entry := range x.entries {
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// Put adds or replaces the entry of a world.  Entries are not changed once
+// put so callers must not modify them afterwards.
+func (x *WorldSearchIndex) Put(ctx context.Context, entry *v1.WorldSearchEntry) error {
+	if x.Store != nil {
+		if err := x.Store.SaveWorldSearchEntry(ctx, entry); err != nil {
+			return fmt.Errorf("failed to save search entry of world %s: %w", entry.World.Id, err)
+		}
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.remove(entry.World.Id)
+	x.add(entry)
+	return nil
+}
+
+// Remove removes a world from the index
+func (x *WorldSearchIndex) Remove(ctx context.Context, worldId string) error {
+	if x.Store != nil {
+		if err := x.Store.DeleteWorldSearchEntry(ctx, worldId); err != nil {
+			return fmt.Errorf("failed to delete search entry of world %s: %w", worldId, err)
+		}
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.remove(worldId)
+	return nil
+}
+
+// searchWords splits text into the lower cased words that are indexed and
+// searched for
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// entryWords returns the words of an entry and the weight of the most
+// important field each is in
+func entryWords(entry *v1.WorldSearchEntry) map[string]float64 {
+	weights := map[string]float64{}
+	addWords := func(text string, weight float64) {
+		for _, word := range searchWords(text) {
+			weights[word] = max(weights[word], weight)
+		}
+	}
+	world := entry.World
+	addWords(world.Name, nameWordWeight)
+	for _, tag := range world.Tags {
+		addWords(tag, tagWordWeight)
+	}
+	addWords(world.Difficulty, difficultyWordWeight)
+	addWords(world.Description, descriptionWordWeight)
+	return weights
+}
+
+func (x *WorldSearchIndex) add(entry *v1.WorldSearchEntry) {
+	id := entry.World.Id
+	for word, weight := range entryWords(entry) {
+		if x.words[word] == nil {
+			x.words[word] = map[string]float64{}
+			x.sortedWords = nil
+		}
+		x.words[word][id] = weight
+	}
+	x.entries[id] = entry
+}
+
+func (x *WorldSearchIndex) remove(worldId string) {
+	entry := x.entries[worldId]
+	if entry == nil {
+		return
+	}
+	for word := range entryWords(entry) {
+		delete(x.words[word], worldId)
+		if len(x.words[word]) == 0 {
+			delete(x.words, word)
+			x.sortedWords = nil
+		}
+	}
+	delete(x.entries, worldId)
+}
+
+// match returns the worlds with a word starting with a query word and how
+// relevant the word is to each
+func (x *WorldSearchIndex) match(queryWord string) map[string]float64 {
+	if x.sortedWords == nil {
+		x.sortedWords = make([]string, 0, len(x.words))
+		for word := range x.words {
+			x.sortedWords = append(x.sortedWords, word)
+		}
+		slices.Sort(x.sortedWords)
+	}
+	scores := map[string]float64{}
+	start, _ := slices.BinarySearch(x.sortedWords, queryWord)
+	for _, word := range x.sortedWords[start:] {
+		if !strings.HasPrefix(word, queryWord) {
+			break
+		}
+		for id, weight := range x.words[word] {
+			if word != queryWord {
+				weight /= 2
+			}
+			scores[id] = max(scores[id], weight)
+		}
+	}
+	return scores
+}
+
+// Search returns a page of the worlds matching a search and the facets of
+// all of them
+func (x *WorldSearchIndex) Search(req *v1.SearchWorldsRequest) (*v1.SearchWorldsResponse, error) {
+	query := searchWords(req.Query)
+	sortBy := req.SortBy
+	if sortBy == "" {
+		sortBy = SortByPopularity
+		if len(query) > 0 {
+			sortBy = SortByRelevance
+		}
+	}
+	// Best, most popular and newest first, A-Z for names
+	var descending bool
+	switch sortBy {
+	case SortByRelevance, SortByPopularity, SortByUpdatedAt:
+		descending = true
+	case SortByName:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort_by %q: must be one of %s, %s, %s or %s", req.SortBy, SortByRelevance, SortByPopularity, SortByName, SortByUpdatedAt)
+	}
+	switch req.SortOrder {
+	case "":
+	case "asc":
+		descending = false
+	case "desc":
+		descending = true
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort_order %q: must be asc or desc", req.SortOrder)
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	// Every query word has to match
+	var scores map[string]float64
+	if len(query) == 0 {
+		scores = map[string]float64{}
+		for id := range x.entries {
+			scores[id] = 0
+		}
+	}
+	for i, word := range query {
+		matches := x.match(word)
+		if i == 0 {
+			scores = matches
+			continue
+		}
+		for id := range scores {
+			if score, ok := matches[id]; ok {
+				scores[id] += score
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+
+	var results []*v1.WorldSearchEntry
+	tags, difficulties, playerCounts := map[string]int32{}, map[string]int32{}, map[string]int32{}
+	for id := range scores {
+		entry := x.entries[id]
+		if !matchesWorldSearch(req, entry) {
+			continue
+		}
+		results = append(results, entry)
+		for _, tag := range entry.World.Tags {
+			tags[tag]++
+		}
+		if entry.World.Difficulty != "" {
+			difficulties[entry.World.Difficulty]++
+		}
+		if entry.Players > 0 {
+			playerCounts[strconv.Itoa(int(entry.Players))]++
+		}
+	}
+
+	slices.SortFunc(results, func(a, b *v1.WorldSearchEntry) int {
+		var c int
+		switch sortBy {
+		case SortByRelevance:
+			c = cmp.Or(cmp.Compare(scores[a.World.Id], scores[b.World.Id]), cmp.Compare(a.Games, b.Games))
+		case SortByPopularity:
+			c = cmp.Compare(a.Games, b.Games)
+		case SortByUpdatedAt:
+			c = a.World.UpdatedAt.AsTime().Compare(b.World.UpdatedAt.AsTime())
+		case SortByName:
+			c = strings.Compare(strings.ToLower(a.World.Name), strings.ToLower(b.World.Name))
+		}
+		if descending {
+			c = -c
+		}
+		return cmp.Or(c, strings.Compare(a.World.Name, b.World.Name), strings.Compare(a.World.Id, b.World.Id))
+	})
+
+	pageSize, offset := DefaultListPageSize, 0
+	if req.Pagination != nil {
+		if req.Pagination.PageSize > 0 {
+			pageSize = min(int(req.Pagination.PageSize), MaxListPageSize)
+		}
+		offset = min(max(int(req.Pagination.PageOffset), 0), len(results))
+	}
+	end := min(offset+pageSize, len(results))
+	resp := &v1.SearchWorldsResponse{
+		Pagination: &v1.PaginationResponse{
+			HasMore:      end < len(results),
+			TotalResults: int32(len(results)),
+		},
+		Tags:         facetCounts(tags),
+		Difficulties: facetCounts(difficulties),
+		PlayerCounts: facetCounts(playerCounts),
+	}
+	if resp.Pagination.HasMore {
+		resp.Pagination.NextPageOffset = int32(end)
+	}
+	for _, entry := range results[offset:end] {
+		resp.Items = append(resp.Items, proto.Clone(entry.World).(*v1.World))
+	}
+	return resp, nil
+}
+
+// matchesWorldSearch checks the facet filters of a search.  Tags and
+// difficulties are matched ignoring case.
+func matchesWorldSearch(req *v1.SearchWorldsRequest, entry *v1.WorldSearchEntry) bool {
+	world := entry.World
+	for _, tag := range req.Tags {
+		if !slices.ContainsFunc(world.Tags, func(t string) bool { return strings.EqualFold(t, tag) }) {
+			return false
+		}
+	}
+	if len(req.Difficulties) > 0 && !slices.ContainsFunc(req.Difficulties, func(d string) bool { return strings.EqualFold(d, world.Difficulty) }) {
+		return false
+	}
+	if (req.MinPlayers > 0 && entry.Players < req.MinPlayers) || (req.MaxPlayers > 0 && entry.Players > req.MaxPlayers) {
+		return false
+	}
+	return (req.MinTiles <= 0 || entry.Tiles >= req.MinTiles) && (req.MaxTiles <= 0 || entry.Tiles <= req.MaxTiles)
+}
+
+// facetCounts orders the counts of a facet most common first
+func facetCounts(counts map[string]int32) []*v1.SearchFacetCount {
+	var facets []*v1.SearchFacetCount
+	for value, count := range counts {
+		facets = append(facets, &v1.SearchFacetCount{Value: value, Count: count})
+	}
+	slices.SortFunc(facets, func(a, b *v1.SearchFacetCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), strings.Compare(a.Value, b.Value))
+	})
+	return facets
+}
+
+// NewWorldSearchEntry builds the search entry of a world
+func NewWorldSearchEntry(world *v1.World, worldData *v1.WorldData, games int32, indexedAt time.Time) *v1.WorldSearchEntry {
+	players := map[int32]bool{}
+	for _, tile := range worldData.GetTilesMap() {
+		if tile.Player > 0 {
+			players[tile.Player] = true
+		}
+	}
+	for _, unit := range worldData.GetUnitsMap() {
+		if unit.Player > 0 {
+			players[unit.Player] = true
+		}
+	}
+	return &v1.WorldSearchEntry{
+		World:     world,
+		Players:   int32(len(players)),
+		Tiles:     int32(len(worldData.GetTilesMap())),
+		Games:     games,
+		IndexedAt: tspb.New(indexedAt),
+	}
+}
+
+// WorldSearchIndexer keeps a WorldSearchIndex up to date.  It is both the
+// IndexSource and the IndexQueue of the "keywords" index so an
+// IndexReconciler finds the worlds created or updated since they were
+// indexed and sends them back here.  Games are created without updating
+// their world so popularity is recounted every RefreshInterval instead.
+type WorldSearchIndexer struct {
+	Index  *WorldSearchIndex
+	Worlds v1s.WorldsServiceServer
+
+	// Where games are counted for popularity - nil leaves them uncounted
+	Games v1s.GamesServiceServer
+
+	// How often Start recounts games and drops worlds deleted through
+	// another server
+	RefreshInterval time.Duration
+
+	// Clock - overridable for tests
+	Now func() time.Time
+
+	mu        sync.Mutex
+	onIndexed []func(items []ScreenShotItem)
+	stop      chan bool
+}
+
+// NewWorldSearchIndexer creates an indexer of worlds into index that
+// refreshes popularity every 15 minutes
+func NewWorldSearchIndexer(index *WorldSearchIndex, worlds v1s.WorldsServiceServer, games v1s.GamesServiceServer) *WorldSearchIndexer {
+	return &WorldSearchIndexer{
+		Index:           index,
+		Worlds:          worlds,
+		Games:           games,
+		RefreshInterval: 15 * time.Minute,
+		Now:             time.Now,
+	}
+}
+
+// IndexEntityType implements IndexSource
+func (w *WorldSearchIndexer) IndexEntityType() string {
+	return "worlds"
+}
+
+// IndexType implements IndexSource
+func (w *WorldSearchIndexer) IndexType() string {
+	return KeywordsIndexType
+}
+
+// ListIndexEntities implements IndexSource
+func (w *WorldSearchIndexer) ListIndexEntities(ctx context.Context, since time.Time) ([]IndexEntity, error) {
+	return listWorldIndexEntities(ctx, w.Worlds, since)
+}
+
+// LoadIndexItem implements IndexSource.  A world is indexed if its entry
+// was indexed after the world was last updated.
+func (w *WorldSearchIndexer) LoadIndexItem(ctx context.Context, id string) (int64, *v1.WorldData, *v1.IndexInfo, error) {
+	resp, err := w.Worlds.GetWorld(ctx, &v1.GetWorldRequest{Id: id})
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	info := &v1.IndexInfo{LastUpdatedAt: resp.World.GetUpdatedAt(), NeedsIndexing: true}
+	if entry := w.Index.Entry(id); entry != nil {
+		info.LastIndexedAt = entry.IndexedAt
+		info.NeedsIndexing = entry.IndexedAt.AsTime().Before(resp.World.GetUpdatedAt().AsTime())
+	}
+	return resp.WorldData.GetVersion(), resp.WorldData, info, nil
+}
+
+// IndexQueue implements IndexSource
+func (w *WorldSearchIndexer) IndexQueue() IndexQueue {
+	return w
+}
+
+// Send implements IndexQueue.  Worlds are indexed as they are sent as it
+// is quick compared to screenshots.
+func (w *WorldSearchIndexer) Send(kind string, id string, version int64, worldData *v1.WorldData) {
+	item := ScreenShotItem{Kind: kind, Id: id, Version: version, ThemeErrors: map[string]error{}}
+	updatedAt, err := w.IndexWorld(context.Background(), id)
+	if err != nil {
+		log.Printf("Failed to index world %s for search: %v", id, err)
+		item.ThemeErrors[KeywordsIndexType] = err
+	}
+	item.UpdatedAt = updatedAt
+
+	w.mu.Lock()
+	onIndexed := slices.Clone(w.onIndexed)
+	w.mu.Unlock()
+	for _, fn := range onIndexed {
+		fn([]ScreenShotItem{item})
+	}
+}
+
+// OnIndexed implements IndexQueue
+func (w *WorldSearchIndexer) OnIndexed(fn func(items []ScreenShotItem)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.onIndexed = append(w.onIndexed, fn)
+}
+
+// IndexWorld puts the current world into the index, or removes it if it
+// was deleted, and returns when it was last updated
+func (w *WorldSearchIndexer) IndexWorld(ctx context.Context, id string) (*tspb.Timestamp, error) {
+	resp, err := w.Worlds.GetWorld(ctx, &v1.GetWorldRequest{Id: id})
+	if status.Code(err) == codes.NotFound {
+		return nil, w.Index.Remove(ctx, id)
+	} else if err != nil {
+		return nil, err
+	}
+	games, err := w.countGames(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	entry := NewWorldSearchEntry(resp.World, resp.WorldData, games[id], w.Now())
+	return resp.World.GetUpdatedAt(), w.Index.Put(ctx, entry)
+}
+
+// countGames counts the games created from a world, or from every world
+// when worldId is empty
+func (w *WorldSearchIndexer) countGames(ctx context.Context, worldId string) (map[string]int32, error) {
+	counts := map[string]int32{}
+	if w.Games == nil {
+		return counts, nil
+	}
+	req := &v1.ListGamesRequest{WorldId: worldId, Pagination: &v1.Pagination{PageSize: MaxListPageSize}}
+	for {
+		resp, err := w.Games.ListGames(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list games: %w", err)
+		}
+		for _, game := range resp.Items {
+			counts[game.WorldId]++
+		}
+		if !resp.Pagination.GetHasMore() || resp.Pagination.NextPageKey == "" {
+			return counts, nil
+		}
+		req.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+}
+
+// Refresh recounts the games of every indexed world and drops the worlds
+// that no longer exist.  Worlds deleted through this server are removed as
+// they are deleted but each server keeps its own index.
+func (w *WorldSearchIndexer) Refresh(ctx context.Context) error {
+	worlds, err := listWorldIndexEntities(ctx, w.Worlds, time.Time{})
+	if err != nil {
+		return err
+	}
+	games, err := w.countGames(ctx, "")
+	if err != nil {
+		return err
+	}
+	exists := map[string]bool{}
+	for _, world := range worlds {
+		exists[world.Id] = true
+	}
+	for _, entry := range w.Index.Entries() {
+		id := entry.World.Id
+		if !exists[id] {
+			err = w.Index.Remove(ctx, id)
+		} else if entry.Games != games[id] {
+			updated := proto.Clone(entry).(*v1.WorldSearchEntry)
+			updated.Games = games[id]
+			err = w.Index.Put(ctx, updated)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Start launches the background loop refreshing the index every
+// RefreshInterval, beginning with a refresh now
+func (w *WorldSearchIndexer) Start() {
+	w.mu.Lock()
+	if w.stop != nil {
+		w.mu.Unlock()
+		return
+	}
+	w.stop = make(chan bool)
+	stop := w.stop
+	w.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(w.RefreshInterval)
+		defer ticker.Stop()
+		for {
+			if err := w.Refresh(context.Background()); err != nil {
+				log.Printf("World search refresh failed: %v", err)
+			}
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop halts the background loop
+func (w *WorldSearchIndexer) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.stop != nil {
+		close(w.stop)
+		w.stop = nil
+	}
+}
+
+// InitializeSearchIndex serves SearchWorlds from index and removes worlds
+// from it as they are deleted
+func (s *BackendWorldsService) InitializeSearchIndex(index *WorldSearchIndex) {
+	s.SearchIndex = index
+}
+
+// SearchWorlds searches the world search index
+func (s *BackendWorldsService) SearchWorlds(ctx context.Context, req *v1.SearchWorldsRequest) (*v1.SearchWorldsResponse, error) {
+	if s.SearchIndex == nil {
+		return nil, status.Error(codes.Unimplemented, "world search is not enabled")
+	}
+	return s.SearchIndex.Search(req)
+}
+
+// RemoveFromSearchIndex removes a deleted world from the search index
+func (s *BackendWorldsService) RemoveFromSearchIndex(ctx context.Context, worldId string) {
+	if s.SearchIndex == nil {
+		return
+	}
+	if err := s.SearchIndex.Remove(ctx, worldId); err != nil {
+		log.Printf("Failed to remove world %s from the search index: %v", worldId, err)
+	}
+}
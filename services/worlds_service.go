@@ -29,6 +29,23 @@ type WorldsService interface {
 	DeleteWorld(context.Context, *v1.DeleteWorldRequest) (*v1.DeleteWorldResponse, error)
 	// GetWorld returns a specific world with metadata
 	UpdateWorld(context.Context, *v1.UpdateWorldRequest) (*v1.UpdateWorldResponse, error)
+	// ListWorldRevisions returns a world's immutable revisions, newest first
+	ListWorldRevisions(context.Context, *v1.ListWorldRevisionsRequest) (*v1.ListWorldRevisionsResponse, error)
+	// *
+	// Revert a world to an older revision.  The old data is saved as a new
+	// revision rather than discarding the ones after it.
+	RevertWorld(context.Context, *v1.RevertWorldRequest) (*v1.RevertWorldResponse, error)
+	// ListDerivedWorlds returns the worlds copied (remixed) from a world
+	ListDerivedWorlds(context.Context, *v1.ListDerivedWorldsRequest) (*v1.ListDerivedWorldsResponse, error)
+	// *
+	// Merge the changes made to a world's parent since it was copied into
+	// the world, with a three-way merge of tiles, units and crossings.
+	MergeWorldUpstream(context.Context, *v1.MergeWorldUpstreamRequest) (*v1.MergeWorldUpstreamResponse, error)
+	// *
+	// Search worlds by free text, tags, difficulty, player count and map
+	// size.  Results come from the world search index so recent changes show
+	// up once the world is reindexed.
+	SearchWorlds(context.Context, *v1.SearchWorldsRequest) (*v1.SearchWorldsResponse, error)
 }
 
 type BaseWorldsService struct {
@@ -0,0 +1,187 @@
+//go:build !wasm
+// +build !wasm
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// endTurnGroup is a move group of player 1 ending their turn
+func endTurnGroup(number int64) *v1.GameMoveGroup {
+	return &v1.GameMoveGroup{GroupNumber: number, Moves: []*v1.GameMove{{
+		Player:   1,
+		MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}},
+	}}}
+}
+
+// newFSMoveLogGame saves a game with its initial state under dir and
+// returns the service holding it
+func newFSMoveLogGame(t *testing.T, dir string) *fsbe.FSGamesService {
+	t.Helper()
+	svc := fsbe.NewFSGamesService(dir, nil)
+	ctx := context.Background()
+	game := createTestGame("log-game", []*v1.GamePlayer{
+		{PlayerId: 1, PlayerType: "human", UserId: "alice"},
+		{PlayerId: 2, PlayerType: "human", UserId: "bob"},
+	})
+	if err := svc.SaveGame(ctx, game.Id, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	state := createTestGameState()
+	state.GameId = game.Id
+	if err := svc.SaveGameState(ctx, game.Id, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+	return svc
+}
+
+// saveFSGroups saves count end turn groups the way ProcessMoves does
+func saveFSGroups(t *testing.T, svc *fsbe.FSGamesService, count int) {
+	t.Helper()
+	ctx := context.Background()
+	for range count {
+		state, err := svc.LoadGameState(ctx, "log-game")
+		if err != nil {
+			t.Fatalf("LoadGameState failed: %v", err)
+		}
+		state.CurrentGroupNumber++
+		if err := svc.SaveMoveGroup(ctx, "log-game", state, endTurnGroup(state.CurrentGroupNumber)); err != nil {
+			t.Fatalf("SaveMoveGroup failed: %v", err)
+		}
+	}
+}
+
+// reopenedHistory loads the game's history the way a restarted server does
+func reopenedHistory(t *testing.T, dir string) *v1.GameMoveHistory {
+	t.Helper()
+	history, err := fsbe.NewFSGamesService(dir, nil).LoadGameHistory(context.Background(), "log-game")
+	if err != nil {
+		t.Fatalf("LoadGameHistory failed: %v", err)
+	}
+	return history
+}
+
+func moveLogPath(dir string) string {
+	return filepath.Join(dir, "log-game", "moves.jsonl")
+}
+
+func appendToFile(t *testing.T, path string, data string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatalf("Failed to open %s: %v", path, err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(data); err != nil {
+		t.Fatalf("Failed to append to %s: %v", path, err)
+	}
+}
+
+func TestFSMoveLog_AppendsWithoutRewritingHistory(t *testing.T) {
+	dir := t.TempDir()
+	svc := newFSMoveLogGame(t, dir)
+	saveFSGroups(t, svc, 3)
+
+	if _, err := os.Stat(filepath.Join(dir, "log-game", "history.json")); err == nil {
+		t.Error("Expected saving moves to append to the log rather than write a snapshot")
+	}
+	if got := len(reopenedHistory(t, dir).Groups); got != 3 {
+		t.Errorf("Expected 3 groups after reopening, got %d", got)
+	}
+}
+
+// A crash mid append leaves a partial record - it was never acknowledged
+// so recovery drops it and later saves append cleanly
+func TestFSMoveLog_TornTail(t *testing.T) {
+	dir := t.TempDir()
+	svc := newFSMoveLogGame(t, dir)
+	saveFSGroups(t, svc, 2)
+	appendToFile(t, moveLogPath(dir), `{"group":{"group_number":3,"mo`)
+
+	svc = fsbe.NewFSGamesService(dir, nil)
+	history, err := svc.LoadGameHistory(context.Background(), "log-game")
+	if err != nil {
+		t.Fatalf("LoadGameHistory failed: %v", err)
+	}
+	if len(history.Groups) != 2 {
+		t.Fatalf("Expected the 2 committed groups, got %d", len(history.Groups))
+	}
+
+	saveFSGroups(t, svc, 1)
+	history = reopenedHistory(t, dir)
+	if len(history.Groups) != 3 || history.Groups[2].GroupNumber != 3 {
+		t.Errorf("Expected groups 1-3 after saving past the torn record, got %v", history.Groups)
+	}
+	data, _ := os.ReadFile(moveLogPath(dir))
+	for _, line := range bytes.Split(bytes.TrimSuffix(data, []byte("\n")), []byte("\n")) {
+		if !json.Valid(line) {
+			t.Errorf("Expected the torn record to be cut off before appending, got %s", line)
+		}
+	}
+}
+
+// Moves saved without reaching the state are orphans
+func TestFSMoveLog_UncommittedGroup(t *testing.T) {
+	dir := t.TempDir()
+	svc := newFSMoveLogGame(t, dir)
+	saveFSGroups(t, svc, 1)
+	if err := svc.SaveMoves(context.Background(), "log-game", endTurnGroup(2), 2); err != nil {
+		t.Fatalf("SaveMoves failed: %v", err)
+	}
+
+	if got := len(reopenedHistory(t, dir).Groups); got != 1 {
+		t.Errorf("Expected only the committed group, got %d", got)
+	}
+}
+
+// A crash after saving the state but before writing the commit marker
+// still committed the group
+func TestFSMoveLog_MissingCommitMarker(t *testing.T) {
+	dir := t.TempDir()
+	svc := newFSMoveLogGame(t, dir)
+	saveFSGroups(t, svc, 2)
+
+	data, err := os.ReadFile(moveLogPath(dir))
+	if err != nil {
+		t.Fatalf("Failed to read move log: %v", err)
+	}
+	lines := bytes.SplitAfter(bytes.TrimSuffix(data, []byte("\n")), []byte("\n"))
+	if err := os.WriteFile(moveLogPath(dir), bytes.Join(lines[:len(lines)-1], nil), 0644); err != nil {
+		t.Fatalf("Failed to drop the commit marker: %v", err)
+	}
+
+	if got := len(reopenedHistory(t, dir).Groups); got != 2 {
+		t.Errorf("Expected the state to commit group 2, got %d groups", got)
+	}
+}
+
+func TestFSMoveLog_Compaction(t *testing.T) {
+	defer func(size int64) { fsbe.MoveLogCompactBytes = size }(fsbe.MoveLogCompactBytes)
+	fsbe.MoveLogCompactBytes = 1
+
+	dir := t.TempDir()
+	svc := newFSMoveLogGame(t, dir)
+	saveFSGroups(t, svc, 3)
+
+	if info, err := os.Stat(moveLogPath(dir)); err != nil || info.Size() != 0 {
+		t.Errorf("Expected the log to be emptied into the snapshot, got %v %v", info, err)
+	}
+	history := reopenedHistory(t, dir)
+	if len(history.Groups) != 3 {
+		t.Fatalf("Expected 3 groups from the snapshot, got %d", len(history.Groups))
+	}
+	for i, group := range history.Groups {
+		if group.GroupNumber != int64(i+1) {
+			t.Errorf("Expected group %d, got %d", i+1, group.GroupNumber)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+//go:build !wasm
+// +build !wasm
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/conformance"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"google.golang.org/protobuf/proto"
+)
+
+// playArchivedGame plays a few turns of a game on a file backed service
+// through ProcessMoves and returns an archive of it
+func playArchivedGame(t *testing.T) *lib.GameArchive {
+	t.Helper()
+	ctx := context.Background()
+	svc := fsbe.NewFSGamesService(t.TempDir(), nil)
+	game := createTestGame("archived-game", []*v1.GamePlayer{
+		{PlayerId: 1, PlayerType: "human", UserId: "alice", StartingCoins: 100},
+		{PlayerId: 2, PlayerType: "human", UserId: "bob", StartingCoins: 100},
+	})
+	world := createTestGameState().WorldData
+	if err := svc.SaveGame(ctx, game.Id, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if err := svc.SaveGameState(ctx, game.Id, lib.NewInitialGameState(game, world)); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+
+	endTurn := &v1.GameMove{MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	turns := []struct {
+		user  string
+		moves []*v1.GameMove
+	}{
+		{"alice", []*v1.GameMove{{Player: 1, MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{
+			Attacker: &v1.Position{Q: 0, R: 0},
+			Defender: &v1.Position{Q: 1, R: 0},
+		}}}}},
+		{"alice", []*v1.GameMove{proto.Clone(endTurn).(*v1.GameMove)}},
+		{"bob", []*v1.GameMove{proto.Clone(endTurn).(*v1.GameMove)}},
+	}
+	for _, turn := range turns {
+		if _, err := svc.ProcessMoves(ContextWithUserID(turn.user), &v1.ProcessMovesRequest{GameId: game.Id, Moves: turn.moves}); err != nil {
+			t.Fatalf("ProcessMoves for %s failed: %v", turn.user, err)
+		}
+	}
+
+	archive := &lib.GameArchive{World: world, ExportedAt: time.Now()}
+	archive.RulesJSON, archive.DamageJSON = lib.DefaultRulesJSON()
+	archive.Game, _ = svc.LoadGame(ctx, game.Id)
+	archive.State, _ = svc.LoadGameState(ctx, game.Id)
+	archive.History, _ = svc.LoadGameHistory(ctx, game.Id)
+	if len(archive.History.GetGroups()) != len(turns) {
+		t.Fatalf("Expected %d move groups, got %v", len(turns), archive.History)
+	}
+	return archive
+}
+
+func TestImportGame_ReplaysArchive(t *testing.T) {
+	testImportGameReplaysArchive(t, fsbe.NewFSGamesService(t.TempDir(), nil))
+}
+
+// testImportGameReplaysArchive imports a played game into dest and carries
+// on playing it there
+func testImportGameReplaysArchive(t *testing.T, dest conformance.GamesBackend) {
+	data, hash, err := playArchivedGame(t).Marshal()
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	resp, err := dest.ImportGame(ContextWithUserID("carol"), &v1.ImportGameRequest{Archive: data})
+	if err != nil {
+		t.Fatalf("ImportGame failed: %v", err)
+	}
+	if resp.ContentHash != hash || resp.ReplayedGroups != 3 || !resp.RulesMatch {
+		t.Errorf("Unexpected import result: hash=%s groups=%d rules_match=%v", resp.ContentHash, resp.ReplayedGroups, resp.RulesMatch)
+	}
+	if resp.Game.Id != "archived-game" || resp.Game.CreatorId != "carol" {
+		t.Errorf("Expected archived-game owned by carol, got %s by %s", resp.Game.Id, resp.Game.CreatorId)
+	}
+
+	// The imported game carries on where it left off
+	ctx := context.Background()
+	history, err := dest.LoadGameHistory(ctx, "archived-game")
+	if err != nil || len(history.Groups) != 3 || history.Groups[0].Moves[0].GetAttackUnit() == nil {
+		t.Fatalf("Expected the imported history, got %v %v", history, err)
+	}
+	state, _ := dest.LoadGameState(ctx, "archived-game")
+	if _, err := dest.ProcessMoves(ContextWithUserID("alice"), &v1.ProcessMovesRequest{
+		GameId: "archived-game",
+		Moves:  []*v1.GameMove{{Player: state.CurrentPlayer, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}},
+	}); err != nil {
+		t.Errorf("Expected to keep playing the imported game: %v", err)
+	}
+
+	resp, err = dest.ImportGame(ContextWithUserID("carol"), &v1.ImportGameRequest{Archive: data})
+	if err != nil || resp.FieldErrors["id"] == "" {
+		t.Errorf("Expected importing over an existing game to suggest another ID, got %v %v", resp, err)
+	}
+	resp, err = dest.ImportGame(ContextWithUserID("carol"), &v1.ImportGameRequest{Archive: data, GameId: "copy"})
+	if err != nil || resp.Game.Id != "copy" {
+		t.Errorf("Expected the archive to import as copy, got %v %v", resp, err)
+	}
+}
+
+func TestImportGame_KeepsArchivedRules(t *testing.T) {
+	testImportGameKeepsArchivedRules(t, fsbe.NewFSGamesService(t.TempDir(), nil))
+}
+
+// testImportGameKeepsArchivedRules imports a game played with rules other
+// than the server's into dest and checks it goes on being played with them
+func testImportGameKeepsArchivedRules(t *testing.T, dest conformance.GamesBackend) {
+	// The same rules under another version, as after the server's change
+	archive := playArchivedGame(t)
+	archive.RulesJSON = append(append([]byte{}, archive.RulesJSON...), '\n')
+	archive.Game.RulesVersion = lib.RulesVersion(archive.RulesJSON, archive.DamageJSON)
+	data, _, err := archive.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	resp, err := dest.ImportGame(ContextWithUserID("carol"), &v1.ImportGameRequest{Archive: data})
+	if err != nil {
+		t.Fatalf("ImportGame failed: %v", err)
+	}
+	if resp.RulesMatch || resp.Game.RulesVersion != archive.Game.RulesVersion {
+		t.Errorf("Expected the game kept on rules version %s, got %s (rules_match=%v)",
+			archive.Game.RulesVersion, resp.Game.RulesVersion, resp.RulesMatch)
+	}
+
+	ctx := context.Background()
+	game, _ := dest.LoadGame(ctx, "archived-game")
+	state, _ := dest.LoadGameState(ctx, "archived-game")
+	rg, err := dest.GetRuntimeGame(game, state)
+	if err != nil {
+		t.Fatalf("GetRuntimeGame failed: %v", err)
+	}
+	if rg.RulesEngine == lib.DefaultRulesEngine() {
+		t.Error("Expected the imported game to be played with the archived rules")
+	}
+	if _, err := dest.ProcessMoves(ContextWithUserID("alice"), &v1.ProcessMovesRequest{
+		GameId: "archived-game",
+		Moves:  []*v1.GameMove{{Player: state.CurrentPlayer, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}},
+	}); err != nil {
+		t.Errorf("Expected to keep playing the imported game: %v", err)
+	}
+}
+
+// failingHistorySaves is a storage provider whose history saves fail
+type failingHistorySaves struct {
+	services.GameStorageProvider
+}
+
+func (failingHistorySaves) SaveGameHistory(ctx context.Context, id string, history *v1.GameMoveHistory) error {
+	return errors.New("disk full")
+}
+
+func TestImportGame_RemovesPartialImports(t *testing.T) {
+	data, _, err := playArchivedGame(t).Marshal()
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	dest := fsbe.NewFSGamesService(t.TempDir(), nil)
+	dest.StorageProvider = failingHistorySaves{dest.StorageProvider}
+
+	if _, err := dest.ImportGame(ContextWithUserID("carol"), &v1.ImportGameRequest{Archive: data}); err == nil {
+		t.Fatal("Expected the import to fail when the history cannot be saved")
+	}
+	if _, err := dest.LoadGame(context.Background(), "archived-game"); err == nil {
+		t.Error("Expected a failed import not to leave the game behind")
+	}
+}
+
+func TestImportGame_RejectsBadArchives(t *testing.T) {
+	archive := playArchivedGame(t)
+	dest := fsbe.NewFSGamesService(t.TempDir(), nil)
+
+	data, _, _ := archive.Marshal()
+	if _, err := dest.ImportGame(context.Background(), &v1.ImportGameRequest{Archive: data}); err == nil {
+		t.Error("Expected importing without a user to fail")
+	}
+
+	// The history no longer leads to the archived state
+	archive.History.Groups = archive.History.Groups[:2]
+	data, _, _ = archive.Marshal()
+	if _, err := dest.ImportGame(ContextWithUserID("carol"), &v1.ImportGameRequest{Archive: data}); err == nil {
+		t.Error("Expected an archive whose history does not replay to be rejected")
+	}
+	if _, err := dest.LoadGame(context.Background(), "archived-game"); err == nil {
+		t.Error("Expected a rejected archive not to be stored")
+	}
+}
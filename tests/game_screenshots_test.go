@@ -0,0 +1,77 @@
+//go:build !wasm
+// +build !wasm
+
+package tests
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestGameScreenshots_FollowTurns tests that a game's screenshot is only
+// flagged for rendering when a move group changes the turn
+func TestGameScreenshots_FollowTurns(t *testing.T) {
+	svc := newFSMoveLogGame(t, t.TempDir())
+	ctx := context.Background()
+	indexedAt := time.Now().Add(-time.Hour)
+
+	saveGroup := func(group *v1.GameMoveGroup) *v1.IndexInfo {
+		t.Helper()
+		state, err := svc.LoadGameState(ctx, "log-game")
+		if err != nil {
+			t.Fatalf("LoadGameState failed: %v", err)
+		}
+		state.WorldData.ScreenshotIndexInfo = &v1.IndexInfo{LastUpdatedAt: timestamppb.New(indexedAt), LastIndexedAt: timestamppb.New(indexedAt)}
+		state.CurrentGroupNumber++
+		group.GroupNumber = state.CurrentGroupNumber
+		if err := svc.SaveMoveGroup(ctx, "log-game", state, group); err != nil {
+			t.Fatalf("SaveMoveGroup failed: %v", err)
+		}
+		saved, err := svc.LoadGameState(ctx, "log-game")
+		if err != nil {
+			t.Fatalf("LoadGameState failed: %v", err)
+		}
+		return saved.WorldData.ScreenshotIndexInfo
+	}
+
+	// A move within the turn keeps the screenshot
+	info := saveGroup(&v1.GameMoveGroup{Moves: []*v1.GameMove{{
+		Player:   1,
+		MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{}},
+	}}})
+	if info.NeedsIndexing || info.LastUpdatedAt.AsTime().After(indexedAt) {
+		t.Errorf("Expected a move within the turn not to flag the screenshot, got %v", info)
+	}
+
+	// Ending the turn flags it for rendering
+	info = saveGroup(&v1.GameMoveGroup{Moves: []*v1.GameMove{{
+		Player:   1,
+		MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}},
+		Changes:  []*v1.WorldChange{{ChangeType: &v1.WorldChange_PlayerChanged{PlayerChanged: &v1.PlayerChangedChange{PreviousPlayer: 1, NewPlayer: 2}}}},
+	}}})
+	if !info.NeedsIndexing || !info.LastUpdatedAt.AsTime().After(indexedAt) {
+		t.Errorf("Expected the turn change to flag the screenshot, got %v", info)
+	}
+}
+
+func TestGameScreenshotOverlay(t *testing.T) {
+	game := createTestGame("overlay-game", []*v1.GamePlayer{{PlayerId: 1}, {PlayerId: 2}, {PlayerId: 3}})
+	state := createTestGameState()
+	state.TurnCounter, state.CurrentPlayer = 7, 2
+
+	overlay := services.GameScreenshotOverlay(game, state)
+	if overlay.Caption != "Turn 7" || overlay.CurrentPlayer != 2 || !slices.Equal(overlay.Players, []int32{1, 2, 3}) {
+		t.Errorf("Expected turn 7 with player 2 of 3 outlined, got %+v", overlay)
+	}
+
+	state.Finished, state.WinningPlayer = true, 3
+	if overlay := services.GameScreenshotOverlay(game, state); overlay.Caption != "Turn 7 - finished" || overlay.CurrentPlayer != 3 {
+		t.Errorf("Expected the winner outlined once finished, got %+v", overlay)
+	}
+}
@@ -0,0 +1,183 @@
+//go:build !wasm
+// +build !wasm
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// fakeIndexQueue records what is sent for indexing instead of rendering it
+type fakeIndexQueue struct {
+	sent      []services.ScreenShotItem
+	onIndexed []func(items []services.ScreenShotItem)
+}
+
+func (q *fakeIndexQueue) Send(kind string, id string, version int64, worldData *v1.WorldData) {
+	q.sent = append(q.sent, services.ScreenShotItem{Kind: kind, Id: id, Version: version, WorldData: worldData, ThemeErrors: map[string]error{}})
+}
+
+func (q *fakeIndexQueue) OnIndexed(fn func(items []services.ScreenShotItem)) {
+	q.onIndexed = append(q.onIndexed, fn)
+}
+
+// finish reports everything sent as indexed, failing the items in failed
+func (q *fakeIndexQueue) finish(failed ...string) {
+	for i, item := range q.sent {
+		for _, id := range failed {
+			if item.Id == id {
+				q.sent[i].ThemeErrors["default"] = errors.New("render failed")
+			}
+		}
+	}
+	for _, fn := range q.onIndexed {
+		fn(q.sent)
+	}
+	q.sent = nil
+}
+
+// worldsIndexSource is an fsbe worlds service whose screenshots go to a
+// fake queue
+type worldsIndexSource struct {
+	*fsbe.FSWorldsService
+	queue *fakeIndexQueue
+}
+
+func (s *worldsIndexSource) IndexQueue() services.IndexQueue {
+	return s.queue
+}
+
+// newTestWorldsReconciler returns a reconciler of the screenshots of worlds
+// sent to queue, whose clock reads *now
+func newTestWorldsReconciler(t *testing.T, worlds *fsbe.FSWorldsService, queue *fakeIndexQueue, now *time.Time) *services.IndexReconciler {
+	t.Helper()
+	reconciler := services.NewIndexReconciler(fsbe.NewFSIndexStateStore(t.TempDir()), &worldsIndexSource{worlds, queue})
+	reconciler.MaxPerPass = 1
+	reconciler.MaxRetries = 2
+	reconciler.Now = func() time.Time { return *now }
+	return reconciler
+}
+
+// reconcileWorlds runs a pass and returns the ids queued since the queue
+// was last finished
+func reconcileWorlds(t *testing.T, reconciler *services.IndexReconciler, queue *fakeIndexQueue) []string {
+	t.Helper()
+	if _, err := reconciler.Reconcile(context.Background()); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	var ids []string
+	for _, item := range queue.sent {
+		ids = append(ids, item.Id)
+	}
+	return ids
+}
+
+func loadWorldIndexState(t *testing.T, reconciler *services.IndexReconciler, id string) *v1.IndexState {
+	t.Helper()
+	state, err := reconciler.States.LoadIndexState(context.Background(), "worlds", id, services.ScreenshotIndexType)
+	if err != nil {
+		t.Fatalf("LoadIndexState failed: %v", err)
+	}
+	return state
+}
+
+// TestIndexReconciler_Bootstrap tests that a bootstrap queues the worlds
+// never screenshotted a few at a time and records the ones that were
+func TestIndexReconciler_Bootstrap(t *testing.T) {
+	// Worlds are stamped with the wall clock so the reconciler's starts there
+	now := time.Now()
+	worlds := fsbe.NewFSWorldsService(t.TempDir(), nil)
+	queue := &fakeIndexQueue{}
+	reconciler := newTestWorldsReconciler(t, worlds, queue, &now)
+	CreateStoredTestWorld(t, worlds, &v1.World{Id: "w-a", Name: "w-a"}, CreateTestWorldData(1, 0))
+	CreateStoredTestWorld(t, worlds, &v1.World{Id: "w-b", Name: "w-b"}, CreateTestWorldData(1, 0))
+	shot := CreateStoredTestWorld(t, worlds, &v1.World{Id: "w-c", Name: "w-c"}, CreateTestWorldData(1, 0))
+	if err := worlds.UpdateWorldDataIndexInfo(context.Background(), "w-c", shot.Version, time.Now(), false); err != nil {
+		t.Fatalf("UpdateWorldDataIndexInfo failed: %v", err)
+	}
+
+	queued, err := reconciler.Bootstrap(context.Background())
+	if err != nil || queued != 1 {
+		t.Fatalf("Expected the bootstrap to queue 1 world, queued %d (%v)", queued, err)
+	}
+	// The capped bootstrap carries on in the next passes
+	if got := reconcileWorlds(t, reconciler, queue); len(got) != 2 {
+		t.Fatalf("Expected a second world queued by the next pass, got %v", got)
+	}
+	if got := reconcileWorlds(t, reconciler, queue); len(got) != 2 {
+		t.Fatalf("Expected nothing more to queue, got %v", got)
+	}
+	if state := loadWorldIndexState(t, reconciler, "w-a"); state.Status != v1.IndexStatus_INDEX_STATUS_PENDING || !state.NeedsIndexing {
+		t.Errorf("Expected w-a pending, got %v", state)
+	}
+	if state := loadWorldIndexState(t, reconciler, "w-c"); state.Status != v1.IndexStatus_INDEX_STATUS_COMPLETED || state.NeedsIndexing {
+		t.Errorf("Expected the screenshotted w-c recorded as completed, got %v", state)
+	}
+
+	queue.finish()
+	if state := loadWorldIndexState(t, reconciler, "w-b"); state.Status != v1.IndexStatus_INDEX_STATUS_COMPLETED || state.IndexedAt == nil {
+		t.Errorf("Expected w-b completed, got %v", state)
+	}
+	if got := reconcileWorlds(t, reconciler, queue); len(got) != 0 {
+		t.Errorf("Expected nothing to queue once indexed, got %v", got)
+	}
+}
+
+// TestIndexReconciler_RetriesAndUpdates tests that failed screenshots are
+// retried with a backoff up to MaxRetries and that worlds updated since the
+// last pass are queued
+func TestIndexReconciler_RetriesAndUpdates(t *testing.T) {
+	now := time.Now()
+	worlds := fsbe.NewFSWorldsService(t.TempDir(), nil)
+	queue := &fakeIndexQueue{}
+	reconciler := newTestWorldsReconciler(t, worlds, queue, &now)
+	reconciler.MaxPerPass = 10
+	CreateStoredTestWorld(t, worlds, &v1.World{Id: "w-a", Name: "w-a"}, CreateTestWorldData(1, 0))
+	CreateStoredTestWorld(t, worlds, &v1.World{Id: "w-b", Name: "w-b"}, CreateTestWorldData(1, 0))
+	if got := reconcileWorlds(t, reconciler, queue); len(got) != 2 {
+		t.Fatalf("Expected both worlds queued, got %v", got)
+	}
+	queue.finish("w-b")
+	state := loadWorldIndexState(t, reconciler, "w-b")
+	if state.Status != v1.IndexStatus_INDEX_STATUS_FAILED || state.RetryCount != 1 || state.LastError != "default: render failed" {
+		t.Fatalf("Expected w-b failed once, got %v", state)
+	}
+
+	// An update is picked up by the next pass, the failure waits its backoff
+	before := loadWorldIndexState(t, reconciler, "w-a")
+	resp, err := worlds.GetWorld(context.Background(), &v1.GetWorldRequest{Id: "w-a"})
+	if err != nil {
+		t.Fatalf("GetWorld failed: %v", err)
+	}
+	resp.WorldData.TilesMap["1,0"] = &v1.Tile{Q: 1, R: 0, TileType: 1}
+	if _, err := worlds.UpdateWorld(ContextWithUserID("alice"), &v1.UpdateWorldRequest{World: &v1.World{Id: "w-a"}, WorldData: resp.WorldData}); err != nil {
+		t.Fatalf("UpdateWorld failed: %v", err)
+	}
+	if got := reconcileWorlds(t, reconciler, queue); len(got) != 1 || got[0] != "w-a" {
+		t.Fatalf("Expected only the updated w-a queued, got %v", got)
+	}
+	if state := loadWorldIndexState(t, reconciler, "w-a"); !state.UpdatedAt.AsTime().After(before.UpdatedAt.AsTime()) {
+		t.Errorf("Expected the update recorded on w-a's state, got %v", state)
+	}
+	queue.finish()
+
+	now = now.Add(reconciler.RetryBackoff)
+	if got := reconcileWorlds(t, reconciler, queue); len(got) != 1 || got[0] != "w-b" {
+		t.Fatalf("Expected w-b retried after the backoff, got %v", got)
+	}
+	queue.finish("w-b")
+	if state := loadWorldIndexState(t, reconciler, "w-b"); state.RetryCount != 2 {
+		t.Fatalf("Expected 2 failures, got %v", state)
+	}
+	now = now.Add(time.Hour)
+	if got := reconcileWorlds(t, reconciler, queue); len(got) != 0 {
+		t.Errorf("Expected w-b given up on after MaxRetries, got %v", got)
+	}
+}
@@ -0,0 +1,197 @@
+//go:build !wasm
+// +build !wasm
+
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var janitorNow = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// addJanitorGame saves a game last touched idle ago.  moves is the number
+// of move groups played and openSeat leaves player 2's seat open.
+func addJanitorGame(t *testing.T, games *fsbe.FSGamesService, id string, idle time.Duration, moves int64, openSeat bool, status v1.GameStatus) {
+	t.Helper()
+	seat2 := &v1.GamePlayer{PlayerId: 2, PlayerType: "human", UserId: "bob"}
+	if openSeat {
+		seat2 = &v1.GamePlayer{PlayerId: 2, PlayerType: "open"}
+	}
+	game := createTestGame(id, []*v1.GamePlayer{{PlayerId: 1, PlayerType: "human", UserId: "alice"}, seat2})
+	game.CreatorId = "alice"
+	game.CreatedAt = tspb.New(janitorNow.Add(-400 * 24 * time.Hour))
+	game.UpdatedAt = game.CreatedAt
+	if moves == 0 {
+		game.CreatedAt = tspb.New(janitorNow.Add(-idle))
+		game.UpdatedAt = game.CreatedAt
+	}
+	state := createTestGameState()
+	state.CurrentGroupNumber = moves
+	state.Status = status
+	state.UpdatedAt = tspb.New(janitorNow.Add(-idle))
+	SaveTestGame(t, games, game, state)
+}
+
+// addAgedFile writes a file to the file store last modified age ago
+func addAgedFile(t *testing.T, files *fsbe.FileStoreService, path string, age time.Duration) {
+	t.Helper()
+	if _, err := files.PutFile(context.Background(), &v1.PutFileRequest{File: &v1.File{Path: path}, Content: []byte("png")}); err != nil {
+		t.Fatalf("PutFile failed: %v", err)
+	}
+	modTime := janitorNow.Add(-age)
+	if err := os.Chtimes(filepath.Join(files.BasePath, path), modTime, modTime); err != nil {
+		t.Fatalf("Chtimes failed: %v", err)
+	}
+}
+
+func gameExists(games *fsbe.FSGamesService, id string) bool {
+	_, err := games.GetGame(context.Background(), &v1.GetGameRequest{Id: id})
+	return err == nil
+}
+
+func fileExists(files *fsbe.FileStoreService, path string) bool {
+	_, err := files.GetFile(context.Background(), &v1.GetFileRequest{Path: path})
+	return err == nil
+}
+
+// TestJanitor_DryRunThenClean tests that a dry run reports the abandoned
+// games and orphaned screenshots a real run then removes, and that
+// everything else is left alone
+func TestJanitor_DryRunThenClean(t *testing.T) {
+	day := 24 * time.Hour
+	games := fsbe.NewFSGamesService(t.TempDir(), nil)
+	worlds := fsbe.NewFSWorldsService(t.TempDir(), nil)
+	files := fsbe.NewFileStoreService(t.TempDir(), nil)
+	janitor := services.NewJanitor(games, worlds, files, services.RetentionPolicy{
+		Unstarted:        services.RetentionRule{Action: services.RetentionDelete, After: 30 * day},
+		Inactive:         services.RetentionRule{Action: services.RetentionDelete, After: 90 * day},
+		OrphanFilesAfter: 7 * day,
+	})
+	janitor.Now = func() time.Time { return janitorNow }
+	addJanitorGame(t, games, "stale-open", 60*day, 0, true, v1.GameStatus_GAME_STATUS_PLAYING)
+	addJanitorGame(t, games, "fresh-open", 2*day, 0, true, v1.GameStatus_GAME_STATUS_PLAYING)
+	addJanitorGame(t, games, "stale-playing", 200*day, 12, false, v1.GameStatus_GAME_STATUS_PLAYING)
+	addJanitorGame(t, games, "recent-playing", 3*day, 40, false, v1.GameStatus_GAME_STATUS_PLAYING)
+	addJanitorGame(t, games, "old-ended", 300*day, 80, false, v1.GameStatus_GAME_STATUS_ENDED)
+
+	CreateStoredTestWorld(t, worlds, &v1.World{Id: "live-world", Name: "Live"}, CreateTestWorldData(1, 0))
+	liveShot := "screenshots/worlds/live-world/default.png"
+	goneShot := "screenshots/worlds/deleted-world/default.png"
+	newShot := "screenshots/worlds/just-created/default.png"
+	gameShot := "screenshots/games/stale-open/default.png"
+	addAgedFile(t, files, liveShot, 30*day)
+	addAgedFile(t, files, goneShot, 30*day)
+	addAgedFile(t, files, newShot, time.Hour)
+	addAgedFile(t, files, gameShot, 60*day)
+	addAgedFile(t, files, "screenshots/games/fresh-open/default.png", 2*day)
+
+	report, err := janitor.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Dry run failed: %v", err)
+	}
+	if !report.DryRun || report.GamesScanned != 5 || report.FilesScanned != 5 {
+		t.Errorf("Unexpected dry run report: %s", report.Summary())
+	}
+	reasons := map[string]string{}
+	for _, game := range report.Games {
+		reasons[game.GameId] = game.Reason
+	}
+	if len(reasons) != 2 || reasons["stale-open"] != services.JanitorReasonUnstarted || reasons["stale-playing"] != services.JanitorReasonInactive {
+		t.Errorf("Expected stale-open (unstarted) and stale-playing (inactive), got %v", reasons)
+	}
+	orphans := map[string]bool{}
+	for _, file := range report.OrphanFiles {
+		orphans[file.Path] = true
+	}
+	if len(orphans) != 2 || !orphans[goneShot] || !orphans[gameShot] {
+		t.Errorf("Expected %s and %s to be orphans, got %v", goneShot, gameShot, orphans)
+	}
+	if !gameExists(games, "stale-open") || !fileExists(files, goneShot) {
+		t.Fatalf("A dry run should not remove anything")
+	}
+
+	janitor.DryRun = false
+	report, err = janitor.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	for _, game := range report.Games {
+		if game.Error != nil {
+			t.Errorf("Cleaning up %s failed: %v", game.GameId, game.Error)
+		}
+	}
+	for id, want := range map[string]bool{
+		"stale-open": false, "stale-playing": false, "fresh-open": true, "recent-playing": true, "old-ended": true,
+	} {
+		if got := gameExists(games, id); got != want {
+			t.Errorf("Game %s exists = %v, want %v", id, got, want)
+		}
+	}
+	for path, want := range map[string]bool{liveShot: true, goneShot: false, newShot: true, gameShot: false} {
+		if got := fileExists(files, path); got != want {
+			t.Errorf("File %s exists = %v, want %v", path, got, want)
+		}
+	}
+}
+
+// TestJanitor_KeepsGameWhenArchiveFails tests that a game whose archive
+// cannot be made is neither deleted nor has its screenshots removed
+func TestJanitor_KeepsGameWhenArchiveFails(t *testing.T) {
+	day := 24 * time.Hour
+	games := fsbe.NewFSGamesService(t.TempDir(), nil)
+	worlds := fsbe.NewFSWorldsService(t.TempDir(), nil)
+	files := fsbe.NewFileStoreService(t.TempDir(), nil)
+	janitor := services.NewJanitor(games, worlds, files, services.RetentionPolicy{
+		Inactive:         services.RetentionRule{Action: services.RetentionArchive, After: 90 * day},
+		OrphanFilesAfter: day,
+	})
+	janitor.Now = func() time.Time { return janitorNow }
+	janitor.DryRun = false
+	// Without a worlds client the game cannot be exported
+	addJanitorGame(t, games, "stale-playing", 200*day, 12, false, v1.GameStatus_GAME_STATUS_PLAYING)
+	addAgedFile(t, files, "screenshots/games/stale-playing/default.png", 200*day)
+
+	report, err := janitor.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if len(report.Games) != 1 || report.Games[0].Error == nil || report.Games[0].ArchivePath != "archives/games/stale-playing.zip" {
+		t.Fatalf("Expected a failed archive of stale-playing, got %+v", report.Games)
+	}
+	if !gameExists(games, "stale-playing") || !fileExists(files, "screenshots/games/stale-playing/default.png") {
+		t.Errorf("A game that could not be archived should be kept with its screenshots")
+	}
+	if len(report.OrphanFiles) != 0 {
+		t.Errorf("Expected no orphaned files, got %d", len(report.OrphanFiles))
+	}
+}
+
+func TestParseRetentionRule(t *testing.T) {
+	for _, tc := range []struct {
+		value   string
+		want    services.RetentionRule
+		wantErr bool
+	}{
+		{"", services.RetentionRule{Action: services.RetentionKeep}, false},
+		{"keep", services.RetentionRule{Action: services.RetentionKeep}, false},
+		{"archive:30d", services.RetentionRule{Action: services.RetentionArchive, After: 30 * 24 * time.Hour}, false},
+		{"delete:36h", services.RetentionRule{Action: services.RetentionDelete, After: 36 * time.Hour}, false},
+		{"archive", services.RetentionRule{}, true},
+		{"shred:30d", services.RetentionRule{}, true},
+		{"delete:-3d", services.RetentionRule{}, true},
+	} {
+		got, err := services.ParseRetentionRule(tc.value)
+		if (err != nil) != tc.wantErr || got != tc.want {
+			t.Errorf("ParseRetentionRule(%q) = %+v, %v; want %+v (error %v)", tc.value, got, err, tc.want, tc.wantErr)
+		}
+	}
+}
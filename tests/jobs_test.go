@@ -0,0 +1,502 @@
+//go:build !wasm
+// +build !wasm
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var jobsNow = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// newTestJobRunner returns a runner over a file backed job store whose
+// clock reads *now, with short backoffs and 2 retries
+func newTestJobRunner(t *testing.T, now *time.Time) *services.JobRunner {
+	t.Helper()
+	runner := services.NewJobRunner(fsbe.NewFSJobStore(t.TempDir()))
+	runner.RetryBackoff = time.Minute
+	runner.MaxBackoff = 10 * time.Minute
+	runner.DefaultMaxRetries = 2
+	runner.Now = func() time.Time { return *now }
+	return runner
+}
+
+// runDue advances *now by d and runs whatever is due then
+func runDue(t *testing.T, runner *services.JobRunner, now *time.Time, d time.Duration) int {
+	t.Helper()
+	*now = now.Add(d)
+	ran, err := runner.RunDue(context.Background())
+	if err != nil {
+		t.Fatalf("RunDue failed: %v", err)
+	}
+	return ran
+}
+
+func loadJob(t *testing.T, runner *services.JobRunner, id string) *v1.Job {
+	t.Helper()
+	job, err := runner.Store.LoadJob(context.Background(), id)
+	if err != nil {
+		t.Fatalf("LoadJob failed: %v", err)
+	}
+	return job
+}
+
+// TestJobRunner_DebouncesEnqueues tests that enqueueing the same work
+// repeatedly runs it once, with the latest data, the debounce window after
+// it was first enqueued however often it is enqueued since
+func TestJobRunner_DebouncesEnqueues(t *testing.T) {
+	now := jobsNow
+	runner := newTestJobRunner(t, &now)
+	var got []string
+	services.RegisterJobHandler(runner, "render", func(ctx context.Context, job *v1.Job, data *wrapperspb.StringValue) error {
+		got = append(got, data.Value)
+		return nil
+	})
+
+	for _, theme := range []string{"default", "fantasy", "modern"} {
+		job, err := services.NewJob("render", "games", "g1", wrapperspb.String(theme))
+		if err != nil {
+			t.Fatalf("NewJob failed: %v", err)
+		}
+		job.DebounceWindowSeconds = 10
+		if _, err := runner.Enqueue(context.Background(), job); err != nil {
+			t.Fatalf("Enqueue failed: %v", err)
+		}
+		now = now.Add(3 * time.Second)
+	}
+
+	if ran := runDue(t, runner, &now, 0); ran != 0 {
+		t.Errorf("Expected nothing due inside the debounce window, ran %d", ran)
+	}
+	// Due 10s after the first enqueue though the last was only 4s ago
+	if ran := runDue(t, runner, &now, time.Second); ran != 1 || len(got) != 1 || got[0] != "modern" {
+		t.Fatalf("Expected one run with the latest data, ran %d with %v", ran, got)
+	}
+	job := loadJob(t, runner, services.JobID("render", "games", "g1"))
+	if job.Status != v1.JobStatus_JOB_STATUS_SUCCEEDED {
+		t.Errorf("Expected job to have succeeded, got %v", job.Status)
+	}
+	runs, err := runner.Store.ListRuns(context.Background(), job.Id, 0)
+	if err != nil || len(runs) != 1 || runs[0].State != v1.RunState_RUN_STATE_FINISHED || runs[0].RunId != job.LastRunId {
+		t.Errorf("Expected one finished run, got %v (%v)", runs, err)
+	}
+}
+
+// TestJobRunner_RetriesWithBackoff tests that a failing job is retried with
+// doubling backoff, marked failed once out of retries and runs again after
+// RetryJob
+func TestJobRunner_RetriesWithBackoff(t *testing.T) {
+	now := jobsNow
+	runner := newTestJobRunner(t, &now)
+	fail := true
+	calls := 0
+	runner.Handle("notify", func(ctx context.Context, job *v1.Job) error {
+		calls++
+		if calls == 2 {
+			panic("flaky")
+		}
+		if fail {
+			return errors.New("smtp down")
+		}
+		return nil
+	})
+	if _, err := runner.Enqueue(context.Background(), &v1.Job{JobType: "notify", EntityType: "games", EntityId: "g1"}); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+	id := services.JobID("notify", "games", "g1")
+
+	runDue(t, runner, &now, 0)
+	if job := loadJob(t, runner, id); job.Status != v1.JobStatus_JOB_STATUS_PENDING || job.Attempts != 1 || job.LastError != "smtp down" {
+		t.Fatalf("Expected a pending retry after the first failure, got %v", job)
+	}
+	if ran := runDue(t, runner, &now, 59*time.Second); ran != 0 {
+		t.Errorf("Expected no retry before the backoff, ran %d", ran)
+	}
+	runDue(t, runner, &now, time.Second)
+	if job := loadJob(t, runner, id); job.Attempts != 2 || !job.RunAfter.AsTime().Equal(now.Add(2*time.Minute)) {
+		t.Fatalf("Expected the second retry 2 minutes out after a panic, got %v", job)
+	}
+	runDue(t, runner, &now, 2*time.Minute)
+	job := loadJob(t, runner, id)
+	if job.Status != v1.JobStatus_JOB_STATUS_FAILED || job.Attempts != 3 || calls != 3 {
+		t.Fatalf("Expected the job failed after 2 retries, got %v after %d calls", job, calls)
+	}
+	if ran := runDue(t, runner, &now, time.Hour); ran != 0 {
+		t.Errorf("Expected a failed job not to run again, ran %d", ran)
+	}
+
+	jobs := services.NewJobsService(runner, []string{"ops"})
+	if _, err := jobs.RetryJob(ContextWithUserID("alice"), &v1.RetryJobRequest{Id: id}); err == nil {
+		t.Errorf("Expected a non-operator to be refused")
+	}
+	if _, err := jobs.RetryJob(ContextWithUserID("ops"), &v1.RetryJobRequest{Id: id}); err != nil {
+		t.Fatalf("RetryJob failed: %v", err)
+	}
+	fail = false
+	runDue(t, runner, &now, 0)
+	if job := loadJob(t, runner, id); job.Status != v1.JobStatus_JOB_STATUS_SUCCEEDED || job.Attempts != 0 || job.LastError != "" {
+		t.Errorf("Expected the retried job to succeed, got %v", job)
+	}
+	resp, err := jobs.GetJob(ContextWithUserID("ops"), &v1.GetJobRequest{Id: id, RunLimit: 2})
+	if err != nil || len(resp.Runs) != 2 || resp.Runs[0].State != v1.RunState_RUN_STATE_FINISHED || resp.Runs[1].State != v1.RunState_RUN_STATE_FAILED {
+		t.Errorf("Expected the last 2 runs newest first, got %v (%v)", resp.GetRuns(), err)
+	}
+}
+
+// TestJobRunner_RepeatsAndRequeues tests that repeating jobs are scheduled
+// again after each run, and only once however often they are scheduled, and
+// that work enqueued during a run is run again
+func TestJobRunner_RepeatsAndRequeues(t *testing.T) {
+	now := jobsNow
+	runner := newTestJobRunner(t, &now)
+	calls := 0
+	runner.Handle("sweep", func(ctx context.Context, job *v1.Job) error {
+		calls++
+		return nil
+	})
+	runner.Handle("index", func(ctx context.Context, job *v1.Job) error {
+		calls++
+		if calls == 1 {
+			if _, err := runner.Enqueue(ctx, &v1.Job{JobType: "index", EntityType: "worlds", EntityId: "w1"}); err != nil {
+				t.Errorf("Enqueue during run failed: %v", err)
+			}
+		}
+		return nil
+	})
+
+	// Scheduling again, as a restarted server does, keeps the next run
+	sweep := &v1.Job{JobType: "sweep", RepeatInfo: &v1.RepeatInfo{IntervalSeconds: 3600}, DebounceWindowSeconds: 3600}
+	for range 2 {
+		if _, err := runner.Schedule(context.Background(), sweep); err != nil {
+			t.Fatalf("Schedule failed: %v", err)
+		}
+		now = now.Add(30 * time.Minute)
+	}
+	if ran := runDue(t, runner, &now, 0); ran != 1 {
+		t.Fatalf("Expected the first sweep an hour after it was first scheduled, ran %d", ran)
+	}
+	for i := 1; i < 3; i++ {
+		runDue(t, runner, &now, time.Hour)
+		if job := loadJob(t, runner, services.JobID("sweep", "", "")); job.Status != v1.JobStatus_JOB_STATUS_PENDING || calls != i+1 {
+			t.Fatalf("Expected sweep pending again after run %d, got %v", i+1, job)
+		}
+	}
+
+	calls = 0
+	if _, err := runner.Enqueue(context.Background(), &v1.Job{JobType: "index", EntityType: "worlds", EntityId: "w1"}); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+	runDue(t, runner, &now, 0)
+	id := services.JobID("index", "worlds", "w1")
+	if job := loadJob(t, runner, id); job.Status != v1.JobStatus_JOB_STATUS_PENDING {
+		t.Fatalf("Expected work enqueued during the run to be pending, got %v", job)
+	}
+	runDue(t, runner, &now, 0)
+	if job := loadJob(t, runner, id); job.Status != v1.JobStatus_JOB_STATUS_SUCCEEDED || calls != 2 {
+		t.Errorf("Expected the requeued work to run once more, got %v after %d calls", job, calls)
+	}
+}
+
+// TestJobRunner_ReclaimsExpiredLeases tests that a job left running by a
+// runner that died is only taken back once its lease has expired, and is
+// then retried like a failed run
+func TestJobRunner_ReclaimsExpiredLeases(t *testing.T) {
+	now := jobsNow
+	runner := newTestJobRunner(t, &now)
+	calls := 0
+	runner.Handle("render", func(ctx context.Context, job *v1.Job) error {
+		calls++
+		return nil
+	})
+
+	id := services.JobID("render", "games", "g1")
+	job := &v1.Job{
+		Id:         id,
+		JobType:    "render",
+		EntityType: "games",
+		EntityId:   "g1",
+		Status:     v1.JobStatus_JOB_STATUS_RUNNING,
+		LastRunId:  "dead-runner",
+		RunAfter:   timestamppb.New(now.Add(runner.LeaseDuration)),
+	}
+	if err := runner.Store.SaveJob(context.Background(), job, 0); err != nil {
+		t.Fatalf("SaveJob failed: %v", err)
+	}
+
+	if ran := runDue(t, runner, &now, runner.LeaseDuration/2); ran != 0 || loadJob(t, runner, id).Status != v1.JobStatus_JOB_STATUS_RUNNING {
+		t.Fatalf("Expected a job within its lease left running, ran %d", ran)
+	}
+	runDue(t, runner, &now, runner.LeaseDuration)
+	job = loadJob(t, runner, id)
+	if job.Status != v1.JobStatus_JOB_STATUS_PENDING || job.Attempts != 1 || job.LastError == "" ||
+		!job.RunAfter.AsTime().Equal(now.Add(runner.RetryBackoff)) {
+		t.Fatalf("Expected the expired job pending a retry after the backoff, got %v", job)
+	}
+	if ran := runDue(t, runner, &now, runner.RetryBackoff); ran != 1 || calls != 1 {
+		t.Fatalf("Expected the reclaimed job run again after the backoff, ran %d", ran)
+	}
+	if job := loadJob(t, runner, id); job.Status != v1.JobStatus_JOB_STATUS_SUCCEEDED || job.Attempts != 0 {
+		t.Errorf("Expected the reclaimed job to succeed, got %v", job)
+	}
+}
+
+// TestJobRunner_FailsJobsThatKeepExpiring tests that a job whose runs never
+// finish in their lease is failed once out of retries rather than retried
+// forever
+func TestJobRunner_FailsJobsThatKeepExpiring(t *testing.T) {
+	now := jobsNow
+	runner := newTestJobRunner(t, &now)
+	runner.Handle("render", func(ctx context.Context, job *v1.Job) error { return nil })
+
+	id := services.JobID("render", "games", "g1")
+	if err := runner.Store.SaveJob(context.Background(), &v1.Job{
+		Id:         id,
+		JobType:    "render",
+		EntityType: "games",
+		EntityId:   "g1",
+		Status:     v1.JobStatus_JOB_STATUS_RUNNING,
+		RunAfter:   timestamppb.New(now),
+	}, 0); err != nil {
+		t.Fatalf("SaveJob failed: %v", err)
+	}
+
+	// Each time the job is reclaimed its runner dies again
+	for attempt := int32(1); attempt <= runner.DefaultMaxRetries; attempt++ {
+		runDue(t, runner, &now, runner.MaxBackoff)
+		job := loadJob(t, runner, id)
+		if job.Status != v1.JobStatus_JOB_STATUS_PENDING || job.Attempts != attempt {
+			t.Fatalf("Expected attempt %d pending a retry, got %v", attempt, job)
+		}
+		job.Status = v1.JobStatus_JOB_STATUS_RUNNING
+		if err := runner.Store.SaveJob(context.Background(), job, job.Version); err != nil {
+			t.Fatalf("SaveJob failed: %v", err)
+		}
+	}
+	runDue(t, runner, &now, runner.MaxBackoff)
+	if job := loadJob(t, runner, id); job.Status != v1.JobStatus_JOB_STATUS_FAILED || job.Attempts != runner.DefaultMaxRetries+1 {
+		t.Errorf("Expected the job failed once out of retries, got %v", job)
+	}
+}
+
+// TestJobRunner_HeartbeatKeepsRequeues tests that a long run extends its
+// lease and still notices work enqueued while it ran
+func TestJobRunner_HeartbeatKeepsRequeues(t *testing.T) {
+	now := jobsNow
+	runner := newTestJobRunner(t, &now)
+	runner.LeaseDuration = 30 * time.Millisecond
+	id := services.JobID("render", "games", "g1")
+	// waitForHeartbeat waits for the lease to be saved past version
+	waitForHeartbeat := func(version int64) int64 {
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+			if job, err := runner.Store.LoadJob(context.Background(), id); err == nil && job.Version > version {
+				return job.Version
+			}
+		}
+		t.Errorf("Expected the lease extended past version %d", version)
+		return version
+	}
+	calls := 0
+	runner.Handle("render", func(ctx context.Context, job *v1.Job) error {
+		calls++
+		if calls == 1 {
+			waitForHeartbeat(job.Version)
+			requeued, err := runner.Enqueue(ctx, &v1.Job{JobType: "render", EntityType: "games", EntityId: "g1"})
+			if err != nil {
+				t.Errorf("Enqueue during run failed: %v", err)
+				return nil
+			}
+			waitForHeartbeat(requeued.Version)
+		}
+		return nil
+	})
+
+	if _, err := runner.Enqueue(context.Background(), &v1.Job{JobType: "render", EntityType: "games", EntityId: "g1"}); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+	runDue(t, runner, &now, 0)
+	if job := loadJob(t, runner, id); job.Status != v1.JobStatus_JOB_STATUS_PENDING {
+		t.Fatalf("Expected work enqueued during the run to be pending, got %v", job)
+	}
+	runDue(t, runner, &now, 0)
+	if job := loadJob(t, runner, id); job.Status != v1.JobStatus_JOB_STATUS_SUCCEEDED || calls != 2 {
+		t.Errorf("Expected the requeued work to run once more, got %v after %d calls", job, calls)
+	}
+}
+
+// TestOrdersDeadlineJob tests that a simultaneous turn's deadline runs as a
+// job which forfeits the player who never committed
+func TestOrdersDeadlineJob(t *testing.T) {
+	now := jobsNow
+	runner := newTestJobRunner(t, &now)
+	ctx := context.Background()
+	svc := fsbe.NewFSGamesService(t.TempDir(), nil)
+	svc.Now = runner.Now
+	svc.InitializeOrdersDeadlines(runner)
+
+	game := createTestGame("sim-game", []*v1.GamePlayer{
+		{PlayerId: 1, PlayerType: "human", UserId: "alice"},
+		{PlayerId: 2, PlayerType: "human", UserId: "bob"},
+	})
+	game.Config.Settings = &v1.GameSettings{TurnMode: lib.TurnModeSimultaneous, TurnTimeLimit: 60}
+	state := createTestGameState()
+	state.UpdatedAt = timestamppb.New(now)
+	SaveTestGame(t, svc, game, state)
+
+	commitment, _ := lib.OrdersCommitment(nil, "salt")
+	if _, err := svc.CommitOrders(ContextWithUserID("alice"), &v1.CommitOrdersRequest{GameId: game.Id, Commitment: commitment}); err != nil {
+		t.Fatalf("CommitOrders failed: %v", err)
+	}
+	if ran := runDue(t, runner, &now, 30*time.Second); ran != 0 {
+		t.Errorf("Expected nothing due before the deadline, ran %d", ran)
+	}
+	if ran := runDue(t, runner, &now, 31*time.Second); ran != 1 {
+		t.Fatalf("Expected the deadline job to run, ran %d", ran)
+	}
+
+	saved, err := svc.LoadGameState(ctx, game.Id)
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+	if orders := saved.PendingOrders[2]; orders == nil || orders.Commitment != services.ForfeitedCommitment {
+		t.Errorf("Expected bob's turn forfeited, got %v", orders)
+	}
+	// The reveal deadline is scheduled next
+	if job := loadJob(t, runner, services.JobID(services.OrdersDeadlineJobType, "game", game.Id)); job.Status != v1.JobStatus_JOB_STATUS_PENDING {
+		t.Errorf("Expected the reveal deadline pending, got %v", job.Status)
+	}
+}
+
+// flakyEmailSender records notification emails, failing the first failures
+// sends and calling onSend before each
+type flakyEmailSender struct {
+	failures int
+	subjects []string
+	onSend   func()
+}
+
+func (s *flakyEmailSender) SendNotificationEmail(to string, subject string, htmlBody string) error {
+	if s.onSend != nil {
+		s.onSend()
+	}
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("email bounced")
+	}
+	s.subjects = append(s.subjects, subject)
+	return nil
+}
+
+type profileMap map[string]map[string]any
+
+func (p profileMap) GetUserProfile(_ context.Context, userId string) (map[string]any, error) {
+	return p[userId], nil
+}
+
+// TestTurnNotificationJob tests that a digest held as a job goes out once
+// its window is up, that failed deliveries are retried and that turns
+// coming in during a delivery are kept for the next one
+func TestTurnNotificationJob(t *testing.T) {
+	now := jobsNow
+	runner := newTestJobRunner(t, &now)
+	ctx := context.Background()
+	prefs := services.NotificationPrefs{EmailEnabled: true, DigestMinutes: 30}
+	profiles := profileMap{"bob": {"email": "bob@example.com", services.NotificationProfileKey: prefs.ToProfileValue()}}
+	email := &flakyEmailSender{}
+	notifier := services.NewTurnNotifier(profiles, email, "https://lilbattle.test")
+	notifier.Now = runner.Now
+	notifier.UseJobRunner(runner)
+	notify := func(gameId string, turn int32) {
+		t.Helper()
+		game := &v1.Game{Id: gameId, Name: gameId, Config: &v1.GameConfiguration{Players: []*v1.GamePlayer{
+			{PlayerId: 2, UserId: "bob", PlayerType: "human"},
+		}}}
+		if err := notifier.NotifyTurn(ctx, game, 2, turn); err != nil {
+			t.Fatalf("NotifyTurn failed: %v", err)
+		}
+	}
+
+	notify("g1", 3)
+	notify("g1", 4)
+	notify("g2", 1)
+	if got := notifier.PendingCount("bob"); got != 2 {
+		t.Fatalf("PendingCount = %d, want 2 (one per game)", got)
+	}
+	if ran := runDue(t, runner, &now, 10*time.Minute); ran != 0 {
+		t.Fatalf("Expected the digest held for its window, ran %d", ran)
+	}
+	notify("g3", 1)
+	if ran := runDue(t, runner, &now, 20*time.Minute); ran != 1 || len(email.subjects) != 1 || email.subjects[0] != "It's your turn in 3 games" {
+		t.Fatalf("Expected one digest of 3 games after the window, ran %d and sent %v", ran, email.subjects)
+	}
+
+	// The first delivery bounces and a turn comes in while it is retried
+	email.failures = 1
+	notify("g1", 5)
+	runDue(t, runner, &now, 30*time.Minute)
+	id := services.JobID(services.TurnNotificationJobType, "users", "bob")
+	if job := loadJob(t, runner, id); job.Status != v1.JobStatus_JOB_STATUS_PENDING || job.Attempts != 1 {
+		t.Fatalf("Expected the failed delivery to be retried, got %v", job)
+	}
+	email.onSend = func() {
+		email.onSend = nil
+		notify("g2", 2)
+	}
+	runDue(t, runner, &now, runner.RetryBackoff)
+	if len(email.subjects) != 2 || email.subjects[1] != "It's your turn in g1" {
+		t.Fatalf("Expected the retry to deliver g1 alone, sent %v", email.subjects)
+	}
+	if got := notifier.PendingCount("bob"); got != 1 {
+		t.Fatalf("Expected the turn during delivery held, PendingCount = %d", got)
+	}
+	runDue(t, runner, &now, 30*time.Minute)
+	if len(email.subjects) != 3 || email.subjects[2] != "It's your turn in g2" {
+		t.Errorf("Expected the held turn delivered next, sent %v", email.subjects)
+	}
+}
+
+// TestScreenshotJob tests that screenshots queued as jobs render an item
+// once per burst of changes, at its latest version, and that a theme that
+// keeps failing fails the job so it is retried
+func TestScreenshotJob(t *testing.T) {
+	now := jobsNow
+	runner := newTestJobRunner(t, &now)
+	worlds := fsbe.NewFSWorldsService(t.TempDir(), nil)
+	stored := CreateStoredTestWorld(t, worlds, &v1.World{Id: "w1", Name: "w1"}, CreateTestWorldData(1, 0))
+	indexer, renderer := newStubIndexer(2, map[string]int{"fantasy": 3})
+	indexer.UseJobRunner(runner, worlds)
+	var completed []services.ScreenShotItem
+	indexer.OnComplete = func(items []services.ScreenShotItem) error {
+		completed = append(completed, items...)
+		return nil
+	}
+
+	indexer.Send("worlds", "w1", 1, nil)
+	indexer.Send("worlds", "w1", 2, nil)
+	if ran := runDue(t, runner, &now, 0); ran != 0 {
+		t.Fatalf("Expected the render debounced, ran %d", ran)
+	}
+	if ran := runDue(t, runner, &now, 5*time.Second); ran != 1 || len(completed) != 1 {
+		t.Fatalf("Expected one render of the burst, ran %d and completed %d", ran, len(completed))
+	}
+	if completed[0].Version != stored.Version || completed[0].WorldData == nil {
+		t.Errorf("Expected the stored world rendered, got version %d", completed[0].Version)
+	}
+	id := services.JobID(services.ScreenshotJobType("worlds"), "worlds", "w1")
+	if job := loadJob(t, runner, id); job.Status != v1.JobStatus_JOB_STATUS_PENDING || job.Attempts != 1 {
+		t.Fatalf("Expected the failed theme to retry the job, got %v", job)
+	}
+	runDue(t, runner, &now, runner.RetryBackoff)
+	if job := loadJob(t, runner, id); job.Status != v1.JobStatus_JOB_STATUS_SUCCEEDED || renderer.attempts["w1/fantasy"] != 4 {
+		t.Errorf("Expected the retry to render fantasy, got %v after %d attempts", job, renderer.attempts["w1/fantasy"])
+	}
+}
@@ -0,0 +1,171 @@
+//go:build !wasm
+// +build !wasm
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services/fsbe"
+)
+
+// newMyGamesService returns a file backed games service holding one game
+// with player 1 taken by "alice" and player 2 open
+func newMyGamesService(t *testing.T) *fsbe.FSGamesService {
+	t.Helper()
+	ctx := context.Background()
+	svc := fsbe.NewFSGamesService(t.TempDir(), nil)
+
+	game := createTestGame("my-game", []*v1.GamePlayer{
+		{PlayerId: 1, PlayerType: "human", UserId: "alice", Name: "Player 1"},
+		{PlayerId: 2, PlayerType: "open", Name: "Player 2"},
+	})
+	state := createTestGameState()
+	state.GameId = game.Id
+	state.Status = v1.GameStatus_GAME_STATUS_PLAYING
+	if err := svc.SaveGame(ctx, game.Id, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if err := svc.SaveGameState(ctx, game.Id, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+	if err := svc.SaveGameHistory(ctx, game.Id, &v1.GameMoveHistory{GameId: game.Id}); err != nil {
+		t.Fatalf("SaveGameHistory failed: %v", err)
+	}
+	// What CreateGame does once the game is stored
+	svc.UpdateUserGameIndex(ctx, game, state)
+	return svc
+}
+
+func listMyGames(t *testing.T, svc *fsbe.FSGamesService, userID string, req *v1.ListMyGamesRequest) []*v1.UserGame {
+	t.Helper()
+	resp, err := svc.ListMyGames(ContextWithUserID(userID), req)
+	if err != nil {
+		t.Fatalf("ListMyGames(%s) failed: %v", userID, err)
+	}
+	return resp.Items
+}
+
+func TestListMyGames_JoinAndTurnChange(t *testing.T) {
+	svc := newMyGamesService(t)
+
+	games := listMyGames(t, svc, "alice", &v1.ListMyGamesRequest{})
+	if len(games) != 1 || !games[0].IsMyTurn || games[0].PlayerIds[0] != 1 {
+		t.Fatalf("Expected alice's turn in my-game, got %v", games)
+	}
+	if games := listMyGames(t, svc, "bob", &v1.ListMyGamesRequest{}); len(games) != 0 {
+		t.Fatalf("Expected no games for bob before joining, got %v", games)
+	}
+
+	if _, err := svc.JoinGame(ContextWithUserID("bob"), &v1.JoinGameRequest{GameId: "my-game", PlayerId: 2}); err != nil {
+		t.Fatalf("JoinGame failed: %v", err)
+	}
+	games = listMyGames(t, svc, "bob", &v1.ListMyGamesRequest{})
+	if len(games) != 1 || games[0].IsMyTurn || games[0].CurrentPlayer != 1 {
+		t.Fatalf("Expected bob in my-game waiting on player 1, got %v", games)
+	}
+
+	// Ending player 1's turn hands it to bob
+	state, _ := svc.LoadGameState(context.Background(), "my-game")
+	state.CurrentPlayer, state.TurnCounter = 2, 2
+	group := &v1.GameMoveGroup{GroupNumber: 1, Moves: []*v1.GameMove{{
+		Player: 1,
+		Changes: []*v1.WorldChange{{ChangeType: &v1.WorldChange_PlayerChanged{
+			PlayerChanged: &v1.PlayerChangedChange{PreviousPlayer: 1, NewPlayer: 2},
+		}}},
+	}}}
+	if err := svc.SaveMoveGroup(context.Background(), "my-game", state, group); err != nil {
+		t.Fatalf("SaveMoveGroup failed: %v", err)
+	}
+
+	if games := listMyGames(t, svc, "bob", &v1.ListMyGamesRequest{OnlyMyTurn: true}); len(games) != 1 || games[0].TurnCounter != 2 {
+		t.Errorf("Expected it to be bob's turn, got %v", games)
+	}
+	if games := listMyGames(t, svc, "alice", &v1.ListMyGamesRequest{OnlyMyTurn: true}); len(games) != 0 {
+		t.Errorf("Expected it to no longer be alice's turn, got %v", games)
+	}
+	if games := listMyGames(t, svc, "alice", &v1.ListMyGamesRequest{Status: v1.GameStatus_GAME_STATUS_ENDED}); len(games) != 0 {
+		t.Errorf("Expected no ended games for alice, got %v", games)
+	}
+}
+
+func TestListMyGames_DeleteAndLeave(t *testing.T) {
+	svc := newMyGamesService(t)
+	ctx := context.Background()
+	game, _ := svc.LoadGame(ctx, "my-game")
+	state, _ := svc.LoadGameState(ctx, "my-game")
+
+	// Players removed from the game drop out of the index
+	game.Config.Players[0].UserId = "carol"
+	svc.UpdateUserGameIndex(ctx, game, state)
+	if games := listMyGames(t, svc, "alice", &v1.ListMyGamesRequest{}); len(games) != 0 {
+		t.Errorf("Expected alice to have left my-game, got %v", games)
+	}
+	if games := listMyGames(t, svc, "carol", &v1.ListMyGamesRequest{}); len(games) != 1 {
+		t.Errorf("Expected carol in my-game, got %v", games)
+	}
+
+	// Only the creator can delete games
+	game.CreatorId = "carol"
+	if err := svc.SaveGame(ctx, game.Id, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if _, err := svc.DeleteGame(ContextWithUserID("carol"), &v1.DeleteGameRequest{Id: "my-game"}); err != nil {
+		t.Fatalf("DeleteGame failed: %v", err)
+	}
+	if games := listMyGames(t, svc, "carol", &v1.ListMyGamesRequest{}); len(games) != 0 {
+		t.Errorf("Expected deleted game to leave the index, got %v", games)
+	}
+
+	if _, err := svc.ListMyGames(ctx, &v1.ListMyGamesRequest{}); err == nil {
+		t.Error("ListMyGames should require authentication")
+	}
+}
+
+func TestListMyGames_Backfill(t *testing.T) {
+	svc := newMyGamesService(t)
+	ctx := context.Background()
+
+	// A game stored before the index existed
+	game := createTestGame("old-game", []*v1.GamePlayer{
+		{PlayerId: 1, PlayerType: "human", UserId: "alice", Name: "Player 1"},
+		{PlayerId: 2, PlayerType: "human", UserId: "bob", Name: "Player 2"},
+	})
+	state := createTestGameState()
+	state.GameId = game.Id
+	if err := svc.SaveGame(ctx, game.Id, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if err := svc.SaveGameState(ctx, game.Id, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+	if games := listMyGames(t, svc, "bob", &v1.ListMyGamesRequest{}); len(games) != 0 {
+		t.Fatalf("Expected no indexed games for bob yet, got %v", games)
+	}
+
+	now := jobsNow
+	runner := newTestJobRunner(t, &now)
+	if err := svc.InitializeUserGameBackfill(ctx, runner); err != nil {
+		t.Fatalf("InitializeUserGameBackfill failed: %v", err)
+	}
+	if ran := runDue(t, runner, &now, 0); ran != 1 {
+		t.Fatalf("Expected the backfill to run, ran %d", ran)
+	}
+	if games := listMyGames(t, svc, "bob", &v1.ListMyGamesRequest{}); len(games) != 1 || games[0].GameId != "old-game" {
+		t.Errorf("Expected old-game backfilled for bob, got %v", games)
+	}
+	if games := listMyGames(t, svc, "alice", &v1.ListMyGamesRequest{}); len(games) != 2 {
+		t.Errorf("Expected both games for alice, got %v", games)
+	}
+
+	// Restarting does not run it again
+	if err := svc.InitializeUserGameBackfill(ctx, runner); err != nil {
+		t.Fatalf("InitializeUserGameBackfill failed: %v", err)
+	}
+	if ran := runDue(t, runner, &now, time.Hour); ran != 0 {
+		t.Errorf("Expected the finished backfill to stay done, ran %d", ran)
+	}
+}
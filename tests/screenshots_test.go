@@ -0,0 +1,97 @@
+//go:build !wasm
+// +build !wasm
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+)
+
+// stubRenderer renders by failing the first failures[theme] attempts of a
+// theme and tracks how many renders run at once
+type stubRenderer struct {
+	mu        sync.Mutex
+	failures  map[string]int
+	attempts  map[string]int
+	running   int
+	maxActive int
+}
+
+func (r *stubRenderer) render(ctx context.Context, themeName string, item *services.ScreenShotItem) (*v1.File, error) {
+	r.mu.Lock()
+	r.running++
+	r.maxActive = max(r.maxActive, r.running)
+	key := item.Id + "/" + themeName
+	r.attempts[key]++
+	fail := r.attempts[key] <= r.failures[themeName]
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		r.running--
+		r.mu.Unlock()
+	}()
+	if fail {
+		return nil, errors.New("render failed")
+	}
+	return &v1.File{Path: "screenshots/" + item.Kind + "/" + key + ".png"}, nil
+}
+
+func newStubIndexer(workers int, failures map[string]int) (*services.ScreenShotIndexer, *stubRenderer) {
+	renderer := &stubRenderer{failures: failures, attempts: map[string]int{}}
+	indexer := services.NewScreenShotIndexer(nil)
+	indexer.Workers = workers
+	indexer.RetryBackoff = 0
+	indexer.RenderTheme = renderer.render
+	return indexer, renderer
+}
+
+// TestScreenShotIndexer_PartialSuccess tests that a theme failing every
+// attempt is recorded without stopping the other themes, and that a flaky
+// theme succeeds on retry
+func TestScreenShotIndexer_PartialSuccess(t *testing.T) {
+	indexer, renderer := newStubIndexer(2, map[string]int{"modern": 2, "fantasy": 10})
+	results := indexer.RenderItems(context.Background(), []services.ScreenShotItem{{Kind: "worlds", Id: "w1"}})
+
+	item := results[0]
+	if len(item.ThemeFiles) != 2 || item.ThemeFiles["default"] == nil || item.ThemeFiles["modern"] == nil {
+		t.Errorf("Expected default and modern rendered, got %v", item.ThemeFiles)
+	}
+	if len(item.ThemeErrors) != 1 || item.ThemeErrors["fantasy"] == nil {
+		t.Errorf("Expected only fantasy failed, got %v", item.ThemeErrors)
+	}
+	for theme, want := range map[string]int{"default": 1, "modern": 3, "fantasy": 3} {
+		if got := renderer.attempts["w1/"+theme]; got != want {
+			t.Errorf("Expected %d attempts at %s, got %d", want, theme, got)
+		}
+	}
+}
+
+// TestScreenShotIndexer_BoundedWorkers tests that a batch renders every
+// theme of every item with no more than Workers renders at once
+func TestScreenShotIndexer_BoundedWorkers(t *testing.T) {
+	indexer, renderer := newStubIndexer(3, nil)
+	var items []services.ScreenShotItem
+	for _, id := range []string{"w1", "w2", "w3", "w4"} {
+		items = append(items, services.ScreenShotItem{Kind: "worlds", Id: id})
+	}
+
+	results := indexer.RenderItems(context.Background(), items)
+	for _, item := range results {
+		if len(item.ThemeFiles) != len(services.ScreenshotThemes) || len(item.ThemeErrors) != 0 {
+			t.Errorf("Expected every theme of %s rendered, got %v (%v)", item.Id, item.ThemeFiles, item.ThemeErrors)
+		}
+	}
+	if len(renderer.attempts) != len(items)*len(services.ScreenshotThemes) {
+		t.Errorf("Expected one render per item and theme, got %v", renderer.attempts)
+	}
+	if renderer.maxActive > 3 {
+		t.Errorf("Expected at most 3 renders at once, got %d", renderer.maxActive)
+	}
+}
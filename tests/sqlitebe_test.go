@@ -0,0 +1,181 @@
+//go:build !wasm && sqlite
+// +build !wasm,sqlite
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/conformance"
+	"github.com/turnforge/lilbattle/services/gormbe"
+	"github.com/turnforge/lilbattle/services/sqlitebe"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// newSQLiteGamesService returns a games service over a fresh database
+// holding one game with its initial state
+func newSQLiteGamesService(t *testing.T) *gormbe.GamesService {
+	t.Helper()
+	db, err := sqlitebe.OpenDB(filepath.Join(t.TempDir(), "lilbattle.db"))
+	if err != nil {
+		t.Fatalf("OpenDB failed: %v", err)
+	}
+	svc, err := sqlitebe.NewGamesService(db, nil)
+	if err != nil {
+		t.Fatalf("NewGamesService failed: %v", err)
+	}
+
+	ctx := context.Background()
+	game := createTestGame("sqlite-game", []*v1.GamePlayer{
+		{PlayerId: 1, PlayerType: "human", UserId: "alice"},
+		{PlayerId: 2, PlayerType: "human", UserId: "bob"},
+	})
+	if err := svc.SaveGame(ctx, game.Id, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	state := createTestGameState()
+	state.GameId = game.Id
+	if err := svc.SaveGameState(ctx, game.Id, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+	return svc
+}
+
+func TestSQLite_SaveMoveGroup(t *testing.T) {
+	svc := newSQLiteGamesService(t)
+	ctx := context.Background()
+
+	state, err := svc.LoadGameState(ctx, "sqlite-game")
+	if err != nil {
+		t.Fatalf("LoadGameState failed: %v", err)
+	}
+	state.CurrentGroupNumber, state.CurrentPlayer = 1, 2
+	if err := svc.SaveMoveGroup(ctx, "sqlite-game", state, endTurnGroup(1)); err != nil {
+		t.Fatalf("SaveMoveGroup failed: %v", err)
+	}
+	if state.Version != 1 {
+		t.Errorf("Expected saving to bump the version to 1, got %d", state.Version)
+	}
+
+	saved, _ := svc.LoadGameState(ctx, "sqlite-game")
+	history, _ := svc.LoadGameHistory(ctx, "sqlite-game")
+	if saved.CurrentPlayer != 2 || saved.Version != 1 {
+		t.Errorf("Expected the new state to be stored, got player %d version %d", saved.CurrentPlayer, saved.Version)
+	}
+	if len(history.Groups) != 1 || len(history.Groups[0].Moves) != 1 {
+		t.Errorf("Expected one group of one move, got %v", history.Groups)
+	}
+
+	// A request holding the old state must not overwrite the new one - nor
+	// leave its moves behind
+	stale := createTestGameState()
+	stale.GameId, stale.CurrentGroupNumber = "sqlite-game", 2
+	err = svc.SaveMoveGroup(ctx, "sqlite-game", stale, endTurnGroup(2))
+	if !errors.Is(err, services.ErrStaleGameState) {
+		t.Fatalf("Expected ErrStaleGameState, got %v", err)
+	}
+	if stale.Version != 0 {
+		t.Errorf("Expected the failed save to keep version 0, got %d", stale.Version)
+	}
+	history, _ = svc.LoadGameHistory(ctx, "sqlite-game")
+	if len(history.Groups) != 1 {
+		t.Errorf("Expected the rejected moves to be rolled back, got %d groups", len(history.Groups))
+	}
+
+	// Screenshot bookkeeping updates the matching version only
+	if err := svc.UpdateGameStateScreenshotIndexInfo(ctx, "sqlite-game", 1, time.Now(), false); err != nil {
+		t.Errorf("UpdateGameStateScreenshotIndexInfo failed: %v", err)
+	}
+	if err := svc.UpdateGameStateScreenshotIndexInfo(ctx, "sqlite-game", 0, time.Now(), false); err == nil {
+		t.Error("Expected an outdated version to be rejected")
+	}
+}
+
+func TestSQLite_ListGames(t *testing.T) {
+	svc := newSQLiteGamesService(t)
+	ctx := context.Background()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 5 {
+		game := createTestGame(fmt.Sprintf("game-%d", i), nil)
+		game.CreatorId = "alice"
+		game.CreatedAt = timestamppb.New(base.Add(time.Duration(i) * time.Hour))
+		game.UpdatedAt = game.CreatedAt
+		if err := svc.SaveGame(ctx, game.Id, game); err != nil {
+			t.Fatalf("SaveGame failed: %v", err)
+		}
+	}
+
+	// Newest first, resuming from the page key
+	var ids []string
+	req := &v1.ListGamesRequest{OwnerId: "alice", Pagination: &v1.Pagination{PageSize: 2}}
+	for {
+		resp, err := svc.ListGames(ctx, req)
+		if err != nil {
+			t.Fatalf("ListGames failed: %v", err)
+		}
+		for _, game := range resp.Items {
+			ids = append(ids, game.Id)
+		}
+		if !resp.Pagination.HasMore {
+			break
+		}
+		req.Pagination.PageKey = resp.Pagination.NextPageKey
+	}
+	if fmt.Sprint(ids) != "[game-4 game-3 game-2 game-1 game-0]" {
+		t.Errorf("Expected alice's games newest first, got %v", ids)
+	}
+}
+
+func openTestSQLite(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := sqlitebe.OpenDB(filepath.Join(t.TempDir(), "lilbattle.db"))
+	if err != nil {
+		t.Fatalf("OpenDB failed: %v", err)
+	}
+	return db
+}
+
+func TestImportGame_SQLite(t *testing.T) {
+	dest, err := sqlitebe.NewGamesService(openTestSQLite(t), nil)
+	if err != nil {
+		t.Fatalf("NewGamesService failed: %v", err)
+	}
+	testImportGameReplaysArchive(t, dest)
+
+	dest, err = sqlitebe.NewGamesService(openTestSQLite(t), nil)
+	if err != nil {
+		t.Fatalf("NewGamesService failed: %v", err)
+	}
+	testImportGameKeepsArchivedRules(t, dest)
+}
+
+func TestConformance_SQLite(t *testing.T) {
+	conformance.RunGames(t, func(t *testing.T) conformance.GamesBackend {
+		svc, err := sqlitebe.NewGamesService(openTestSQLite(t), nil)
+		if err != nil {
+			t.Fatalf("NewGamesService failed: %v", err)
+		}
+		return svc
+	})
+	conformance.RunWorlds(t, func(t *testing.T) conformance.WorldsBackend {
+		svc, err := sqlitebe.NewWorldsService(openTestSQLite(t), nil)
+		if err != nil {
+			t.Fatalf("NewWorldsService failed: %v", err)
+		}
+		return svc
+	})
+	conformance.RunJobs(t, func(t *testing.T) services.JobStore {
+		return sqlitebe.NewJobStore(openTestSQLite(t))
+	})
+	conformance.RunIndexStates(t, func(t *testing.T) services.IndexStateStore {
+		return sqlitebe.NewIndexStateStore(openTestSQLite(t))
+	})
+}
@@ -0,0 +1,91 @@
+//go:build !wasm
+// +build !wasm
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/conformance"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"github.com/turnforge/lilbattle/services/gaebe"
+	"github.com/turnforge/lilbattle/services/gormbe"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Runs the storage conformance suite against every backend available here:
+// fsbe always, SQLite when built with -tags sqlite (sqlitebe_test.go),
+// Postgres when LILBATTLE_TEST_DB_ENDPOINT is set and Datastore when
+// DATASTORE_EMULATOR_HOST points at an emulator.
+
+func TestConformance_FS(t *testing.T) {
+	conformance.RunGames(t, func(t *testing.T) conformance.GamesBackend {
+		return fsbe.NewFSGamesService(t.TempDir(), nil)
+	})
+	conformance.RunWorlds(t, func(t *testing.T) conformance.WorldsBackend {
+		return fsbe.NewFSWorldsService(t.TempDir(), nil)
+	})
+	conformance.RunJobs(t, func(t *testing.T) services.JobStore {
+		return fsbe.NewFSJobStore(t.TempDir())
+	})
+	conformance.RunIndexStates(t, func(t *testing.T) services.IndexStateStore {
+		return fsbe.NewFSIndexStateStore(t.TempDir())
+	})
+}
+
+func TestConformance_Postgres(t *testing.T) {
+	endpoint := os.Getenv("LILBATTLE_TEST_DB_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("LILBATTLE_TEST_DB_ENDPOINT not set")
+	}
+	// Cases use their own IDs so can share the database
+	db, err := gorm.Open(postgres.Open(endpoint), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to open %s: %v", endpoint, err)
+	}
+	conformance.RunGames(t, func(t *testing.T) conformance.GamesBackend {
+		return gormbe.NewGamesService(db, nil)
+	})
+	conformance.RunWorlds(t, func(t *testing.T) conformance.WorldsBackend {
+		return gormbe.NewWorldsService(db, nil)
+	})
+	conformance.RunJobs(t, func(t *testing.T) services.JobStore {
+		return gormbe.NewJobStore(db)
+	})
+	conformance.RunIndexStates(t, func(t *testing.T) services.IndexStateStore {
+		return gormbe.NewIndexStateStore(db)
+	})
+}
+
+func TestConformance_Datastore(t *testing.T) {
+	if os.Getenv("DATASTORE_EMULATOR_HOST") == "" {
+		t.Skip("DATASTORE_EMULATOR_HOST not set")
+	}
+	client, err := datastore.NewClient(context.Background(), "lilbattle-conformance")
+	if err != nil {
+		t.Fatalf("Failed to create Datastore client: %v", err)
+	}
+	defer client.Close()
+
+	// A namespace per run keeps reruns against a long lived emulator apart
+	namespace := fmt.Sprintf("conformance-%d", time.Now().UnixNano())
+	conformance.RunGames(t, func(t *testing.T) conformance.GamesBackend {
+		return gaebe.NewGamesService(client, namespace, nil)
+	})
+	conformance.RunWorlds(t, func(t *testing.T) conformance.WorldsBackend {
+		return gaebe.NewWorldsService(client, namespace, nil)
+	})
+	conformance.RunJobs(t, func(t *testing.T) services.JobStore {
+		return gaebe.NewJobStore(client, namespace)
+	})
+	conformance.RunIndexStates(t, func(t *testing.T) services.IndexStateStore {
+		return gaebe.NewIndexStateStore(client, namespace)
+	})
+}
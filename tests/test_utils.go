@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"testing"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	"github.com/turnforge/lilbattle/lib"
@@ -131,3 +132,42 @@ func LoadTestWorld(worldId string) (*lib.World, error) {
 	// Create runtime world from proto data
 	return lib.NewWorld(protoWorld.Name, &protoWorldData), nil
 }
+
+// SaveTestGame stores a game and its state in a file backed games service
+func SaveTestGame(t *testing.T, svc *fsbe.FSGamesService, game *v1.Game, state *v1.GameState) {
+	t.Helper()
+	ctx := context.Background()
+	state.GameId = game.Id
+	if err := svc.SaveGame(ctx, game.Id, game); err != nil {
+		t.Fatalf("SaveGame failed: %v", err)
+	}
+	if err := svc.SaveGameState(ctx, game.Id, state); err != nil {
+		t.Fatalf("SaveGameState failed: %v", err)
+	}
+}
+
+// CreateTestWorldData creates a row of grass tiles, the first players of
+// them owned by players 1, 2, ... in turn
+func CreateTestWorldData(tiles int, players int32) *v1.WorldData {
+	data := &v1.WorldData{TilesMap: map[string]*v1.Tile{}}
+	for q := int32(0); q < int32(tiles); q++ {
+		tile := &v1.Tile{Q: q, TileType: 1}
+		if q < players {
+			tile.Player = q + 1
+		}
+		data.TilesMap[lib.CoordKey(q, 0)] = tile
+	}
+	return data
+}
+
+// CreateStoredTestWorld creates a world through a worlds service as alice
+// and returns the world data it stored
+func CreateStoredTestWorld(t *testing.T, worlds *fsbe.FSWorldsService, world *v1.World, data *v1.WorldData) *v1.WorldData {
+	t.Helper()
+	world.CreatorId = "alice"
+	resp, err := worlds.CreateWorld(ContextWithUserID("alice"), &v1.CreateWorldRequest{World: world, WorldData: data})
+	if err != nil {
+		t.Fatalf("CreateWorld failed: %v", err)
+	}
+	return resp.WorldData
+}
@@ -0,0 +1,166 @@
+//go:build !wasm
+// +build !wasm
+
+package tests
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/services/fsbe"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// countedGames lists a fixed set of games for the search indexer to count
+type countedGames struct {
+	v1s.UnimplementedGamesServiceServer
+	games []*v1.Game
+}
+
+func (g *countedGames) ListGames(ctx context.Context, req *v1.ListGamesRequest) (*v1.ListGamesResponse, error) {
+	var items []*v1.Game
+	for _, game := range g.games {
+		if req.WorldId == "" || game.WorldId == req.WorldId {
+			items = append(items, game)
+		}
+	}
+	return &v1.ListGamesResponse{Items: items, Pagination: &v1.PaginationResponse{}}, nil
+}
+
+// newTestSearchIndexer indexes worlds for search in store, counting the
+// games in games
+func newTestSearchIndexer(worlds *fsbe.FSWorldsService, store services.WorldSearchStore, games *countedGames) *services.WorldSearchIndexer {
+	index := services.NewWorldSearchIndex(store)
+	worlds.InitializeSearchIndex(index)
+	return services.NewWorldSearchIndexer(index, worlds, games)
+}
+
+func reconcileSearch(t *testing.T, reconciler *services.IndexReconciler) {
+	t.Helper()
+	if _, err := reconciler.Reconcile(context.Background()); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+}
+
+func searchWorlds(t *testing.T, worlds *fsbe.FSWorldsService, req *v1.SearchWorldsRequest) ([]string, *v1.SearchWorldsResponse) {
+	t.Helper()
+	resp, err := worlds.SearchWorlds(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SearchWorlds failed: %v", err)
+	}
+	var ids []string
+	for _, world := range resp.Items {
+		ids = append(ids, world.Id)
+	}
+	return ids, resp
+}
+
+// TestWorldSearch_QueryAndFacets tests free text relevance, facet filters
+// and counts, and popularity ordering
+func TestWorldSearch_QueryAndFacets(t *testing.T) {
+	worlds := fsbe.NewFSWorldsService(t.TempDir(), nil)
+	games := &countedGames{}
+	indexer := newTestSearchIndexer(worlds, fsbe.NewFSWorldSearchStore(t.TempDir()), games)
+	reconciler := services.NewIndexReconciler(fsbe.NewFSIndexStateStore(t.TempDir()), indexer)
+	reconciler.MaxPerPass = 100
+	CreateStoredTestWorld(t, worlds, &v1.World{Id: "w-islands", Name: "Island Hopping", Tags: []string{"naval", "islands"}, Difficulty: "easy"}, CreateTestWorldData(30, 2))
+	CreateStoredTestWorld(t, worlds, &v1.World{Id: "w-desert", Name: "Desert Storm", Description: "A dry map with a few islands of green", Tags: []string{"desert"}, Difficulty: "hard"}, CreateTestWorldData(60, 4))
+	CreateStoredTestWorld(t, worlds, &v1.World{Id: "w-lakes", Name: "Lakes", Tags: []string{"naval"}, Difficulty: "Easy"}, CreateTestWorldData(10, 2))
+	games.games = []*v1.Game{{Id: "g1", WorldId: "w-lakes"}, {Id: "g2", WorldId: "w-lakes"}, {Id: "g3", WorldId: "w-desert"}}
+	reconcileSearch(t, reconciler)
+
+	// A name match outranks a description match, words match as prefixes
+	if ids, _ := searchWorlds(t, worlds, &v1.SearchWorldsRequest{Query: "isl"}); !slices.Equal(ids, []string{"w-islands", "w-desert"}) {
+		t.Errorf("Expected the island worlds best first, got %v", ids)
+	}
+	if ids, _ := searchWorlds(t, worlds, &v1.SearchWorldsRequest{Query: "islands green"}); !slices.Equal(ids, []string{"w-desert"}) {
+		t.Errorf("Expected every word to have to match, got %v", ids)
+	}
+
+	// Without a query the most played come first
+	ids, resp := searchWorlds(t, worlds, &v1.SearchWorldsRequest{})
+	if !slices.Equal(ids, []string{"w-lakes", "w-desert", "w-islands"}) {
+		t.Errorf("Expected worlds by popularity, got %v", ids)
+	}
+	if resp.Tags[0].Value != "naval" || resp.Tags[0].Count != 2 || resp.Pagination.TotalResults != 3 {
+		t.Errorf("Expected naval as the top tag of 3 results, got %v (%v)", resp.Tags, resp.Pagination)
+	}
+
+	// Facet filters
+	if ids, _ := searchWorlds(t, worlds, &v1.SearchWorldsRequest{Tags: []string{"NAVAL"}, Difficulties: []string{"easy"}, SortBy: "name"}); !slices.Equal(ids, []string{"w-islands", "w-lakes"}) {
+		t.Errorf("Expected the easy naval worlds by name, got %v", ids)
+	}
+	ids, resp = searchWorlds(t, worlds, &v1.SearchWorldsRequest{MinPlayers: 2, MaxPlayers: 2, MinTiles: 20})
+	if !slices.Equal(ids, []string{"w-islands"}) || len(resp.PlayerCounts) != 1 || resp.PlayerCounts[0].Value != "2" {
+		t.Errorf("Expected the large 2 player world, got %v (%v)", ids, resp.PlayerCounts)
+	}
+
+	// Paging by offset
+	ids, resp = searchWorlds(t, worlds, &v1.SearchWorldsRequest{Pagination: &v1.Pagination{PageSize: 2}})
+	if len(ids) != 2 || !resp.Pagination.HasMore || resp.Pagination.NextPageOffset != 2 {
+		t.Errorf("Expected a first page of 2 with more, got %v (%v)", ids, resp.Pagination)
+	}
+
+	if _, err := worlds.SearchWorlds(context.Background(), &v1.SearchWorldsRequest{SortBy: "size"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected an invalid sort to be rejected, got %v", err)
+	}
+}
+
+// TestWorldSearch_Reindexing tests that updated worlds are reindexed by the
+// reconciler, deleted ones removed and that the index is kept across
+// restarts
+func TestWorldSearch_Reindexing(t *testing.T) {
+	storeDir := t.TempDir()
+	worlds := fsbe.NewFSWorldsService(t.TempDir(), nil)
+	games := &countedGames{}
+	indexer := newTestSearchIndexer(worlds, fsbe.NewFSWorldSearchStore(storeDir), games)
+	reconciler := services.NewIndexReconciler(fsbe.NewFSIndexStateStore(t.TempDir()), indexer)
+	reconciler.MaxPerPass = 100
+	CreateStoredTestWorld(t, worlds, &v1.World{Id: "w-a", Name: "Volcano"}, CreateTestWorldData(5, 2))
+	CreateStoredTestWorld(t, worlds, &v1.World{Id: "w-b", Name: "Glacier"}, CreateTestWorldData(5, 2))
+	reconcileSearch(t, reconciler)
+
+	if _, err := worlds.UpdateWorld(ContextWithUserID("alice"), &v1.UpdateWorldRequest{World: &v1.World{Id: "w-a", Name: "Lava Fields"}}); err != nil {
+		t.Fatalf("UpdateWorld failed: %v", err)
+	}
+	reconcileSearch(t, reconciler)
+	if ids, _ := searchWorlds(t, worlds, &v1.SearchWorldsRequest{Query: "lava"}); !slices.Equal(ids, []string{"w-a"}) {
+		t.Errorf("Expected the renamed world found by its new name, got %v", ids)
+	}
+	if ids, _ := searchWorlds(t, worlds, &v1.SearchWorldsRequest{Query: "volcano"}); len(ids) != 0 {
+		t.Errorf("Expected the old name gone from the index, got %v", ids)
+	}
+
+	if _, err := worlds.DeleteWorld(ContextWithUserID("alice"), &v1.DeleteWorldRequest{Id: "w-b"}); err != nil {
+		t.Fatalf("DeleteWorld failed: %v", err)
+	}
+	if ids, _ := searchWorlds(t, worlds, &v1.SearchWorldsRequest{}); !slices.Equal(ids, []string{"w-a"}) {
+		t.Errorf("Expected the deleted world removed, got %v", ids)
+	}
+
+	// A restart loads the stored entries
+	restarted := services.NewWorldSearchIndex(fsbe.NewFSWorldSearchStore(storeDir))
+	if err := restarted.Load(context.Background()); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if entry := restarted.Entry("w-a"); entry == nil || entry.World.Name != "Lava Fields" || restarted.Entry("w-b") != nil {
+		t.Errorf("Expected only w-a reloaded, got %v", restarted.Entries())
+	}
+
+	// A refresh recounts games and drops worlds deleted elsewhere
+	games.games = []*v1.Game{{Id: "g1", WorldId: "w-a"}}
+	if err := indexer.Index.Put(context.Background(), &v1.WorldSearchEntry{World: &v1.World{Id: "w-gone", Name: "Gone"}}); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if err := indexer.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+	if entry := indexer.Index.Entry("w-a"); entry.Games != 1 || indexer.Index.Entry("w-gone") != nil {
+		t.Errorf("Expected w-a with 1 game and w-gone dropped, got %v", indexer.Index.Entries())
+	}
+}
@@ -9,6 +9,7 @@ import (
 type BaseTheme struct {
 	manifest     *v1.ThemeManifest
 	cityTerrains map[int32]bool // Terrains that use player colors (from RulesEngine)
+	assetDir     string         // Directory of a theme pack's assets, see AssetDir
 }
 
 // Default player colors - used when manifest doesn't specify playerColors
@@ -54,6 +55,18 @@ func (b *BaseTheme) CityTerrains() map[int32]bool {
 	return b.cityTerrains
 }
 
+// AssetDir returns the directory the server side renderers read assets
+// from - a theme pack's own directory, else the base path under web/
+func (b *BaseTheme) AssetDir() string {
+	if b.assetDir != "" {
+		return b.assetDir
+	}
+	if b.manifest.ThemeInfo == nil {
+		return ""
+	}
+	return "web" + b.manifest.ThemeInfo.BasePath
+}
+
 func (b *BaseTheme) GetUnitName(unitId int32) string {
 	if mapping, ok := b.manifest.Units[unitId]; ok {
 		return mapping.Name
@@ -0,0 +1,105 @@
+package themes
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Theme packs let themes be added without Go changes: a directory with a
+// mapping.json manifest (the same format as the built in themes) and the
+// assets it refers to, relative to the directory.  PNG packs lay assets out
+// like the default theme (Units/1/0.png, a file per player) and SVG packs
+// like fantasy (a template per unit or terrain coloured per player).
+
+// ThemePackManifest is the manifest file in a theme pack directory
+const ThemePackManifest = "mapping.json"
+
+// PackTheme is an SVG theme loaded from a theme pack.  PNG packs are loaded
+// as a DefaultTheme so the PNG renderer can draw them.
+type PackTheme struct {
+	*BaseTheme
+}
+
+// LoadThemePack loads the theme pack in a directory.  The pack is named
+// after the directory unless its manifest has a name.
+func LoadThemePack(dir string, cityTerrains map[int32]bool) (Theme, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ThemePackManifest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read theme pack manifest: %w", err)
+	}
+	manifest := &v1.ThemeManifest{}
+	if err := protojson.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filepath.Join(dir, ThemePackManifest), err)
+	}
+
+	name := filepath.Base(dir)
+	if manifest.ThemeInfo == nil {
+		manifest.ThemeInfo = &v1.ThemeInfo{}
+	}
+	if manifest.ThemeInfo.Name == "" {
+		manifest.ThemeInfo.Name = name
+	}
+	if manifest.ThemeInfo.BasePath == "" {
+		manifest.ThemeInfo.BasePath = "/static/assets/themes/" + name
+	}
+
+	base := NewBaseTheme(manifest, cityTerrains)
+	base.assetDir = dir
+	switch manifest.ThemeInfo.AssetType {
+	case "png":
+		return &DefaultTheme{BaseTheme: base}, nil
+	case "svg":
+		return &PackTheme{BaseTheme: base}, nil
+	default:
+		return nil, fmt.Errorf("theme pack %s: unknown asset type %q (png or svg)", name, manifest.ThemeInfo.AssetType)
+	}
+}
+
+// LoadPacks registers every theme pack in a directory - each subdirectory
+// with a manifest - under its directory name.  Packs that fail to load or
+// clash with a registered theme are skipped and reported in the error; the
+// rest are still registered.  Returns the names registered.
+func (r *ThemeRegistry) LoadPacks(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read theme packs: %w", err)
+	}
+
+	var names []string
+	var errs []error
+	for _, entry := range entries {
+		packDir := filepath.Join(dir, entry.Name())
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(packDir, ThemePackManifest)); err != nil {
+			continue
+		}
+		if r.Has(entry.Name()) {
+			errs = append(errs, fmt.Errorf("theme pack %s: theme already registered", entry.Name()))
+			continue
+		}
+		// Load once up front so broken packs are reported now rather than
+		// on every render
+		if _, err := LoadThemePack(packDir, nil); err != nil {
+			errs = append(errs, fmt.Errorf("theme pack %s: %w", entry.Name(), err))
+			continue
+		}
+		r.Register(entry.Name(), func(cityTerrains map[int32]bool) (Theme, error) {
+			return LoadThemePack(packDir, cityTerrains)
+		})
+		names = append(names, entry.Name())
+	}
+	return names, errors.Join(errs...)
+}
+
+// LoadThemePacks registers the theme packs in a directory with the default
+// registry
+func LoadThemePacks(dir string) ([]string, error) {
+	return DefaultRegistry.LoadPacks(dir)
+}
@@ -0,0 +1,186 @@
+package themes_test
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+const testPackUnitSVG = `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+<defs><linearGradient id="playerColor"><stop offset="0%" stop-color="#000000"/><stop offset="100%" stop-color="#000000"/></linearGradient></defs>
+<circle cx="50" cy="50" r="40" fill="url(#playerColor)"/></svg>`
+
+// writeTestFile writes a file under a directory, creating its parents
+func writeTestFile(t *testing.T, dir, name string, data []byte) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestLoadThemePacks tests that packs in a directory are registered under
+// their directory names and broken or clashing ones are reported
+func TestLoadThemePacks(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "pixel/mapping.json", []byte(`{
+		"themeInfo": {"name": "Pixel", "asset_type": "svg"},
+		"units": {"1": {"name": "Blob", "image": "Units/blob.svg"}},
+		"terrains": {"5": {"name": "Field", "image": "Tiles/field.svg"}}
+	}`))
+	writeTestFile(t, dir, "broken/mapping.json", []byte(`{"themeInfo": {"asset_type": "jpeg"}}`))
+	writeTestFile(t, dir, "default/mapping.json", []byte(`{"themeInfo": {"asset_type": "svg"}}`))
+	writeTestFile(t, dir, "notes/README.md", []byte("not a pack"))
+
+	registry := themes.NewThemeRegistry()
+	names, err := registry.LoadPacks(dir)
+	if len(names) != 1 || names[0] != "pixel" {
+		t.Errorf("Expected only the pixel pack registered, got %v", names)
+	}
+	if err == nil || !strings.Contains(err.Error(), "broken") || !strings.Contains(err.Error(), "default: theme already registered") {
+		t.Errorf("Expected the broken and clashing packs reported, got %v", err)
+	}
+
+	theme, err := registry.Create("pixel", testCityTerrains())
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if theme.GetUnitName(1) != "Blob" || theme.GetThemeInfo().Name != "Pixel" || theme.AssetDir() != filepath.Join(dir, "pixel") {
+		t.Errorf("Expected the pack's manifest and directory, got %q %q %q", theme.GetUnitName(1), theme.GetThemeInfo().Name, theme.AssetDir())
+	}
+	if theme.GetPlayerColor(1) == nil {
+		t.Errorf("Expected default player colours for a pack without any")
+	}
+}
+
+// TestValidateTheme tests that missing mappings, assets and player colour
+// gradients are reported
+func TestValidateTheme(t *testing.T) {
+	rules := lib.DefaultRulesEngine()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "mapping.json", []byte(`{
+		"themeInfo": {"asset_type": "svg"},
+		"units": {"1": {"name": "Blob", "image": "Units/blob.svg"}, "2": {"name": "Ghost", "image": "Units/ghost.svg"}},
+		"terrains": {"1": {"name": "Base", "image": "Tiles/base.svg"}}
+	}`))
+	writeTestFile(t, dir, "Units/blob.svg", []byte(testPackUnitSVG))
+	writeTestFile(t, dir, "Tiles/base.svg", []byte(`<svg xmlns="http://www.w3.org/2000/svg"/>`))
+
+	theme, err := themes.LoadThemePack(dir, rules.GetCityTerrains())
+	if err != nil {
+		t.Fatalf("LoadThemePack: %v", err)
+	}
+	issues := map[string]string{}
+	for _, issue := range themes.ValidateTheme(theme, rules) {
+		issues[fmt.Sprintf("%s %d", issue.Kind, issue.Id)] = issue.Message
+	}
+	if msg, ok := issues["unit 1"]; ok {
+		t.Errorf("Expected no issue for the complete unit, got %q", msg)
+	}
+	if msg := issues["unit 2"]; !strings.Contains(msg, "missing Units/ghost.svg") {
+		t.Errorf("Expected the missing unit asset reported, got %q", msg)
+	}
+	if msg := issues["unit 3"]; msg != "no mapping in the theme" {
+		t.Errorf("Expected the unmapped unit reported, got %q", msg)
+	}
+	if msg := issues["terrain 1"]; !strings.Contains(msg, "no playerColor gradient") {
+		t.Errorf("Expected the city terrain without player colours reported, got %q", msg)
+	}
+}
+
+// TestThemePackPNG tests that a PNG pack renders from its own directory and
+// validates a file per player variant
+func TestThemePackPNG(t *testing.T) {
+	rules := lib.DefaultRulesEngine()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "mapping.json", []byte(`{
+		"themeInfo": {"asset_type": "png"},
+		"terrains": {"5": {"name": "Field", "image": "Tiles/5"}},
+		"playerColors": {"0": {"primary": "#888888", "secondary": "#666666"}}
+	}`))
+	tile := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for i := range tile.Pix {
+		tile.Pix[i] = []uint8{0x10, 0x80, 0x30, 0xff}[i%4]
+	}
+	var buf bytes.Buffer
+	png.Encode(&buf, tile)
+	writeTestFile(t, dir, "Tiles/5/0.png", buf.Bytes())
+
+	theme, err := themes.LoadThemePack(dir, rules.GetCityTerrains())
+	if err != nil {
+		t.Fatalf("LoadThemePack: %v", err)
+	}
+	for _, issue := range themes.ValidateTheme(theme, rules) {
+		if issue.Kind == "terrain" && issue.Id == 5 {
+			t.Errorf("Expected the field tile found, got %s", issue)
+		}
+	}
+
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("CreateWorldRenderer: %v", err)
+	}
+	tiles := map[string]*v1.Tile{"0,0": {Q: 0, R: 0, TileType: 5}}
+	data, _, err := renderer.Render(tiles, nil, nil)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	center := img.Bounds().Min.Add(image.Pt(img.Bounds().Dx()/2, img.Bounds().Dy()/2))
+	if got := color.RGBAModel.Convert(img.At(center.X, center.Y)).(color.RGBA); got != (color.RGBA{0x10, 0x80, 0x30, 0xff}) {
+		t.Errorf("Expected the pack's tile drawn, got %v", got)
+	}
+}
+
+// TestContactSheet tests that a contact sheet shows every terrain, the
+// city terrains per player and every unit per player
+func TestContactSheet(t *testing.T) {
+	rules := lib.DefaultRulesEngine()
+	theme, err := themes.CreateTheme("fantasy", rules.GetCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme: %v", err)
+	}
+	data, contentType, err := themes.ContactSheet(theme, rules, 2)
+	if err != nil {
+		t.Fatalf("ContactSheet: %v", err)
+	}
+	if contentType != "image/svg+xml" || !strings.Contains(string(data), "Medieval Fantasy") {
+		t.Errorf("Expected an SVG captioned with the theme name")
+	}
+
+	// Each player adds rows of city terrains and units
+	fewer, _, err := themes.ContactSheet(theme, rules, 1)
+	if err != nil {
+		t.Fatalf("ContactSheet: %v", err)
+	}
+	if svgHeight(t, fewer) >= svgHeight(t, data) {
+		t.Errorf("Expected a taller sheet for more players")
+	}
+}
+
+// svgHeight returns the height attribute of an SVG document
+func svgHeight(t *testing.T, svg []byte) int {
+	t.Helper()
+	var width, height int
+	start := bytes.Index(svg, []byte(`width="`))
+	if _, err := fmt.Sscanf(string(svg[start:]), `width="%d" height="%d"`, &width, &height); err != nil {
+		t.Fatalf("No SVG size: %v", err)
+	}
+	return height
+}
@@ -0,0 +1,213 @@
+package themes
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"strings"
+)
+
+// pdfDocument writes a minimal PDF: pages of content streams drawing images
+// and Helvetica text.  Only what posters need is supported - no embedded
+// fonts, and images are RGB (transparency should be flattened first).
+type pdfDocument struct {
+	objects [][]byte // Object bodies by id - 1
+	pages   []int
+	font    int
+}
+
+func newPDFDocument() *pdfDocument {
+	doc := &pdfDocument{}
+	doc.reserve() // Catalog
+	doc.reserve() // Page tree
+	doc.font = doc.add([]byte("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>"))
+	return doc
+}
+
+// reserve allocates an object id to be filled in later
+func (d *pdfDocument) reserve() int {
+	d.objects = append(d.objects, nil)
+	return len(d.objects)
+}
+
+func (d *pdfDocument) add(body []byte) int {
+	d.objects = append(d.objects, body)
+	return len(d.objects)
+}
+
+// addStream adds a Flate compressed stream object
+func (d *pdfDocument) addStream(dict string, data []byte) int {
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	zw.Write(data)
+	zw.Close()
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "<< %s /Filter /FlateDecode /Length %d >>\nstream\n", dict, compressed.Len())
+	body.Write(compressed.Bytes())
+	body.WriteString("\nendstream")
+	return d.add(body.Bytes())
+}
+
+// addImage adds an image XObject with its colours over a white background
+func (d *pdfDocument) addImage(img image.Image) int {
+	bounds := img.Bounds()
+	rgb := make([]byte, 0, bounds.Dx()*bounds.Dy()*3)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			// Premultiplied colours over white: c + (1 - a)
+			r, g, b, a := img.At(x, y).RGBA()
+			white := 0xffff - a
+			rgb = append(rgb, byte((r+white)>>8), byte((g+white)>>8), byte((b+white)>>8))
+		}
+	}
+	return d.addStream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8",
+		bounds.Dx(), bounds.Dy()), rgb)
+}
+
+// addPage adds a page of a size in points drawing its content with the
+// images it uses (by id, named /Im<id> in the content)
+func (d *pdfDocument) addPage(width, height float64, content *pdfContent) {
+	var xobjects strings.Builder
+	for _, id := range content.images {
+		fmt.Fprintf(&xobjects, " /Im%d %d 0 R", id, id)
+	}
+	contents := d.addStream("", content.buf.Bytes())
+	page := d.add([]byte(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 %d 0 R >> /XObject <<%s >> >> /Contents %d 0 R >>",
+		pdfNumber(width), pdfNumber(height), d.font, xobjects.String(), contents)))
+	d.pages = append(d.pages, page)
+}
+
+// bytes writes out the document
+func (d *pdfDocument) bytes() []byte {
+	var kids strings.Builder
+	for _, page := range d.pages {
+		fmt.Fprintf(&kids, "%d 0 R ", page)
+	}
+	d.objects[0] = []byte("<< /Type /Catalog /Pages 2 0 R >>")
+	d.objects[1] = []byte(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", kids.String(), len(d.pages)))
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(d.objects))
+	for i, body := range d.objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n", i+1)
+		out.Write(body)
+		out.WriteString("\nendobj\n")
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(d.objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(d.objects)+1, xref)
+	return out.Bytes()
+}
+
+// pdfContent builds a page's content stream.  Coordinates are in points
+// from the bottom left of the page.
+type pdfContent struct {
+	buf    bytes.Buffer
+	images []int
+}
+
+func (c *pdfContent) op(format string, args ...any) {
+	fmt.Fprintf(&c.buf, format+"\n", args...)
+}
+
+// image draws an image XObject into a rectangle
+func (c *pdfContent) image(id int, x, y, width, height float64) {
+	found := false
+	for _, used := range c.images {
+		found = found || used == id
+	}
+	if !found {
+		c.images = append(c.images, id)
+	}
+	c.op("q %s 0 0 %s %s %s cm /Im%d Do Q", pdfNumber(width), pdfNumber(height), pdfNumber(x), pdfNumber(y), id)
+}
+
+// clip limits everything drawn after it to a rectangle (until restore)
+func (c *pdfContent) clip(x, y, width, height float64) {
+	c.op("q %s %s %s %s re W n", pdfNumber(x), pdfNumber(y), pdfNumber(width), pdfNumber(height))
+}
+
+func (c *pdfContent) restore() {
+	c.op("Q")
+}
+
+// polygon strokes a closed path in a grey level (0 black to 1 white)
+func (c *pdfContent) polygon(points [][2]float64, lineWidth, gray float64) {
+	c.op("%s w %s G", pdfNumber(lineWidth), pdfNumber(gray))
+	for i, p := range points {
+		op := "l"
+		if i == 0 {
+			op = "m"
+		}
+		c.op("%s %s %s", pdfNumber(p[0]), pdfNumber(p[1]), op)
+	}
+	c.op("s")
+}
+
+// rect strokes a rectangle
+func (c *pdfContent) rect(x, y, width, height, lineWidth, gray float64) {
+	c.op("%s w %s G %s %s %s %s re S", pdfNumber(lineWidth), pdfNumber(gray), pdfNumber(x), pdfNumber(y), pdfNumber(width), pdfNumber(height))
+}
+
+// text draws text with its baseline starting at a point
+func (c *pdfContent) text(x, y, size, gray float64, text string) {
+	c.op("BT %s g /F1 %s Tf %s %s Td (%s) Tj ET", pdfNumber(gray), pdfNumber(size), pdfNumber(x), pdfNumber(y), pdfEscape(text))
+}
+
+// centredText draws text centred on a point horizontally
+func (c *pdfContent) centredText(x, y, size, gray float64, text string) {
+	c.text(x-helveticaWidth(text, size)/2, y, size, gray, text)
+}
+
+// helveticaWidth returns the width of text in Helvetica, from its glyph
+// widths for digits and the punctuation in labels and an average for the
+// rest
+func helveticaWidth(text string, size float64) float64 {
+	width := 0
+	for _, r := range text {
+		switch {
+		case r >= '0' && r <= '9':
+			width += 556
+		case r == ',' || r == '.' || r == ' ':
+			width += 278
+		case r == '-' || r == 'r':
+			width += 333
+		default:
+			width += 556
+		}
+	}
+	return float64(width) * size / 1000
+}
+
+// pdfEscape escapes a string for a PDF literal, replacing what WinAnsi
+// cannot show
+func pdfEscape(text string) string {
+	var out strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			out.WriteByte('\\')
+			out.WriteRune(r)
+		case r < 32 || r > 126:
+			out.WriteByte('?')
+		default:
+			out.WriteRune(r)
+		}
+	}
+	return out.String()
+}
+
+// pdfNumber formats a number with at most 2 decimals
+func pdfNumber(n float64) string {
+	s := strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", n), "0"), ".")
+	if s == "-0" || s == "" {
+		return "0"
+	}
+	return s
+}
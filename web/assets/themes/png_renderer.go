@@ -7,7 +7,9 @@ import (
 	"image/color"
 	"image/draw"
 	"image/png"
+	"math"
 	"os"
+	"strings"
 	"sync"
 
 	"golang.org/x/image/font"
@@ -44,12 +46,29 @@ func NewPNGWorldRenderer(theme Theme) (*PNGWorldRenderer, error) {
 
 // Render produces a composite PNG image of the world
 func (r *PNGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v1.Unit, options *lib.RenderOptions) ([]byte, string, error) {
+	outputImg, err := r.RenderImage(tiles, units, options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Encode to PNG
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, outputImg); err != nil {
+		return nil, "", fmt.Errorf("failed to encode PNG: %w", err)
+	}
+
+	return buf.Bytes(), "image/png", nil
+}
+
+// RenderImage composes the world into an image without encoding it, eg for
+// the frames of a replay
+func (r *PNGWorldRenderer) RenderImage(tiles map[string]*v1.Tile, units map[string]*v1.Unit, options *lib.RenderOptions) (*image.RGBA, error) {
 	if options == nil {
 		options = lib.DefaultRenderOptions()
 	}
 
 	if len(tiles) == 0 {
-		return nil, "", fmt.Errorf("no tiles to render")
+		return nil, fmt.Errorf("no tiles to render")
 	}
 
 	// Compute bounds
@@ -66,6 +85,12 @@ func (r *PNGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v
 		}
 	}
 
+	// Roads and bridges go over the tiles they cross
+	r.renderCrossings(outputImg, minX, minY, options)
+
+	// Shade the heatmap between tiles and units
+	r.renderHeatmap(outputImg, minX, minY, options)
+
 	// Render units on top
 	for _, unit := range units {
 		if err := r.renderUnit(outputImg, unit, minX, minY, options); err != nil {
@@ -73,6 +98,12 @@ func (r *PNGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v
 		}
 	}
 
+	// Dim exhausted units and mark captures and health over them
+	r.renderUnitState(outputImg, units, minX, minY, options)
+
+	// Render paths and flashes over the units
+	r.renderPaths(outputImg, minX, minY, options)
+
 	// Render tile labels if enabled (below tiles, above units)
 	if options.ShowTileLabels {
 		for _, tile := range tiles {
@@ -87,13 +118,12 @@ func (r *PNGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v
 		}
 	}
 
-	// Encode to PNG
-	var buf bytes.Buffer
-	if err := png.Encode(&buf, outputImg); err != nil {
-		return nil, "", fmt.Errorf("failed to encode PNG: %w", err)
+	// Render the overlay last so nothing covers it
+	if options.Overlay != nil {
+		r.renderOverlay(outputImg, options.Overlay)
 	}
 
-	return buf.Bytes(), "image/png", nil
+	return outputImg, nil
 }
 
 // renderTile draws a single tile onto the output image
@@ -190,8 +220,7 @@ func (r *PNGWorldRenderer) getTileImage(tileType, playerId int32) (image.Image,
 	}
 	r.cacheMutex.RUnlock()
 
-	// Convert web path to filesystem path (remove leading "/" and prepend "web")
-	path := "web" + webPath
+	path := r.assetFile(webPath)
 
 	img, err := r.loadPNG(path)
 	if err != nil {
@@ -225,8 +254,7 @@ func (r *PNGWorldRenderer) getUnitImage(unitType, playerId int32) (image.Image,
 	if webPath == "" {
 		return nil, fmt.Errorf("unit %d not found in theme", unitType)
 	}
-	// Convert web path to filesystem path (remove leading "/" and prepend "web")
-	path := "web" + webPath
+	path := r.assetFile(webPath)
 
 	img, err := r.loadPNG(path)
 	if err != nil {
@@ -241,6 +269,12 @@ func (r *PNGWorldRenderer) getUnitImage(unitType, playerId int32) (image.Image,
 	return img, nil
 }
 
+// assetFile converts a web path from the theme to a filesystem path under
+// the theme's asset directory
+func (r *PNGWorldRenderer) assetFile(webPath string) string {
+	return r.theme.AssetDir() + strings.TrimPrefix(webPath, r.theme.GetThemeInfo().BasePath)
+}
+
 // loadPNG loads a PNG file from the filesystem
 func (r *PNGWorldRenderer) loadPNG(path string) (image.Image, error) {
 	file, err := os.Open(path)
@@ -356,6 +390,240 @@ func (r *PNGWorldRenderer) renderTileLabel(output *image.RGBA, tile *v1.Tile, of
 	r.drawText(output, labelText, labelX, labelY, color.White, face)
 }
 
+// renderOverlay draws the overlay caption and a swatch in each player's
+// colour, outlining the current player's
+func (r *PNGWorldRenderer) renderOverlay(output *image.RGBA, overlay *lib.RenderOverlay) {
+	box, caption, swatches := overlayLayout(overlay)
+	bgColor := color.RGBA{R: 0x11, G: 0x18, B: 0x27, A: 0xB3} // ~70% opacity
+	draw.Draw(output, box, &image.Uniform{bgColor}, image.Point{}, draw.Over)
+	r.drawText(output, overlay.Caption, caption.X, caption.Y, color.White, basicfont.Face7x13)
+
+	for i, swatch := range swatches {
+		playerId := overlay.Players[i]
+		if playerId == overlay.CurrentPlayer {
+			draw.Draw(output, swatch.Inset(-2), &image.Uniform{color.White}, image.Point{}, draw.Src)
+		}
+		fill := parseHexColor("")
+		if colors := r.theme.GetPlayerColor(playerId); colors != nil {
+			fill = parseHexColor(colors.Primary)
+		}
+		draw.Draw(output, swatch, &image.Uniform{fill}, image.Point{}, draw.Src)
+	}
+}
+
+// renderPaths draws each path as a line between hex centres in its
+// player's colour and each flash as a translucent disc
+func (r *PNGWorldRenderer) renderPaths(output *image.RGBA, minX, minY int, options *lib.RenderOptions) {
+	for _, path := range options.Paths {
+		col := parseHexColor("")
+		if colors := r.theme.GetPlayerColor(path.Player); colors != nil {
+			col = parseHexColor(colors.Primary)
+		}
+		src := &image.Uniform{col}
+		for i := 1; i < len(path.Hexes); i++ {
+			from := hexCenter(path.Hexes[i-1], minX, minY, options)
+			to := hexCenter(path.Hexes[i], minX, minY, options)
+			drawLine(output, from, to, pathWidth, src)
+		}
+		if n := len(path.Hexes); n > 0 {
+			fillCircle(output, hexCenter(path.Hexes[n-1], minX, minY, options), pathWidth*2, src)
+		}
+	}
+
+	flash := parseHexColor(flashColor)
+	flash.A = 0x99 // ~60% opacity
+	for _, coord := range options.Flashes {
+		radius := int(float64(options.TileWidth) * flashRadius)
+		fillCircle(output, hexCenter(coord, minX, minY, options), radius, &image.Uniform{flash})
+	}
+}
+
+// renderCrossings draws roads and bridges as segments from each crossing's
+// hex centre toward the neighbours it connects to: a grey border, a dark
+// surface and a dashed yellow centre line, with pillars beside bridges
+func (r *PNGWorldRenderer) renderCrossings(output *image.RGBA, minX, minY int, options *lib.RenderOptions) {
+	width := crossingWidth(options)
+	border := &image.Uniform{parseHexColor(crossingBorderColor)}
+	surface := &image.Uniform{parseHexColor(crossingSurfaceColor)}
+	line := &image.Uniform{parseHexColor(crossingLineColor)}
+	pillar := &image.Uniform{parseHexColor(bridgePillarColor)}
+	for _, coord := range sortedCrossings(options.Crossings) {
+		crossing := options.Crossings[lib.CoordKeyFromAxial(coord)]
+		segments := crossingSegments(coord, crossing, minX, minY, options)
+		if crossing.Type == v1.CrossingType_CROSSING_TYPE_BRIDGE {
+			for _, segment := range segments {
+				for _, p := range bridgePillars(segment, options) {
+					fillCircle(output, p, scaled(3, options), pillar)
+				}
+			}
+		}
+		for _, segment := range segments {
+			drawLine(output, segment[0], segment[1], width+2, border)
+		}
+		for _, segment := range segments {
+			drawLine(output, segment[0], segment[1], width, surface)
+		}
+		for _, segment := range segments {
+			length := math.Hypot(float64(segment[1].X-segment[0].X), float64(segment[1].Y-segment[0].Y))
+			for d := 0.0; d < length; d += float64(scaled(9, options)) {
+				end := min(d+float64(scaled(5, options)), length)
+				drawLine(output, segmentPoint(segment, d), segmentPoint(segment, end), scaled(2, options), line)
+			}
+		}
+	}
+}
+
+// renderUnitState dims exhausted units, flags units capturing their tile
+// and draws health bars
+func (r *PNGWorldRenderer) renderUnitState(output *image.RGBA, units map[string]*v1.Unit, minX, minY int, options *lib.RenderOptions) {
+	dim := parseHexColor(exhaustedColor)
+	dim.A = uint8(255 * exhaustedOpacity)
+	// Colours are premultiplied by alpha
+	dim.R = uint8(uint32(dim.R) * uint32(dim.A) / 255)
+	dim.G = uint8(uint32(dim.G) * uint32(dim.A) / 255)
+	dim.B = uint8(uint32(dim.B) * uint32(dim.A) / 255)
+	for _, coord := range options.Exhausted {
+		mask := &hexMask{center: hexCenter(coord, minX, minY, options), width: options.TileWidth, height: options.TileHeight}
+		draw.DrawMask(output, mask.Bounds(), &image.Uniform{dim}, image.Point{}, mask, mask.Bounds().Min, draw.Over)
+	}
+
+	for _, unit := range units {
+		if unit.CaptureStartedTurn > 0 {
+			pole, pennant := captureFlag(lib.AxialCoord{Q: int(unit.Q), R: int(unit.R)}, minX, minY, options)
+			drawLine(output, pole[0], pole[1], scaled(2, options), &image.Uniform{parseHexColor(flagPoleColor)})
+			col := parseHexColor("")
+			if colors := r.theme.GetPlayerColor(unit.Player); colors != nil {
+				col = parseHexColor(colors.Primary)
+			}
+			for i := range pennant {
+				drawLine(output, pennant[i], pennant[(i+1)%3], 2, &image.Uniform{color.Black})
+			}
+			mask := &triangleMask{points: pennant}
+			draw.DrawMask(output, mask.Bounds(), &image.Uniform{col}, image.Point{}, mask, mask.Bounds().Min, draw.Over)
+		}
+		if options.ShowHealthBars {
+			bar, fill, fillColor := healthBar(unit, minX, minY, options)
+			draw.Draw(output, bar, &image.Uniform{parseHexColor(healthBarBackground)}, image.Point{}, draw.Over)
+			draw.Draw(output, fill, &image.Uniform{parseHexColor(fillColor)}, image.Point{}, draw.Over)
+		}
+	}
+}
+
+// drawLine draws a line of a width with round ends by stamping discs
+// along it
+func drawLine(output *image.RGBA, from, to image.Point, width int, src image.Image) {
+	steps := max(abs(to.X-from.X), abs(to.Y-from.Y))
+	for s := 0; s <= steps; s += 2 {
+		fillCircle(output, from.Add(to.Sub(from).Mul(s).Div(max(steps, 1))), width/2, src)
+	}
+	fillCircle(output, to, width/2, src)
+}
+
+// renderHeatmap shades each cell's hex with an opacity from its weight
+func (r *PNGWorldRenderer) renderHeatmap(output *image.RGBA, minX, minY int, options *lib.RenderOptions) {
+	for _, cell := range options.Heatmap {
+		col := parseHexColor(threatColor)
+		if cell.Player > 0 {
+			col = parseHexColor("")
+			if colors := r.theme.GetPlayerColor(cell.Player); colors != nil {
+				col = parseHexColor(colors.Primary)
+			}
+		}
+		col.A = uint8(255 * heatmapOpacity * min(max(cell.Weight, 0), 1))
+		// Colours are premultiplied by alpha
+		col.R = uint8(uint32(col.R) * uint32(col.A) / 255)
+		col.G = uint8(uint32(col.G) * uint32(col.A) / 255)
+		col.B = uint8(uint32(col.B) * uint32(col.A) / 255)
+		mask := &hexMask{center: hexCenter(cell.Coord, minX, minY, options), width: options.TileWidth, height: options.TileHeight}
+		draw.DrawMask(output, mask.Bounds(), &image.Uniform{col}, image.Point{}, mask, mask.Bounds().Min, draw.Over)
+	}
+}
+
+// hexMask is an opaque pointy-top hex filling a tile, used as a draw mask
+type hexMask struct {
+	center        image.Point
+	width, height int
+}
+
+func (m *hexMask) ColorModel() color.Model { return color.AlphaModel }
+
+func (m *hexMask) Bounds() image.Rectangle {
+	return image.Rect(m.center.X-m.width/2, m.center.Y-m.height/2, m.center.X+m.width/2, m.center.Y+m.height/2)
+}
+
+func (m *hexMask) At(x, y int) color.Color {
+	// Inside the side edges and under the sloped top and bottom edges
+	dx, dy := abs(x-m.center.X), abs(y-m.center.Y)
+	halfW, halfH := m.width/2, m.height/2
+	if dx <= halfW && dy*halfW <= halfH*halfW-dx*halfH/2 {
+		return color.Alpha{A: 0xff}
+	}
+	return color.Alpha{}
+}
+
+// triangleMask is an opaque triangle used as a draw mask
+type triangleMask struct {
+	points [3]image.Point
+}
+
+func (m *triangleMask) ColorModel() color.Model { return color.AlphaModel }
+
+func (m *triangleMask) Bounds() image.Rectangle {
+	bounds := image.Rectangle{Min: m.points[0], Max: m.points[0].Add(image.Pt(1, 1))}
+	for _, p := range m.points[1:] {
+		bounds = bounds.Union(image.Rectangle{Min: p, Max: p.Add(image.Pt(1, 1))})
+	}
+	return bounds
+}
+
+func (m *triangleMask) At(x, y int) color.Color {
+	// Inside when on the same side of all three edges
+	var negative, positive bool
+	for i := range m.points {
+		a, b := m.points[i], m.points[(i+1)%3]
+		cross := (b.X-a.X)*(y-a.Y) - (b.Y-a.Y)*(x-a.X)
+		negative = negative || cross < 0
+		positive = positive || cross > 0
+	}
+	if negative && positive {
+		return color.Alpha{}
+	}
+	return color.Alpha{A: 0xff}
+}
+
+// fillCircle draws a disc of src over the image
+func fillCircle(output *image.RGBA, center image.Point, radius int, src image.Image) {
+	mask := &circleMask{center: center, radius: radius}
+	draw.DrawMask(output, mask.Bounds(), src, image.Point{}, mask, mask.Bounds().Min, draw.Over)
+}
+
+// circleMask is an opaque disc used as a draw mask
+type circleMask struct {
+	center image.Point
+	radius int
+}
+
+func (c *circleMask) ColorModel() color.Model { return color.AlphaModel }
+
+func (c *circleMask) Bounds() image.Rectangle {
+	return image.Rect(c.center.X-c.radius, c.center.Y-c.radius, c.center.X+c.radius+1, c.center.Y+c.radius+1)
+}
+
+func (c *circleMask) At(x, y int) color.Color {
+	dx, dy := x-c.center.X, y-c.center.Y
+	if dx*dx+dy*dy <= c.radius*c.radius {
+		return color.Alpha{A: 0xff}
+	}
+	return color.Alpha{}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
 // drawText draws text at the given position
 func (r *PNGWorldRenderer) drawText(img *image.RGBA, text string, x, y int, col color.Color, face font.Face) {
 	d := &font.Drawer{
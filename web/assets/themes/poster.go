@@ -0,0 +1,443 @@
+package themes
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/png"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// Posters are printable maps for tabletop play: the world at print
+// resolution with every hex labelled with the coordinates ww commands take
+// (Q,R and rRow,Col), and a legend of the theme's terrains and units.  PDF
+// posters are tiled over as many pages as the map needs; SVG posters are a
+// single document sized in millimetres for a large format printer.
+
+// Poster formats
+const (
+	PosterFormatPDF = "pdf"
+	PosterFormatSVG = "svg"
+)
+
+// PaperSizes are the page sizes PDF posters tile over, in points portrait
+var PaperSizes = map[string][2]float64{
+	"a4":      {595.28, 841.89},
+	"a3":      {841.89, 1190.55},
+	"letter":  {612, 792},
+	"tabloid": {792, 1224},
+}
+
+// Poster defaults
+const (
+	DefaultPosterHexSize = 20.0 // Millimetres across a hex
+	DefaultPosterDPI     = 150
+	DefaultPosterPaper   = "a4"
+
+	posterMargin = 36.0 // Half an inch
+	posterHeader = 18.0 // Title line above the map on each page
+)
+
+// PosterOptions controls how a poster is laid out
+type PosterOptions struct {
+	// Render options for the map, eg crossings.  The tile size is set from
+	// HexSize and DPI.
+	Render *lib.RenderOptions
+
+	// Title printed on every page
+	Title string
+
+	// HexSize is the width of a hex on paper in millimetres
+	HexSize float64
+
+	// DPI the map is rendered at
+	DPI int
+
+	// Paper is a key of PaperSizes (PDF only)
+	Paper     string
+	Landscape bool
+
+	// ShowLegend adds the theme's names for the terrains and units on the
+	// map
+	ShowLegend bool
+}
+
+// DefaultPosterOptions returns options for A4 pages with 20mm hexes and a
+// legend
+func DefaultPosterOptions() *PosterOptions {
+	return &PosterOptions{
+		HexSize:    DefaultPosterHexSize,
+		DPI:        DefaultPosterDPI,
+		Paper:      DefaultPosterPaper,
+		ShowLegend: true,
+	}
+}
+
+// posterLegendEntry is a terrain or unit shown in a poster's legend
+type posterLegendEntry struct {
+	Kind   string // "Terrain" or "Unit"
+	Id     int32
+	Player int32
+	Name   string
+}
+
+// posterLayout is the map of a poster in pixels at its DPI
+type posterLayout struct {
+	options    *PosterOptions
+	render     *lib.RenderOptions
+	minX, minY int
+	width      int
+	height     int
+	tiles      []*v1.Tile // In row then column order
+}
+
+// RenderPoster renders a world as a printable PDF or SVG poster.  PDF
+// posters need a PNG asset theme.  Returns the document and its content
+// type.
+func RenderPoster(renderer WorldRenderer, tiles map[string]*v1.Tile, units map[string]*v1.Unit, format string, options *PosterOptions) ([]byte, string, error) {
+	if len(tiles) == 0 {
+		return nil, "", fmt.Errorf("no tiles to render")
+	}
+	layout, err := newPosterLayout(tiles, units, options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch format {
+	case PosterFormatPDF:
+		pngRenderer, ok := renderer.(*PNGWorldRenderer)
+		if !ok {
+			return nil, "", fmt.Errorf("PDF posters need a PNG asset theme")
+		}
+		data, err := renderPosterPDF(pngRenderer, tiles, units, layout)
+		return data, "application/pdf", err
+	case PosterFormatSVG:
+		data, err := renderPosterSVG(renderer, tiles, units, layout)
+		return data, "image/svg+xml", err
+	default:
+		return nil, "", fmt.Errorf("unknown poster format: %s", format)
+	}
+}
+
+// newPosterLayout fills in default options and sizes the map
+func newPosterLayout(tiles map[string]*v1.Tile, units map[string]*v1.Unit, options *PosterOptions) (*posterLayout, error) {
+	opts := DefaultPosterOptions()
+	if options != nil {
+		*opts = *options
+	}
+	if opts.HexSize <= 0 {
+		opts.HexSize = DefaultPosterHexSize
+	}
+	if opts.DPI <= 0 {
+		opts.DPI = DefaultPosterDPI
+	}
+	if opts.Paper == "" {
+		opts.Paper = DefaultPosterPaper
+	}
+	if _, ok := PaperSizes[opts.Paper]; !ok {
+		return nil, fmt.Errorf("unknown paper size: %s", opts.Paper)
+	}
+
+	render := lib.DefaultRenderOptions()
+	if opts.Render != nil {
+		copied := *opts.Render
+		render = &copied
+	}
+	size := int(math.Round(opts.HexSize / 25.4 * float64(opts.DPI)))
+	if size < 8 || size > 2048 {
+		return nil, fmt.Errorf("hex size %.1fmm at %d DPI is out of range", opts.HexSize, opts.DPI)
+	}
+	render.TileWidth = size
+	render.TileHeight = size * lib.DefaultTileHeight / lib.DefaultTileWidth
+	render.YIncrement = size * lib.DefaultYIncrement / lib.DefaultTileWidth
+
+	layout := &posterLayout{options: opts, render: render}
+	layout.minX, layout.minY, layout.width, layout.height = computeBounds(tiles, units, render)
+	for _, tile := range tiles {
+		layout.tiles = append(layout.tiles, tile)
+	}
+	sort.Slice(layout.tiles, func(i, j int) bool {
+		a, b := layout.tiles[i], layout.tiles[j]
+		if a.R != b.R {
+			return a.R < b.R
+		}
+		return a.Q < b.Q
+	})
+	return layout, nil
+}
+
+// hexLabels returns a hex's Q,R and row/col labels as ParsePositionOrUnit
+// reads them
+func hexLabels(coord lib.AxialCoord) (string, string) {
+	row, col := lib.HexToRowCol(coord, lib.UseEvenRowOffsetCoords)
+	return fmt.Sprintf("%d,%d", coord.Q, coord.R), fmt.Sprintf("r%d,%d", row, col)
+}
+
+// posterLegend returns the terrains and units on the map by id with their
+// names in a theme
+func posterLegend(theme Theme, tiles map[string]*v1.Tile, units map[string]*v1.Unit) []posterLegendEntry {
+	var entries []posterLegendEntry
+	seen := map[string]bool{}
+	for _, tile := range tiles {
+		key := fmt.Sprintf("t%d", tile.TileType)
+		if !seen[key] {
+			seen[key] = true
+			entries = append(entries, posterLegendEntry{Kind: "Terrain", Id: tile.TileType, Name: theme.GetTerrainName(tile.TileType)})
+		}
+	}
+	unitPlayers := map[int32]int32{}
+	for _, unit := range units {
+		if player, ok := unitPlayers[unit.UnitType]; !ok || unit.Player < player {
+			unitPlayers[unit.UnitType] = unit.Player
+		}
+	}
+	for unitType, player := range unitPlayers {
+		entries = append(entries, posterLegendEntry{Kind: "Unit", Id: unitType, Player: player, Name: theme.GetUnitName(unitType)})
+	}
+	for i := range entries {
+		if entries[i].Name == "" {
+			entries[i].Name = fmt.Sprintf("%s %d", entries[i].Kind, entries[i].Id)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Kind != entries[j].Kind {
+			return entries[i].Kind == "Terrain"
+		}
+		return entries[i].Id < entries[j].Id
+	})
+	return entries
+}
+
+// renderPosterPDF renders the map once and places it across pages with the
+// hex grid and labels drawn as vectors over it, then legend pages
+func renderPosterPDF(r *PNGWorldRenderer, tiles map[string]*v1.Tile, units map[string]*v1.Unit, layout *posterLayout) ([]byte, error) {
+	img, err := r.RenderImage(tiles, units, layout.render)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := layout.options
+	paper := PaperSizes[opts.Paper]
+	pageW, pageH := paper[0], paper[1]
+	if opts.Landscape {
+		pageW, pageH = pageH, pageW
+	}
+	areaW, areaH := pageW-2*posterMargin, pageH-2*posterMargin-posterHeader
+	top := pageH - posterMargin - posterHeader
+	scale := 72 / float64(opts.DPI) // Points per pixel
+	mapW, mapH := float64(layout.width)*scale, float64(layout.height)*scale
+	cols, rows := int(math.Ceil(mapW/areaW)), int(math.Ceil(mapH/areaH))
+
+	doc := newPDFDocument()
+	mapImage := doc.addImage(img)
+	labelSize := max(float64(layout.render.TileWidth)*scale*0.13, 4)
+	pages := rows * cols
+	for row := range rows {
+		for col := range cols {
+			ox, oy := float64(col)*areaW, float64(row)*areaH
+			// toPage converts map pixels to points on this page
+			toPage := func(p image.Point) (float64, float64) {
+				return posterMargin + float64(p.X)*scale - ox, top - (float64(p.Y)*scale - oy)
+			}
+
+			content := &pdfContent{}
+			title := fmt.Sprintf("Page %d of %d (row %d, column %d)", row*cols+col+1, pages, row+1, col+1)
+			if opts.Title != "" {
+				title = opts.Title + " - " + title
+			}
+			content.text(posterMargin, pageH-posterMargin-12, 10, 0, title)
+			content.rect(posterMargin, top-areaH, areaW, areaH, 0.5, 0.6)
+
+			content.clip(posterMargin, top-areaH, areaW, areaH)
+			content.image(mapImage, posterMargin-ox, top+oy-mapH, mapW, mapH)
+			for _, tile := range layout.tiles {
+				coord := lib.AxialCoord{Q: int(tile.Q), R: int(tile.R)}
+				cx, cy := toPage(hexCenter(coord, layout.minX, layout.minY, layout.render))
+				reach := float64(layout.render.TileWidth) * scale
+				if cx < posterMargin-reach || cx > posterMargin+areaW+reach || cy < top-areaH-reach || cy > top+reach {
+					continue
+				}
+				var points [][2]float64
+				for _, corner := range hexCorners(coord, layout.minX, layout.minY, layout.render) {
+					x, y := toPage(corner)
+					points = append(points, [2]float64{x, y})
+				}
+				content.polygon(points, 0.4, 0.25)
+				qr, rowCol := hexLabels(coord)
+				half := float64(layout.render.TileHeight) * scale / 2
+				content.centredText(cx, cy+half*0.55, labelSize, 0, qr)
+				content.centredText(cx, cy-half*0.55-labelSize*0.7, labelSize, 0, rowCol)
+			}
+			content.restore()
+			doc.addPage(pageW, pageH, content)
+		}
+	}
+
+	if opts.ShowLegend {
+		writePDFLegend(doc, r, posterLegend(r.theme, tiles, units), pageW, pageH, opts.Title)
+	}
+	return doc.bytes(), nil
+}
+
+// writePDFLegend adds pages listing each legend entry with its asset
+func writePDFLegend(doc *pdfDocument, r *PNGWorldRenderer, entries []posterLegendEntry, pageW, pageH float64, title string) {
+	const cellW, cellH, iconSize = 170.0, 44.0, 36.0
+	top := pageH - posterMargin - posterHeader
+	cols := max(int((pageW-2*posterMargin)/cellW), 1)
+	perPage := cols * max(int((top-posterMargin)/cellH), 1)
+	heading := "Legend"
+	if title != "" {
+		heading = title + " - " + heading
+	}
+
+	for start := 0; start < len(entries); start += perPage {
+		content := &pdfContent{}
+		content.text(posterMargin, pageH-posterMargin-12, 10, 0, heading)
+		for i, entry := range entries[start:min(start+perPage, len(entries))] {
+			x := posterMargin + float64(i%cols)*cellW
+			y := top - float64(i/cols+1)*cellH
+			var img image.Image
+			var err error
+			if entry.Kind == "Terrain" {
+				img, err = r.getTileImage(entry.Id, 0)
+			} else {
+				img, err = r.getUnitImage(entry.Id, entry.Player)
+			}
+			if err == nil {
+				content.image(doc.addImage(img), x, y+(cellH-iconSize)/2, iconSize, iconSize)
+			}
+			content.text(x+iconSize+6, y+cellH/2+2, 9, 0, entry.Name)
+			content.text(x+iconSize+6, y+cellH/2-9, 7, 0.4, fmt.Sprintf("%s %d", entry.Kind, entry.Id))
+		}
+		doc.addPage(pageW, pageH, content)
+	}
+}
+
+// svgDocumentTag matches the XML declaration and doctype before an SVG
+var svgDocumentTag = regexp.MustCompile(`^\s*(<\?xml[^?]*\?>\s*)?(<!DOCTYPE[^>]*>\s*)?`)
+
+// renderPosterSVG renders the map as one SVG sized in millimetres, with the
+// grid and labels over it and the legend below
+func renderPosterSVG(renderer WorldRenderer, tiles map[string]*v1.Tile, units map[string]*v1.Unit, layout *posterLayout) ([]byte, error) {
+	opts := layout.options
+	header := int(float64(opts.DPI) * 0.4)
+	labelSize := max(layout.render.TileWidth*13/100, 6)
+
+	var legend []posterLegendEntry
+	var theme Theme
+	switch r := renderer.(type) {
+	case *PNGWorldRenderer:
+		theme = r.theme
+	case *SVGWorldRenderer:
+		theme = r.theme
+	}
+	if opts.ShowLegend && theme != nil {
+		legend = posterLegend(theme, tiles, units)
+	}
+	cellW, cellH := opts.DPI*2, opts.DPI/2
+	legendCols := max(layout.width/cellW, 1)
+	legendRows := (len(legend) + legendCols - 1) / legendCols
+	legendHeight := 0
+	if legendRows > 0 {
+		legendHeight = header + legendRows*cellH
+	}
+	width, height := layout.width, header+layout.height+legendHeight
+	mm := func(px int) string { return pdfNumber(float64(px)*25.4/float64(opts.DPI)) + "mm" }
+
+	var svg bytes.Buffer
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" width="%s" height="%s" font-family="Helvetica, Arial, sans-serif">
+  <rect width="%d" height="%d" fill="#ffffff"/>
+`, width, height, mm(width), mm(height), width, height)
+	if opts.Title != "" {
+		fmt.Fprintf(&svg, "  <text x=\"0\" y=\"%d\" font-size=\"%d\">%s</text>\n", header*2/3, header/2, xmlEscape(opts.Title))
+	}
+
+	// The map as the theme renders it
+	fmt.Fprintf(&svg, "  <g transform=\"translate(0,%d)\">\n", header)
+	data, contentType, err := renderer.Render(tiles, units, layout.render)
+	if err != nil {
+		return nil, err
+	}
+	if contentType == "image/svg+xml" {
+		svg.Write(svgDocumentTag.ReplaceAll(data, nil))
+	} else {
+		fmt.Fprintf(&svg, "  <image width=\"%d\" height=\"%d\" href=\"data:%s;base64,%s\"/>\n",
+			layout.width, layout.height, contentType, base64.StdEncoding.EncodeToString(data))
+	}
+
+	// Grid and labels
+	svg.WriteString("\n  <!-- Grid -->\n  <g fill=\"none\" stroke=\"#404040\" stroke-width=\"1\">\n")
+	for _, tile := range layout.tiles {
+		var points []string
+		for _, p := range hexCorners(lib.AxialCoord{Q: int(tile.Q), R: int(tile.R)}, layout.minX, layout.minY, layout.render) {
+			points = append(points, fmt.Sprintf("%d,%d", p.X, p.Y))
+		}
+		fmt.Fprintf(&svg, "    <polygon points=\"%s\"/>\n", strings.Join(points, " "))
+	}
+	fmt.Fprintf(&svg, "  </g>\n  <g font-size=\"%d\" text-anchor=\"middle\" fill=\"#000000\" stroke=\"#ffffff\" stroke-width=\"%d\" paint-order=\"stroke\">\n", labelSize, max(labelSize/5, 1))
+	for _, tile := range layout.tiles {
+		coord := lib.AxialCoord{Q: int(tile.Q), R: int(tile.R)}
+		c := hexCenter(coord, layout.minX, layout.minY, layout.render)
+		qr, rowCol := hexLabels(coord)
+		offset := layout.render.TileHeight * 55 / 200
+		fmt.Fprintf(&svg, "    <text x=\"%d\" y=\"%d\">%s</text>\n", c.X, c.Y-offset, qr)
+		fmt.Fprintf(&svg, "    <text x=\"%d\" y=\"%d\">%s</text>\n", c.X, c.Y+offset+labelSize*7/10, rowCol)
+	}
+	svg.WriteString("  </g>\n  </g>\n")
+
+	if len(legend) > 0 {
+		writeSVGLegend(&svg, renderer, legend, header+layout.height, header, legendCols, cellW, cellH)
+	}
+	svg.WriteString("</svg>\n")
+	return svg.Bytes(), nil
+}
+
+// writeSVGLegend writes the legend entries in rows below the map, using the
+// map's symbols for SVG themes and inlined assets for PNG themes
+func writeSVGLegend(svg *bytes.Buffer, renderer WorldRenderer, entries []posterLegendEntry, top, header, cols, cellW, cellH int) {
+	icon := cellH * 4 / 5
+	fmt.Fprintf(svg, "\n  <!-- Legend -->\n  <g transform=\"translate(0,%d)\">\n", top)
+	fmt.Fprintf(svg, "    <text x=\"0\" y=\"%d\" font-size=\"%d\">Legend</text>\n", header*2/3, header/2)
+	for i, entry := range entries {
+		x, y := (i%cols)*cellW, header+(i/cols)*cellH
+		switch r := renderer.(type) {
+		case *SVGWorldRenderer:
+			id := r.unitSymbolId(entry.Id, entry.Player)
+			if entry.Kind == "Terrain" {
+				id = r.tileSymbolId(entry.Id, 0)
+			}
+			fmt.Fprintf(svg, "    <use href=\"#%s\" x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\"/>\n", id, x, y, icon, icon)
+		case *PNGWorldRenderer:
+			var img image.Image
+			var err error
+			if entry.Kind == "Terrain" {
+				img, err = r.getTileImage(entry.Id, 0)
+			} else {
+				img, err = r.getUnitImage(entry.Id, entry.Player)
+			}
+			var buf bytes.Buffer
+			if err == nil && png.Encode(&buf, img) == nil {
+				fmt.Fprintf(svg, "    <image x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" href=\"data:image/png;base64,%s\"/>\n",
+					x, y, icon, icon, base64.StdEncoding.EncodeToString(buf.Bytes()))
+			}
+		}
+		fmt.Fprintf(svg, "    <text x=\"%d\" y=\"%d\" font-size=\"%d\">%s</text>\n", x+icon+cellH/5, y+cellH*2/5, cellH/4, xmlEscape(entry.Name))
+		fmt.Fprintf(svg, "    <text x=\"%d\" y=\"%d\" font-size=\"%d\" fill=\"#666666\">%s %d</text>\n", x+icon+cellH/5, y+cellH*3/4, cellH/5, entry.Kind, entry.Id)
+	}
+	svg.WriteString("  </g>\n")
+}
+
+// xmlEscape escapes text for XML content
+func xmlEscape(text string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(text))
+	return buf.String()
+}
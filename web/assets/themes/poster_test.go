@@ -0,0 +1,153 @@
+package themes_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// posterWorld returns a rectangle of grass with a unit in its corner
+func posterWorld(rows, cols int) (map[string]*v1.Tile, map[string]*v1.Unit) {
+	tiles := map[string]*v1.Tile{}
+	for row := range rows {
+		for col := range cols {
+			coord := lib.RowColToHex(row, col, lib.UseEvenRowOffsetCoords)
+			tiles[lib.CoordKeyFromAxial(coord)] = &v1.Tile{Q: int32(coord.Q), R: int32(coord.R), TileType: 5}
+		}
+	}
+	units := map[string]*v1.Unit{"0,0": {Q: 0, R: 0, Player: 1, UnitType: 1}}
+	return tiles, units
+}
+
+// pdfStreams returns the decompressed streams of a PDF
+func pdfStreams(t *testing.T, data []byte) []string {
+	t.Helper()
+	var streams []string
+	for _, match := range regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllSubmatch(data, -1) {
+		zr, err := zlib.NewReader(bytes.NewReader(match[1]))
+		if err != nil {
+			t.Fatalf("Bad stream: %v", err)
+		}
+		content, err := io.ReadAll(zr)
+		if err != nil {
+			t.Fatalf("Bad stream: %v", err)
+		}
+		streams = append(streams, string(content))
+	}
+	return streams
+}
+
+// TestRenderPosterPDF tests that a large map is tiled over pages labelled
+// with both coordinate forms, followed by a legend
+func TestRenderPosterPDF(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "mapping.json", []byte(`{
+		"themeInfo": {"asset_type": "png"},
+		"terrains": {"5": {"name": "Field", "image": "Tiles/5"}},
+		"units": {"1": {"name": "Trooper", "image": "Units/1"}}
+	}`))
+	var buf bytes.Buffer
+	png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 8, 8)))
+	writeTestFile(t, dir, "Tiles/5/0.png", buf.Bytes())
+	writeTestFile(t, dir, "Units/1/1.png", buf.Bytes())
+
+	theme, err := themes.LoadThemePack(dir, nil)
+	if err != nil {
+		t.Fatalf("LoadThemePack: %v", err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("CreateWorldRenderer: %v", err)
+	}
+	tiles, units := posterWorld(20, 20)
+	options := themes.DefaultPosterOptions()
+	options.Title = "Big Map"
+	data, contentType, err := themes.RenderPoster(renderer, tiles, units, themes.PosterFormatPDF, options)
+	if err != nil {
+		t.Fatalf("RenderPoster: %v", err)
+	}
+	if contentType != "application/pdf" || !bytes.HasPrefix(data, []byte("%PDF-")) {
+		t.Fatalf("Expected a PDF, got %s", contentType)
+	}
+
+	var mapPages int
+	content := strings.Join(pdfStreams(t, data), "\n")
+	if _, err := fmt.Sscanf(content[strings.Index(content, "(Big Map - Page 1 of "):], "(Big Map - Page 1 of %d", &mapPages); err != nil || mapPages < 2 {
+		t.Fatalf("Expected the map tiled over several pages, got %d (%v)", mapPages, err)
+	}
+	if pages := bytes.Count(data, []byte("/Type /Page ")); pages != mapPages+1 {
+		t.Errorf("Expected %d map pages and a legend, got %d pages", mapPages, pages)
+	}
+	for _, label := range []string{"(0,0) Tj", "(r0,0) Tj", "(r19,19) Tj", "(Field) Tj", "(Trooper) Tj"} {
+		if !strings.Contains(content, label) {
+			t.Errorf("Expected %s in the page content", label)
+		}
+	}
+
+	// Landscape A3 needs fewer pages
+	options.Paper, options.Landscape = "a3", true
+	data, _, err = themes.RenderPoster(renderer, tiles, units, themes.PosterFormatPDF, options)
+	if err != nil {
+		t.Fatalf("RenderPoster: %v", err)
+	}
+	if pages := bytes.Count(data, []byte("/Type /Page ")); pages >= mapPages+1 {
+		t.Errorf("Expected fewer pages on landscape A3, got %d", pages)
+	}
+
+	options.Paper = "napkin"
+	if _, _, err := themes.RenderPoster(renderer, tiles, units, themes.PosterFormatPDF, options); err == nil {
+		t.Errorf("Expected an unknown paper size rejected")
+	}
+}
+
+// TestRenderPosterSVG tests that an SVG poster is sized in millimetres with
+// the grid labels and a legend using the map's symbols
+func TestRenderPosterSVG(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "mapping.json", []byte(`{
+		"themeInfo": {"asset_type": "svg"},
+		"terrains": {"5": {"name": "Field", "image": "Tiles/field.svg"}},
+		"units": {"1": {"name": "Blob", "image": "Units/blob.svg"}}
+	}`))
+	writeTestFile(t, dir, "Tiles/field.svg", []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100"><rect width="100" height="100"/></svg>`))
+	writeTestFile(t, dir, "Units/blob.svg", []byte(testPackUnitSVG))
+
+	theme, err := themes.LoadThemePack(dir, nil)
+	if err != nil {
+		t.Fatalf("LoadThemePack: %v", err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("CreateWorldRenderer: %v", err)
+	}
+	tiles, units := posterWorld(3, 4)
+	data, contentType, err := themes.RenderPoster(renderer, tiles, units, themes.PosterFormatSVG, &themes.PosterOptions{Title: "Skirmish <1>", ShowLegend: true})
+	if err != nil {
+		t.Fatalf("RenderPoster: %v", err)
+	}
+	svg := string(data)
+	if contentType != "image/svg+xml" || !regexp.MustCompile(`width="[0-9.]+mm"`).MatchString(svg) {
+		t.Errorf("Expected an SVG sized in millimetres")
+	}
+	if strings.Count(svg, "<polygon") != len(tiles) {
+		t.Errorf("Expected a grid hex per tile, got %d", strings.Count(svg, "<polygon"))
+	}
+	for _, text := range []string{">0,0</text>", ">r2,3</text>", "Skirmish &lt;1&gt;", ">Field</text>", ">Blob</text>", `href="#unit_1_1"`} {
+		if !strings.Contains(svg, text) {
+			t.Errorf("Expected %s in the poster", text)
+		}
+	}
+	if strings.Contains(svg, "<?xml") {
+		t.Errorf("Expected the map's XML declaration dropped when nesting it")
+	}
+}
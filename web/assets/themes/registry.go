@@ -2,6 +2,7 @@ package themes
 
 import (
 	"fmt"
+	"sort"
 )
 
 // ThemeRegistry provides a factory for creating themes by name
@@ -47,15 +48,22 @@ func (r *ThemeRegistry) Create(name string, cityTerrains map[int32]bool) (Theme,
 	return factory(cityTerrains)
 }
 
-// GetAvailableThemes returns a list of all registered theme names
+// GetAvailableThemes returns a list of all registered theme names, sorted
 func (r *ThemeRegistry) GetAvailableThemes() []string {
 	names := make([]string, 0, len(r.themes))
 	for name := range r.themes {
 		names = append(names, name)
 	}
+	sort.Strings(names)
 	return names
 }
 
+// Has checks if a theme is registered
+func (r *ThemeRegistry) Has(name string) bool {
+	_, ok := r.themes[name]
+	return ok
+}
+
 // DefaultRegistry is the global theme registry
 var DefaultRegistry = NewThemeRegistry()
 
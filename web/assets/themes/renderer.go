@@ -2,6 +2,11 @@ package themes
 
 import (
 	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+	"strconv"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
 	"github.com/turnforge/lilbattle/lib"
@@ -37,3 +42,206 @@ func computeBounds(tiles map[string]*v1.Tile, units map[string]*v1.Unit, opts *l
 	bounds := lib.ComputeWorldBounds(tiles, units, opts)
 	return bounds.MinX, bounds.MinY, bounds.Width, bounds.Height
 }
+
+// Overlay geometry shared by the renderers, in pixels.  The caption uses a
+// 7x13 fixed width font.
+const (
+	overlayMargin     = 8
+	overlayPadding    = 6
+	overlayCharWidth  = 7
+	overlayTextHeight = 13
+	overlaySwatchSize = 13
+	overlaySwatchGap  = 4
+)
+
+// overlayLayout places an overlay's box, caption baseline and player swatches
+// in the top-left corner of the image
+func overlayLayout(overlay *lib.RenderOverlay) (box image.Rectangle, caption image.Point, swatches []image.Rectangle) {
+	x := overlayMargin + overlayPadding
+	top := overlayMargin + overlayPadding
+	caption = image.Pt(x, top+overlayTextHeight-2)
+	x += len(overlay.Caption) * overlayCharWidth
+	for range overlay.Players {
+		x += overlaySwatchGap
+		swatches = append(swatches, image.Rect(x, top, x+overlaySwatchSize, top+overlaySwatchSize))
+		x += overlaySwatchSize
+	}
+	box = image.Rect(overlayMargin, overlayMargin, x+overlayPadding, top+overlayTextHeight+overlayPadding)
+	return box, caption, swatches
+}
+
+// Replay marks shared by the renderers, in pixels
+const (
+	pathWidth   = 4
+	flashColor  = "#f97316"
+	flashRadius = 0.4 // Of the tile width
+)
+
+// Heatmap shading, in the player's colour or threatColor for player 0
+const (
+	threatColor    = "#dc2626"
+	heatmapOpacity = 0.55 // Of a cell with weight 1
+)
+
+// hexCorners returns the corners of a pointy-top hex filling its tile,
+// clockwise from the top
+func hexCorners(coord lib.AxialCoord, minX, minY int, opts *lib.RenderOptions) [6]image.Point {
+	c := hexCenter(coord, minX, minY, opts)
+	w, h := opts.TileWidth/2, opts.TileHeight/2
+	return [6]image.Point{
+		{c.X, c.Y - h}, {c.X + w, c.Y - h/2}, {c.X + w, c.Y + h/2},
+		{c.X, c.Y + h}, {c.X - w, c.Y + h/2}, {c.X - w, c.Y - h/2},
+	}
+}
+
+// Crossings and unit state are drawn like the browser's crossing, exhausted
+// and capturing flag layers
+const (
+	crossingBorderColor  = "#888888"
+	crossingSurfaceColor = "#3a3a3a"
+	crossingLineColor    = "#e0b000"
+	bridgePillarColor    = "#505050"
+	exhaustedColor       = "#404040"
+	exhaustedOpacity     = 0.4
+	flagPoleColor        = "#8b4513"
+	healthBarBackground  = "#111827"
+)
+
+// scaled scales a size in pixels on the browser's 64 pixel wide tiles
+func scaled(size int, opts *lib.RenderOptions) int {
+	return max(size*opts.TileWidth/lib.DefaultTileWidth, 1)
+}
+
+// sortedCrossings returns the coordinates of the crossings in key order so
+// renders are the same every time
+func sortedCrossings(crossings map[string]*v1.Crossing) []lib.AxialCoord {
+	keys := make([]string, 0, len(crossings))
+	for key := range crossings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var coords []lib.AxialCoord
+	for _, key := range keys {
+		if crossings[key].GetType() == v1.CrossingType_CROSSING_TYPE_UNSPECIFIED {
+			continue
+		}
+		if coord, err := lib.ParseCoordKey(key); err == nil {
+			coords = append(coords, coord)
+		}
+	}
+	return coords
+}
+
+// crossingSegments returns a crossing's segments - from the hex centre
+// halfway to each neighbour it connects to, or across the hex when it has
+// no connections
+func crossingSegments(coord lib.AxialCoord, crossing *v1.Crossing, minX, minY int, opts *lib.RenderOptions) [][2]image.Point {
+	center := hexCenter(coord, minX, minY, opts)
+	var segments [][2]image.Point
+	for i, connected := range crossing.ConnectsTo {
+		if !connected || i >= 6 {
+			continue
+		}
+		neighbor := hexCenter(coord.Neighbor(lib.NeighborDirection(i)), minX, minY, opts)
+		segments = append(segments, [2]image.Point{center, center.Add(neighbor).Div(2)})
+	}
+	if len(segments) == 0 {
+		half := opts.TileWidth * 7 / 20
+		segments = append(segments, [2]image.Point{center.Sub(image.Pt(half, 0)), center.Add(image.Pt(half, 0))})
+	}
+	return segments
+}
+
+// segmentPoint returns the point a distance along a segment
+func segmentPoint(segment [2]image.Point, distance float64) image.Point {
+	dx, dy := float64(segment[1].X-segment[0].X), float64(segment[1].Y-segment[0].Y)
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return segment[0]
+	}
+	return image.Pt(segment[0].X+int(math.Round(dx*distance/length)), segment[0].Y+int(math.Round(dy*distance/length)))
+}
+
+// bridgePillars returns the centres of a bridge segment's pillars - three
+// on each side
+func bridgePillars(segment [2]image.Point, opts *lib.RenderOptions) []image.Point {
+	dx, dy := float64(segment[1].X-segment[0].X), float64(segment[1].Y-segment[0].Y)
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return nil
+	}
+	offset := float64(crossingWidth(opts)/2 + scaled(4, opts))
+	px, py := -dy/length*offset, dx/length*offset
+	var pillars []image.Point
+	for _, t := range []float64{0.1, 0.5, 0.9} {
+		p := segmentPoint(segment, t*length)
+		pillars = append(pillars,
+			image.Pt(p.X+int(math.Round(px)), p.Y+int(math.Round(py))),
+			image.Pt(p.X-int(math.Round(px)), p.Y-int(math.Round(py))))
+	}
+	return pillars
+}
+
+// crossingWidth is the width of a road or bridge surface
+func crossingWidth(opts *lib.RenderOptions) int {
+	return scaled(20, opts)
+}
+
+// unitMaxHealth returns a unit type's full health from the default rules
+func unitMaxHealth(unitType int32) int32 {
+	if unitData, err := lib.DefaultRulesEngine().GetUnitData(unitType); err == nil && unitData.Health > 0 {
+		return unitData.Health
+	}
+	return 10
+}
+
+// healthBar returns the bar over the top of a unit, its filled part and the
+// fill colour - green, then yellow and red as health runs out
+func healthBar(unit *v1.Unit, minX, minY int, opts *lib.RenderOptions) (bar, fill image.Rectangle, fillColor string) {
+	x, y := lib.HexToPixelInt32(unit.Q, unit.R, opts)
+	width, height := opts.TileWidth*3/5, scaled(5, opts)
+	left, top := x-minX+(opts.TileWidth-width)/2, y-minY+opts.TileHeight/8
+	bar = image.Rect(left, top, left+width, top+height)
+
+	fraction := min(max(float64(unit.AvailableHealth)/float64(unitMaxHealth(unit.UnitType)), 0), 1)
+	fill = image.Rect(left, top, left+int(math.Round(float64(width)*fraction)), top+height)
+	switch {
+	case fraction > 0.6:
+		fillColor = "#22c55e"
+	case fraction > 0.3:
+		fillColor = "#eab308"
+	default:
+		fillColor = threatColor
+	}
+	return bar, fill, fillColor
+}
+
+// captureFlag returns the pole and pennant of the flag drawn at the top
+// right of a unit capturing its tile
+func captureFlag(coord lib.AxialCoord, minX, minY int, opts *lib.RenderOptions) (pole [2]image.Point, pennant [3]image.Point) {
+	base := hexCenter(coord, minX, minY, opts).Add(image.Pt(opts.TileWidth/4, -opts.TileWidth/4))
+	pole = [2]image.Point{base.Add(image.Pt(0, -scaled(15, opts))), base.Add(image.Pt(0, scaled(15, opts)))}
+	pennant = [3]image.Point{
+		pole[0],
+		base.Add(image.Pt(scaled(20, opts), -scaled(10, opts))),
+		base.Add(image.Pt(0, -scaled(5, opts))),
+	}
+	return pole, pennant
+}
+
+// hexCenter returns the centre of a hex in image coordinates
+func hexCenter(coord lib.AxialCoord, minX, minY int, opts *lib.RenderOptions) image.Point {
+	x, y := lib.HexToPixel(coord, opts)
+	return image.Pt(x-minX+opts.TileWidth/2, y-minY+opts.TileHeight/2)
+}
+
+// parseHexColor parses a "#rrggbb" theme colour, falling back to grey
+func parseHexColor(hex string) color.RGBA {
+	c := color.RGBA{R: 0x88, G: 0x88, B: 0x88, A: 0xff}
+	if len(hex) == 7 && hex[0] == '#' {
+		if v, err := strconv.ParseUint(hex[1:], 16, 32); err == nil {
+			c.R, c.G, c.B = uint8(v>>16), uint8(v>>8), uint8(v)
+		}
+	}
+	return c
+}
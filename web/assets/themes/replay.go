@@ -0,0 +1,326 @@
+package themes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"time"
+
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// Replay output formats
+const (
+	ReplayFormatGIF  = "gif"
+	ReplayFormatAPNG = "apng"
+)
+
+// DefaultReplayFrameDelay is how long each move is shown for
+const DefaultReplayFrameDelay = 800 * time.Millisecond
+
+// DefaultMaxReplayFrames caps the frames of an animation when ReplayOptions
+// does not
+const DefaultMaxReplayFrames = 300
+
+// replayBackground fills the transparent corners of the map in animations.
+// Frames are made opaque so GIF has no transparency to lose and every APNG
+// frame encodes with the same colour type as the first.
+const replayBackground = "#111827"
+
+// ReplayOptions controls how replay frames are rendered
+type ReplayOptions struct {
+	// Render options for every frame.  Paths, flashes and the overlay are
+	// filled in per frame.
+	Render *lib.RenderOptions
+
+	// Players shown in the caption, eg from the game's config
+	Players []int32
+
+	// How long each frame is shown, DefaultReplayFrameDelay if 0
+	FrameDelay time.Duration
+
+	// Hold the last frame for this many frame delays before looping
+	HoldLast int
+
+	// Most frames an animation has, DefaultMaxReplayFrames if 0.  Longer
+	// replays are sampled evenly, keeping the first and last frames.
+	MaxFrames int
+}
+
+// ReplayFrameOptions returns the render options for a frame - its paths,
+// attack flashes and a turn and player caption
+func ReplayFrameOptions(frame *lib.ReplayFrame, options *ReplayOptions) *lib.RenderOptions {
+	out := lib.DefaultRenderOptions()
+	if options != nil && options.Render != nil {
+		copied := *options.Render
+		out = &copied
+	}
+	out.Paths = frame.Paths
+	out.Flashes = frame.Flashes
+	out.Overlay = &lib.RenderOverlay{
+		Caption:       fmt.Sprintf("Turn %d - Player %d", frame.Turn, frame.Player),
+		CurrentPlayer: frame.Player,
+	}
+	if options != nil {
+		out.Overlay.Players = options.Players
+	}
+	return out
+}
+
+// RenderReplayFrames renders each frame on its own with any renderer, eg
+// to write a frame sequence.  Returns the images and their content type.
+func RenderReplayFrames(renderer WorldRenderer, frames []*lib.ReplayFrame, options *ReplayOptions) ([][]byte, string, error) {
+	var images [][]byte
+	var contentType string
+	for i, frame := range frames {
+		data, ct, err := renderer.Render(frame.Tiles, frame.Units, ReplayFrameOptions(frame, options))
+		if err != nil {
+			return nil, "", fmt.Errorf("frame %d: %w", i, err)
+		}
+		images = append(images, data)
+		contentType = ct
+	}
+	return images, contentType, nil
+}
+
+// EncodeReplay renders the frames with a PNG asset theme and encodes them
+// as an animated GIF or APNG.  Returns the image and its content type.
+func EncodeReplay(renderer WorldRenderer, frames []*lib.ReplayFrame, format string, options *ReplayOptions) ([]byte, string, error) {
+	var buf bytes.Buffer
+	contentType, err := WriteReplay(&buf, renderer, frames, format, options)
+	if err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), contentType, nil
+}
+
+// WriteReplay renders the frames with a PNG asset theme and writes them to
+// w as an animated GIF or APNG a frame at a time, so only one frame's image
+// is held at once.  Replays longer than MaxFrames are sampled.  Returns the
+// content type.
+func WriteReplay(w io.Writer, renderer WorldRenderer, frames []*lib.ReplayFrame, format string, options *ReplayOptions) (string, error) {
+	pngRenderer, ok := renderer.(*PNGWorldRenderer)
+	if !ok {
+		return "", fmt.Errorf("animated replays need a PNG asset theme")
+	}
+	if len(frames) == 0 {
+		return "", fmt.Errorf("no frames to encode")
+	}
+
+	delay := DefaultReplayFrameDelay
+	hold := 0
+	maxFrames := DefaultMaxReplayFrames
+	if options != nil {
+		if options.FrameDelay > 0 {
+			delay = options.FrameDelay
+		}
+		if options.MaxFrames > 0 {
+			maxFrames = options.MaxFrames
+		}
+		hold = options.HoldLast
+	}
+	frames = lib.SampleReplayFrames(frames, maxFrames)
+
+	// Every frame is drawn on an opaque canvas big enough for all of them,
+	// sized up front from the frames' bounds
+	var canvas image.Rectangle
+	for _, frame := range frames {
+		bounds := lib.ComputeWorldBounds(frame.Tiles, frame.Units, ReplayFrameOptions(frame, options))
+		canvas = canvas.Union(image.Rect(0, 0, bounds.Width, bounds.Height))
+	}
+
+	var out replayWriter
+	var contentType string
+	switch format {
+	case ReplayFormatGIF:
+		out, contentType = &gifWriter{w: w, canvas: canvas}, "image/gif"
+	case ReplayFormatAPNG:
+		out, contentType = &apngWriter{w: w, canvas: canvas, frames: len(frames)}, "image/apng"
+	default:
+		return "", fmt.Errorf("unknown replay format: %s", format)
+	}
+
+	full := image.NewRGBA(canvas)
+	background := &image.Uniform{parseHexColor(replayBackground)}
+	for i, frame := range frames {
+		img, err := pngRenderer.RenderImage(frame.Tiles, frame.Units, ReplayFrameOptions(frame, options))
+		if err != nil {
+			return "", fmt.Errorf("frame %d: %w", i, err)
+		}
+		draw.Draw(full, canvas, background, image.Point{}, draw.Src)
+		draw.Draw(full, img.Bounds(), img, img.Bounds().Min, draw.Over)
+
+		frameDelay := delay
+		if i == len(frames)-1 {
+			frameDelay += time.Duration(hold) * delay
+		}
+		if err := out.writeFrame(full, frameDelay); err != nil {
+			return "", fmt.Errorf("frame %d: %w", i, err)
+		}
+	}
+	return contentType, out.close()
+}
+
+// replayWriter writes an animation a frame at a time
+type replayWriter interface {
+	writeFrame(img *image.RGBA, delay time.Duration) error
+	close() error
+}
+
+// gifWriter dithers each frame onto the Plan 9 palette and encodes it as a
+// GIF on its own, with the palette as its global colour table, then copies
+// its image block into the animation
+type gifWriter struct {
+	w       io.Writer
+	canvas  image.Rectangle
+	started bool
+}
+
+func (g *gifWriter) writeFrame(img *image.RGBA, delay time.Duration) error {
+	frame := image.NewPaletted(g.canvas, palette.Plan9)
+	draw.FloydSteinberg.Draw(frame, g.canvas, img, g.canvas.Min)
+	var buf bytes.Buffer
+	err := gif.EncodeAll(&buf, &gif.GIF{
+		Image:  []*image.Paletted{frame},
+		Delay:  []int{int(delay / (10 * time.Millisecond))},
+		Config: image.Config{ColorModel: color.Palette(palette.Plan9), Width: g.canvas.Dx(), Height: g.canvas.Dy()},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode GIF: %w", err)
+	}
+	data := buf.Bytes()
+
+	// The header and logical screen descriptor are followed by the global
+	// colour table when its flag is set, and the image by the trailer
+	header := 13
+	if data[10]&0x80 != 0 {
+		header += 3 << (data[10]&7 + 1)
+	}
+	if !g.started {
+		if _, err := g.w.Write(data[:header]); err != nil {
+			return err
+		}
+		// Loop forever
+		if _, err := g.w.Write([]byte("\x21\xff\x0bNETSCAPE2.0\x03\x01\x00\x00\x00")); err != nil {
+			return err
+		}
+		g.started = true
+	}
+	_, err = g.w.Write(data[header : len(data)-1])
+	return err
+}
+
+func (g *gifWriter) close() error {
+	_, err := g.w.Write([]byte{0x3b})
+	return err
+}
+
+// apngWriter writes an animated PNG.  Each frame is encoded as a PNG on its
+// own and its IDAT data moved into the animation: as is for the first frame
+// (so viewers without APNG support show it) and as fdAT chunks for the rest.
+type apngWriter struct {
+	w      io.Writer
+	canvas image.Rectangle
+	frames int
+	ihdr   []byte
+	seq    uint32
+}
+
+func (a *apngWriter) writeFrame(img *image.RGBA, delay time.Duration) error {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return fmt.Errorf("failed to encode frame: %w", err)
+	}
+	chunks, err := pngChunks(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	if len(chunks["IHDR"]) != 1 {
+		return fmt.Errorf("frame has no PNG header")
+	}
+
+	var out bytes.Buffer
+	first := a.ihdr == nil
+	if first {
+		a.ihdr = chunks["IHDR"][0]
+		out.WriteString("\x89PNG\r\n\x1a\n")
+		writePNGChunk(&out, "IHDR", a.ihdr)
+		actl := make([]byte, 8)
+		binary.BigEndian.PutUint32(actl[0:], uint32(a.frames))
+		binary.BigEndian.PutUint32(actl[4:], 0) // Loop forever
+		writePNGChunk(&out, "acTL", actl)
+	} else if !bytes.Equal(chunks["IHDR"][0], a.ihdr) {
+		return fmt.Errorf("frame encoded differently from the first")
+	}
+
+	fctl := make([]byte, 26)
+	binary.BigEndian.PutUint32(fctl[0:], a.seq)
+	binary.BigEndian.PutUint32(fctl[4:], uint32(a.canvas.Dx()))
+	binary.BigEndian.PutUint32(fctl[8:], uint32(a.canvas.Dy()))
+	binary.BigEndian.PutUint16(fctl[20:], uint16(delay/time.Millisecond))
+	binary.BigEndian.PutUint16(fctl[22:], 1000)
+	// x/y offsets, dispose and blend ops are all 0
+	writePNGChunk(&out, "fcTL", fctl)
+	a.seq++
+
+	for _, data := range chunks["IDAT"] {
+		if first {
+			writePNGChunk(&out, "IDAT", data)
+			continue
+		}
+		fdat := make([]byte, 4+len(data))
+		binary.BigEndian.PutUint32(fdat, a.seq)
+		copy(fdat[4:], data)
+		writePNGChunk(&out, "fdAT", fdat)
+		a.seq++
+	}
+	_, err = a.w.Write(out.Bytes())
+	return err
+}
+
+func (a *apngWriter) close() error {
+	var out bytes.Buffer
+	writePNGChunk(&out, "IEND", nil)
+	_, err := a.w.Write(out.Bytes())
+	return err
+}
+
+// pngChunks returns the data of each chunk of an encoded PNG by type
+func pngChunks(data []byte) (map[string][][]byte, error) {
+	if len(data) < 8 {
+		return nil, fmt.Errorf("not a PNG")
+	}
+	chunks := map[string][][]byte{}
+	for pos := 8; pos+12 <= len(data); {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		if pos+12+length > len(data) {
+			return nil, fmt.Errorf("truncated PNG chunk")
+		}
+		kind := string(data[pos+4 : pos+8])
+		chunks[kind] = append(chunks[kind], data[pos+8:pos+8+length])
+		pos += 12 + length
+	}
+	return chunks, nil
+}
+
+// writePNGChunk writes a chunk with its length and CRC
+func writePNGChunk(out *bytes.Buffer, kind string, data []byte) {
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(data)))
+	out.Write(header[:])
+	crc := crc32.NewIEEE()
+	crc.Write([]byte(kind))
+	crc.Write(data)
+	out.WriteString(kind)
+	out.Write(data)
+	binary.BigEndian.PutUint32(header[:], crc.Sum32())
+	out.Write(header[:])
+}
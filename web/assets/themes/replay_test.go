@@ -0,0 +1,102 @@
+package themes_test
+
+import (
+	"bytes"
+	"image/gif"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// replayTestFrames returns a starting frame and one where a unit moved and
+// was attacked
+func replayTestFrames() []*lib.ReplayFrame {
+	tiles, units := smallTestWorld()
+	moved := map[string]*v1.Unit{"1,0": {Q: 1, R: 0, Player: 1, UnitType: 1, AvailableHealth: 6}}
+	return []*lib.ReplayFrame{
+		{Tiles: tiles, Units: units, Turn: 1, Player: 1},
+		{
+			Tiles: tiles, Units: moved, Turn: 1, Player: 1,
+			Paths:   []lib.RenderPath{{Player: 1, Hexes: []lib.AxialCoord{{Q: 0, R: 0}, {Q: 1, R: 0}}}},
+			Flashes: []lib.AxialCoord{{Q: 1, R: 0}},
+		},
+	}
+}
+
+func TestEncodeReplay(t *testing.T) {
+	theme, err := themes.CreateTheme("default", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme: %v", err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("CreateWorldRenderer: %v", err)
+	}
+	options := &themes.ReplayOptions{Players: []int32{1, 2}, FrameDelay: 500 * time.Millisecond, HoldLast: 2}
+
+	data, contentType, err := themes.EncodeReplay(renderer, replayTestFrames(), themes.ReplayFormatGIF, options)
+	if err != nil {
+		t.Fatalf("EncodeReplay(gif): %v", err)
+	}
+	anim, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Invalid GIF: %v", err)
+	}
+	if contentType != "image/gif" || len(anim.Image) != 2 || anim.Delay[0] != 50 || anim.Delay[1] != 150 || anim.LoopCount != 0 {
+		t.Errorf("Expected 2 looping GIF frames with the last held, got %d frames, delays %v, loop %d (%s)", len(anim.Image), anim.Delay, anim.LoopCount, contentType)
+	}
+
+	data, contentType, err = themes.EncodeReplay(renderer, replayTestFrames(), themes.ReplayFormatAPNG, options)
+	if err != nil {
+		t.Fatalf("EncodeReplay(apng): %v", err)
+	}
+	// Viewers without APNG support see the first frame as a plain PNG
+	if _, err := png.Decode(bytes.NewReader(data)); err != nil {
+		t.Errorf("Expected the APNG to decode as a PNG: %v", err)
+	}
+	if contentType != "image/apng" || !bytes.Contains(data, []byte("acTL")) || bytes.Count(data, []byte("fcTL")) != 2 || !bytes.Contains(data, []byte("fdAT")) {
+		t.Errorf("Expected an APNG with 2 frames, got %d bytes (%s)", len(data), contentType)
+	}
+
+	// Replays over MaxFrames are sampled down to it
+	options.MaxFrames = 1
+	data, _, err = themes.EncodeReplay(renderer, replayTestFrames(), themes.ReplayFormatGIF, options)
+	if err != nil {
+		t.Fatalf("EncodeReplay(gif, 1 frame): %v", err)
+	}
+	if anim, err := gif.DecodeAll(bytes.NewReader(data)); err != nil || len(anim.Image) != 1 {
+		t.Errorf("Expected a 1 frame GIF, got %v (%v)", anim, err)
+	}
+}
+
+func TestRenderReplayFramesSVG(t *testing.T) {
+	theme, err := themes.CreateTheme("fantasy", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme: %v", err)
+	}
+	renderer, err := themes.CreateWorldRenderer(theme)
+	if err != nil {
+		t.Fatalf("CreateWorldRenderer: %v", err)
+	}
+
+	// Animations need PNG assets but frames can be rendered in any theme
+	if _, _, err := themes.EncodeReplay(renderer, replayTestFrames(), themes.ReplayFormatGIF, nil); err == nil {
+		t.Errorf("Expected an SVG theme to be rejected for animations")
+	}
+	frames, contentType, err := themes.RenderReplayFrames(renderer, replayTestFrames(), nil)
+	if err != nil {
+		t.Fatalf("RenderReplayFrames: %v", err)
+	}
+	if contentType != "image/svg+xml" || len(frames) != 2 {
+		t.Fatalf("Expected 2 SVG frames, got %d (%s)", len(frames), contentType)
+	}
+	last := string(frames[1])
+	if !strings.Contains(last, "<polyline") || !strings.Contains(last, "fill-opacity=\"0.6\"") || !strings.Contains(last, "Turn 1 - Player 1") {
+		t.Errorf("Expected the path, flash and caption in the frame, got %s", last)
+	}
+}
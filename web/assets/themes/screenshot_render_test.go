@@ -1,9 +1,14 @@
 package themes_test
 
 import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"strings"
 	"testing"
 
 	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
 	"github.com/turnforge/lilbattle/web/assets/themes"
 )
 
@@ -89,3 +94,188 @@ func TestScreenshotRenderingEmptyWorld(t *testing.T) {
 		t.Logf("Empty world render returned error (acceptable): %v", err)
 	}
 }
+
+// TestScreenshotRenderingOverlay tests that the game overlay draws its
+// caption and a swatch in each player's theme colour
+func TestScreenshotRenderingOverlay(t *testing.T) {
+	tiles, units := smallTestWorld()
+	options := lib.DefaultRenderOptions()
+	options.Overlay = &lib.RenderOverlay{Caption: "Turn 3", Players: []int32{1, 2}, CurrentPlayer: 2}
+
+	for _, themeName := range []string{"default", "fantasy"} {
+		t.Run(themeName, func(t *testing.T) {
+			theme, err := themes.CreateTheme(themeName, testCityTerrains())
+			if err != nil {
+				t.Fatalf("CreateTheme(%s): %v", themeName, err)
+			}
+			renderer, err := themes.CreateWorldRenderer(theme)
+			if err != nil {
+				t.Fatalf("CreateWorldRenderer(%s): %v", themeName, err)
+			}
+			imageBytes, contentType, err := renderer.Render(tiles, units, options)
+			if err != nil {
+				t.Fatalf("Render(%s): %v", themeName, err)
+			}
+			red := theme.GetPlayerColor(2).Primary
+
+			if contentType == "image/svg+xml" {
+				svg := string(imageBytes)
+				if !strings.Contains(svg, ">Turn 3</text>") || !strings.Contains(svg, `fill="`+red+`" stroke="#ffffff"`) {
+					t.Errorf("Expected the caption and outlined player 2 swatch in the SVG")
+				}
+				return
+			}
+
+			img, err := png.Decode(bytes.NewReader(imageBytes))
+			if err != nil {
+				t.Fatalf("Failed to decode PNG: %v", err)
+			}
+			// Swatches follow the caption: margin, padding and 6 characters
+			x := 8 + 6 + 6*7
+			blue := theme.GetPlayerColor(1).Primary
+			for i, want := range []string{blue, red} {
+				r, g, b, _ := img.At(x+4+i*17+6, 8+6+6).RGBA()
+				if got := fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8); got != want {
+					t.Errorf("Expected swatch %d coloured %s, got %s", i, want, got)
+				}
+			}
+		})
+	}
+}
+
+// TestScreenshotRenderingHeatmap tests that heatmap cells shade their hex in
+// red or their player's colour between the tiles and units
+func TestScreenshotRenderingHeatmap(t *testing.T) {
+	tiles, units := smallTestWorld()
+	options := lib.DefaultRenderOptions()
+	options.Heatmap = []lib.HeatmapCell{
+		{Coord: lib.AxialCoord{Q: 1, R: 0}, Weight: 1},
+		{Coord: lib.AxialCoord{Q: 0, R: 1}, Player: 2, Weight: 0.5},
+	}
+
+	theme, err := themes.CreateTheme("fantasy", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme: %v", err)
+	}
+	renderer, _ := themes.CreateWorldRenderer(theme)
+	imageBytes, _, err := renderer.Render(tiles, units, options)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	svg := string(imageBytes)
+	heatmap := strings.Index(svg, "<!-- Heatmap -->")
+	if heatmap < 0 || heatmap > strings.Index(svg, "<!-- Units -->") || strings.Count(svg, "<polygon") != 2 {
+		t.Errorf("Expected 2 heatmap hexes before the units in the SVG")
+	}
+	if !strings.Contains(svg, `fill="#dc2626" fill-opacity="0.55"`) || !strings.Contains(svg, `fill="`+theme.GetPlayerColor(2).Primary+`" fill-opacity="0.28"`) {
+		t.Errorf("Expected a red threat hex and a half weight player 2 hex in the SVG")
+	}
+
+	theme, err = themes.CreateTheme("default", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme: %v", err)
+	}
+	renderer, _ = themes.CreateWorldRenderer(theme)
+	plain, _, err := renderer.Render(tiles, units, nil)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	shaded, _, err := renderer.Render(tiles, units, options)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	plainImg, _ := png.Decode(bytes.NewReader(plain))
+	shadedImg, _ := png.Decode(bytes.NewReader(shaded))
+
+	// The centre of the threatened hex is redder, the unshaded one untouched
+	minX, minY := minPixel(tiles, options)
+	center := func(q, r int32) (int, int) {
+		x, y := lib.HexToPixelInt32(q, r, options)
+		return x - minX + options.TileWidth/2, y - minY + options.TileHeight/2
+	}
+	x, y := center(1, 0)
+	pr, pg, _, _ := plainImg.At(x, y).RGBA()
+	sr, sg, _, _ := shadedImg.At(x, y).RGBA()
+	if int(sr)-int(sg) <= int(pr)-int(pg) {
+		t.Errorf("Expected the threatened hex shaded red")
+	}
+	x, y = center(0, 0)
+	if plainImg.At(x, y) != shadedImg.At(x, y) {
+		t.Errorf("Expected the hex without a cell untouched")
+	}
+}
+
+// TestScreenshotRenderingCrossingsAndUnitState tests that crossings, capture
+// flags, health bars and exhausted units are drawn as players see them
+func TestScreenshotRenderingCrossingsAndUnitState(t *testing.T) {
+	tiles, units := smallTestWorld()
+	units["0,0"].AvailableHealth = 4
+	units["0,0"].CaptureStartedTurn = 1
+	options := lib.DefaultRenderOptions()
+	options.Crossings = map[string]*v1.Crossing{
+		"1,0": {Type: v1.CrossingType_CROSSING_TYPE_BRIDGE, ConnectsTo: []bool{true, false, false, false, false, false}},
+	}
+	options.ShowHealthBars = true
+	options.Exhausted = []lib.AxialCoord{{Q: 0, R: 0}}
+
+	theme, err := themes.CreateTheme("fantasy", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme: %v", err)
+	}
+	renderer, _ := themes.CreateWorldRenderer(theme)
+	imageBytes, _, err := renderer.Render(tiles, units, options)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	svg := string(imageBytes)
+	crossings := strings.Index(svg, "<!-- Crossings -->")
+	if crossings < 0 || crossings > strings.Index(svg, "<!-- Units -->") {
+		t.Errorf("Expected crossings before the units in the SVG")
+	}
+	if strings.Count(svg, `stroke="#3a3a3a"`) != 1 || !strings.Contains(svg, `stroke-dasharray="5 4"`) || strings.Count(svg, `fill="#505050"`) != 6 {
+		t.Errorf("Expected one bridge segment with a dashed centre line and 6 pillars in the SVG")
+	}
+	unitState := strings.Index(svg, "<!-- Unit state -->")
+	if unitState < strings.Index(svg, "<!-- Units -->") {
+		t.Errorf("Expected unit state after the units in the SVG")
+	}
+	for _, want := range []string{`fill="#404040" fill-opacity="0.40"`, `stroke="#8b4513"`, `fill="#eab308"`, `fill="#111827"`} {
+		if !strings.Contains(svg[unitState:], want) {
+			t.Errorf("Expected %s in the SVG unit state", want)
+		}
+	}
+
+	theme, err = themes.CreateTheme("default", testCityTerrains())
+	if err != nil {
+		t.Fatalf("CreateTheme: %v", err)
+	}
+	renderer, _ = themes.CreateWorldRenderer(theme)
+	imageBytes, _, err = renderer.Render(tiles, units, options)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(imageBytes))
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+
+	// The bridge surface runs left from the centre of its hex and the
+	// health bar is 40% full in yellow
+	minX, minY := minPixel(tiles, options)
+	x, y := lib.HexToPixelInt32(1, 0, options)
+	x, y = x-minX+options.TileWidth/2, y-minY+options.TileHeight/2
+	if r, g, b, _ := img.At(x-8, y+5).RGBA(); r>>8 != 0x3a || g>>8 != 0x3a || b>>8 != 0x3a {
+		t.Errorf("Expected the bridge surface at (%d,%d), got %v", x-8, y+5, img.At(x-8, y+5))
+	}
+	x, y = lib.HexToPixelInt32(0, 0, options)
+	x, y = x-minX+options.TileWidth/5+1, y-minY+options.TileHeight/8+1
+	if r, g, b, _ := img.At(x, y).RGBA(); r>>8 != 0xea || g>>8 != 0xb3 || b>>8 != 0x08 {
+		t.Errorf("Expected a yellow health bar at (%d,%d), got %v", x, y, img.At(x, y))
+	}
+}
+
+// minPixel returns the top-left of a world's pixel bounds
+func minPixel(tiles map[string]*v1.Tile, options *lib.RenderOptions) (int, int) {
+	bounds := lib.ComputeWorldBounds(tiles, nil, options)
+	return bounds.MinX, bounds.MinY
+}
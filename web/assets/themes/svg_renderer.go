@@ -2,10 +2,13 @@ package themes
 
 import (
 	"bytes"
+	"encoding/xml"
 	"fmt"
+	"image"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 
@@ -36,8 +39,8 @@ func NewSVGWorldRenderer(theme Theme) (*SVGWorldRenderer, error) {
 
 	// Determine asset root from theme's base path
 	// Theme basePath is like "/static/assets/themes/fantasy"
-	// We need "web/static/assets/themes/fantasy" or relative "web" + basePath
-	assetRoot := "web" + info.BasePath
+	// We need "web/static/assets/themes/fantasy" (or a theme pack's directory)
+	assetRoot := theme.AssetDir()
 
 	return &SVGWorldRenderer{
 		theme:     theme,
@@ -127,6 +130,14 @@ func (r *SVGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v
 			symbolId, x, y, options.TileWidth, options.TileHeight))
 	}
 
+	if len(options.Crossings) > 0 {
+		r.writeCrossings(&svg, minX, minY, options)
+	}
+
+	if len(options.Heatmap) > 0 {
+		r.writeHeatmap(&svg, minX, minY, options)
+	}
+
 	// Third pass: place units on top
 	svg.WriteString("\n  <!-- Units -->\n")
 	for _, unit := range units {
@@ -149,11 +160,186 @@ func (r *SVGWorldRenderer) Render(tiles map[string]*v1.Tile, units map[string]*v
 			symbolId, useX, useY, unitWidth, unitHeight))
 	}
 
+	r.writeUnitState(&svg, units, minX, minY, options)
+
+	if len(options.Paths) > 0 || len(options.Flashes) > 0 {
+		r.writePaths(&svg, minX, minY, options)
+	}
+
+	// Overlay on top of everything
+	if options.Overlay != nil {
+		r.writeOverlay(&svg, options.Overlay)
+	}
+
 	svg.WriteString("</svg>\n")
 
 	return svg.Bytes(), "image/svg+xml", nil
 }
 
+// writeCrossings writes roads and bridges as round capped lines from each
+// crossing's hex centre toward the neighbours it connects to: a grey border,
+// a dark surface and a dashed yellow centre line, with pillars beside bridges
+func (r *SVGWorldRenderer) writeCrossings(svg *bytes.Buffer, minX, minY int, options *lib.RenderOptions) {
+	width := crossingWidth(options)
+	line := func(segment [2]image.Point, stroke string, width int, extra string) {
+		svg.WriteString(fmt.Sprintf("    <line x1=\"%d\" y1=\"%d\" x2=\"%d\" y2=\"%d\" stroke=\"%s\" stroke-width=\"%d\" stroke-linecap=\"round\"%s/>\n",
+			segment[0].X, segment[0].Y, segment[1].X, segment[1].Y, stroke, width, extra))
+	}
+	svg.WriteString("\n  <!-- Crossings -->\n  <g>\n")
+	for _, coord := range sortedCrossings(options.Crossings) {
+		crossing := options.Crossings[lib.CoordKeyFromAxial(coord)]
+		segments := crossingSegments(coord, crossing, minX, minY, options)
+		if crossing.Type == v1.CrossingType_CROSSING_TYPE_BRIDGE {
+			for _, segment := range segments {
+				for _, p := range bridgePillars(segment, options) {
+					svg.WriteString(fmt.Sprintf("    <circle cx=\"%d\" cy=\"%d\" r=\"%d\" fill=\"%s\"/>\n",
+						p.X, p.Y, scaled(3, options), bridgePillarColor))
+				}
+			}
+		}
+		for _, segment := range segments {
+			line(segment, crossingBorderColor, width+2, "")
+		}
+		for _, segment := range segments {
+			line(segment, crossingSurfaceColor, width, "")
+		}
+		for _, segment := range segments {
+			line(segment, crossingLineColor, scaled(2, options),
+				fmt.Sprintf(" stroke-dasharray=\"%d %d\"", scaled(5, options), scaled(4, options)))
+		}
+	}
+	svg.WriteString("  </g>\n")
+}
+
+// writeUnitState dims exhausted units, flags units capturing their tile and
+// writes health bars
+func (r *SVGWorldRenderer) writeUnitState(svg *bytes.Buffer, units map[string]*v1.Unit, minX, minY int, options *lib.RenderOptions) {
+	capturing := false
+	for _, unit := range units {
+		capturing = capturing || unit.CaptureStartedTurn > 0
+	}
+	if len(options.Exhausted) == 0 && !capturing && !options.ShowHealthBars {
+		return
+	}
+	svg.WriteString("\n  <!-- Unit state -->\n  <g>\n")
+	for _, coord := range options.Exhausted {
+		var points []string
+		for _, p := range hexCorners(coord, minX, minY, options) {
+			points = append(points, fmt.Sprintf("%d,%d", p.X, p.Y))
+		}
+		svg.WriteString(fmt.Sprintf("    <polygon points=\"%s\" fill=\"%s\" fill-opacity=\"%.2f\"/>\n",
+			strings.Join(points, " "), exhaustedColor, exhaustedOpacity))
+	}
+
+	// Units in key order so renders are the same every time
+	keys := make([]string, 0, len(units))
+	for key := range units {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		unit := units[key]
+		if unit.CaptureStartedTurn > 0 {
+			pole, pennant := captureFlag(lib.AxialCoord{Q: int(unit.Q), R: int(unit.R)}, minX, minY, options)
+			fill := "#888888"
+			if colors := r.theme.GetPlayerColor(unit.Player); colors != nil {
+				fill = colors.Primary
+			}
+			svg.WriteString(fmt.Sprintf("    <line x1=\"%d\" y1=\"%d\" x2=\"%d\" y2=\"%d\" stroke=\"%s\" stroke-width=\"%d\"/>\n",
+				pole[0].X, pole[0].Y, pole[1].X, pole[1].Y, flagPoleColor, scaled(2, options)))
+			svg.WriteString(fmt.Sprintf("    <polygon points=\"%d,%d %d,%d %d,%d\" fill=\"%s\" stroke=\"#000000\" stroke-width=\"1\"/>\n",
+				pennant[0].X, pennant[0].Y, pennant[1].X, pennant[1].Y, pennant[2].X, pennant[2].Y, fill))
+		}
+		if options.ShowHealthBars {
+			bar, fill, fillColor := healthBar(unit, minX, minY, options)
+			svg.WriteString(fmt.Sprintf("    <rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n",
+				bar.Min.X, bar.Min.Y, bar.Dx(), bar.Dy(), healthBarBackground))
+			svg.WriteString(fmt.Sprintf("    <rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n",
+				fill.Min.X, fill.Min.Y, fill.Dx(), fill.Dy(), fillColor))
+		}
+	}
+	svg.WriteString("  </g>\n")
+}
+
+// writeHeatmap writes each cell as a hex polygon with an opacity from its
+// weight
+func (r *SVGWorldRenderer) writeHeatmap(svg *bytes.Buffer, minX, minY int, options *lib.RenderOptions) {
+	svg.WriteString("\n  <!-- Heatmap -->\n  <g>\n")
+	for _, cell := range options.Heatmap {
+		fill := threatColor
+		if cell.Player > 0 {
+			fill = "#888888"
+			if colors := r.theme.GetPlayerColor(cell.Player); colors != nil {
+				fill = colors.Primary
+			}
+		}
+		var points []string
+		for _, p := range hexCorners(cell.Coord, minX, minY, options) {
+			points = append(points, fmt.Sprintf("%d,%d", p.X, p.Y))
+		}
+		svg.WriteString(fmt.Sprintf("    <polygon points=\"%s\" fill=\"%s\" fill-opacity=\"%.2f\"/>\n",
+			strings.Join(points, " "), fill, heatmapOpacity*min(max(cell.Weight, 0), 1)))
+	}
+	svg.WriteString("  </g>\n")
+}
+
+// writePaths writes each path as a polyline between hex centres in its
+// player's colour and each flash as a translucent circle
+func (r *SVGWorldRenderer) writePaths(svg *bytes.Buffer, minX, minY int, options *lib.RenderOptions) {
+	svg.WriteString("\n  <!-- Paths -->\n  <g>\n")
+	for _, path := range options.Paths {
+		if len(path.Hexes) == 0 {
+			continue
+		}
+		stroke := "#888888"
+		if colors := r.theme.GetPlayerColor(path.Player); colors != nil {
+			stroke = colors.Primary
+		}
+		var points []string
+		for _, coord := range path.Hexes {
+			p := hexCenter(coord, minX, minY, options)
+			points = append(points, fmt.Sprintf("%d,%d", p.X, p.Y))
+		}
+		end := hexCenter(path.Hexes[len(path.Hexes)-1], minX, minY, options)
+		svg.WriteString(fmt.Sprintf("    <polyline points=\"%s\" fill=\"none\" stroke=\"%s\" stroke-width=\"%d\" stroke-linecap=\"round\" stroke-linejoin=\"round\"/>\n",
+			strings.Join(points, " "), stroke, pathWidth))
+		svg.WriteString(fmt.Sprintf("    <circle cx=\"%d\" cy=\"%d\" r=\"%d\" fill=\"%s\"/>\n", end.X, end.Y, pathWidth*2, stroke))
+	}
+	for _, coord := range options.Flashes {
+		p := hexCenter(coord, minX, minY, options)
+		svg.WriteString(fmt.Sprintf("    <circle cx=\"%d\" cy=\"%d\" r=\"%d\" fill=\"%s\" fill-opacity=\"0.6\"/>\n",
+			p.X, p.Y, int(float64(options.TileWidth)*flashRadius), flashColor))
+	}
+	svg.WriteString("  </g>\n")
+}
+
+// writeOverlay writes the overlay caption and a swatch in each player's
+// colour, outlining the current player's
+func (r *SVGWorldRenderer) writeOverlay(svg *bytes.Buffer, overlay *lib.RenderOverlay) {
+	box, caption, swatches := overlayLayout(overlay)
+	svg.WriteString("\n  <!-- Overlay -->\n  <g>\n")
+	svg.WriteString(fmt.Sprintf("    <rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" rx=\"3\" fill=\"#111827\" fill-opacity=\"0.7\"/>\n",
+		box.Min.X, box.Min.Y, box.Dx(), box.Dy()))
+	var text bytes.Buffer
+	xml.EscapeText(&text, []byte(overlay.Caption))
+	svg.WriteString(fmt.Sprintf("    <text x=\"%d\" y=\"%d\" font-family=\"monospace\" font-size=\"12\" fill=\"#ffffff\">%s</text>\n",
+		caption.X, caption.Y, text.String()))
+	for i, swatch := range swatches {
+		playerId := overlay.Players[i]
+		fill := "#888888"
+		if colors := r.theme.GetPlayerColor(playerId); colors != nil {
+			fill = colors.Primary
+		}
+		stroke := ""
+		if playerId == overlay.CurrentPlayer {
+			stroke = ` stroke="#ffffff" stroke-width="2"`
+		}
+		svg.WriteString(fmt.Sprintf("    <rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"%s/>\n",
+			swatch.Min.X, swatch.Min.Y, swatch.Dx(), swatch.Dy(), fill, stroke))
+	}
+	svg.WriteString("  </g>\n")
+}
+
 // tileSymbolId generates a unique symbol ID for a tile type+player
 func (r *SVGWorldRenderer) tileSymbolId(tileType, player int32) string {
 	effectivePlayer := r.theme.GetEffectivePlayer(tileType, player)
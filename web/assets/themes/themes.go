@@ -55,6 +55,10 @@ type Theme interface {
 	// GetPlayerColor returns the color scheme for a player in this theme.
 	// Returns nil if the player ID is not found.
 	GetPlayerColor(playerId int32) *v1.PlayerColor
+
+	// AssetDir returns the filesystem directory the theme's assets are read
+	// from by server side renderers (the theme pack directory for packs)
+	AssetDir() string
 }
 
 // ThemeAssets interface handles asset loading and rendering
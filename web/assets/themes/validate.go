@@ -0,0 +1,169 @@
+package themes
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	v1 "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+)
+
+// ThemeIssue is something a theme is missing for a set of rules
+type ThemeIssue struct {
+	Kind    string // "unit" or "terrain"
+	Id      int32
+	Name    string // From the rules
+	Message string
+}
+
+func (i ThemeIssue) String() string {
+	return fmt.Sprintf("%s %d (%s): %s", i.Kind, i.Id, i.Name, i.Message)
+}
+
+// ValidateTheme checks a theme has everything needed to draw games with a
+// set of rules: a mapping for every unit and terrain, and their assets on
+// disk in every player's colours.  PNG themes need a file per player (per
+// player in the theme's colours for units and city terrains, player 0 for
+// other terrains); SVG templates need the playerColor gradient recoloured
+// for each player.  Issues are in unit then terrain id order.
+func ValidateTheme(theme Theme, rules *lib.RulesEngine) []ThemeIssue {
+	info := theme.GetThemeInfo()
+	var players []int32
+	if m, ok := theme.(interface{ Manifest() *v1.ThemeManifest }); ok {
+		for player := range m.Manifest().PlayerColors {
+			players = append(players, player)
+		}
+	}
+	sort.Slice(players, func(i, j int) bool { return players[i] < players[j] })
+	cityTerrains := rules.GetCityTerrains()
+
+	var issues []ThemeIssue
+	check := func(kind string, id int32, name, image string, coloured bool) {
+		issue := func(format string, args ...any) {
+			issues = append(issues, ThemeIssue{Kind: kind, Id: id, Name: name, Message: fmt.Sprintf(format, args...)})
+		}
+		if image == "" {
+			issue("no mapping in the theme")
+			return
+		}
+		path := filepath.Join(theme.AssetDir(), image)
+		if info.GetAssetType() == "png" {
+			variants := []int32{0}
+			if coloured {
+				variants = players
+			}
+			var missing []string
+			for _, player := range variants {
+				if _, err := os.Stat(filepath.Join(path, fmt.Sprintf("%d.png", player))); err != nil {
+					missing = append(missing, fmt.Sprint(player))
+				}
+			}
+			if len(missing) > 0 {
+				issue("missing %s/{%s}.png", image, strings.Join(missing, ","))
+			}
+			return
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			issue("missing %s", image)
+			return
+		}
+		if coloured && !strings.Contains(string(content), `id="playerColor"`) {
+			issue("%s has no playerColor gradient to draw player colours with", image)
+		}
+	}
+
+	unitIds := make([]int32, 0, len(rules.Units))
+	for id := range rules.Units {
+		unitIds = append(unitIds, id)
+	}
+	sort.Slice(unitIds, func(i, j int) bool { return unitIds[i] < unitIds[j] })
+	for _, id := range unitIds {
+		check("unit", id, rules.Units[id].Name, theme.GetUnitPath(id), true)
+	}
+
+	terrainIds := make([]int32, 0, len(rules.Terrains))
+	for id := range rules.Terrains {
+		terrainIds = append(terrainIds, id)
+	}
+	sort.Slice(terrainIds, func(i, j int) bool { return terrainIds[i] < terrainIds[j] })
+	for _, id := range terrainIds {
+		check("terrain", id, rules.Terrains[id].Name, theme.GetTilePath(id), cityTerrains[id])
+	}
+	return issues
+}
+
+// ContactSheetColumns is how many hexes wide a contact sheet is
+const ContactSheetColumns = 10
+
+// ContactSheet renders every terrain and unit of a set of rules in a theme
+// on one map for previewing it: the terrains, city terrains in each
+// player's colours, then each player's units on grass.
+// Returns the image and its content type.
+func ContactSheet(theme Theme, rules *lib.RulesEngine, players int) ([]byte, string, error) {
+	renderer, err := CreateWorldRenderer(theme)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var terrainIds, cityIds, unitIds []int32
+	for id := range rules.Terrains {
+		terrainIds = append(terrainIds, id)
+		if rules.GetCityTerrains()[id] {
+			cityIds = append(cityIds, id)
+		}
+	}
+	for id := range rules.Units {
+		unitIds = append(unitIds, id)
+	}
+	for _, ids := range [][]int32{terrainIds, cityIds, unitIds} {
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	}
+	if len(terrainIds) == 0 {
+		return nil, "", fmt.Errorf("rules have no terrains")
+	}
+	// Units stand on grass, or else the first terrain
+	base := terrainIds[0]
+	for _, id := range terrainIds {
+		if rules.Terrains[id].Name == "Grass" {
+			base = id
+			break
+		}
+	}
+
+	tiles := map[string]*v1.Tile{}
+	units := map[string]*v1.Unit{}
+	row := 0
+	// place lays out a run of hexes, wrapping at ContactSheetColumns
+	place := func(count int, at func(i int, coord lib.AxialCoord)) {
+		for i := range count {
+			at(i, lib.RowColToHex(row+i/ContactSheetColumns, i%ContactSheetColumns, false))
+		}
+		row += (count + ContactSheetColumns - 1) / ContactSheetColumns
+	}
+	putTile := func(coord lib.AxialCoord, tileType, player int32) {
+		tiles[lib.CoordKeyFromAxial(coord)] = &v1.Tile{Q: int32(coord.Q), R: int32(coord.R), TileType: tileType, Player: player}
+	}
+
+	place(len(terrainIds), func(i int, coord lib.AxialCoord) { putTile(coord, terrainIds[i], 0) })
+	var playerIds []int32
+	for player := int32(1); player <= int32(players); player++ {
+		playerIds = append(playerIds, player)
+		place(len(cityIds), func(i int, coord lib.AxialCoord) { putTile(coord, cityIds[i], player) })
+	}
+	for _, player := range playerIds {
+		place(len(unitIds), func(i int, coord lib.AxialCoord) {
+			putTile(coord, base, 0)
+			units[lib.CoordKeyFromAxial(coord)] = &v1.Unit{
+				Q: int32(coord.Q), R: int32(coord.R), Player: player, UnitType: unitIds[i],
+			}
+		})
+	}
+
+	options := lib.DefaultRenderOptions()
+	options.Overlay = &lib.RenderOverlay{Caption: theme.GetThemeInfo().GetName(), Players: playerIds}
+	return renderer.Render(tiles, units, options)
+}
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file lilbattle/v1/datastore/models.proto.
  */
 export const file_lilbattle_v1_datastore_models: GenFile = /*@__PURE__*/
-  fileDesc("CiNsaWxiYXR0bGUvdjEvZGF0YXN0b3JlL21vZGVscy5wcm90bxIMbGlsYmF0dGxlLnYxIjIKEkluZGV4SW5mb0RhdGFzdG9yZToc0qYdGCoWbGlsYmF0dGxlLnYxLkluZGV4SW5mbyIoCg1UaWxlRGF0YXN0b3JlOhfSph0TKhFsaWxiYXR0bGUudjEuVGlsZSIwChFDcm9zc2luZ0RhdGFzdG9yZTob0qYdFyoVbGlsYmF0dGxlLnYxLkNyb3NzaW5nInQKDVVuaXREYXRhc3RvcmUSSgoOYXR0YWNrX2hpc3RvcnkYASADKAsyIy5saWxiYXR0bGUudjEuQXR0YWNrUmVjb3JkRGF0YXN0b3JlQg2Sph0Jcgdub2luZGV4OhfSph0TKhFsaWxiYXR0bGUudjEuVW5pdCI4ChVBdHRhY2tSZWNvcmREYXRhc3RvcmU6H9KmHRsqGWxpbGJhdHRsZS52MS5BdHRhY2tSZWNvcmQiqgIKDldvcmxkRGF0YXN0b3JlEhMKAmlkGAEgASgJQgeSph0DcgEtEhsKBHRhZ3MYAiADKAlCDZKmHQlyB25vaW5kZXgSIwoMcHJldmlld191cmxzGAMgAygJQg2Sph0Jcgdub2luZGV4ElQKE2RlZmF1bHRfZ2FtZV9jb25maWcYBCABKAsyKC5saWxiYXR0bGUudjEuR2FtZUNvbmZpZ3VyYXRpb25EYXRhc3RvcmVCDZKmHQlyB25vaW5kZXgSSgoRc2VhcmNoX2luZGV4X2luZm8YBSABKAsyIC5saWxiYXR0bGUudjEuSW5kZXhJbmZvRGF0YXN0b3JlQg2Sph0JcgdmbGF0dGVuOh/Sph0bCgVXb3JsZCoSbGlsYmF0dGxlLnYxLldvcmxkIpAFChJXb3JsZERhdGFEYXRhc3RvcmUSGQoId29ybGRfaWQYASABKAlCB5KmHQNyAS0SUAoJdGlsZXNfbWFwGAIgAygLMi4ubGlsYmF0dGxlLnYxLldvcmxkRGF0YURhdGFzdG9yZS5UaWxlc01hcEVudHJ5Qg2Sph0Jcgdub2luZGV4ElAKCXVuaXRzX21hcBgDIAMoCzIuLmxpbGJhdHRsZS52MS5Xb3JsZERhdGFEYXRhc3RvcmUuVW5pdHNNYXBFbnRyeUINkqYdCXIHbm9pbmRleBJRCgljcm9zc2luZ3MYBCADKAsyLy5saWxiYXR0bGUudjEuV29ybGREYXRhRGF0YXN0b3JlLkNyb3NzaW5nc0VudHJ5Qg2Sph0Jcgdub2luZGV4Ek4KFXNjcmVlbnNob3RfaW5kZXhfaW5mbxgFIAEoCzIgLmxpbGJhdHRsZS52MS5JbmRleEluZm9EYXRhc3RvcmVCDZKmHQlyB2ZsYXR0ZW4aTAoNVGlsZXNNYXBFbnRyeRILCgNrZXkYASABKAkSKgoFdmFsdWUYAiABKAsyGy5saWxiYXR0bGUudjEuVGlsZURhdGFzdG9yZToCOAEaTAoNVW5pdHNNYXBFbnRyeRILCgNrZXkYASABKAkSKgoFdmFsdWUYAiABKAsyGy5saWxiYXR0bGUudjEuVW5pdERhdGFzdG9yZToCOAEaUQoOQ3Jvc3NpbmdzRW50cnkSCwoDa2V5GAEgASgJEi4KBXZhbHVlGAIgASgLMh8ubGlsYmF0dGxlLnYxLkNyb3NzaW5nRGF0YXN0b3JlOgI4ATop0qYdJQoJV29ybGREYXRhKhZsaWxiYXR0bGUudjEuV29ybGREYXRhOAEirAIKDUdhbWVEYXRhc3RvcmUSEwoCaWQYASABKAlCB5KmHQNyAS0SEAoId29ybGRfaWQYAiABKAkSGwoEdGFncxgDIAMoCUINkqYdCXIHbm9pbmRleBIjCgxwcmV2aWV3X3VybHMYBCADKAlCDZKmHQlyB25vaW5kZXgSRwoGY29uZmlnGAUgASgLMigubGlsYmF0dGxlLnYxLkdhbWVDb25maWd1cmF0aW9uRGF0YXN0b3JlQg2Sph0Jcgdub2luZGV4EkoKEXNlYXJjaF9pbmRleF9pbmZvGAYgASgLMiAubGlsYmF0dGxlLnYxLkluZGV4SW5mb0RhdGFzdG9yZUINkqYdCXIHZmxhdHRlbjod0qYdGQoER2FtZSoRbGlsYmF0dGxlLnYxLkdhbWUi0QIKEkdhbWVTdGF0ZURhdGFzdG9yZRIYCgdnYW1lX2lkGAEgASgJQgeSph0DcgEtEkMKCndvcmxkX2RhdGEYAiABKAsyIC5saWxiYXR0bGUudjEuV29ybGREYXRhRGF0YXN0b3JlQg2Sph0Jcgdub2luZGV4ElgKDXBsYXllcl9zdGF0ZXMYAyADKAsyMi5saWxiYXR0bGUudjEuR2FtZVN0YXRlRGF0YXN0b3JlLlBsYXllclN0YXRlc0VudHJ5Qg2Sph0Jcgdub2luZGV4GlcKEVBsYXllclN0YXRlc0VudHJ5EgsKA2tleRgBIAEoBRIxCgV2YWx1ZRgCIAEoCzIiLmxpbGJhdHRsZS52MS5QbGF5ZXJTdGF0ZURhdGFzdG9yZToCOAE6KdKmHSUKCUdhbWVTdGF0ZSoWbGlsYmF0dGxlLnYxLkdhbWVTdGF0ZTgBIrgCChpHYW1lQ29uZmlndXJhdGlvbkRhdGFzdG9yZRJBCgdwbGF5ZXJzGAEgAygLMiEubGlsYmF0dGxlLnYxLkdhbWVQbGF5ZXJEYXRhc3RvcmVCDZKmHQlyB25vaW5kZXgSPQoFdGVhbXMYAiADKAsyHy5saWxiYXR0bGUudjEuR2FtZVRlYW1EYXRhc3RvcmVCDZKmHQlyB25vaW5kZXgSOwoOaW5jb21lX2NvbmZpZ3MYAyABKAsyIy5saWxiYXR0bGUudjEuSW5jb21lQ29uZmlnRGF0YXN0b3JlEjUKCHNldHRpbmdzGAQgASgLMiMubGlsYmF0dGxlLnYxLkdhbWVTZXR0aW5nc0RhdGFzdG9yZTok0qYdICoebGlsYmF0dGxlLnYxLkdhbWVDb25maWd1cmF0aW9uIjgKFUluY29tZUNvbmZpZ0RhdGFzdG9yZTof0qYdGyoZbGlsYmF0dGxlLnYxLkluY29tZUNvbmZpZyI0ChNHYW1lUGxheWVyRGF0YXN0b3JlOh3Sph0ZKhdsaWxiYXR0bGUudjEuR2FtZVBsYXllciIwChFHYW1lVGVhbURhdGFzdG9yZTob0qYdFyoVbGlsYmF0dGxlLnYxLkdhbWVUZWFtIl4KFUdhbWVTZXR0aW5nc0RhdGFzdG9yZRIkCg1hbGxvd2VkX3VuaXRzGAEgAygFQg2Sph0Jcgdub2luZGV4Oh/Sph0bKhlsaWxiYXR0bGUudjEuR2FtZVNldHRpbmdzIjYKFFBsYXllclN0YXRlRGF0YXN0b3JlOh7Sph0aKhhsaWxiYXR0bGUudjEuUGxheWVyU3RhdGUi5AEKEUdhbWVNb3ZlRGF0YXN0b3JlEg8KB2dhbWVfaWQYASABKAkSFAoMZ3JvdXBfbnVtYmVyGAIgASgDEhMKC21vdmVfbnVtYmVyGAMgASgDEjYKCW1vdmVfdHlwZRgEIAEoCzIULmdvb2dsZS5wcm90b2J1Zi5BbnlCDZKmHQlyB25vaW5kZXgSNAoHY2hhbmdlcxgFIAMoCzIULmdvb2dsZS5wcm90b2J1Zi5BbnlCDZKmHQlyB25vaW5kZXg6JdKmHSEKCEdhbWVNb3ZlKhVsaWxiYXR0bGUudjEuR2FtZU1vdmVCugEKEGNvbS5saWxiYXR0bGUudjFCC01vZGVsc1Byb3RvUAFaSGdpdGh1Yi5jb20vdHVybmZvcmdlL2xpbGJhdHRsZS9nZW4vZ28vbGlsYmF0dGxlL3YxL2RhdGFzdG9yZTtsaWxiYXR0bGV2MaICA0xYWKoCDExpbGJhdHRsZS5WMcoCDExpbGJhdHRsZVxWMeICGExpbGJhdHRsZVxWMVxHUEJNZXRhZGF0YeoCDUxpbGJhdHRsZTo6VjFiBnByb3RvMw", [file_dal_v1_annotations, file_lilbattle_v1_models_models, file_google_protobuf_any, file_google_protobuf_struct]);
+  fileDesc("CiNsaWxiYXR0bGUvdjEvZGF0YXN0b3JlL21vZGVscy5wcm90bxIMbGlsYmF0dGxlLnYxIjIKEkluZGV4SW5mb0RhdGFzdG9yZToc0qYdGCoWbGlsYmF0dGxlLnYxLkluZGV4SW5mbyIoCg1UaWxlRGF0YXN0b3JlOhfSph0TKhFsaWxiYXR0bGUudjEuVGlsZSIwChFDcm9zc2luZ0RhdGFzdG9yZTob0qYdFyoVbGlsYmF0dGxlLnYxLkNyb3NzaW5nInQKDVVuaXREYXRhc3RvcmUSSgoOYXR0YWNrX2hpc3RvcnkYASADKAsyIy5saWxiYXR0bGUudjEuQXR0YWNrUmVjb3JkRGF0YXN0b3JlQg2Sph0Jcgdub2luZGV4OhfSph0TKhFsaWxiYXR0bGUudjEuVW5pdCI4ChVBdHRhY2tSZWNvcmREYXRhc3RvcmU6H9KmHRsqGWxpbGJhdHRsZS52MS5BdHRhY2tSZWNvcmQiqgIKDldvcmxkRGF0YXN0b3JlEhMKAmlkGAEgASgJQgeSph0DcgEtEhsKBHRhZ3MYAiADKAlCDZKmHQlyB25vaW5kZXgSIwoMcHJldmlld191cmxzGAMgAygJQg2Sph0Jcgdub2luZGV4ElQKE2RlZmF1bHRfZ2FtZV9jb25maWcYBCABKAsyKC5saWxiYXR0bGUudjEuR2FtZUNvbmZpZ3VyYXRpb25EYXRhc3RvcmVCDZKmHQlyB25vaW5kZXgSSgoRc2VhcmNoX2luZGV4X2luZm8YBSABKAsyIC5saWxiYXR0bGUudjEuSW5kZXhJbmZvRGF0YXN0b3JlQg2Sph0JcgdmbGF0dGVuOh/Sph0bCgVXb3JsZCoSbGlsYmF0dGxlLnYxLldvcmxkIpAFChJXb3JsZERhdGFEYXRhc3RvcmUSGQoId29ybGRfaWQYASABKAlCB5KmHQNyAS0SUAoJdGlsZXNfbWFwGAIgAygLMi4ubGlsYmF0dGxlLnYxLldvcmxkRGF0YURhdGFzdG9yZS5UaWxlc01hcEVudHJ5Qg2Sph0Jcgdub2luZGV4ElAKCXVuaXRzX21hcBgDIAMoCzIuLmxpbGJhdHRsZS52MS5Xb3JsZERhdGFEYXRhc3RvcmUuVW5pdHNNYXBFbnRyeUINkqYdCXIHbm9pbmRleBJRCgljcm9zc2luZ3MYBCADKAsyLy5saWxiYXR0bGUudjEuV29ybGREYXRhRGF0YXN0b3JlLkNyb3NzaW5nc0VudHJ5Qg2Sph0Jcgdub2luZGV4Ek4KFXNjcmVlbnNob3RfaW5kZXhfaW5mbxgFIAEoCzIgLmxpbGJhdHRsZS52MS5JbmRleEluZm9EYXRhc3RvcmVCDZKmHQlyB2ZsYXR0ZW4aTAoNVGlsZXNNYXBFbnRyeRILCgNrZXkYASABKAkSKgoFdmFsdWUYAiABKAsyGy5saWxiYXR0bGUudjEuVGlsZURhdGFzdG9yZToCOAEaTAoNVW5pdHNNYXBFbnRyeRILCgNrZXkYASABKAkSKgoFdmFsdWUYAiABKAsyGy5saWxiYXR0bGUudjEuVW5pdERhdGFzdG9yZToCOAEaUQoOQ3Jvc3NpbmdzRW50cnkSCwoDa2V5GAEgASgJEi4KBXZhbHVlGAIgASgLMh8ubGlsYmF0dGxlLnYxLkNyb3NzaW5nRGF0YXN0b3JlOgI4ATop0qYdJQoJV29ybGREYXRhKhZsaWxiYXR0bGUudjEuV29ybGREYXRhOAEirAIKDUdhbWVEYXRhc3RvcmUSEwoCaWQYASABKAlCB5KmHQNyAS0SEAoId29ybGRfaWQYAiABKAkSGwoEdGFncxgDIAMoCUINkqYdCXIHbm9pbmRleBIjCgxwcmV2aWV3X3VybHMYBCADKAlCDZKmHQlyB25vaW5kZXgSRwoGY29uZmlnGAUgASgLMigubGlsYmF0dGxlLnYxLkdhbWVDb25maWd1cmF0aW9uRGF0YXN0b3JlQg2Sph0Jcgdub2luZGV4EkoKEXNlYXJjaF9pbmRleF9pbmZvGAYgASgLMiAubGlsYmF0dGxlLnYxLkluZGV4SW5mb0RhdGFzdG9yZUINkqYdCXIHZmxhdHRlbjod0qYdGQoER2FtZSoRbGlsYmF0dGxlLnYxLkdhbWUiiAQKEkdhbWVTdGF0ZURhdGFzdG9yZRIYCgdnYW1lX2lkGAEgASgJQgeSph0DcgEtEkMKCndvcmxkX2RhdGEYAiABKAsyIC5saWxiYXR0bGUudjEuV29ybGREYXRhRGF0YXN0b3JlQg2Sph0Jcgdub2luZGV4ElgKDXBsYXllcl9zdGF0ZXMYAyADKAsyMi5saWxiYXR0bGUudjEuR2FtZVN0YXRlRGF0YXN0b3JlLlBsYXllclN0YXRlc0VudHJ5Qg2Sph0Jcgdub2luZGV4EloKDnBlbmRpbmdfb3JkZXJzGAQgAygLMjMubGlsYmF0dGxlLnYxLkdhbWVTdGF0ZURhdGFzdG9yZS5QZW5kaW5nT3JkZXJzRW50cnlCDZKmHQlyB25vaW5kZXgaVwoRUGxheWVyU3RhdGVzRW50cnkSCwoDa2V5GAEgASgFEjEKBXZhbHVlGAIgASgLMiIubGlsYmF0dGxlLnYxLlBsYXllclN0YXRlRGF0YXN0b3JlOgI4ARpZChJQZW5kaW5nT3JkZXJzRW50cnkSCwoDa2V5GAEgASgFEjIKBXZhbHVlGAIgASgLMiMubGlsYmF0dGxlLnYxLlBsYXllck9yZGVyc0RhdGFzdG9yZToCOAE6KdKmHSUKCUdhbWVTdGF0ZSoWbGlsYmF0dGxlLnYxLkdhbWVTdGF0ZTgBIrgCChpHYW1lQ29uZmlndXJhdGlvbkRhdGFzdG9yZRJBCgdwbGF5ZXJzGAEgAygLMiEubGlsYmF0dGxlLnYxLkdhbWVQbGF5ZXJEYXRhc3RvcmVCDZKmHQlyB25vaW5kZXgSPQoFdGVhbXMYAiADKAsyHy5saWxiYXR0bGUudjEuR2FtZVRlYW1EYXRhc3RvcmVCDZKmHQlyB25vaW5kZXgSOwoOaW5jb21lX2NvbmZpZ3MYAyABKAsyIy5saWxiYXR0bGUudjEuSW5jb21lQ29uZmlnRGF0YXN0b3JlEjUKCHNldHRpbmdzGAQgASgLMiMubGlsYmF0dGxlLnYxLkdhbWVTZXR0aW5nc0RhdGFzdG9yZTok0qYdICoebGlsYmF0dGxlLnYxLkdhbWVDb25maWd1cmF0aW9uIjgKFUluY29tZUNvbmZpZ0RhdGFzdG9yZTof0qYdGyoZbGlsYmF0dGxlLnYxLkluY29tZUNvbmZpZyI0ChNHYW1lUGxheWVyRGF0YXN0b3JlOh3Sph0ZKhdsaWxiYXR0bGUudjEuR2FtZVBsYXllciIwChFHYW1lVGVhbURhdGFzdG9yZTob0qYdFyoVbGlsYmF0dGxlLnYxLkdhbWVUZWFtIl4KFUdhbWVTZXR0aW5nc0RhdGFzdG9yZRIkCg1hbGxvd2VkX3VuaXRzGAEgAygFQg2Sph0Jcgdub2luZGV4Oh/Sph0bKhlsaWxiYXR0bGUudjEuR2FtZVNldHRpbmdzIjYKFFBsYXllclN0YXRlRGF0YXN0b3JlOh7Sph0aKhhsaWxiYXR0bGUudjEuUGxheWVyU3RhdGUi5AEKEUdhbWVNb3ZlRGF0YXN0b3JlEg8KB2dhbWVfaWQYASABKAkSFAoMZ3JvdXBfbnVtYmVyGAIgASgDEhMKC21vdmVfbnVtYmVyGAMgASgDEjYKCW1vdmVfdHlwZRgEIAEoCzIULmdvb2dsZS5wcm90b2J1Zi5BbnlCDZKmHQlyB25vaW5kZXgSNAoHY2hhbmdlcxgFIAMoCzIULmdvb2dsZS5wcm90b2J1Zi5BbnlCDZKmHQlyB25vaW5kZXg6JdKmHSEKCEdhbWVNb3ZlKhVsaWxiYXR0bGUudjEuR2FtZU1vdmUidwoVUGxheWVyT3JkZXJzRGF0YXN0b3JlEj0KBW1vdmVzGAUgAygLMh8ubGlsYmF0dGxlLnYxLkdhbWVNb3ZlRGF0YXN0b3JlQg2Sph0Jcgdub2luZGV4Oh/Sph0bKhlsaWxiYXR0bGUudjEuUGxheWVyT3JkZXJzIn8KEVVzZXJHYW1lRGF0YXN0b3JlEg8KB3VzZXJfaWQYASABKAkSDwoHZ2FtZV9pZBgCIAEoCRIhCgpwbGF5ZXJfaWRzGAMgAygFQg2Sph0Jcgdub2luZGV4OiXSph0hCghVc2VyR2FtZSoVbGlsYmF0dGxlLnYxLlVzZXJHYW1lQroBChBjb20ubGlsYmF0dGxlLnYxQgtNb2RlbHNQcm90b1ABWkhnaXRodWIuY29tL3R1cm5mb3JnZS9saWxiYXR0bGUvZ2VuL2dvL2xpbGJhdHRsZS92MS9kYXRhc3RvcmU7bGlsYmF0dGxldjGiAgNMWFiqAgxMaWxiYXR0bGUuVjHKAgxMaWxiYXR0bGVcVjHiAhhMaWxiYXR0bGVcVjFcR1BCTWV0YWRhdGHqAg1MaWxiYXR0bGU6OlYxYgZwcm90bzM", [file_dal_v1_annotations, file_lilbattle_v1_models_models, file_google_protobuf_any, file_google_protobuf_struct]);
 
 /**
  * @generated from message lilbattle.v1.IndexInfoDatastore
@@ -265,6 +265,13 @@ export type GameStateDatastore = Message<"lilbattle.v1.GameStateDatastore"> & {
    * @generated from field: map<int32, lilbattle.v1.PlayerStateDatastore> player_states = 3;
    */
   playerStates: { [key: number]: PlayerStateDatastore };
+
+  /**
+   * Pending simultaneous turn orders - noindex
+   *
+   * @generated from field: map<int32, lilbattle.v1.PlayerOrdersDatastore> pending_orders = 4;
+   */
+  pendingOrders: { [key: number]: PlayerOrdersDatastore };
 };
 
 /**
@@ -436,3 +443,53 @@ export type GameMoveDatastore = Message<"lilbattle.v1.GameMoveDatastore"> & {
 export const GameMoveDatastoreSchema: GenMessage<GameMoveDatastore> = /*@__PURE__*/
   messageDesc(file_lilbattle_v1_datastore_models, 15);
 
+/**
+ * @generated from message lilbattle.v1.PlayerOrdersDatastore
+ */
+export type PlayerOrdersDatastore = Message<"lilbattle.v1.PlayerOrdersDatastore"> & {
+  /**
+   * Moves as noindex nested array
+   *
+   * @generated from field: repeated lilbattle.v1.GameMoveDatastore moves = 5;
+   */
+  moves: GameMoveDatastore[];
+};
+
+/**
+ * Describes the message lilbattle.v1.PlayerOrdersDatastore.
+ * Use `create(PlayerOrdersDatastoreSchema)` to create a new message.
+ */
+export const PlayerOrdersDatastoreSchema: GenMessage<PlayerOrdersDatastore> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_datastore_models, 16);
+
+/**
+ * UserGameDatastore is the per-user game index.  Keyed by "<user_id>/<game_id>".
+ *
+ * @generated from message lilbattle.v1.UserGameDatastore
+ */
+export type UserGameDatastore = Message<"lilbattle.v1.UserGameDatastore"> & {
+  /**
+   * Indexed for listing a user's games and replacing a game's entries
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string game_id = 2;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: repeated int32 player_ids = 3;
+   */
+  playerIds: number[];
+};
+
+/**
+ * Describes the message lilbattle.v1.UserGameDatastore.
+ * Use `create(UserGameDatastoreSchema)` to create a new message.
+ */
+export const UserGameDatastoreSchema: GenMessage<UserGameDatastore> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_datastore_models, 17);
+
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file lilbattle/v1/gorm/models.proto.
  */
 export const file_lilbattle_v1_gorm_models: GenFile = /*@__PURE__*/
-  fileDesc("Ch5saWxiYXR0bGUvdjEvZ29ybS9tb2RlbHMucHJvdG8SDGxpbGJhdHRsZS52MSItCg1JbmRleEluZm9HT1JNOhzKph0YChZsaWxiYXR0bGUudjEuSW5kZXhJbmZvIiUKCFRpbGVHT1JNOhnKph0VChFsaWxiYXR0bGUudjEuVGlsZSABIi0KDENyb3NzaW5nR09STTodyqYdGQoVbGlsYmF0dGxlLnYxLkNyb3NzaW5nIAEiJQoIVW5pdEdPUk06GcqmHRUKEWxpbGJhdHRsZS52MS5Vbml0IAEiNQoQQXR0YWNrUmVjb3JkR09STTohyqYdHQoZbGlsYmF0dGxlLnYxLkF0dGFja1JlY29yZCABIoMCCglXb3JsZEdPUk0SHAoCaWQYASABKAlCEJKmHQxSCnByaW1hcnlLZXkSIwoEdGFncxgHIAMoCUIVkqYdEVIPc2VyaWFsaXplcjpqc29uEisKDHByZXZpZXdfdXJscxgLIAMoCUIVkqYdEVIPc2VyaWFsaXplcjpqc29uEmQKEXNlYXJjaF9pbmRleF9pbmZvGA0gASgLMhsubGlsYmF0dGxlLnYxLkluZGV4SW5mb0dPUk1CLJKmHShSCGVtYmVkZGVkUhxlbWJlZGRlZFByZWZpeDpzZWFyY2hfaW5kZXhfOiDKph0cChJsaWxiYXR0bGUudjEuV29ybGQSBndvcmxkcyKtBQoNV29ybGREYXRhR09STRIiCgh3b3JsZF9pZBgBIAEoCUIQkqYdDFIKcHJpbWFyeUtleRJUCgljcm9zc2luZ3MYBCADKAsyKi5saWxiYXR0bGUudjEuV29ybGREYXRhR09STS5Dcm9zc2luZ3NFbnRyeUIVkqYdEVIPc2VyaWFsaXplcjpqc29uEmwKFXNjcmVlbnNob3RfaW5kZXhfaW5mbxgFIAEoCzIbLmxpbGJhdHRsZS52MS5JbmRleEluZm9HT1JNQjCSph0sUghlbWJlZGRlZFIgZW1iZWRkZWRQcmVmaXg6c2NyZWVuc2hvdF9pbmRleF8SUwoJdGlsZXNfbWFwGAYgAygLMikubGlsYmF0dGxlLnYxLldvcmxkRGF0YUdPUk0uVGlsZXNNYXBFbnRyeUIVkqYdEVIPc2VyaWFsaXplcjpqc29uElMKCXVuaXRzX21hcBgHIAMoCzIpLmxpbGJhdHRsZS52MS5Xb3JsZERhdGFHT1JNLlVuaXRzTWFwRW50cnlCFZKmHRFSD3NlcmlhbGl6ZXI6anNvbhpMCg5Dcm9zc2luZ3NFbnRyeRILCgNrZXkYASABKAkSKQoFdmFsdWUYAiABKAsyGi5saWxiYXR0bGUudjEuQ3Jvc3NpbmdHT1JNOgI4ARpHCg1UaWxlc01hcEVudHJ5EgsKA2tleRgBIAEoCRIlCgV2YWx1ZRgCIAEoCzIWLmxpbGJhdHRsZS52MS5UaWxlR09STToCOAEaRwoNVW5pdHNNYXBFbnRyeRILCgNrZXkYASABKAkSJQoFdmFsdWUYAiABKAsyFi5saWxiYXR0bGUudjEuVW5pdEdPUk06AjgBOirKph0mChZsaWxiYXR0bGUudjEuV29ybGREYXRhEgp3b3JsZF9kYXRhIAEisgIKCEdhbWVHT1JNEhwKAmlkGAEgASgJQhCSph0MUgpwcmltYXJ5S2V5EjAKCHdvcmxkX2lkGAMgASgJQh6Sph0aUhhpbmRleDppZHhfZ2FtZXNfd29ybGRfaWQSIwoEdGFncxgHIAMoCUIVkqYdEVIPc2VyaWFsaXplcjpqc29uEisKDHByZXZpZXdfdXJscxgLIAMoCUIVkqYdEVIPc2VyaWFsaXplcjpqc29uEmQKEXNlYXJjaF9pbmRleF9pbmZvGA0gASgLMhsubGlsYmF0dGxlLnYxLkluZGV4SW5mb0dPUk1CLJKmHShSCGVtYmVkZGVkUhxlbWJlZGRlZFByZWZpeDpzZWFyY2hfaW5kZXhfOh7Kph0aChFsaWxiYXR0bGUudjEuR2FtZRIFZ2FtZXMi7gIKDUdhbWVTdGF0ZUdPUk0SIQoHZ2FtZV9pZBgBIAEoCUIQkqYdDFIKcHJpbWFyeUtleRJfCgp3b3JsZF9kYXRhGAQgASgLMh8ubGlsYmF0dGxlLnYxLkdhbWVXb3JsZERhdGFHT1JNQiqSph0mUghlbWJlZGRlZFIaZW1iZWRkZWRQcmVmaXg6d29ybGRfZGF0YV8SWwoNcGxheWVyX3N0YXRlcxgFIAMoCzItLmxpbGJhdHRsZS52MS5HYW1lU3RhdGVHT1JNLlBsYXllclN0YXRlc0VudHJ5QhWSph0RUg9zZXJpYWxpemVyOmpzb24aUgoRUGxheWVyU3RhdGVzRW50cnkSCwoDa2V5GAEgASgFEiwKBXZhbHVlGAIgASgLMh0ubGlsYmF0dGxlLnYxLlBsYXllclN0YXRlR09STToCOAE6KMqmHSQKFmxpbGJhdHRsZS52MS5HYW1lU3RhdGUSCmdhbWVfc3RhdGUiuQEKFUdhbWVDb25maWd1cmF0aW9uR09STRJGCg5pbmNvbWVfY29uZmlncxgDIAEoCzIeLmxpbGJhdHRsZS52MS5JbmNvbWVDb25maWdHT1JNQg6Sph0KUghlbWJlZGRlZBIwCghzZXR0aW5ncxgEIAEoCzIeLmxpbGJhdHRsZS52MS5HYW1lU2V0dGluZ3NHT1JNOibKph0iCh5saWxiYXR0bGUudjEuR2FtZUNvbmZpZ3VyYXRpb24gASI1ChBJbmNvbWVDb25maWdHT1JNOiHKph0dChlsaWxiYXR0bGUudjEuSW5jb21lQ29uZmlnIAEiMQoOR2FtZVBsYXllckdPUk06H8qmHRsKF2xpbGJhdHRsZS52MS5HYW1lUGxheWVyIAEiLQoMR2FtZVRlYW1HT1JNOh3Kph0ZChVsaWxiYXR0bGUudjEuR2FtZVRlYW0gASJhChBHYW1lU2V0dGluZ3NHT1JNEiwKDWFsbG93ZWRfdW5pdHMYASADKAVCFZKmHRFSD3NlcmlhbGl6ZXI6anNvbjofyqYdGwoZbGlsYmF0dGxlLnYxLkdhbWVTZXR0aW5ncyIzCg9QbGF5ZXJTdGF0ZUdPUk06IMqmHRwKGGxpbGJhdHRsZS52MS5QbGF5ZXJTdGF0ZSABIosFChFHYW1lV29ybGREYXRhR09STRJsChVzY3JlZW5zaG90X2luZGV4X2luZm8YBCABKAsyGy5saWxiYXR0bGUudjEuSW5kZXhJbmZvR09STUIwkqYdLFIIZW1iZWRkZWRSIGVtYmVkZGVkUHJlZml4OnNjcmVlbnNob3RfaW5kZXhfElgKCWNyb3NzaW5ncxgFIAMoCzIuLmxpbGJhdHRsZS52MS5HYW1lV29ybGREYXRhR09STS5Dcm9zc2luZ3NFbnRyeUIVkqYdEVIPc2VyaWFsaXplcjpqc29uElcKCXRpbGVzX21hcBgGIAMoCzItLmxpbGJhdHRsZS52MS5HYW1lV29ybGREYXRhR09STS5UaWxlc01hcEVudHJ5QhWSph0RUg9zZXJpYWxpemVyOmpzb24SVwoJdW5pdHNfbWFwGAcgAygLMi0ubGlsYmF0dGxlLnYxLkdhbWVXb3JsZERhdGFHT1JNLlVuaXRzTWFwRW50cnlCFZKmHRFSD3NlcmlhbGl6ZXI6anNvbhpMCg5Dcm9zc2luZ3NFbnRyeRILCgNrZXkYASABKAkSKQoFdmFsdWUYAiABKAsyGi5saWxiYXR0bGUudjEuQ3Jvc3NpbmdHT1JNOgI4ARpHCg1UaWxlc01hcEVudHJ5EgsKA2tleRgBIAEoCRIlCgV2YWx1ZRgCIAEoCzIWLmxpbGJhdHRsZS52MS5UaWxlR09STToCOAEaRwoNVW5pdHNNYXBFbnRyeRILCgNrZXkYASABKAkSJQoFdmFsdWUYAiABKAsyFi5saWxiYXR0bGUudjEuVW5pdEdPUk06AjgBOhzKph0YChZsaWxiYXR0bGUudjEuV29ybGREYXRhIjkKE0dhbWVNb3ZlSGlzdG9yeUdPUk06IsqmHR4KHGxpbGJhdHRsZS52MS5HYW1lTW92ZUhpc3RvcnkiNQoRR2FtZU1vdmVHcm91cEdPUk06IMqmHRwKGmxpbGJhdHRsZS52MS5HYW1lTW92ZUdyb3VwIqYDCgxHYW1lTW92ZUdPUk0SZwoHZ2FtZV9pZBgBIAEoCUJWkqYdUlIKcHJpbWFyeUtleVIcaW5kZXg6aWR4X2dhbWVfbW92ZXNfZ2FtZV9pZFImaW5kZXg6aWR4X2dhbWVfbW92ZXNfbG9va3VwLHByaW9yaXR5OjESTgoMZ3JvdXBfbnVtYmVyGAIgASgDQjiSph00UgpwcmltYXJ5S2V5UiZpbmRleDppZHhfZ2FtZV9tb3Zlc19sb29rdXAscHJpb3JpdHk6MhIlCgttb3ZlX251bWJlchgDIAEoA0IQkqYdDFIKcHJpbWFyeUtleRIPCgd2ZXJzaW9uGAQgASgDEj4KCW1vdmVfdHlwZRgFIAEoCzIULmdvb2dsZS5wcm90b2J1Zi5BbnlCFZKmHRFSD3NlcmlhbGl6ZXI6anNvbhI8CgdjaGFuZ2VzGAYgAygLMhQuZ29vZ2xlLnByb3RvYnVmLkFueUIVkqYdEVIPc2VyaWFsaXplcjpqc29uOifKph0jChVsaWxiYXR0bGUudjEuR2FtZU1vdmUSCmdhbWVfbW92ZXNCtQEKEGNvbS5saWxiYXR0bGUudjFCC01vZGVsc1Byb3RvUAFaQ2dpdGh1Yi5jb20vdHVybmZvcmdlL2xpbGJhdHRsZS9nZW4vZ28vbGlsYmF0dGxlL3YxL2dvcm07bGlsYmF0dGxldjGiAgNMWFiqAgxMaWxiYXR0bGUuVjHKAgxMaWxiYXR0bGVcVjHiAhhMaWxiYXR0bGVcVjFcR1BCTWV0YWRhdGHqAg1MaWxiYXR0bGU6OlYxYgZwcm90bzM", [file_dal_v1_annotations, file_lilbattle_v1_models_models, file_google_protobuf_any, file_google_protobuf_struct]);
+  fileDesc("Ch5saWxiYXR0bGUvdjEvZ29ybS9tb2RlbHMucHJvdG8SDGxpbGJhdHRsZS52MSItCg1JbmRleEluZm9HT1JNOhzKph0YChZsaWxiYXR0bGUudjEuSW5kZXhJbmZvIiUKCFRpbGVHT1JNOhnKph0VChFsaWxiYXR0bGUudjEuVGlsZSABIi0KDENyb3NzaW5nR09STTodyqYdGQoVbGlsYmF0dGxlLnYxLkNyb3NzaW5nIAEiJQoIVW5pdEdPUk06GcqmHRUKEWxpbGJhdHRsZS52MS5Vbml0IAEiNQoQQXR0YWNrUmVjb3JkR09STTohyqYdHQoZbGlsYmF0dGxlLnYxLkF0dGFja1JlY29yZCABIoMCCglXb3JsZEdPUk0SHAoCaWQYASABKAlCEJKmHQxSCnByaW1hcnlLZXkSIwoEdGFncxgHIAMoCUIVkqYdEVIPc2VyaWFsaXplcjpqc29uEisKDHByZXZpZXdfdXJscxgLIAMoCUIVkqYdEVIPc2VyaWFsaXplcjpqc29uEmQKEXNlYXJjaF9pbmRleF9pbmZvGA0gASgLMhsubGlsYmF0dGxlLnYxLkluZGV4SW5mb0dPUk1CLJKmHShSCGVtYmVkZGVkUhxlbWJlZGRlZFByZWZpeDpzZWFyY2hfaW5kZXhfOiDKph0cChJsaWxiYXR0bGUudjEuV29ybGQSBndvcmxkcyKtBQoNV29ybGREYXRhR09STRIiCgh3b3JsZF9pZBgBIAEoCUIQkqYdDFIKcHJpbWFyeUtleRJUCgljcm9zc2luZ3MYBCADKAsyKi5saWxiYXR0bGUudjEuV29ybGREYXRhR09STS5Dcm9zc2luZ3NFbnRyeUIVkqYdEVIPc2VyaWFsaXplcjpqc29uEmwKFXNjcmVlbnNob3RfaW5kZXhfaW5mbxgFIAEoCzIbLmxpbGJhdHRsZS52MS5JbmRleEluZm9HT1JNQjCSph0sUghlbWJlZGRlZFIgZW1iZWRkZWRQcmVmaXg6c2NyZWVuc2hvdF9pbmRleF8SUwoJdGlsZXNfbWFwGAYgAygLMikubGlsYmF0dGxlLnYxLldvcmxkRGF0YUdPUk0uVGlsZXNNYXBFbnRyeUIVkqYdEVIPc2VyaWFsaXplcjpqc29uElMKCXVuaXRzX21hcBgHIAMoCzIpLmxpbGJhdHRsZS52MS5Xb3JsZERhdGFHT1JNLlVuaXRzTWFwRW50cnlCFZKmHRFSD3NlcmlhbGl6ZXI6anNvbhpMCg5Dcm9zc2luZ3NFbnRyeRILCgNrZXkYASABKAkSKQoFdmFsdWUYAiABKAsyGi5saWxiYXR0bGUudjEuQ3Jvc3NpbmdHT1JNOgI4ARpHCg1UaWxlc01hcEVudHJ5EgsKA2tleRgBIAEoCRIlCgV2YWx1ZRgCIAEoCzIWLmxpbGJhdHRsZS52MS5UaWxlR09STToCOAEaRwoNVW5pdHNNYXBFbnRyeRILCgNrZXkYASABKAkSJQoFdmFsdWUYAiABKAsyFi5saWxiYXR0bGUudjEuVW5pdEdPUk06AjgBOirKph0mChZsaWxiYXR0bGUudjEuV29ybGREYXRhEgp3b3JsZF9kYXRhIAEisgIKCEdhbWVHT1JNEhwKAmlkGAEgASgJQhCSph0MUgpwcmltYXJ5S2V5EjAKCHdvcmxkX2lkGAMgASgJQh6Sph0aUhhpbmRleDppZHhfZ2FtZXNfd29ybGRfaWQSIwoEdGFncxgHIAMoCUIVkqYdEVIPc2VyaWFsaXplcjpqc29uEisKDHByZXZpZXdfdXJscxgLIAMoCUIVkqYdEVIPc2VyaWFsaXplcjpqc29uEmQKEXNlYXJjaF9pbmRleF9pbmZvGA0gASgLMhsubGlsYmF0dGxlLnYxLkluZGV4SW5mb0dPUk1CLJKmHShSCGVtYmVkZGVkUhxlbWJlZGRlZFByZWZpeDpzZWFyY2hfaW5kZXhfOh7Kph0aChFsaWxiYXR0bGUudjEuR2FtZRIFZ2FtZXMiowQKDUdhbWVTdGF0ZUdPUk0SIQoHZ2FtZV9pZBgBIAEoCUIQkqYdDFIKcHJpbWFyeUtleRJfCgp3b3JsZF9kYXRhGAQgASgLMh8ubGlsYmF0dGxlLnYxLkdhbWVXb3JsZERhdGFHT1JNQiqSph0mUghlbWJlZGRlZFIaZW1iZWRkZWRQcmVmaXg6d29ybGRfZGF0YV8SWwoNcGxheWVyX3N0YXRlcxgFIAMoCzItLmxpbGJhdHRsZS52MS5HYW1lU3RhdGVHT1JNLlBsYXllclN0YXRlc0VudHJ5QhWSph0RUg9zZXJpYWxpemVyOmpzb24SXQoOcGVuZGluZ19vcmRlcnMYBiADKAsyLi5saWxiYXR0bGUudjEuR2FtZVN0YXRlR09STS5QZW5kaW5nT3JkZXJzRW50cnlCFZKmHRFSD3NlcmlhbGl6ZXI6anNvbhpSChFQbGF5ZXJTdGF0ZXNFbnRyeRILCgNrZXkYASABKAUSLAoFdmFsdWUYAiABKAsyHS5saWxiYXR0bGUudjEuUGxheWVyU3RhdGVHT1JNOgI4ARpUChJQZW5kaW5nT3JkZXJzRW50cnkSCwoDa2V5GAEgASgFEi0KBXZhbHVlGAIgASgLMh4ubGlsYmF0dGxlLnYxLlBsYXllck9yZGVyc0dPUk06AjgBOijKph0kChZsaWxiYXR0bGUudjEuR2FtZVN0YXRlEgpnYW1lX3N0YXRlIrkBChVHYW1lQ29uZmlndXJhdGlvbkdPUk0SRgoOaW5jb21lX2NvbmZpZ3MYAyABKAsyHi5saWxiYXR0bGUudjEuSW5jb21lQ29uZmlnR09STUIOkqYdClIIZW1iZWRkZWQSMAoIc2V0dGluZ3MYBCABKAsyHi5saWxiYXR0bGUudjEuR2FtZVNldHRpbmdzR09STTomyqYdIgoebGlsYmF0dGxlLnYxLkdhbWVDb25maWd1cmF0aW9uIAEiNQoQSW5jb21lQ29uZmlnR09STTohyqYdHQoZbGlsYmF0dGxlLnYxLkluY29tZUNvbmZpZyABIjEKDkdhbWVQbGF5ZXJHT1JNOh/Kph0bChdsaWxiYXR0bGUudjEuR2FtZVBsYXllciABIi0KDEdhbWVUZWFtR09STTodyqYdGQoVbGlsYmF0dGxlLnYxLkdhbWVUZWFtIAEiYQoQR2FtZVNldHRpbmdzR09STRIsCg1hbGxvd2VkX3VuaXRzGAEgAygFQhWSph0RUg9zZXJpYWxpemVyOmpzb246H8qmHRsKGWxpbGJhdHRsZS52MS5HYW1lU2V0dGluZ3MiMwoPUGxheWVyU3RhdGVHT1JNOiDKph0cChhsaWxiYXR0bGUudjEuUGxheWVyU3RhdGUgASKLBQoRR2FtZVdvcmxkRGF0YUdPUk0SbAoVc2NyZWVuc2hvdF9pbmRleF9pbmZvGAQgASgLMhsubGlsYmF0dGxlLnYxLkluZGV4SW5mb0dPUk1CMJKmHSxSCGVtYmVkZGVkUiBlbWJlZGRlZFByZWZpeDpzY3JlZW5zaG90X2luZGV4XxJYCgljcm9zc2luZ3MYBSADKAsyLi5saWxiYXR0bGUudjEuR2FtZVdvcmxkRGF0YUdPUk0uQ3Jvc3NpbmdzRW50cnlCFZKmHRFSD3NlcmlhbGl6ZXI6anNvbhJXCgl0aWxlc19tYXAYBiADKAsyLS5saWxiYXR0bGUudjEuR2FtZVdvcmxkRGF0YUdPUk0uVGlsZXNNYXBFbnRyeUIVkqYdEVIPc2VyaWFsaXplcjpqc29uElcKCXVuaXRzX21hcBgHIAMoCzItLmxpbGJhdHRsZS52MS5HYW1lV29ybGREYXRhR09STS5Vbml0c01hcEVudHJ5QhWSph0RUg9zZXJpYWxpemVyOmpzb24aTAoOQ3Jvc3NpbmdzRW50cnkSCwoDa2V5GAEgASgJEikKBXZhbHVlGAIgASgLMhoubGlsYmF0dGxlLnYxLkNyb3NzaW5nR09STToCOAEaRwoNVGlsZXNNYXBFbnRyeRILCgNrZXkYASABKAkSJQoFdmFsdWUYAiABKAsyFi5saWxiYXR0bGUudjEuVGlsZUdPUk06AjgBGkcKDVVuaXRzTWFwRW50cnkSCwoDa2V5GAEgASgJEiUKBXZhbHVlGAIgASgLMhYubGlsYmF0dGxlLnYxLlVuaXRHT1JNOgI4ATocyqYdGAoWbGlsYmF0dGxlLnYxLldvcmxkRGF0YSI5ChNHYW1lTW92ZUhpc3RvcnlHT1JNOiLKph0eChxsaWxiYXR0bGUudjEuR2FtZU1vdmVIaXN0b3J5IjUKEUdhbWVNb3ZlR3JvdXBHT1JNOiDKph0cChpsaWxiYXR0bGUudjEuR2FtZU1vdmVHcm91cCKmAwoMR2FtZU1vdmVHT1JNEmcKB2dhbWVfaWQYASABKAlCVpKmHVJSCnByaW1hcnlLZXlSHGluZGV4OmlkeF9nYW1lX21vdmVzX2dhbWVfaWRSJmluZGV4OmlkeF9nYW1lX21vdmVzX2xvb2t1cCxwcmlvcml0eToxEk4KDGdyb3VwX251bWJlchgCIAEoA0I4kqYdNFIKcHJpbWFyeUtleVImaW5kZXg6aWR4X2dhbWVfbW92ZXNfbG9va3VwLHByaW9yaXR5OjISJQoLbW92ZV9udW1iZXIYAyABKANCEJKmHQxSCnByaW1hcnlLZXkSDwoHdmVyc2lvbhgEIAEoAxI+Cgltb3ZlX3R5cGUYBSABKAsyFC5nb29nbGUucHJvdG9idWYuQW55QhWSph0RUg9zZXJpYWxpemVyOmpzb24SPAoHY2hhbmdlcxgGIAMoCzIULmdvb2dsZS5wcm90b2J1Zi5BbnlCFZKmHRFSD3NlcmlhbGl6ZXI6anNvbjonyqYdIwoVbGlsYmF0dGxlLnYxLkdhbWVNb3ZlEgpnYW1lX21vdmVzIncKEFBsYXllck9yZGVyc0dPUk0SQAoFbW92ZXMYBSADKAsyGi5saWxiYXR0bGUudjEuR2FtZU1vdmVHT1JNQhWSph0RUg9zZXJpYWxpemVyOmpzb246IcqmHR0KGWxpbGJhdHRsZS52MS5QbGF5ZXJPcmRlcnMgASLGAQoMVXNlckdhbWVHT1JNEiEKB3VzZXJfaWQYASABKAlCEJKmHQxSCnByaW1hcnlLZXkSPwoHZ2FtZV9pZBgCIAEoCUIukqYdKlIKcHJpbWFyeUtleVIcaW5kZXg6aWR4X3VzZXJfZ2FtZXNfZ2FtZV9pZBIpCgpwbGF5ZXJfaWRzGAMgAygFQhWSph0RUg9zZXJpYWxpemVyOmpzb246J8qmHSMKFWxpbGJhdHRsZS52MS5Vc2VyR2FtZRIKdXNlcl9nYW1lc0K1AQoQY29tLmxpbGJhdHRsZS52MUILTW9kZWxzUHJvdG9QAVpDZ2l0aHViLmNvbS90dXJuZm9yZ2UvbGlsYmF0dGxlL2dlbi9nby9saWxiYXR0bGUvdjEvZ29ybTtsaWxiYXR0bGV2MaICA0xYWKoCDExpbGJhdHRsZS5WMcoCDExpbGJhdHRsZVxWMeICGExpbGJhdHRsZVxWMVxHUEJNZXRhZGF0YeoCDUxpbGJhdHRsZTo6VjFiBnByb3RvMw", [file_dal_v1_annotations, file_lilbattle_v1_models_models, file_google_protobuf_any, file_google_protobuf_struct]);
 
 /**
  * @generated from message lilbattle.v1.IndexInfoGORM
@@ -236,6 +236,13 @@ export type GameStateGORM = Message<"lilbattle.v1.GameStateGORM"> & {
    * @generated from field: map<int32, lilbattle.v1.PlayerStateGORM> player_states = 5;
    */
   playerStates: { [key: number]: PlayerStateGORM };
+
+  /**
+   * Pending simultaneous turn orders as JSON for cross-DB compatibility
+   *
+   * @generated from field: map<int32, lilbattle.v1.PlayerOrdersGORM> pending_orders = 6;
+   */
+  pendingOrders: { [key: number]: PlayerOrdersGORM };
 };
 
 /**
@@ -470,3 +477,57 @@ export type GameMoveGORM = Message<"lilbattle.v1.GameMoveGORM"> & {
 export const GameMoveGORMSchema: GenMessage<GameMoveGORM> = /*@__PURE__*/
   messageDesc(file_lilbattle_v1_gorm_models, 18);
 
+/**
+ * @generated from message lilbattle.v1.PlayerOrdersGORM
+ */
+export type PlayerOrdersGORM = Message<"lilbattle.v1.PlayerOrdersGORM"> & {
+  /**
+   * Moves as JSON for cross-DB compatibility
+   *
+   * @generated from field: repeated lilbattle.v1.GameMoveGORM moves = 5;
+   */
+  moves: GameMoveGORM[];
+};
+
+/**
+ * Describes the message lilbattle.v1.PlayerOrdersGORM.
+ * Use `create(PlayerOrdersGORMSchema)` to create a new message.
+ */
+export const PlayerOrdersGORMSchema: GenMessage<PlayerOrdersGORM> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_gorm_models, 19);
+
+/**
+ * Per-user game index - one row per (user, game)
+ *
+ * @generated from message lilbattle.v1.UserGameGORM
+ */
+export type UserGameGORM = Message<"lilbattle.v1.UserGameGORM"> & {
+  /**
+   * user_id leads the primary key so listing a user's games is a prefix scan
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * game_id indexed for replacing a game's entries when it changes
+   *
+   * @generated from field: string game_id = 2;
+   */
+  gameId: string;
+
+  /**
+   * PlayerIds as JSON for cross-DB compatibility
+   *
+   * @generated from field: repeated int32 player_ids = 3;
+   */
+  playerIds: number[];
+};
+
+/**
+ * Describes the message lilbattle.v1.UserGameGORM.
+ * Use `create(UserGameGORMSchema)` to create a new message.
+ */
+export const UserGameGORMSchema: GenMessage<UserGameGORM> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_gorm_models, 20);
+
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file lilbattle/v1/models/filestore.proto.
  */
 export const file_lilbattle_v1_models_filestore: GenFile = /*@__PURE__*/
-  fileDesc("CiNsaWxiYXR0bGUvdjEvbW9kZWxzL2ZpbGVzdG9yZS5wcm90bxIMbGlsYmF0dGxlLnYxIrICCgRGaWxlEgwKBHBhdGgYASABKAkSFAoMY29udGVudF90eXBlGAIgASgJEhEKCWZpbGVfc2l6ZRgDIAEoBBIRCglpc19wdWJsaWMYBCABKAgSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMZG93bmxvYWRfdXJsGAcgASgJEjcKC3NpZ25lZF91cmxzGAggAygLMiIubGlsYmF0dGxlLnYxLkZpbGUuU2lnbmVkVXJsc0VudHJ5GjEKD1NpZ25lZFVybHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkMKDlB1dEZpbGVSZXF1ZXN0EiAKBGZpbGUYASABKAsyEi5saWxiYXR0bGUudjEuRmlsZRIPCgdjb250ZW50GAIgASgMIjMKD1B1dEZpbGVSZXNwb25zZRIgCgRmaWxlGAEgASgLMhIubGlsYmF0dGxlLnYxLkZpbGUiOwoOR2V0RmlsZVJlcXVlc3QSDAoEcGF0aBgBIAEoCRIbChNpbmNsdWRlX3NpZ25lZF91cmxzGAIgASgIIjMKD0dldEZpbGVSZXNwb25zZRIgCgRmaWxlGAEgASgLMhIubGlsYmF0dGxlLnYxLkZpbGUiIQoRRGVsZXRlRmlsZVJlcXVlc3QSDAoEcGF0aBgBIAEoCSI2ChJEZWxldGVGaWxlUmVzcG9uc2USIAoEZmlsZRgBIAEoCzISLmxpbGJhdHRsZS52MS5GaWxlImsKEExpc3RGaWxlc1JlcXVlc3QSDAoEcGF0aBgBIAEoCRIsCgpwYWdpbmF0aW9uGAIgASgLMhgubGlsYmF0dGxlLnYxLlBhZ2luYXRpb24SGwoTaW5jbHVkZV9zaWduZWRfdXJscxgDIAEoCCJsChFMaXN0RmlsZXNSZXNwb25zZRIhCgVpdGVtcxgBIAMoCzISLmxpbGJhdHRsZS52MS5GaWxlEjQKCnBhZ2luYXRpb24YAiABKAsyIC5saWxiYXR0bGUudjEuUGFnaW5hdGlvblJlc3BvbnNlQroBChBjb20ubGlsYmF0dGxlLnYxQg5GaWxlc3RvcmVQcm90b1ABWkVnaXRodWIuY29tL3R1cm5mb3JnZS9saWxiYXR0bGUvZ2VuL2dvL2xpbGJhdHRsZS92MS9tb2RlbHM7bGlsYmF0dGxldjGiAgNMWFiqAgxMaWxiYXR0bGUuVjHKAgxMaWxiYXR0bGVcVjHiAhhMaWxiYXR0bGVcVjFcR1BCTWV0YWRhdGHqAg1MaWxiYXR0bGU6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_google_protobuf_any, file_google_protobuf_field_mask, file_lilbattle_v1_models_models]);
+  fileDesc("CiNsaWxiYXR0bGUvdjEvbW9kZWxzL2ZpbGVzdG9yZS5wcm90bxIMbGlsYmF0dGxlLnYxIrICCgRGaWxlEgwKBHBhdGgYASABKAkSFAoMY29udGVudF90eXBlGAIgASgJEhEKCWZpbGVfc2l6ZRgDIAEoBBIRCglpc19wdWJsaWMYBCABKAgSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMZG93bmxvYWRfdXJsGAcgASgJEjcKC3NpZ25lZF91cmxzGAggAygLMiIubGlsYmF0dGxlLnYxLkZpbGUuU2lnbmVkVXJsc0VudHJ5GjEKD1NpZ25lZFVybHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkMKDlB1dEZpbGVSZXF1ZXN0EiAKBGZpbGUYASABKAsyEi5saWxiYXR0bGUudjEuRmlsZRIPCgdjb250ZW50GAIgASgMIjMKD1B1dEZpbGVSZXNwb25zZRIgCgRmaWxlGAEgASgLMhIubGlsYmF0dGxlLnYxLkZpbGUiOwoOR2V0RmlsZVJlcXVlc3QSDAoEcGF0aBgBIAEoCRIbChNpbmNsdWRlX3NpZ25lZF91cmxzGAIgASgIIjMKD0dldEZpbGVSZXNwb25zZRIgCgRmaWxlGAEgASgLMhIubGlsYmF0dGxlLnYxLkZpbGUiIQoRRGVsZXRlRmlsZVJlcXVlc3QSDAoEcGF0aBgBIAEoCSI2ChJEZWxldGVGaWxlUmVzcG9uc2USIAoEZmlsZRgBIAEoCzISLmxpbGJhdHRsZS52MS5GaWxlIn4KEExpc3RGaWxlc1JlcXVlc3QSDAoEcGF0aBgBIAEoCRIsCgpwYWdpbmF0aW9uGAIgASgLMhgubGlsYmF0dGxlLnYxLlBhZ2luYXRpb24SGwoTaW5jbHVkZV9zaWduZWRfdXJscxgDIAEoCBIRCglyZWN1cnNpdmUYBCABKAgibAoRTGlzdEZpbGVzUmVzcG9uc2USIQoFaXRlbXMYASADKAsyEi5saWxiYXR0bGUudjEuRmlsZRI0CgpwYWdpbmF0aW9uGAIgASgLMiAubGlsYmF0dGxlLnYxLlBhZ2luYXRpb25SZXNwb25zZUK6AQoQY29tLmxpbGJhdHRsZS52MUIORmlsZXN0b3JlUHJvdG9QAVpFZ2l0aHViLmNvbS90dXJuZm9yZ2UvbGlsYmF0dGxlL2dlbi9nby9saWxiYXR0bGUvdjEvbW9kZWxzO2xpbGJhdHRsZXYxogIDTFhYqgIMTGlsYmF0dGxlLlYxygIMTGlsYmF0dGxlXFYx4gIYTGlsYmF0dGxlXFYxXEdQQk1ldGFkYXRh6gINTGlsYmF0dGxlOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_any, file_google_protobuf_field_mask, file_lilbattle_v1_models_models]);
 
 /**
  * @generated from message lilbattle.v1.File
@@ -221,6 +221,13 @@ export type ListFilesRequest = Message<"lilbattle.v1.ListFilesRequest"> & {
    * @generated from field: bool include_signed_urls = 3;
    */
   includeSignedUrls: boolean;
+
+  /**
+   * If true, list the files under every sub directory of path too
+   *
+   * @generated from field: bool recursive = 4;
+   */
+  recursive: boolean;
 };
 
 /**
@@ -6,13 +6,15 @@ import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2"
 import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Any, Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_any, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Pagination, PaginationResponse } from "./models_pb";
+import { file_lilbattle_v1_models_models } from "./models_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file lilbattle/v1/models/jobs.proto.
  */
 export const file_lilbattle_v1_models_jobs: GenFile = /*@__PURE__*/
-  fileDesc("Ch5saWxiYXR0bGUvdjEvbW9kZWxzL2pvYnMucHJvdG8SDGxpbGJhdHRsZS52MSKXAgoDSm9iEhMKC2VudGl0eV90eXBlGAEgASgJEhEKCWVudGl0eV9pZBgCIAEoCRIQCghqb2JfdHlwZRgDIAEoCRIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBImCghqb2JfZGF0YRgGIAEoCzIULmdvb2dsZS5wcm90b2J1Zi5BbnkSHwoXZGVib3VuY2Vfd2luZG93X3NlY29uZHMYByABKAUSLQoLcmVwZWF0X2luZm8YCCABKAsyGC5saWxiYXR0bGUudjEuUmVwZWF0SW5mbyIMCgpSZXBlYXRJbmZvIsgCCgNSdW4SDgoGam9iX2lkGAEgASgJEg4KBnJ1bl9pZBgCIAEoCRIuCgpjcmVhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpzdGFydGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIlCgVzdGF0ZRgGIAEoDjIWLmxpbGJhdHRsZS52MS5SdW5TdGF0ZRImCghydW5fZGF0YRgHIAEoCzIULmdvb2dsZS5wcm90b2J1Zi5BbnkSEgoKbGFzdF9lcnJvchgIIAEoCRIZChFsYXN0X2NvbnRlbnRfaGFzaBgJIAEoCRITCgtyZXRyeV9jb3VudBgKIAEoBSpUCghSdW5TdGF0ZRIZChVSVU5fU1RBVEVfVU5TUEVDSUZJRUQQABIVChFSVU5fU1RBVEVfU1RBUlRFRBABEhYKElJVTl9TVEFURV9GSU5JU0hFRBACQrUBChBjb20ubGlsYmF0dGxlLnYxQglKb2JzUHJvdG9QAVpFZ2l0aHViLmNvbS90dXJuZm9yZ2UvbGlsYmF0dGxlL2dlbi9nby9saWxiYXR0bGUvdjEvbW9kZWxzO2xpbGJhdHRsZXYxogIDTFhYqgIMTGlsYmF0dGxlLlYxygIMTGlsYmF0dGxlXFYx4gIYTGlsYmF0dGxlXFYxXEdQQk1ldGFkYXRh6gINTGlsYmF0dGxlOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_any, file_google_protobuf_field_mask]);
+  fileDesc("Ch5saWxiYXR0bGUvdjEvbW9kZWxzL2pvYnMucHJvdG8SDGxpbGJhdHRsZS52MSLcAwoDSm9iEhMKC2VudGl0eV90eXBlGAEgASgJEhEKCWVudGl0eV9pZBgCIAEoCRIQCghqb2JfdHlwZRgDIAEoCRIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBImCghqb2JfZGF0YRgGIAEoCzIULmdvb2dsZS5wcm90b2J1Zi5BbnkSHwoXZGVib3VuY2Vfd2luZG93X3NlY29uZHMYByABKAUSLQoLcmVwZWF0X2luZm8YCCABKAsyGC5saWxiYXR0bGUudjEuUmVwZWF0SW5mbxIKCgJpZBgJIAEoCRInCgZzdGF0dXMYCiABKA4yFy5saWxiYXR0bGUudjEuSm9iU3RhdHVzEi0KCXJ1bl9hZnRlchgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIYXR0ZW1wdHMYDCABKAUSEwoLbWF4X3JldHJpZXMYDSABKAUSEgoKbGFzdF9lcnJvchgOIAEoCRITCgtsYXN0X3J1bl9pZBgPIAEoCRIPCgd2ZXJzaW9uGBAgASgDIiYKClJlcGVhdEluZm8SGAoQaW50ZXJ2YWxfc2Vjb25kcxgBIAEoBSKLAwoDUnVuEg4KBmpvYl9pZBgBIAEoCRIOCgZydW5faWQYAiABKAkSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKc3RhcnRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJQoFc3RhdGUYBiABKA4yFi5saWxiYXR0bGUudjEuUnVuU3RhdGUSJgoIcnVuX2RhdGEYByABKAsyFC5nb29nbGUucHJvdG9idWYuQW55EhIKCmxhc3RfZXJyb3IYCCABKAkSGQoRbGFzdF9jb250ZW50X2hhc2gYCSABKAkSEwoLcmV0cnlfY291bnQYCiABKAUSEAoIam9iX3R5cGUYCyABKAkSLwoLZmluaXNoZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wInoKD0xpc3RKb2JzUmVxdWVzdBIQCghqb2JfdHlwZRgBIAEoCRInCgZzdGF0dXMYAiABKA4yFy5saWxiYXR0bGUudjEuSm9iU3RhdHVzEiwKCnBhZ2luYXRpb24YAyABKAsyGC5saWxiYXR0bGUudjEuUGFnaW5hdGlvbiJqChBMaXN0Sm9ic1Jlc3BvbnNlEiAKBWl0ZW1zGAEgAygLMhEubGlsYmF0dGxlLnYxLkpvYhI0CgpwYWdpbmF0aW9uGAIgASgLMiAubGlsYmF0dGxlLnYxLlBhZ2luYXRpb25SZXNwb25zZSIuCg1HZXRKb2JSZXF1ZXN0EgoKAmlkGAEgASgJEhEKCXJ1bl9saW1pdBgCIAEoBSJRCg5HZXRKb2JSZXNwb25zZRIeCgNqb2IYASABKAsyES5saWxiYXR0bGUudjEuSm9iEh8KBHJ1bnMYAiADKAsyES5saWxiYXR0bGUudjEuUnVuIh0KD1JldHJ5Sm9iUmVxdWVzdBIKCgJpZBgBIAEoCSIyChBSZXRyeUpvYlJlc3BvbnNlEh4KA2pvYhgBIAEoCzIRLmxpbGJhdHRsZS52MS5Kb2IqagoIUnVuU3RhdGUSGQoVUlVOX1NUQVRFX1VOU1BFQ0lGSUVEEAASFQoRUlVOX1NUQVRFX1NUQVJURUQQARIWChJSVU5fU1RBVEVfRklOSVNIRUQQAhIUChBSVU5fU1RBVEVfRkFJTEVEEAMqiAEKCUpvYlN0YXR1cxIaChZKT0JfU1RBVFVTX1VOU1BFQ0lGSUVEEAASFgoSSk9CX1NUQVRVU19QRU5ESU5HEAESFgoSSk9CX1NUQVRVU19SVU5OSU5HEAISGAoUSk9CX1NUQVRVU19TVUNDRUVERUQQAxIVChFKT0JfU1RBVFVTX0ZBSUxFRBAEQrUBChBjb20ubGlsYmF0dGxlLnYxQglKb2JzUHJvdG9QAVpFZ2l0aHViLmNvbS90dXJuZm9yZ2UvbGlsYmF0dGxlL2dlbi9nby9saWxiYXR0bGUvdjEvbW9kZWxzO2xpbGJhdHRsZXYxogIDTFhYqgIMTGlsYmF0dGxlLlYxygIMTGlsYmF0dGxlXFYx4gIYTGlsYmF0dGxlXFYxXEdQQk1ldGFkYXRh6gINTGlsYmF0dGxlOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_any, file_google_protobuf_field_mask, file_lilbattle_v1_models_models]);
 
 /**
  * Job describes the work that needs to be done.
@@ -69,6 +71,62 @@ export type Job = Message<"lilbattle.v1.Job"> & {
    * @generated from field: lilbattle.v1.RepeatInfo repeat_info = 8;
    */
   repeatInfo?: RepeatInfo;
+
+  /**
+   * job_type:entity_type:entity_id - so enqueueing the same work again
+   * updates the pending job instead of adding another one
+   *
+   * @generated from field: string id = 9;
+   */
+  id: string;
+
+  /**
+   * @generated from field: lilbattle.v1.JobStatus status = 10;
+   */
+  status: JobStatus;
+
+  /**
+   * When the job is next due to run
+   *
+   * @generated from field: google.protobuf.Timestamp run_after = 11;
+   */
+  runAfter?: Timestamp;
+
+  /**
+   * Failed runs since the job last succeeded
+   *
+   * @generated from field: int32 attempts = 12;
+   */
+  attempts: number;
+
+  /**
+   * How many times a failing job is retried before it is marked failed.
+   * 0 uses the runner's default.
+   *
+   * @generated from field: int32 max_retries = 13;
+   */
+  maxRetries: number;
+
+  /**
+   * Error of the last failed run
+   *
+   * @generated from field: string last_error = 14;
+   */
+  lastError: string;
+
+  /**
+   * ID of the latest run
+   *
+   * @generated from field: string last_run_id = 15;
+   */
+  lastRunId: string;
+
+  /**
+   * Incremented on every save for optimistic locking
+   *
+   * @generated from field: int64 version = 16;
+   */
+  version: bigint;
 };
 
 /**
@@ -82,6 +140,13 @@ export const JobSchema: GenMessage<Job> = /*@__PURE__*/
  * @generated from message lilbattle.v1.RepeatInfo
  */
 export type RepeatInfo = Message<"lilbattle.v1.RepeatInfo"> & {
+  /**
+   * Seconds from the end of one run to the start of the next.  0 runs the
+   * job once each time it is enqueued.
+   *
+   * @generated from field: int32 interval_seconds = 1;
+   */
+  intervalSeconds: number;
 };
 
 /**
@@ -155,6 +220,16 @@ export type Run = Message<"lilbattle.v1.Run"> & {
    * @generated from field: int32 retry_count = 10;
    */
   retryCount: number;
+
+  /**
+   * @generated from field: string job_type = 11;
+   */
+  jobType: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp finished_at = 12;
+   */
+  finishedAt?: Timestamp;
 };
 
 /**
@@ -164,6 +239,143 @@ export type Run = Message<"lilbattle.v1.Run"> & {
 export const RunSchema: GenMessage<Run> = /*@__PURE__*/
   messageDesc(file_lilbattle_v1_models_jobs, 2);
 
+/**
+ * @generated from message lilbattle.v1.ListJobsRequest
+ */
+export type ListJobsRequest = Message<"lilbattle.v1.ListJobsRequest"> & {
+  /**
+   * Only jobs of this type
+   *
+   * @generated from field: string job_type = 1;
+   */
+  jobType: string;
+
+  /**
+   * Only jobs in this status (unspecified matches every status)
+   *
+   * @generated from field: lilbattle.v1.JobStatus status = 2;
+   */
+  status: JobStatus;
+
+  /**
+   * @generated from field: lilbattle.v1.Pagination pagination = 3;
+   */
+  pagination?: Pagination;
+};
+
+/**
+ * Describes the message lilbattle.v1.ListJobsRequest.
+ * Use `create(ListJobsRequestSchema)` to create a new message.
+ */
+export const ListJobsRequestSchema: GenMessage<ListJobsRequest> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_jobs, 3);
+
+/**
+ * @generated from message lilbattle.v1.ListJobsResponse
+ */
+export type ListJobsResponse = Message<"lilbattle.v1.ListJobsResponse"> & {
+  /**
+   * Jobs due soonest first
+   *
+   * @generated from field: repeated lilbattle.v1.Job items = 1;
+   */
+  items: Job[];
+
+  /**
+   * @generated from field: lilbattle.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message lilbattle.v1.ListJobsResponse.
+ * Use `create(ListJobsResponseSchema)` to create a new message.
+ */
+export const ListJobsResponseSchema: GenMessage<ListJobsResponse> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_jobs, 4);
+
+/**
+ * @generated from message lilbattle.v1.GetJobRequest
+ */
+export type GetJobRequest = Message<"lilbattle.v1.GetJobRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * How many of the latest runs to return (default 20)
+   *
+   * @generated from field: int32 run_limit = 2;
+   */
+  runLimit: number;
+};
+
+/**
+ * Describes the message lilbattle.v1.GetJobRequest.
+ * Use `create(GetJobRequestSchema)` to create a new message.
+ */
+export const GetJobRequestSchema: GenMessage<GetJobRequest> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_jobs, 5);
+
+/**
+ * @generated from message lilbattle.v1.GetJobResponse
+ */
+export type GetJobResponse = Message<"lilbattle.v1.GetJobResponse"> & {
+  /**
+   * @generated from field: lilbattle.v1.Job job = 1;
+   */
+  job?: Job;
+
+  /**
+   * Run history, newest first
+   *
+   * @generated from field: repeated lilbattle.v1.Run runs = 2;
+   */
+  runs: Run[];
+};
+
+/**
+ * Describes the message lilbattle.v1.GetJobResponse.
+ * Use `create(GetJobResponseSchema)` to create a new message.
+ */
+export const GetJobResponseSchema: GenMessage<GetJobResponse> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_jobs, 6);
+
+/**
+ * @generated from message lilbattle.v1.RetryJobRequest
+ */
+export type RetryJobRequest = Message<"lilbattle.v1.RetryJobRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message lilbattle.v1.RetryJobRequest.
+ * Use `create(RetryJobRequestSchema)` to create a new message.
+ */
+export const RetryJobRequestSchema: GenMessage<RetryJobRequest> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_jobs, 7);
+
+/**
+ * @generated from message lilbattle.v1.RetryJobResponse
+ */
+export type RetryJobResponse = Message<"lilbattle.v1.RetryJobResponse"> & {
+  /**
+   * @generated from field: lilbattle.v1.Job job = 1;
+   */
+  job?: Job;
+};
+
+/**
+ * Describes the message lilbattle.v1.RetryJobResponse.
+ * Use `create(RetryJobResponseSchema)` to create a new message.
+ */
+export const RetryJobResponseSchema: GenMessage<RetryJobResponse> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_jobs, 8);
+
 /**
  * @generated from enum lilbattle.v1.RunState
  */
@@ -182,6 +394,11 @@ export enum RunState {
    * @generated from enum value: RUN_STATE_FINISHED = 2;
    */
   FINISHED = 2,
+
+  /**
+   * @generated from enum value: RUN_STATE_FAILED = 3;
+   */
+  FAILED = 3,
 }
 
 /**
@@ -190,3 +407,43 @@ export enum RunState {
 export const RunStateSchema: GenEnum<RunState> = /*@__PURE__*/
   enumDesc(file_lilbattle_v1_models_jobs, 0);
 
+/**
+ * @generated from enum lilbattle.v1.JobStatus
+ */
+export enum JobStatus {
+  /**
+   * @generated from enum value: JOB_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for run_after
+   *
+   * @generated from enum value: JOB_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: JOB_STATUS_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * @generated from enum value: JOB_STATUS_SUCCEEDED = 3;
+   */
+  SUCCEEDED = 3,
+
+  /**
+   * Out of retries - only runs again when retried or enqueued again
+   *
+   * @generated from enum value: JOB_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+}
+
+/**
+ * Describes the enum lilbattle.v1.JobStatus.
+ */
+export const JobStatusSchema: GenEnum<JobStatus> = /*@__PURE__*/
+  enumDesc(file_lilbattle_v1_models_jobs, 1);
+
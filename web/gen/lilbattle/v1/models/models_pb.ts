@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file lilbattle/v1/models/models.proto.
  */
 export const file_lilbattle_v1_models_models: GenFile = /*@__PURE__*/
-  fileDesc("CiBsaWxiYXR0bGUvdjEvbW9kZWxzL21vZGVscy5wcm90bxIMbGlsYmF0dGxlLnYxIo0BCglJbmRleEluZm8SMwoPbGFzdF91cGRhdGVkX2F0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIzCg9sYXN0X2luZGV4ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhYKDm5lZWRzX2luZGV4aW5nGAMgASgIIkYKClBhZ2luYXRpb24SEAoIcGFnZV9rZXkYASABKAkSEwoLcGFnZV9vZmZzZXQYAiABKAUSEQoJcGFnZV9zaXplGAMgASgFIm4KElBhZ2luYXRpb25SZXNwb25zZRIVCg1uZXh0X3BhZ2Vfa2V5GAIgASgJEhgKEG5leHRfcGFnZV9vZmZzZXQYAyABKAUSEAoIaGFzX21vcmUYBCABKAgSFQoNdG90YWxfcmVzdWx0cxgFIAEoBSL4AgoFV29ybGQSLgoKY3JlYXRlZF9hdBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDwoHdmVyc2lvbhgDIAEoAxIKCgJpZBgEIAEoCRISCgpjcmVhdG9yX2lkGAUgASgJEgwKBG5hbWUYBiABKAkSEwoLZGVzY3JpcHRpb24YByABKAkSDAoEdGFncxgIIAMoCRIRCglpbWFnZV91cmwYCSABKAkSEgoKZGlmZmljdWx0eRgKIAEoCRIUCgxwcmV2aWV3X3VybHMYCyADKAkSPAoTZGVmYXVsdF9nYW1lX2NvbmZpZxgMIAEoCzIfLmxpbGJhdHRsZS52MS5HYW1lQ29uZmlndXJhdGlvbhIyChFzZWFyY2hfaW5kZXhfaW5mbxgNIAEoCzIXLmxpbGJhdHRsZS52MS5JbmRleEluZm8i7QMKCVdvcmxkRGF0YRI4Cgl0aWxlc19tYXAYASADKAsyJS5saWxiYXR0bGUudjEuV29ybGREYXRhLlRpbGVzTWFwRW50cnkSOAoJdW5pdHNfbWFwGAIgAygLMiUubGlsYmF0dGxlLnYxLldvcmxkRGF0YS5Vbml0c01hcEVudHJ5EjYKFXNjcmVlbnNob3RfaW5kZXhfaW5mbxgDIAEoCzIXLmxpbGJhdHRsZS52MS5JbmRleEluZm8SFAoMY29udGVudF9oYXNoGAQgASgJEg8KB3ZlcnNpb24YBSABKAMSOQoJY3Jvc3NpbmdzGAggAygLMiYubGlsYmF0dGxlLnYxLldvcmxkRGF0YS5Dcm9zc2luZ3NFbnRyeRpDCg1UaWxlc01hcEVudHJ5EgsKA2tleRgBIAEoCRIhCgV2YWx1ZRgCIAEoCzISLmxpbGJhdHRsZS52MS5UaWxlOgI4ARpDCg1Vbml0c01hcEVudHJ5EgsKA2tleRgBIAEoCRIhCgV2YWx1ZRgCIAEoCzISLmxpbGJhdHRsZS52MS5Vbml0OgI4ARpICg5Dcm9zc2luZ3NFbnRyeRILCgNrZXkYASABKAkSJQoFdmFsdWUYAiABKAsyFi5saWxiYXR0bGUudjEuQ3Jvc3Npbmc6AjgBIkkKCENyb3NzaW5nEigKBHR5cGUYASABKA4yGi5saWxiYXR0bGUudjEuQ3Jvc3NpbmdUeXBlEhMKC2Nvbm5lY3RzX3RvGAIgAygIIoYBCgRUaWxlEgkKAXEYASABKAUSCQoBchgCIAEoBRIRCgl0aWxlX3R5cGUYAyABKAUSDgoGcGxheWVyGAQgASgFEhAKCHNob3J0Y3V0GAUgASgJEhcKD2xhc3RfYWN0ZWRfdHVybhgGIAEoBRIaChJsYXN0X3RvcHBlZHVwX3R1cm4YByABKAUi4wIKBFVuaXQSCQoBcRgBIAEoBRIJCgFyGAIgASgFEg4KBnBsYXllchgDIAEoBRIRCgl1bml0X3R5cGUYBCABKAUSEAoIc2hvcnRjdXQYBSABKAkSGAoQYXZhaWxhYmxlX2hlYWx0aBgGIAEoBRIVCg1kaXN0YW5jZV9sZWZ0GAcgASgBEhcKD2xhc3RfYWN0ZWRfdHVybhgIIAEoBRIaChJsYXN0X3RvcHBlZHVwX3R1cm4YCSABKAUSIgoaYXR0YWNrc19yZWNlaXZlZF90aGlzX3R1cm4YCiABKAUSMgoOYXR0YWNrX2hpc3RvcnkYCyADKAsyGi5saWxiYXR0bGUudjEuQXR0YWNrUmVjb3JkEhgKEHByb2dyZXNzaW9uX3N0ZXAYDCABKAUSGgoSY2hvc2VuX2FsdGVybmF0aXZlGA0gASgJEhwKFGNhcHR1cmVfc3RhcnRlZF90dXJuGA4gASgFIkwKDEF0dGFja1JlY29yZBIJCgFxGAEgASgFEgkKAXIYAiABKAUSEQoJaXNfcmFuZ2VkGAMgASgIEhMKC3R1cm5fbnVtYmVyGAQgASgFIq8CChFUZXJyYWluRGVmaW5pdGlvbhIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEgwKBHR5cGUYBSABKAUSEwoLZGVzY3JpcHRpb24YBiABKAkSTAoPdW5pdF9wcm9wZXJ0aWVzGAcgAygLMjMubGlsYmF0dGxlLnYxLlRlcnJhaW5EZWZpbml0aW9uLlVuaXRQcm9wZXJ0aWVzRW50cnkSGgoSYnVpbGRhYmxlX3VuaXRfaWRzGAggAygFEhcKD2luY29tZV9wZXJfdHVybhgJIAEoBRpaChNVbml0UHJvcGVydGllc0VudHJ5EgsKA2tleRgBIAEoBRIyCgV2YWx1ZRgCIAEoCzIjLmxpbGJhdHRsZS52MS5UZXJyYWluVW5pdFByb3BlcnRpZXM6AjgBIvoFCg5Vbml0RGVmaW5pdGlvbhIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg4KBmhlYWx0aBgEIAEoBRINCgVjb2lucxgFIAEoBRIXCg9tb3ZlbWVudF9wb2ludHMYBiABKAESFgoOcmV0cmVhdF9wb2ludHMYByABKAESDwoHZGVmZW5zZRgIIAEoBRIUCgxhdHRhY2tfcmFuZ2UYCSABKAUSGAoQbWluX2F0dGFja19yYW5nZRgKIAEoBRIVCg1zcGxhc2hfZGFtYWdlGAsgASgFEk8KEnRlcnJhaW5fcHJvcGVydGllcxgMIAMoCzIzLmxpbGJhdHRsZS52MS5Vbml0RGVmaW5pdGlvbi5UZXJyYWluUHJvcGVydGllc0VudHJ5EhIKCnByb3BlcnRpZXMYDSADKAkSEgoKdW5pdF9jbGFzcxgOIAEoCRIUCgx1bml0X3RlcnJhaW4YDyABKAkSSAoPYXR0YWNrX3ZzX2NsYXNzGBAgAygLMi8ubGlsYmF0dGxlLnYxLlVuaXREZWZpbml0aW9uLkF0dGFja1ZzQ2xhc3NFbnRyeRIUCgxhY3Rpb25fb3JkZXIYESADKAkSRQoNYWN0aW9uX2xpbWl0cxgSIAMoCzIuLmxpbGJhdHRsZS52MS5Vbml0RGVmaW5pdGlvbi5BY3Rpb25MaW1pdHNFbnRyeRIRCglmaXhfdmFsdWUYEyABKAUaXQoWVGVycmFpblByb3BlcnRpZXNFbnRyeRILCgNrZXkYASABKAUSMgoFdmFsdWUYAiABKAsyIy5saWxiYXR0bGUudjEuVGVycmFpblVuaXRQcm9wZXJ0aWVzOgI4ARo0ChJBdHRhY2tWc0NsYXNzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ARozChFBY3Rpb25MaW1pdHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBIu8BChVUZXJyYWluVW5pdFByb3BlcnRpZXMSEgoKdGVycmFpbl9pZBgBIAEoBRIPCgd1bml0X2lkGAIgASgFEhUKDW1vdmVtZW50X2Nvc3QYAyABKAESFQoNaGVhbGluZ19ib251cxgEIAEoBRIRCgljYW5fYnVpbGQYBSABKAgSEwoLY2FuX2NhcHR1cmUYBiABKAgSFAoMYXR0YWNrX2JvbnVzGAcgASgFEhUKDWRlZmVuc2VfYm9udXMYCCABKAUSFAoMYXR0YWNrX3JhbmdlGAkgASgFEhgKEG1pbl9hdHRhY2tfcmFuZ2UYCiABKAUi1gEKElVuaXRVbml0UHJvcGVydGllcxITCgthdHRhY2tlcl9pZBgBIAEoBRITCgtkZWZlbmRlcl9pZBgCIAEoBRIcCg9hdHRhY2tfb3ZlcnJpZGUYAyABKAVIAIgBARIdChBkZWZlbnNlX292ZXJyaWRlGAQgASgFSAGIAQESMAoGZGFtYWdlGAUgASgLMiAubGlsYmF0dGxlLnYxLkRhbWFnZURpc3RyaWJ1dGlvbkISChBfYXR0YWNrX292ZXJyaWRlQhMKEV9kZWZlbnNlX292ZXJyaWRlIoABChJEYW1hZ2VEaXN0cmlidXRpb24SEgoKbWluX2RhbWFnZRgBIAEoARISCgptYXhfZGFtYWdlGAIgASgBEhcKD2V4cGVjdGVkX2RhbWFnZRgDIAEoARIpCgZyYW5nZXMYBCADKAsyGS5saWxiYXR0bGUudjEuRGFtYWdlUmFuZ2UiSAoLRGFtYWdlUmFuZ2USEQoJbWluX3ZhbHVlGAEgASgBEhEKCW1heF92YWx1ZRgCIAEoARITCgtwcm9iYWJpbGl0eRgDIAEoASKXBgoLUnVsZXNFbmdpbmUSMwoFdW5pdHMYASADKAsyJC5saWxiYXR0bGUudjEuUnVsZXNFbmdpbmUuVW5pdHNFbnRyeRI5Cgh0ZXJyYWlucxgCIAMoCzInLmxpbGJhdHRsZS52MS5SdWxlc0VuZ2luZS5UZXJyYWluc0VudHJ5ElUKF3RlcnJhaW5fdW5pdF9wcm9wZXJ0aWVzGAMgAygLMjQubGlsYmF0dGxlLnYxLlJ1bGVzRW5naW5lLlRlcnJhaW5Vbml0UHJvcGVydGllc0VudHJ5Ek8KFHVuaXRfdW5pdF9wcm9wZXJ0aWVzGAQgAygLMjEubGlsYmF0dGxlLnYxLlJ1bGVzRW5naW5lLlVuaXRVbml0UHJvcGVydGllc0VudHJ5EkIKDXRlcnJhaW5fdHlwZXMYBSADKAsyKy5saWxiYXR0bGUudjEuUnVsZXNFbmdpbmUuVGVycmFpblR5cGVzRW50cnkaSgoKVW5pdHNFbnRyeRILCgNrZXkYASABKAUSKwoFdmFsdWUYAiABKAsyHC5saWxiYXR0bGUudjEuVW5pdERlZmluaXRpb246AjgBGlAKDVRlcnJhaW5zRW50cnkSCwoDa2V5GAEgASgFEi4KBXZhbHVlGAIgASgLMh8ubGlsYmF0dGxlLnYxLlRlcnJhaW5EZWZpbml0aW9uOgI4ARphChpUZXJyYWluVW5pdFByb3BlcnRpZXNFbnRyeRILCgNrZXkYASABKAkSMgoFdmFsdWUYAiABKAsyIy5saWxiYXR0bGUudjEuVGVycmFpblVuaXRQcm9wZXJ0aWVzOgI4ARpbChdVbml0VW5pdFByb3BlcnRpZXNFbnRyeRILCgNrZXkYASABKAkSLwoFdmFsdWUYAiABKAsyIC5saWxiYXR0bGUudjEuVW5pdFVuaXRQcm9wZXJ0aWVzOgI4ARpOChFUZXJyYWluVHlwZXNFbnRyeRILCgNrZXkYASABKAUSKAoFdmFsdWUYAiABKA4yGS5saWxiYXR0bGUudjEuVGVycmFpblR5cGU6AjgBIvwCCgRHYW1lEi4KCmNyZWF0ZWRfYXQYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB3ZlcnNpb24YAyABKAMSCgoCaWQYBCABKAkSEgoKY3JlYXRvcl9pZBgFIAEoCRIQCgh3b3JsZF9pZBgGIAEoCRIMCgRuYW1lGAcgASgJEhMKC2Rlc2NyaXB0aW9uGAggASgJEgwKBHRhZ3MYCSADKAkSEQoJaW1hZ2VfdXJsGAogASgJEhIKCmRpZmZpY3VsdHkYCyABKAkSLwoGY29uZmlnGAwgASgLMh8ubGlsYmF0dGxlLnYxLkdhbWVDb25maWd1cmF0aW9uEhQKDHByZXZpZXdfdXJscxgNIAMoCRIyChFzZWFyY2hfaW5kZXhfaW5mbxgPIAEoCzIXLmxpbGJhdHRsZS52MS5JbmRleEluZm8ixwEKEUdhbWVDb25maWd1cmF0aW9uEikKB3BsYXllcnMYASADKAsyGC5saWxiYXR0bGUudjEuR2FtZVBsYXllchIlCgV0ZWFtcxgCIAMoCzIWLmxpbGJhdHRsZS52MS5HYW1lVGVhbRIyCg5pbmNvbWVfY29uZmlncxgDIAEoCzIaLmxpbGJhdHRsZS52MS5JbmNvbWVDb25maWcSLAoIc2V0dGluZ3MYBCABKAsyGi5saWxiYXR0bGUudjEuR2FtZVNldHRpbmdzIrwBCgxJbmNvbWVDb25maWcSFgoOc3RhcnRpbmdfY29pbnMYASABKAUSEwoLZ2FtZV9pbmNvbWUYAiABKAUSFwoPbGFuZGJhc2VfaW5jb21lGAMgASgFEhgKEG5hdmFsYmFzZV9pbmNvbWUYBCABKAUSGgoSYWlycG9ydGJhc2VfaW5jb21lGAUgASgFEhoKEm1pc3NpbGVzaWxvX2luY29tZRgGIAEoBRIUCgxtaW5lc19pbmNvbWUYByABKAUingEKCkdhbWVQbGF5ZXISEQoJcGxheWVyX2lkGAEgASgFEg8KB3VzZXJfaWQYAiABKAkSEwoLcGxheWVyX3R5cGUYAyABKAkSDQoFY29sb3IYBCABKAkSDwoHdGVhbV9pZBgFIAEoBRIMCgRuYW1lGAYgASgJEhEKCWlzX2FjdGl2ZRgHIAEoCBIWCg5zdGFydGluZ19jb2lucxgIIAEoBSJLCghHYW1lVGVhbRIPCgd0ZWFtX2lkGAEgASgFEgwKBG5hbWUYAiABKAkSDQoFY29sb3IYAyABKAkSEQoJaXNfYWN0aXZlGAQgASgIImQKDEdhbWVTZXR0aW5ncxIVCg1hbGxvd2VkX3VuaXRzGAEgAygFEhcKD3R1cm5fdGltZV9saW1pdBgCIAEoBRIRCgl0ZWFtX21vZGUYAyABKAkSEQoJbWF4X3R1cm5zGAQgASgFIi8KC1BsYXllclN0YXRlEg0KBWNvaW5zGAEgASgFEhEKCWlzX2FjdGl2ZRgCIAEoCCLmAwoJR2FtZVN0YXRlEi4KCnVwZGF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB2dhbWVfaWQYAyABKAkSFAoMdHVybl9jb3VudGVyGAQgASgFEhYKDmN1cnJlbnRfcGxheWVyGAUgASgFEisKCndvcmxkX2RhdGEYBiABKAsyFy5saWxiYXR0bGUudjEuV29ybGREYXRhEhIKCnN0YXRlX2hhc2gYCCABKAkSDwoHdmVyc2lvbhgJIAEoAxIoCgZzdGF0dXMYCiABKA4yGC5saWxiYXR0bGUudjEuR2FtZVN0YXR1cxIQCghmaW5pc2hlZBgLIAEoCBIWCg53aW5uaW5nX3BsYXllchgMIAEoBRIUCgx3aW5uaW5nX3RlYW0YDSABKAUSHAoUY3VycmVudF9ncm91cF9udW1iZXIYDiABKAMSQAoNcGxheWVyX3N0YXRlcxgPIAMoCzIpLmxpbGJhdHRsZS52MS5HYW1lU3RhdGUuUGxheWVyU3RhdGVzRW50cnkaTgoRUGxheWVyU3RhdGVzRW50cnkSCwoDa2V5GAEgASgFEigKBXZhbHVlGAIgASgLMhkubGlsYmF0dGxlLnYxLlBsYXllclN0YXRlOgI4ASJPCg9HYW1lTW92ZUhpc3RvcnkSDwoHZ2FtZV9pZBgBIAEoCRIrCgZncm91cHMYAiADKAsyGy5saWxiYXR0bGUudjEuR2FtZU1vdmVHcm91cCKqAQoNR2FtZU1vdmVHcm91cBIuCgpzdGFydGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMZ3JvdXBfbnVtYmVyGAQgASgDEiUKBW1vdmVzGAUgAygLMhYubGlsYmF0dGxlLnYxLkdhbWVNb3ZlIuMECghHYW1lTW92ZRIOCgZwbGF5ZXIYASABKAUSFAoMZ3JvdXBfbnVtYmVyGAIgASgDEhMKC21vdmVfbnVtYmVyGAMgASgDEi0KCXRpbWVzdGFtcBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMQoJbW92ZV91bml0GAUgASgLMhwubGlsYmF0dGxlLnYxLk1vdmVVbml0QWN0aW9uSAASNQoLYXR0YWNrX3VuaXQYBiABKAsyHi5saWxiYXR0bGUudjEuQXR0YWNrVW5pdEFjdGlvbkgAEi8KCGVuZF90dXJuGAcgASgLMhsubGlsYmF0dGxlLnYxLkVuZFR1cm5BY3Rpb25IABIzCgpidWlsZF91bml0GAggASgLMh0ubGlsYmF0dGxlLnYxLkJ1aWxkVW5pdEFjdGlvbkgAEj8KEGNhcHR1cmVfYnVpbGRpbmcYDSABKAsyIy5saWxiYXR0bGUudjEuQ2FwdHVyZUJ1aWxkaW5nQWN0aW9uSAASMQoJaGVhbF91bml0GA4gASgLMhwubGlsYmF0dGxlLnYxLkhlYWxVbml0QWN0aW9uSAASLwoIZml4X3VuaXQYDyABKAsyGy5saWxiYXR0bGUudjEuRml4VW5pdEFjdGlvbkgAEhQKDHNlcXVlbmNlX251bRgJIAEoAxIUCgxpc19wZXJtYW5lbnQYCiABKAgSKgoHY2hhbmdlcxgLIAMoCzIZLmxpbGJhdHRsZS52MS5Xb3JsZENoYW5nZRITCgtkZXNjcmlwdGlvbhgMIAEoCUILCgltb3ZlX3R5cGUiLwoIUG9zaXRpb24SDQoFbGFiZWwYASABKAkSCQoBcRgCIAEoBRIJCgFyGAMgASgFIqEBCg5Nb3ZlVW5pdEFjdGlvbhIkCgRmcm9tGAEgASgLMhYubGlsYmF0dGxlLnYxLlBvc2l0aW9uEiIKAnRvGAIgASgLMhYubGlsYmF0dGxlLnYxLlBvc2l0aW9uEhUKDW1vdmVtZW50X2Nvc3QYAyABKAESLgoScmVjb25zdHJ1Y3RlZF9wYXRoGAQgASgLMhIubGlsYmF0dGxlLnYxLlBhdGgiyQEKEEF0dGFja1VuaXRBY3Rpb24SKAoIYXR0YWNrZXIYASABKAsyFi5saWxiYXR0bGUudjEuUG9zaXRpb24SKAoIZGVmZW5kZXIYAiABKAsyFi5saWxiYXR0bGUudjEuUG9zaXRpb24SGAoQdGFyZ2V0X3VuaXRfdHlwZRgHIAEoBRIaChJ0YXJnZXRfdW5pdF9oZWFsdGgYCCABKAUSEgoKY2FuX2F0dGFjaxgJIAEoCBIXCg9kYW1hZ2VfZXN0aW1hdGUYCiABKAUiVwoPQnVpbGRVbml0QWN0aW9uEiMKA3BvcxgBIAEoCzIWLmxpbGJhdHRsZS52MS5Qb3NpdGlvbhIRCgl1bml0X3R5cGUYAiABKAUSDAoEY29zdBgDIAEoBSJPChVDYXB0dXJlQnVpbGRpbmdBY3Rpb24SIwoDcG9zGAEgASgLMhYubGlsYmF0dGxlLnYxLlBvc2l0aW9uEhEKCXRpbGVfdHlwZRgDIAEoBSIPCg1FbmRUdXJuQWN0aW9uIkoKDkhlYWxVbml0QWN0aW9uEiMKA3BvcxgBIAEoCzIWLmxpbGJhdHRsZS52MS5Qb3NpdGlvbhITCgtoZWFsX2Ftb3VudBgCIAEoBSJyCg1GaXhVbml0QWN0aW9uEiUKBWZpeGVyGAEgASgLMhYubGlsYmF0dGxlLnYxLlBvc2l0aW9uEiYKBnRhcmdldBgCIAEoCzIWLmxpbGJhdHRsZS52MS5Qb3NpdGlvbhISCgpmaXhfYW1vdW50GAMgASgFItQECgtXb3JsZENoYW5nZRIzCgp1bml0X21vdmVkGAEgASgLMh0ubGlsYmF0dGxlLnYxLlVuaXRNb3ZlZENoYW5nZUgAEjcKDHVuaXRfZGFtYWdlZBgCIAEoCzIfLmxpbGJhdHRsZS52MS5Vbml0RGFtYWdlZENoYW5nZUgAEjUKC3VuaXRfa2lsbGVkGAMgASgLMh4ubGlsYmF0dGxlLnYxLlVuaXRLaWxsZWRDaGFuZ2VIABI7Cg5wbGF5ZXJfY2hhbmdlZBgEIAEoCzIhLmxpbGJhdHRsZS52MS5QbGF5ZXJDaGFuZ2VkQ2hhbmdlSAASMwoKdW5pdF9idWlsdBgFIAEoCzIdLmxpbGJhdHRsZS52MS5Vbml0QnVpbHRDaGFuZ2VIABI5Cg1jb2luc19jaGFuZ2VkGAYgASgLMiAubGlsYmF0dGxlLnYxLkNvaW5zQ2hhbmdlZENoYW5nZUgAEjkKDXRpbGVfY2FwdHVyZWQYByABKAsyIC5saWxiYXR0bGUudjEuVGlsZUNhcHR1cmVkQ2hhbmdlSAASPQoPY2FwdHVyZV9zdGFydGVkGAggASgLMiIubGlsYmF0dGxlLnYxLkNhcHR1cmVTdGFydGVkQ2hhbmdlSAASNQoLdW5pdF9oZWFsZWQYCSABKAsyHi5saWxiYXR0bGUudjEuVW5pdEhlYWxlZENoYW5nZUgAEjMKCnVuaXRfZml4ZWQYCiABKAsyHS5saWxiYXR0bGUudjEuVW5pdEZpeGVkQ2hhbmdlSABCDQoLY2hhbmdlX3R5cGUifAoQVW5pdEhlYWxlZENoYW5nZRIpCg1wcmV2aW91c191bml0GAEgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSKAoMdXBkYXRlZF91bml0GAIgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSEwoLaGVhbF9hbW91bnQYAyABKAUipgEKD1VuaXRGaXhlZENoYW5nZRImCgpmaXhlcl91bml0GAEgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSKwoPcHJldmlvdXNfdGFyZ2V0GAIgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSKgoOdXBkYXRlZF90YXJnZXQYAyABKAsyEi5saWxiYXR0bGUudjEuVW5pdBISCgpmaXhfYW1vdW50GAQgASgFImYKD1VuaXRNb3ZlZENoYW5nZRIpCg1wcmV2aW91c191bml0GAYgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSKAoMdXBkYXRlZF91bml0GAcgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQiaAoRVW5pdERhbWFnZWRDaGFuZ2USKQoNcHJldmlvdXNfdW5pdBgGIAEoCzISLmxpbGJhdHRsZS52MS5Vbml0EigKDHVwZGF0ZWRfdW5pdBgHIAEoCzISLmxpbGJhdHRsZS52MS5Vbml0Ij0KEFVuaXRLaWxsZWRDaGFuZ2USKQoNcHJldmlvdXNfdW5pdBgGIAEoCzISLmxpbGJhdHRsZS52MS5Vbml0IpQBChNQbGF5ZXJDaGFuZ2VkQ2hhbmdlEhcKD3ByZXZpb3VzX3BsYXllchgBIAEoBRISCgpuZXdfcGxheWVyGAIgASgFEhUKDXByZXZpb3VzX3R1cm4YAyABKAUSEAoIbmV3X3R1cm4YBCABKAUSJwoLcmVzZXRfdW5pdHMYBSADKAsyEi5saWxiYXR0bGUudjEuVW5pdCJ9Cg9Vbml0QnVpbHRDaGFuZ2USIAoEdW5pdBgBIAEoCzISLmxpbGJhdHRsZS52MS5Vbml0Eg4KBnRpbGVfcRgCIAEoBRIOCgZ0aWxlX3IYAyABKAUSEgoKY29pbnNfY29zdBgEIAEoBRIUCgxwbGF5ZXJfY29pbnMYBSABKAUiYgoSQ29pbnNDaGFuZ2VkQ2hhbmdlEhEKCXBsYXllcl9pZBgBIAEoBRIWCg5wcmV2aW91c19jb2lucxgCIAEoBRIRCgluZXdfY29pbnMYAyABKAUSDgoGcmVhc29uGAQgASgJIp4BChJUaWxlQ2FwdHVyZWRDaGFuZ2USKgoOY2FwdHVyaW5nX3VuaXQYASABKAsyEi5saWxiYXR0bGUudjEuVW5pdBIOCgZ0aWxlX3EYAiABKAUSDgoGdGlsZV9yGAMgASgFEhEKCXRpbGVfdHlwZRgEIAEoBRIWCg5wcmV2aW91c19vd25lchgFIAEoBRIRCgluZXdfb3duZXIYBiABKAUijAEKFENhcHR1cmVTdGFydGVkQ2hhbmdlEioKDmNhcHR1cmluZ191bml0GAEgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSDgoGdGlsZV9xGAIgASgFEg4KBnRpbGVfchgDIAEoBRIRCgl0aWxlX3R5cGUYBCABKAUSFQoNY3VycmVudF9vd25lchgFIAEoBSKmAQoIQWxsUGF0aHMSEAoIc291cmNlX3EYASABKAUSEAoIc291cmNlX3IYAiABKAUSMAoFZWRnZXMYAyADKAsyIS5saWxiYXR0bGUudjEuQWxsUGF0aHMuRWRnZXNFbnRyeRpECgpFZGdlc0VudHJ5EgsKA2tleRgBIAEoCRIlCgV2YWx1ZRgCIAEoCzIWLmxpbGJhdHRsZS52MS5QYXRoRWRnZToCOAEisQEKCFBhdGhFZGdlEg4KBmZyb21fcRgBIAEoBRIOCgZmcm9tX3IYAiABKAUSDAoEdG9fcRgDIAEoBRIMCgR0b19yGAQgASgFEhUKDW1vdmVtZW50X2Nvc3QYBSABKAESEgoKdG90YWxfY29zdBgGIAEoARIUCgx0ZXJyYWluX3R5cGUYByABKAkSEwoLZXhwbGFuYXRpb24YCCABKAkSEwoLaXNfb2NjdXBpZWQYCSABKAgicgoEUGF0aBIlCgVlZGdlcxgBIAMoCzIWLmxpbGJhdHRsZS52MS5QYXRoRWRnZRIvCgpkaXJlY3Rpb25zGAIgAygOMhsubGlsYmF0dGxlLnYxLlBhdGhEaXJlY3Rpb24SEgoKdG90YWxfY29zdBgDIAEoASpfCgxDcm9zc2luZ1R5cGUSHQoZQ1JPU1NJTkdfVFlQRV9VTlNQRUNJRklFRBAAEhYKEkNST1NTSU5HX1RZUEVfUk9BRBABEhgKFENST1NTSU5HX1RZUEVfQlJJREdFEAIqowEKC1RlcnJhaW5UeXBlEhwKGFRFUlJBSU5fVFlQRV9VTlNQRUNJRklFRBAAEhUKEVRFUlJBSU5fVFlQRV9DSVRZEAESFwoTVEVSUkFJTl9UWVBFX05BVFVSRRACEhcKE1RFUlJBSU5fVFlQRV9CUklER0UQAxIWChJURVJSQUlOX1RZUEVfV0FURVIQBBIVChFURVJSQUlOX1RZUEVfUk9BRBAFKnEKCkdhbWVTdGF0dXMSGwoXR0FNRV9TVEFUVVNfVU5TUEVDSUZJRUQQABIXChNHQU1FX1NUQVRVU19QTEFZSU5HEAESFgoSR0FNRV9TVEFUVVNfUEFVU0VEEAISFQoRR0FNRV9TVEFUVVNfRU5ERUQQAyreAQoNUGF0aERpcmVjdGlvbhIeChpQQVRIX0RJUkVDVElPTl9VTlNQRUNJRklFRBAAEhcKE1BBVEhfRElSRUNUSU9OX0xFRlQQARIbChdQQVRIX0RJUkVDVElPTl9UT1BfTEVGVBACEhwKGFBBVEhfRElSRUNUSU9OX1RPUF9SSUdIVBADEhgKFFBBVEhfRElSRUNUSU9OX1JJR0hUEAQSHwobUEFUSF9ESVJFQ1RJT05fQk9UVE9NX1JJR0hUEAUSHgoaUEFUSF9ESVJFQ1RJT05fQk9UVE9NX0xFRlQQBkK3AQoQY29tLmxpbGJhdHRsZS52MUILTW9kZWxzUHJvdG9QAVpFZ2l0aHViLmNvbS90dXJuZm9yZ2UvbGlsYmF0dGxlL2dlbi9nby9saWxiYXR0bGUvdjEvbW9kZWxzO2xpbGJhdHRsZXYxogIDTFhYqgIMTGlsYmF0dGxlLlYxygIMTGlsYmF0dGxlXFYx4gIYTGlsYmF0dGxlXFYxXEdQQk1ldGFkYXRh6gINTGlsYmF0dGxlOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_struct]);
+  fileDesc("CiBsaWxiYXR0bGUvdjEvbW9kZWxzL21vZGVscy5wcm90bxIMbGlsYmF0dGxlLnYxIo0BCglJbmRleEluZm8SMwoPbGFzdF91cGRhdGVkX2F0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIzCg9sYXN0X2luZGV4ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhYKDm5lZWRzX2luZGV4aW5nGAMgASgIIkYKClBhZ2luYXRpb24SEAoIcGFnZV9rZXkYASABKAkSEwoLcGFnZV9vZmZzZXQYAiABKAUSEQoJcGFnZV9zaXplGAMgASgFIm4KElBhZ2luYXRpb25SZXNwb25zZRIVCg1uZXh0X3BhZ2Vfa2V5GAIgASgJEhgKEG5leHRfcGFnZV9vZmZzZXQYAyABKAUSEAoIaGFzX21vcmUYBCABKAgSFQoNdG90YWxfcmVzdWx0cxgFIAEoBSKqAwoFV29ybGQSLgoKY3JlYXRlZF9hdBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDwoHdmVyc2lvbhgDIAEoAxIKCgJpZBgEIAEoCRISCgpjcmVhdG9yX2lkGAUgASgJEgwKBG5hbWUYBiABKAkSEwoLZGVzY3JpcHRpb24YByABKAkSDAoEdGFncxgIIAMoCRIRCglpbWFnZV91cmwYCSABKAkSEgoKZGlmZmljdWx0eRgKIAEoCRIUCgxwcmV2aWV3X3VybHMYCyADKAkSPAoTZGVmYXVsdF9nYW1lX2NvbmZpZxgMIAEoCzIfLmxpbGJhdHRsZS52MS5HYW1lQ29uZmlndXJhdGlvbhIyChFzZWFyY2hfaW5kZXhfaW5mbxgNIAEoCzIXLmxpbGJhdHRsZS52MS5JbmRleEluZm8SFwoPcGFyZW50X3dvcmxkX2lkGA4gASgJEhcKD3BhcmVudF9yZXZpc2lvbhgPIAEoAyLtAwoJV29ybGREYXRhEjgKCXRpbGVzX21hcBgBIAMoCzIlLmxpbGJhdHRsZS52MS5Xb3JsZERhdGEuVGlsZXNNYXBFbnRyeRI4Cgl1bml0c19tYXAYAiADKAsyJS5saWxiYXR0bGUudjEuV29ybGREYXRhLlVuaXRzTWFwRW50cnkSNgoVc2NyZWVuc2hvdF9pbmRleF9pbmZvGAMgASgLMhcubGlsYmF0dGxlLnYxLkluZGV4SW5mbxIUCgxjb250ZW50X2hhc2gYBCABKAkSDwoHdmVyc2lvbhgFIAEoAxI5Cgljcm9zc2luZ3MYCCADKAsyJi5saWxiYXR0bGUudjEuV29ybGREYXRhLkNyb3NzaW5nc0VudHJ5GkMKDVRpbGVzTWFwRW50cnkSCwoDa2V5GAEgASgJEiEKBXZhbHVlGAIgASgLMhIubGlsYmF0dGxlLnYxLlRpbGU6AjgBGkMKDVVuaXRzTWFwRW50cnkSCwoDa2V5GAEgASgJEiEKBXZhbHVlGAIgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQ6AjgBGkgKDkNyb3NzaW5nc0VudHJ5EgsKA2tleRgBIAEoCRIlCgV2YWx1ZRgCIAEoCzIWLmxpbGJhdHRsZS52MS5Dcm9zc2luZzoCOAEiSQoIQ3Jvc3NpbmcSKAoEdHlwZRgBIAEoDjIaLmxpbGJhdHRsZS52MS5Dcm9zc2luZ1R5cGUSEwoLY29ubmVjdHNfdG8YAiADKAgihgEKBFRpbGUSCQoBcRgBIAEoBRIJCgFyGAIgASgFEhEKCXRpbGVfdHlwZRgDIAEoBRIOCgZwbGF5ZXIYBCABKAUSEAoIc2hvcnRjdXQYBSABKAkSFwoPbGFzdF9hY3RlZF90dXJuGAYgASgFEhoKEmxhc3RfdG9wcGVkdXBfdHVybhgHIAEoBSLjAgoEVW5pdBIJCgFxGAEgASgFEgkKAXIYAiABKAUSDgoGcGxheWVyGAMgASgFEhEKCXVuaXRfdHlwZRgEIAEoBRIQCghzaG9ydGN1dBgFIAEoCRIYChBhdmFpbGFibGVfaGVhbHRoGAYgASgFEhUKDWRpc3RhbmNlX2xlZnQYByABKAESFwoPbGFzdF9hY3RlZF90dXJuGAggASgFEhoKEmxhc3RfdG9wcGVkdXBfdHVybhgJIAEoBRIiChphdHRhY2tzX3JlY2VpdmVkX3RoaXNfdHVybhgKIAEoBRIyCg5hdHRhY2tfaGlzdG9yeRgLIAMoCzIaLmxpbGJhdHRsZS52MS5BdHRhY2tSZWNvcmQSGAoQcHJvZ3Jlc3Npb25fc3RlcBgMIAEoBRIaChJjaG9zZW5fYWx0ZXJuYXRpdmUYDSABKAkSHAoUY2FwdHVyZV9zdGFydGVkX3R1cm4YDiABKAUiTAoMQXR0YWNrUmVjb3JkEgkKAXEYASABKAUSCQoBchgCIAEoBRIRCglpc19yYW5nZWQYAyABKAgSEwoLdHVybl9udW1iZXIYBCABKAUirwIKEVRlcnJhaW5EZWZpbml0aW9uEgoKAmlkGAEgASgFEgwKBG5hbWUYAiABKAkSDAoEdHlwZRgFIAEoBRITCgtkZXNjcmlwdGlvbhgGIAEoCRJMCg91bml0X3Byb3BlcnRpZXMYByADKAsyMy5saWxiYXR0bGUudjEuVGVycmFpbkRlZmluaXRpb24uVW5pdFByb3BlcnRpZXNFbnRyeRIaChJidWlsZGFibGVfdW5pdF9pZHMYCCADKAUSFwoPaW5jb21lX3Blcl90dXJuGAkgASgFGloKE1VuaXRQcm9wZXJ0aWVzRW50cnkSCwoDa2V5GAEgASgFEjIKBXZhbHVlGAIgASgLMiMubGlsYmF0dGxlLnYxLlRlcnJhaW5Vbml0UHJvcGVydGllczoCOAEi+gUKDlVuaXREZWZpbml0aW9uEgoKAmlkGAEgASgFEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDgoGaGVhbHRoGAQgASgFEg0KBWNvaW5zGAUgASgFEhcKD21vdmVtZW50X3BvaW50cxgGIAEoARIWCg5yZXRyZWF0X3BvaW50cxgHIAEoARIPCgdkZWZlbnNlGAggASgFEhQKDGF0dGFja19yYW5nZRgJIAEoBRIYChBtaW5fYXR0YWNrX3JhbmdlGAogASgFEhUKDXNwbGFzaF9kYW1hZ2UYCyABKAUSTwoSdGVycmFpbl9wcm9wZXJ0aWVzGAwgAygLMjMubGlsYmF0dGxlLnYxLlVuaXREZWZpbml0aW9uLlRlcnJhaW5Qcm9wZXJ0aWVzRW50cnkSEgoKcHJvcGVydGllcxgNIAMoCRISCgp1bml0X2NsYXNzGA4gASgJEhQKDHVuaXRfdGVycmFpbhgPIAEoCRJICg9hdHRhY2tfdnNfY2xhc3MYECADKAsyLy5saWxiYXR0bGUudjEuVW5pdERlZmluaXRpb24uQXR0YWNrVnNDbGFzc0VudHJ5EhQKDGFjdGlvbl9vcmRlchgRIAMoCRJFCg1hY3Rpb25fbGltaXRzGBIgAygLMi4ubGlsYmF0dGxlLnYxLlVuaXREZWZpbml0aW9uLkFjdGlvbkxpbWl0c0VudHJ5EhEKCWZpeF92YWx1ZRgTIAEoBRpdChZUZXJyYWluUHJvcGVydGllc0VudHJ5EgsKA2tleRgBIAEoBRIyCgV2YWx1ZRgCIAEoCzIjLmxpbGJhdHRsZS52MS5UZXJyYWluVW5pdFByb3BlcnRpZXM6AjgBGjQKEkF0dGFja1ZzQ2xhc3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBGjMKEUFjdGlvbkxpbWl0c0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEi7wEKFVRlcnJhaW5Vbml0UHJvcGVydGllcxISCgp0ZXJyYWluX2lkGAEgASgFEg8KB3VuaXRfaWQYAiABKAUSFQoNbW92ZW1lbnRfY29zdBgDIAEoARIVCg1oZWFsaW5nX2JvbnVzGAQgASgFEhEKCWNhbl9idWlsZBgFIAEoCBITCgtjYW5fY2FwdHVyZRgGIAEoCBIUCgxhdHRhY2tfYm9udXMYByABKAUSFQoNZGVmZW5zZV9ib251cxgIIAEoBRIUCgxhdHRhY2tfcmFuZ2UYCSABKAUSGAoQbWluX2F0dGFja19yYW5nZRgKIAEoBSLWAQoSVW5pdFVuaXRQcm9wZXJ0aWVzEhMKC2F0dGFja2VyX2lkGAEgASgFEhMKC2RlZmVuZGVyX2lkGAIgASgFEhwKD2F0dGFja19vdmVycmlkZRgDIAEoBUgAiAEBEh0KEGRlZmVuc2Vfb3ZlcnJpZGUYBCABKAVIAYgBARIwCgZkYW1hZ2UYBSABKAsyIC5saWxiYXR0bGUudjEuRGFtYWdlRGlzdHJpYnV0aW9uQhIKEF9hdHRhY2tfb3ZlcnJpZGVCEwoRX2RlZmVuc2Vfb3ZlcnJpZGUigAEKEkRhbWFnZURpc3RyaWJ1dGlvbhISCgptaW5fZGFtYWdlGAEgASgBEhIKCm1heF9kYW1hZ2UYAiABKAESFwoPZXhwZWN0ZWRfZGFtYWdlGAMgASgBEikKBnJhbmdlcxgEIAMoCzIZLmxpbGJhdHRsZS52MS5EYW1hZ2VSYW5nZSJICgtEYW1hZ2VSYW5nZRIRCgltaW5fdmFsdWUYASABKAESEQoJbWF4X3ZhbHVlGAIgASgBEhMKC3Byb2JhYmlsaXR5GAMgASgBIpcGCgtSdWxlc0VuZ2luZRIzCgV1bml0cxgBIAMoCzIkLmxpbGJhdHRsZS52MS5SdWxlc0VuZ2luZS5Vbml0c0VudHJ5EjkKCHRlcnJhaW5zGAIgAygLMicubGlsYmF0dGxlLnYxLlJ1bGVzRW5naW5lLlRlcnJhaW5zRW50cnkSVQoXdGVycmFpbl91bml0X3Byb3BlcnRpZXMYAyADKAsyNC5saWxiYXR0bGUudjEuUnVsZXNFbmdpbmUuVGVycmFpblVuaXRQcm9wZXJ0aWVzRW50cnkSTwoUdW5pdF91bml0X3Byb3BlcnRpZXMYBCADKAsyMS5saWxiYXR0bGUudjEuUnVsZXNFbmdpbmUuVW5pdFVuaXRQcm9wZXJ0aWVzRW50cnkSQgoNdGVycmFpbl90eXBlcxgFIAMoCzIrLmxpbGJhdHRsZS52MS5SdWxlc0VuZ2luZS5UZXJyYWluVHlwZXNFbnRyeRpKCgpVbml0c0VudHJ5EgsKA2tleRgBIAEoBRIrCgV2YWx1ZRgCIAEoCzIcLmxpbGJhdHRsZS52MS5Vbml0RGVmaW5pdGlvbjoCOAEaUAoNVGVycmFpbnNFbnRyeRILCgNrZXkYASABKAUSLgoFdmFsdWUYAiABKAsyHy5saWxiYXR0bGUudjEuVGVycmFpbkRlZmluaXRpb246AjgBGmEKGlRlcnJhaW5Vbml0UHJvcGVydGllc0VudHJ5EgsKA2tleRgBIAEoCRIyCgV2YWx1ZRgCIAEoCzIjLmxpbGJhdHRsZS52MS5UZXJyYWluVW5pdFByb3BlcnRpZXM6AjgBGlsKF1VuaXRVbml0UHJvcGVydGllc0VudHJ5EgsKA2tleRgBIAEoCRIvCgV2YWx1ZRgCIAEoCzIgLmxpbGJhdHRsZS52MS5Vbml0VW5pdFByb3BlcnRpZXM6AjgBGk4KEVRlcnJhaW5UeXBlc0VudHJ5EgsKA2tleRgBIAEoBRIoCgV2YWx1ZRgCIAEoDjIZLmxpbGJhdHRsZS52MS5UZXJyYWluVHlwZToCOAEiqwMKBEdhbWUSLgoKY3JlYXRlZF9hdBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDwoHdmVyc2lvbhgDIAEoAxIKCgJpZBgEIAEoCRISCgpjcmVhdG9yX2lkGAUgASgJEhAKCHdvcmxkX2lkGAYgASgJEgwKBG5hbWUYByABKAkSEwoLZGVzY3JpcHRpb24YCCABKAkSDAoEdGFncxgJIAMoCRIRCglpbWFnZV91cmwYCiABKAkSEgoKZGlmZmljdWx0eRgLIAEoCRIvCgZjb25maWcYDCABKAsyHy5saWxiYXR0bGUudjEuR2FtZUNvbmZpZ3VyYXRpb24SFAoMcHJldmlld191cmxzGA0gAygJEjIKEXNlYXJjaF9pbmRleF9pbmZvGA8gASgLMhcubGlsYmF0dGxlLnYxLkluZGV4SW5mbxIWCg53b3JsZF9yZXZpc2lvbhgQIAEoAxIVCg1ydWxlc192ZXJzaW9uGBEgASgJIscBChFHYW1lQ29uZmlndXJhdGlvbhIpCgdwbGF5ZXJzGAEgAygLMhgubGlsYmF0dGxlLnYxLkdhbWVQbGF5ZXISJQoFdGVhbXMYAiADKAsyFi5saWxiYXR0bGUudjEuR2FtZVRlYW0SMgoOaW5jb21lX2NvbmZpZ3MYAyABKAsyGi5saWxiYXR0bGUudjEuSW5jb21lQ29uZmlnEiwKCHNldHRpbmdzGAQgASgLMhoubGlsYmF0dGxlLnYxLkdhbWVTZXR0aW5ncyK8AQoMSW5jb21lQ29uZmlnEhYKDnN0YXJ0aW5nX2NvaW5zGAEgASgFEhMKC2dhbWVfaW5jb21lGAIgASgFEhcKD2xhbmRiYXNlX2luY29tZRgDIAEoBRIYChBuYXZhbGJhc2VfaW5jb21lGAQgASgFEhoKEmFpcnBvcnRiYXNlX2luY29tZRgFIAEoBRIaChJtaXNzaWxlc2lsb19pbmNvbWUYBiABKAUSFAoMbWluZXNfaW5jb21lGAcgASgFIp4BCgpHYW1lUGxheWVyEhEKCXBsYXllcl9pZBgBIAEoBRIPCgd1c2VyX2lkGAIgASgJEhMKC3BsYXllcl90eXBlGAMgASgJEg0KBWNvbG9yGAQgASgJEg8KB3RlYW1faWQYBSABKAUSDAoEbmFtZRgGIAEoCRIRCglpc19hY3RpdmUYByABKAgSFgoOc3RhcnRpbmdfY29pbnMYCCABKAUiSwoIR2FtZVRlYW0SDwoHdGVhbV9pZBgBIAEoBRIMCgRuYW1lGAIgASgJEg0KBWNvbG9yGAMgASgJEhEKCWlzX2FjdGl2ZRgEIAEoCCLXAQoMR2FtZVNldHRpbmdzEhUKDWFsbG93ZWRfdW5pdHMYASADKAUSFwoPdHVybl90aW1lX2xpbWl0GAIgASgFEhEKCXRlYW1fbW9kZRgDIAEoCRIRCgltYXhfdHVybnMYBCABKAUSEQoJdHVybl9tb2RlGAUgASgJEhQKDHNoYXJlZF9jb2lucxgGIAEoCBIWCg5zaGFyZWRfY29udHJvbBgHIAEoCBIWCg5hbGxpZWRfc3VwcG9ydBgIIAEoCBIYChBjb21iaW5lZF92aWN0b3J5GAkgASgIIi8KC1BsYXllclN0YXRlEg0KBWNvaW5zGAEgASgFEhEKCWlzX2FjdGl2ZRgCIAEoCCL8BAoJR2FtZVN0YXRlEi4KCnVwZGF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB2dhbWVfaWQYAyABKAkSFAoMdHVybl9jb3VudGVyGAQgASgFEhYKDmN1cnJlbnRfcGxheWVyGAUgASgFEisKCndvcmxkX2RhdGEYBiABKAsyFy5saWxiYXR0bGUudjEuV29ybGREYXRhEhIKCnN0YXRlX2hhc2gYCCABKAkSDwoHdmVyc2lvbhgJIAEoAxIoCgZzdGF0dXMYCiABKA4yGC5saWxiYXR0bGUudjEuR2FtZVN0YXR1cxIQCghmaW5pc2hlZBgLIAEoCBIWCg53aW5uaW5nX3BsYXllchgMIAEoBRIUCgx3aW5uaW5nX3RlYW0YDSABKAUSHAoUY3VycmVudF9ncm91cF9udW1iZXIYDiABKAMSQAoNcGxheWVyX3N0YXRlcxgPIAMoCzIpLmxpbGJhdHRsZS52MS5HYW1lU3RhdGUuUGxheWVyU3RhdGVzRW50cnkSQgoOcGVuZGluZ19vcmRlcnMYECADKAsyKi5saWxiYXR0bGUudjEuR2FtZVN0YXRlLlBlbmRpbmdPcmRlcnNFbnRyeRpOChFQbGF5ZXJTdGF0ZXNFbnRyeRILCgNrZXkYASABKAUSKAoFdmFsdWUYAiABKAsyGS5saWxiYXR0bGUudjEuUGxheWVyU3RhdGU6AjgBGlAKElBlbmRpbmdPcmRlcnNFbnRyeRILCgNrZXkYASABKAUSKQoFdmFsdWUYAiABKAsyGi5saWxiYXR0bGUudjEuUGxheWVyT3JkZXJzOgI4ASJPCg9HYW1lTW92ZUhpc3RvcnkSDwoHZ2FtZV9pZBgBIAEoCRIrCgZncm91cHMYAiADKAsyGy5saWxiYXR0bGUudjEuR2FtZU1vdmVHcm91cCKqAQoNR2FtZU1vdmVHcm91cBIuCgpzdGFydGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMZ3JvdXBfbnVtYmVyGAQgASgDEiUKBW1vdmVzGAUgAygLMhYubGlsYmF0dGxlLnYxLkdhbWVNb3ZlIuMECghHYW1lTW92ZRIOCgZwbGF5ZXIYASABKAUSFAoMZ3JvdXBfbnVtYmVyGAIgASgDEhMKC21vdmVfbnVtYmVyGAMgASgDEi0KCXRpbWVzdGFtcBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMQoJbW92ZV91bml0GAUgASgLMhwubGlsYmF0dGxlLnYxLk1vdmVVbml0QWN0aW9uSAASNQoLYXR0YWNrX3VuaXQYBiABKAsyHi5saWxiYXR0bGUudjEuQXR0YWNrVW5pdEFjdGlvbkgAEi8KCGVuZF90dXJuGAcgASgLMhsubGlsYmF0dGxlLnYxLkVuZFR1cm5BY3Rpb25IABIzCgpidWlsZF91bml0GAggASgLMh0ubGlsYmF0dGxlLnYxLkJ1aWxkVW5pdEFjdGlvbkgAEj8KEGNhcHR1cmVfYnVpbGRpbmcYDSABKAsyIy5saWxiYXR0bGUudjEuQ2FwdHVyZUJ1aWxkaW5nQWN0aW9uSAASMQoJaGVhbF91bml0GA4gASgLMhwubGlsYmF0dGxlLnYxLkhlYWxVbml0QWN0aW9uSAASLwoIZml4X3VuaXQYDyABKAsyGy5saWxiYXR0bGUudjEuRml4VW5pdEFjdGlvbkgAEhQKDHNlcXVlbmNlX251bRgJIAEoAxIUCgxpc19wZXJtYW5lbnQYCiABKAgSKgoHY2hhbmdlcxgLIAMoCzIZLmxpbGJhdHRsZS52MS5Xb3JsZENoYW5nZRITCgtkZXNjcmlwdGlvbhgMIAEoCUILCgltb3ZlX3R5cGUiLwoIUG9zaXRpb24SDQoFbGFiZWwYASABKAkSCQoBcRgCIAEoBRIJCgFyGAMgASgFIqEBCg5Nb3ZlVW5pdEFjdGlvbhIkCgRmcm9tGAEgASgLMhYubGlsYmF0dGxlLnYxLlBvc2l0aW9uEiIKAnRvGAIgASgLMhYubGlsYmF0dGxlLnYxLlBvc2l0aW9uEhUKDW1vdmVtZW50X2Nvc3QYAyABKAESLgoScmVjb25zdHJ1Y3RlZF9wYXRoGAQgASgLMhIubGlsYmF0dGxlLnYxLlBhdGgiyQEKEEF0dGFja1VuaXRBY3Rpb24SKAoIYXR0YWNrZXIYASABKAsyFi5saWxiYXR0bGUudjEuUG9zaXRpb24SKAoIZGVmZW5kZXIYAiABKAsyFi5saWxiYXR0bGUudjEuUG9zaXRpb24SGAoQdGFyZ2V0X3VuaXRfdHlwZRgHIAEoBRIaChJ0YXJnZXRfdW5pdF9oZWFsdGgYCCABKAUSEgoKY2FuX2F0dGFjaxgJIAEoCBIXCg9kYW1hZ2VfZXN0aW1hdGUYCiABKAUiVwoPQnVpbGRVbml0QWN0aW9uEiMKA3BvcxgBIAEoCzIWLmxpbGJhdHRsZS52MS5Qb3NpdGlvbhIRCgl1bml0X3R5cGUYAiABKAUSDAoEY29zdBgDIAEoBSJPChVDYXB0dXJlQnVpbGRpbmdBY3Rpb24SIwoDcG9zGAEgASgLMhYubGlsYmF0dGxlLnYxLlBvc2l0aW9uEhEKCXRpbGVfdHlwZRgDIAEoBSIPCg1FbmRUdXJuQWN0aW9uIkoKDkhlYWxVbml0QWN0aW9uEiMKA3BvcxgBIAEoCzIWLmxpbGJhdHRsZS52MS5Qb3NpdGlvbhITCgtoZWFsX2Ftb3VudBgCIAEoBSJyCg1GaXhVbml0QWN0aW9uEiUKBWZpeGVyGAEgASgLMhYubGlsYmF0dGxlLnYxLlBvc2l0aW9uEiYKBnRhcmdldBgCIAEoCzIWLmxpbGJhdHRsZS52MS5Qb3NpdGlvbhISCgpmaXhfYW1vdW50GAMgASgFItQECgtXb3JsZENoYW5nZRIzCgp1bml0X21vdmVkGAEgASgLMh0ubGlsYmF0dGxlLnYxLlVuaXRNb3ZlZENoYW5nZUgAEjcKDHVuaXRfZGFtYWdlZBgCIAEoCzIfLmxpbGJhdHRsZS52MS5Vbml0RGFtYWdlZENoYW5nZUgAEjUKC3VuaXRfa2lsbGVkGAMgASgLMh4ubGlsYmF0dGxlLnYxLlVuaXRLaWxsZWRDaGFuZ2VIABI7Cg5wbGF5ZXJfY2hhbmdlZBgEIAEoCzIhLmxpbGJhdHRsZS52MS5QbGF5ZXJDaGFuZ2VkQ2hhbmdlSAASMwoKdW5pdF9idWlsdBgFIAEoCzIdLmxpbGJhdHRsZS52MS5Vbml0QnVpbHRDaGFuZ2VIABI5Cg1jb2luc19jaGFuZ2VkGAYgASgLMiAubGlsYmF0dGxlLnYxLkNvaW5zQ2hhbmdlZENoYW5nZUgAEjkKDXRpbGVfY2FwdHVyZWQYByABKAsyIC5saWxiYXR0bGUudjEuVGlsZUNhcHR1cmVkQ2hhbmdlSAASPQoPY2FwdHVyZV9zdGFydGVkGAggASgLMiIubGlsYmF0dGxlLnYxLkNhcHR1cmVTdGFydGVkQ2hhbmdlSAASNQoLdW5pdF9oZWFsZWQYCSABKAsyHi5saWxiYXR0bGUudjEuVW5pdEhlYWxlZENoYW5nZUgAEjMKCnVuaXRfZml4ZWQYCiABKAsyHS5saWxiYXR0bGUudjEuVW5pdEZpeGVkQ2hhbmdlSABCDQoLY2hhbmdlX3R5cGUifAoQVW5pdEhlYWxlZENoYW5nZRIpCg1wcmV2aW91c191bml0GAEgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSKAoMdXBkYXRlZF91bml0GAIgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSEwoLaGVhbF9hbW91bnQYAyABKAUipgEKD1VuaXRGaXhlZENoYW5nZRImCgpmaXhlcl91bml0GAEgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSKwoPcHJldmlvdXNfdGFyZ2V0GAIgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSKgoOdXBkYXRlZF90YXJnZXQYAyABKAsyEi5saWxiYXR0bGUudjEuVW5pdBISCgpmaXhfYW1vdW50GAQgASgFImYKD1VuaXRNb3ZlZENoYW5nZRIpCg1wcmV2aW91c191bml0GAYgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSKAoMdXBkYXRlZF91bml0GAcgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQiaAoRVW5pdERhbWFnZWRDaGFuZ2USKQoNcHJldmlvdXNfdW5pdBgGIAEoCzISLmxpbGJhdHRsZS52MS5Vbml0EigKDHVwZGF0ZWRfdW5pdBgHIAEoCzISLmxpbGJhdHRsZS52MS5Vbml0Ij0KEFVuaXRLaWxsZWRDaGFuZ2USKQoNcHJldmlvdXNfdW5pdBgGIAEoCzISLmxpbGJhdHRsZS52MS5Vbml0IpQBChNQbGF5ZXJDaGFuZ2VkQ2hhbmdlEhcKD3ByZXZpb3VzX3BsYXllchgBIAEoBRISCgpuZXdfcGxheWVyGAIgASgFEhUKDXByZXZpb3VzX3R1cm4YAyABKAUSEAoIbmV3X3R1cm4YBCABKAUSJwoLcmVzZXRfdW5pdHMYBSADKAsyEi5saWxiYXR0bGUudjEuVW5pdCJ9Cg9Vbml0QnVpbHRDaGFuZ2USIAoEdW5pdBgBIAEoCzISLmxpbGJhdHRsZS52MS5Vbml0Eg4KBnRpbGVfcRgCIAEoBRIOCgZ0aWxlX3IYAyABKAUSEgoKY29pbnNfY29zdBgEIAEoBRIUCgxwbGF5ZXJfY29pbnMYBSABKAUiYgoSQ29pbnNDaGFuZ2VkQ2hhbmdlEhEKCXBsYXllcl9pZBgBIAEoBRIWCg5wcmV2aW91c19jb2lucxgCIAEoBRIRCgluZXdfY29pbnMYAyABKAUSDgoGcmVhc29uGAQgASgJIp4BChJUaWxlQ2FwdHVyZWRDaGFuZ2USKgoOY2FwdHVyaW5nX3VuaXQYASABKAsyEi5saWxiYXR0bGUudjEuVW5pdBIOCgZ0aWxlX3EYAiABKAUSDgoGdGlsZV9yGAMgASgFEhEKCXRpbGVfdHlwZRgEIAEoBRIWCg5wcmV2aW91c19vd25lchgFIAEoBRIRCgluZXdfb3duZXIYBiABKAUijAEKFENhcHR1cmVTdGFydGVkQ2hhbmdlEioKDmNhcHR1cmluZ191bml0GAEgASgLMhIubGlsYmF0dGxlLnYxLlVuaXQSDgoGdGlsZV9xGAIgASgFEg4KBnRpbGVfchgDIAEoBRIRCgl0aWxlX3R5cGUYBCABKAUSFQoNY3VycmVudF9vd25lchgFIAEoBSKmAQoIQWxsUGF0aHMSEAoIc291cmNlX3EYASABKAUSEAoIc291cmNlX3IYAiABKAUSMAoFZWRnZXMYAyADKAsyIS5saWxiYXR0bGUudjEuQWxsUGF0aHMuRWRnZXNFbnRyeRpECgpFZGdlc0VudHJ5EgsKA2tleRgBIAEoCRIlCgV2YWx1ZRgCIAEoCzIWLmxpbGJhdHRsZS52MS5QYXRoRWRnZToCOAEisQEKCFBhdGhFZGdlEg4KBmZyb21fcRgBIAEoBRIOCgZmcm9tX3IYAiABKAUSDAoEdG9fcRgDIAEoBRIMCgR0b19yGAQgASgFEhUKDW1vdmVtZW50X2Nvc3QYBSABKAESEgoKdG90YWxfY29zdBgGIAEoARIUCgx0ZXJyYWluX3R5cGUYByABKAkSEwoLZXhwbGFuYXRpb24YCCABKAkSEwoLaXNfb2NjdXBpZWQYCSABKAgicgoEUGF0aBIlCgVlZGdlcxgBIAMoCzIWLmxpbGJhdHRsZS52MS5QYXRoRWRnZRIvCgpkaXJlY3Rpb25zGAIgAygOMhsubGlsYmF0dGxlLnYxLlBhdGhEaXJlY3Rpb24SEgoKdG90YWxfY29zdBgDIAEoASKuAQoMUGxheWVyT3JkZXJzEhEKCXBsYXllcl9pZBgBIAEoBRISCgpjb21taXRtZW50GAIgASgJEjAKDGNvbW1pdHRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIcmV2ZWFsZWQYBCABKAgSJQoFbW92ZXMYBSADKAsyFi5saWxiYXR0bGUudjEuR2FtZU1vdmUSDAoEc2FsdBgGIAEoCSJfCglUaW1lUmFuZ2USKQoFc3RhcnQYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKA2VuZBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAigQIKCFVzZXJHYW1lEg8KB3VzZXJfaWQYASABKAkSDwoHZ2FtZV9pZBgCIAEoCRISCgpwbGF5ZXJfaWRzGAMgAygFEhEKCWdhbWVfbmFtZRgEIAEoCRIQCgh3b3JsZF9pZBgFIAEoCRIoCgZzdGF0dXMYBiABKA4yGC5saWxiYXR0bGUudjEuR2FtZVN0YXR1cxIWCg5jdXJyZW50X3BsYXllchgHIAEoBRIUCgx0dXJuX2NvdW50ZXIYCCABKAUSEgoKaXNfbXlfdHVybhgJIAEoCBIuCgp1cGRhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI1CgxVc2VyR2FtZUxpc3QSJQoFZ2FtZXMYASADKAsyFi5saWxiYXR0bGUudjEuVXNlckdhbWUi+AEKDVdvcmxkUmV2aXNpb24SEAoId29ybGRfaWQYASABKAkSEAoIcmV2aXNpb24YAiABKAMSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJYXV0aG9yX2lkGAQgASgJEhUKDXJldmVydGVkX2Zyb20YBSABKAMSFAoMY29udGVudF9oYXNoGAYgASgJEhIKCnRpbGVfY291bnQYByABKAUSEgoKdW5pdF9jb3VudBgIIAEoBRIrCgp3b3JsZF9kYXRhGAkgASgLMhcubGlsYmF0dGxlLnYxLldvcmxkRGF0YSJaCg9Xb3JsZENlbGxDaGFuZ2USDQoFbGF5ZXIYASABKAkSCwoDa2V5GAIgASgJEisKBGtpbmQYAyABKA4yHS5saWxiYXR0bGUudjEuV29ybGRDaGFuZ2VLaW5kIowBChJXb3JsZE1lcmdlQ29uZmxpY3QSDQoFbGF5ZXIYASABKAkSCwoDa2V5GAIgASgJEisKBG91cnMYAyABKA4yHS5saWxiYXR0bGUudjEuV29ybGRDaGFuZ2VLaW5kEi0KBnRoZWlycxgEIAEoDjIdLmxpbGJhdHRsZS52MS5Xb3JsZENoYW5nZUtpbmQilQEKEFdvcmxkU2VhcmNoRW50cnkSIgoFd29ybGQYASABKAsyEy5saWxiYXR0bGUudjEuV29ybGQSDwoHcGxheWVycxgCIAEoBRINCgV0aWxlcxgDIAEoBRINCgVnYW1lcxgEIAEoBRIuCgppbmRleGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCpfCgxDcm9zc2luZ1R5cGUSHQoZQ1JPU1NJTkdfVFlQRV9VTlNQRUNJRklFRBAAEhYKEkNST1NTSU5HX1RZUEVfUk9BRBABEhgKFENST1NTSU5HX1RZUEVfQlJJREdFEAIqowEKC1RlcnJhaW5UeXBlEhwKGFRFUlJBSU5fVFlQRV9VTlNQRUNJRklFRBAAEhUKEVRFUlJBSU5fVFlQRV9DSVRZEAESFwoTVEVSUkFJTl9UWVBFX05BVFVSRRACEhcKE1RFUlJBSU5fVFlQRV9CUklER0UQAxIWChJURVJSQUlOX1RZUEVfV0FURVIQBBIVChFURVJSQUlOX1RZUEVfUk9BRBAFKnEKCkdhbWVTdGF0dXMSGwoXR0FNRV9TVEFUVVNfVU5TUEVDSUZJRUQQABIXChNHQU1FX1NUQVRVU19QTEFZSU5HEAESFgoSR0FNRV9TVEFUVVNfUEFVU0VEEAISFQoRR0FNRV9TVEFUVVNfRU5ERUQQAyreAQoNUGF0aERpcmVjdGlvbhIeChpQQVRIX0RJUkVDVElPTl9VTlNQRUNJRklFRBAAEhcKE1BBVEhfRElSRUNUSU9OX0xFRlQQARIbChdQQVRIX0RJUkVDVElPTl9UT1BfTEVGVBACEhwKGFBBVEhfRElSRUNUSU9OX1RPUF9SSUdIVBADEhgKFFBBVEhfRElSRUNUSU9OX1JJR0hUEAQSHwobUEFUSF9ESVJFQ1RJT05fQk9UVE9NX1JJR0hUEAUSHgoaUEFUSF9ESVJFQ1RJT05fQk9UVE9NX0xFRlQQBiqQAQoPV29ybGRDaGFuZ2VLaW5kEiEKHVdPUkxEX0NIQU5HRV9LSU5EX1VOU1BFQ0lGSUVEEAASGwoXV09STERfQ0hBTkdFX0tJTkRfQURERUQQARIdChlXT1JMRF9DSEFOR0VfS0lORF9SRU1PVkVEEAISHgoaV09STERfQ0hBTkdFX0tJTkRfTU9ESUZJRUQQAyp6ChJXb3JsZE1lcmdlU3RyYXRlZ3kSJAogV09STERfTUVSR0VfU1RSQVRFR1lfVU5TUEVDSUZJRUQQABIdChlXT1JMRF9NRVJHRV9TVFJBVEVHWV9PVVJTEAESHwobV09STERfTUVSR0VfU1RSQVRFR1lfVEhFSVJTEAJCtwEKEGNvbS5saWxiYXR0bGUudjFCC01vZGVsc1Byb3RvUAFaRWdpdGh1Yi5jb20vdHVybmZvcmdlL2xpbGJhdHRsZS9nZW4vZ28vbGlsYmF0dGxlL3YxL21vZGVscztsaWxiYXR0bGV2MaICA0xYWKoCDExpbGJhdHRsZS5WMcoCDExpbGJhdHRsZVxWMeICGExpbGJhdHRsZVxWMVxHUEJNZXRhZGF0YeoCDUxpbGJhdHRsZTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_google_protobuf_struct]);
 
 /**
  * @generated from message lilbattle.v1.IndexInfo
@@ -115,7 +115,8 @@ export type PaginationResponse = Message<"lilbattle.v1.PaginationResponse"> & {
 
   /**
    * *
-   * Total number of results.
+   * Total number of results.  Backends that cannot count matches cheaply
+   * leave this as 0.
    *
    * @generated from field: int32 total_results = 5;
    */
@@ -218,6 +219,22 @@ export type World = Message<"lilbattle.v1.World"> & {
    * @generated from field: lilbattle.v1.IndexInfo search_index_info = 13;
    */
   searchIndexInfo?: IndexInfo;
+
+  /**
+   * World this one was copied (remixed) from, empty for original worlds
+   *
+   * @generated from field: string parent_world_id = 14;
+   */
+  parentWorldId: string;
+
+  /**
+   * Revision of the parent world this one was copied from, or last merged
+   * upstream changes from.  It is the base of three-way merges with the
+   * parent.
+   *
+   * @generated from field: int64 parent_revision = 15;
+   */
+  parentRevision: bigint;
 };
 
 /**
@@ -1091,6 +1108,22 @@ export type Game = Message<"lilbattle.v1.Game"> & {
    * @generated from field: lilbattle.v1.IndexInfo search_index_info = 15;
    */
   searchIndexInfo?: IndexInfo;
+
+  /**
+   * The world revision (WorldData version) this game was created from.
+   * 0 for games created before worlds kept revisions.
+   *
+   * @generated from field: int64 world_revision = 16;
+   */
+  worldRevision: bigint;
+
+  /**
+   * The version (content hash) of the rules and damage data this game is
+   * played with.  Empty for games created before games recorded it.
+   *
+   * @generated from field: string rules_version = 17;
+   */
+  rulesVersion: string;
 };
 
 /**
@@ -1323,7 +1356,7 @@ export type GameSettings = Message<"lilbattle.v1.GameSettings"> & {
   allowedUnits: number[];
 
   /**
-   * Turn time limit in seconds (0 = no limit)
+   * Turn time limit in seconds (0 = no limit, or 24 hours for each orders phase of simultaneous turns)
    *
    * @generated from field: int32 turn_time_limit = 2;
    */
@@ -1344,6 +1377,43 @@ export type GameSettings = Message<"lilbattle.v1.GameSettings"> & {
    * @generated from field: int32 max_turns = 4;
    */
   maxTurns: number;
+
+  /**
+   * Turn mode - "sequential" (default) or "simultaneous"
+   * In simultaneous mode all players commit orders for a turn in parallel
+   * and they are resolved together once everyone has revealed them.
+   *
+   * @generated from field: string turn_mode = 5;
+   */
+  turnMode: string;
+
+  /**
+   * Teammates share a single coin pool for building units
+   *
+   * @generated from field: bool shared_coins = 6;
+   */
+  sharedCoins: boolean;
+
+  /**
+   * Teammates may move and act with each other's units on their turn
+   *
+   * @generated from field: bool shared_control = 7;
+   */
+  sharedControl: boolean;
+
+  /**
+   * Units may be healed and fixed at allied bases and by allied units
+   *
+   * @generated from field: bool allied_support = 8;
+   */
+  alliedSupport: boolean;
+
+  /**
+   * The game is won by a team once only its players have units left
+   *
+   * @generated from field: bool combined_victory = 9;
+   */
+  combinedVictory: boolean;
 };
 
 /**
@@ -1466,6 +1536,14 @@ export type GameState = Message<"lilbattle.v1.GameState"> & {
    * @generated from field: map<int32, lilbattle.v1.PlayerState> player_states = 15;
    */
   playerStates: { [key: number]: PlayerState };
+
+  /**
+   * Orders committed/revealed for the current turn in simultaneous mode,
+   * keyed by player_id.  Cleared once the turn is resolved.
+   *
+   * @generated from field: map<int32, lilbattle.v1.PlayerOrders> pending_orders = 16;
+   */
+  pendingOrders: { [key: number]: PlayerOrders };
 };
 
 /**
@@ -2715,3 +2793,439 @@ export enum PathDirection {
 export const PathDirectionSchema: GenEnum<PathDirection> = /*@__PURE__*/
   enumDesc(file_lilbattle_v1_models_models, 3);
 
+/**
+ * Orders a player has submitted for the current turn in simultaneous mode.
+ * Orders go through a commit/reveal flow - a player first commits a hash of
+ * their moves so nobody can react to anybody else's orders, and once every
+ * player has committed the moves are revealed and checked against the hash.
+ *
+ * @generated from message lilbattle.v1.PlayerOrders
+ */
+export type PlayerOrders = Message<"lilbattle.v1.PlayerOrders"> & {
+  /**
+   * @generated from field: int32 player_id = 1;
+   */
+  playerId: number;
+
+  /**
+   * Hex encoded SHA-256 of the moves and salt (see lib.OrdersCommitment)
+   *
+   * @generated from field: string commitment = 2;
+   */
+  commitment: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp committed_at = 3;
+   */
+  committedAt?: Timestamp;
+
+  /**
+   * Set once the moves have been revealed and verified against the commitment
+   *
+   * @generated from field: bool revealed = 4;
+   */
+  revealed: boolean;
+
+  /**
+   * The revealed moves - empty until revealed
+   *
+   * @generated from field: repeated lilbattle.v1.GameMove moves = 5;
+   */
+  moves: GameMove[];
+
+  /**
+   * Salt used in the commitment so identical orders do not hash the same
+   *
+   * @generated from field: string salt = 6;
+   */
+  salt: string;
+};
+
+/**
+ * Describes the message lilbattle.v1.PlayerOrders.
+ * Use `create(PlayerOrdersSchema)` to create a new message.
+ */
+export const PlayerOrdersSchema: GenMessage<PlayerOrders> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_models, 49);
+
+/**
+ * A time window used to filter list results.  Either bound may be omitted;
+ * start is inclusive and end is exclusive.
+ *
+ * @generated from message lilbattle.v1.TimeRange
+ */
+export type TimeRange = Message<"lilbattle.v1.TimeRange"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp start = 1;
+   */
+  start?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end = 2;
+   */
+  end?: Timestamp;
+};
+
+/**
+ * Describes the message lilbattle.v1.TimeRange.
+ * Use `create(TimeRangeSchema)` to create a new message.
+ */
+export const TimeRangeSchema: GenMessage<TimeRange> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_models, 50);
+
+/**
+ * An entry in the per-user game index - one per (user, game) the user holds
+ * a player slot in.  Entries are rewritten whenever the game is created,
+ * joined or changes turn so "my games" can be listed without loading every
+ * game.
+ *
+ * @generated from message lilbattle.v1.UserGame
+ */
+export type UserGame = Message<"lilbattle.v1.UserGame"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string game_id = 2;
+   */
+  gameId: string;
+
+  /**
+   * The user's player slots in the game (usually one)
+   *
+   * @generated from field: repeated int32 player_ids = 3;
+   */
+  playerIds: number[];
+
+  /**
+   * Denormalized from the game for listing
+   *
+   * @generated from field: string game_name = 4;
+   */
+  gameName: string;
+
+  /**
+   * @generated from field: string world_id = 5;
+   */
+  worldId: string;
+
+  /**
+   * Denormalized from the game state
+   *
+   * @generated from field: lilbattle.v1.GameStatus status = 6;
+   */
+  status: GameStatus;
+
+  /**
+   * @generated from field: int32 current_player = 7;
+   */
+  currentPlayer: number;
+
+  /**
+   * @generated from field: int32 turn_counter = 8;
+   */
+  turnCounter: number;
+
+  /**
+   * Whether the game is waiting on this user - it is one of their slots'
+   * turn, or in simultaneous mode they have orders to commit or reveal
+   *
+   * @generated from field: bool is_my_turn = 9;
+   */
+  isMyTurn: boolean;
+
+  /**
+   * When the entry was last rewritten
+   *
+   * @generated from field: google.protobuf.Timestamp updated_at = 10;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message lilbattle.v1.UserGame.
+ * Use `create(UserGameSchema)` to create a new message.
+ */
+export const UserGameSchema: GenMessage<UserGame> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_models, 51);
+
+/**
+ * The index entries of one user (the file backend stores one per user)
+ *
+ * @generated from message lilbattle.v1.UserGameList
+ */
+export type UserGameList = Message<"lilbattle.v1.UserGameList"> & {
+  /**
+   * @generated from field: repeated lilbattle.v1.UserGame games = 1;
+   */
+  games: UserGame[];
+};
+
+/**
+ * Describes the message lilbattle.v1.UserGameList.
+ * Use `create(UserGameListSchema)` to create a new message.
+ */
+export const UserGameListSchema: GenMessage<UserGameList> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_models, 52);
+
+/**
+ * An immutable snapshot of a world's data.  A revision is recorded every
+ * time the world's data is saved and is numbered by the WorldData version
+ * it captures, so games can be pinned to the revision they started from.
+ *
+ * @generated from message lilbattle.v1.WorldRevision
+ */
+export type WorldRevision = Message<"lilbattle.v1.WorldRevision"> & {
+  /**
+   * @generated from field: string world_id = 1;
+   */
+  worldId: string;
+
+  /**
+   * The WorldData version this revision captures
+   *
+   * @generated from field: int64 revision = 2;
+   */
+  revision: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * User whose save created the revision
+   *
+   * @generated from field: string author_id = 4;
+   */
+  authorId: string;
+
+  /**
+   * Set when the revision was created by reverting to an older one
+   *
+   * @generated from field: int64 reverted_from = 5;
+   */
+  revertedFrom: bigint;
+
+  /**
+   * @generated from field: string content_hash = 6;
+   */
+  contentHash: string;
+
+  /**
+   * @generated from field: int32 tile_count = 7;
+   */
+  tileCount: number;
+
+  /**
+   * @generated from field: int32 unit_count = 8;
+   */
+  unitCount: number;
+
+  /**
+   * The world's data at this revision (not set when listing revisions)
+   *
+   * @generated from field: lilbattle.v1.WorldData world_data = 9;
+   */
+  worldData?: WorldData;
+};
+
+/**
+ * Describes the message lilbattle.v1.WorldRevision.
+ * Use `create(WorldRevisionSchema)` to create a new message.
+ */
+export const WorldRevisionSchema: GenMessage<WorldRevision> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_models, 53);
+
+/**
+ * How a cell of a world differs from the same cell in another version of
+ * the world
+ *
+ * @generated from enum lilbattle.v1.WorldChangeKind
+ */
+export enum WorldChangeKind {
+  /**
+   * @generated from enum value: WORLD_CHANGE_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WORLD_CHANGE_KIND_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * @generated from enum value: WORLD_CHANGE_KIND_REMOVED = 2;
+   */
+  REMOVED = 2,
+
+  /**
+   * @generated from enum value: WORLD_CHANGE_KIND_MODIFIED = 3;
+   */
+  MODIFIED = 3,
+}
+
+/**
+ * Describes the enum lilbattle.v1.WorldChangeKind.
+ */
+export const WorldChangeKindSchema: GenEnum<WorldChangeKind> = /*@__PURE__*/
+  enumDesc(file_lilbattle_v1_models_models, 4);
+
+/**
+ * What a three-way world merge does with cells both sides changed
+ * differently
+ *
+ * @generated from enum lilbattle.v1.WorldMergeStrategy
+ */
+export enum WorldMergeStrategy {
+  /**
+   * Report the conflicts and do not merge
+   *
+   * @generated from enum value: WORLD_MERGE_STRATEGY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Keep our side of every conflict
+   *
+   * @generated from enum value: WORLD_MERGE_STRATEGY_OURS = 1;
+   */
+  OURS = 1,
+
+  /**
+   * Take their side of every conflict
+   *
+   * @generated from enum value: WORLD_MERGE_STRATEGY_THEIRS = 2;
+   */
+  THEIRS = 2,
+}
+
+/**
+ * Describes the enum lilbattle.v1.WorldMergeStrategy.
+ */
+export const WorldMergeStrategySchema: GenEnum<WorldMergeStrategy> = /*@__PURE__*/
+  enumDesc(file_lilbattle_v1_models_models, 5);
+
+/**
+ * A change to one cell of one layer of a world
+ *
+ * @generated from message lilbattle.v1.WorldCellChange
+ */
+export type WorldCellChange = Message<"lilbattle.v1.WorldCellChange"> & {
+  /**
+   * "tile", "unit" or "crossing"
+   *
+   * @generated from field: string layer = 1;
+   */
+  layer: string;
+
+  /**
+   * "q,r" coordinate of the cell
+   *
+   * @generated from field: string key = 2;
+   */
+  key: string;
+
+  /**
+   * @generated from field: lilbattle.v1.WorldChangeKind kind = 3;
+   */
+  kind: WorldChangeKind;
+};
+
+/**
+ * Describes the message lilbattle.v1.WorldCellChange.
+ * Use `create(WorldCellChangeSchema)` to create a new message.
+ */
+export const WorldCellChangeSchema: GenMessage<WorldCellChange> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_models, 54);
+
+/**
+ * A cell both sides of a three-way world merge changed, differently
+ *
+ * @generated from message lilbattle.v1.WorldMergeConflict
+ */
+export type WorldMergeConflict = Message<"lilbattle.v1.WorldMergeConflict"> & {
+  /**
+   * "tile", "unit" or "crossing"
+   *
+   * @generated from field: string layer = 1;
+   */
+  layer: string;
+
+  /**
+   * "q,r" coordinate of the cell
+   *
+   * @generated from field: string key = 2;
+   */
+  key: string;
+
+  /**
+   * How each side changed the cell relative to the common base
+   *
+   * @generated from field: lilbattle.v1.WorldChangeKind ours = 3;
+   */
+  ours: WorldChangeKind;
+
+  /**
+   * @generated from field: lilbattle.v1.WorldChangeKind theirs = 4;
+   */
+  theirs: WorldChangeKind;
+};
+
+/**
+ * Describes the message lilbattle.v1.WorldMergeConflict.
+ * Use `create(WorldMergeConflictSchema)` to create a new message.
+ */
+export const WorldMergeConflictSchema: GenMessage<WorldMergeConflict> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_models, 55);
+
+/**
+ * A world as kept in the world search index, with the attributes searches
+ * filter and sort on
+ *
+ * @generated from message lilbattle.v1.WorldSearchEntry
+ */
+export type WorldSearchEntry = Message<"lilbattle.v1.WorldSearchEntry"> & {
+  /**
+   * @generated from field: lilbattle.v1.World world = 1;
+   */
+  world?: World;
+
+  /**
+   * Number of players - the distinct players owning units or tiles
+   *
+   * @generated from field: int32 players = 2;
+   */
+  players: number;
+
+  /**
+   * Number of tiles, the map size
+   *
+   * @generated from field: int32 tiles = 3;
+   */
+  tiles: number;
+
+  /**
+   * Number of games created from the world, its popularity
+   *
+   * @generated from field: int32 games = 4;
+   */
+  games: number;
+
+  /**
+   * When the entry was last indexed
+   *
+   * @generated from field: google.protobuf.Timestamp indexed_at = 5;
+   */
+  indexedAt?: Timestamp;
+};
+
+/**
+ * Describes the message lilbattle.v1.WorldSearchEntry.
+ * Use `create(WorldSearchEntrySchema)` to create a new message.
+ */
+export const WorldSearchEntrySchema: GenMessage<WorldSearchEntry> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_models, 56);
+
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file lilbattle/v1/models/sync.proto.
  */
 export const file_lilbattle_v1_models_sync: GenFile = /*@__PURE__*/
-  fileDesc("Ch5saWxiYXR0bGUvdjEvbW9kZWxzL3N5bmMucHJvdG8SDGxpbGJhdHRsZS52MSJNChBTdWJzY3JpYmVSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSEQoJcGxheWVyX2lkGAIgASgJEhUKDWZyb21fc2VxdWVuY2UYAyABKAMifAoRU3Vic2NyaWJlUmVzcG9uc2USGAoQY3VycmVudF9zZXF1ZW5jZRgBIAEoAxIrCgpnYW1lX3N0YXRlGAIgASgLMhcubGlsYmF0dGxlLnYxLkdhbWVTdGF0ZRIgCgRnYW1lGAMgASgLMhIubGlsYmF0dGxlLnYxLkdhbWUitQIKCkdhbWVVcGRhdGUSEAoIc2VxdWVuY2UYASABKAMSNwoPbW92ZXNfcHVibGlzaGVkGAIgASgLMhwubGlsYmF0dGxlLnYxLk1vdmVzUHVibGlzaGVkSAASMwoNcGxheWVyX2pvaW5lZBgDIAEoCzIaLmxpbGJhdHRsZS52MS5QbGF5ZXJKb2luZWRIABIvCgtwbGF5ZXJfbGVmdBgEIAEoCzIYLmxpbGJhdHRsZS52MS5QbGF5ZXJMZWZ0SAASLQoKZ2FtZV9lbmRlZBgFIAEoCzIXLmxpbGJhdHRsZS52MS5HYW1lRW5kZWRIABI4Cg1pbml0aWFsX3N0YXRlGAYgASgLMh8ubGlsYmF0dGxlLnYxLlN1YnNjcmliZVJlc3BvbnNlSABCDQoLdXBkYXRlX3R5cGUiXQoOTW92ZXNQdWJsaXNoZWQSDgoGcGxheWVyGAEgASgFEiUKBW1vdmVzGAIgAygLMhYubGlsYmF0dGxlLnYxLkdhbWVNb3ZlEhQKDGdyb3VwX251bWJlchgDIAEoAyI4CgxQbGF5ZXJKb2luZWQSEQoJcGxheWVyX2lkGAEgASgJEhUKDXBsYXllcl9udW1iZXIYAiABKAUiNgoKUGxheWVyTGVmdBIRCglwbGF5ZXJfaWQYASABKAkSFQoNcGxheWVyX251bWJlchgCIAEoBSIrCglHYW1lRW5kZWQSDgoGd2lubmVyGAEgASgFEg4KBnJlYXNvbhgCIAEoCSJNChBCcm9hZGNhc3RSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSKAoGdXBkYXRlGAIgASgLMhgubGlsYmF0dGxlLnYxLkdhbWVVcGRhdGUiPwoRQnJvYWRjYXN0UmVzcG9uc2USGAoQc3Vic2NyaWJlcl9jb3VudBgBIAEoBRIQCghzZXF1ZW5jZRgCIAEoA0K1AQoQY29tLmxpbGJhdHRsZS52MUIJU3luY1Byb3RvUAFaRWdpdGh1Yi5jb20vdHVybmZvcmdlL2xpbGJhdHRsZS9nZW4vZ28vbGlsYmF0dGxlL3YxL21vZGVscztsaWxiYXR0bGV2MaICA0xYWKoCDExpbGJhdHRsZS5WMcoCDExpbGJhdHRsZVxWMeICGExpbGJhdHRsZVxWMVxHUEJNZXRhZGF0YeoCDUxpbGJhdHRsZTo6VjFiBnByb3RvMw", [file_lilbattle_v1_models_models]);
+  fileDesc("Ch5saWxiYXR0bGUvdjEvbW9kZWxzL3N5bmMucHJvdG8SDGxpbGJhdHRsZS52MSJNChBTdWJzY3JpYmVSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSEQoJcGxheWVyX2lkGAIgASgJEhUKDWZyb21fc2VxdWVuY2UYAyABKAMilQEKEVN1YnNjcmliZVJlc3BvbnNlEhgKEGN1cnJlbnRfc2VxdWVuY2UYASABKAMSKwoKZ2FtZV9zdGF0ZRgCIAEoCzIXLmxpbGJhdHRsZS52MS5HYW1lU3RhdGUSIAoEZ2FtZRgDIAEoCzISLmxpbGJhdHRsZS52MS5HYW1lEhcKD3JlbG9hZF9yZXF1aXJlZBgEIAEoCCK1AgoKR2FtZVVwZGF0ZRIQCghzZXF1ZW5jZRgBIAEoAxI3Cg9tb3Zlc19wdWJsaXNoZWQYAiABKAsyHC5saWxiYXR0bGUudjEuTW92ZXNQdWJsaXNoZWRIABIzCg1wbGF5ZXJfam9pbmVkGAMgASgLMhoubGlsYmF0dGxlLnYxLlBsYXllckpvaW5lZEgAEi8KC3BsYXllcl9sZWZ0GAQgASgLMhgubGlsYmF0dGxlLnYxLlBsYXllckxlZnRIABItCgpnYW1lX2VuZGVkGAUgASgLMhcubGlsYmF0dGxlLnYxLkdhbWVFbmRlZEgAEjgKDWluaXRpYWxfc3RhdGUYBiABKAsyHy5saWxiYXR0bGUudjEuU3Vic2NyaWJlUmVzcG9uc2VIAEINCgt1cGRhdGVfdHlwZSJdCg5Nb3Zlc1B1Ymxpc2hlZBIOCgZwbGF5ZXIYASABKAUSJQoFbW92ZXMYAiADKAsyFi5saWxiYXR0bGUudjEuR2FtZU1vdmUSFAoMZ3JvdXBfbnVtYmVyGAMgASgDIjgKDFBsYXllckpvaW5lZBIRCglwbGF5ZXJfaWQYASABKAkSFQoNcGxheWVyX251bWJlchgCIAEoBSI2CgpQbGF5ZXJMZWZ0EhEKCXBsYXllcl9pZBgBIAEoCRIVCg1wbGF5ZXJfbnVtYmVyGAIgASgFIisKCUdhbWVFbmRlZBIOCgZ3aW5uZXIYASABKAUSDgoGcmVhc29uGAIgASgJIk0KEEJyb2FkY2FzdFJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCRIoCgZ1cGRhdGUYAiABKAsyGC5saWxiYXR0bGUudjEuR2FtZVVwZGF0ZSI/ChFCcm9hZGNhc3RSZXNwb25zZRIYChBzdWJzY3JpYmVyX2NvdW50GAEgASgFEhAKCHNlcXVlbmNlGAIgASgDQrUBChBjb20ubGlsYmF0dGxlLnYxQglTeW5jUHJvdG9QAVpFZ2l0aHViLmNvbS90dXJuZm9yZ2UvbGlsYmF0dGxlL2dlbi9nby9saWxiYXR0bGUvdjEvbW9kZWxzO2xpbGJhdHRsZXYxogIDTFhYqgIMTGlsYmF0dGxlLlYxygIMTGlsYmF0dGxlXFYx4gIYTGlsYmF0dGxlXFYxXEdQQk1ldGFkYXRh6gINTGlsYmF0dGxlOjpWMWIGcHJvdG8z", [file_lilbattle_v1_models_models]);
 
 /**
  * SubscribeRequest to start receiving game updates
@@ -77,6 +77,15 @@ export type SubscribeResponse = Message<"lilbattle.v1.SubscribeResponse"> & {
    * @generated from field: lilbattle.v1.Game game = 3;
    */
   game?: Game;
+
+  /**
+   * Set when from_sequence can't be resumed because the updates since then
+   * are no longer retained.  The client should reload the game via GetGame
+   * and track sequences from current_sequence.
+   *
+   * @generated from field: bool reload_required = 4;
+   */
+  reloadRequired: boolean;
 };
 
 /**
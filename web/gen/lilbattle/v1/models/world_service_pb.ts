@@ -6,7 +6,7 @@ import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
 import type { FieldMask } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
-import type { Pagination, PaginationResponse, World, WorldData } from "./models_pb";
+import type { Pagination, PaginationResponse, TimeRange, World, WorldCellChange, WorldData, WorldMergeConflict, WorldMergeStrategy, WorldRevision } from "./models_pb";
 import { file_lilbattle_v1_models_models } from "./models_pb";
 import { file_google_api_annotations } from "../../../google/api/annotations_pb";
 import { file_protoc_gen_openapiv2_options_annotations } from "../../../protoc-gen-openapiv2/options/annotations_pb";
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file lilbattle/v1/models/world_service.proto.
  */
 export const file_lilbattle_v1_models_world_service: GenFile = /*@__PURE__*/
-  fileDesc("CidsaWxiYXR0bGUvdjEvbW9kZWxzL3dvcmxkX3NlcnZpY2UucHJvdG8SDGxpbGJhdHRsZS52MSKSAQoJV29ybGRJbmZvEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSEAoIY2F0ZWdvcnkYBCABKAkSEgoKZGlmZmljdWx0eRgFIAEoCRIMCgR0YWdzGAYgAygJEgwKBGljb24YByABKAkSFAoMbGFzdF91cGRhdGVkGAggASgJIlMKEUxpc3RXb3JsZHNSZXF1ZXN0EiwKCnBhZ2luYXRpb24YASABKAsyGC5saWxiYXR0bGUudjEuUGFnaW5hdGlvbhIQCghvd25lcl9pZBgCIAEoCSJuChJMaXN0V29ybGRzUmVzcG9uc2USIgoFaXRlbXMYASADKAsyEy5saWxiYXR0bGUudjEuV29ybGQSNAoKcGFnaW5hdGlvbhgCIAEoCzIgLmxpbGJhdHRsZS52MS5QYWdpbmF0aW9uUmVzcG9uc2UiLgoPR2V0V29ybGRSZXF1ZXN0EgoKAmlkGAEgASgJEg8KB3ZlcnNpb24YAiABKAkiYwoQR2V0V29ybGRSZXNwb25zZRIiCgV3b3JsZBgBIAEoCzITLmxpbGJhdHRsZS52MS5Xb3JsZBIrCgp3b3JsZF9kYXRhGAIgASgLMhcubGlsYmF0dGxlLnYxLldvcmxkRGF0YSLGAQoSVXBkYXRlV29ybGRSZXF1ZXN0EiIKBXdvcmxkGAEgASgLMhMubGlsYmF0dGxlLnYxLldvcmxkEisKCndvcmxkX2RhdGEYAiABKAsyFy5saWxiYXR0bGUudjEuV29ybGREYXRhEhMKC2NsZWFyX3dvcmxkGAMgASgIEi8KC3VwZGF0ZV9tYXNrGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzazoZkkEWChQqElVwZGF0ZVdvcmxkUmVxdWVzdCKCAQoTVXBkYXRlV29ybGRSZXNwb25zZRIiCgV3b3JsZBgBIAEoCzITLmxpbGJhdHRsZS52MS5Xb3JsZBIrCgp3b3JsZF9kYXRhGAIgASgLMhcubGlsYmF0dGxlLnYxLldvcmxkRGF0YToakkEXChUqE1VwZGF0ZVdvcmxkUmVzcG9uc2UiIAoSRGVsZXRlV29ybGRSZXF1ZXN0EgoKAmlkGAEgASgJIhUKE0RlbGV0ZVdvcmxkUmVzcG9uc2UiHwoQR2V0V29ybGRzUmVxdWVzdBILCgNpZHMYASADKAkilAEKEUdldFdvcmxkc1Jlc3BvbnNlEjsKBndvcmxkcxgBIAMoCzIrLmxpbGJhdHRsZS52MS5HZXRXb3JsZHNSZXNwb25zZS5Xb3JsZHNFbnRyeRpCCgtXb3JsZHNFbnRyeRILCgNrZXkYASABKAkSIgoFdmFsdWUYAiABKAsyEy5saWxiYXR0bGUudjEuV29ybGQ6AjgBImUKEkNyZWF0ZVdvcmxkUmVxdWVzdBIiCgV3b3JsZBgBIAEoCzITLmxpbGJhdHRsZS52MS5Xb3JsZBIrCgp3b3JsZF9kYXRhGAIgASgLMhcubGlsYmF0dGxlLnYxLldvcmxkRGF0YSLkAQoTQ3JlYXRlV29ybGRSZXNwb25zZRIiCgV3b3JsZBgBIAEoCzITLmxpbGJhdHRsZS52MS5Xb3JsZBIrCgp3b3JsZF9kYXRhGAIgASgLMhcubGlsYmF0dGxlLnYxLldvcmxkRGF0YRJICgxmaWVsZF9lcnJvcnMYAyADKAsyMi5saWxiYXR0bGUudjEuQ3JlYXRlV29ybGRSZXNwb25zZS5GaWVsZEVycm9yc0VudHJ5GjIKEEZpZWxkRXJyb3JzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUK9AQoQY29tLmxpbGJhdHRsZS52MUIRV29ybGRTZXJ2aWNlUHJvdG9QAVpFZ2l0aHViLmNvbS90dXJuZm9yZ2UvbGlsYmF0dGxlL2dlbi9nby9saWxiYXR0bGUvdjEvbW9kZWxzO2xpbGJhdHRsZXYxogIDTFhYqgIMTGlsYmF0dGxlLlYxygIMTGlsYmF0dGxlXFYx4gIYTGlsYmF0dGxlXFYxXEdQQk1ldGFkYXRh6gINTGlsYmF0dGxlOjpWMWIGcHJvdG8z", [file_google_protobuf_field_mask, file_lilbattle_v1_models_models, file_google_api_annotations, file_protoc_gen_openapiv2_options_annotations]);
+  fileDesc("CidsaWxiYXR0bGUvdjEvbW9kZWxzL3dvcmxkX3NlcnZpY2UucHJvdG8SDGxpbGJhdHRsZS52MSKSAQoJV29ybGRJbmZvEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSEAoIY2F0ZWdvcnkYBCABKAkSEgoKZGlmZmljdWx0eRgFIAEoCRIMCgR0YWdzGAYgAygJEgwKBGljb24YByABKAkSFAoMbGFzdF91cGRhdGVkGAggASgJIvIBChFMaXN0V29ybGRzUmVxdWVzdBIsCgpwYWdpbmF0aW9uGAEgASgLMhgubGlsYmF0dGxlLnYxLlBhZ2luYXRpb24SEAoIb3duZXJfaWQYAiABKAkSCwoDdGFnGAMgASgJEigKB2NyZWF0ZWQYBCABKAsyFy5saWxiYXR0bGUudjEuVGltZVJhbmdlEigKB3VwZGF0ZWQYBSABKAsyFy5saWxiYXR0bGUudjEuVGltZVJhbmdlEg8KB3NvcnRfYnkYBiABKAkSEgoKc29ydF9vcmRlchgHIAEoCRIXCg9wYXJlbnRfd29ybGRfaWQYCCABKAkibgoSTGlzdFdvcmxkc1Jlc3BvbnNlEiIKBWl0ZW1zGAEgAygLMhMubGlsYmF0dGxlLnYxLldvcmxkEjQKCnBhZ2luYXRpb24YAiABKAsyIC5saWxiYXR0bGUudjEuUGFnaW5hdGlvblJlc3BvbnNlIi4KD0dldFdvcmxkUmVxdWVzdBIKCgJpZBgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJImMKEEdldFdvcmxkUmVzcG9uc2USIgoFd29ybGQYASABKAsyEy5saWxiYXR0bGUudjEuV29ybGQSKwoKd29ybGRfZGF0YRgCIAEoCzIXLmxpbGJhdHRsZS52MS5Xb3JsZERhdGEixgEKElVwZGF0ZVdvcmxkUmVxdWVzdBIiCgV3b3JsZBgBIAEoCzITLmxpbGJhdHRsZS52MS5Xb3JsZBIrCgp3b3JsZF9kYXRhGAIgASgLMhcubGlsYmF0dGxlLnYxLldvcmxkRGF0YRITCgtjbGVhcl93b3JsZBgDIAEoCBIvCgt1cGRhdGVfbWFzaxgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2s6GZJBFgoUKhJVcGRhdGVXb3JsZFJlcXVlc3QiggEKE1VwZGF0ZVdvcmxkUmVzcG9uc2USIgoFd29ybGQYASABKAsyEy5saWxiYXR0bGUudjEuV29ybGQSKwoKd29ybGRfZGF0YRgCIAEoCzIXLmxpbGJhdHRsZS52MS5Xb3JsZERhdGE6GpJBFwoVKhNVcGRhdGVXb3JsZFJlc3BvbnNlIiAKEkRlbGV0ZVdvcmxkUmVxdWVzdBIKCgJpZBgBIAEoCSIVChNEZWxldGVXb3JsZFJlc3BvbnNlIh8KEEdldFdvcmxkc1JlcXVlc3QSCwoDaWRzGAEgAygJIpQBChFHZXRXb3JsZHNSZXNwb25zZRI7CgZ3b3JsZHMYASADKAsyKy5saWxiYXR0bGUudjEuR2V0V29ybGRzUmVzcG9uc2UuV29ybGRzRW50cnkaQgoLV29ybGRzRW50cnkSCwoDa2V5GAEgASgJEiIKBXZhbHVlGAIgASgLMhMubGlsYmF0dGxlLnYxLldvcmxkOgI4ASJlChJDcmVhdGVXb3JsZFJlcXVlc3QSIgoFd29ybGQYASABKAsyEy5saWxiYXR0bGUudjEuV29ybGQSKwoKd29ybGRfZGF0YRgCIAEoCzIXLmxpbGJhdHRsZS52MS5Xb3JsZERhdGEi5AEKE0NyZWF0ZVdvcmxkUmVzcG9uc2USIgoFd29ybGQYASABKAsyEy5saWxiYXR0bGUudjEuV29ybGQSKwoKd29ybGRfZGF0YRgCIAEoCzIXLmxpbGJhdHRsZS52MS5Xb3JsZERhdGESSAoMZmllbGRfZXJyb3JzGAMgAygLMjIubGlsYmF0dGxlLnYxLkNyZWF0ZVdvcmxkUmVzcG9uc2UuRmllbGRFcnJvcnNFbnRyeRoyChBGaWVsZEVycm9yc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiWwoZTGlzdFdvcmxkUmV2aXNpb25zUmVxdWVzdBIQCgh3b3JsZF9pZBgBIAEoCRIsCgpwYWdpbmF0aW9uGAIgASgLMhgubGlsYmF0dGxlLnYxLlBhZ2luYXRpb24ifgoaTGlzdFdvcmxkUmV2aXNpb25zUmVzcG9uc2USKgoFaXRlbXMYASADKAsyGy5saWxiYXR0bGUudjEuV29ybGRSZXZpc2lvbhI0CgpwYWdpbmF0aW9uGAIgASgLMiAubGlsYmF0dGxlLnYxLlBhZ2luYXRpb25SZXNwb25zZSI4ChJSZXZlcnRXb3JsZFJlcXVlc3QSEAoId29ybGRfaWQYASABKAkSEAoIcmV2aXNpb24YAiABKAMiZgoTUmV2ZXJ0V29ybGRSZXNwb25zZRIiCgV3b3JsZBgBIAEoCzITLmxpbGJhdHRsZS52MS5Xb3JsZBIrCgp3b3JsZF9kYXRhGAIgASgLMhcubGlsYmF0dGxlLnYxLldvcmxkRGF0YSJaChhMaXN0RGVyaXZlZFdvcmxkc1JlcXVlc3QSEAoId29ybGRfaWQYASABKAkSLAoKcGFnaW5hdGlvbhgCIAEoCzIYLmxpbGJhdHRsZS52MS5QYWdpbmF0aW9uInUKGUxpc3REZXJpdmVkV29ybGRzUmVzcG9uc2USIgoFaXRlbXMYASADKAsyEy5saWxiYXR0bGUudjEuV29ybGQSNAoKcGFnaW5hdGlvbhgCIAEoCzIgLmxpbGJhdHRsZS52MS5QYWdpbmF0aW9uUmVzcG9uc2UijQEKGU1lcmdlV29ybGRVcHN0cmVhbVJlcXVlc3QSEAoId29ybGRfaWQYASABKAkSGQoRdXBzdHJlYW1fcmV2aXNpb24YAiABKAMSMgoIc3RyYXRlZ3kYAyABKA4yIC5saWxiYXR0bGUudjEuV29ybGRNZXJnZVN0cmF0ZWd5Eg8KB2RyeV9ydW4YBCABKAgilAIKGk1lcmdlV29ybGRVcHN0cmVhbVJlc3BvbnNlEiIKBXdvcmxkGAEgASgLMhMubGlsYmF0dGxlLnYxLldvcmxkEisKCndvcmxkX2RhdGEYAiABKAsyFy5saWxiYXR0bGUudjEuV29ybGREYXRhEhUKDWJhc2VfcmV2aXNpb24YAyABKAMSGQoRdXBzdHJlYW1fcmV2aXNpb24YBCABKAMSLgoHYXBwbGllZBgFIAMoCzIdLmxpbGJhdHRsZS52MS5Xb3JsZENlbGxDaGFuZ2USMwoJY29uZmxpY3RzGAYgAygLMiAubGlsYmF0dGxlLnYxLldvcmxkTWVyZ2VDb25mbGljdBIOCgZtZXJnZWQYByABKAgi6wEKE1NlYXJjaFdvcmxkc1JlcXVlc3QSDQoFcXVlcnkYASABKAkSDAoEdGFncxgCIAMoCRIUCgxkaWZmaWN1bHRpZXMYAyADKAkSEwoLbWluX3BsYXllcnMYBCABKAUSEwoLbWF4X3BsYXllcnMYBSABKAUSEQoJbWluX3RpbGVzGAYgASgFEhEKCW1heF90aWxlcxgHIAEoBRIPCgdzb3J0X2J5GAggASgJEhIKCnNvcnRfb3JkZXIYCSABKAkSLAoKcGFnaW5hdGlvbhgKIAEoCzIYLmxpbGJhdHRsZS52MS5QYWdpbmF0aW9uIjAKEFNlYXJjaEZhY2V0Q291bnQSDQoFdmFsdWUYASABKAkSDQoFY291bnQYAiABKAUiiwIKFFNlYXJjaFdvcmxkc1Jlc3BvbnNlEiIKBWl0ZW1zGAEgAygLMhMubGlsYmF0dGxlLnYxLldvcmxkEjQKCnBhZ2luYXRpb24YAiABKAsyIC5saWxiYXR0bGUudjEuUGFnaW5hdGlvblJlc3BvbnNlEiwKBHRhZ3MYAyADKAsyHi5saWxiYXR0bGUudjEuU2VhcmNoRmFjZXRDb3VudBI0CgxkaWZmaWN1bHRpZXMYBCADKAsyHi5saWxiYXR0bGUudjEuU2VhcmNoRmFjZXRDb3VudBI1Cg1wbGF5ZXJfY291bnRzGAUgAygLMh4ubGlsYmF0dGxlLnYxLlNlYXJjaEZhY2V0Q291bnRCvQEKEGNvbS5saWxiYXR0bGUudjFCEVdvcmxkU2VydmljZVByb3RvUAFaRWdpdGh1Yi5jb20vdHVybmZvcmdlL2xpbGJhdHRsZS9nZW4vZ28vbGlsYmF0dGxlL3YxL21vZGVscztsaWxiYXR0bGV2MaICA0xYWKoCDExpbGJhdHRsZS5WMcoCDExpbGJhdHRsZVxWMeICGExpbGJhdHRsZVxWMVxHUEJNZXRhZGF0YeoCDUxpbGJhdHRsZTo6VjFiBnByb3RvMw", [file_google_protobuf_field_mask, file_lilbattle_v1_models_models, file_google_api_annotations, file_protoc_gen_openapiv2_options_annotations]);
 
 /**
  * WorldInfo represents a world in the catalog
@@ -91,6 +91,46 @@ export type ListWorldsRequest = Message<"lilbattle.v1.ListWorldsRequest"> & {
    * @generated from field: string owner_id = 2;
    */
   ownerId: string;
+
+  /**
+   * Only worlds carrying this tag
+   *
+   * @generated from field: string tag = 3;
+   */
+  tag: string;
+
+  /**
+   * Only worlds created/updated within these windows
+   *
+   * @generated from field: lilbattle.v1.TimeRange created = 4;
+   */
+  created?: TimeRange;
+
+  /**
+   * @generated from field: lilbattle.v1.TimeRange updated = 5;
+   */
+  updated?: TimeRange;
+
+  /**
+   * Field to sort by - "name" (default), "updated_at" or "created_at"
+   *
+   * @generated from field: string sort_by = 6;
+   */
+  sortBy: string;
+
+  /**
+   * "asc" or "desc".  Defaults to newest first for times and A-Z for names.
+   *
+   * @generated from field: string sort_order = 7;
+   */
+  sortOrder: string;
+
+  /**
+   * Only worlds copied from this world
+   *
+   * @generated from field: string parent_world_id = 8;
+   */
+  parentWorldId: string;
 };
 
 /**
@@ -132,7 +172,7 @@ export type GetWorldRequest = Message<"lilbattle.v1.GetWorldRequest"> & {
   id: string;
 
   /**
-   * Optional, defaults to default_version
+   * Optional revision (WorldData version) to fetch, defaults to the latest
    *
    * @generated from field: string version = 2;
    */
@@ -380,3 +420,406 @@ export type CreateWorldResponse = Message<"lilbattle.v1.CreateWorldResponse"> &
 export const CreateWorldResponseSchema: GenMessage<CreateWorldResponse> = /*@__PURE__*/
   messageDesc(file_lilbattle_v1_models_world_service, 12);
 
+/**
+ * *
+ * Request to list a world's revisions
+ *
+ * @generated from message lilbattle.v1.ListWorldRevisionsRequest
+ */
+export type ListWorldRevisionsRequest = Message<"lilbattle.v1.ListWorldRevisionsRequest"> & {
+  /**
+   * @generated from field: string world_id = 1;
+   */
+  worldId: string;
+
+  /**
+   * Pagination info
+   *
+   * @generated from field: lilbattle.v1.Pagination pagination = 2;
+   */
+  pagination?: Pagination;
+};
+
+/**
+ * Describes the message lilbattle.v1.ListWorldRevisionsRequest.
+ * Use `create(ListWorldRevisionsRequestSchema)` to create a new message.
+ */
+export const ListWorldRevisionsRequestSchema: GenMessage<ListWorldRevisionsRequest> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_world_service, 13);
+
+/**
+ * *
+ * A world's revisions, newest first, without their world data
+ *
+ * @generated from message lilbattle.v1.ListWorldRevisionsResponse
+ */
+export type ListWorldRevisionsResponse = Message<"lilbattle.v1.ListWorldRevisionsResponse"> & {
+  /**
+   * @generated from field: repeated lilbattle.v1.WorldRevision items = 1;
+   */
+  items: WorldRevision[];
+
+  /**
+   * @generated from field: lilbattle.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message lilbattle.v1.ListWorldRevisionsResponse.
+ * Use `create(ListWorldRevisionsResponseSchema)` to create a new message.
+ */
+export const ListWorldRevisionsResponseSchema: GenMessage<ListWorldRevisionsResponse> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_world_service, 14);
+
+/**
+ * *
+ * Request to make an older revision of a world its latest one
+ *
+ * @generated from message lilbattle.v1.RevertWorldRequest
+ */
+export type RevertWorldRequest = Message<"lilbattle.v1.RevertWorldRequest"> & {
+  /**
+   * @generated from field: string world_id = 1;
+   */
+  worldId: string;
+
+  /**
+   * The revision to revert to
+   *
+   * @generated from field: int64 revision = 2;
+   */
+  revision: bigint;
+};
+
+/**
+ * Describes the message lilbattle.v1.RevertWorldRequest.
+ * Use `create(RevertWorldRequestSchema)` to create a new message.
+ */
+export const RevertWorldRequestSchema: GenMessage<RevertWorldRequest> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_world_service, 15);
+
+/**
+ * *
+ * Response of a revert.  The world's data is saved as a new revision so
+ * the revisions after the one reverted to are kept.
+ *
+ * @generated from message lilbattle.v1.RevertWorldResponse
+ */
+export type RevertWorldResponse = Message<"lilbattle.v1.RevertWorldResponse"> & {
+  /**
+   * @generated from field: lilbattle.v1.World world = 1;
+   */
+  world?: World;
+
+  /**
+   * @generated from field: lilbattle.v1.WorldData world_data = 2;
+   */
+  worldData?: WorldData;
+};
+
+/**
+ * Describes the message lilbattle.v1.RevertWorldResponse.
+ * Use `create(RevertWorldResponseSchema)` to create a new message.
+ */
+export const RevertWorldResponseSchema: GenMessage<RevertWorldResponse> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_world_service, 16);
+
+/**
+ * *
+ * Request to list the worlds copied (remixed) from a world
+ *
+ * @generated from message lilbattle.v1.ListDerivedWorldsRequest
+ */
+export type ListDerivedWorldsRequest = Message<"lilbattle.v1.ListDerivedWorldsRequest"> & {
+  /**
+   * @generated from field: string world_id = 1;
+   */
+  worldId: string;
+
+  /**
+   * Pagination info
+   *
+   * @generated from field: lilbattle.v1.Pagination pagination = 2;
+   */
+  pagination?: Pagination;
+};
+
+/**
+ * Describes the message lilbattle.v1.ListDerivedWorldsRequest.
+ * Use `create(ListDerivedWorldsRequestSchema)` to create a new message.
+ */
+export const ListDerivedWorldsRequestSchema: GenMessage<ListDerivedWorldsRequest> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_world_service, 17);
+
+/**
+ * @generated from message lilbattle.v1.ListDerivedWorldsResponse
+ */
+export type ListDerivedWorldsResponse = Message<"lilbattle.v1.ListDerivedWorldsResponse"> & {
+  /**
+   * @generated from field: repeated lilbattle.v1.World items = 1;
+   */
+  items: World[];
+
+  /**
+   * @generated from field: lilbattle.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message lilbattle.v1.ListDerivedWorldsResponse.
+ * Use `create(ListDerivedWorldsResponseSchema)` to create a new message.
+ */
+export const ListDerivedWorldsResponseSchema: GenMessage<ListDerivedWorldsResponse> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_world_service, 18);
+
+/**
+ * *
+ * Request to pull changes made to a world's parent since it was copied (or
+ * last merged) into the world.  The parent revision the world records is
+ * the base of a three-way merge of tiles, units and crossings.
+ *
+ * @generated from message lilbattle.v1.MergeWorldUpstreamRequest
+ */
+export type MergeWorldUpstreamRequest = Message<"lilbattle.v1.MergeWorldUpstreamRequest"> & {
+  /**
+   * @generated from field: string world_id = 1;
+   */
+  worldId: string;
+
+  /**
+   * Parent revision to merge, defaults to the parent's latest
+   *
+   * @generated from field: int64 upstream_revision = 2;
+   */
+  upstreamRevision: bigint;
+
+  /**
+   * What to do with cells both worlds changed.  By default conflicts are
+   * reported and nothing is merged.
+   *
+   * @generated from field: lilbattle.v1.WorldMergeStrategy strategy = 3;
+   */
+  strategy: WorldMergeStrategy;
+
+  /**
+   * Compute the merge without saving it
+   *
+   * @generated from field: bool dry_run = 4;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message lilbattle.v1.MergeWorldUpstreamRequest.
+ * Use `create(MergeWorldUpstreamRequestSchema)` to create a new message.
+ */
+export const MergeWorldUpstreamRequestSchema: GenMessage<MergeWorldUpstreamRequest> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_world_service, 19);
+
+/**
+ * @generated from message lilbattle.v1.MergeWorldUpstreamResponse
+ */
+export type MergeWorldUpstreamResponse = Message<"lilbattle.v1.MergeWorldUpstreamResponse"> & {
+  /**
+   * @generated from field: lilbattle.v1.World world = 1;
+   */
+  world?: World;
+
+  /**
+   * The merged world data - saved unless this was a dry run or it
+   * conflicted without a strategy
+   *
+   * @generated from field: lilbattle.v1.WorldData world_data = 2;
+   */
+  worldData?: WorldData;
+
+  /**
+   * Parent revisions the merge was between
+   *
+   * @generated from field: int64 base_revision = 3;
+   */
+  baseRevision: bigint;
+
+  /**
+   * @generated from field: int64 upstream_revision = 4;
+   */
+  upstreamRevision: bigint;
+
+  /**
+   * Upstream changes taken into the world
+   *
+   * @generated from field: repeated lilbattle.v1.WorldCellChange applied = 5;
+   */
+  applied: WorldCellChange[];
+
+  /**
+   * Cells both worlds changed differently
+   *
+   * @generated from field: repeated lilbattle.v1.WorldMergeConflict conflicts = 6;
+   */
+  conflicts: WorldMergeConflict[];
+
+  /**
+   * Whether the merged data was saved
+   *
+   * @generated from field: bool merged = 7;
+   */
+  merged: boolean;
+};
+
+/**
+ * Describes the message lilbattle.v1.MergeWorldUpstreamResponse.
+ * Use `create(MergeWorldUpstreamResponseSchema)` to create a new message.
+ */
+export const MergeWorldUpstreamResponseSchema: GenMessage<MergeWorldUpstreamResponse> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_world_service, 20);
+
+/**
+ * *
+ * Request to search worlds by free text and facets.  Zero fields match
+ * every world.
+ *
+ * @generated from message lilbattle.v1.SearchWorldsRequest
+ */
+export type SearchWorldsRequest = Message<"lilbattle.v1.SearchWorldsRequest"> & {
+  /**
+   * Free text matched against the name, tags, difficulty and description.
+   * Words match as prefixes and every word must match.
+   *
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * Only worlds carrying all of these tags
+   *
+   * @generated from field: repeated string tags = 2;
+   */
+  tags: string[];
+
+  /**
+   * Only worlds of one of these difficulties
+   *
+   * @generated from field: repeated string difficulties = 3;
+   */
+  difficulties: string[];
+
+  /**
+   * Only worlds for this many players
+   *
+   * @generated from field: int32 min_players = 4;
+   */
+  minPlayers: number;
+
+  /**
+   * @generated from field: int32 max_players = 5;
+   */
+  maxPlayers: number;
+
+  /**
+   * Only worlds with this many tiles
+   *
+   * @generated from field: int32 min_tiles = 6;
+   */
+  minTiles: number;
+
+  /**
+   * @generated from field: int32 max_tiles = 7;
+   */
+  maxTiles: number;
+
+  /**
+   * Field to sort by - "relevance" (default with a query), "popularity"
+   * (default without), "name" or "updated_at"
+   *
+   * @generated from field: string sort_by = 8;
+   */
+  sortBy: string;
+
+  /**
+   * "asc" or "desc".  Defaults to best/most/newest first and A-Z for names.
+   *
+   * @generated from field: string sort_order = 9;
+   */
+  sortOrder: string;
+
+  /**
+   * Pagination info - by offset
+   *
+   * @generated from field: lilbattle.v1.Pagination pagination = 10;
+   */
+  pagination?: Pagination;
+};
+
+/**
+ * Describes the message lilbattle.v1.SearchWorldsRequest.
+ * Use `create(SearchWorldsRequestSchema)` to create a new message.
+ */
+export const SearchWorldsRequestSchema: GenMessage<SearchWorldsRequest> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_world_service, 21);
+
+/**
+ * A value of a search facet and how many results have it
+ *
+ * @generated from message lilbattle.v1.SearchFacetCount
+ */
+export type SearchFacetCount = Message<"lilbattle.v1.SearchFacetCount"> & {
+  /**
+   * @generated from field: string value = 1;
+   */
+  value: string;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message lilbattle.v1.SearchFacetCount.
+ * Use `create(SearchFacetCountSchema)` to create a new message.
+ */
+export const SearchFacetCountSchema: GenMessage<SearchFacetCount> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_world_service, 22);
+
+/**
+ * @generated from message lilbattle.v1.SearchWorldsResponse
+ */
+export type SearchWorldsResponse = Message<"lilbattle.v1.SearchWorldsResponse"> & {
+  /**
+   * @generated from field: repeated lilbattle.v1.World items = 1;
+   */
+  items: World[];
+
+  /**
+   * @generated from field: lilbattle.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+
+  /**
+   * Facets of all the results (not just this page), most common first
+   *
+   * @generated from field: repeated lilbattle.v1.SearchFacetCount tags = 3;
+   */
+  tags: SearchFacetCount[];
+
+  /**
+   * @generated from field: repeated lilbattle.v1.SearchFacetCount difficulties = 4;
+   */
+  difficulties: SearchFacetCount[];
+
+  /**
+   * @generated from field: repeated lilbattle.v1.SearchFacetCount player_counts = 5;
+   */
+  playerCounts: SearchFacetCount[];
+};
+
+/**
+ * Describes the message lilbattle.v1.SearchWorldsResponse.
+ * Use `create(SearchWorldsResponseSchema)` to create a new message.
+ */
+export const SearchWorldsResponseSchema: GenMessage<SearchWorldsResponse> = /*@__PURE__*/
+  messageDesc(file_lilbattle_v1_models_world_service, 23);
+
@@ -8,14 +8,14 @@ import { file_google_api_annotations } from "../../../google/api/annotations_pb"
 import { file_protoc_gen_openapiv2_options_annotations } from "../../../protoc-gen-openapiv2/options/annotations_pb";
 import { file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
 import { file_lilbattle_v1_models_models } from "../models/models_pb";
-import type { CreateGameRequestSchema, CreateGameResponseSchema, DeleteGameRequestSchema, DeleteGameResponseSchema, GetGameRequestSchema, GetGameResponseSchema, GetGamesRequestSchema, GetGamesResponseSchema, GetGameStateRequestSchema, GetGameStateResponseSchema, GetOptionsAtRequestSchema, GetOptionsAtResponseSchema, JoinGameRequestSchema, JoinGameResponseSchema, ListGamesRequestSchema, ListGamesResponseSchema, ListMovesRequestSchema, ListMovesResponseSchema, ProcessMovesRequestSchema, ProcessMovesResponseSchema, SimulateAttackRequestSchema, SimulateAttackResponseSchema, SimulateFixRequestSchema, SimulateFixResponseSchema, UpdateGameRequestSchema, UpdateGameResponseSchema } from "../models/games_service_pb";
+import type { CommitOrdersRequestSchema, CommitOrdersResponseSchema, CreateGameRequestSchema, CreateGameResponseSchema, DeleteGameRequestSchema, DeleteGameResponseSchema, ExportGameRequestSchema, ExportGameResponseSchema, GetGameRequestSchema, GetGameResponseSchema, GetGamesRequestSchema, GetGamesResponseSchema, GetGameStateRequestSchema, GetGameStateResponseSchema, GetOptionsAtRequestSchema, GetOptionsAtResponseSchema, ImportGameRequestSchema, ImportGameResponseSchema, JoinGameRequestSchema, JoinGameResponseSchema, ListGamesRequestSchema, ListGamesResponseSchema, ListMovesRequestSchema, ListMovesResponseSchema, ListMyGamesRequestSchema, ListMyGamesResponseSchema, ProcessMovesRequestSchema, ProcessMovesResponseSchema, RevealOrdersRequestSchema, RevealOrdersResponseSchema, SimulateAttackRequestSchema, SimulateAttackResponseSchema, SimulateFixRequestSchema, SimulateFixResponseSchema, UpdateGameRequestSchema, UpdateGameResponseSchema } from "../models/games_service_pb";
 import { file_lilbattle_v1_models_games_service } from "../models/games_service_pb";
 
 /**
  * Describes the file lilbattle/v1/services/games.proto.
  */
 export const file_lilbattle_v1_services_games: GenFile = /*@__PURE__*/
-  fileDesc("CiFsaWxiYXR0bGUvdjEvc2VydmljZXMvZ2FtZXMucHJvdG8SDGxpbGJhdHRsZS52MTKGDAoMR2FtZXNTZXJ2aWNlEmUKCkNyZWF0ZUdhbWUSHy5saWxiYXR0bGUudjEuQ3JlYXRlR2FtZVJlcXVlc3QaIC5saWxiYXR0bGUudjEuQ3JlYXRlR2FtZVJlc3BvbnNlIhSC0+STAg46ASoiCS92MS9nYW1lcxJlCghHZXRHYW1lcxIdLmxpbGJhdHRsZS52MS5HZXRHYW1lc1JlcXVlc3QaHi5saWxiYXR0bGUudjEuR2V0R2FtZXNSZXNwb25zZSIagtPkkwIUEhIvdjEvZ2FtZXM6YmF0Y2hHZXQSXwoJTGlzdEdhbWVzEh4ubGlsYmF0dGxlLnYxLkxpc3RHYW1lc1JlcXVlc3QaHy5saWxiYXR0bGUudjEuTGlzdEdhbWVzUmVzcG9uc2UiEYLT5JMCCxIJL3YxL2dhbWVzEl4KB0dldEdhbWUSHC5saWxiYXR0bGUudjEuR2V0R2FtZVJlcXVlc3QaHS5saWxiYXR0bGUudjEuR2V0R2FtZVJlc3BvbnNlIhaC0+STAhASDi92MS9nYW1lcy97aWR9EmkKCkRlbGV0ZUdhbWUSHy5saWxiYXR0bGUudjEuRGVsZXRlR2FtZVJlcXVlc3QaIC5saWxiYXR0bGUudjEuRGVsZXRlR2FtZVJlc3BvbnNlIhiC0+STAhIqEC92MS9nYW1lcy97aWQ9Kn0ScQoKVXBkYXRlR2FtZRIfLmxpbGJhdHRsZS52MS5VcGRhdGVHYW1lUmVxdWVzdBogLmxpbGJhdHRsZS52MS5VcGRhdGVHYW1lUmVzcG9uc2UiIILT5JMCGjoBKjIVL3YxL2dhbWVzL3tnYW1lX2lkPSp9EngKDEdldEdhbWVTdGF0ZRIhLmxpbGJhdHRsZS52MS5HZXRHYW1lU3RhdGVSZXF1ZXN0GiIubGlsYmF0dGxlLnYxLkdldEdhbWVTdGF0ZVJlc3BvbnNlIiGC0+STAhsSGS92MS9nYW1lcy97Z2FtZV9pZH0vc3RhdGUSbwoJTGlzdE1vdmVzEh4ubGlsYmF0dGxlLnYxLkxpc3RNb3Zlc1JlcXVlc3QaHy5saWxiYXR0bGUudjEuTGlzdE1vdmVzUmVzcG9uc2UiIYLT5JMCGxIZL3YxL2dhbWVzL3tnYW1lX2lkfS9tb3ZlcxJ7CgxQcm9jZXNzTW92ZXMSIS5saWxiYXR0bGUudjEuUHJvY2Vzc01vdmVzUmVxdWVzdBoiLmxpbGJhdHRsZS52MS5Qcm9jZXNzTW92ZXNSZXNwb25zZSIkgtPkkwIeOgEqIhkvdjEvZ2FtZXMve2dhbWVfaWR9L21vdmVzErUBCgxHZXRPcHRpb25zQXQSIS5saWxiYXR0bGUudjEuR2V0T3B0aW9uc0F0UmVxdWVzdBoiLmxpbGJhdHRsZS52MS5HZXRPcHRpb25zQXRSZXNwb25zZSJegtPkkwJYWikSJy92MS9nYW1lcy97Z2FtZV9pZH0vb3B0aW9ucy97cG9zLmxhYmVsfRIrL3YxL2dhbWVzL3tnYW1lX2lkfS9vcHRpb25zL3twb3MucX0ve3Bvcy5yfRKBAQoOU2ltdWxhdGVBdHRhY2sSIy5saWxiYXR0bGUudjEuU2ltdWxhdGVBdHRhY2tSZXF1ZXN0GiQubGlsYmF0dGxlLnYxLlNpbXVsYXRlQXR0YWNrUmVzcG9uc2UiJILT5JMCHjoBKiIZL3YxL2dhbWVzL3NpbXVsYXRlX2F0dGFjaxJ1CgtTaW11bGF0ZUZpeBIgLmxpbGJhdHRsZS52MS5TaW11bGF0ZUZpeFJlcXVlc3QaIS5saWxiYXR0bGUudjEuU2ltdWxhdGVGaXhSZXNwb25zZSIhgtPkkwIbOgEqIhYvdjEvZ2FtZXMvc2ltdWxhdGVfZml4Em4KCEpvaW5HYW1lEh0ubGlsYmF0dGxlLnYxLkpvaW5HYW1lUmVxdWVzdBoeLmxpbGJhdHRsZS52MS5Kb2luR2FtZVJlc3BvbnNlIiOC0+STAh06ASoiGC92MS9nYW1lcy97Z2FtZV9pZH0vam9pbkK4AQoQY29tLmxpbGJhdHRsZS52MUIKR2FtZXNQcm90b1ABWkdnaXRodWIuY29tL3R1cm5mb3JnZS9saWxiYXR0bGUvZ2VuL2dvL2xpbGJhdHRsZS92MS9zZXJ2aWNlcztsaWxiYXR0bGV2MaICA0xYWKoCDExpbGJhdHRsZS5WMcoCDExpbGJhdHRsZVxWMeICGExpbGJhdHRsZVxWMVxHUEJNZXRhZGF0YeoCDUxpbGJhdHRsZTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_protoc_gen_openapiv2_options_annotations, file_google_protobuf_field_mask, file_lilbattle_v1_models_models, file_lilbattle_v1_models_games_service]);
+  fileDesc("CiFsaWxiYXR0bGUvdjEvc2VydmljZXMvZ2FtZXMucHJvdG8SDGxpbGJhdHRsZS52MTLgEAoMR2FtZXNTZXJ2aWNlEmUKCkNyZWF0ZUdhbWUSHy5saWxiYXR0bGUudjEuQ3JlYXRlR2FtZVJlcXVlc3QaIC5saWxiYXR0bGUudjEuQ3JlYXRlR2FtZVJlc3BvbnNlIhSC0+STAg46ASoiCS92MS9nYW1lcxJlCghHZXRHYW1lcxIdLmxpbGJhdHRsZS52MS5HZXRHYW1lc1JlcXVlc3QaHi5saWxiYXR0bGUudjEuR2V0R2FtZXNSZXNwb25zZSIagtPkkwIUEhIvdjEvZ2FtZXM6YmF0Y2hHZXQSXwoJTGlzdEdhbWVzEh4ubGlsYmF0dGxlLnYxLkxpc3RHYW1lc1JlcXVlc3QaHy5saWxiYXR0bGUudjEuTGlzdEdhbWVzUmVzcG9uc2UiEYLT5JMCCxIJL3YxL2dhbWVzEl4KB0dldEdhbWUSHC5saWxiYXR0bGUudjEuR2V0R2FtZVJlcXVlc3QaHS5saWxiYXR0bGUudjEuR2V0R2FtZVJlc3BvbnNlIhaC0+STAhASDi92MS9nYW1lcy97aWR9EmkKCkRlbGV0ZUdhbWUSHy5saWxiYXR0bGUudjEuRGVsZXRlR2FtZVJlcXVlc3QaIC5saWxiYXR0bGUudjEuRGVsZXRlR2FtZVJlc3BvbnNlIhiC0+STAhIqEC92MS9nYW1lcy97aWQ9Kn0ScQoKVXBkYXRlR2FtZRIfLmxpbGJhdHRsZS52MS5VcGRhdGVHYW1lUmVxdWVzdBogLmxpbGJhdHRsZS52MS5VcGRhdGVHYW1lUmVzcG9uc2UiIILT5JMCGjoBKjIVL3YxL2dhbWVzL3tnYW1lX2lkPSp9EngKDEdldEdhbWVTdGF0ZRIhLmxpbGJhdHRsZS52MS5HZXRHYW1lU3RhdGVSZXF1ZXN0GiIubGlsYmF0dGxlLnYxLkdldEdhbWVTdGF0ZVJlc3BvbnNlIiGC0+STAhsSGS92MS9nYW1lcy97Z2FtZV9pZH0vc3RhdGUSbwoJTGlzdE1vdmVzEh4ubGlsYmF0dGxlLnYxLkxpc3RNb3Zlc1JlcXVlc3QaHy5saWxiYXR0bGUudjEuTGlzdE1vdmVzUmVzcG9uc2UiIYLT5JMCGxIZL3YxL2dhbWVzL3tnYW1lX2lkfS9tb3ZlcxJ7CgxQcm9jZXNzTW92ZXMSIS5saWxiYXR0bGUudjEuUHJvY2Vzc01vdmVzUmVxdWVzdBoiLmxpbGJhdHRsZS52MS5Qcm9jZXNzTW92ZXNSZXNwb25zZSIkgtPkkwIeOgEqIhkvdjEvZ2FtZXMve2dhbWVfaWR9L21vdmVzErUBCgxHZXRPcHRpb25zQXQSIS5saWxiYXR0bGUudjEuR2V0T3B0aW9uc0F0UmVxdWVzdBoiLmxpbGJhdHRsZS52MS5HZXRPcHRpb25zQXRSZXNwb25zZSJegtPkkwJYWikSJy92MS9nYW1lcy97Z2FtZV9pZH0vb3B0aW9ucy97cG9zLmxhYmVsfRIrL3YxL2dhbWVzL3tnYW1lX2lkfS9vcHRpb25zL3twb3MucX0ve3Bvcy5yfRKBAQoOU2ltdWxhdGVBdHRhY2sSIy5saWxiYXR0bGUudjEuU2ltdWxhdGVBdHRhY2tSZXF1ZXN0GiQubGlsYmF0dGxlLnYxLlNpbXVsYXRlQXR0YWNrUmVzcG9uc2UiJILT5JMCHjoBKiIZL3YxL2dhbWVzL3NpbXVsYXRlX2F0dGFjaxJ1CgtTaW11bGF0ZUZpeBIgLmxpbGJhdHRsZS52MS5TaW11bGF0ZUZpeFJlcXVlc3QaIS5saWxiYXR0bGUudjEuU2ltdWxhdGVGaXhSZXNwb25zZSIhgtPkkwIbOgEqIhYvdjEvZ2FtZXMvc2ltdWxhdGVfZml4Em4KCEpvaW5HYW1lEh0ubGlsYmF0dGxlLnYxLkpvaW5HYW1lUmVxdWVzdBoeLmxpbGJhdHRsZS52MS5Kb2luR2FtZVJlc3BvbnNlIiOC0+STAh06ASoiGC92MS9nYW1lcy97Z2FtZV9pZH0vam9pbhKDAQoMQ29tbWl0T3JkZXJzEiEubGlsYmF0dGxlLnYxLkNvbW1pdE9yZGVyc1JlcXVlc3QaIi5saWxiYXR0bGUudjEuQ29tbWl0T3JkZXJzUmVzcG9uc2UiLILT5JMCJjoBKiIhL3YxL2dhbWVzL3tnYW1lX2lkfS9vcmRlcnMvY29tbWl0EoMBCgxSZXZlYWxPcmRlcnMSIS5saWxiYXR0bGUudjEuUmV2ZWFsT3JkZXJzUmVxdWVzdBoiLmxpbGJhdHRsZS52MS5SZXZlYWxPcmRlcnNSZXNwb25zZSIsgtPkkwImOgEqIiEvdjEvZ2FtZXMve2dhbWVfaWR9L29yZGVycy9yZXZlYWwSaAoLTGlzdE15R2FtZXMSIC5saWxiYXR0bGUudjEuTGlzdE15R2FtZXNSZXF1ZXN0GiEubGlsYmF0dGxlLnYxLkxpc3RNeUdhbWVzUmVzcG9uc2UiFILT5JMCDhIML3YxL21lL2dhbWVzEnQKCkV4cG9ydEdhbWUSHy5saWxiYXR0bGUudjEuRXhwb3J0R2FtZVJlcXVlc3QaIC5saWxiYXR0bGUudjEuRXhwb3J0R2FtZVJlc3BvbnNlIiOC0+STAh0SGy92MS9nYW1lcy97Z2FtZV9pZH0vYXJjaGl2ZRJsCgpJbXBvcnRHYW1lEh8ubGlsYmF0dGxlLnYxLkltcG9ydEdhbWVSZXF1ZXN0GiAubGlsYmF0dGxlLnYxLkltcG9ydEdhbWVSZXNwb25zZSIbgtPkkwIVOgEqIhAvdjEvZ2FtZXM6aW1wb3J0QrgBChBjb20ubGlsYmF0dGxlLnYxQgpHYW1lc1Byb3RvUAFaR2dpdGh1Yi5jb20vdHVybmZvcmdlL2xpbGJhdHRsZS9nZW4vZ28vbGlsYmF0dGxlL3YxL3NlcnZpY2VzO2xpbGJhdHRsZXYxogIDTFhYqgIMTGlsYmF0dGxlLlYxygIMTGlsYmF0dGxlXFYx4gIYTGlsYmF0dGxlXFYxXEdQQk1ldGFkYXRh6gINTGlsYmF0dGxlOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_protoc_gen_openapiv2_options_annotations, file_google_protobuf_field_mask, file_lilbattle_v1_models_models, file_lilbattle_v1_models_games_service]);
 
 /**
  * GamesService manages the game examples catalog
@@ -158,6 +158,66 @@ export const GamesService: GenService<{
     input: typeof JoinGameRequestSchema;
     output: typeof JoinGameResponseSchema;
   },
+  /**
+   * *
+   * Commit a hash of the caller's orders for the current turn.
+   * Only valid for games in simultaneous turn mode.
+   *
+   * @generated from rpc lilbattle.v1.GamesService.CommitOrders
+   */
+  commitOrders: {
+    methodKind: "unary";
+    input: typeof CommitOrdersRequestSchema;
+    output: typeof CommitOrdersResponseSchema;
+  },
+  /**
+   * *
+   * Reveal the caller's committed orders.  Once all players have revealed,
+   * the turn is resolved for everyone at once.
+   *
+   * @generated from rpc lilbattle.v1.GamesService.RevealOrders
+   */
+  revealOrders: {
+    methodKind: "unary";
+    input: typeof RevealOrdersRequestSchema;
+    output: typeof RevealOrdersResponseSchema;
+  },
+  /**
+   * *
+   * List the games the caller holds a player slot in along with whose turn
+   * it is.  Served from the per-user game index.
+   *
+   * @generated from rpc lilbattle.v1.GamesService.ListMyGames
+   */
+  listMyGames: {
+    methodKind: "unary";
+    input: typeof ListMyGamesRequestSchema;
+    output: typeof ListMyGamesResponseSchema;
+  },
+  /**
+   * *
+   * Export a game as a portable archive bundling its state, history, the
+   * world it started from and the rules it was played with
+   *
+   * @generated from rpc lilbattle.v1.GamesService.ExportGame
+   */
+  exportGame: {
+    methodKind: "unary";
+    input: typeof ExportGameRequestSchema;
+    output: typeof ExportGameResponseSchema;
+  },
+  /**
+   * *
+   * Import a game archive.  The archive's content hash is checked and its
+   * history replayed against the bundled rules before the game is saved.
+   *
+   * @generated from rpc lilbattle.v1.GamesService.ImportGame
+   */
+  importGame: {
+    methodKind: "unary";
+    input: typeof ImportGameRequestSchema;
+    output: typeof ImportGameResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_lilbattle_v1_services_games, 0);
 
@@ -0,0 +1,58 @@
+// @generated by protoc-gen-es v2.9.0 with parameter "target=ts"
+// @generated from file lilbattle/v1/services/jobs.proto (package lilbattle.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_api_annotations } from "../../../google/api/annotations_pb";
+import type { GetJobRequestSchema, GetJobResponseSchema, ListJobsRequestSchema, ListJobsResponseSchema, RetryJobRequestSchema, RetryJobResponseSchema } from "../models/jobs_pb";
+import { file_lilbattle_v1_models_jobs } from "../models/jobs_pb";
+
+/**
+ * Describes the file lilbattle/v1/services/jobs.proto.
+ */
+export const file_lilbattle_v1_services_jobs: GenFile = /*@__PURE__*/
+  fileDesc("CiBsaWxiYXR0bGUvdjEvc2VydmljZXMvam9icy5wcm90bxIMbGlsYmF0dGxlLnYxMrECCgtKb2JzU2VydmljZRJbCghMaXN0Sm9icxIdLmxpbGJhdHRsZS52MS5MaXN0Sm9ic1JlcXVlc3QaHi5saWxiYXR0bGUudjEuTGlzdEpvYnNSZXNwb25zZSIQgtPkkwIKEggvdjEvam9icxJaCgZHZXRKb2ISGy5saWxiYXR0bGUudjEuR2V0Sm9iUmVxdWVzdBocLmxpbGJhdHRsZS52MS5HZXRKb2JSZXNwb25zZSIVgtPkkwIPEg0vdjEvam9icy97aWR9EmkKCFJldHJ5Sm9iEh0ubGlsYmF0dGxlLnYxLlJldHJ5Sm9iUmVxdWVzdBoeLmxpbGJhdHRsZS52MS5SZXRyeUpvYlJlc3BvbnNlIh6C0+STAhg6ASoiEy92MS9qb2JzL3tpZH06cmV0cnlCtwEKEGNvbS5saWxiYXR0bGUudjFCCUpvYnNQcm90b1ABWkdnaXRodWIuY29tL3R1cm5mb3JnZS9saWxiYXR0bGUvZ2VuL2dvL2xpbGJhdHRsZS92MS9zZXJ2aWNlcztsaWxiYXR0bGV2MaICA0xYWKoCDExpbGJhdHRsZS5WMcoCDExpbGJhdHRsZVxWMeICGExpbGJhdHRsZVxWMVxHUEJNZXRhZGF0YeoCDUxpbGJhdHRsZTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_lilbattle_v1_models_jobs]);
+
+/**
+ * Inspects and manages the background jobs queue
+ *
+ * @generated from service lilbattle.v1.JobsService
+ */
+export const JobsService: GenService<{
+  /**
+   * *
+   * List jobs, optionally by type and status
+   *
+   * @generated from rpc lilbattle.v1.JobsService.ListJobs
+   */
+  listJobs: {
+    methodKind: "unary";
+    input: typeof ListJobsRequestSchema;
+    output: typeof ListJobsResponseSchema;
+  },
+  /**
+   * *
+   * Get a job with its run history
+   *
+   * @generated from rpc lilbattle.v1.JobsService.GetJob
+   */
+  getJob: {
+    methodKind: "unary";
+    input: typeof GetJobRequestSchema;
+    output: typeof GetJobResponseSchema;
+  },
+  /**
+   * *
+   * Run a job again now, with fresh retries
+   *
+   * @generated from rpc lilbattle.v1.JobsService.RetryJob
+   */
+  retryJob: {
+    methodKind: "unary";
+    input: typeof RetryJobRequestSchema;
+    output: typeof RetryJobResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_lilbattle_v1_services_jobs, 0);
+
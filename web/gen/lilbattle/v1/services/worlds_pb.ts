@@ -6,7 +6,7 @@ import type { GenFile, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
 import { file_lilbattle_v1_models_models } from "../models/models_pb";
-import type { CreateWorldRequestSchema, CreateWorldResponseSchema, DeleteWorldRequestSchema, DeleteWorldResponseSchema, GetWorldRequestSchema, GetWorldResponseSchema, GetWorldsRequestSchema, GetWorldsResponseSchema, ListWorldsRequestSchema, ListWorldsResponseSchema, UpdateWorldRequestSchema, UpdateWorldResponseSchema } from "../models/world_service_pb";
+import type { CreateWorldRequestSchema, CreateWorldResponseSchema, DeleteWorldRequestSchema, DeleteWorldResponseSchema, GetWorldRequestSchema, GetWorldResponseSchema, GetWorldsRequestSchema, GetWorldsResponseSchema, ListDerivedWorldsRequestSchema, ListDerivedWorldsResponseSchema, ListWorldRevisionsRequestSchema, ListWorldRevisionsResponseSchema, ListWorldsRequestSchema, ListWorldsResponseSchema, MergeWorldUpstreamRequestSchema, MergeWorldUpstreamResponseSchema, RevertWorldRequestSchema, RevertWorldResponseSchema, SearchWorldsRequestSchema, SearchWorldsResponseSchema, UpdateWorldRequestSchema, UpdateWorldResponseSchema } from "../models/world_service_pb";
 import { file_lilbattle_v1_models_world_service } from "../models/world_service_pb";
 import { file_google_api_annotations } from "../../../google/api/annotations_pb";
 import { file_protoc_gen_openapiv2_options_annotations } from "../../../protoc-gen-openapiv2/options/annotations_pb";
@@ -15,7 +15,7 @@ import { file_protoc_gen_openapiv2_options_annotations } from "../../../protoc-g
  * Describes the file lilbattle/v1/services/worlds.proto.
  */
 export const file_lilbattle_v1_services_worlds: GenFile = /*@__PURE__*/
-  fileDesc("CiJsaWxiYXR0bGUvdjEvc2VydmljZXMvd29ybGRzLnByb3RvEgxsaWxiYXR0bGUudjEylQUKDVdvcmxkc1NlcnZpY2USaQoLQ3JlYXRlV29ybGQSIC5saWxiYXR0bGUudjEuQ3JlYXRlV29ybGRSZXF1ZXN0GiEubGlsYmF0dGxlLnYxLkNyZWF0ZVdvcmxkUmVzcG9uc2UiFYLT5JMCDzoBKiIKL3YxL3dvcmxkcxJpCglHZXRXb3JsZHMSHi5saWxiYXR0bGUudjEuR2V0V29ybGRzUmVxdWVzdBofLmxpbGJhdHRsZS52MS5HZXRXb3JsZHNSZXNwb25zZSIbgtPkkwIVEhMvdjEvd29ybGRzOmJhdGNoR2V0EmMKCkxpc3RXb3JsZHMSHy5saWxiYXR0bGUudjEuTGlzdFdvcmxkc1JlcXVlc3QaIC5saWxiYXR0bGUudjEuTGlzdFdvcmxkc1Jlc3BvbnNlIhKC0+STAgwSCi92MS93b3JsZHMSYgoIR2V0V29ybGQSHS5saWxiYXR0bGUudjEuR2V0V29ybGRSZXF1ZXN0Gh4ubGlsYmF0dGxlLnYxLkdldFdvcmxkUmVzcG9uc2UiF4LT5JMCERIPL3YxL3dvcmxkcy97aWR9Em0KC0RlbGV0ZVdvcmxkEiAubGlsYmF0dGxlLnYxLkRlbGV0ZVdvcmxkUmVxdWVzdBohLmxpbGJhdHRsZS52MS5EZWxldGVXb3JsZFJlc3BvbnNlIhmC0+STAhMqES92MS93b3JsZHMve2lkPSp9EnYKC1VwZGF0ZVdvcmxkEiAubGlsYmF0dGxlLnYxLlVwZGF0ZVdvcmxkUmVxdWVzdBohLmxpbGJhdHRsZS52MS5VcGRhdGVXb3JsZFJlc3BvbnNlIiKC0+STAhw6ASoyFy92MS93b3JsZHMve3dvcmxkLmlkPSp9QrkBChBjb20ubGlsYmF0dGxlLnYxQgtXb3JsZHNQcm90b1ABWkdnaXRodWIuY29tL3R1cm5mb3JnZS9saWxiYXR0bGUvZ2VuL2dvL2xpbGJhdHRsZS92MS9zZXJ2aWNlcztsaWxiYXR0bGV2MaICA0xYWKoCDExpbGJhdHRsZS5WMcoCDExpbGJhdHRsZVxWMeICGExpbGJhdHRsZVxWMVxHUEJNZXRhZGF0YeoCDUxpbGJhdHRsZTo6VjFiBnByb3RvMw", [file_google_protobuf_field_mask, file_lilbattle_v1_models_models, file_lilbattle_v1_models_world_service, file_google_api_annotations, file_protoc_gen_openapiv2_options_annotations]);
+  fileDesc("CiJsaWxiYXR0bGUvdjEvc2VydmljZXMvd29ybGRzLnByb3RvEgxsaWxiYXR0bGUudjEyvwoKDVdvcmxkc1NlcnZpY2USaQoLQ3JlYXRlV29ybGQSIC5saWxiYXR0bGUudjEuQ3JlYXRlV29ybGRSZXF1ZXN0GiEubGlsYmF0dGxlLnYxLkNyZWF0ZVdvcmxkUmVzcG9uc2UiFYLT5JMCDzoBKiIKL3YxL3dvcmxkcxJpCglHZXRXb3JsZHMSHi5saWxiYXR0bGUudjEuR2V0V29ybGRzUmVxdWVzdBofLmxpbGJhdHRsZS52MS5HZXRXb3JsZHNSZXNwb25zZSIbgtPkkwIVEhMvdjEvd29ybGRzOmJhdGNoR2V0EmMKCkxpc3RXb3JsZHMSHy5saWxiYXR0bGUudjEuTGlzdFdvcmxkc1JlcXVlc3QaIC5saWxiYXR0bGUudjEuTGlzdFdvcmxkc1Jlc3BvbnNlIhKC0+STAgwSCi92MS93b3JsZHMSYgoIR2V0V29ybGQSHS5saWxiYXR0bGUudjEuR2V0V29ybGRSZXF1ZXN0Gh4ubGlsYmF0dGxlLnYxLkdldFdvcmxkUmVzcG9uc2UiF4LT5JMCERIPL3YxL3dvcmxkcy97aWR9Em0KC0RlbGV0ZVdvcmxkEiAubGlsYmF0dGxlLnYxLkRlbGV0ZVdvcmxkUmVxdWVzdBohLmxpbGJhdHRsZS52MS5EZWxldGVXb3JsZFJlc3BvbnNlIhmC0+STAhMqES92MS93b3JsZHMve2lkPSp9EnYKC1VwZGF0ZVdvcmxkEiAubGlsYmF0dGxlLnYxLlVwZGF0ZVdvcmxkUmVxdWVzdBohLmxpbGJhdHRsZS52MS5VcGRhdGVXb3JsZFJlc3BvbnNlIiKC0+STAhw6ASoyFy92MS93b3JsZHMve3dvcmxkLmlkPSp9EpABChJMaXN0V29ybGRSZXZpc2lvbnMSJy5saWxiYXR0bGUudjEuTGlzdFdvcmxkUmV2aXNpb25zUmVxdWVzdBooLmxpbGJhdHRsZS52MS5MaXN0V29ybGRSZXZpc2lvbnNSZXNwb25zZSIngtPkkwIhEh8vdjEvd29ybGRzL3t3b3JsZF9pZH0vcmV2aXNpb25zEnsKC1JldmVydFdvcmxkEiAubGlsYmF0dGxlLnYxLlJldmVydFdvcmxkUmVxdWVzdBohLmxpbGJhdHRsZS52MS5SZXZlcnRXb3JsZFJlc3BvbnNlIieC0+STAiE6ASoiHC92MS93b3JsZHMve3dvcmxkX2lkfTpyZXZlcnQSiwEKEUxpc3REZXJpdmVkV29ybGRzEiYubGlsYmF0dGxlLnYxLkxpc3REZXJpdmVkV29ybGRzUmVxdWVzdBonLmxpbGJhdHRsZS52MS5MaXN0RGVyaXZlZFdvcmxkc1Jlc3BvbnNlIiWC0+STAh8SHS92MS93b3JsZHMve3dvcmxkX2lkfS9kZXJpdmVkEnAKDFNlYXJjaFdvcmxkcxIhLmxpbGJhdHRsZS52MS5TZWFyY2hXb3JsZHNSZXF1ZXN0GiIubGlsYmF0dGxlLnYxLlNlYXJjaFdvcmxkc1Jlc3BvbnNlIhmC0+STAhMSES92MS93b3JsZHM6c2VhcmNoEpcBChJNZXJnZVdvcmxkVXBzdHJlYW0SJy5saWxiYXR0bGUudjEuTWVyZ2VXb3JsZFVwc3RyZWFtUmVxdWVzdBooLmxpbGJhdHRsZS52MS5NZXJnZVdvcmxkVXBzdHJlYW1SZXNwb25zZSIugtPkkwIoOgEqIiMvdjEvd29ybGRzL3t3b3JsZF9pZH06bWVyZ2VVcHN0cmVhbUK5AQoQY29tLmxpbGJhdHRsZS52MUILV29ybGRzUHJvdG9QAVpHZ2l0aHViLmNvbS90dXJuZm9yZ2UvbGlsYmF0dGxlL2dlbi9nby9saWxiYXR0bGUvdjEvc2VydmljZXM7bGlsYmF0dGxldjGiAgNMWFiqAgxMaWxiYXR0bGUuVjHKAgxMaWxiYXR0bGVcVjHiAhhMaWxiYXR0bGVcVjFcR1BCTWV0YWRhdGHqAg1MaWxiYXR0bGU6OlYxYgZwcm90bzM", [file_google_protobuf_field_mask, file_lilbattle_v1_models_models, file_lilbattle_v1_models_world_service, file_google_api_annotations, file_protoc_gen_openapiv2_options_annotations]);
 
 /**
  * WorldsService manages the world examples catalog
@@ -86,6 +86,63 @@ export const WorldsService: GenService<{
     input: typeof UpdateWorldRequestSchema;
     output: typeof UpdateWorldResponseSchema;
   },
+  /**
+   * ListWorldRevisions returns a world's immutable revisions, newest first
+   *
+   * @generated from rpc lilbattle.v1.WorldsService.ListWorldRevisions
+   */
+  listWorldRevisions: {
+    methodKind: "unary";
+    input: typeof ListWorldRevisionsRequestSchema;
+    output: typeof ListWorldRevisionsResponseSchema;
+  },
+  /**
+   * *
+   * Revert a world to an older revision.  The old data is saved as a new
+   * revision rather than discarding the ones after it.
+   *
+   * @generated from rpc lilbattle.v1.WorldsService.RevertWorld
+   */
+  revertWorld: {
+    methodKind: "unary";
+    input: typeof RevertWorldRequestSchema;
+    output: typeof RevertWorldResponseSchema;
+  },
+  /**
+   * ListDerivedWorlds returns the worlds copied (remixed) from a world
+   *
+   * @generated from rpc lilbattle.v1.WorldsService.ListDerivedWorlds
+   */
+  listDerivedWorlds: {
+    methodKind: "unary";
+    input: typeof ListDerivedWorldsRequestSchema;
+    output: typeof ListDerivedWorldsResponseSchema;
+  },
+  /**
+   * *
+   * Merge the changes made to a world's parent since it was copied into
+   * the world, with a three-way merge of tiles, units and crossings.
+   *
+   * @generated from rpc lilbattle.v1.WorldsService.MergeWorldUpstream
+   */
+  mergeWorldUpstream: {
+    methodKind: "unary";
+    input: typeof MergeWorldUpstreamRequestSchema;
+    output: typeof MergeWorldUpstreamResponseSchema;
+  },
+  /**
+   * *
+   * Search worlds by free text, tags, difficulty, player count and map
+   * size.  Results come from the world search index so recent changes show
+   * up once the world is reindexed.
+   *
+   * @generated from rpc lilbattle.v1.WorldsService.SearchWorlds
+   */
+  searchWorlds: {
+    methodKind: "unary";
+    input: typeof SearchWorldsRequestSchema;
+    output: typeof SearchWorldsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_lilbattle_v1_services_worlds, 0);
 
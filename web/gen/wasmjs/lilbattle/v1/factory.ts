@@ -10,9 +10,9 @@ import { lilbattle_v1SchemaRegistry } from "./schemas";
 
 
 
-import {AllPaths as AllPathsInterface,ApplyRemoteChangesRequest as ApplyRemoteChangesRequestInterface,ApplyRemoteChangesResponse as ApplyRemoteChangesResponseInterface,AssetResult as AssetResultInterface,AttackRecord as AttackRecordInterface,AttackUnitAction as AttackUnitActionInterface,BuildOptionClickedRequest as BuildOptionClickedRequestInterface,BuildOptionClickedResponse as BuildOptionClickedResponseInterface,BuildUnitAction as BuildUnitActionInterface,CaptureBuildingAction as CaptureBuildingActionInterface,CaptureStartedChange as CaptureStartedChangeInterface,ClearHighlightsRequest as ClearHighlightsRequestInterface,ClearHighlightsResponse as ClearHighlightsResponseInterface,ClearPathsRequest as ClearPathsRequestInterface,ClearPathsResponse as ClearPathsResponseInterface,ClientReadyRequest as ClientReadyRequestInterface,ClientReadyResponse as ClientReadyResponseInterface,CoinsChangedChange as CoinsChangedChangeInterface,CreateGameRequest as CreateGameRequestInterface,CreateGameResponse as CreateGameResponseInterface,CreateIndexRecordsLRORequest as CreateIndexRecordsLRORequestInterface,CreateIndexRecordsLROResponse as CreateIndexRecordsLROResponseInterface,CreateWorldRequest as CreateWorldRequestInterface,CreateWorldResponse as CreateWorldResponseInterface,Crossing as CrossingInterface,DamageDistribution as DamageDistributionInterface,DamageRange as DamageRangeInterface,DeleteGameRequest as DeleteGameRequestInterface,DeleteGameResponse as DeleteGameResponseInterface,DeleteIndexStatesRequest as DeleteIndexStatesRequestInterface,DeleteIndexStatesResponse as DeleteIndexStatesResponseInterface,DeleteWorldRequest as DeleteWorldRequestInterface,DeleteWorldResponse as DeleteWorldResponseInterface,EmptyRequest as EmptyRequestInterface,EmptyResponse as EmptyResponseInterface,EndTurnAction as EndTurnActionInterface,EndTurnButtonClickedRequest as EndTurnButtonClickedRequestInterface,EndTurnButtonClickedResponse as EndTurnButtonClickedResponseInterface,EnsureIndexStateRequest as EnsureIndexStateRequestInterface,EnsureIndexStateResponse as EnsureIndexStateResponseInterface,FixUnitAction as FixUnitActionInterface,Game as GameInterface,GameConfiguration as GameConfigurationInterface,GameMove as GameMoveInterface,GameMoveGroup as GameMoveGroupInterface,GameMoveHistory as GameMoveHistoryInterface,GameOption as GameOptionInterface,GamePlayer as GamePlayerInterface,GameSettings as GameSettingsInterface,GameState as GameStateInterface,GameTeam as GameTeamInterface,GetGameContentRequest as GetGameContentRequestInterface,GetGameContentResponse as GetGameContentResponseInterface,GetGameRequest as GetGameRequestInterface,GetGameResponse as GetGameResponseInterface,GetGameStateRequest as GetGameStateRequestInterface,GetGameStateResponse as GetGameStateResponseInterface,GetGamesRequest as GetGamesRequestInterface,GetGamesResponse as GetGamesResponseInterface,GetIndexRecordsLRORequest as GetIndexRecordsLRORequestInterface,GetIndexRecordsLROResponse as GetIndexRecordsLROResponseInterface,GetIndexStatesRequest as GetIndexStatesRequestInterface,GetIndexStatesResponse as GetIndexStatesResponseInterface,GetOptionsAtRequest as GetOptionsAtRequestInterface,GetOptionsAtResponse as GetOptionsAtResponseInterface,GetWorldRequest as GetWorldRequestInterface,GetWorldResponse as GetWorldResponseInterface,GetWorldsRequest as GetWorldsRequestInterface,GetWorldsResponse as GetWorldsResponseInterface,HealUnitAction as HealUnitActionInterface,HexCoord as HexCoordInterface,HighlightSpec as HighlightSpecInterface,IncomeConfig as IncomeConfigInterface,IndexInfo as IndexInfoInterface,IndexRecord as IndexRecordInterface,IndexRecordsLRO as IndexRecordsLROInterface,IndexState as IndexStateInterface,IndexStateList as IndexStateListInterface,InitializeGameRequest as InitializeGameRequestInterface,InitializeGameResponse as InitializeGameResponseInterface,InitializeSingletonRequest as InitializeSingletonRequestInterface,InitializeSingletonResponse as InitializeSingletonResponseInterface,Job as JobInterface,JoinGameRequest as JoinGameRequestInterface,JoinGameResponse as JoinGameResponseInterface,ListGamesRequest as ListGamesRequestInterface,ListGamesResponse as ListGamesResponseInterface,ListIndexStatesRequest as ListIndexStatesRequestInterface,ListIndexStatesResponse as ListIndexStatesResponseInterface,ListMovesRequest as ListMovesRequestInterface,ListMovesResponse as ListMovesResponseInterface,ListWorldsRequest as ListWorldsRequestInterface,ListWorldsResponse as ListWorldsResponseInterface,LogMessageRequest as LogMessageRequestInterface,LogMessageResponse as LogMessageResponseInterface,MoveUnitAction as MoveUnitActionInterface,MoveUnitRequest as MoveUnitRequestInterface,MoveUnitResponse as MoveUnitResponseInterface,Pagination as PaginationInterface,PaginationResponse as PaginationResponseInterface,Path as PathInterface,PathEdge as PathEdgeInterface,PlayerChangedChange as PlayerChangedChangeInterface,PlayerColor as PlayerColorInterface,PlayerState as PlayerStateInterface,Position as PositionInterface,ProcessMovesRequest as ProcessMovesRequestInterface,ProcessMovesResponse as ProcessMovesResponseInterface,RemoveTileAtRequest as RemoveTileAtRequestInterface,RemoveTileAtResponse as RemoveTileAtResponseInterface,RemoveUnitAtRequest as RemoveUnitAtRequestInterface,RemoveUnitAtResponse as RemoveUnitAtResponseInterface,RepeatInfo as RepeatInfoInterface,RulesEngine as RulesEngineInterface,Run as RunInterface,SceneClickedRequest as SceneClickedRequestInterface,SceneClickedResponse as SceneClickedResponseInterface,SetAllowedPanelsRequest as SetAllowedPanelsRequestInterface,SetAllowedPanelsResponse as SetAllowedPanelsResponseInterface,SetContentRequest as SetContentRequestInterface,SetContentResponse as SetContentResponseInterface,SetGameStateRequest as SetGameStateRequestInterface,SetGameStateResponse as SetGameStateResponseInterface,SetTileAtRequest as SetTileAtRequestInterface,SetTileAtResponse as SetTileAtResponseInterface,SetUnitAtRequest as SetUnitAtRequestInterface,SetUnitAtResponse as SetUnitAtResponseInterface,ShowAttackEffectRequest as ShowAttackEffectRequestInterface,ShowAttackEffectResponse as ShowAttackEffectResponseInterface,ShowBuildOptionsRequest as ShowBuildOptionsRequestInterface,ShowBuildOptionsResponse as ShowBuildOptionsResponseInterface,ShowCaptureEffectRequest as ShowCaptureEffectRequestInterface,ShowCaptureEffectResponse as ShowCaptureEffectResponseInterface,ShowHealEffectRequest as ShowHealEffectRequestInterface,ShowHealEffectResponse as ShowHealEffectResponseInterface,ShowHighlightsRequest as ShowHighlightsRequestInterface,ShowHighlightsResponse as ShowHighlightsResponseInterface,ShowPathRequest as ShowPathRequestInterface,ShowPathResponse as ShowPathResponseInterface,SimulateAttackRequest as SimulateAttackRequestInterface,SimulateAttackResponse as SimulateAttackResponseInterface,SimulateFixRequest as SimulateFixRequestInterface,SimulateFixResponse as SimulateFixResponseInterface,SplashTarget as SplashTargetInterface,TerrainDefinition as TerrainDefinitionInterface,TerrainMapping as TerrainMappingInterface,TerrainUnitProperties as TerrainUnitPropertiesInterface,ThemeInfo as ThemeInfoInterface,ThemeManifest as ThemeManifestInterface,Tile as TileInterface,TileCapturedChange as TileCapturedChangeInterface,TurnOptionClickedRequest as TurnOptionClickedRequestInterface,TurnOptionClickedResponse as TurnOptionClickedResponseInterface,Unit as UnitInterface,UnitBuiltChange as UnitBuiltChangeInterface,UnitDamagedChange as UnitDamagedChangeInterface,UnitDefinition as UnitDefinitionInterface,UnitFixedChange as UnitFixedChangeInterface,UnitHealedChange as UnitHealedChangeInterface,UnitKilledChange as UnitKilledChangeInterface,UnitMapping as UnitMappingInterface,UnitMovedChange as UnitMovedChangeInterface,UnitUnitProperties as UnitUnitPropertiesInterface,UpdateGameRequest as UpdateGameRequestInterface,UpdateGameResponse as UpdateGameResponseInterface,UpdateGameStatusRequest as UpdateGameStatusRequestInterface,UpdateGameStatusResponse as UpdateGameStatusResponseInterface,UpdateIndexRecordsLRORequest as UpdateIndexRecordsLRORequestInterface,UpdateIndexRecordsLROResponse as UpdateIndexRecordsLROResponseInterface,UpdateWorldRequest as UpdateWorldRequestInterface,UpdateWorldResponse as UpdateWorldResponseInterface,World as WorldInterface,WorldChange as WorldChangeInterface,WorldData as WorldDataInterface,WorldInfo as WorldInfoInterface} from "./models/interfaces";
+import {AllPaths as AllPathsInterface,ApplyRemoteChangesRequest as ApplyRemoteChangesRequestInterface,ApplyRemoteChangesResponse as ApplyRemoteChangesResponseInterface,AssetResult as AssetResultInterface,AttackRecord as AttackRecordInterface,AttackUnitAction as AttackUnitActionInterface,BuildOptionClickedRequest as BuildOptionClickedRequestInterface,BuildOptionClickedResponse as BuildOptionClickedResponseInterface,BuildUnitAction as BuildUnitActionInterface,CaptureBuildingAction as CaptureBuildingActionInterface,CaptureStartedChange as CaptureStartedChangeInterface,ClearHighlightsRequest as ClearHighlightsRequestInterface,ClearHighlightsResponse as ClearHighlightsResponseInterface,ClearPathsRequest as ClearPathsRequestInterface,ClearPathsResponse as ClearPathsResponseInterface,ClientReadyRequest as ClientReadyRequestInterface,ClientReadyResponse as ClientReadyResponseInterface,CoinsChangedChange as CoinsChangedChangeInterface,CommitOrdersRequest as CommitOrdersRequestInterface,CommitOrdersResponse as CommitOrdersResponseInterface,CreateGameRequest as CreateGameRequestInterface,CreateGameResponse as CreateGameResponseInterface,CreateIndexRecordsLRORequest as CreateIndexRecordsLRORequestInterface,CreateIndexRecordsLROResponse as CreateIndexRecordsLROResponseInterface,CreateWorldRequest as CreateWorldRequestInterface,CreateWorldResponse as CreateWorldResponseInterface,Crossing as CrossingInterface,DamageDistribution as DamageDistributionInterface,DamageRange as DamageRangeInterface,DeleteGameRequest as DeleteGameRequestInterface,DeleteGameResponse as DeleteGameResponseInterface,DeleteIndexStatesRequest as DeleteIndexStatesRequestInterface,DeleteIndexStatesResponse as DeleteIndexStatesResponseInterface,DeleteWorldRequest as DeleteWorldRequestInterface,DeleteWorldResponse as DeleteWorldResponseInterface,EmptyRequest as EmptyRequestInterface,EmptyResponse as EmptyResponseInterface,EndTurnAction as EndTurnActionInterface,EndTurnButtonClickedRequest as EndTurnButtonClickedRequestInterface,EndTurnButtonClickedResponse as EndTurnButtonClickedResponseInterface,EnsureIndexStateRequest as EnsureIndexStateRequestInterface,EnsureIndexStateResponse as EnsureIndexStateResponseInterface,ExportGameRequest as ExportGameRequestInterface,ExportGameResponse as ExportGameResponseInterface,FixUnitAction as FixUnitActionInterface,Game as GameInterface,GameConfiguration as GameConfigurationInterface,GameMove as GameMoveInterface,GameMoveGroup as GameMoveGroupInterface,GameMoveHistory as GameMoveHistoryInterface,GameOption as GameOptionInterface,GamePlayer as GamePlayerInterface,GameSettings as GameSettingsInterface,GameState as GameStateInterface,GameTeam as GameTeamInterface,GetGameContentRequest as GetGameContentRequestInterface,GetGameContentResponse as GetGameContentResponseInterface,GetGameRequest as GetGameRequestInterface,GetGameResponse as GetGameResponseInterface,GetGameStateRequest as GetGameStateRequestInterface,GetGameStateResponse as GetGameStateResponseInterface,GetGamesRequest as GetGamesRequestInterface,GetGamesResponse as GetGamesResponseInterface,GetIndexRecordsLRORequest as GetIndexRecordsLRORequestInterface,GetIndexRecordsLROResponse as GetIndexRecordsLROResponseInterface,GetIndexStatesRequest as GetIndexStatesRequestInterface,GetIndexStatesResponse as GetIndexStatesResponseInterface,GetJobRequest as GetJobRequestInterface,GetJobResponse as GetJobResponseInterface,GetOptionsAtRequest as GetOptionsAtRequestInterface,GetOptionsAtResponse as GetOptionsAtResponseInterface,GetWorldRequest as GetWorldRequestInterface,GetWorldResponse as GetWorldResponseInterface,GetWorldsRequest as GetWorldsRequestInterface,GetWorldsResponse as GetWorldsResponseInterface,HealUnitAction as HealUnitActionInterface,HexCoord as HexCoordInterface,HighlightSpec as HighlightSpecInterface,ImportGameRequest as ImportGameRequestInterface,ImportGameResponse as ImportGameResponseInterface,IncomeConfig as IncomeConfigInterface,IndexInfo as IndexInfoInterface,IndexRecord as IndexRecordInterface,IndexRecordsLRO as IndexRecordsLROInterface,IndexState as IndexStateInterface,IndexStateList as IndexStateListInterface,InitializeGameRequest as InitializeGameRequestInterface,InitializeGameResponse as InitializeGameResponseInterface,InitializeSingletonRequest as InitializeSingletonRequestInterface,InitializeSingletonResponse as InitializeSingletonResponseInterface,Job as JobInterface,JoinGameRequest as JoinGameRequestInterface,JoinGameResponse as JoinGameResponseInterface,ListDerivedWorldsRequest as ListDerivedWorldsRequestInterface,ListDerivedWorldsResponse as ListDerivedWorldsResponseInterface,ListGamesRequest as ListGamesRequestInterface,ListGamesResponse as ListGamesResponseInterface,ListIndexStatesRequest as ListIndexStatesRequestInterface,ListIndexStatesResponse as ListIndexStatesResponseInterface,ListJobsRequest as ListJobsRequestInterface,ListJobsResponse as ListJobsResponseInterface,ListMovesRequest as ListMovesRequestInterface,ListMovesResponse as ListMovesResponseInterface,ListMyGamesRequest as ListMyGamesRequestInterface,ListMyGamesResponse as ListMyGamesResponseInterface,ListWorldRevisionsRequest as ListWorldRevisionsRequestInterface,ListWorldRevisionsResponse as ListWorldRevisionsResponseInterface,ListWorldsRequest as ListWorldsRequestInterface,ListWorldsResponse as ListWorldsResponseInterface,LogMessageRequest as LogMessageRequestInterface,LogMessageResponse as LogMessageResponseInterface,MergeWorldUpstreamRequest as MergeWorldUpstreamRequestInterface,MergeWorldUpstreamResponse as MergeWorldUpstreamResponseInterface,MoveUnitAction as MoveUnitActionInterface,MoveUnitRequest as MoveUnitRequestInterface,MoveUnitResponse as MoveUnitResponseInterface,Pagination as PaginationInterface,PaginationResponse as PaginationResponseInterface,Path as PathInterface,PathEdge as PathEdgeInterface,PlayerChangedChange as PlayerChangedChangeInterface,PlayerColor as PlayerColorInterface,PlayerOrders as PlayerOrdersInterface,PlayerState as PlayerStateInterface,Position as PositionInterface,ProcessMovesRequest as ProcessMovesRequestInterface,ProcessMovesResponse as ProcessMovesResponseInterface,RemoveTileAtRequest as RemoveTileAtRequestInterface,RemoveTileAtResponse as RemoveTileAtResponseInterface,RemoveUnitAtRequest as RemoveUnitAtRequestInterface,RemoveUnitAtResponse as RemoveUnitAtResponseInterface,RepeatInfo as RepeatInfoInterface,RetryJobRequest as RetryJobRequestInterface,RetryJobResponse as RetryJobResponseInterface,RevealOrdersRequest as RevealOrdersRequestInterface,RevealOrdersResponse as RevealOrdersResponseInterface,RevertWorldRequest as RevertWorldRequestInterface,RevertWorldResponse as RevertWorldResponseInterface,RulesEngine as RulesEngineInterface,Run as RunInterface,SceneClickedRequest as SceneClickedRequestInterface,SceneClickedResponse as SceneClickedResponseInterface,SearchFacetCount as SearchFacetCountInterface,SearchWorldsRequest as SearchWorldsRequestInterface,SearchWorldsResponse as SearchWorldsResponseInterface,SetAllowedPanelsRequest as SetAllowedPanelsRequestInterface,SetAllowedPanelsResponse as SetAllowedPanelsResponseInterface,SetContentRequest as SetContentRequestInterface,SetContentResponse as SetContentResponseInterface,SetGameStateRequest as SetGameStateRequestInterface,SetGameStateResponse as SetGameStateResponseInterface,SetTileAtRequest as SetTileAtRequestInterface,SetTileAtResponse as SetTileAtResponseInterface,SetUnitAtRequest as SetUnitAtRequestInterface,SetUnitAtResponse as SetUnitAtResponseInterface,ShowAttackEffectRequest as ShowAttackEffectRequestInterface,ShowAttackEffectResponse as ShowAttackEffectResponseInterface,ShowBuildOptionsRequest as ShowBuildOptionsRequestInterface,ShowBuildOptionsResponse as ShowBuildOptionsResponseInterface,ShowCaptureEffectRequest as ShowCaptureEffectRequestInterface,ShowCaptureEffectResponse as ShowCaptureEffectResponseInterface,ShowHealEffectRequest as ShowHealEffectRequestInterface,ShowHealEffectResponse as ShowHealEffectResponseInterface,ShowHighlightsRequest as ShowHighlightsRequestInterface,ShowHighlightsResponse as ShowHighlightsResponseInterface,ShowPathRequest as ShowPathRequestInterface,ShowPathResponse as ShowPathResponseInterface,SimulateAttackRequest as SimulateAttackRequestInterface,SimulateAttackResponse as SimulateAttackResponseInterface,SimulateFixRequest as SimulateFixRequestInterface,SimulateFixResponse as SimulateFixResponseInterface,SplashTarget as SplashTargetInterface,TerrainDefinition as TerrainDefinitionInterface,TerrainMapping as TerrainMappingInterface,TerrainUnitProperties as TerrainUnitPropertiesInterface,ThemeInfo as ThemeInfoInterface,ThemeManifest as ThemeManifestInterface,Tile as TileInterface,TileCapturedChange as TileCapturedChangeInterface,TimeRange as TimeRangeInterface,TurnOptionClickedRequest as TurnOptionClickedRequestInterface,TurnOptionClickedResponse as TurnOptionClickedResponseInterface,Unit as UnitInterface,UnitBuiltChange as UnitBuiltChangeInterface,UnitDamagedChange as UnitDamagedChangeInterface,UnitDefinition as UnitDefinitionInterface,UnitFixedChange as UnitFixedChangeInterface,UnitHealedChange as UnitHealedChangeInterface,UnitKilledChange as UnitKilledChangeInterface,UnitMapping as UnitMappingInterface,UnitMovedChange as UnitMovedChangeInterface,UnitUnitProperties as UnitUnitPropertiesInterface,UpdateGameRequest as UpdateGameRequestInterface,UpdateGameResponse as UpdateGameResponseInterface,UpdateGameStatusRequest as UpdateGameStatusRequestInterface,UpdateGameStatusResponse as UpdateGameStatusResponseInterface,UpdateIndexRecordsLRORequest as UpdateIndexRecordsLRORequestInterface,UpdateIndexRecordsLROResponse as UpdateIndexRecordsLROResponseInterface,UpdateWorldRequest as UpdateWorldRequestInterface,UpdateWorldResponse as UpdateWorldResponseInterface,UserGame as UserGameInterface,UserGameList as UserGameListInterface,World as WorldInterface,WorldCellChange as WorldCellChangeInterface,WorldChange as WorldChangeInterface,WorldData as WorldDataInterface,WorldInfo as WorldInfoInterface,WorldMergeConflict as WorldMergeConflictInterface,WorldRevision as WorldRevisionInterface,WorldSearchEntry as WorldSearchEntryInterface} from "./models/interfaces";
 
-import {AllPaths as ConcreteAllPaths,ApplyRemoteChangesRequest as ConcreteApplyRemoteChangesRequest,ApplyRemoteChangesResponse as ConcreteApplyRemoteChangesResponse,AssetResult as ConcreteAssetResult,AttackRecord as ConcreteAttackRecord,AttackUnitAction as ConcreteAttackUnitAction,BuildOptionClickedRequest as ConcreteBuildOptionClickedRequest,BuildOptionClickedResponse as ConcreteBuildOptionClickedResponse,BuildUnitAction as ConcreteBuildUnitAction,CaptureBuildingAction as ConcreteCaptureBuildingAction,CaptureStartedChange as ConcreteCaptureStartedChange,ClearHighlightsRequest as ConcreteClearHighlightsRequest,ClearHighlightsResponse as ConcreteClearHighlightsResponse,ClearPathsRequest as ConcreteClearPathsRequest,ClearPathsResponse as ConcreteClearPathsResponse,ClientReadyRequest as ConcreteClientReadyRequest,ClientReadyResponse as ConcreteClientReadyResponse,CoinsChangedChange as ConcreteCoinsChangedChange,CreateGameRequest as ConcreteCreateGameRequest,CreateGameResponse as ConcreteCreateGameResponse,CreateIndexRecordsLRORequest as ConcreteCreateIndexRecordsLRORequest,CreateIndexRecordsLROResponse as ConcreteCreateIndexRecordsLROResponse,CreateWorldRequest as ConcreteCreateWorldRequest,CreateWorldResponse as ConcreteCreateWorldResponse,Crossing as ConcreteCrossing,DamageDistribution as ConcreteDamageDistribution,DamageRange as ConcreteDamageRange,DeleteGameRequest as ConcreteDeleteGameRequest,DeleteGameResponse as ConcreteDeleteGameResponse,DeleteIndexStatesRequest as ConcreteDeleteIndexStatesRequest,DeleteIndexStatesResponse as ConcreteDeleteIndexStatesResponse,DeleteWorldRequest as ConcreteDeleteWorldRequest,DeleteWorldResponse as ConcreteDeleteWorldResponse,EmptyRequest as ConcreteEmptyRequest,EmptyResponse as ConcreteEmptyResponse,EndTurnAction as ConcreteEndTurnAction,EndTurnButtonClickedRequest as ConcreteEndTurnButtonClickedRequest,EndTurnButtonClickedResponse as ConcreteEndTurnButtonClickedResponse,EnsureIndexStateRequest as ConcreteEnsureIndexStateRequest,EnsureIndexStateResponse as ConcreteEnsureIndexStateResponse,FixUnitAction as ConcreteFixUnitAction,Game as ConcreteGame,GameConfiguration as ConcreteGameConfiguration,GameMove as ConcreteGameMove,GameMoveGroup as ConcreteGameMoveGroup,GameMoveHistory as ConcreteGameMoveHistory,GameOption as ConcreteGameOption,GamePlayer as ConcreteGamePlayer,GameSettings as ConcreteGameSettings,GameState as ConcreteGameState,GameTeam as ConcreteGameTeam,GetGameContentRequest as ConcreteGetGameContentRequest,GetGameContentResponse as ConcreteGetGameContentResponse,GetGameRequest as ConcreteGetGameRequest,GetGameResponse as ConcreteGetGameResponse,GetGameStateRequest as ConcreteGetGameStateRequest,GetGameStateResponse as ConcreteGetGameStateResponse,GetGamesRequest as ConcreteGetGamesRequest,GetGamesResponse as ConcreteGetGamesResponse,GetIndexRecordsLRORequest as ConcreteGetIndexRecordsLRORequest,GetIndexRecordsLROResponse as ConcreteGetIndexRecordsLROResponse,GetIndexStatesRequest as ConcreteGetIndexStatesRequest,GetIndexStatesResponse as ConcreteGetIndexStatesResponse,GetOptionsAtRequest as ConcreteGetOptionsAtRequest,GetOptionsAtResponse as ConcreteGetOptionsAtResponse,GetWorldRequest as ConcreteGetWorldRequest,GetWorldResponse as ConcreteGetWorldResponse,GetWorldsRequest as ConcreteGetWorldsRequest,GetWorldsResponse as ConcreteGetWorldsResponse,HealUnitAction as ConcreteHealUnitAction,HexCoord as ConcreteHexCoord,HighlightSpec as ConcreteHighlightSpec,IncomeConfig as ConcreteIncomeConfig,IndexInfo as ConcreteIndexInfo,IndexRecord as ConcreteIndexRecord,IndexRecordsLRO as ConcreteIndexRecordsLRO,IndexState as ConcreteIndexState,IndexStateList as ConcreteIndexStateList,InitializeGameRequest as ConcreteInitializeGameRequest,InitializeGameResponse as ConcreteInitializeGameResponse,InitializeSingletonRequest as ConcreteInitializeSingletonRequest,InitializeSingletonResponse as ConcreteInitializeSingletonResponse,Job as ConcreteJob,JoinGameRequest as ConcreteJoinGameRequest,JoinGameResponse as ConcreteJoinGameResponse,ListGamesRequest as ConcreteListGamesRequest,ListGamesResponse as ConcreteListGamesResponse,ListIndexStatesRequest as ConcreteListIndexStatesRequest,ListIndexStatesResponse as ConcreteListIndexStatesResponse,ListMovesRequest as ConcreteListMovesRequest,ListMovesResponse as ConcreteListMovesResponse,ListWorldsRequest as ConcreteListWorldsRequest,ListWorldsResponse as ConcreteListWorldsResponse,LogMessageRequest as ConcreteLogMessageRequest,LogMessageResponse as ConcreteLogMessageResponse,MoveUnitAction as ConcreteMoveUnitAction,MoveUnitRequest as ConcreteMoveUnitRequest,MoveUnitResponse as ConcreteMoveUnitResponse,Pagination as ConcretePagination,PaginationResponse as ConcretePaginationResponse,Path as ConcretePath,PathEdge as ConcretePathEdge,PlayerChangedChange as ConcretePlayerChangedChange,PlayerColor as ConcretePlayerColor,PlayerState as ConcretePlayerState,Position as ConcretePosition,ProcessMovesRequest as ConcreteProcessMovesRequest,ProcessMovesResponse as ConcreteProcessMovesResponse,RemoveTileAtRequest as ConcreteRemoveTileAtRequest,RemoveTileAtResponse as ConcreteRemoveTileAtResponse,RemoveUnitAtRequest as ConcreteRemoveUnitAtRequest,RemoveUnitAtResponse as ConcreteRemoveUnitAtResponse,RepeatInfo as ConcreteRepeatInfo,RulesEngine as ConcreteRulesEngine,Run as ConcreteRun,SceneClickedRequest as ConcreteSceneClickedRequest,SceneClickedResponse as ConcreteSceneClickedResponse,SetAllowedPanelsRequest as ConcreteSetAllowedPanelsRequest,SetAllowedPanelsResponse as ConcreteSetAllowedPanelsResponse,SetContentRequest as ConcreteSetContentRequest,SetContentResponse as ConcreteSetContentResponse,SetGameStateRequest as ConcreteSetGameStateRequest,SetGameStateResponse as ConcreteSetGameStateResponse,SetTileAtRequest as ConcreteSetTileAtRequest,SetTileAtResponse as ConcreteSetTileAtResponse,SetUnitAtRequest as ConcreteSetUnitAtRequest,SetUnitAtResponse as ConcreteSetUnitAtResponse,ShowAttackEffectRequest as ConcreteShowAttackEffectRequest,ShowAttackEffectResponse as ConcreteShowAttackEffectResponse,ShowBuildOptionsRequest as ConcreteShowBuildOptionsRequest,ShowBuildOptionsResponse as ConcreteShowBuildOptionsResponse,ShowCaptureEffectRequest as ConcreteShowCaptureEffectRequest,ShowCaptureEffectResponse as ConcreteShowCaptureEffectResponse,ShowHealEffectRequest as ConcreteShowHealEffectRequest,ShowHealEffectResponse as ConcreteShowHealEffectResponse,ShowHighlightsRequest as ConcreteShowHighlightsRequest,ShowHighlightsResponse as ConcreteShowHighlightsResponse,ShowPathRequest as ConcreteShowPathRequest,ShowPathResponse as ConcreteShowPathResponse,SimulateAttackRequest as ConcreteSimulateAttackRequest,SimulateAttackResponse as ConcreteSimulateAttackResponse,SimulateFixRequest as ConcreteSimulateFixRequest,SimulateFixResponse as ConcreteSimulateFixResponse,SplashTarget as ConcreteSplashTarget,TerrainDefinition as ConcreteTerrainDefinition,TerrainMapping as ConcreteTerrainMapping,TerrainUnitProperties as ConcreteTerrainUnitProperties,ThemeInfo as ConcreteThemeInfo,ThemeManifest as ConcreteThemeManifest,Tile as ConcreteTile,TileCapturedChange as ConcreteTileCapturedChange,TurnOptionClickedRequest as ConcreteTurnOptionClickedRequest,TurnOptionClickedResponse as ConcreteTurnOptionClickedResponse,Unit as ConcreteUnit,UnitBuiltChange as ConcreteUnitBuiltChange,UnitDamagedChange as ConcreteUnitDamagedChange,UnitDefinition as ConcreteUnitDefinition,UnitFixedChange as ConcreteUnitFixedChange,UnitHealedChange as ConcreteUnitHealedChange,UnitKilledChange as ConcreteUnitKilledChange,UnitMapping as ConcreteUnitMapping,UnitMovedChange as ConcreteUnitMovedChange,UnitUnitProperties as ConcreteUnitUnitProperties,UpdateGameRequest as ConcreteUpdateGameRequest,UpdateGameResponse as ConcreteUpdateGameResponse,UpdateGameStatusRequest as ConcreteUpdateGameStatusRequest,UpdateGameStatusResponse as ConcreteUpdateGameStatusResponse,UpdateIndexRecordsLRORequest as ConcreteUpdateIndexRecordsLRORequest,UpdateIndexRecordsLROResponse as ConcreteUpdateIndexRecordsLROResponse,UpdateWorldRequest as ConcreteUpdateWorldRequest,UpdateWorldResponse as ConcreteUpdateWorldResponse,World as ConcreteWorld,WorldChange as ConcreteWorldChange,WorldData as ConcreteWorldData,WorldInfo as ConcreteWorldInfo} from "./models/models";
+import {AllPaths as ConcreteAllPaths,ApplyRemoteChangesRequest as ConcreteApplyRemoteChangesRequest,ApplyRemoteChangesResponse as ConcreteApplyRemoteChangesResponse,AssetResult as ConcreteAssetResult,AttackRecord as ConcreteAttackRecord,AttackUnitAction as ConcreteAttackUnitAction,BuildOptionClickedRequest as ConcreteBuildOptionClickedRequest,BuildOptionClickedResponse as ConcreteBuildOptionClickedResponse,BuildUnitAction as ConcreteBuildUnitAction,CaptureBuildingAction as ConcreteCaptureBuildingAction,CaptureStartedChange as ConcreteCaptureStartedChange,ClearHighlightsRequest as ConcreteClearHighlightsRequest,ClearHighlightsResponse as ConcreteClearHighlightsResponse,ClearPathsRequest as ConcreteClearPathsRequest,ClearPathsResponse as ConcreteClearPathsResponse,ClientReadyRequest as ConcreteClientReadyRequest,ClientReadyResponse as ConcreteClientReadyResponse,CoinsChangedChange as ConcreteCoinsChangedChange,CommitOrdersRequest as ConcreteCommitOrdersRequest,CommitOrdersResponse as ConcreteCommitOrdersResponse,CreateGameRequest as ConcreteCreateGameRequest,CreateGameResponse as ConcreteCreateGameResponse,CreateIndexRecordsLRORequest as ConcreteCreateIndexRecordsLRORequest,CreateIndexRecordsLROResponse as ConcreteCreateIndexRecordsLROResponse,CreateWorldRequest as ConcreteCreateWorldRequest,CreateWorldResponse as ConcreteCreateWorldResponse,Crossing as ConcreteCrossing,DamageDistribution as ConcreteDamageDistribution,DamageRange as ConcreteDamageRange,DeleteGameRequest as ConcreteDeleteGameRequest,DeleteGameResponse as ConcreteDeleteGameResponse,DeleteIndexStatesRequest as ConcreteDeleteIndexStatesRequest,DeleteIndexStatesResponse as ConcreteDeleteIndexStatesResponse,DeleteWorldRequest as ConcreteDeleteWorldRequest,DeleteWorldResponse as ConcreteDeleteWorldResponse,EmptyRequest as ConcreteEmptyRequest,EmptyResponse as ConcreteEmptyResponse,EndTurnAction as ConcreteEndTurnAction,EndTurnButtonClickedRequest as ConcreteEndTurnButtonClickedRequest,EndTurnButtonClickedResponse as ConcreteEndTurnButtonClickedResponse,EnsureIndexStateRequest as ConcreteEnsureIndexStateRequest,EnsureIndexStateResponse as ConcreteEnsureIndexStateResponse,ExportGameRequest as ConcreteExportGameRequest,ExportGameResponse as ConcreteExportGameResponse,FixUnitAction as ConcreteFixUnitAction,Game as ConcreteGame,GameConfiguration as ConcreteGameConfiguration,GameMove as ConcreteGameMove,GameMoveGroup as ConcreteGameMoveGroup,GameMoveHistory as ConcreteGameMoveHistory,GameOption as ConcreteGameOption,GamePlayer as ConcreteGamePlayer,GameSettings as ConcreteGameSettings,GameState as ConcreteGameState,GameTeam as ConcreteGameTeam,GetGameContentRequest as ConcreteGetGameContentRequest,GetGameContentResponse as ConcreteGetGameContentResponse,GetGameRequest as ConcreteGetGameRequest,GetGameResponse as ConcreteGetGameResponse,GetGameStateRequest as ConcreteGetGameStateRequest,GetGameStateResponse as ConcreteGetGameStateResponse,GetGamesRequest as ConcreteGetGamesRequest,GetGamesResponse as ConcreteGetGamesResponse,GetIndexRecordsLRORequest as ConcreteGetIndexRecordsLRORequest,GetIndexRecordsLROResponse as ConcreteGetIndexRecordsLROResponse,GetIndexStatesRequest as ConcreteGetIndexStatesRequest,GetIndexStatesResponse as ConcreteGetIndexStatesResponse,GetJobRequest as ConcreteGetJobRequest,GetJobResponse as ConcreteGetJobResponse,GetOptionsAtRequest as ConcreteGetOptionsAtRequest,GetOptionsAtResponse as ConcreteGetOptionsAtResponse,GetWorldRequest as ConcreteGetWorldRequest,GetWorldResponse as ConcreteGetWorldResponse,GetWorldsRequest as ConcreteGetWorldsRequest,GetWorldsResponse as ConcreteGetWorldsResponse,HealUnitAction as ConcreteHealUnitAction,HexCoord as ConcreteHexCoord,HighlightSpec as ConcreteHighlightSpec,ImportGameRequest as ConcreteImportGameRequest,ImportGameResponse as ConcreteImportGameResponse,IncomeConfig as ConcreteIncomeConfig,IndexInfo as ConcreteIndexInfo,IndexRecord as ConcreteIndexRecord,IndexRecordsLRO as ConcreteIndexRecordsLRO,IndexState as ConcreteIndexState,IndexStateList as ConcreteIndexStateList,InitializeGameRequest as ConcreteInitializeGameRequest,InitializeGameResponse as ConcreteInitializeGameResponse,InitializeSingletonRequest as ConcreteInitializeSingletonRequest,InitializeSingletonResponse as ConcreteInitializeSingletonResponse,Job as ConcreteJob,JoinGameRequest as ConcreteJoinGameRequest,JoinGameResponse as ConcreteJoinGameResponse,ListDerivedWorldsRequest as ConcreteListDerivedWorldsRequest,ListDerivedWorldsResponse as ConcreteListDerivedWorldsResponse,ListGamesRequest as ConcreteListGamesRequest,ListGamesResponse as ConcreteListGamesResponse,ListIndexStatesRequest as ConcreteListIndexStatesRequest,ListIndexStatesResponse as ConcreteListIndexStatesResponse,ListJobsRequest as ConcreteListJobsRequest,ListJobsResponse as ConcreteListJobsResponse,ListMovesRequest as ConcreteListMovesRequest,ListMovesResponse as ConcreteListMovesResponse,ListMyGamesRequest as ConcreteListMyGamesRequest,ListMyGamesResponse as ConcreteListMyGamesResponse,ListWorldRevisionsRequest as ConcreteListWorldRevisionsRequest,ListWorldRevisionsResponse as ConcreteListWorldRevisionsResponse,ListWorldsRequest as ConcreteListWorldsRequest,ListWorldsResponse as ConcreteListWorldsResponse,LogMessageRequest as ConcreteLogMessageRequest,LogMessageResponse as ConcreteLogMessageResponse,MergeWorldUpstreamRequest as ConcreteMergeWorldUpstreamRequest,MergeWorldUpstreamResponse as ConcreteMergeWorldUpstreamResponse,MoveUnitAction as ConcreteMoveUnitAction,MoveUnitRequest as ConcreteMoveUnitRequest,MoveUnitResponse as ConcreteMoveUnitResponse,Pagination as ConcretePagination,PaginationResponse as ConcretePaginationResponse,Path as ConcretePath,PathEdge as ConcretePathEdge,PlayerChangedChange as ConcretePlayerChangedChange,PlayerColor as ConcretePlayerColor,PlayerOrders as ConcretePlayerOrders,PlayerState as ConcretePlayerState,Position as ConcretePosition,ProcessMovesRequest as ConcreteProcessMovesRequest,ProcessMovesResponse as ConcreteProcessMovesResponse,RemoveTileAtRequest as ConcreteRemoveTileAtRequest,RemoveTileAtResponse as ConcreteRemoveTileAtResponse,RemoveUnitAtRequest as ConcreteRemoveUnitAtRequest,RemoveUnitAtResponse as ConcreteRemoveUnitAtResponse,RepeatInfo as ConcreteRepeatInfo,RetryJobRequest as ConcreteRetryJobRequest,RetryJobResponse as ConcreteRetryJobResponse,RevealOrdersRequest as ConcreteRevealOrdersRequest,RevealOrdersResponse as ConcreteRevealOrdersResponse,RevertWorldRequest as ConcreteRevertWorldRequest,RevertWorldResponse as ConcreteRevertWorldResponse,RulesEngine as ConcreteRulesEngine,Run as ConcreteRun,SceneClickedRequest as ConcreteSceneClickedRequest,SceneClickedResponse as ConcreteSceneClickedResponse,SearchFacetCount as ConcreteSearchFacetCount,SearchWorldsRequest as ConcreteSearchWorldsRequest,SearchWorldsResponse as ConcreteSearchWorldsResponse,SetAllowedPanelsRequest as ConcreteSetAllowedPanelsRequest,SetAllowedPanelsResponse as ConcreteSetAllowedPanelsResponse,SetContentRequest as ConcreteSetContentRequest,SetContentResponse as ConcreteSetContentResponse,SetGameStateRequest as ConcreteSetGameStateRequest,SetGameStateResponse as ConcreteSetGameStateResponse,SetTileAtRequest as ConcreteSetTileAtRequest,SetTileAtResponse as ConcreteSetTileAtResponse,SetUnitAtRequest as ConcreteSetUnitAtRequest,SetUnitAtResponse as ConcreteSetUnitAtResponse,ShowAttackEffectRequest as ConcreteShowAttackEffectRequest,ShowAttackEffectResponse as ConcreteShowAttackEffectResponse,ShowBuildOptionsRequest as ConcreteShowBuildOptionsRequest,ShowBuildOptionsResponse as ConcreteShowBuildOptionsResponse,ShowCaptureEffectRequest as ConcreteShowCaptureEffectRequest,ShowCaptureEffectResponse as ConcreteShowCaptureEffectResponse,ShowHealEffectRequest as ConcreteShowHealEffectRequest,ShowHealEffectResponse as ConcreteShowHealEffectResponse,ShowHighlightsRequest as ConcreteShowHighlightsRequest,ShowHighlightsResponse as ConcreteShowHighlightsResponse,ShowPathRequest as ConcreteShowPathRequest,ShowPathResponse as ConcreteShowPathResponse,SimulateAttackRequest as ConcreteSimulateAttackRequest,SimulateAttackResponse as ConcreteSimulateAttackResponse,SimulateFixRequest as ConcreteSimulateFixRequest,SimulateFixResponse as ConcreteSimulateFixResponse,SplashTarget as ConcreteSplashTarget,TerrainDefinition as ConcreteTerrainDefinition,TerrainMapping as ConcreteTerrainMapping,TerrainUnitProperties as ConcreteTerrainUnitProperties,ThemeInfo as ConcreteThemeInfo,ThemeManifest as ConcreteThemeManifest,Tile as ConcreteTile,TileCapturedChange as ConcreteTileCapturedChange,TimeRange as ConcreteTimeRange,TurnOptionClickedRequest as ConcreteTurnOptionClickedRequest,TurnOptionClickedResponse as ConcreteTurnOptionClickedResponse,Unit as ConcreteUnit,UnitBuiltChange as ConcreteUnitBuiltChange,UnitDamagedChange as ConcreteUnitDamagedChange,UnitDefinition as ConcreteUnitDefinition,UnitFixedChange as ConcreteUnitFixedChange,UnitHealedChange as ConcreteUnitHealedChange,UnitKilledChange as ConcreteUnitKilledChange,UnitMapping as ConcreteUnitMapping,UnitMovedChange as ConcreteUnitMovedChange,UnitUnitProperties as ConcreteUnitUnitProperties,UpdateGameRequest as ConcreteUpdateGameRequest,UpdateGameResponse as ConcreteUpdateGameResponse,UpdateGameStatusRequest as ConcreteUpdateGameStatusRequest,UpdateGameStatusResponse as ConcreteUpdateGameStatusResponse,UpdateIndexRecordsLRORequest as ConcreteUpdateIndexRecordsLRORequest,UpdateIndexRecordsLROResponse as ConcreteUpdateIndexRecordsLROResponse,UpdateWorldRequest as ConcreteUpdateWorldRequest,UpdateWorldResponse as ConcreteUpdateWorldResponse,UserGame as ConcreteUserGame,UserGameList as ConcreteUserGameList,World as ConcreteWorld,WorldCellChange as ConcreteWorldCellChange,WorldChange as ConcreteWorldChange,WorldData as ConcreteWorldData,WorldInfo as ConcreteWorldInfo,WorldMergeConflict as ConcreteWorldMergeConflict,WorldRevision as ConcreteWorldRevision,WorldSearchEntry as ConcreteWorldSearchEntry} from "./models/models";
 
 
 
@@ -1011,6 +1011,166 @@ export class Lilbattle_v1Factory {
     return { instance: out, fullyLoaded: false };
   }
 
+  /**
+   * Enhanced factory method for PlayerOrders
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newPlayerOrders = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<PlayerOrdersInterface> => {
+    const out = new ConcretePlayerOrders();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for TimeRange
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newTimeRange = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<TimeRangeInterface> => {
+    const out = new ConcreteTimeRange();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for UserGame
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newUserGame = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<UserGameInterface> => {
+    const out = new ConcreteUserGame();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for UserGameList
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newUserGameList = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<UserGameListInterface> => {
+    const out = new ConcreteUserGameList();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for WorldRevision
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newWorldRevision = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<WorldRevisionInterface> => {
+    const out = new ConcreteWorldRevision();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for WorldCellChange
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newWorldCellChange = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<WorldCellChangeInterface> => {
+    const out = new ConcreteWorldCellChange();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for WorldMergeConflict
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newWorldMergeConflict = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<WorldMergeConflictInterface> => {
+    const out = new ConcreteWorldMergeConflict();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for WorldSearchEntry
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newWorldSearchEntry = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<WorldSearchEntryInterface> => {
+    const out = new ConcreteWorldSearchEntry();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
   /**
    * Enhanced factory method for IndexState
    * @param parent Parent object containing this field
@@ -1631,6 +1791,226 @@ export class Lilbattle_v1Factory {
     return { instance: out, fullyLoaded: false };
   }
 
+  /**
+   * Enhanced factory method for ListWorldRevisionsRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListWorldRevisionsRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListWorldRevisionsRequestInterface> => {
+    const out = new ConcreteListWorldRevisionsRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ListWorldRevisionsResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListWorldRevisionsResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListWorldRevisionsResponseInterface> => {
+    const out = new ConcreteListWorldRevisionsResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for RevertWorldRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newRevertWorldRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<RevertWorldRequestInterface> => {
+    const out = new ConcreteRevertWorldRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for RevertWorldResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newRevertWorldResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<RevertWorldResponseInterface> => {
+    const out = new ConcreteRevertWorldResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ListDerivedWorldsRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListDerivedWorldsRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListDerivedWorldsRequestInterface> => {
+    const out = new ConcreteListDerivedWorldsRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ListDerivedWorldsResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListDerivedWorldsResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListDerivedWorldsResponseInterface> => {
+    const out = new ConcreteListDerivedWorldsResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for MergeWorldUpstreamRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newMergeWorldUpstreamRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<MergeWorldUpstreamRequestInterface> => {
+    const out = new ConcreteMergeWorldUpstreamRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for MergeWorldUpstreamResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newMergeWorldUpstreamResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<MergeWorldUpstreamResponseInterface> => {
+    const out = new ConcreteMergeWorldUpstreamResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for SearchWorldsRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newSearchWorldsRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<SearchWorldsRequestInterface> => {
+    const out = new ConcreteSearchWorldsRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for SearchFacetCount
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newSearchFacetCount = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<SearchFacetCountInterface> => {
+    const out = new ConcreteSearchFacetCount();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for SearchWorldsResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newSearchWorldsResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<SearchWorldsResponseInterface> => {
+    const out = new ConcreteSearchWorldsResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
   /**
    * Enhanced factory method for ListGamesRequest
    * @param parent Parent object containing this field
@@ -2211,6 +2591,206 @@ export class Lilbattle_v1Factory {
     return { instance: out, fullyLoaded: false };
   }
 
+  /**
+   * Enhanced factory method for CommitOrdersRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newCommitOrdersRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<CommitOrdersRequestInterface> => {
+    const out = new ConcreteCommitOrdersRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for CommitOrdersResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newCommitOrdersResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<CommitOrdersResponseInterface> => {
+    const out = new ConcreteCommitOrdersResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for RevealOrdersRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newRevealOrdersRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<RevealOrdersRequestInterface> => {
+    const out = new ConcreteRevealOrdersRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for RevealOrdersResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newRevealOrdersResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<RevealOrdersResponseInterface> => {
+    const out = new ConcreteRevealOrdersResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ListMyGamesRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListMyGamesRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListMyGamesRequestInterface> => {
+    const out = new ConcreteListMyGamesRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ListMyGamesResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListMyGamesResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListMyGamesResponseInterface> => {
+    const out = new ConcreteListMyGamesResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ExportGameRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newExportGameRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ExportGameRequestInterface> => {
+    const out = new ConcreteExportGameRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ExportGameResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newExportGameResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ExportGameResponseInterface> => {
+    const out = new ConcreteExportGameResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ImportGameRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newImportGameRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ImportGameRequestInterface> => {
+    const out = new ConcreteImportGameRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ImportGameResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newImportGameResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ImportGameResponseInterface> => {
+    const out = new ConcreteImportGameResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
   /**
    * Enhanced factory method for InitializeSingletonRequest
    * @param parent Parent object containing this field
@@ -3411,6 +3991,126 @@ export class Lilbattle_v1Factory {
     return { instance: out, fullyLoaded: false };
   }
 
+  /**
+   * Enhanced factory method for ListJobsRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListJobsRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListJobsRequestInterface> => {
+    const out = new ConcreteListJobsRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ListJobsResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListJobsResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListJobsResponseInterface> => {
+    const out = new ConcreteListJobsResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetJobRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetJobRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetJobRequestInterface> => {
+    const out = new ConcreteGetJobRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetJobResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetJobResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetJobResponseInterface> => {
+    const out = new ConcreteGetJobResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for RetryJobRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newRetryJobRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<RetryJobRequestInterface> => {
+    const out = new ConcreteRetryJobRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for RetryJobResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newRetryJobResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<RetryJobResponseInterface> => {
+    const out = new ConcreteRetryJobResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
   /**
    * Enhanced factory method for ThemeInfo
    * @param parent Parent object containing this field
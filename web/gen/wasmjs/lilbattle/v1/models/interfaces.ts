@@ -46,6 +46,30 @@ export enum PathDirection {
   PATH_DIRECTION_BOTTOM_LEFT = 6,
 }
 
+/**
+ * How a cell of a world differs from the same cell in another version of
+ the world
+ */
+export enum WorldChangeKind {
+  WORLD_CHANGE_KIND_UNSPECIFIED = 0,
+  WORLD_CHANGE_KIND_ADDED = 1,
+  WORLD_CHANGE_KIND_REMOVED = 2,
+  WORLD_CHANGE_KIND_MODIFIED = 3,
+}
+
+/**
+ * What a three-way world merge does with cells both sides changed
+ differently
+ */
+export enum WorldMergeStrategy {
+  /** Report the conflicts and do not merge */
+  WORLD_MERGE_STRATEGY_UNSPECIFIED = 0,
+  /** Keep our side of every conflict */
+  WORLD_MERGE_STRATEGY_OURS = 1,
+  /** Take their side of every conflict */
+  WORLD_MERGE_STRATEGY_THEIRS = 2,
+}
+
 
 export enum IndexStatus {
   INDEX_STATUS_UNSPECIFIED = 0,
@@ -60,6 +84,18 @@ export enum RunState {
   RUN_STATE_UNSPECIFIED = 0,
   RUN_STATE_STARTED = 1,
   RUN_STATE_FINISHED = 2,
+  RUN_STATE_FAILED = 3,
+}
+
+
+export enum JobStatus {
+  JOB_STATUS_UNSPECIFIED = 0,
+  /** Waiting for run_after */
+  JOB_STATUS_PENDING = 1,
+  JOB_STATUS_RUNNING = 2,
+  JOB_STATUS_SUCCEEDED = 3,
+  /** Out of retries - only runs again when retried or enqueued again */
+  JOB_STATUS_FAILED = 4,
 }
 
 
@@ -112,7 +148,8 @@ export interface PaginationResponse {
  Whether theere are more results. */
   hasMore: boolean;
   /** *
- Total number of results. */
+ Total number of results.  Backends that cannot count matches cheaply
+ leave this as 0. */
   totalResults: number;
 }
 
@@ -143,6 +180,12 @@ export interface World {
   /** Default game configs */
   defaultGameConfig?: GameConfiguration;
   searchIndexInfo?: IndexInfo;
+  /** World this one was copied (remixed) from, empty for original worlds */
+  parentWorldId: string;
+  /** Revision of the parent world this one was copied from, or last merged
+ upstream changes from.  It is the base of three-way merges with the
+ parent. */
+  parentRevision: number;
 }
 
 
@@ -398,6 +441,12 @@ export interface Game {
  Can be overridden to point to CDN or external hosting */
   previewUrls: string[];
   searchIndexInfo?: IndexInfo;
+  /** The world revision (WorldData version) this game was created from.
+ 0 for games created before worlds kept revisions. */
+  worldRevision: number;
+  /** The version (content hash) of the rules and damage data this game is
+ played with.  Empty for games created before games recorded it. */
+  rulesVersion: string;
 }
 
 
@@ -471,12 +520,24 @@ export interface GameTeam {
 export interface GameSettings {
   /** List of allowed unit type IDs */
   allowedUnits: number[];
-  /** Turn time limit in seconds (0 = no limit) */
+  /** Turn time limit in seconds (0 = no limit, or 24 hours for each orders phase of simultaneous turns) */
   turnTimeLimit: number;
   /** Team mode */
   teamMode: string;
   /** Maximum number of turns (0 = unlimited) */
   maxTurns: number;
+  /** Turn mode - "sequential" (default) or "simultaneous"
+ In simultaneous mode all players commit orders for a turn in parallel
+ and they are resolved together once everyone has revealed them. */
+  turnMode: string;
+  /** Teammates share a single coin pool for building units */
+  sharedCoins: boolean;
+  /** Teammates may move and act with each other's units on their turn */
+  sharedControl: boolean;
+  /** Units may be healed and fixed at allied bases and by allied units */
+  alliedSupport: boolean;
+  /** The game is won by a team once only its players have units left */
+  combinedVictory: boolean;
 }
 
 
@@ -517,6 +578,9 @@ export interface GameState {
   /** Per-player runtime state, keyed by player_id (1-based)
  This holds mutable player state like coins that changes during gameplay */
   playerStates: Record<number, PlayerState>;
+  /** Orders committed/revealed for the current turn in simultaneous mode,
+ keyed by player_id.  Cleared once the turn is resolved. */
+  pendingOrders: Record<number, PlayerOrders>;
 }
 
 
@@ -875,6 +939,135 @@ export interface Path {
 }
 
 
+/**
+ * Orders a player has submitted for the current turn in simultaneous mode.
+ Orders go through a commit/reveal flow - a player first commits a hash of
+ their moves so nobody can react to anybody else's orders, and once every
+ player has committed the moves are revealed and checked against the hash.
+ */
+export interface PlayerOrders {
+  playerId: number;
+  /** Hex encoded SHA-256 of the moves and salt (see lib.OrdersCommitment) */
+  commitment: string;
+  committedAt?: Timestamp;
+  /** Set once the moves have been revealed and verified against the commitment */
+  revealed: boolean;
+  /** The revealed moves - empty until revealed */
+  moves?: GameMove[];
+  /** Salt used in the commitment so identical orders do not hash the same */
+  salt: string;
+}
+
+
+/**
+ * A time window used to filter list results.  Either bound may be omitted;
+ start is inclusive and end is exclusive.
+ */
+export interface TimeRange {
+  start?: Timestamp;
+  end?: Timestamp;
+}
+
+
+/**
+ * An entry in the per-user game index - one per (user, game) the user holds
+ a player slot in.  Entries are rewritten whenever the game is created,
+ joined or changes turn so "my games" can be listed without loading every
+ game.
+ */
+export interface UserGame {
+  userId: string;
+  gameId: string;
+  /** The user's player slots in the game (usually one) */
+  playerIds: number[];
+  /** Denormalized from the game for listing */
+  gameName: string;
+  worldId: string;
+  /** Denormalized from the game state */
+  status: GameStatus;
+  currentPlayer: number;
+  turnCounter: number;
+  /** Whether the game is waiting on this user - it is one of their slots'
+ turn, or in simultaneous mode they have orders to commit or reveal */
+  isMyTurn: boolean;
+  /** When the entry was last rewritten */
+  updatedAt?: Timestamp;
+}
+
+
+/**
+ * The index entries of one user (the file backend stores one per user)
+ */
+export interface UserGameList {
+  games?: UserGame[];
+}
+
+
+/**
+ * An immutable snapshot of a world's data.  A revision is recorded every
+ time the world's data is saved and is numbered by the WorldData version
+ it captures, so games can be pinned to the revision they started from.
+ */
+export interface WorldRevision {
+  worldId: string;
+  /** The WorldData version this revision captures */
+  revision: number;
+  createdAt?: Timestamp;
+  /** User whose save created the revision */
+  authorId: string;
+  /** Set when the revision was created by reverting to an older one */
+  revertedFrom: number;
+  contentHash: string;
+  tileCount: number;
+  unitCount: number;
+  /** The world's data at this revision (not set when listing revisions) */
+  worldData?: WorldData;
+}
+
+
+/**
+ * A change to one cell of one layer of a world
+ */
+export interface WorldCellChange {
+  /** "tile", "unit" or "crossing" */
+  layer: string;
+  /** "q,r" coordinate of the cell */
+  key: string;
+  kind: WorldChangeKind;
+}
+
+
+/**
+ * A cell both sides of a three-way world merge changed, differently
+ */
+export interface WorldMergeConflict {
+  /** "tile", "unit" or "crossing" */
+  layer: string;
+  /** "q,r" coordinate of the cell */
+  key: string;
+  /** How each side changed the cell relative to the common base */
+  ours: WorldChangeKind;
+  theirs: WorldChangeKind;
+}
+
+
+/**
+ * A world as kept in the world search index, with the attributes searches
+ filter and sort on
+ */
+export interface WorldSearchEntry {
+  world?: World;
+  /** Number of players - the distinct players owning units or tiles */
+  players: number;
+  /** Number of tiles, the map size */
+  tiles: number;
+  /** Number of games created from the world, its popularity */
+  games: number;
+  /** When the entry was last indexed */
+  indexedAt?: Timestamp;
+}
+
+
 
 export interface File {
   /** Unique path of the file */
@@ -943,6 +1136,8 @@ export interface ListFilesRequest {
   pagination?: Pagination;
   /** If true, populate signed_urls in each File response */
   includeSignedUrls: boolean;
+  /** If true, list the files under every sub directory of path too */
+  recursive: boolean;
 }
 
 
@@ -961,6 +1156,21 @@ export interface ListGamesRequest {
   pagination?: Pagination;
   /** May be filter by owner id */
   ownerId: string;
+  /** Only games with this user in one of the player slots */
+  playerUserId: string;
+  /** Only games in this status (unspecified matches every status) */
+  status: GameStatus;
+  /** Only games created from this world */
+  worldId: string;
+  /** Only games carrying this tag */
+  tag: string;
+  /** Only games created/updated within these windows */
+  created?: TimeRange;
+  updated?: TimeRange;
+  /** Field to sort by - "updated_at" (default), "created_at" or "name" */
+  sortBy: string;
+  /** "asc" or "desc".  Defaults to newest first for times and A-Z for names. */
+  sortOrder: string;
 }
 
 
@@ -1303,6 +1513,146 @@ export interface JoinGameResponse {
 }
 
 
+/**
+ * *
+ Request to commit orders for the current turn in a simultaneous turns game.
+ Only a hash of the orders is sent so other players learn nothing about them
+ until everyone has committed.
+ */
+export interface CommitOrdersRequest {
+  /** ID of the game */
+  gameId: string;
+  /** The player slot committing (1-based).  Optional - defaults to the
+ caller's slot.  Must belong to the caller. */
+  playerId: number;
+  /** Hex encoded SHA-256 of the moves and salt (see lib.OrdersCommitment) */
+  commitment: string;
+}
+
+
+/**
+ * *
+ Response after committing orders
+ */
+export interface CommitOrdersResponse {
+  /** The player ID the orders were committed for */
+  playerId: number;
+  /** Players that have not committed their orders yet */
+  waitingOn: number[];
+}
+
+
+/**
+ * *
+ Request to reveal previously committed orders.
+ Only allowed once every player has committed.
+ */
+export interface RevealOrdersRequest {
+  /** ID of the game */
+  gameId: string;
+  /** The player slot revealing (1-based).  Optional - defaults to the
+ caller's slot.  Must belong to the caller. */
+  playerId: number;
+  /** The moves that were committed to */
+  moves?: GameMove[];
+  /** The salt used when computing the commitment */
+  salt: string;
+}
+
+
+/**
+ * *
+ Response after revealing orders
+ */
+export interface RevealOrdersResponse {
+  /** The player ID the orders were revealed for */
+  playerId: number;
+  /** Players that have not revealed their orders yet */
+  waitingOn: number[];
+  /** Whether this reveal completed the turn and all orders were resolved */
+  resolved: boolean;
+  /** The resolved moves (in resolution order) with their changes when resolved.
+ Orders that could not be carried out are included without changes and
+ with the reason in their description. */
+  moves?: GameMove[];
+}
+
+
+/**
+ * *
+ Request to list the games the caller is a player in
+ */
+export interface ListMyGamesRequest {
+  /** Pagination info (offset based) */
+  pagination?: Pagination;
+  /** Only games where it is the caller's turn */
+  onlyMyTurn: boolean;
+  /** Only games in this status (unspecified matches every status) */
+  status: GameStatus;
+}
+
+
+/**
+ * *
+ The caller's games, those waiting on the caller first and then most
+ recently updated first
+ */
+export interface ListMyGamesResponse {
+  items?: UserGame[];
+  pagination?: PaginationResponse;
+}
+
+
+/**
+ * *
+ Request to export a game archive
+ */
+export interface ExportGameRequest {
+  gameId: string;
+}
+
+
+/**
+ * *
+ A game archive - a zip file with a manifest, see lib/game_archive.go
+ */
+export interface ExportGameResponse {
+  archive: Uint8Array;
+  /** SHA-256 content hash recorded in the archive's manifest */
+  contentHash: string;
+}
+
+
+/**
+ * *
+ Request to import a game archive
+ */
+export interface ImportGameRequest {
+  archive: Uint8Array;
+  /** ID to import the game under - defaults to the archived game's ID */
+  gameId: string;
+}
+
+
+
+export interface ImportGameResponse {
+  /** The imported game (not set if the ID was taken) */
+  game?: Game;
+  /** Content hash of the verified archive */
+  contentHash: string;
+  /** Number of move groups replayed to verify the archive */
+  replayedGroups: number;
+  /** Whether the bundled rules are the rules this server plays with.  If
+ not the server keeps the bundled rules and the game goes on being
+ played with them. */
+  rulesMatch: boolean;
+  /** *
+ Error specific to a field if there are any errors - as in CreateGame a
+ taken "id" maps to a suggested free one. */
+  fieldErrors: Record<string, string>;
+}
+
+
 
 export interface EmptyRequest {
 }
@@ -1802,11 +2152,31 @@ export interface Job {
   debounceWindowSeconds: number;
   /** Whether the job is a oneoff or can repeat */
   repeatInfo?: RepeatInfo;
+  /** job_type:entity_type:entity_id - so enqueueing the same work again
+ updates the pending job instead of adding another one */
+  id: string;
+  status: JobStatus;
+  /** When the job is next due to run */
+  runAfter?: Timestamp;
+  /** Failed runs since the job last succeeded */
+  attempts: number;
+  /** How many times a failing job is retried before it is marked failed.
+ 0 uses the runner's default. */
+  maxRetries: number;
+  /** Error of the last failed run */
+  lastError: string;
+  /** ID of the latest run */
+  lastRunId: string;
+  /** Incremented on every save for optimistic locking */
+  version: number;
 }
 
 
 
 export interface RepeatInfo {
+  /** Seconds from the end of one run to the start of the next.  0 runs the
+ job once each time it is enqueued. */
+  intervalSeconds: number;
 }
 
 
@@ -1828,6 +2198,54 @@ export interface Run {
   lastContentHash: string;
   /** If there were retries */
   retryCount: number;
+  jobType: string;
+  finishedAt?: Timestamp;
+}
+
+
+
+export interface ListJobsRequest {
+  /** Only jobs of this type */
+  jobType: string;
+  /** Only jobs in this status (unspecified matches every status) */
+  status: JobStatus;
+  pagination?: Pagination;
+}
+
+
+
+export interface ListJobsResponse {
+  /** Jobs due soonest first */
+  items?: Job[];
+  pagination?: PaginationResponse;
+}
+
+
+
+export interface GetJobRequest {
+  id: string;
+  /** How many of the latest runs to return (default 20) */
+  runLimit: number;
+}
+
+
+
+export interface GetJobResponse {
+  job?: Job;
+  /** Run history, newest first */
+  runs?: Run[];
+}
+
+
+
+export interface RetryJobRequest {
+  id: string;
+}
+
+
+
+export interface RetryJobResponse {
+  job?: Job;
 }
 
 
@@ -2008,6 +2426,10 @@ export interface SubscribeResponse {
   gameState?: GameState;
   /** Game metadata */
   game?: Game;
+  /** Set when from_sequence can't be resumed because the updates since then
+ are no longer retained.  The client should reload the game via GetGame
+ and track sequences from current_sequence. */
+  reloadRequired: boolean;
 }
 
 
@@ -2184,6 +2606,17 @@ export interface ListWorldsRequest {
   pagination?: Pagination;
   /** May be filter by owner id */
   ownerId: string;
+  /** Only worlds carrying this tag */
+  tag: string;
+  /** Only worlds created/updated within these windows */
+  created?: TimeRange;
+  updated?: TimeRange;
+  /** Field to sort by - "name" (default), "updated_at" or "created_at" */
+  sortBy: string;
+  /** "asc" or "desc".  Defaults to newest first for times and A-Z for names. */
+  sortOrder: string;
+  /** Only worlds copied from this world */
+  parentWorldId: string;
 }
 
 
@@ -2197,6 +2630,7 @@ export interface ListWorldsResponse {
 
 export interface GetWorldRequest {
   id: string;
+  /** Optional revision (WorldData version) to fetch, defaults to the latest */
   version: string;
 }
 
@@ -2298,3 +2732,149 @@ export interface CreateWorldResponse {
   fieldErrors: Record<string, string>;
 }
 
+
+/**
+ * *
+ Request to list a world's revisions
+ */
+export interface ListWorldRevisionsRequest {
+  worldId: string;
+  /** Pagination info */
+  pagination?: Pagination;
+}
+
+
+/**
+ * *
+ A world's revisions, newest first, without their world data
+ */
+export interface ListWorldRevisionsResponse {
+  items?: WorldRevision[];
+  pagination?: PaginationResponse;
+}
+
+
+/**
+ * *
+ Request to make an older revision of a world its latest one
+ */
+export interface RevertWorldRequest {
+  worldId: string;
+  /** The revision to revert to */
+  revision: number;
+}
+
+
+/**
+ * *
+ Response of a revert.  The world's data is saved as a new revision so
+ the revisions after the one reverted to are kept.
+ */
+export interface RevertWorldResponse {
+  world?: World;
+  worldData?: WorldData;
+}
+
+
+/**
+ * *
+ Request to list the worlds copied (remixed) from a world
+ */
+export interface ListDerivedWorldsRequest {
+  worldId: string;
+  /** Pagination info */
+  pagination?: Pagination;
+}
+
+
+
+export interface ListDerivedWorldsResponse {
+  items?: World[];
+  pagination?: PaginationResponse;
+}
+
+
+/**
+ * *
+ Request to pull changes made to a world's parent since it was copied (or
+ last merged) into the world.  The parent revision the world records is
+ the base of a three-way merge of tiles, units and crossings.
+ */
+export interface MergeWorldUpstreamRequest {
+  worldId: string;
+  /** Parent revision to merge, defaults to the parent's latest */
+  upstreamRevision: number;
+  /** What to do with cells both worlds changed.  By default conflicts are
+ reported and nothing is merged. */
+  strategy: WorldMergeStrategy;
+  /** Compute the merge without saving it */
+  dryRun: boolean;
+}
+
+
+
+export interface MergeWorldUpstreamResponse {
+  world?: World;
+  /** The merged world data - saved unless this was a dry run or it
+ conflicted without a strategy */
+  worldData?: WorldData;
+  /** Parent revisions the merge was between */
+  baseRevision: number;
+  upstreamRevision: number;
+  /** Upstream changes taken into the world */
+  applied?: WorldCellChange[];
+  /** Cells both worlds changed differently */
+  conflicts?: WorldMergeConflict[];
+  /** Whether the merged data was saved */
+  merged: boolean;
+}
+
+
+/**
+ * *
+ Request to search worlds by free text and facets.  Zero fields match
+ every world.
+ */
+export interface SearchWorldsRequest {
+  /** Free text matched against the name, tags, difficulty and description.
+ Words match as prefixes and every word must match. */
+  query: string;
+  /** Only worlds carrying all of these tags */
+  tags: string[];
+  /** Only worlds of one of these difficulties */
+  difficulties: string[];
+  /** Only worlds for this many players */
+  minPlayers: number;
+  maxPlayers: number;
+  /** Only worlds with this many tiles */
+  minTiles: number;
+  maxTiles: number;
+  /** Field to sort by - "relevance" (default with a query), "popularity"
+ (default without), "name" or "updated_at" */
+  sortBy: string;
+  /** "asc" or "desc".  Defaults to best/most/newest first and A-Z for names. */
+  sortOrder: string;
+  /** Pagination info - by offset */
+  pagination?: Pagination;
+}
+
+
+/**
+ * A value of a search facet and how many results have it
+ */
+export interface SearchFacetCount {
+  value: string;
+  count: number;
+}
+
+
+
+export interface SearchWorldsResponse {
+  items?: World[];
+  pagination?: PaginationResponse;
+  /** Facets of all the results (not just this page), most common first */
+  tags?: SearchFacetCount[];
+  difficulties?: SearchFacetCount[];
+  playerCounts?: SearchFacetCount[];
+}
+
@@ -1,7 +1,7 @@
 import { Any, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
 
 
-import { IndexInfo as IndexInfoInterface, Pagination as PaginationInterface, PaginationResponse as PaginationResponseInterface, World as WorldInterface, WorldData as WorldDataInterface, Crossing as CrossingInterface, Tile as TileInterface, Unit as UnitInterface, AttackRecord as AttackRecordInterface, TerrainDefinition as TerrainDefinitionInterface, UnitDefinition as UnitDefinitionInterface, TerrainUnitProperties as TerrainUnitPropertiesInterface, UnitUnitProperties as UnitUnitPropertiesInterface, DamageDistribution as DamageDistributionInterface, DamageRange as DamageRangeInterface, RulesEngine as RulesEngineInterface, Game as GameInterface, GameConfiguration as GameConfigurationInterface, IncomeConfig as IncomeConfigInterface, GamePlayer as GamePlayerInterface, GameTeam as GameTeamInterface, GameSettings as GameSettingsInterface, PlayerState as PlayerStateInterface, GameState as GameStateInterface, GameMoveHistory as GameMoveHistoryInterface, GameMoveGroup as GameMoveGroupInterface, GameMove as GameMoveInterface, Position as PositionInterface, MoveUnitAction as MoveUnitActionInterface, AttackUnitAction as AttackUnitActionInterface, BuildUnitAction as BuildUnitActionInterface, CaptureBuildingAction as CaptureBuildingActionInterface, EndTurnAction as EndTurnActionInterface, HealUnitAction as HealUnitActionInterface, FixUnitAction as FixUnitActionInterface, WorldChange as WorldChangeInterface, UnitHealedChange as UnitHealedChangeInterface, UnitFixedChange as UnitFixedChangeInterface, UnitMovedChange as UnitMovedChangeInterface, UnitDamagedChange as UnitDamagedChangeInterface, UnitKilledChange as UnitKilledChangeInterface, PlayerChangedChange as PlayerChangedChangeInterface, UnitBuiltChange as UnitBuiltChangeInterface, CoinsChangedChange as CoinsChangedChangeInterface, TileCapturedChange as TileCapturedChangeInterface, CaptureStartedChange as CaptureStartedChangeInterface, AllPaths as AllPathsInterface, PathEdge as PathEdgeInterface, Path as PathInterface, File as FileInterface, PutFileRequest as PutFileRequestInterface, PutFileResponse as PutFileResponseInterface, GetFileRequest as GetFileRequestInterface, GetFileResponse as GetFileResponseInterface, DeleteFileRequest as DeleteFileRequestInterface, DeleteFileResponse as DeleteFileResponseInterface, ListFilesRequest as ListFilesRequestInterface, ListFilesResponse as ListFilesResponseInterface, ListGamesRequest as ListGamesRequestInterface, ListGamesResponse as ListGamesResponseInterface, GetGameRequest as GetGameRequestInterface, GetGameResponse as GetGameResponseInterface, GetGameContentRequest as GetGameContentRequestInterface, GetGameContentResponse as GetGameContentResponseInterface, UpdateGameRequest as UpdateGameRequestInterface, UpdateGameResponse as UpdateGameResponseInterface, DeleteGameRequest as DeleteGameRequestInterface, DeleteGameResponse as DeleteGameResponseInterface, GetGamesRequest as GetGamesRequestInterface, GetGamesResponse as GetGamesResponseInterface, CreateGameRequest as CreateGameRequestInterface, CreateGameResponse as CreateGameResponseInterface, ProcessMovesRequest as ProcessMovesRequestInterface, ProcessMovesResponse as ProcessMovesResponseInterface, GetGameStateRequest as GetGameStateRequestInterface, GetGameStateResponse as GetGameStateResponseInterface, ListMovesRequest as ListMovesRequestInterface, ListMovesResponse as ListMovesResponseInterface, GetOptionsAtRequest as GetOptionsAtRequestInterface, GetOptionsAtResponse as GetOptionsAtResponseInterface, GameOption as GameOptionInterface, SimulateAttackRequest as SimulateAttackRequestInterface, SimulateAttackResponse as SimulateAttackResponseInterface, SimulateFixRequest as SimulateFixRequestInterface, SimulateFixResponse as SimulateFixResponseInterface, JoinGameRequest as JoinGameRequestInterface, JoinGameResponse as JoinGameResponseInterface, EmptyRequest as EmptyRequestInterface, EmptyResponse as EmptyResponseInterface, SetContentRequest as SetContentRequestInterface, SetContentResponse as SetContentResponseInterface, ShowBuildOptionsRequest as ShowBuildOptionsRequestInterface, ShowBuildOptionsResponse as ShowBuildOptionsResponseInterface, LogMessageRequest as LogMessageRequestInterface, LogMessageResponse as LogMessageResponseInterface, SetGameStateRequest as SetGameStateRequestInterface, SetGameStateResponse as SetGameStateResponseInterface, UpdateGameStatusRequest as UpdateGameStatusRequestInterface, UpdateGameStatusResponse as UpdateGameStatusResponseInterface, SetTileAtRequest as SetTileAtRequestInterface, SetTileAtResponse as SetTileAtResponseInterface, SetUnitAtRequest as SetUnitAtRequestInterface, SetUnitAtResponse as SetUnitAtResponseInterface, RemoveTileAtRequest as RemoveTileAtRequestInterface, RemoveTileAtResponse as RemoveTileAtResponseInterface, RemoveUnitAtRequest as RemoveUnitAtRequestInterface, RemoveUnitAtResponse as RemoveUnitAtResponseInterface, ShowHighlightsRequest as ShowHighlightsRequestInterface, ShowHighlightsResponse as ShowHighlightsResponseInterface, HighlightSpec as HighlightSpecInterface, ClearHighlightsRequest as ClearHighlightsRequestInterface, ClearHighlightsResponse as ClearHighlightsResponseInterface, ShowPathRequest as ShowPathRequestInterface, ShowPathResponse as ShowPathResponseInterface, ClearPathsRequest as ClearPathsRequestInterface, ClearPathsResponse as ClearPathsResponseInterface, MoveUnitRequest as MoveUnitRequestInterface, MoveUnitResponse as MoveUnitResponseInterface, HexCoord as HexCoordInterface, ShowAttackEffectRequest as ShowAttackEffectRequestInterface, SplashTarget as SplashTargetInterface, ShowAttackEffectResponse as ShowAttackEffectResponseInterface, ShowHealEffectRequest as ShowHealEffectRequestInterface, ShowHealEffectResponse as ShowHealEffectResponseInterface, ShowCaptureEffectRequest as ShowCaptureEffectRequestInterface, ShowCaptureEffectResponse as ShowCaptureEffectResponseInterface, SetAllowedPanelsRequest as SetAllowedPanelsRequestInterface, SetAllowedPanelsResponse as SetAllowedPanelsResponseInterface, IndexState as IndexStateInterface, EnsureIndexStateRequest as EnsureIndexStateRequestInterface, EnsureIndexStateResponse as EnsureIndexStateResponseInterface, GetIndexStatesRequest as GetIndexStatesRequestInterface, IndexStateList as IndexStateListInterface, GetIndexStatesResponse as GetIndexStatesResponseInterface, ListIndexStatesRequest as ListIndexStatesRequestInterface, ListIndexStatesResponse as ListIndexStatesResponseInterface, DeleteIndexStatesRequest as DeleteIndexStatesRequestInterface, DeleteIndexStatesResponse as DeleteIndexStatesResponseInterface, IndexRecord as IndexRecordInterface, IndexRecordsLRO as IndexRecordsLROInterface, CreateIndexRecordsLRORequest as CreateIndexRecordsLRORequestInterface, CreateIndexRecordsLROResponse as CreateIndexRecordsLROResponseInterface, UpdateIndexRecordsLRORequest as UpdateIndexRecordsLRORequestInterface, UpdateIndexRecordsLROResponse as UpdateIndexRecordsLROResponseInterface, GetIndexRecordsLRORequest as GetIndexRecordsLRORequestInterface, GetIndexRecordsLROResponse as GetIndexRecordsLROResponseInterface, Job as JobInterface, RepeatInfo as RepeatInfoInterface, Run as RunInterface, InitializeSingletonRequest as InitializeSingletonRequestInterface, InitializeSingletonResponse as InitializeSingletonResponseInterface, TurnOptionClickedRequest as TurnOptionClickedRequestInterface, TurnOptionClickedResponse as TurnOptionClickedResponseInterface, SceneClickedRequest as SceneClickedRequestInterface, SceneClickedResponse as SceneClickedResponseInterface, EndTurnButtonClickedRequest as EndTurnButtonClickedRequestInterface, EndTurnButtonClickedResponse as EndTurnButtonClickedResponseInterface, BuildOptionClickedRequest as BuildOptionClickedRequestInterface, BuildOptionClickedResponse as BuildOptionClickedResponseInterface, InitializeGameRequest as InitializeGameRequestInterface, InitializeGameResponse as InitializeGameResponseInterface, ClientReadyRequest as ClientReadyRequestInterface, ClientReadyResponse as ClientReadyResponseInterface, ApplyRemoteChangesRequest as ApplyRemoteChangesRequestInterface, ApplyRemoteChangesResponse as ApplyRemoteChangesResponseInterface, SubscribeRequest as SubscribeRequestInterface, SubscribeResponse as SubscribeResponseInterface, GameUpdate as GameUpdateInterface, MovesPublished as MovesPublishedInterface, PlayerJoined as PlayerJoinedInterface, PlayerLeft as PlayerLeftInterface, GameEnded as GameEndedInterface, BroadcastRequest as BroadcastRequestInterface, BroadcastResponse as BroadcastResponseInterface, ThemeInfo as ThemeInfoInterface, UnitMapping as UnitMappingInterface, TerrainMapping as TerrainMappingInterface, ThemeManifest as ThemeManifestInterface, PlayerColor as PlayerColorInterface, AssetResult as AssetResultInterface, WorldInfo as WorldInfoInterface, ListWorldsRequest as ListWorldsRequestInterface, ListWorldsResponse as ListWorldsResponseInterface, GetWorldRequest as GetWorldRequestInterface, GetWorldResponse as GetWorldResponseInterface, UpdateWorldRequest as UpdateWorldRequestInterface, UpdateWorldResponse as UpdateWorldResponseInterface, DeleteWorldRequest as DeleteWorldRequestInterface, DeleteWorldResponse as DeleteWorldResponseInterface, GetWorldsRequest as GetWorldsRequestInterface, GetWorldsResponse as GetWorldsResponseInterface, CreateWorldRequest as CreateWorldRequestInterface, CreateWorldResponse as CreateWorldResponseInterface, CrossingType, TerrainType, GameStatus, PathDirection, IndexStatus, RunState, Type } from "./interfaces";
+import { IndexInfo as IndexInfoInterface, Pagination as PaginationInterface, PaginationResponse as PaginationResponseInterface, World as WorldInterface, WorldData as WorldDataInterface, Crossing as CrossingInterface, Tile as TileInterface, Unit as UnitInterface, AttackRecord as AttackRecordInterface, TerrainDefinition as TerrainDefinitionInterface, UnitDefinition as UnitDefinitionInterface, TerrainUnitProperties as TerrainUnitPropertiesInterface, UnitUnitProperties as UnitUnitPropertiesInterface, DamageDistribution as DamageDistributionInterface, DamageRange as DamageRangeInterface, RulesEngine as RulesEngineInterface, Game as GameInterface, GameConfiguration as GameConfigurationInterface, IncomeConfig as IncomeConfigInterface, GamePlayer as GamePlayerInterface, GameTeam as GameTeamInterface, GameSettings as GameSettingsInterface, PlayerState as PlayerStateInterface, GameState as GameStateInterface, GameMoveHistory as GameMoveHistoryInterface, GameMoveGroup as GameMoveGroupInterface, GameMove as GameMoveInterface, Position as PositionInterface, MoveUnitAction as MoveUnitActionInterface, AttackUnitAction as AttackUnitActionInterface, BuildUnitAction as BuildUnitActionInterface, CaptureBuildingAction as CaptureBuildingActionInterface, EndTurnAction as EndTurnActionInterface, HealUnitAction as HealUnitActionInterface, FixUnitAction as FixUnitActionInterface, WorldChange as WorldChangeInterface, UnitHealedChange as UnitHealedChangeInterface, UnitFixedChange as UnitFixedChangeInterface, UnitMovedChange as UnitMovedChangeInterface, UnitDamagedChange as UnitDamagedChangeInterface, UnitKilledChange as UnitKilledChangeInterface, PlayerChangedChange as PlayerChangedChangeInterface, UnitBuiltChange as UnitBuiltChangeInterface, CoinsChangedChange as CoinsChangedChangeInterface, TileCapturedChange as TileCapturedChangeInterface, CaptureStartedChange as CaptureStartedChangeInterface, AllPaths as AllPathsInterface, PathEdge as PathEdgeInterface, Path as PathInterface, PlayerOrders as PlayerOrdersInterface, TimeRange as TimeRangeInterface, UserGame as UserGameInterface, UserGameList as UserGameListInterface, WorldRevision as WorldRevisionInterface, WorldCellChange as WorldCellChangeInterface, WorldMergeConflict as WorldMergeConflictInterface, WorldSearchEntry as WorldSearchEntryInterface, File as FileInterface, PutFileRequest as PutFileRequestInterface, PutFileResponse as PutFileResponseInterface, GetFileRequest as GetFileRequestInterface, GetFileResponse as GetFileResponseInterface, DeleteFileRequest as DeleteFileRequestInterface, DeleteFileResponse as DeleteFileResponseInterface, ListFilesRequest as ListFilesRequestInterface, ListFilesResponse as ListFilesResponseInterface, ListGamesRequest as ListGamesRequestInterface, ListGamesResponse as ListGamesResponseInterface, GetGameRequest as GetGameRequestInterface, GetGameResponse as GetGameResponseInterface, GetGameContentRequest as GetGameContentRequestInterface, GetGameContentResponse as GetGameContentResponseInterface, UpdateGameRequest as UpdateGameRequestInterface, UpdateGameResponse as UpdateGameResponseInterface, DeleteGameRequest as DeleteGameRequestInterface, DeleteGameResponse as DeleteGameResponseInterface, GetGamesRequest as GetGamesRequestInterface, GetGamesResponse as GetGamesResponseInterface, CreateGameRequest as CreateGameRequestInterface, CreateGameResponse as CreateGameResponseInterface, ProcessMovesRequest as ProcessMovesRequestInterface, ProcessMovesResponse as ProcessMovesResponseInterface, GetGameStateRequest as GetGameStateRequestInterface, GetGameStateResponse as GetGameStateResponseInterface, ListMovesRequest as ListMovesRequestInterface, ListMovesResponse as ListMovesResponseInterface, GetOptionsAtRequest as GetOptionsAtRequestInterface, GetOptionsAtResponse as GetOptionsAtResponseInterface, GameOption as GameOptionInterface, SimulateAttackRequest as SimulateAttackRequestInterface, SimulateAttackResponse as SimulateAttackResponseInterface, SimulateFixRequest as SimulateFixRequestInterface, SimulateFixResponse as SimulateFixResponseInterface, JoinGameRequest as JoinGameRequestInterface, JoinGameResponse as JoinGameResponseInterface, CommitOrdersRequest as CommitOrdersRequestInterface, CommitOrdersResponse as CommitOrdersResponseInterface, RevealOrdersRequest as RevealOrdersRequestInterface, RevealOrdersResponse as RevealOrdersResponseInterface, ListMyGamesRequest as ListMyGamesRequestInterface, ListMyGamesResponse as ListMyGamesResponseInterface, ExportGameRequest as ExportGameRequestInterface, ExportGameResponse as ExportGameResponseInterface, ImportGameRequest as ImportGameRequestInterface, ImportGameResponse as ImportGameResponseInterface, EmptyRequest as EmptyRequestInterface, EmptyResponse as EmptyResponseInterface, SetContentRequest as SetContentRequestInterface, SetContentResponse as SetContentResponseInterface, ShowBuildOptionsRequest as ShowBuildOptionsRequestInterface, ShowBuildOptionsResponse as ShowBuildOptionsResponseInterface, LogMessageRequest as LogMessageRequestInterface, LogMessageResponse as LogMessageResponseInterface, SetGameStateRequest as SetGameStateRequestInterface, SetGameStateResponse as SetGameStateResponseInterface, UpdateGameStatusRequest as UpdateGameStatusRequestInterface, UpdateGameStatusResponse as UpdateGameStatusResponseInterface, SetTileAtRequest as SetTileAtRequestInterface, SetTileAtResponse as SetTileAtResponseInterface, SetUnitAtRequest as SetUnitAtRequestInterface, SetUnitAtResponse as SetUnitAtResponseInterface, RemoveTileAtRequest as RemoveTileAtRequestInterface, RemoveTileAtResponse as RemoveTileAtResponseInterface, RemoveUnitAtRequest as RemoveUnitAtRequestInterface, RemoveUnitAtResponse as RemoveUnitAtResponseInterface, ShowHighlightsRequest as ShowHighlightsRequestInterface, ShowHighlightsResponse as ShowHighlightsResponseInterface, HighlightSpec as HighlightSpecInterface, ClearHighlightsRequest as ClearHighlightsRequestInterface, ClearHighlightsResponse as ClearHighlightsResponseInterface, ShowPathRequest as ShowPathRequestInterface, ShowPathResponse as ShowPathResponseInterface, ClearPathsRequest as ClearPathsRequestInterface, ClearPathsResponse as ClearPathsResponseInterface, MoveUnitRequest as MoveUnitRequestInterface, MoveUnitResponse as MoveUnitResponseInterface, HexCoord as HexCoordInterface, ShowAttackEffectRequest as ShowAttackEffectRequestInterface, SplashTarget as SplashTargetInterface, ShowAttackEffectResponse as ShowAttackEffectResponseInterface, ShowHealEffectRequest as ShowHealEffectRequestInterface, ShowHealEffectResponse as ShowHealEffectResponseInterface, ShowCaptureEffectRequest as ShowCaptureEffectRequestInterface, ShowCaptureEffectResponse as ShowCaptureEffectResponseInterface, SetAllowedPanelsRequest as SetAllowedPanelsRequestInterface, SetAllowedPanelsResponse as SetAllowedPanelsResponseInterface, IndexState as IndexStateInterface, EnsureIndexStateRequest as EnsureIndexStateRequestInterface, EnsureIndexStateResponse as EnsureIndexStateResponseInterface, GetIndexStatesRequest as GetIndexStatesRequestInterface, IndexStateList as IndexStateListInterface, GetIndexStatesResponse as GetIndexStatesResponseInterface, ListIndexStatesRequest as ListIndexStatesRequestInterface, ListIndexStatesResponse as ListIndexStatesResponseInterface, DeleteIndexStatesRequest as DeleteIndexStatesRequestInterface, DeleteIndexStatesResponse as DeleteIndexStatesResponseInterface, IndexRecord as IndexRecordInterface, IndexRecordsLRO as IndexRecordsLROInterface, CreateIndexRecordsLRORequest as CreateIndexRecordsLRORequestInterface, CreateIndexRecordsLROResponse as CreateIndexRecordsLROResponseInterface, UpdateIndexRecordsLRORequest as UpdateIndexRecordsLRORequestInterface, UpdateIndexRecordsLROResponse as UpdateIndexRecordsLROResponseInterface, GetIndexRecordsLRORequest as GetIndexRecordsLRORequestInterface, GetIndexRecordsLROResponse as GetIndexRecordsLROResponseInterface, Job as JobInterface, RepeatInfo as RepeatInfoInterface, Run as RunInterface, ListJobsRequest as ListJobsRequestInterface, ListJobsResponse as ListJobsResponseInterface, GetJobRequest as GetJobRequestInterface, GetJobResponse as GetJobResponseInterface, RetryJobRequest as RetryJobRequestInterface, RetryJobResponse as RetryJobResponseInterface, InitializeSingletonRequest as InitializeSingletonRequestInterface, InitializeSingletonResponse as InitializeSingletonResponseInterface, TurnOptionClickedRequest as TurnOptionClickedRequestInterface, TurnOptionClickedResponse as TurnOptionClickedResponseInterface, SceneClickedRequest as SceneClickedRequestInterface, SceneClickedResponse as SceneClickedResponseInterface, EndTurnButtonClickedRequest as EndTurnButtonClickedRequestInterface, EndTurnButtonClickedResponse as EndTurnButtonClickedResponseInterface, BuildOptionClickedRequest as BuildOptionClickedRequestInterface, BuildOptionClickedResponse as BuildOptionClickedResponseInterface, InitializeGameRequest as InitializeGameRequestInterface, InitializeGameResponse as InitializeGameResponseInterface, ClientReadyRequest as ClientReadyRequestInterface, ClientReadyResponse as ClientReadyResponseInterface, ApplyRemoteChangesRequest as ApplyRemoteChangesRequestInterface, ApplyRemoteChangesResponse as ApplyRemoteChangesResponseInterface, SubscribeRequest as SubscribeRequestInterface, SubscribeResponse as SubscribeResponseInterface, GameUpdate as GameUpdateInterface, MovesPublished as MovesPublishedInterface, PlayerJoined as PlayerJoinedInterface, PlayerLeft as PlayerLeftInterface, GameEnded as GameEndedInterface, BroadcastRequest as BroadcastRequestInterface, BroadcastResponse as BroadcastResponseInterface, ThemeInfo as ThemeInfoInterface, UnitMapping as UnitMappingInterface, TerrainMapping as TerrainMappingInterface, ThemeManifest as ThemeManifestInterface, PlayerColor as PlayerColorInterface, AssetResult as AssetResultInterface, WorldInfo as WorldInfoInterface, ListWorldsRequest as ListWorldsRequestInterface, ListWorldsResponse as ListWorldsResponseInterface, GetWorldRequest as GetWorldRequestInterface, GetWorldResponse as GetWorldResponseInterface, UpdateWorldRequest as UpdateWorldRequestInterface, UpdateWorldResponse as UpdateWorldResponseInterface, DeleteWorldRequest as DeleteWorldRequestInterface, DeleteWorldResponse as DeleteWorldResponseInterface, GetWorldsRequest as GetWorldsRequestInterface, GetWorldsResponse as GetWorldsResponseInterface, CreateWorldRequest as CreateWorldRequestInterface, CreateWorldResponse as CreateWorldResponseInterface, ListWorldRevisionsRequest as ListWorldRevisionsRequestInterface, ListWorldRevisionsResponse as ListWorldRevisionsResponseInterface, RevertWorldRequest as RevertWorldRequestInterface, RevertWorldResponse as RevertWorldResponseInterface, ListDerivedWorldsRequest as ListDerivedWorldsRequestInterface, ListDerivedWorldsResponse as ListDerivedWorldsResponseInterface, MergeWorldUpstreamRequest as MergeWorldUpstreamRequestInterface, MergeWorldUpstreamResponse as MergeWorldUpstreamResponseInterface, SearchWorldsRequest as SearchWorldsRequestInterface, SearchFacetCount as SearchFacetCountInterface, SearchWorldsResponse as SearchWorldsResponseInterface, CrossingType, TerrainType, GameStatus, PathDirection, WorldChangeKind, WorldMergeStrategy, IndexStatus, RunState, JobStatus, Type } from "./interfaces";
 
 
 
@@ -69,7 +69,8 @@ export class PaginationResponse implements PaginationResponseInterface {
  Whether theere are more results. */
   hasMore: boolean = false;
   /** *
- Total number of results. */
+ Total number of results.  Backends that cannot count matches cheaply
+ leave this as 0. */
   totalResults: number = 0;
 
   
@@ -108,6 +109,12 @@ export class World implements WorldInterface {
   /** Default game configs */
   defaultGameConfig?: GameConfiguration;
   searchIndexInfo?: IndexInfo;
+  /** World this one was copied (remixed) from, empty for original worlds */
+  parentWorldId: string = "";
+  /** Revision of the parent world this one was copied from, or last merged
+ upstream changes from.  It is the base of three-way merges with the
+ parent. */
+  parentRevision: number = 0;
 
   
 }
@@ -467,6 +474,12 @@ export class Game implements GameInterface {
  Can be overridden to point to CDN or external hosting */
   previewUrls: string[] = [];
   searchIndexInfo?: IndexInfo;
+  /** The world revision (WorldData version) this game was created from.
+ 0 for games created before worlds kept revisions. */
+  worldRevision: number = 0;
+  /** The version (content hash) of the rules and damage data this game is
+ played with.  Empty for games created before games recorded it. */
+  rulesVersion: string = "";
 
   
 }
@@ -580,12 +593,24 @@ export class GameSettings implements GameSettingsInterface {
 
   /** List of allowed unit type IDs */
   allowedUnits: number[] = [];
-  /** Turn time limit in seconds (0 = no limit) */
+  /** Turn time limit in seconds (0 = no limit, or 24 hours for each orders phase of simultaneous turns) */
   turnTimeLimit: number = 0;
   /** Team mode */
   teamMode: string = "";
   /** Maximum number of turns (0 = unlimited) */
   maxTurns: number = 0;
+  /** Turn mode - "sequential" (default) or "simultaneous"
+ In simultaneous mode all players commit orders for a turn in parallel
+ and they are resolved together once everyone has revealed them. */
+  turnMode: string = "";
+  /** Teammates share a single coin pool for building units */
+  sharedCoins: boolean = false;
+  /** Teammates may move and act with each other's units on their turn */
+  sharedControl: boolean = false;
+  /** Units may be healed and fixed at allied bases and by allied units */
+  alliedSupport: boolean = false;
+  /** The game is won by a team once only its players have units left */
+  combinedVictory: boolean = false;
 
   
 }
@@ -642,6 +667,9 @@ export class GameState implements GameStateInterface {
   /** Per-player runtime state, keyed by player_id (1-based)
  This holds mutable player state like coins that changes during gameplay */
   playerStates: Record<number, PlayerState> = {};
+  /** Orders committed/revealed for the current turn in simultaneous mode,
+ keyed by player_id.  Cleared once the turn is resolved. */
+  pendingOrders: Record<number, PlayerOrders> = {};
 
   
 }
@@ -1202,6 +1230,199 @@ export class Path implements PathInterface {
 }
 
 
+/**
+ * Orders a player has submitted for the current turn in simultaneous mode.
+ Orders go through a commit/reveal flow - a player first commits a hash of
+ their moves so nobody can react to anybody else's orders, and once every
+ player has committed the moves are revealed and checked against the hash.
+ */
+export class PlayerOrders implements PlayerOrdersInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.PlayerOrders";
+  readonly __MESSAGE_TYPE = PlayerOrders.MESSAGE_TYPE;
+
+  playerId: number = 0;
+  /** Hex encoded SHA-256 of the moves and salt (see lib.OrdersCommitment) */
+  commitment: string = "";
+  committedAt?: Timestamp;
+  /** Set once the moves have been revealed and verified against the commitment */
+  revealed: boolean = false;
+  /** The revealed moves - empty until revealed */
+  moves: GameMove[] = [];
+  /** Salt used in the commitment so identical orders do not hash the same */
+  salt: string = "";
+
+  
+}
+
+
+/**
+ * A time window used to filter list results.  Either bound may be omitted;
+ start is inclusive and end is exclusive.
+ */
+export class TimeRange implements TimeRangeInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.TimeRange";
+  readonly __MESSAGE_TYPE = TimeRange.MESSAGE_TYPE;
+
+  start?: Timestamp;
+  end?: Timestamp;
+
+  
+}
+
+
+/**
+ * An entry in the per-user game index - one per (user, game) the user holds
+ a player slot in.  Entries are rewritten whenever the game is created,
+ joined or changes turn so "my games" can be listed without loading every
+ game.
+ */
+export class UserGame implements UserGameInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.UserGame";
+  readonly __MESSAGE_TYPE = UserGame.MESSAGE_TYPE;
+
+  userId: string = "";
+  gameId: string = "";
+  /** The user's player slots in the game (usually one) */
+  playerIds: number[] = [];
+  /** Denormalized from the game for listing */
+  gameName: string = "";
+  worldId: string = "";
+  /** Denormalized from the game state */
+  status: GameStatus = GameStatus.GAME_STATUS_UNSPECIFIED;
+  currentPlayer: number = 0;
+  turnCounter: number = 0;
+  /** Whether the game is waiting on this user - it is one of their slots'
+ turn, or in simultaneous mode they have orders to commit or reveal */
+  isMyTurn: boolean = false;
+  /** When the entry was last rewritten */
+  updatedAt?: Timestamp;
+
+  
+}
+
+
+/**
+ * The index entries of one user (the file backend stores one per user)
+ */
+export class UserGameList implements UserGameListInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.UserGameList";
+  readonly __MESSAGE_TYPE = UserGameList.MESSAGE_TYPE;
+
+  games: UserGame[] = [];
+
+  
+}
+
+
+/**
+ * An immutable snapshot of a world's data.  A revision is recorded every
+ time the world's data is saved and is numbered by the WorldData version
+ it captures, so games can be pinned to the revision they started from.
+ */
+export class WorldRevision implements WorldRevisionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.WorldRevision";
+  readonly __MESSAGE_TYPE = WorldRevision.MESSAGE_TYPE;
+
+  worldId: string = "";
+  /** The WorldData version this revision captures */
+  revision: number = 0;
+  createdAt?: Timestamp;
+  /** User whose save created the revision */
+  authorId: string = "";
+  /** Set when the revision was created by reverting to an older one */
+  revertedFrom: number = 0;
+  contentHash: string = "";
+  tileCount: number = 0;
+  unitCount: number = 0;
+  /** The world's data at this revision (not set when listing revisions) */
+  worldData?: WorldData;
+
+  
+}
+
+
+/**
+ * A change to one cell of one layer of a world
+ */
+export class WorldCellChange implements WorldCellChangeInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.WorldCellChange";
+  readonly __MESSAGE_TYPE = WorldCellChange.MESSAGE_TYPE;
+
+  /** "tile", "unit" or "crossing" */
+  layer: string = "";
+  /** "q,r" coordinate of the cell */
+  key: string = "";
+  kind: WorldChangeKind = WorldChangeKind.WORLD_CHANGE_KIND_UNSPECIFIED;
+
+  
+}
+
+
+/**
+ * A cell both sides of a three-way world merge changed, differently
+ */
+export class WorldMergeConflict implements WorldMergeConflictInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.WorldMergeConflict";
+  readonly __MESSAGE_TYPE = WorldMergeConflict.MESSAGE_TYPE;
+
+  /** "tile", "unit" or "crossing" */
+  layer: string = "";
+  /** "q,r" coordinate of the cell */
+  key: string = "";
+  /** How each side changed the cell relative to the common base */
+  ours: WorldChangeKind = WorldChangeKind.WORLD_CHANGE_KIND_UNSPECIFIED;
+  theirs: WorldChangeKind = WorldChangeKind.WORLD_CHANGE_KIND_UNSPECIFIED;
+
+  
+}
+
+
+/**
+ * A world as kept in the world search index, with the attributes searches
+ filter and sort on
+ */
+export class WorldSearchEntry implements WorldSearchEntryInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.WorldSearchEntry";
+  readonly __MESSAGE_TYPE = WorldSearchEntry.MESSAGE_TYPE;
+
+  world?: World;
+  /** Number of players - the distinct players owning units or tiles */
+  players: number = 0;
+  /** Number of tiles, the map size */
+  tiles: number = 0;
+  /** Number of games created from the world, its popularity */
+  games: number = 0;
+  /** When the entry was last indexed */
+  indexedAt?: Timestamp;
+
+  
+}
+
+
 
 export class File implements FileInterface {
   /**
@@ -1332,6 +1553,8 @@ export class ListFilesRequest implements ListFilesRequestInterface {
   pagination?: Pagination;
   /** If true, populate signed_urls in each File response */
   includeSignedUrls: boolean = false;
+  /** If true, list the files under every sub directory of path too */
+  recursive: boolean = false;
 
   
 }
@@ -1366,6 +1589,21 @@ export class ListGamesRequest implements ListGamesRequestInterface {
   pagination?: Pagination;
   /** May be filter by owner id */
   ownerId: string = "";
+  /** Only games with this user in one of the player slots */
+  playerUserId: string = "";
+  /** Only games in this status (unspecified matches every status) */
+  status: GameStatus = GameStatus.GAME_STATUS_UNSPECIFIED;
+  /** Only games created from this world */
+  worldId: string = "";
+  /** Only games carrying this tag */
+  tag: string = "";
+  /** Only games created/updated within these windows */
+  created?: TimeRange;
+  updated?: TimeRange;
+  /** Field to sort by - "updated_at" (default), "created_at" or "name" */
+  sortBy: string = "";
+  /** "asc" or "desc".  Defaults to newest first for times and A-Z for names. */
+  sortOrder: string = "";
 
   
 }
@@ -1934,6 +2172,226 @@ export class JoinGameResponse implements JoinGameResponseInterface {
 }
 
 
+/**
+ * *
+ Request to commit orders for the current turn in a simultaneous turns game.
+ Only a hash of the orders is sent so other players learn nothing about them
+ until everyone has committed.
+ */
+export class CommitOrdersRequest implements CommitOrdersRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.CommitOrdersRequest";
+  readonly __MESSAGE_TYPE = CommitOrdersRequest.MESSAGE_TYPE;
+
+  /** ID of the game */
+  gameId: string = "";
+  /** The player slot committing (1-based).  Optional - defaults to the
+ caller's slot.  Must belong to the caller. */
+  playerId: number = 0;
+  /** Hex encoded SHA-256 of the moves and salt (see lib.OrdersCommitment) */
+  commitment: string = "";
+
+  
+}
+
+
+/**
+ * *
+ Response after committing orders
+ */
+export class CommitOrdersResponse implements CommitOrdersResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.CommitOrdersResponse";
+  readonly __MESSAGE_TYPE = CommitOrdersResponse.MESSAGE_TYPE;
+
+  /** The player ID the orders were committed for */
+  playerId: number = 0;
+  /** Players that have not committed their orders yet */
+  waitingOn: number[] = [];
+
+  
+}
+
+
+/**
+ * *
+ Request to reveal previously committed orders.
+ Only allowed once every player has committed.
+ */
+export class RevealOrdersRequest implements RevealOrdersRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.RevealOrdersRequest";
+  readonly __MESSAGE_TYPE = RevealOrdersRequest.MESSAGE_TYPE;
+
+  /** ID of the game */
+  gameId: string = "";
+  /** The player slot revealing (1-based).  Optional - defaults to the
+ caller's slot.  Must belong to the caller. */
+  playerId: number = 0;
+  /** The moves that were committed to */
+  moves: GameMove[] = [];
+  /** The salt used when computing the commitment */
+  salt: string = "";
+
+  
+}
+
+
+/**
+ * *
+ Response after revealing orders
+ */
+export class RevealOrdersResponse implements RevealOrdersResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.RevealOrdersResponse";
+  readonly __MESSAGE_TYPE = RevealOrdersResponse.MESSAGE_TYPE;
+
+  /** The player ID the orders were revealed for */
+  playerId: number = 0;
+  /** Players that have not revealed their orders yet */
+  waitingOn: number[] = [];
+  /** Whether this reveal completed the turn and all orders were resolved */
+  resolved: boolean = false;
+  /** The resolved moves (in resolution order) with their changes when resolved.
+ Orders that could not be carried out are included without changes and
+ with the reason in their description. */
+  moves: GameMove[] = [];
+
+  
+}
+
+
+/**
+ * *
+ Request to list the games the caller is a player in
+ */
+export class ListMyGamesRequest implements ListMyGamesRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ListMyGamesRequest";
+  readonly __MESSAGE_TYPE = ListMyGamesRequest.MESSAGE_TYPE;
+
+  /** Pagination info (offset based) */
+  pagination?: Pagination;
+  /** Only games where it is the caller's turn */
+  onlyMyTurn: boolean = false;
+  /** Only games in this status (unspecified matches every status) */
+  status: GameStatus = GameStatus.GAME_STATUS_UNSPECIFIED;
+
+  
+}
+
+
+/**
+ * *
+ The caller's games, those waiting on the caller first and then most
+ recently updated first
+ */
+export class ListMyGamesResponse implements ListMyGamesResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ListMyGamesResponse";
+  readonly __MESSAGE_TYPE = ListMyGamesResponse.MESSAGE_TYPE;
+
+  items: UserGame[] = [];
+  pagination?: PaginationResponse;
+
+  
+}
+
+
+/**
+ * *
+ Request to export a game archive
+ */
+export class ExportGameRequest implements ExportGameRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ExportGameRequest";
+  readonly __MESSAGE_TYPE = ExportGameRequest.MESSAGE_TYPE;
+
+  gameId: string = "";
+
+  
+}
+
+
+/**
+ * *
+ A game archive - a zip file with a manifest, see lib/game_archive.go
+ */
+export class ExportGameResponse implements ExportGameResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ExportGameResponse";
+  readonly __MESSAGE_TYPE = ExportGameResponse.MESSAGE_TYPE;
+
+  archive: Uint8Array = new Uint8Array();
+  /** SHA-256 content hash recorded in the archive's manifest */
+  contentHash: string = "";
+
+  
+}
+
+
+/**
+ * *
+ Request to import a game archive
+ */
+export class ImportGameRequest implements ImportGameRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ImportGameRequest";
+  readonly __MESSAGE_TYPE = ImportGameRequest.MESSAGE_TYPE;
+
+  archive: Uint8Array = new Uint8Array();
+  /** ID to import the game under - defaults to the archived game's ID */
+  gameId: string = "";
+
+  
+}
+
+
+
+export class ImportGameResponse implements ImportGameResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ImportGameResponse";
+  readonly __MESSAGE_TYPE = ImportGameResponse.MESSAGE_TYPE;
+
+  /** The imported game (not set if the ID was taken) */
+  game?: Game;
+  /** Content hash of the verified archive */
+  contentHash: string = "";
+  /** Number of move groups replayed to verify the archive */
+  replayedGroups: number = 0;
+  /** Whether the bundled rules are the rules this server plays with.  If
+ not the server keeps the bundled rules and the game goes on being
+ played with them. */
+  rulesMatch: boolean = false;
+  /** *
+ Error specific to a field if there are any errors - as in CreateGame a
+ taken "id" maps to a suggested free one. */
+  fieldErrors: Record<string, string> = {};
+
+  
+}
+
+
 
 export class EmptyRequest implements EmptyRequestInterface {
   /**
@@ -2911,6 +3369,23 @@ export class Job implements JobInterface {
   debounceWindowSeconds: number = 0;
   /** Whether the job is a oneoff or can repeat */
   repeatInfo?: RepeatInfo;
+  /** job_type:entity_type:entity_id - so enqueueing the same work again
+ updates the pending job instead of adding another one */
+  id: string = "";
+  status: JobStatus = JobStatus.JOB_STATUS_UNSPECIFIED;
+  /** When the job is next due to run */
+  runAfter?: Timestamp;
+  /** Failed runs since the job last succeeded */
+  attempts: number = 0;
+  /** How many times a failing job is retried before it is marked failed.
+ 0 uses the runner's default. */
+  maxRetries: number = 0;
+  /** Error of the last failed run */
+  lastError: string = "";
+  /** ID of the latest run */
+  lastRunId: string = "";
+  /** Incremented on every save for optimistic locking */
+  version: number = 0;
 
   
 }
@@ -2924,6 +3399,9 @@ export class RepeatInfo implements RepeatInfoInterface {
   static readonly MESSAGE_TYPE = "lilbattle.v1.RepeatInfo";
   readonly __MESSAGE_TYPE = RepeatInfo.MESSAGE_TYPE;
 
+  /** Seconds from the end of one run to the start of the next.  0 runs the
+ job once each time it is enqueued. */
+  intervalSeconds: number = 0;
 
   
 }
@@ -2953,6 +3431,102 @@ export class Run implements RunInterface {
   lastContentHash: string = "";
   /** If there were retries */
   retryCount: number = 0;
+  jobType: string = "";
+  finishedAt?: Timestamp;
+
+  
+}
+
+
+
+export class ListJobsRequest implements ListJobsRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ListJobsRequest";
+  readonly __MESSAGE_TYPE = ListJobsRequest.MESSAGE_TYPE;
+
+  /** Only jobs of this type */
+  jobType: string = "";
+  /** Only jobs in this status (unspecified matches every status) */
+  status: JobStatus = JobStatus.JOB_STATUS_UNSPECIFIED;
+  pagination?: Pagination;
+
+  
+}
+
+
+
+export class ListJobsResponse implements ListJobsResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ListJobsResponse";
+  readonly __MESSAGE_TYPE = ListJobsResponse.MESSAGE_TYPE;
+
+  /** Jobs due soonest first */
+  items: Job[] = [];
+  pagination?: PaginationResponse;
+
+  
+}
+
+
+
+export class GetJobRequest implements GetJobRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.GetJobRequest";
+  readonly __MESSAGE_TYPE = GetJobRequest.MESSAGE_TYPE;
+
+  id: string = "";
+  /** How many of the latest runs to return (default 20) */
+  runLimit: number = 0;
+
+  
+}
+
+
+
+export class GetJobResponse implements GetJobResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.GetJobResponse";
+  readonly __MESSAGE_TYPE = GetJobResponse.MESSAGE_TYPE;
+
+  job?: Job;
+  /** Run history, newest first */
+  runs: Run[] = [];
+
+  
+}
+
+
+
+export class RetryJobRequest implements RetryJobRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.RetryJobRequest";
+  readonly __MESSAGE_TYPE = RetryJobRequest.MESSAGE_TYPE;
+
+  id: string = "";
+
+  
+}
+
+
+
+export class RetryJobResponse implements RetryJobResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.RetryJobResponse";
+  readonly __MESSAGE_TYPE = RetryJobResponse.MESSAGE_TYPE;
+
+  job?: Job;
 
   
 }
@@ -3277,6 +3851,10 @@ export class SubscribeResponse implements SubscribeResponseInterface {
   gameState?: GameState;
   /** Game metadata */
   game?: Game;
+  /** Set when from_sequence can't be resumed because the updates since then
+ are no longer retained.  The client should reload the game via GetGame
+ and track sequences from current_sequence. */
+  reloadRequired: boolean = false;
 
   
 }
@@ -3573,6 +4151,17 @@ export class ListWorldsRequest implements ListWorldsRequestInterface {
   pagination?: Pagination;
   /** May be filter by owner id */
   ownerId: string = "";
+  /** Only worlds carrying this tag */
+  tag: string = "";
+  /** Only worlds created/updated within these windows */
+  created?: TimeRange;
+  updated?: TimeRange;
+  /** Field to sort by - "name" (default), "updated_at" or "created_at" */
+  sortBy: string = "";
+  /** "asc" or "desc".  Defaults to newest first for times and A-Z for names. */
+  sortOrder: string = "";
+  /** Only worlds copied from this world */
+  parentWorldId: string = "";
 
   
 }
@@ -3602,6 +4191,7 @@ export class GetWorldRequest implements GetWorldRequestInterface {
   readonly __MESSAGE_TYPE = GetWorldRequest.MESSAGE_TYPE;
 
   id: string = "";
+  /** Optional revision (WorldData version) to fetch, defaults to the latest */
   version: string = "";
 
   
@@ -3778,3 +4368,237 @@ export class CreateWorldResponse implements CreateWorldResponseInterface {
 }
 
 
+/**
+ * *
+ Request to list a world's revisions
+ */
+export class ListWorldRevisionsRequest implements ListWorldRevisionsRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ListWorldRevisionsRequest";
+  readonly __MESSAGE_TYPE = ListWorldRevisionsRequest.MESSAGE_TYPE;
+
+  worldId: string = "";
+  /** Pagination info */
+  pagination?: Pagination;
+
+  
+}
+
+
+/**
+ * *
+ A world's revisions, newest first, without their world data
+ */
+export class ListWorldRevisionsResponse implements ListWorldRevisionsResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ListWorldRevisionsResponse";
+  readonly __MESSAGE_TYPE = ListWorldRevisionsResponse.MESSAGE_TYPE;
+
+  items: WorldRevision[] = [];
+  pagination?: PaginationResponse;
+
+  
+}
+
+
+/**
+ * *
+ Request to make an older revision of a world its latest one
+ */
+export class RevertWorldRequest implements RevertWorldRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.RevertWorldRequest";
+  readonly __MESSAGE_TYPE = RevertWorldRequest.MESSAGE_TYPE;
+
+  worldId: string = "";
+  /** The revision to revert to */
+  revision: number = 0;
+
+  
+}
+
+
+/**
+ * *
+ Response of a revert.  The world's data is saved as a new revision so
+ the revisions after the one reverted to are kept.
+ */
+export class RevertWorldResponse implements RevertWorldResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.RevertWorldResponse";
+  readonly __MESSAGE_TYPE = RevertWorldResponse.MESSAGE_TYPE;
+
+  world?: World;
+  worldData?: WorldData;
+
+  
+}
+
+
+/**
+ * *
+ Request to list the worlds copied (remixed) from a world
+ */
+export class ListDerivedWorldsRequest implements ListDerivedWorldsRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ListDerivedWorldsRequest";
+  readonly __MESSAGE_TYPE = ListDerivedWorldsRequest.MESSAGE_TYPE;
+
+  worldId: string = "";
+  /** Pagination info */
+  pagination?: Pagination;
+
+  
+}
+
+
+
+export class ListDerivedWorldsResponse implements ListDerivedWorldsResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.ListDerivedWorldsResponse";
+  readonly __MESSAGE_TYPE = ListDerivedWorldsResponse.MESSAGE_TYPE;
+
+  items: World[] = [];
+  pagination?: PaginationResponse;
+
+  
+}
+
+
+/**
+ * *
+ Request to pull changes made to a world's parent since it was copied (or
+ last merged) into the world.  The parent revision the world records is
+ the base of a three-way merge of tiles, units and crossings.
+ */
+export class MergeWorldUpstreamRequest implements MergeWorldUpstreamRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.MergeWorldUpstreamRequest";
+  readonly __MESSAGE_TYPE = MergeWorldUpstreamRequest.MESSAGE_TYPE;
+
+  worldId: string = "";
+  /** Parent revision to merge, defaults to the parent's latest */
+  upstreamRevision: number = 0;
+  /** What to do with cells both worlds changed.  By default conflicts are
+ reported and nothing is merged. */
+  strategy: WorldMergeStrategy = WorldMergeStrategy.WORLD_MERGE_STRATEGY_UNSPECIFIED;
+  /** Compute the merge without saving it */
+  dryRun: boolean = false;
+
+  
+}
+
+
+
+export class MergeWorldUpstreamResponse implements MergeWorldUpstreamResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.MergeWorldUpstreamResponse";
+  readonly __MESSAGE_TYPE = MergeWorldUpstreamResponse.MESSAGE_TYPE;
+
+  world?: World;
+  /** The merged world data - saved unless this was a dry run or it
+ conflicted without a strategy */
+  worldData?: WorldData;
+  /** Parent revisions the merge was between */
+  baseRevision: number = 0;
+  upstreamRevision: number = 0;
+  /** Upstream changes taken into the world */
+  applied: WorldCellChange[] = [];
+  /** Cells both worlds changed differently */
+  conflicts: WorldMergeConflict[] = [];
+  /** Whether the merged data was saved */
+  merged: boolean = false;
+
+  
+}
+
+
+/**
+ * *
+ Request to search worlds by free text and facets.  Zero fields match
+ every world.
+ */
+export class SearchWorldsRequest implements SearchWorldsRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.SearchWorldsRequest";
+  readonly __MESSAGE_TYPE = SearchWorldsRequest.MESSAGE_TYPE;
+
+  /** Free text matched against the name, tags, difficulty and description.
+ Words match as prefixes and every word must match. */
+  query: string = "";
+  /** Only worlds carrying all of these tags */
+  tags: string[] = [];
+  /** Only worlds of one of these difficulties */
+  difficulties: string[] = [];
+  /** Only worlds for this many players */
+  minPlayers: number = 0;
+  maxPlayers: number = 0;
+  /** Only worlds with this many tiles */
+  minTiles: number = 0;
+  maxTiles: number = 0;
+  /** Field to sort by - "relevance" (default with a query), "popularity"
+ (default without), "name" or "updated_at" */
+  sortBy: string = "";
+  /** "asc" or "desc".  Defaults to best/most/newest first and A-Z for names. */
+  sortOrder: string = "";
+  /** Pagination info - by offset */
+  pagination?: Pagination;
+
+  
+}
+
+
+/**
+ * A value of a search facet and how many results have it
+ */
+export class SearchFacetCount implements SearchFacetCountInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.SearchFacetCount";
+  readonly __MESSAGE_TYPE = SearchFacetCount.MESSAGE_TYPE;
+
+  value: string = "";
+  count: number = 0;
+
+  
+}
+
+
+
+export class SearchWorldsResponse implements SearchWorldsResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "lilbattle.v1.SearchWorldsResponse";
+  readonly __MESSAGE_TYPE = SearchWorldsResponse.MESSAGE_TYPE;
+
+  items: World[] = [];
+  pagination?: PaginationResponse;
+  /** Facets of all the results (not just this page), most common first */
+  tags: SearchFacetCount[] = [];
+  difficulties: SearchFacetCount[] = [];
+  playerCounts: SearchFacetCount[] = [];
+
+  
+}
+
+
@@ -164,6 +164,16 @@ export const WorldSchema: MessageSchema = {
       id: 13,
       messageType: "lilbattle.v1.IndexInfo",
     },
+    {
+      name: "parentWorldId",
+      type: FieldType.STRING,
+      id: 14,
+    },
+    {
+      name: "parentRevision",
+      type: FieldType.NUMBER,
+      id: 15,
+    },
   ],
 };
 
@@ -637,7 +647,7 @@ export const UnitUnitPropertiesSchema: MessageSchema = {
       messageType: "lilbattle.v1.DamageDistribution",
     },
   ],
-  oneofGroups: ["_attack_override", "_defense_override"],
+  oneofGroups: ["_defense_override", "_attack_override"],
 };
 
 
@@ -815,6 +825,16 @@ export const GameSchema: MessageSchema = {
       id: 15,
       messageType: "lilbattle.v1.IndexInfo",
     },
+    {
+      name: "worldRevision",
+      type: FieldType.NUMBER,
+      id: 16,
+    },
+    {
+      name: "rulesVersion",
+      type: FieldType.STRING,
+      id: 17,
+    },
   ],
 };
 
@@ -1007,6 +1027,31 @@ export const GameSettingsSchema: MessageSchema = {
       type: FieldType.NUMBER,
       id: 4,
     },
+    {
+      name: "turnMode",
+      type: FieldType.STRING,
+      id: 5,
+    },
+    {
+      name: "sharedCoins",
+      type: FieldType.BOOLEAN,
+      id: 6,
+    },
+    {
+      name: "sharedControl",
+      type: FieldType.BOOLEAN,
+      id: 7,
+    },
+    {
+      name: "alliedSupport",
+      type: FieldType.BOOLEAN,
+      id: 8,
+    },
+    {
+      name: "combinedVictory",
+      type: FieldType.BOOLEAN,
+      id: 9,
+    },
   ],
 };
 
@@ -1104,6 +1149,11 @@ export const GameStateSchema: MessageSchema = {
       type: FieldType.STRING,
       id: 15,
     },
+    {
+      name: "pendingOrders",
+      type: FieldType.STRING,
+      id: 16,
+    },
   ],
 };
 
@@ -1962,181 +2012,225 @@ export const PathSchema: MessageSchema = {
 
 
 /**
- * Schema for File message
+ * Schema for PlayerOrders message
  */
-export const FileSchema: MessageSchema = {
-  name: "File",
+export const PlayerOrdersSchema: MessageSchema = {
+  name: "PlayerOrders",
   fields: [
     {
-      name: "path",
-      type: FieldType.STRING,
+      name: "playerId",
+      type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "contentType",
+      name: "commitment",
       type: FieldType.STRING,
       id: 2,
     },
     {
-      name: "fileSize",
-      type: FieldType.NUMBER,
+      name: "committedAt",
+      type: FieldType.MESSAGE,
       id: 3,
+      messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "isPublic",
+      name: "revealed",
       type: FieldType.BOOLEAN,
       id: 4,
     },
     {
-      name: "createdAt",
+      name: "moves",
       type: FieldType.MESSAGE,
       id: 5,
-      messageType: "google.protobuf.Timestamp",
-    },
-    {
-      name: "updatedAt",
-      type: FieldType.MESSAGE,
-      id: 6,
-      messageType: "google.protobuf.Timestamp",
-    },
-    {
-      name: "downloadUrl",
-      type: FieldType.STRING,
-      id: 7,
+      messageType: "lilbattle.v1.GameMove",
+      repeated: true,
     },
     {
-      name: "signedUrls",
+      name: "salt",
       type: FieldType.STRING,
-      id: 8,
+      id: 6,
     },
   ],
 };
 
 
 /**
- * Schema for PutFileRequest message
+ * Schema for TimeRange message
  */
-export const PutFileRequestSchema: MessageSchema = {
-  name: "PutFileRequest",
+export const TimeRangeSchema: MessageSchema = {
+  name: "TimeRange",
   fields: [
     {
-      name: "file",
+      name: "start",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "lilbattle.v1.File",
+      messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "content",
-      type: FieldType.STRING,
+      name: "end",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "google.protobuf.Timestamp",
     },
   ],
 };
 
 
 /**
- * Schema for PutFileResponse message
+ * Schema for UserGame message
  */
-export const PutFileResponseSchema: MessageSchema = {
-  name: "PutFileResponse",
+export const UserGameSchema: MessageSchema = {
+  name: "UserGame",
   fields: [
     {
-      name: "file",
-      type: FieldType.MESSAGE,
+      name: "userId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.File",
     },
-  ],
-};
-
-
-/**
- * Schema for GetFileRequest message
- */
-export const GetFileRequestSchema: MessageSchema = {
-  name: "GetFileRequest",
-  fields: [
     {
-      name: "path",
+      name: "gameId",
       type: FieldType.STRING,
-      id: 1,
+      id: 2,
     },
     {
-      name: "includeSignedUrls",
+      name: "playerIds",
+      type: FieldType.REPEATED,
+      id: 3,
+      repeated: true,
+    },
+    {
+      name: "gameName",
+      type: FieldType.STRING,
+      id: 4,
+    },
+    {
+      name: "worldId",
+      type: FieldType.STRING,
+      id: 5,
+    },
+    {
+      name: "status",
+      type: FieldType.STRING,
+      id: 6,
+    },
+    {
+      name: "currentPlayer",
+      type: FieldType.NUMBER,
+      id: 7,
+    },
+    {
+      name: "turnCounter",
+      type: FieldType.NUMBER,
+      id: 8,
+    },
+    {
+      name: "isMyTurn",
       type: FieldType.BOOLEAN,
-      id: 2,
+      id: 9,
+    },
+    {
+      name: "updatedAt",
+      type: FieldType.MESSAGE,
+      id: 10,
+      messageType: "google.protobuf.Timestamp",
     },
   ],
 };
 
 
 /**
- * Schema for GetFileResponse message
+ * Schema for UserGameList message
  */
-export const GetFileResponseSchema: MessageSchema = {
-  name: "GetFileResponse",
+export const UserGameListSchema: MessageSchema = {
+  name: "UserGameList",
   fields: [
     {
-      name: "file",
+      name: "games",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "lilbattle.v1.File",
+      messageType: "lilbattle.v1.UserGame",
+      repeated: true,
     },
   ],
 };
 
 
 /**
- * Schema for DeleteFileRequest message
+ * Schema for WorldRevision message
  */
-export const DeleteFileRequestSchema: MessageSchema = {
-  name: "DeleteFileRequest",
+export const WorldRevisionSchema: MessageSchema = {
+  name: "WorldRevision",
   fields: [
     {
-      name: "path",
+      name: "worldId",
       type: FieldType.STRING,
       id: 1,
     },
-  ],
-};
-
-
-/**
- * Schema for DeleteFileResponse message
- */
-export const DeleteFileResponseSchema: MessageSchema = {
-  name: "DeleteFileResponse",
-  fields: [
     {
-      name: "file",
+      name: "revision",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "createdAt",
       type: FieldType.MESSAGE,
-      id: 1,
-      messageType: "lilbattle.v1.File",
+      id: 3,
+      messageType: "google.protobuf.Timestamp",
+    },
+    {
+      name: "authorId",
+      type: FieldType.STRING,
+      id: 4,
+    },
+    {
+      name: "revertedFrom",
+      type: FieldType.NUMBER,
+      id: 5,
+    },
+    {
+      name: "contentHash",
+      type: FieldType.STRING,
+      id: 6,
+    },
+    {
+      name: "tileCount",
+      type: FieldType.NUMBER,
+      id: 7,
+    },
+    {
+      name: "unitCount",
+      type: FieldType.NUMBER,
+      id: 8,
+    },
+    {
+      name: "worldData",
+      type: FieldType.MESSAGE,
+      id: 9,
+      messageType: "lilbattle.v1.WorldData",
     },
   ],
 };
 
 
 /**
- * Schema for ListFilesRequest message
+ * Schema for WorldCellChange message
  */
-export const ListFilesRequestSchema: MessageSchema = {
-  name: "ListFilesRequest",
+export const WorldCellChangeSchema: MessageSchema = {
+  name: "WorldCellChange",
   fields: [
     {
-      name: "path",
+      name: "layer",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "pagination",
-      type: FieldType.MESSAGE,
+      name: "key",
+      type: FieldType.STRING,
       id: 2,
-      messageType: "lilbattle.v1.Pagination",
     },
     {
-      name: "includeSignedUrls",
-      type: FieldType.BOOLEAN,
+      name: "kind",
+      type: FieldType.STRING,
       id: 3,
     },
   ],
@@ -2144,133 +2238,138 @@ export const ListFilesRequestSchema: MessageSchema = {
 
 
 /**
- * Schema for ListFilesResponse message
+ * Schema for WorldMergeConflict message
  */
-export const ListFilesResponseSchema: MessageSchema = {
-  name: "ListFilesResponse",
+export const WorldMergeConflictSchema: MessageSchema = {
+  name: "WorldMergeConflict",
   fields: [
     {
-      name: "items",
-      type: FieldType.MESSAGE,
+      name: "layer",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.File",
-      repeated: true,
     },
     {
-      name: "pagination",
-      type: FieldType.MESSAGE,
+      name: "key",
+      type: FieldType.STRING,
       id: 2,
-      messageType: "lilbattle.v1.PaginationResponse",
     },
-  ],
-};
-
-
-/**
- * Schema for ListGamesRequest message
- */
-export const ListGamesRequestSchema: MessageSchema = {
-  name: "ListGamesRequest",
-  fields: [
     {
-      name: "pagination",
-      type: FieldType.MESSAGE,
-      id: 1,
-      messageType: "lilbattle.v1.Pagination",
+      name: "ours",
+      type: FieldType.STRING,
+      id: 3,
     },
     {
-      name: "ownerId",
+      name: "theirs",
       type: FieldType.STRING,
-      id: 2,
+      id: 4,
     },
   ],
 };
 
 
 /**
- * Schema for ListGamesResponse message
+ * Schema for WorldSearchEntry message
  */
-export const ListGamesResponseSchema: MessageSchema = {
-  name: "ListGamesResponse",
+export const WorldSearchEntrySchema: MessageSchema = {
+  name: "WorldSearchEntry",
   fields: [
     {
-      name: "items",
+      name: "world",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "lilbattle.v1.Game",
-      repeated: true,
+      messageType: "lilbattle.v1.World",
     },
     {
-      name: "pagination",
-      type: FieldType.MESSAGE,
+      name: "players",
+      type: FieldType.NUMBER,
       id: 2,
-      messageType: "lilbattle.v1.PaginationResponse",
+    },
+    {
+      name: "tiles",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "games",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "indexedAt",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "google.protobuf.Timestamp",
     },
   ],
 };
 
 
 /**
- * Schema for GetGameRequest message
+ * Schema for File message
  */
-export const GetGameRequestSchema: MessageSchema = {
-  name: "GetGameRequest",
+export const FileSchema: MessageSchema = {
+  name: "File",
   fields: [
     {
-      name: "id",
+      name: "path",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "version",
+      name: "contentType",
       type: FieldType.STRING,
       id: 2,
     },
-  ],
-};
-
-
-/**
- * Schema for GetGameResponse message
- */
-export const GetGameResponseSchema: MessageSchema = {
-  name: "GetGameResponse",
-  fields: [
     {
-      name: "game",
-      type: FieldType.MESSAGE,
-      id: 1,
-      messageType: "lilbattle.v1.Game",
+      name: "fileSize",
+      type: FieldType.NUMBER,
+      id: 3,
     },
     {
-      name: "state",
+      name: "isPublic",
+      type: FieldType.BOOLEAN,
+      id: 4,
+    },
+    {
+      name: "createdAt",
       type: FieldType.MESSAGE,
-      id: 2,
-      messageType: "lilbattle.v1.GameState",
+      id: 5,
+      messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "history",
+      name: "updatedAt",
       type: FieldType.MESSAGE,
-      id: 3,
-      messageType: "lilbattle.v1.GameMoveHistory",
+      id: 6,
+      messageType: "google.protobuf.Timestamp",
+    },
+    {
+      name: "downloadUrl",
+      type: FieldType.STRING,
+      id: 7,
+    },
+    {
+      name: "signedUrls",
+      type: FieldType.STRING,
+      id: 8,
     },
   ],
 };
 
 
 /**
- * Schema for GetGameContentRequest message
+ * Schema for PutFileRequest message
  */
-export const GetGameContentRequestSchema: MessageSchema = {
-  name: "GetGameContentRequest",
+export const PutFileRequestSchema: MessageSchema = {
+  name: "PutFileRequest",
   fields: [
     {
-      name: "id",
-      type: FieldType.STRING,
+      name: "file",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.File",
     },
     {
-      name: "version",
+      name: "content",
       type: FieldType.STRING,
       id: 2,
     },
@@ -2279,93 +2378,65 @@ export const GetGameContentRequestSchema: MessageSchema = {
 
 
 /**
- * Schema for GetGameContentResponse message
+ * Schema for PutFileResponse message
  */
-export const GetGameContentResponseSchema: MessageSchema = {
-  name: "GetGameContentResponse",
+export const PutFileResponseSchema: MessageSchema = {
+  name: "PutFileResponse",
   fields: [
     {
-      name: "lilbattleContent",
-      type: FieldType.STRING,
+      name: "file",
+      type: FieldType.MESSAGE,
       id: 1,
-    },
-    {
-      name: "recipeContent",
-      type: FieldType.STRING,
-      id: 2,
-    },
-    {
-      name: "readmeContent",
-      type: FieldType.STRING,
-      id: 3,
+      messageType: "lilbattle.v1.File",
     },
   ],
 };
 
 
 /**
- * Schema for UpdateGameRequest message
+ * Schema for GetFileRequest message
  */
-export const UpdateGameRequestSchema: MessageSchema = {
-  name: "UpdateGameRequest",
+export const GetFileRequestSchema: MessageSchema = {
+  name: "GetFileRequest",
   fields: [
     {
-      name: "gameId",
+      name: "path",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "newGame",
-      type: FieldType.MESSAGE,
+      name: "includeSignedUrls",
+      type: FieldType.BOOLEAN,
       id: 2,
-      messageType: "lilbattle.v1.Game",
-    },
-    {
-      name: "newState",
-      type: FieldType.MESSAGE,
-      id: 3,
-      messageType: "lilbattle.v1.GameState",
-    },
-    {
-      name: "newHistory",
-      type: FieldType.MESSAGE,
-      id: 4,
-      messageType: "lilbattle.v1.GameMoveHistory",
-    },
-    {
-      name: "updateMask",
-      type: FieldType.MESSAGE,
-      id: 5,
-      messageType: "google.protobuf.FieldMask",
     },
   ],
 };
 
 
 /**
- * Schema for UpdateGameResponse message
+ * Schema for GetFileResponse message
  */
-export const UpdateGameResponseSchema: MessageSchema = {
-  name: "UpdateGameResponse",
+export const GetFileResponseSchema: MessageSchema = {
+  name: "GetFileResponse",
   fields: [
     {
-      name: "game",
+      name: "file",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "lilbattle.v1.Game",
+      messageType: "lilbattle.v1.File",
     },
   ],
 };
 
 
 /**
- * Schema for DeleteGameRequest message
+ * Schema for DeleteFileRequest message
  */
-export const DeleteGameRequestSchema: MessageSchema = {
-  name: "DeleteGameRequest",
+export const DeleteFileRequestSchema: MessageSchema = {
+  name: "DeleteFileRequest",
   fields: [
     {
-      name: "id",
+      name: "path",
       type: FieldType.STRING,
       id: 1,
     },
@@ -2374,463 +2445,402 @@ export const DeleteGameRequestSchema: MessageSchema = {
 
 
 /**
- * Schema for DeleteGameResponse message
- */
-export const DeleteGameResponseSchema: MessageSchema = {
-  name: "DeleteGameResponse",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for GetGamesRequest message
+ * Schema for DeleteFileResponse message
  */
-export const GetGamesRequestSchema: MessageSchema = {
-  name: "GetGamesRequest",
+export const DeleteFileResponseSchema: MessageSchema = {
+  name: "DeleteFileResponse",
   fields: [
     {
-      name: "ids",
-      type: FieldType.REPEATED,
+      name: "file",
+      type: FieldType.MESSAGE,
       id: 1,
-      repeated: true,
+      messageType: "lilbattle.v1.File",
     },
   ],
 };
 
 
 /**
- * Schema for GetGamesResponse message
+ * Schema for ListFilesRequest message
  */
-export const GetGamesResponseSchema: MessageSchema = {
-  name: "GetGamesResponse",
+export const ListFilesRequestSchema: MessageSchema = {
+  name: "ListFilesRequest",
   fields: [
     {
-      name: "games",
+      name: "path",
       type: FieldType.STRING,
       id: 1,
     },
-  ],
-};
-
-
-/**
- * Schema for CreateGameRequest message
- */
-export const CreateGameRequestSchema: MessageSchema = {
-  name: "CreateGameRequest",
-  fields: [
     {
-      name: "game",
+      name: "pagination",
       type: FieldType.MESSAGE,
-      id: 1,
-      messageType: "lilbattle.v1.Game",
+      id: 2,
+      messageType: "lilbattle.v1.Pagination",
+    },
+    {
+      name: "includeSignedUrls",
+      type: FieldType.BOOLEAN,
+      id: 3,
+    },
+    {
+      name: "recursive",
+      type: FieldType.BOOLEAN,
+      id: 4,
     },
   ],
 };
 
 
 /**
- * Schema for CreateGameResponse message
+ * Schema for ListFilesResponse message
  */
-export const CreateGameResponseSchema: MessageSchema = {
-  name: "CreateGameResponse",
+export const ListFilesResponseSchema: MessageSchema = {
+  name: "ListFilesResponse",
   fields: [
     {
-      name: "game",
+      name: "items",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "lilbattle.v1.Game",
+      messageType: "lilbattle.v1.File",
+      repeated: true,
     },
     {
-      name: "gameState",
+      name: "pagination",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "lilbattle.v1.GameState",
-    },
-    {
-      name: "fieldErrors",
-      type: FieldType.STRING,
-      id: 3,
+      messageType: "lilbattle.v1.PaginationResponse",
     },
   ],
 };
 
 
 /**
- * Schema for ProcessMovesRequest message
+ * Schema for ListGamesRequest message
  */
-export const ProcessMovesRequestSchema: MessageSchema = {
-  name: "ProcessMovesRequest",
+export const ListGamesRequestSchema: MessageSchema = {
+  name: "ListGamesRequest",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
+      name: "pagination",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.Pagination",
     },
     {
-      name: "moves",
-      type: FieldType.MESSAGE,
+      name: "ownerId",
+      type: FieldType.STRING,
       id: 2,
-      messageType: "lilbattle.v1.GameMove",
-      repeated: true,
     },
     {
-      name: "expectedResponse",
-      type: FieldType.MESSAGE,
+      name: "playerUserId",
+      type: FieldType.STRING,
       id: 3,
-      messageType: "lilbattle.v1.ProcessMovesResponse",
     },
     {
-      name: "dryRun",
-      type: FieldType.BOOLEAN,
+      name: "status",
+      type: FieldType.STRING,
       id: 4,
     },
-  ],
-};
-
-
-/**
- * Schema for ProcessMovesResponse message
- */
-export const ProcessMovesResponseSchema: MessageSchema = {
-  name: "ProcessMovesResponse",
-  fields: [
     {
-      name: "moves",
+      name: "worldId",
+      type: FieldType.STRING,
+      id: 5,
+    },
+    {
+      name: "tag",
+      type: FieldType.STRING,
+      id: 6,
+    },
+    {
+      name: "created",
       type: FieldType.MESSAGE,
-      id: 3,
-      messageType: "lilbattle.v1.GameMove",
-      repeated: true,
+      id: 7,
+      messageType: "lilbattle.v1.TimeRange",
     },
-  ],
-};
-
-
-/**
- * Schema for GetGameStateRequest message
- */
-export const GetGameStateRequestSchema: MessageSchema = {
-  name: "GetGameStateRequest",
-  fields: [
     {
-      name: "gameId",
+      name: "updated",
+      type: FieldType.MESSAGE,
+      id: 8,
+      messageType: "lilbattle.v1.TimeRange",
+    },
+    {
+      name: "sortBy",
       type: FieldType.STRING,
-      id: 1,
+      id: 9,
+    },
+    {
+      name: "sortOrder",
+      type: FieldType.STRING,
+      id: 10,
     },
   ],
 };
 
 
 /**
- * Schema for GetGameStateResponse message
+ * Schema for ListGamesResponse message
  */
-export const GetGameStateResponseSchema: MessageSchema = {
-  name: "GetGameStateResponse",
+export const ListGamesResponseSchema: MessageSchema = {
+  name: "ListGamesResponse",
   fields: [
     {
-      name: "state",
+      name: "items",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "lilbattle.v1.GameState",
+      messageType: "lilbattle.v1.Game",
+      repeated: true,
+    },
+    {
+      name: "pagination",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "lilbattle.v1.PaginationResponse",
     },
   ],
 };
 
 
 /**
- * Schema for ListMovesRequest message
+ * Schema for GetGameRequest message
  */
-export const ListMovesRequestSchema: MessageSchema = {
-  name: "ListMovesRequest",
+export const GetGameRequestSchema: MessageSchema = {
+  name: "GetGameRequest",
   fields: [
     {
-      name: "gameId",
+      name: "id",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "fromGroup",
-      type: FieldType.NUMBER,
+      name: "version",
+      type: FieldType.STRING,
       id: 2,
     },
-    {
-      name: "toGroup",
-      type: FieldType.NUMBER,
-      id: 3,
-    },
   ],
 };
 
 
 /**
- * Schema for ListMovesResponse message
+ * Schema for GetGameResponse message
  */
-export const ListMovesResponseSchema: MessageSchema = {
-  name: "ListMovesResponse",
+export const GetGameResponseSchema: MessageSchema = {
+  name: "GetGameResponse",
   fields: [
     {
-      name: "hasMore",
-      type: FieldType.BOOLEAN,
+      name: "game",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.Game",
     },
     {
-      name: "moveGroups",
+      name: "state",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "lilbattle.v1.GameMoveGroup",
-      repeated: true,
+      messageType: "lilbattle.v1.GameState",
+    },
+    {
+      name: "history",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "lilbattle.v1.GameMoveHistory",
     },
   ],
 };
 
 
 /**
- * Schema for GetOptionsAtRequest message
+ * Schema for GetGameContentRequest message
  */
-export const GetOptionsAtRequestSchema: MessageSchema = {
-  name: "GetOptionsAtRequest",
+export const GetGameContentRequestSchema: MessageSchema = {
+  name: "GetGameContentRequest",
   fields: [
     {
-      name: "gameId",
+      name: "id",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "pos",
-      type: FieldType.MESSAGE,
+      name: "version",
+      type: FieldType.STRING,
       id: 2,
-      messageType: "lilbattle.v1.Position",
     },
   ],
 };
 
 
 /**
- * Schema for GetOptionsAtResponse message
+ * Schema for GetGameContentResponse message
  */
-export const GetOptionsAtResponseSchema: MessageSchema = {
-  name: "GetOptionsAtResponse",
+export const GetGameContentResponseSchema: MessageSchema = {
+  name: "GetGameContentResponse",
   fields: [
     {
-      name: "options",
-      type: FieldType.MESSAGE,
+      name: "lilbattleContent",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.GameOption",
-      repeated: true,
     },
     {
-      name: "currentPlayer",
-      type: FieldType.NUMBER,
+      name: "recipeContent",
+      type: FieldType.STRING,
       id: 2,
     },
     {
-      name: "gameInitialized",
-      type: FieldType.BOOLEAN,
+      name: "readmeContent",
+      type: FieldType.STRING,
       id: 3,
     },
-    {
-      name: "allPaths",
-      type: FieldType.MESSAGE,
-      id: 5,
-      messageType: "lilbattle.v1.AllPaths",
-    },
   ],
 };
 
 
 /**
- * Schema for GameOption message
+ * Schema for UpdateGameRequest message
  */
-export const GameOptionSchema: MessageSchema = {
-  name: "GameOption",
+export const UpdateGameRequestSchema: MessageSchema = {
+  name: "UpdateGameRequest",
   fields: [
     {
-      name: "move",
-      type: FieldType.MESSAGE,
+      name: "gameId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.MoveUnitAction",
-      oneofGroup: "option_type",
     },
     {
-      name: "attack",
+      name: "newGame",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "lilbattle.v1.AttackUnitAction",
-      oneofGroup: "option_type",
+      messageType: "lilbattle.v1.Game",
     },
     {
-      name: "build",
+      name: "newState",
       type: FieldType.MESSAGE,
       id: 3,
-      messageType: "lilbattle.v1.BuildUnitAction",
-      oneofGroup: "option_type",
+      messageType: "lilbattle.v1.GameState",
     },
     {
-      name: "capture",
+      name: "newHistory",
       type: FieldType.MESSAGE,
       id: 4,
-      messageType: "lilbattle.v1.CaptureBuildingAction",
-      oneofGroup: "option_type",
+      messageType: "lilbattle.v1.GameMoveHistory",
     },
     {
-      name: "endTurn",
+      name: "updateMask",
       type: FieldType.MESSAGE,
       id: 5,
-      messageType: "lilbattle.v1.EndTurnAction",
-      oneofGroup: "option_type",
+      messageType: "google.protobuf.FieldMask",
     },
+  ],
+};
+
+
+/**
+ * Schema for UpdateGameResponse message
+ */
+export const UpdateGameResponseSchema: MessageSchema = {
+  name: "UpdateGameResponse",
+  fields: [
     {
-      name: "heal",
+      name: "game",
       type: FieldType.MESSAGE,
-      id: 6,
-      messageType: "lilbattle.v1.HealUnitAction",
-      oneofGroup: "option_type",
+      id: 1,
+      messageType: "lilbattle.v1.Game",
     },
   ],
-  oneofGroups: ["option_type"],
 };
 
 
 /**
- * Schema for SimulateAttackRequest message
+ * Schema for DeleteGameRequest message
  */
-export const SimulateAttackRequestSchema: MessageSchema = {
-  name: "SimulateAttackRequest",
+export const DeleteGameRequestSchema: MessageSchema = {
+  name: "DeleteGameRequest",
   fields: [
     {
-      name: "attackerUnitType",
-      type: FieldType.NUMBER,
+      name: "id",
+      type: FieldType.STRING,
       id: 1,
     },
-    {
-      name: "attackerTerrain",
-      type: FieldType.NUMBER,
-      id: 2,
-    },
-    {
-      name: "attackerHealth",
-      type: FieldType.NUMBER,
-      id: 3,
-    },
-    {
-      name: "defenderUnitType",
-      type: FieldType.NUMBER,
-      id: 4,
-    },
-    {
-      name: "defenderTerrain",
-      type: FieldType.NUMBER,
-      id: 5,
-    },
-    {
-      name: "defenderHealth",
-      type: FieldType.NUMBER,
-      id: 6,
-    },
-    {
-      name: "woundBonus",
-      type: FieldType.NUMBER,
-      id: 7,
-    },
-    {
-      name: "numSimulations",
-      type: FieldType.NUMBER,
-      id: 8,
-    },
   ],
 };
 
 
 /**
- * Schema for SimulateAttackResponse message
+ * Schema for DeleteGameResponse message
  */
-export const SimulateAttackResponseSchema: MessageSchema = {
-  name: "SimulateAttackResponse",
+export const DeleteGameResponseSchema: MessageSchema = {
+  name: "DeleteGameResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for GetGamesRequest message
+ */
+export const GetGamesRequestSchema: MessageSchema = {
+  name: "GetGamesRequest",
   fields: [
     {
-      name: "attackerDamageDistribution",
-      type: FieldType.STRING,
+      name: "ids",
+      type: FieldType.REPEATED,
       id: 1,
-    },
-    {
-      name: "defenderDamageDistribution",
-      type: FieldType.STRING,
-      id: 2,
-    },
-    {
-      name: "attackerMeanDamage",
-      type: FieldType.NUMBER,
-      id: 3,
-    },
-    {
-      name: "defenderMeanDamage",
-      type: FieldType.NUMBER,
-      id: 4,
-    },
-    {
-      name: "attackerKillProbability",
-      type: FieldType.NUMBER,
-      id: 5,
-    },
-    {
-      name: "defenderKillProbability",
-      type: FieldType.NUMBER,
-      id: 6,
+      repeated: true,
     },
   ],
 };
 
 
 /**
- * Schema for SimulateFixRequest message
+ * Schema for GetGamesResponse message
  */
-export const SimulateFixRequestSchema: MessageSchema = {
-  name: "SimulateFixRequest",
+export const GetGamesResponseSchema: MessageSchema = {
+  name: "GetGamesResponse",
   fields: [
     {
-      name: "fixingUnitType",
-      type: FieldType.NUMBER,
+      name: "games",
+      type: FieldType.STRING,
       id: 1,
     },
+  ],
+};
+
+
+/**
+ * Schema for CreateGameRequest message
+ */
+export const CreateGameRequestSchema: MessageSchema = {
+  name: "CreateGameRequest",
+  fields: [
     {
-      name: "fixingUnitHealth",
-      type: FieldType.NUMBER,
-      id: 2,
-    },
-    {
-      name: "injuredUnitType",
-      type: FieldType.NUMBER,
-      id: 3,
-    },
-    {
-      name: "numSimulations",
-      type: FieldType.NUMBER,
-      id: 4,
+      name: "game",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.Game",
     },
   ],
 };
 
 
 /**
- * Schema for SimulateFixResponse message
+ * Schema for CreateGameResponse message
  */
-export const SimulateFixResponseSchema: MessageSchema = {
-  name: "SimulateFixResponse",
+export const CreateGameResponseSchema: MessageSchema = {
+  name: "CreateGameResponse",
   fields: [
     {
-      name: "healingDistribution",
-      type: FieldType.STRING,
+      name: "game",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.Game",
     },
     {
-      name: "meanHealing",
-      type: FieldType.NUMBER,
+      name: "gameState",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.GameState",
     },
     {
-      name: "fixValue",
-      type: FieldType.NUMBER,
+      name: "fieldErrors",
+      type: FieldType.STRING,
       id: 3,
     },
   ],
@@ -2838,10 +2848,10 @@ export const SimulateFixResponseSchema: MessageSchema = {
 
 
 /**
- * Schema for JoinGameRequest message
+ * Schema for ProcessMovesRequest message
  */
-export const JoinGameRequestSchema: MessageSchema = {
-  name: "JoinGameRequest",
+export const ProcessMovesRequestSchema: MessageSchema = {
+  name: "ProcessMovesRequest",
   fields: [
     {
       name: "gameId",
@@ -2849,63 +2859,52 @@ export const JoinGameRequestSchema: MessageSchema = {
       id: 1,
     },
     {
-      name: "playerId",
-      type: FieldType.NUMBER,
+      name: "moves",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.GameMove",
+      repeated: true,
     },
-  ],
-};
-
-
-/**
- * Schema for JoinGameResponse message
- */
-export const JoinGameResponseSchema: MessageSchema = {
-  name: "JoinGameResponse",
-  fields: [
     {
-      name: "game",
+      name: "expectedResponse",
       type: FieldType.MESSAGE,
-      id: 1,
-      messageType: "lilbattle.v1.Game",
+      id: 3,
+      messageType: "lilbattle.v1.ProcessMovesResponse",
     },
     {
-      name: "playerId",
-      type: FieldType.NUMBER,
-      id: 2,
+      name: "dryRun",
+      type: FieldType.BOOLEAN,
+      id: 4,
     },
   ],
 };
 
 
 /**
- * Schema for EmptyRequest message
- */
-export const EmptyRequestSchema: MessageSchema = {
-  name: "EmptyRequest",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for EmptyResponse message
+ * Schema for ProcessMovesResponse message
  */
-export const EmptyResponseSchema: MessageSchema = {
-  name: "EmptyResponse",
+export const ProcessMovesResponseSchema: MessageSchema = {
+  name: "ProcessMovesResponse",
   fields: [
+    {
+      name: "moves",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "lilbattle.v1.GameMove",
+      repeated: true,
+    },
   ],
 };
 
 
 /**
- * Schema for SetContentRequest message
+ * Schema for GetGameStateRequest message
  */
-export const SetContentRequestSchema: MessageSchema = {
-  name: "SetContentRequest",
+export const GetGameStateRequestSchema: MessageSchema = {
+  name: "GetGameStateRequest",
   fields: [
     {
-      name: "innerHtml",
+      name: "gameId",
       type: FieldType.STRING,
       id: 1,
     },
@@ -2914,293 +2913,314 @@ export const SetContentRequestSchema: MessageSchema = {
 
 
 /**
- * Schema for SetContentResponse message
+ * Schema for GetGameStateResponse message
  */
-export const SetContentResponseSchema: MessageSchema = {
-  name: "SetContentResponse",
+export const GetGameStateResponseSchema: MessageSchema = {
+  name: "GetGameStateResponse",
   fields: [
+    {
+      name: "state",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.GameState",
+    },
   ],
 };
 
 
 /**
- * Schema for ShowBuildOptionsRequest message
+ * Schema for ListMovesRequest message
  */
-export const ShowBuildOptionsRequestSchema: MessageSchema = {
-  name: "ShowBuildOptionsRequest",
+export const ListMovesRequestSchema: MessageSchema = {
+  name: "ListMovesRequest",
   fields: [
     {
-      name: "innerHtml",
+      name: "gameId",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "hide",
-      type: FieldType.BOOLEAN,
+      name: "fromGroup",
+      type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "q",
+      name: "toGroup",
       type: FieldType.NUMBER,
       id: 3,
     },
-    {
-      name: "r",
-      type: FieldType.NUMBER,
-      id: 4,
-    },
-  ],
-};
-
-
-/**
- * Schema for ShowBuildOptionsResponse message
- */
-export const ShowBuildOptionsResponseSchema: MessageSchema = {
-  name: "ShowBuildOptionsResponse",
-  fields: [
   ],
 };
 
 
 /**
- * Schema for LogMessageRequest message
+ * Schema for ListMovesResponse message
  */
-export const LogMessageRequestSchema: MessageSchema = {
-  name: "LogMessageRequest",
+export const ListMovesResponseSchema: MessageSchema = {
+  name: "ListMovesResponse",
   fields: [
     {
-      name: "message",
-      type: FieldType.STRING,
+      name: "hasMore",
+      type: FieldType.BOOLEAN,
       id: 1,
     },
+    {
+      name: "moveGroups",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "lilbattle.v1.GameMoveGroup",
+      repeated: true,
+    },
   ],
 };
 
 
 /**
- * Schema for LogMessageResponse message
- */
-export const LogMessageResponseSchema: MessageSchema = {
-  name: "LogMessageResponse",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for SetGameStateRequest message
+ * Schema for GetOptionsAtRequest message
  */
-export const SetGameStateRequestSchema: MessageSchema = {
-  name: "SetGameStateRequest",
+export const GetOptionsAtRequestSchema: MessageSchema = {
+  name: "GetOptionsAtRequest",
   fields: [
     {
-      name: "game",
-      type: FieldType.MESSAGE,
+      name: "gameId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.Game",
     },
     {
-      name: "state",
+      name: "pos",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "lilbattle.v1.GameState",
+      messageType: "lilbattle.v1.Position",
     },
   ],
 };
 
 
 /**
- * Schema for SetGameStateResponse message
- */
-export const SetGameStateResponseSchema: MessageSchema = {
-  name: "SetGameStateResponse",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for UpdateGameStatusRequest message
+ * Schema for GetOptionsAtResponse message
  */
-export const UpdateGameStatusRequestSchema: MessageSchema = {
-  name: "UpdateGameStatusRequest",
+export const GetOptionsAtResponseSchema: MessageSchema = {
+  name: "GetOptionsAtResponse",
   fields: [
     {
-      name: "currentPlayer",
-      type: FieldType.NUMBER,
+      name: "options",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.GameOption",
+      repeated: true,
     },
     {
-      name: "turnCounter",
+      name: "currentPlayer",
       type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "finished",
+      name: "gameInitialized",
       type: FieldType.BOOLEAN,
       id: 3,
     },
     {
-      name: "winningPlayer",
-      type: FieldType.NUMBER,
-      id: 4,
-    },
-    {
-      name: "status",
-      type: FieldType.STRING,
+      name: "allPaths",
+      type: FieldType.MESSAGE,
       id: 5,
+      messageType: "lilbattle.v1.AllPaths",
     },
   ],
 };
 
 
 /**
- * Schema for UpdateGameStatusResponse message
- */
-export const UpdateGameStatusResponseSchema: MessageSchema = {
-  name: "UpdateGameStatusResponse",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for SetTileAtRequest message
+ * Schema for GameOption message
  */
-export const SetTileAtRequestSchema: MessageSchema = {
-  name: "SetTileAtRequest",
+export const GameOptionSchema: MessageSchema = {
+  name: "GameOption",
   fields: [
     {
-      name: "q",
-      type: FieldType.NUMBER,
+      name: "move",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.MoveUnitAction",
+      oneofGroup: "option_type",
     },
     {
-      name: "r",
-      type: FieldType.NUMBER,
+      name: "attack",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.AttackUnitAction",
+      oneofGroup: "option_type",
     },
     {
-      name: "tile",
+      name: "build",
       type: FieldType.MESSAGE,
       id: 3,
-      messageType: "lilbattle.v1.Tile",
+      messageType: "lilbattle.v1.BuildUnitAction",
+      oneofGroup: "option_type",
+    },
+    {
+      name: "capture",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "lilbattle.v1.CaptureBuildingAction",
+      oneofGroup: "option_type",
+    },
+    {
+      name: "endTurn",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "lilbattle.v1.EndTurnAction",
+      oneofGroup: "option_type",
+    },
+    {
+      name: "heal",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "lilbattle.v1.HealUnitAction",
+      oneofGroup: "option_type",
     },
   ],
+  oneofGroups: ["option_type"],
 };
 
 
 /**
- * Schema for SetTileAtResponse message
- */
-export const SetTileAtResponseSchema: MessageSchema = {
-  name: "SetTileAtResponse",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for SetUnitAtRequest message
+ * Schema for SimulateAttackRequest message
  */
-export const SetUnitAtRequestSchema: MessageSchema = {
-  name: "SetUnitAtRequest",
+export const SimulateAttackRequestSchema: MessageSchema = {
+  name: "SimulateAttackRequest",
   fields: [
     {
-      name: "q",
+      name: "attackerUnitType",
       type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "r",
+      name: "attackerTerrain",
       type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "unit",
-      type: FieldType.MESSAGE,
+      name: "attackerHealth",
+      type: FieldType.NUMBER,
       id: 3,
-      messageType: "lilbattle.v1.Unit",
     },
     {
-      name: "flash",
-      type: FieldType.BOOLEAN,
+      name: "defenderUnitType",
+      type: FieldType.NUMBER,
       id: 4,
     },
     {
-      name: "appear",
-      type: FieldType.BOOLEAN,
+      name: "defenderTerrain",
+      type: FieldType.NUMBER,
       id: 5,
     },
+    {
+      name: "defenderHealth",
+      type: FieldType.NUMBER,
+      id: 6,
+    },
+    {
+      name: "woundBonus",
+      type: FieldType.NUMBER,
+      id: 7,
+    },
+    {
+      name: "numSimulations",
+      type: FieldType.NUMBER,
+      id: 8,
+    },
   ],
 };
 
 
 /**
- * Schema for SetUnitAtResponse message
+ * Schema for SimulateAttackResponse message
  */
-export const SetUnitAtResponseSchema: MessageSchema = {
-  name: "SetUnitAtResponse",
+export const SimulateAttackResponseSchema: MessageSchema = {
+  name: "SimulateAttackResponse",
   fields: [
+    {
+      name: "attackerDamageDistribution",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "defenderDamageDistribution",
+      type: FieldType.STRING,
+      id: 2,
+    },
+    {
+      name: "attackerMeanDamage",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "defenderMeanDamage",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "attackerKillProbability",
+      type: FieldType.NUMBER,
+      id: 5,
+    },
+    {
+      name: "defenderKillProbability",
+      type: FieldType.NUMBER,
+      id: 6,
+    },
   ],
 };
 
 
 /**
- * Schema for RemoveTileAtRequest message
+ * Schema for SimulateFixRequest message
  */
-export const RemoveTileAtRequestSchema: MessageSchema = {
-  name: "RemoveTileAtRequest",
+export const SimulateFixRequestSchema: MessageSchema = {
+  name: "SimulateFixRequest",
   fields: [
     {
-      name: "q",
+      name: "fixingUnitType",
       type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "r",
+      name: "fixingUnitHealth",
       type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "animate",
-      type: FieldType.BOOLEAN,
+      name: "injuredUnitType",
+      type: FieldType.NUMBER,
       id: 3,
     },
+    {
+      name: "numSimulations",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
   ],
 };
 
 
 /**
- * Schema for RemoveTileAtResponse message
- */
-export const RemoveTileAtResponseSchema: MessageSchema = {
-  name: "RemoveTileAtResponse",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for RemoveUnitAtRequest message
+ * Schema for SimulateFixResponse message
  */
-export const RemoveUnitAtRequestSchema: MessageSchema = {
-  name: "RemoveUnitAtRequest",
+export const SimulateFixResponseSchema: MessageSchema = {
+  name: "SimulateFixResponse",
   fields: [
     {
-      name: "q",
-      type: FieldType.NUMBER,
+      name: "healingDistribution",
+      type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "r",
+      name: "meanHealing",
       type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "animate",
-      type: FieldType.BOOLEAN,
+      name: "fixValue",
+      type: FieldType.NUMBER,
       id: 3,
     },
   ],
@@ -3208,111 +3228,151 @@ export const RemoveUnitAtRequestSchema: MessageSchema = {
 
 
 /**
- * Schema for RemoveUnitAtResponse message
+ * Schema for JoinGameRequest message
  */
-export const RemoveUnitAtResponseSchema: MessageSchema = {
-  name: "RemoveUnitAtResponse",
+export const JoinGameRequestSchema: MessageSchema = {
+  name: "JoinGameRequest",
   fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "playerId",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
   ],
 };
 
 
 /**
- * Schema for ShowHighlightsRequest message
+ * Schema for JoinGameResponse message
  */
-export const ShowHighlightsRequestSchema: MessageSchema = {
-  name: "ShowHighlightsRequest",
+export const JoinGameResponseSchema: MessageSchema = {
+  name: "JoinGameResponse",
   fields: [
     {
-      name: "highlights",
+      name: "game",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "lilbattle.v1.HighlightSpec",
-      repeated: true,
+      messageType: "lilbattle.v1.Game",
+    },
+    {
+      name: "playerId",
+      type: FieldType.NUMBER,
+      id: 2,
     },
   ],
 };
 
 
 /**
- * Schema for ShowHighlightsResponse message
+ * Schema for CommitOrdersRequest message
  */
-export const ShowHighlightsResponseSchema: MessageSchema = {
-  name: "ShowHighlightsResponse",
+export const CommitOrdersRequestSchema: MessageSchema = {
+  name: "CommitOrdersRequest",
   fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "playerId",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "commitment",
+      type: FieldType.STRING,
+      id: 3,
+    },
   ],
 };
 
 
 /**
- * Schema for HighlightSpec message
+ * Schema for CommitOrdersResponse message
  */
-export const HighlightSpecSchema: MessageSchema = {
-  name: "HighlightSpec",
+export const CommitOrdersResponseSchema: MessageSchema = {
+  name: "CommitOrdersResponse",
   fields: [
     {
-      name: "q",
+      name: "playerId",
       type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "r",
-      type: FieldType.NUMBER,
+      name: "waitingOn",
+      type: FieldType.REPEATED,
       id: 2,
+      repeated: true,
     },
+  ],
+};
+
+
+/**
+ * Schema for RevealOrdersRequest message
+ */
+export const RevealOrdersRequestSchema: MessageSchema = {
+  name: "RevealOrdersRequest",
+  fields: [
     {
-      name: "type",
-      type: FieldType.STRING,
-      id: 3,
-    },
-    {
-      name: "move",
-      type: FieldType.MESSAGE,
-      id: 4,
-      messageType: "lilbattle.v1.MoveUnitAction",
-      oneofGroup: "action",
-    },
-    {
-      name: "attack",
-      type: FieldType.MESSAGE,
-      id: 5,
-      messageType: "lilbattle.v1.AttackUnitAction",
-      oneofGroup: "action",
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
     },
     {
-      name: "build",
-      type: FieldType.MESSAGE,
-      id: 6,
-      messageType: "lilbattle.v1.BuildUnitAction",
-      oneofGroup: "action",
+      name: "playerId",
+      type: FieldType.NUMBER,
+      id: 2,
     },
     {
-      name: "capture",
+      name: "moves",
       type: FieldType.MESSAGE,
-      id: 7,
-      messageType: "lilbattle.v1.CaptureBuildingAction",
-      oneofGroup: "action",
+      id: 3,
+      messageType: "lilbattle.v1.GameMove",
+      repeated: true,
     },
     {
-      name: "player",
-      type: FieldType.NUMBER,
-      id: 8,
+      name: "salt",
+      type: FieldType.STRING,
+      id: 4,
     },
   ],
-  oneofGroups: ["action"],
 };
 
 
 /**
- * Schema for ClearHighlightsRequest message
+ * Schema for RevealOrdersResponse message
  */
-export const ClearHighlightsRequestSchema: MessageSchema = {
-  name: "ClearHighlightsRequest",
+export const RevealOrdersResponseSchema: MessageSchema = {
+  name: "RevealOrdersResponse",
   fields: [
     {
-      name: "types",
-      type: FieldType.REPEATED,
+      name: "playerId",
+      type: FieldType.NUMBER,
       id: 1,
+    },
+    {
+      name: "waitingOn",
+      type: FieldType.REPEATED,
+      id: 2,
+      repeated: true,
+    },
+    {
+      name: "resolved",
+      type: FieldType.BOOLEAN,
+      id: 3,
+    },
+    {
+      name: "moves",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "lilbattle.v1.GameMove",
       repeated: true,
     },
   ],
@@ -3320,35 +3380,25 @@ export const ClearHighlightsRequestSchema: MessageSchema = {
 
 
 /**
- * Schema for ClearHighlightsResponse message
- */
-export const ClearHighlightsResponseSchema: MessageSchema = {
-  name: "ClearHighlightsResponse",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for ShowPathRequest message
+ * Schema for ListMyGamesRequest message
  */
-export const ShowPathRequestSchema: MessageSchema = {
-  name: "ShowPathRequest",
+export const ListMyGamesRequestSchema: MessageSchema = {
+  name: "ListMyGamesRequest",
   fields: [
     {
-      name: "coords",
-      type: FieldType.REPEATED,
+      name: "pagination",
+      type: FieldType.MESSAGE,
       id: 1,
-      repeated: true,
+      messageType: "lilbattle.v1.Pagination",
     },
     {
-      name: "color",
-      type: FieldType.NUMBER,
+      name: "onlyMyTurn",
+      type: FieldType.BOOLEAN,
       id: 2,
     },
     {
-      name: "thickness",
-      type: FieldType.NUMBER,
+      name: "status",
+      type: FieldType.STRING,
       id: 3,
     },
   ],
@@ -3356,82 +3406,77 @@ export const ShowPathRequestSchema: MessageSchema = {
 
 
 /**
- * Schema for ShowPathResponse message
- */
-export const ShowPathResponseSchema: MessageSchema = {
-  name: "ShowPathResponse",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for ClearPathsRequest message
+ * Schema for ListMyGamesResponse message
  */
-export const ClearPathsRequestSchema: MessageSchema = {
-  name: "ClearPathsRequest",
+export const ListMyGamesResponseSchema: MessageSchema = {
+  name: "ListMyGamesResponse",
   fields: [
+    {
+      name: "items",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.UserGame",
+      repeated: true,
+    },
+    {
+      name: "pagination",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "lilbattle.v1.PaginationResponse",
+    },
   ],
 };
 
 
 /**
- * Schema for ClearPathsResponse message
+ * Schema for ExportGameRequest message
  */
-export const ClearPathsResponseSchema: MessageSchema = {
-  name: "ClearPathsResponse",
+export const ExportGameRequestSchema: MessageSchema = {
+  name: "ExportGameRequest",
   fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
   ],
 };
 
 
 /**
- * Schema for MoveUnitRequest message
+ * Schema for ExportGameResponse message
  */
-export const MoveUnitRequestSchema: MessageSchema = {
-  name: "MoveUnitRequest",
+export const ExportGameResponseSchema: MessageSchema = {
+  name: "ExportGameResponse",
   fields: [
     {
-      name: "unit",
-      type: FieldType.MESSAGE,
+      name: "archive",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.Unit",
     },
     {
-      name: "path",
-      type: FieldType.MESSAGE,
+      name: "contentHash",
+      type: FieldType.STRING,
       id: 2,
-      messageType: "lilbattle.v1.HexCoord",
-      repeated: true,
     },
   ],
 };
 
 
 /**
- * Schema for MoveUnitResponse message
- */
-export const MoveUnitResponseSchema: MessageSchema = {
-  name: "MoveUnitResponse",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for HexCoord message
+ * Schema for ImportGameRequest message
  */
-export const HexCoordSchema: MessageSchema = {
-  name: "HexCoord",
+export const ImportGameRequestSchema: MessageSchema = {
+  name: "ImportGameRequest",
   fields: [
     {
-      name: "q",
-      type: FieldType.NUMBER,
+      name: "archive",
+      type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "r",
-      type: FieldType.NUMBER,
+      name: "gameId",
+      type: FieldType.STRING,
       id: 2,
     },
   ],
@@ -3439,178 +3484,1222 @@ export const HexCoordSchema: MessageSchema = {
 
 
 /**
- * Schema for ShowAttackEffectRequest message
+ * Schema for ImportGameResponse message
  */
-export const ShowAttackEffectRequestSchema: MessageSchema = {
-  name: "ShowAttackEffectRequest",
+export const ImportGameResponseSchema: MessageSchema = {
+  name: "ImportGameResponse",
   fields: [
     {
-      name: "fromQ",
-      type: FieldType.NUMBER,
+      name: "game",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.Game",
     },
     {
-      name: "fromR",
-      type: FieldType.NUMBER,
+      name: "contentHash",
+      type: FieldType.STRING,
       id: 2,
     },
     {
-      name: "toQ",
+      name: "replayedGroups",
       type: FieldType.NUMBER,
       id: 3,
     },
     {
-      name: "toR",
-      type: FieldType.NUMBER,
+      name: "rulesMatch",
+      type: FieldType.BOOLEAN,
       id: 4,
     },
     {
-      name: "damage",
-      type: FieldType.NUMBER,
+      name: "fieldErrors",
+      type: FieldType.STRING,
       id: 5,
     },
-    {
-      name: "splashTargets",
-      type: FieldType.MESSAGE,
-      id: 6,
-      messageType: "lilbattle.v1.SplashTarget",
-      repeated: true,
-    },
   ],
 };
 
 
 /**
- * Schema for SplashTarget message
+ * Schema for EmptyRequest message
  */
-export const SplashTargetSchema: MessageSchema = {
-  name: "SplashTarget",
+export const EmptyRequestSchema: MessageSchema = {
+  name: "EmptyRequest",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for EmptyResponse message
+ */
+export const EmptyResponseSchema: MessageSchema = {
+  name: "EmptyResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for SetContentRequest message
+ */
+export const SetContentRequestSchema: MessageSchema = {
+  name: "SetContentRequest",
   fields: [
     {
-      name: "q",
-      type: FieldType.NUMBER,
+      name: "innerHtml",
+      type: FieldType.STRING,
       id: 1,
     },
-    {
-      name: "r",
-      type: FieldType.NUMBER,
-      id: 2,
-    },
-    {
-      name: "damage",
-      type: FieldType.NUMBER,
-      id: 3,
-    },
   ],
 };
 
 
 /**
- * Schema for ShowAttackEffectResponse message
+ * Schema for SetContentResponse message
  */
-export const ShowAttackEffectResponseSchema: MessageSchema = {
-  name: "ShowAttackEffectResponse",
+export const SetContentResponseSchema: MessageSchema = {
+  name: "SetContentResponse",
   fields: [
   ],
 };
 
 
 /**
- * Schema for ShowHealEffectRequest message
+ * Schema for ShowBuildOptionsRequest message
  */
-export const ShowHealEffectRequestSchema: MessageSchema = {
-  name: "ShowHealEffectRequest",
+export const ShowBuildOptionsRequestSchema: MessageSchema = {
+  name: "ShowBuildOptionsRequest",
   fields: [
+    {
+      name: "innerHtml",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "hide",
+      type: FieldType.BOOLEAN,
+      id: 2,
+    },
     {
       name: "q",
       type: FieldType.NUMBER,
-      id: 1,
+      id: 3,
     },
     {
       name: "r",
       type: FieldType.NUMBER,
-      id: 2,
+      id: 4,
     },
+  ],
+};
+
+
+/**
+ * Schema for ShowBuildOptionsResponse message
+ */
+export const ShowBuildOptionsResponseSchema: MessageSchema = {
+  name: "ShowBuildOptionsResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for LogMessageRequest message
+ */
+export const LogMessageRequestSchema: MessageSchema = {
+  name: "LogMessageRequest",
+  fields: [
+    {
+      name: "message",
+      type: FieldType.STRING,
+      id: 1,
+    },
+  ],
+};
+
+
+/**
+ * Schema for LogMessageResponse message
+ */
+export const LogMessageResponseSchema: MessageSchema = {
+  name: "LogMessageResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for SetGameStateRequest message
+ */
+export const SetGameStateRequestSchema: MessageSchema = {
+  name: "SetGameStateRequest",
+  fields: [
+    {
+      name: "game",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.Game",
+    },
+    {
+      name: "state",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "lilbattle.v1.GameState",
+    },
+  ],
+};
+
+
+/**
+ * Schema for SetGameStateResponse message
+ */
+export const SetGameStateResponseSchema: MessageSchema = {
+  name: "SetGameStateResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for UpdateGameStatusRequest message
+ */
+export const UpdateGameStatusRequestSchema: MessageSchema = {
+  name: "UpdateGameStatusRequest",
+  fields: [
+    {
+      name: "currentPlayer",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "turnCounter",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "finished",
+      type: FieldType.BOOLEAN,
+      id: 3,
+    },
+    {
+      name: "winningPlayer",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "status",
+      type: FieldType.STRING,
+      id: 5,
+    },
+  ],
+};
+
+
+/**
+ * Schema for UpdateGameStatusResponse message
+ */
+export const UpdateGameStatusResponseSchema: MessageSchema = {
+  name: "UpdateGameStatusResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for SetTileAtRequest message
+ */
+export const SetTileAtRequestSchema: MessageSchema = {
+  name: "SetTileAtRequest",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "tile",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "lilbattle.v1.Tile",
+    },
+  ],
+};
+
+
+/**
+ * Schema for SetTileAtResponse message
+ */
+export const SetTileAtResponseSchema: MessageSchema = {
+  name: "SetTileAtResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for SetUnitAtRequest message
+ */
+export const SetUnitAtRequestSchema: MessageSchema = {
+  name: "SetUnitAtRequest",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "unit",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "lilbattle.v1.Unit",
+    },
+    {
+      name: "flash",
+      type: FieldType.BOOLEAN,
+      id: 4,
+    },
+    {
+      name: "appear",
+      type: FieldType.BOOLEAN,
+      id: 5,
+    },
+  ],
+};
+
+
+/**
+ * Schema for SetUnitAtResponse message
+ */
+export const SetUnitAtResponseSchema: MessageSchema = {
+  name: "SetUnitAtResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for RemoveTileAtRequest message
+ */
+export const RemoveTileAtRequestSchema: MessageSchema = {
+  name: "RemoveTileAtRequest",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "animate",
+      type: FieldType.BOOLEAN,
+      id: 3,
+    },
+  ],
+};
+
+
+/**
+ * Schema for RemoveTileAtResponse message
+ */
+export const RemoveTileAtResponseSchema: MessageSchema = {
+  name: "RemoveTileAtResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for RemoveUnitAtRequest message
+ */
+export const RemoveUnitAtRequestSchema: MessageSchema = {
+  name: "RemoveUnitAtRequest",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "animate",
+      type: FieldType.BOOLEAN,
+      id: 3,
+    },
+  ],
+};
+
+
+/**
+ * Schema for RemoveUnitAtResponse message
+ */
+export const RemoveUnitAtResponseSchema: MessageSchema = {
+  name: "RemoveUnitAtResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for ShowHighlightsRequest message
+ */
+export const ShowHighlightsRequestSchema: MessageSchema = {
+  name: "ShowHighlightsRequest",
+  fields: [
+    {
+      name: "highlights",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.HighlightSpec",
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ShowHighlightsResponse message
+ */
+export const ShowHighlightsResponseSchema: MessageSchema = {
+  name: "ShowHighlightsResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for HighlightSpec message
+ */
+export const HighlightSpecSchema: MessageSchema = {
+  name: "HighlightSpec",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "type",
+      type: FieldType.STRING,
+      id: 3,
+    },
+    {
+      name: "move",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "lilbattle.v1.MoveUnitAction",
+      oneofGroup: "action",
+    },
+    {
+      name: "attack",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "lilbattle.v1.AttackUnitAction",
+      oneofGroup: "action",
+    },
+    {
+      name: "build",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "lilbattle.v1.BuildUnitAction",
+      oneofGroup: "action",
+    },
+    {
+      name: "capture",
+      type: FieldType.MESSAGE,
+      id: 7,
+      messageType: "lilbattle.v1.CaptureBuildingAction",
+      oneofGroup: "action",
+    },
+    {
+      name: "player",
+      type: FieldType.NUMBER,
+      id: 8,
+    },
+  ],
+  oneofGroups: ["action"],
+};
+
+
+/**
+ * Schema for ClearHighlightsRequest message
+ */
+export const ClearHighlightsRequestSchema: MessageSchema = {
+  name: "ClearHighlightsRequest",
+  fields: [
+    {
+      name: "types",
+      type: FieldType.REPEATED,
+      id: 1,
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ClearHighlightsResponse message
+ */
+export const ClearHighlightsResponseSchema: MessageSchema = {
+  name: "ClearHighlightsResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for ShowPathRequest message
+ */
+export const ShowPathRequestSchema: MessageSchema = {
+  name: "ShowPathRequest",
+  fields: [
+    {
+      name: "coords",
+      type: FieldType.REPEATED,
+      id: 1,
+      repeated: true,
+    },
+    {
+      name: "color",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "thickness",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ShowPathResponse message
+ */
+export const ShowPathResponseSchema: MessageSchema = {
+  name: "ShowPathResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for ClearPathsRequest message
+ */
+export const ClearPathsRequestSchema: MessageSchema = {
+  name: "ClearPathsRequest",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for ClearPathsResponse message
+ */
+export const ClearPathsResponseSchema: MessageSchema = {
+  name: "ClearPathsResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for MoveUnitRequest message
+ */
+export const MoveUnitRequestSchema: MessageSchema = {
+  name: "MoveUnitRequest",
+  fields: [
+    {
+      name: "unit",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.Unit",
+    },
+    {
+      name: "path",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "lilbattle.v1.HexCoord",
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for MoveUnitResponse message
+ */
+export const MoveUnitResponseSchema: MessageSchema = {
+  name: "MoveUnitResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for HexCoord message
+ */
+export const HexCoordSchema: MessageSchema = {
+  name: "HexCoord",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ShowAttackEffectRequest message
+ */
+export const ShowAttackEffectRequestSchema: MessageSchema = {
+  name: "ShowAttackEffectRequest",
+  fields: [
+    {
+      name: "fromQ",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "fromR",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "toQ",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "toR",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "damage",
+      type: FieldType.NUMBER,
+      id: 5,
+    },
+    {
+      name: "splashTargets",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "lilbattle.v1.SplashTarget",
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for SplashTarget message
+ */
+export const SplashTargetSchema: MessageSchema = {
+  name: "SplashTarget",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "damage",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ShowAttackEffectResponse message
+ */
+export const ShowAttackEffectResponseSchema: MessageSchema = {
+  name: "ShowAttackEffectResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for ShowHealEffectRequest message
+ */
+export const ShowHealEffectRequestSchema: MessageSchema = {
+  name: "ShowHealEffectRequest",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "amount",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ShowHealEffectResponse message
+ */
+export const ShowHealEffectResponseSchema: MessageSchema = {
+  name: "ShowHealEffectResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for ShowCaptureEffectRequest message
+ */
+export const ShowCaptureEffectRequestSchema: MessageSchema = {
+  name: "ShowCaptureEffectRequest",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ShowCaptureEffectResponse message
+ */
+export const ShowCaptureEffectResponseSchema: MessageSchema = {
+  name: "ShowCaptureEffectResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for SetAllowedPanelsRequest message
+ */
+export const SetAllowedPanelsRequestSchema: MessageSchema = {
+  name: "SetAllowedPanelsRequest",
+  fields: [
+    {
+      name: "panelIds",
+      type: FieldType.REPEATED,
+      id: 1,
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for SetAllowedPanelsResponse message
+ */
+export const SetAllowedPanelsResponseSchema: MessageSchema = {
+  name: "SetAllowedPanelsResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for IndexState message
+ */
+export const IndexStateSchema: MessageSchema = {
+  name: "IndexState",
+  fields: [
+    {
+      name: "entityType",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "entityId",
+      type: FieldType.STRING,
+      id: 2,
+    },
+    {
+      name: "indexType",
+      type: FieldType.STRING,
+      id: 3,
+    },
+    {
+      name: "createdAt",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "google.protobuf.Timestamp",
+    },
+    {
+      name: "updatedAt",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "google.protobuf.Timestamp",
+    },
+    {
+      name: "indexedAt",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "google.protobuf.Timestamp",
+    },
+    {
+      name: "needsIndexing",
+      type: FieldType.BOOLEAN,
+      id: 7,
+    },
+    {
+      name: "status",
+      type: FieldType.STRING,
+      id: 8,
+    },
+    {
+      name: "lastError",
+      type: FieldType.STRING,
+      id: 9,
+    },
+    {
+      name: "idempotencyKey",
+      type: FieldType.STRING,
+      id: 10,
+    },
+    {
+      name: "retryCount",
+      type: FieldType.NUMBER,
+      id: 11,
+    },
+  ],
+};
+
+
+/**
+ * Schema for EnsureIndexStateRequest message
+ */
+export const EnsureIndexStateRequestSchema: MessageSchema = {
+  name: "EnsureIndexStateRequest",
+  fields: [
+    {
+      name: "indexState",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.IndexState",
+    },
+    {
+      name: "updateMask",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "google.protobuf.FieldMask",
+    },
+  ],
+};
+
+
+/**
+ * Schema for EnsureIndexStateResponse message
+ */
+export const EnsureIndexStateResponseSchema: MessageSchema = {
+  name: "EnsureIndexStateResponse",
+  fields: [
+    {
+      name: "indexState",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.IndexState",
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetIndexStatesRequest message
+ */
+export const GetIndexStatesRequestSchema: MessageSchema = {
+  name: "GetIndexStatesRequest",
+  fields: [
+    {
+      name: "entityType",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "entityId",
+      type: FieldType.STRING,
+      id: 2,
+    },
+    {
+      name: "indexTypes",
+      type: FieldType.REPEATED,
+      id: 3,
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for IndexStateList message
+ */
+export const IndexStateListSchema: MessageSchema = {
+  name: "IndexStateList",
+  fields: [
+    {
+      name: "states",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.IndexState",
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetIndexStatesResponse message
+ */
+export const GetIndexStatesResponseSchema: MessageSchema = {
+  name: "GetIndexStatesResponse",
+  fields: [
+    {
+      name: "states",
+      type: FieldType.STRING,
+      id: 1,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ListIndexStatesRequest message
+ */
+export const ListIndexStatesRequestSchema: MessageSchema = {
+  name: "ListIndexStatesRequest",
+  fields: [
+    {
+      name: "entityType",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "updatedBefore",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "google.protobuf.Timestamp",
+      oneofGroup: "_updated_before",
+      optional: true,
+    },
+    {
+      name: "updatedAfter",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "google.protobuf.Timestamp",
+      oneofGroup: "_updated_after",
+      optional: true,
+    },
+    {
+      name: "indexTypes",
+      type: FieldType.REPEATED,
+      id: 4,
+      repeated: true,
+    },
+    {
+      name: "orderBy",
+      type: FieldType.STRING,
+      id: 5,
+    },
+    {
+      name: "count",
+      type: FieldType.NUMBER,
+      id: 6,
+    },
+  ],
+  oneofGroups: ["_updated_before", "_updated_after"],
+};
+
+
+/**
+ * Schema for ListIndexStatesResponse message
+ */
+export const ListIndexStatesResponseSchema: MessageSchema = {
+  name: "ListIndexStatesResponse",
+  fields: [
+    {
+      name: "items",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.IndexState",
+      repeated: true,
+    },
+    {
+      name: "nextPageKey",
+      type: FieldType.STRING,
+      id: 2,
+    },
+  ],
+};
+
+
+/**
+ * Schema for DeleteIndexStatesRequest message
+ */
+export const DeleteIndexStatesRequestSchema: MessageSchema = {
+  name: "DeleteIndexStatesRequest",
+  fields: [
+    {
+      name: "entityType",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "entityId",
+      type: FieldType.STRING,
+      id: 2,
+    },
+    {
+      name: "indexTypes",
+      type: FieldType.REPEATED,
+      id: 3,
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for DeleteIndexStatesResponse message
+ */
+export const DeleteIndexStatesResponseSchema: MessageSchema = {
+  name: "DeleteIndexStatesResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for IndexRecord message
+ */
+export const IndexRecordSchema: MessageSchema = {
+  name: "IndexRecord",
+  fields: [
+    {
+      name: "entityId",
+      type: FieldType.STRING,
+      id: 2,
+    },
+    {
+      name: "updatedAt",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "google.protobuf.Timestamp",
+    },
+    {
+      name: "entityData",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "google.protobuf.Any",
+    },
+    {
+      name: "indexerTypes",
+      type: FieldType.REPEATED,
+      id: 5,
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for IndexRecordsLRO message
+ */
+export const IndexRecordsLROSchema: MessageSchema = {
+  name: "IndexRecordsLRO",
+  fields: [
+    {
+      name: "lroId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "entityType",
+      type: FieldType.STRING,
+      id: 2,
+    },
+    {
+      name: "createdAt",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "google.protobuf.Timestamp",
+    },
+    {
+      name: "updatedAt",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "google.protobuf.Timestamp",
+    },
+    {
+      name: "callbackUrl",
+      type: FieldType.STRING,
+      id: 5,
+    },
+    {
+      name: "records",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "lilbattle.v1.IndexRecord",
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for CreateIndexRecordsLRORequest message
+ */
+export const CreateIndexRecordsLRORequestSchema: MessageSchema = {
+  name: "CreateIndexRecordsLRORequest",
+  fields: [
     {
-      name: "amount",
-      type: FieldType.NUMBER,
-      id: 3,
+      name: "lro",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.IndexRecordsLRO",
     },
   ],
 };
 
 
 /**
- * Schema for ShowHealEffectResponse message
+ * Schema for CreateIndexRecordsLROResponse message
  */
-export const ShowHealEffectResponseSchema: MessageSchema = {
-  name: "ShowHealEffectResponse",
+export const CreateIndexRecordsLROResponseSchema: MessageSchema = {
+  name: "CreateIndexRecordsLROResponse",
   fields: [
+    {
+      name: "lro",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.IndexRecordsLRO",
+    },
   ],
 };
 
 
 /**
- * Schema for ShowCaptureEffectRequest message
+ * Schema for UpdateIndexRecordsLRORequest message
  */
-export const ShowCaptureEffectRequestSchema: MessageSchema = {
-  name: "ShowCaptureEffectRequest",
+export const UpdateIndexRecordsLRORequestSchema: MessageSchema = {
+  name: "UpdateIndexRecordsLRORequest",
   fields: [
     {
-      name: "q",
-      type: FieldType.NUMBER,
+      name: "lro",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.IndexRecordsLRO",
     },
     {
-      name: "r",
-      type: FieldType.NUMBER,
+      name: "updateMask",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "google.protobuf.FieldMask",
     },
   ],
 };
 
 
 /**
- * Schema for ShowCaptureEffectResponse message
+ * Schema for UpdateIndexRecordsLROResponse message
  */
-export const ShowCaptureEffectResponseSchema: MessageSchema = {
-  name: "ShowCaptureEffectResponse",
+export const UpdateIndexRecordsLROResponseSchema: MessageSchema = {
+  name: "UpdateIndexRecordsLROResponse",
   fields: [
+    {
+      name: "lro",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.IndexRecordsLRO",
+    },
   ],
 };
 
 
 /**
- * Schema for SetAllowedPanelsRequest message
+ * Schema for GetIndexRecordsLRORequest message
  */
-export const SetAllowedPanelsRequestSchema: MessageSchema = {
-  name: "SetAllowedPanelsRequest",
+export const GetIndexRecordsLRORequestSchema: MessageSchema = {
+  name: "GetIndexRecordsLRORequest",
   fields: [
     {
-      name: "panelIds",
-      type: FieldType.REPEATED,
+      name: "lroId",
+      type: FieldType.STRING,
       id: 1,
-      repeated: true,
     },
   ],
 };
 
 
 /**
- * Schema for SetAllowedPanelsResponse message
+ * Schema for GetIndexRecordsLROResponse message
  */
-export const SetAllowedPanelsResponseSchema: MessageSchema = {
-  name: "SetAllowedPanelsResponse",
+export const GetIndexRecordsLROResponseSchema: MessageSchema = {
+  name: "GetIndexRecordsLROResponse",
   fields: [
+    {
+      name: "lro",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.IndexRecordsLRO",
+    },
   ],
 };
 
 
 /**
- * Schema for IndexState message
+ * Schema for Job message
  */
-export const IndexStateSchema: MessageSchema = {
-  name: "IndexState",
+export const JobSchema: MessageSchema = {
+  name: "Job",
   fields: [
     {
       name: "entityType",
@@ -3623,7 +4712,7 @@ export const IndexStateSchema: MessageSchema = {
       id: 2,
     },
     {
-      name: "indexType",
+      name: "jobType",
       type: FieldType.STRING,
       id: 3,
     },
@@ -3640,397 +4729,426 @@ export const IndexStateSchema: MessageSchema = {
       messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "indexedAt",
+      name: "jobData",
       type: FieldType.MESSAGE,
       id: 6,
-      messageType: "google.protobuf.Timestamp",
+      messageType: "google.protobuf.Any",
     },
     {
-      name: "needsIndexing",
-      type: FieldType.BOOLEAN,
+      name: "debounceWindowSeconds",
+      type: FieldType.NUMBER,
       id: 7,
     },
     {
-      name: "status",
-      type: FieldType.STRING,
+      name: "repeatInfo",
+      type: FieldType.MESSAGE,
       id: 8,
+      messageType: "lilbattle.v1.RepeatInfo",
     },
     {
-      name: "lastError",
+      name: "id",
       type: FieldType.STRING,
       id: 9,
     },
     {
-      name: "idempotencyKey",
+      name: "status",
       type: FieldType.STRING,
       id: 10,
     },
     {
-      name: "retryCount",
-      type: FieldType.NUMBER,
+      name: "runAfter",
+      type: FieldType.MESSAGE,
       id: 11,
+      messageType: "google.protobuf.Timestamp",
     },
-  ],
-};
-
-
-/**
- * Schema for EnsureIndexStateRequest message
- */
-export const EnsureIndexStateRequestSchema: MessageSchema = {
-  name: "EnsureIndexStateRequest",
-  fields: [
     {
-      name: "indexState",
-      type: FieldType.MESSAGE,
-      id: 1,
-      messageType: "lilbattle.v1.IndexState",
+      name: "attempts",
+      type: FieldType.NUMBER,
+      id: 12,
     },
     {
-      name: "updateMask",
-      type: FieldType.MESSAGE,
-      id: 2,
-      messageType: "google.protobuf.FieldMask",
+      name: "maxRetries",
+      type: FieldType.NUMBER,
+      id: 13,
+    },
+    {
+      name: "lastError",
+      type: FieldType.STRING,
+      id: 14,
+    },
+    {
+      name: "lastRunId",
+      type: FieldType.STRING,
+      id: 15,
+    },
+    {
+      name: "version",
+      type: FieldType.NUMBER,
+      id: 16,
     },
   ],
 };
 
 
 /**
- * Schema for EnsureIndexStateResponse message
+ * Schema for RepeatInfo message
  */
-export const EnsureIndexStateResponseSchema: MessageSchema = {
-  name: "EnsureIndexStateResponse",
+export const RepeatInfoSchema: MessageSchema = {
+  name: "RepeatInfo",
   fields: [
     {
-      name: "indexState",
-      type: FieldType.MESSAGE,
+      name: "intervalSeconds",
+      type: FieldType.NUMBER,
       id: 1,
-      messageType: "lilbattle.v1.IndexState",
     },
   ],
 };
 
 
 /**
- * Schema for GetIndexStatesRequest message
+ * Schema for Run message
  */
-export const GetIndexStatesRequestSchema: MessageSchema = {
-  name: "GetIndexStatesRequest",
+export const RunSchema: MessageSchema = {
+  name: "Run",
   fields: [
     {
-      name: "entityType",
+      name: "jobId",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "entityId",
+      name: "runId",
       type: FieldType.STRING,
       id: 2,
     },
     {
-      name: "indexTypes",
-      type: FieldType.REPEATED,
+      name: "createdAt",
+      type: FieldType.MESSAGE,
       id: 3,
-      repeated: true,
+      messageType: "google.protobuf.Timestamp",
     },
-  ],
-};
-
-
-/**
- * Schema for IndexStateList message
- */
-export const IndexStateListSchema: MessageSchema = {
-  name: "IndexStateList",
-  fields: [
     {
-      name: "states",
+      name: "startedAt",
       type: FieldType.MESSAGE,
-      id: 1,
-      messageType: "lilbattle.v1.IndexState",
-      repeated: true,
+      id: 4,
+      messageType: "google.protobuf.Timestamp",
     },
-  ],
-};
-
-
-/**
- * Schema for GetIndexStatesResponse message
- */
-export const GetIndexStatesResponseSchema: MessageSchema = {
-  name: "GetIndexStatesResponse",
-  fields: [
     {
-      name: "states",
-      type: FieldType.STRING,
-      id: 1,
+      name: "updatedAt",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "google.protobuf.Timestamp",
     },
-  ],
-};
-
-
-/**
- * Schema for ListIndexStatesRequest message
- */
-export const ListIndexStatesRequestSchema: MessageSchema = {
-  name: "ListIndexStatesRequest",
-  fields: [
     {
-      name: "entityType",
+      name: "state",
       type: FieldType.STRING,
-      id: 1,
+      id: 6,
     },
     {
-      name: "updatedBefore",
+      name: "runData",
       type: FieldType.MESSAGE,
-      id: 2,
-      messageType: "google.protobuf.Timestamp",
-      oneofGroup: "_updated_before",
-      optional: true,
+      id: 7,
+      messageType: "google.protobuf.Any",
     },
     {
-      name: "updatedAfter",
+      name: "lastError",
+      type: FieldType.STRING,
+      id: 8,
+    },
+    {
+      name: "lastContentHash",
+      type: FieldType.STRING,
+      id: 9,
+    },
+    {
+      name: "retryCount",
+      type: FieldType.NUMBER,
+      id: 10,
+    },
+    {
+      name: "jobType",
+      type: FieldType.STRING,
+      id: 11,
+    },
+    {
+      name: "finishedAt",
       type: FieldType.MESSAGE,
-      id: 3,
+      id: 12,
       messageType: "google.protobuf.Timestamp",
-      oneofGroup: "_updated_after",
-      optional: true,
     },
-    {
-      name: "indexTypes",
-      type: FieldType.REPEATED,
-      id: 4,
-      repeated: true,
+  ],
+};
+
+
+/**
+ * Schema for ListJobsRequest message
+ */
+export const ListJobsRequestSchema: MessageSchema = {
+  name: "ListJobsRequest",
+  fields: [
+    {
+      name: "jobType",
+      type: FieldType.STRING,
+      id: 1,
     },
     {
-      name: "orderBy",
+      name: "status",
       type: FieldType.STRING,
-      id: 5,
+      id: 2,
     },
     {
-      name: "count",
-      type: FieldType.NUMBER,
-      id: 6,
+      name: "pagination",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "lilbattle.v1.Pagination",
     },
   ],
-  oneofGroups: ["_updated_before", "_updated_after"],
 };
 
 
 /**
- * Schema for ListIndexStatesResponse message
+ * Schema for ListJobsResponse message
  */
-export const ListIndexStatesResponseSchema: MessageSchema = {
-  name: "ListIndexStatesResponse",
+export const ListJobsResponseSchema: MessageSchema = {
+  name: "ListJobsResponse",
   fields: [
     {
       name: "items",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "lilbattle.v1.IndexState",
+      messageType: "lilbattle.v1.Job",
       repeated: true,
     },
     {
-      name: "nextPageKey",
-      type: FieldType.STRING,
+      name: "pagination",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.PaginationResponse",
     },
   ],
 };
 
 
 /**
- * Schema for DeleteIndexStatesRequest message
+ * Schema for GetJobRequest message
  */
-export const DeleteIndexStatesRequestSchema: MessageSchema = {
-  name: "DeleteIndexStatesRequest",
+export const GetJobRequestSchema: MessageSchema = {
+  name: "GetJobRequest",
   fields: [
     {
-      name: "entityType",
+      name: "id",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "entityId",
-      type: FieldType.STRING,
+      name: "runLimit",
+      type: FieldType.NUMBER,
       id: 2,
     },
-    {
-      name: "indexTypes",
-      type: FieldType.REPEATED,
-      id: 3,
-      repeated: true,
-    },
   ],
 };
 
 
 /**
- * Schema for DeleteIndexStatesResponse message
+ * Schema for GetJobResponse message
  */
-export const DeleteIndexStatesResponseSchema: MessageSchema = {
-  name: "DeleteIndexStatesResponse",
+export const GetJobResponseSchema: MessageSchema = {
+  name: "GetJobResponse",
   fields: [
+    {
+      name: "job",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "lilbattle.v1.Job",
+    },
+    {
+      name: "runs",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "lilbattle.v1.Run",
+      repeated: true,
+    },
   ],
 };
 
 
 /**
- * Schema for IndexRecord message
+ * Schema for RetryJobRequest message
  */
-export const IndexRecordSchema: MessageSchema = {
-  name: "IndexRecord",
+export const RetryJobRequestSchema: MessageSchema = {
+  name: "RetryJobRequest",
   fields: [
     {
-      name: "entityId",
+      name: "id",
       type: FieldType.STRING,
-      id: 2,
-    },
-    {
-      name: "updatedAt",
-      type: FieldType.MESSAGE,
-      id: 3,
-      messageType: "google.protobuf.Timestamp",
+      id: 1,
     },
+  ],
+};
+
+
+/**
+ * Schema for RetryJobResponse message
+ */
+export const RetryJobResponseSchema: MessageSchema = {
+  name: "RetryJobResponse",
+  fields: [
     {
-      name: "entityData",
+      name: "job",
       type: FieldType.MESSAGE,
-      id: 4,
-      messageType: "google.protobuf.Any",
-    },
-    {
-      name: "indexerTypes",
-      type: FieldType.REPEATED,
-      id: 5,
-      repeated: true,
+      id: 1,
+      messageType: "lilbattle.v1.Job",
     },
   ],
 };
 
 
 /**
- * Schema for IndexRecordsLRO message
+ * Schema for InitializeSingletonRequest message
  */
-export const IndexRecordsLROSchema: MessageSchema = {
-  name: "IndexRecordsLRO",
+export const InitializeSingletonRequestSchema: MessageSchema = {
+  name: "InitializeSingletonRequest",
   fields: [
     {
-      name: "lroId",
+      name: "gameId",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "entityType",
+      name: "gameData",
       type: FieldType.STRING,
       id: 2,
     },
     {
-      name: "createdAt",
-      type: FieldType.MESSAGE,
+      name: "gameState",
+      type: FieldType.STRING,
       id: 3,
-      messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "updatedAt",
-      type: FieldType.MESSAGE,
+      name: "moveHistory",
+      type: FieldType.STRING,
       id: 4,
-      messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "callbackUrl",
+      name: "viewerUserId",
       type: FieldType.STRING,
       id: 5,
     },
+  ],
+};
+
+
+/**
+ * Schema for InitializeSingletonResponse message
+ */
+export const InitializeSingletonResponseSchema: MessageSchema = {
+  name: "InitializeSingletonResponse",
+  fields: [
     {
-      name: "records",
+      name: "response",
       type: FieldType.MESSAGE,
-      id: 6,
-      messageType: "lilbattle.v1.IndexRecord",
-      repeated: true,
+      id: 1,
+      messageType: "lilbattle.v1.InitializeGameResponse",
     },
   ],
 };
 
 
 /**
- * Schema for CreateIndexRecordsLRORequest message
+ * Schema for TurnOptionClickedRequest message
  */
-export const CreateIndexRecordsLRORequestSchema: MessageSchema = {
-  name: "CreateIndexRecordsLRORequest",
+export const TurnOptionClickedRequestSchema: MessageSchema = {
+  name: "TurnOptionClickedRequest",
   fields: [
     {
-      name: "lro",
-      type: FieldType.MESSAGE,
+      name: "gameId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.IndexRecordsLRO",
+    },
+    {
+      name: "optionIndex",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "optionType",
+      type: FieldType.STRING,
+      id: 3,
+    },
+    {
+      name: "pos",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "lilbattle.v1.Position",
     },
   ],
 };
 
 
 /**
- * Schema for CreateIndexRecordsLROResponse message
+ * Schema for TurnOptionClickedResponse message
  */
-export const CreateIndexRecordsLROResponseSchema: MessageSchema = {
-  name: "CreateIndexRecordsLROResponse",
+export const TurnOptionClickedResponseSchema: MessageSchema = {
+  name: "TurnOptionClickedResponse",
   fields: [
     {
-      name: "lro",
-      type: FieldType.MESSAGE,
+      name: "gameId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.IndexRecordsLRO",
     },
   ],
 };
 
 
 /**
- * Schema for UpdateIndexRecordsLRORequest message
+ * Schema for SceneClickedRequest message
  */
-export const UpdateIndexRecordsLRORequestSchema: MessageSchema = {
-  name: "UpdateIndexRecordsLRORequest",
+export const SceneClickedRequestSchema: MessageSchema = {
+  name: "SceneClickedRequest",
   fields: [
     {
-      name: "lro",
-      type: FieldType.MESSAGE,
+      name: "gameId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.IndexRecordsLRO",
     },
     {
-      name: "updateMask",
+      name: "pos",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "google.protobuf.FieldMask",
+      messageType: "lilbattle.v1.Position",
+    },
+    {
+      name: "layer",
+      type: FieldType.STRING,
+      id: 3,
     },
   ],
 };
 
 
 /**
- * Schema for UpdateIndexRecordsLROResponse message
+ * Schema for SceneClickedResponse message
  */
-export const UpdateIndexRecordsLROResponseSchema: MessageSchema = {
-  name: "UpdateIndexRecordsLROResponse",
+export const SceneClickedResponseSchema: MessageSchema = {
+  name: "SceneClickedResponse",
   fields: [
     {
-      name: "lro",
-      type: FieldType.MESSAGE,
+      name: "gameId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.IndexRecordsLRO",
     },
   ],
 };
 
 
 /**
- * Schema for GetIndexRecordsLRORequest message
+ * Schema for EndTurnButtonClickedRequest message
  */
-export const GetIndexRecordsLRORequestSchema: MessageSchema = {
-  name: "GetIndexRecordsLRORequest",
+export const EndTurnButtonClickedRequestSchema: MessageSchema = {
+  name: "EndTurnButtonClickedRequest",
   fields: [
     {
-      name: "lroId",
+      name: "gameId",
       type: FieldType.STRING,
       id: 1,
     },
@@ -4039,154 +5157,141 @@ export const GetIndexRecordsLRORequestSchema: MessageSchema = {
 
 
 /**
- * Schema for GetIndexRecordsLROResponse message
+ * Schema for EndTurnButtonClickedResponse message
  */
-export const GetIndexRecordsLROResponseSchema: MessageSchema = {
-  name: "GetIndexRecordsLROResponse",
+export const EndTurnButtonClickedResponseSchema: MessageSchema = {
+  name: "EndTurnButtonClickedResponse",
   fields: [
     {
-      name: "lro",
-      type: FieldType.MESSAGE,
+      name: "gameId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.IndexRecordsLRO",
     },
   ],
 };
 
 
 /**
- * Schema for Job message
+ * Schema for BuildOptionClickedRequest message
  */
-export const JobSchema: MessageSchema = {
-  name: "Job",
+export const BuildOptionClickedRequestSchema: MessageSchema = {
+  name: "BuildOptionClickedRequest",
   fields: [
     {
-      name: "entityType",
+      name: "gameId",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "entityId",
-      type: FieldType.STRING,
-      id: 2,
-    },
-    {
-      name: "jobType",
-      type: FieldType.STRING,
-      id: 3,
-    },
-    {
-      name: "createdAt",
-      type: FieldType.MESSAGE,
-      id: 4,
-      messageType: "google.protobuf.Timestamp",
-    },
-    {
-      name: "updatedAt",
-      type: FieldType.MESSAGE,
-      id: 5,
-      messageType: "google.protobuf.Timestamp",
-    },
-    {
-      name: "jobData",
+      name: "pos",
       type: FieldType.MESSAGE,
-      id: 6,
-      messageType: "google.protobuf.Any",
-    },
-    {
-      name: "debounceWindowSeconds",
-      type: FieldType.NUMBER,
-      id: 7,
+      id: 2,
+      messageType: "lilbattle.v1.Position",
     },
-    {
-      name: "repeatInfo",
-      type: FieldType.MESSAGE,
-      id: 8,
-      messageType: "lilbattle.v1.RepeatInfo",
+    {
+      name: "unitType",
+      type: FieldType.NUMBER,
+      id: 3,
     },
   ],
 };
 
 
 /**
- * Schema for RepeatInfo message
+ * Schema for BuildOptionClickedResponse message
  */
-export const RepeatInfoSchema: MessageSchema = {
-  name: "RepeatInfo",
+export const BuildOptionClickedResponseSchema: MessageSchema = {
+  name: "BuildOptionClickedResponse",
   fields: [
   ],
 };
 
 
 /**
- * Schema for Run message
+ * Schema for InitializeGameRequest message
  */
-export const RunSchema: MessageSchema = {
-  name: "Run",
+export const InitializeGameRequestSchema: MessageSchema = {
+  name: "InitializeGameRequest",
   fields: [
     {
-      name: "jobId",
+      name: "gameId",
       type: FieldType.STRING,
       id: 1,
     },
+  ],
+};
+
+
+/**
+ * Schema for InitializeGameResponse message
+ */
+export const InitializeGameResponseSchema: MessageSchema = {
+  name: "InitializeGameResponse",
+  fields: [
     {
-      name: "runId",
+      name: "success",
+      type: FieldType.BOOLEAN,
+      id: 1,
+    },
+    {
+      name: "error",
       type: FieldType.STRING,
       id: 2,
     },
     {
-      name: "createdAt",
-      type: FieldType.MESSAGE,
+      name: "currentPlayer",
+      type: FieldType.NUMBER,
       id: 3,
-      messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "startedAt",
-      type: FieldType.MESSAGE,
+      name: "turnCounter",
+      type: FieldType.NUMBER,
       id: 4,
-      messageType: "google.protobuf.Timestamp",
-    },
-    {
-      name: "updatedAt",
-      type: FieldType.MESSAGE,
-      id: 5,
-      messageType: "google.protobuf.Timestamp",
-    },
-    {
-      name: "state",
-      type: FieldType.STRING,
-      id: 6,
     },
     {
-      name: "runData",
-      type: FieldType.MESSAGE,
-      id: 7,
-      messageType: "google.protobuf.Any",
-    },
-    {
-      name: "lastError",
+      name: "gameName",
       type: FieldType.STRING,
-      id: 8,
+      id: 5,
     },
+  ],
+};
+
+
+/**
+ * Schema for ClientReadyRequest message
+ */
+export const ClientReadyRequestSchema: MessageSchema = {
+  name: "ClientReadyRequest",
+  fields: [
     {
-      name: "lastContentHash",
+      name: "gameId",
       type: FieldType.STRING,
-      id: 9,
+      id: 1,
     },
+  ],
+};
+
+
+/**
+ * Schema for ClientReadyResponse message
+ */
+export const ClientReadyResponseSchema: MessageSchema = {
+  name: "ClientReadyResponse",
+  fields: [
     {
-      name: "retryCount",
-      type: FieldType.NUMBER,
-      id: 10,
+      name: "success",
+      type: FieldType.BOOLEAN,
+      id: 1,
     },
   ],
 };
 
 
 /**
- * Schema for InitializeSingletonRequest message
+ * Schema for ApplyRemoteChangesRequest message
  */
-export const InitializeSingletonRequestSchema: MessageSchema = {
-  name: "InitializeSingletonRequest",
+export const ApplyRemoteChangesRequestSchema: MessageSchema = {
+  name: "ApplyRemoteChangesRequest",
   fields: [
     {
       name: "gameId",
@@ -4194,111 +5299,170 @@ export const InitializeSingletonRequestSchema: MessageSchema = {
       id: 1,
     },
     {
-      name: "gameData",
-      type: FieldType.STRING,
+      name: "moves",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.GameMove",
+      repeated: true,
     },
+  ],
+};
+
+
+/**
+ * Schema for ApplyRemoteChangesResponse message
+ */
+export const ApplyRemoteChangesResponseSchema: MessageSchema = {
+  name: "ApplyRemoteChangesResponse",
+  fields: [
     {
-      name: "gameState",
-      type: FieldType.STRING,
-      id: 3,
+      name: "success",
+      type: FieldType.BOOLEAN,
+      id: 1,
     },
     {
-      name: "moveHistory",
+      name: "error",
       type: FieldType.STRING,
-      id: 4,
+      id: 2,
     },
     {
-      name: "viewerUserId",
-      type: FieldType.STRING,
-      id: 5,
+      name: "requiresReload",
+      type: FieldType.BOOLEAN,
+      id: 3,
     },
   ],
 };
 
 
 /**
- * Schema for InitializeSingletonResponse message
+ * Schema for SubscribeRequest message
  */
-export const InitializeSingletonResponseSchema: MessageSchema = {
-  name: "InitializeSingletonResponse",
+export const SubscribeRequestSchema: MessageSchema = {
+  name: "SubscribeRequest",
   fields: [
     {
-      name: "response",
-      type: FieldType.MESSAGE,
+      name: "gameId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.InitializeGameResponse",
+    },
+    {
+      name: "playerId",
+      type: FieldType.STRING,
+      id: 2,
+    },
+    {
+      name: "fromSequence",
+      type: FieldType.NUMBER,
+      id: 3,
     },
   ],
 };
 
 
 /**
- * Schema for TurnOptionClickedRequest message
+ * Schema for SubscribeResponse message
  */
-export const TurnOptionClickedRequestSchema: MessageSchema = {
-  name: "TurnOptionClickedRequest",
+export const SubscribeResponseSchema: MessageSchema = {
+  name: "SubscribeResponse",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
+      name: "currentSequence",
+      type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "optionIndex",
-      type: FieldType.NUMBER,
+      name: "gameState",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.GameState",
     },
     {
-      name: "optionType",
-      type: FieldType.STRING,
+      name: "game",
+      type: FieldType.MESSAGE,
       id: 3,
+      messageType: "lilbattle.v1.Game",
     },
     {
-      name: "pos",
-      type: FieldType.MESSAGE,
+      name: "reloadRequired",
+      type: FieldType.BOOLEAN,
       id: 4,
-      messageType: "lilbattle.v1.Position",
     },
   ],
 };
 
 
 /**
- * Schema for TurnOptionClickedResponse message
+ * Schema for GameUpdate message
  */
-export const TurnOptionClickedResponseSchema: MessageSchema = {
-  name: "TurnOptionClickedResponse",
+export const GameUpdateSchema: MessageSchema = {
+  name: "GameUpdate",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
+      name: "sequence",
+      type: FieldType.NUMBER,
       id: 1,
     },
+    {
+      name: "movesPublished",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "lilbattle.v1.MovesPublished",
+      oneofGroup: "update_type",
+    },
+    {
+      name: "playerJoined",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "lilbattle.v1.PlayerJoined",
+      oneofGroup: "update_type",
+    },
+    {
+      name: "playerLeft",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "lilbattle.v1.PlayerLeft",
+      oneofGroup: "update_type",
+    },
+    {
+      name: "gameEnded",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "lilbattle.v1.GameEnded",
+      oneofGroup: "update_type",
+    },
+    {
+      name: "initialState",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "lilbattle.v1.SubscribeResponse",
+      oneofGroup: "update_type",
+    },
   ],
+  oneofGroups: ["update_type"],
 };
 
 
 /**
- * Schema for SceneClickedRequest message
+ * Schema for MovesPublished message
  */
-export const SceneClickedRequestSchema: MessageSchema = {
-  name: "SceneClickedRequest",
+export const MovesPublishedSchema: MessageSchema = {
+  name: "MovesPublished",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
+      name: "player",
+      type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "pos",
+      name: "moves",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "lilbattle.v1.Position",
+      messageType: "lilbattle.v1.GameMove",
+      repeated: true,
     },
     {
-      name: "layer",
-      type: FieldType.STRING,
+      name: "groupNumber",
+      type: FieldType.NUMBER,
       id: 3,
     },
   ],
@@ -4306,55 +5470,70 @@ export const SceneClickedRequestSchema: MessageSchema = {
 
 
 /**
- * Schema for SceneClickedResponse message
+ * Schema for PlayerJoined message
  */
-export const SceneClickedResponseSchema: MessageSchema = {
-  name: "SceneClickedResponse",
+export const PlayerJoinedSchema: MessageSchema = {
+  name: "PlayerJoined",
   fields: [
     {
-      name: "gameId",
+      name: "playerId",
       type: FieldType.STRING,
       id: 1,
     },
+    {
+      name: "playerNumber",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
   ],
 };
 
 
 /**
- * Schema for EndTurnButtonClickedRequest message
+ * Schema for PlayerLeft message
  */
-export const EndTurnButtonClickedRequestSchema: MessageSchema = {
-  name: "EndTurnButtonClickedRequest",
+export const PlayerLeftSchema: MessageSchema = {
+  name: "PlayerLeft",
   fields: [
     {
-      name: "gameId",
+      name: "playerId",
       type: FieldType.STRING,
       id: 1,
     },
+    {
+      name: "playerNumber",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
   ],
 };
 
 
 /**
- * Schema for EndTurnButtonClickedResponse message
+ * Schema for GameEnded message
  */
-export const EndTurnButtonClickedResponseSchema: MessageSchema = {
-  name: "EndTurnButtonClickedResponse",
+export const GameEndedSchema: MessageSchema = {
+  name: "GameEnded",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
+      name: "winner",
+      type: FieldType.NUMBER,
       id: 1,
     },
+    {
+      name: "reason",
+      type: FieldType.STRING,
+      id: 2,
+    },
   ],
 };
 
 
 /**
- * Schema for BuildOptionClickedRequest message
+ * Schema for BroadcastRequest message
  */
-export const BuildOptionClickedRequestSchema: MessageSchema = {
-  name: "BuildOptionClickedRequest",
+export const BroadcastRequestSchema: MessageSchema = {
+  name: "BroadcastRequest",
   fields: [
     {
       name: "gameId",
@@ -4362,74 +5541,64 @@ export const BuildOptionClickedRequestSchema: MessageSchema = {
       id: 1,
     },
     {
-      name: "pos",
+      name: "update",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "lilbattle.v1.Position",
-    },
-    {
-      name: "unitType",
-      type: FieldType.NUMBER,
-      id: 3,
+      messageType: "lilbattle.v1.GameUpdate",
     },
   ],
 };
 
 
 /**
- * Schema for BuildOptionClickedResponse message
- */
-export const BuildOptionClickedResponseSchema: MessageSchema = {
-  name: "BuildOptionClickedResponse",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for InitializeGameRequest message
+ * Schema for BroadcastResponse message
  */
-export const InitializeGameRequestSchema: MessageSchema = {
-  name: "InitializeGameRequest",
+export const BroadcastResponseSchema: MessageSchema = {
+  name: "BroadcastResponse",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
+      name: "subscriberCount",
+      type: FieldType.NUMBER,
       id: 1,
     },
+    {
+      name: "sequence",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
   ],
 };
 
 
 /**
- * Schema for InitializeGameResponse message
+ * Schema for ThemeInfo message
  */
-export const InitializeGameResponseSchema: MessageSchema = {
-  name: "InitializeGameResponse",
+export const ThemeInfoSchema: MessageSchema = {
+  name: "ThemeInfo",
   fields: [
     {
-      name: "success",
-      type: FieldType.BOOLEAN,
+      name: "name",
+      type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "error",
+      name: "version",
       type: FieldType.STRING,
       id: 2,
     },
     {
-      name: "currentPlayer",
-      type: FieldType.NUMBER,
+      name: "basePath",
+      type: FieldType.STRING,
       id: 3,
     },
     {
-      name: "turnCounter",
-      type: FieldType.NUMBER,
+      name: "assetType",
+      type: FieldType.STRING,
       id: 4,
     },
     {
-      name: "gameName",
-      type: FieldType.STRING,
+      name: "needsPostProcessing",
+      type: FieldType.BOOLEAN,
       id: 5,
     },
   ],
@@ -4437,101 +5606,115 @@ export const InitializeGameResponseSchema: MessageSchema = {
 
 
 /**
- * Schema for ClientReadyRequest message
+ * Schema for UnitMapping message
  */
-export const ClientReadyRequestSchema: MessageSchema = {
-  name: "ClientReadyRequest",
+export const UnitMappingSchema: MessageSchema = {
+  name: "UnitMapping",
   fields: [
     {
-      name: "gameId",
+      name: "old",
       type: FieldType.STRING,
       id: 1,
     },
-  ],
-};
-
-
-/**
- * Schema for ClientReadyResponse message
- */
-export const ClientReadyResponseSchema: MessageSchema = {
-  name: "ClientReadyResponse",
-  fields: [
     {
-      name: "success",
-      type: FieldType.BOOLEAN,
-      id: 1,
+      name: "name",
+      type: FieldType.STRING,
+      id: 2,
+    },
+    {
+      name: "image",
+      type: FieldType.STRING,
+      id: 3,
+    },
+    {
+      name: "description",
+      type: FieldType.STRING,
+      id: 4,
     },
   ],
 };
 
 
 /**
- * Schema for ApplyRemoteChangesRequest message
+ * Schema for TerrainMapping message
  */
-export const ApplyRemoteChangesRequestSchema: MessageSchema = {
-  name: "ApplyRemoteChangesRequest",
+export const TerrainMappingSchema: MessageSchema = {
+  name: "TerrainMapping",
   fields: [
     {
-      name: "gameId",
+      name: "old",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "moves",
-      type: FieldType.MESSAGE,
+      name: "name",
+      type: FieldType.STRING,
       id: 2,
-      messageType: "lilbattle.v1.GameMove",
-      repeated: true,
+    },
+    {
+      name: "image",
+      type: FieldType.STRING,
+      id: 3,
+    },
+    {
+      name: "description",
+      type: FieldType.STRING,
+      id: 4,
     },
   ],
 };
 
 
 /**
- * Schema for ApplyRemoteChangesResponse message
+ * Schema for ThemeManifest message
  */
-export const ApplyRemoteChangesResponseSchema: MessageSchema = {
-  name: "ApplyRemoteChangesResponse",
+export const ThemeManifestSchema: MessageSchema = {
+  name: "ThemeManifest",
   fields: [
     {
-      name: "success",
-      type: FieldType.BOOLEAN,
+      name: "themeInfo",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.ThemeInfo",
     },
     {
-      name: "error",
+      name: "units",
       type: FieldType.STRING,
       id: 2,
     },
     {
-      name: "requiresReload",
-      type: FieldType.BOOLEAN,
+      name: "terrains",
+      type: FieldType.STRING,
       id: 3,
     },
+    {
+      name: "playerColors",
+      type: FieldType.STRING,
+      id: 4,
+    },
   ],
 };
 
 
 /**
- * Schema for SubscribeRequest message
+ * Schema for PlayerColor message
  */
-export const SubscribeRequestSchema: MessageSchema = {
-  name: "SubscribeRequest",
+export const PlayerColorSchema: MessageSchema = {
+  name: "PlayerColor",
   fields: [
     {
-      name: "gameId",
+      name: "primary",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "playerId",
+      name: "secondary",
       type: FieldType.STRING,
       id: 2,
     },
     {
-      name: "fromSequence",
-      type: FieldType.NUMBER,
+      name: "name",
+      type: FieldType.STRING,
       id: 3,
     },
   ],
@@ -4539,465 +5722,386 @@ export const SubscribeRequestSchema: MessageSchema = {
 
 
 /**
- * Schema for SubscribeResponse message
+ * Schema for AssetResult message
  */
-export const SubscribeResponseSchema: MessageSchema = {
-  name: "SubscribeResponse",
+export const AssetResultSchema: MessageSchema = {
+  name: "AssetResult",
   fields: [
     {
-      name: "currentSequence",
-      type: FieldType.NUMBER,
+      name: "type",
+      type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "gameState",
-      type: FieldType.MESSAGE,
+      name: "data",
+      type: FieldType.STRING,
       id: 2,
-      messageType: "lilbattle.v1.GameState",
-    },
-    {
-      name: "game",
-      type: FieldType.MESSAGE,
-      id: 3,
-      messageType: "lilbattle.v1.Game",
     },
   ],
 };
 
 
 /**
- * Schema for GameUpdate message
+ * Schema for WorldInfo message
  */
-export const GameUpdateSchema: MessageSchema = {
-  name: "GameUpdate",
+export const WorldInfoSchema: MessageSchema = {
+  name: "WorldInfo",
   fields: [
     {
-      name: "sequence",
-      type: FieldType.NUMBER,
+      name: "id",
+      type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "movesPublished",
-      type: FieldType.MESSAGE,
+      name: "name",
+      type: FieldType.STRING,
       id: 2,
-      messageType: "lilbattle.v1.MovesPublished",
-      oneofGroup: "update_type",
     },
     {
-      name: "playerJoined",
-      type: FieldType.MESSAGE,
+      name: "description",
+      type: FieldType.STRING,
       id: 3,
-      messageType: "lilbattle.v1.PlayerJoined",
-      oneofGroup: "update_type",
     },
     {
-      name: "playerLeft",
-      type: FieldType.MESSAGE,
+      name: "category",
+      type: FieldType.STRING,
       id: 4,
-      messageType: "lilbattle.v1.PlayerLeft",
-      oneofGroup: "update_type",
     },
     {
-      name: "gameEnded",
-      type: FieldType.MESSAGE,
+      name: "difficulty",
+      type: FieldType.STRING,
       id: 5,
-      messageType: "lilbattle.v1.GameEnded",
-      oneofGroup: "update_type",
     },
     {
-      name: "initialState",
-      type: FieldType.MESSAGE,
+      name: "tags",
+      type: FieldType.REPEATED,
       id: 6,
-      messageType: "lilbattle.v1.SubscribeResponse",
-      oneofGroup: "update_type",
+      repeated: true,
+    },
+    {
+      name: "icon",
+      type: FieldType.STRING,
+      id: 7,
+    },
+    {
+      name: "lastUpdated",
+      type: FieldType.STRING,
+      id: 8,
     },
   ],
-  oneofGroups: ["update_type"],
 };
 
 
 /**
- * Schema for MovesPublished message
+ * Schema for ListWorldsRequest message
  */
-export const MovesPublishedSchema: MessageSchema = {
-  name: "MovesPublished",
+export const ListWorldsRequestSchema: MessageSchema = {
+  name: "ListWorldsRequest",
   fields: [
     {
-      name: "player",
-      type: FieldType.NUMBER,
+      name: "pagination",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.Pagination",
     },
     {
-      name: "moves",
-      type: FieldType.MESSAGE,
+      name: "ownerId",
+      type: FieldType.STRING,
       id: 2,
-      messageType: "lilbattle.v1.GameMove",
-      repeated: true,
     },
     {
-      name: "groupNumber",
-      type: FieldType.NUMBER,
+      name: "tag",
+      type: FieldType.STRING,
       id: 3,
     },
-  ],
-};
-
-
-/**
- * Schema for PlayerJoined message
- */
-export const PlayerJoinedSchema: MessageSchema = {
-  name: "PlayerJoined",
-  fields: [
     {
-      name: "playerId",
-      type: FieldType.STRING,
-      id: 1,
+      name: "created",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "lilbattle.v1.TimeRange",
     },
     {
-      name: "playerNumber",
-      type: FieldType.NUMBER,
-      id: 2,
+      name: "updated",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "lilbattle.v1.TimeRange",
     },
-  ],
-};
-
-
-/**
- * Schema for PlayerLeft message
- */
-export const PlayerLeftSchema: MessageSchema = {
-  name: "PlayerLeft",
-  fields: [
     {
-      name: "playerId",
+      name: "sortBy",
       type: FieldType.STRING,
-      id: 1,
+      id: 6,
     },
     {
-      name: "playerNumber",
-      type: FieldType.NUMBER,
-      id: 2,
+      name: "sortOrder",
+      type: FieldType.STRING,
+      id: 7,
+    },
+    {
+      name: "parentWorldId",
+      type: FieldType.STRING,
+      id: 8,
     },
   ],
 };
 
 
 /**
- * Schema for GameEnded message
+ * Schema for ListWorldsResponse message
  */
-export const GameEndedSchema: MessageSchema = {
-  name: "GameEnded",
+export const ListWorldsResponseSchema: MessageSchema = {
+  name: "ListWorldsResponse",
   fields: [
     {
-      name: "winner",
-      type: FieldType.NUMBER,
+      name: "items",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.World",
+      repeated: true,
     },
     {
-      name: "reason",
-      type: FieldType.STRING,
+      name: "pagination",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.PaginationResponse",
     },
   ],
 };
 
 
 /**
- * Schema for BroadcastRequest message
+ * Schema for GetWorldRequest message
  */
-export const BroadcastRequestSchema: MessageSchema = {
-  name: "BroadcastRequest",
+export const GetWorldRequestSchema: MessageSchema = {
+  name: "GetWorldRequest",
   fields: [
     {
-      name: "gameId",
+      name: "id",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "update",
-      type: FieldType.MESSAGE,
+      name: "version",
+      type: FieldType.STRING,
       id: 2,
-      messageType: "lilbattle.v1.GameUpdate",
     },
   ],
 };
 
 
 /**
- * Schema for BroadcastResponse message
+ * Schema for GetWorldResponse message
  */
-export const BroadcastResponseSchema: MessageSchema = {
-  name: "BroadcastResponse",
+export const GetWorldResponseSchema: MessageSchema = {
+  name: "GetWorldResponse",
   fields: [
     {
-      name: "subscriberCount",
-      type: FieldType.NUMBER,
+      name: "world",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.World",
     },
     {
-      name: "sequence",
-      type: FieldType.NUMBER,
+      name: "worldData",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.WorldData",
     },
   ],
 };
 
 
 /**
- * Schema for ThemeInfo message
+ * Schema for UpdateWorldRequest message
  */
-export const ThemeInfoSchema: MessageSchema = {
-  name: "ThemeInfo",
+export const UpdateWorldRequestSchema: MessageSchema = {
+  name: "UpdateWorldRequest",
   fields: [
     {
-      name: "name",
-      type: FieldType.STRING,
+      name: "world",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.World",
     },
     {
-      name: "version",
-      type: FieldType.STRING,
+      name: "worldData",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.WorldData",
     },
     {
-      name: "basePath",
-      type: FieldType.STRING,
+      name: "clearWorld",
+      type: FieldType.BOOLEAN,
       id: 3,
     },
     {
-      name: "assetType",
-      type: FieldType.STRING,
+      name: "updateMask",
+      type: FieldType.MESSAGE,
       id: 4,
-    },
-    {
-      name: "needsPostProcessing",
-      type: FieldType.BOOLEAN,
-      id: 5,
+      messageType: "google.protobuf.FieldMask",
     },
   ],
 };
 
 
 /**
- * Schema for UnitMapping message
+ * Schema for UpdateWorldResponse message
  */
-export const UnitMappingSchema: MessageSchema = {
-  name: "UnitMapping",
+export const UpdateWorldResponseSchema: MessageSchema = {
+  name: "UpdateWorldResponse",
   fields: [
     {
-      name: "old",
-      type: FieldType.STRING,
+      name: "world",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.World",
     },
     {
-      name: "name",
-      type: FieldType.STRING,
+      name: "worldData",
+      type: FieldType.MESSAGE,
       id: 2,
-    },
-    {
-      name: "image",
-      type: FieldType.STRING,
-      id: 3,
-    },
-    {
-      name: "description",
-      type: FieldType.STRING,
-      id: 4,
+      messageType: "lilbattle.v1.WorldData",
     },
   ],
 };
 
 
 /**
- * Schema for TerrainMapping message
+ * Schema for DeleteWorldRequest message
  */
-export const TerrainMappingSchema: MessageSchema = {
-  name: "TerrainMapping",
+export const DeleteWorldRequestSchema: MessageSchema = {
+  name: "DeleteWorldRequest",
   fields: [
     {
-      name: "old",
+      name: "id",
       type: FieldType.STRING,
       id: 1,
     },
-    {
-      name: "name",
-      type: FieldType.STRING,
-      id: 2,
-    },
-    {
-      name: "image",
-      type: FieldType.STRING,
-      id: 3,
-    },
-    {
-      name: "description",
-      type: FieldType.STRING,
-      id: 4,
-    },
   ],
 };
 
 
 /**
- * Schema for ThemeManifest message
+ * Schema for DeleteWorldResponse message
  */
-export const ThemeManifestSchema: MessageSchema = {
-  name: "ThemeManifest",
+export const DeleteWorldResponseSchema: MessageSchema = {
+  name: "DeleteWorldResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for GetWorldsRequest message
+ */
+export const GetWorldsRequestSchema: MessageSchema = {
+  name: "GetWorldsRequest",
   fields: [
     {
-      name: "themeInfo",
-      type: FieldType.MESSAGE,
+      name: "ids",
+      type: FieldType.REPEATED,
       id: 1,
-      messageType: "lilbattle.v1.ThemeInfo",
-    },
-    {
-      name: "units",
-      type: FieldType.STRING,
-      id: 2,
-    },
-    {
-      name: "terrains",
-      type: FieldType.STRING,
-      id: 3,
-    },
-    {
-      name: "playerColors",
-      type: FieldType.STRING,
-      id: 4,
+      repeated: true,
     },
   ],
 };
 
 
 /**
- * Schema for PlayerColor message
+ * Schema for GetWorldsResponse message
  */
-export const PlayerColorSchema: MessageSchema = {
-  name: "PlayerColor",
+export const GetWorldsResponseSchema: MessageSchema = {
+  name: "GetWorldsResponse",
   fields: [
     {
-      name: "primary",
+      name: "worlds",
       type: FieldType.STRING,
       id: 1,
     },
-    {
-      name: "secondary",
-      type: FieldType.STRING,
-      id: 2,
-    },
-    {
-      name: "name",
-      type: FieldType.STRING,
-      id: 3,
-    },
   ],
 };
 
 
 /**
- * Schema for AssetResult message
+ * Schema for CreateWorldRequest message
  */
-export const AssetResultSchema: MessageSchema = {
-  name: "AssetResult",
+export const CreateWorldRequestSchema: MessageSchema = {
+  name: "CreateWorldRequest",
   fields: [
     {
-      name: "type",
-      type: FieldType.STRING,
+      name: "world",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.World",
     },
     {
-      name: "data",
-      type: FieldType.STRING,
+      name: "worldData",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.WorldData",
     },
   ],
 };
 
 
 /**
- * Schema for WorldInfo message
+ * Schema for CreateWorldResponse message
  */
-export const WorldInfoSchema: MessageSchema = {
-  name: "WorldInfo",
+export const CreateWorldResponseSchema: MessageSchema = {
+  name: "CreateWorldResponse",
   fields: [
     {
-      name: "id",
-      type: FieldType.STRING,
+      name: "world",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.World",
     },
     {
-      name: "name",
-      type: FieldType.STRING,
+      name: "worldData",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.WorldData",
     },
     {
-      name: "description",
+      name: "fieldErrors",
       type: FieldType.STRING,
       id: 3,
     },
-    {
-      name: "category",
-      type: FieldType.STRING,
-      id: 4,
-    },
-    {
-      name: "difficulty",
-      type: FieldType.STRING,
-      id: 5,
-    },
-    {
-      name: "tags",
-      type: FieldType.REPEATED,
-      id: 6,
-      repeated: true,
-    },
-    {
-      name: "icon",
-      type: FieldType.STRING,
-      id: 7,
-    },
-    {
-      name: "lastUpdated",
-      type: FieldType.STRING,
-      id: 8,
-    },
   ],
 };
 
 
 /**
- * Schema for ListWorldsRequest message
+ * Schema for ListWorldRevisionsRequest message
  */
-export const ListWorldsRequestSchema: MessageSchema = {
-  name: "ListWorldsRequest",
+export const ListWorldRevisionsRequestSchema: MessageSchema = {
+  name: "ListWorldRevisionsRequest",
   fields: [
     {
-      name: "pagination",
-      type: FieldType.MESSAGE,
+      name: "worldId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.Pagination",
     },
     {
-      name: "ownerId",
-      type: FieldType.STRING,
+      name: "pagination",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "lilbattle.v1.Pagination",
     },
   ],
 };
 
 
 /**
- * Schema for ListWorldsResponse message
+ * Schema for ListWorldRevisionsResponse message
  */
-export const ListWorldsResponseSchema: MessageSchema = {
-  name: "ListWorldsResponse",
+export const ListWorldRevisionsResponseSchema: MessageSchema = {
+  name: "ListWorldRevisionsResponse",
   fields: [
     {
       name: "items",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "lilbattle.v1.World",
+      messageType: "lilbattle.v1.WorldRevision",
       repeated: true,
     },
     {
@@ -5011,19 +6115,19 @@ export const ListWorldsResponseSchema: MessageSchema = {
 
 
 /**
- * Schema for GetWorldRequest message
+ * Schema for RevertWorldRequest message
  */
-export const GetWorldRequestSchema: MessageSchema = {
-  name: "GetWorldRequest",
+export const RevertWorldRequestSchema: MessageSchema = {
+  name: "RevertWorldRequest",
   fields: [
     {
-      name: "id",
+      name: "worldId",
       type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "version",
-      type: FieldType.STRING,
+      name: "revision",
+      type: FieldType.NUMBER,
       id: 2,
     },
   ],
@@ -5031,10 +6135,10 @@ export const GetWorldRequestSchema: MessageSchema = {
 
 
 /**
- * Schema for GetWorldResponse message
+ * Schema for RevertWorldResponse message
  */
-export const GetWorldResponseSchema: MessageSchema = {
-  name: "GetWorldResponse",
+export const RevertWorldResponseSchema: MessageSchema = {
+  name: "RevertWorldResponse",
   fields: [
     {
       name: "world",
@@ -5053,160 +6157,252 @@ export const GetWorldResponseSchema: MessageSchema = {
 
 
 /**
- * Schema for UpdateWorldRequest message
+ * Schema for ListDerivedWorldsRequest message
  */
-export const UpdateWorldRequestSchema: MessageSchema = {
-  name: "UpdateWorldRequest",
+export const ListDerivedWorldsRequestSchema: MessageSchema = {
+  name: "ListDerivedWorldsRequest",
   fields: [
     {
-      name: "world",
-      type: FieldType.MESSAGE,
+      name: "worldId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.World",
     },
     {
-      name: "worldData",
+      name: "pagination",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "lilbattle.v1.WorldData",
-    },
-    {
-      name: "clearWorld",
-      type: FieldType.BOOLEAN,
-      id: 3,
-    },
-    {
-      name: "updateMask",
-      type: FieldType.MESSAGE,
-      id: 4,
-      messageType: "google.protobuf.FieldMask",
+      messageType: "lilbattle.v1.Pagination",
     },
   ],
 };
 
 
 /**
- * Schema for UpdateWorldResponse message
+ * Schema for ListDerivedWorldsResponse message
  */
-export const UpdateWorldResponseSchema: MessageSchema = {
-  name: "UpdateWorldResponse",
+export const ListDerivedWorldsResponseSchema: MessageSchema = {
+  name: "ListDerivedWorldsResponse",
   fields: [
     {
-      name: "world",
+      name: "items",
       type: FieldType.MESSAGE,
       id: 1,
       messageType: "lilbattle.v1.World",
+      repeated: true,
     },
     {
-      name: "worldData",
+      name: "pagination",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "lilbattle.v1.WorldData",
+      messageType: "lilbattle.v1.PaginationResponse",
     },
   ],
 };
 
 
 /**
- * Schema for DeleteWorldRequest message
+ * Schema for MergeWorldUpstreamRequest message
  */
-export const DeleteWorldRequestSchema: MessageSchema = {
-  name: "DeleteWorldRequest",
+export const MergeWorldUpstreamRequestSchema: MessageSchema = {
+  name: "MergeWorldUpstreamRequest",
   fields: [
     {
-      name: "id",
+      name: "worldId",
       type: FieldType.STRING,
       id: 1,
     },
+    {
+      name: "upstreamRevision",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "strategy",
+      type: FieldType.STRING,
+      id: 3,
+    },
+    {
+      name: "dryRun",
+      type: FieldType.BOOLEAN,
+      id: 4,
+    },
   ],
 };
 
 
 /**
- * Schema for DeleteWorldResponse message
- */
-export const DeleteWorldResponseSchema: MessageSchema = {
-  name: "DeleteWorldResponse",
-  fields: [
-  ],
-};
-
-
-/**
- * Schema for GetWorldsRequest message
+ * Schema for MergeWorldUpstreamResponse message
  */
-export const GetWorldsRequestSchema: MessageSchema = {
-  name: "GetWorldsRequest",
+export const MergeWorldUpstreamResponseSchema: MessageSchema = {
+  name: "MergeWorldUpstreamResponse",
   fields: [
     {
-      name: "ids",
-      type: FieldType.REPEATED,
+      name: "world",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "lilbattle.v1.World",
+    },
+    {
+      name: "worldData",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "lilbattle.v1.WorldData",
+    },
+    {
+      name: "baseRevision",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "upstreamRevision",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "applied",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "lilbattle.v1.WorldCellChange",
+      repeated: true,
+    },
+    {
+      name: "conflicts",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "lilbattle.v1.WorldMergeConflict",
       repeated: true,
     },
+    {
+      name: "merged",
+      type: FieldType.BOOLEAN,
+      id: 7,
+    },
   ],
 };
 
 
 /**
- * Schema for GetWorldsResponse message
+ * Schema for SearchWorldsRequest message
  */
-export const GetWorldsResponseSchema: MessageSchema = {
-  name: "GetWorldsResponse",
+export const SearchWorldsRequestSchema: MessageSchema = {
+  name: "SearchWorldsRequest",
   fields: [
     {
-      name: "worlds",
+      name: "query",
       type: FieldType.STRING,
       id: 1,
     },
+    {
+      name: "tags",
+      type: FieldType.REPEATED,
+      id: 2,
+      repeated: true,
+    },
+    {
+      name: "difficulties",
+      type: FieldType.REPEATED,
+      id: 3,
+      repeated: true,
+    },
+    {
+      name: "minPlayers",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "maxPlayers",
+      type: FieldType.NUMBER,
+      id: 5,
+    },
+    {
+      name: "minTiles",
+      type: FieldType.NUMBER,
+      id: 6,
+    },
+    {
+      name: "maxTiles",
+      type: FieldType.NUMBER,
+      id: 7,
+    },
+    {
+      name: "sortBy",
+      type: FieldType.STRING,
+      id: 8,
+    },
+    {
+      name: "sortOrder",
+      type: FieldType.STRING,
+      id: 9,
+    },
+    {
+      name: "pagination",
+      type: FieldType.MESSAGE,
+      id: 10,
+      messageType: "lilbattle.v1.Pagination",
+    },
   ],
 };
 
 
 /**
- * Schema for CreateWorldRequest message
+ * Schema for SearchFacetCount message
  */
-export const CreateWorldRequestSchema: MessageSchema = {
-  name: "CreateWorldRequest",
+export const SearchFacetCountSchema: MessageSchema = {
+  name: "SearchFacetCount",
   fields: [
     {
-      name: "world",
-      type: FieldType.MESSAGE,
+      name: "value",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "lilbattle.v1.World",
     },
     {
-      name: "worldData",
-      type: FieldType.MESSAGE,
+      name: "count",
+      type: FieldType.NUMBER,
       id: 2,
-      messageType: "lilbattle.v1.WorldData",
     },
   ],
 };
 
 
 /**
- * Schema for CreateWorldResponse message
+ * Schema for SearchWorldsResponse message
  */
-export const CreateWorldResponseSchema: MessageSchema = {
-  name: "CreateWorldResponse",
+export const SearchWorldsResponseSchema: MessageSchema = {
+  name: "SearchWorldsResponse",
   fields: [
     {
-      name: "world",
+      name: "items",
       type: FieldType.MESSAGE,
       id: 1,
       messageType: "lilbattle.v1.World",
+      repeated: true,
     },
     {
-      name: "worldData",
+      name: "pagination",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "lilbattle.v1.WorldData",
+      messageType: "lilbattle.v1.PaginationResponse",
     },
     {
-      name: "fieldErrors",
-      type: FieldType.STRING,
+      name: "tags",
+      type: FieldType.MESSAGE,
       id: 3,
+      messageType: "lilbattle.v1.SearchFacetCount",
+      repeated: true,
+    },
+    {
+      name: "difficulties",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "lilbattle.v1.SearchFacetCount",
+      repeated: true,
+    },
+    {
+      name: "playerCounts",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "lilbattle.v1.SearchFacetCount",
+      repeated: true,
     },
   ],
 };
@@ -5266,6 +6462,14 @@ export const lilbattle_v1SchemaRegistry: Record<string, MessageSchema> = {
   "lilbattle.v1.AllPaths": AllPathsSchema,
   "lilbattle.v1.PathEdge": PathEdgeSchema,
   "lilbattle.v1.Path": PathSchema,
+  "lilbattle.v1.PlayerOrders": PlayerOrdersSchema,
+  "lilbattle.v1.TimeRange": TimeRangeSchema,
+  "lilbattle.v1.UserGame": UserGameSchema,
+  "lilbattle.v1.UserGameList": UserGameListSchema,
+  "lilbattle.v1.WorldRevision": WorldRevisionSchema,
+  "lilbattle.v1.WorldCellChange": WorldCellChangeSchema,
+  "lilbattle.v1.WorldMergeConflict": WorldMergeConflictSchema,
+  "lilbattle.v1.WorldSearchEntry": WorldSearchEntrySchema,
   "lilbattle.v1.File": FileSchema,
   "lilbattle.v1.PutFileRequest": PutFileRequestSchema,
   "lilbattle.v1.PutFileResponse": PutFileResponseSchema,
@@ -5304,6 +6508,16 @@ export const lilbattle_v1SchemaRegistry: Record<string, MessageSchema> = {
   "lilbattle.v1.SimulateFixResponse": SimulateFixResponseSchema,
   "lilbattle.v1.JoinGameRequest": JoinGameRequestSchema,
   "lilbattle.v1.JoinGameResponse": JoinGameResponseSchema,
+  "lilbattle.v1.CommitOrdersRequest": CommitOrdersRequestSchema,
+  "lilbattle.v1.CommitOrdersResponse": CommitOrdersResponseSchema,
+  "lilbattle.v1.RevealOrdersRequest": RevealOrdersRequestSchema,
+  "lilbattle.v1.RevealOrdersResponse": RevealOrdersResponseSchema,
+  "lilbattle.v1.ListMyGamesRequest": ListMyGamesRequestSchema,
+  "lilbattle.v1.ListMyGamesResponse": ListMyGamesResponseSchema,
+  "lilbattle.v1.ExportGameRequest": ExportGameRequestSchema,
+  "lilbattle.v1.ExportGameResponse": ExportGameResponseSchema,
+  "lilbattle.v1.ImportGameRequest": ImportGameRequestSchema,
+  "lilbattle.v1.ImportGameResponse": ImportGameResponseSchema,
   "lilbattle.v1.EmptyRequest": EmptyRequestSchema,
   "lilbattle.v1.EmptyResponse": EmptyResponseSchema,
   "lilbattle.v1.SetContentRequest": SetContentRequestSchema,
@@ -5366,6 +6580,12 @@ export const lilbattle_v1SchemaRegistry: Record<string, MessageSchema> = {
   "lilbattle.v1.Job": JobSchema,
   "lilbattle.v1.RepeatInfo": RepeatInfoSchema,
   "lilbattle.v1.Run": RunSchema,
+  "lilbattle.v1.ListJobsRequest": ListJobsRequestSchema,
+  "lilbattle.v1.ListJobsResponse": ListJobsResponseSchema,
+  "lilbattle.v1.GetJobRequest": GetJobRequestSchema,
+  "lilbattle.v1.GetJobResponse": GetJobResponseSchema,
+  "lilbattle.v1.RetryJobRequest": RetryJobRequestSchema,
+  "lilbattle.v1.RetryJobResponse": RetryJobResponseSchema,
   "lilbattle.v1.InitializeSingletonRequest": InitializeSingletonRequestSchema,
   "lilbattle.v1.InitializeSingletonResponse": InitializeSingletonResponseSchema,
   "lilbattle.v1.TurnOptionClickedRequest": TurnOptionClickedRequestSchema,
@@ -5410,6 +6630,17 @@ export const lilbattle_v1SchemaRegistry: Record<string, MessageSchema> = {
   "lilbattle.v1.GetWorldsResponse": GetWorldsResponseSchema,
   "lilbattle.v1.CreateWorldRequest": CreateWorldRequestSchema,
   "lilbattle.v1.CreateWorldResponse": CreateWorldResponseSchema,
+  "lilbattle.v1.ListWorldRevisionsRequest": ListWorldRevisionsRequestSchema,
+  "lilbattle.v1.ListWorldRevisionsResponse": ListWorldRevisionsResponseSchema,
+  "lilbattle.v1.RevertWorldRequest": RevertWorldRequestSchema,
+  "lilbattle.v1.RevertWorldResponse": RevertWorldResponseSchema,
+  "lilbattle.v1.ListDerivedWorldsRequest": ListDerivedWorldsRequestSchema,
+  "lilbattle.v1.ListDerivedWorldsResponse": ListDerivedWorldsResponseSchema,
+  "lilbattle.v1.MergeWorldUpstreamRequest": MergeWorldUpstreamRequestSchema,
+  "lilbattle.v1.MergeWorldUpstreamResponse": MergeWorldUpstreamResponseSchema,
+  "lilbattle.v1.SearchWorldsRequest": SearchWorldsRequestSchema,
+  "lilbattle.v1.SearchFacetCount": SearchFacetCountSchema,
+  "lilbattle.v1.SearchWorldsResponse": SearchWorldsResponseSchema,
 };
 
 /**
@@ -5,10 +5,14 @@ import { ServiceClient } from '@protoc-gen-go-wasmjs/runtime';
 
 // Import TypeScript types for method signatures
 import {
+    CommitOrdersRequest,
+    CommitOrdersResponse,
     CreateGameRequest,
     CreateGameResponse,
     DeleteGameRequest,
     DeleteGameResponse,
+    ExportGameRequest,
+    ExportGameResponse,
     GetGameRequest,
     GetGameResponse,
     GetGameStateRequest,
@@ -17,14 +21,20 @@ import {
     GetGamesResponse,
     GetOptionsAtRequest,
     GetOptionsAtResponse,
+    ImportGameRequest,
+    ImportGameResponse,
     JoinGameRequest,
     JoinGameResponse,
     ListGamesRequest,
     ListGamesResponse,
     ListMovesRequest,
     ListMovesResponse,
+    ListMyGamesRequest,
+    ListMyGamesResponse,
     ProcessMovesRequest,
     ProcessMovesResponse,
+    RevealOrdersRequest,
+    RevealOrdersResponse,
     SimulateAttackRequest,
     SimulateAttackResponse,
     SimulateFixRequest,
@@ -50,6 +60,11 @@ export interface GamesServiceMethods {
     simulateAttack(request: SimulateAttackRequest): SimulateAttackResponse;
     simulateFix(request: SimulateFixRequest): SimulateFixResponse;
     joinGame(request: JoinGameRequest): JoinGameResponse;
+    commitOrders(request: CommitOrdersRequest): CommitOrdersResponse;
+    revealOrders(request: RevealOrdersRequest): RevealOrdersResponse;
+    listMyGames(request: ListMyGamesRequest): ListMyGamesResponse;
+    exportGame(request: ExportGameRequest): ExportGameResponse;
+    importGame(request: ImportGameRequest): ImportGameResponse;
 }
 /**
  * GamesService service client implementation
@@ -95,4 +110,19 @@ export class GamesServiceClient extends ServiceClient implements GamesServiceMet
         joinGame(request: JoinGameRequest): JoinGameResponse {
             return this.callMethodSync('gamesService.joinGame', request);
         }
+        commitOrders(request: CommitOrdersRequest): CommitOrdersResponse {
+            return this.callMethodSync('gamesService.commitOrders', request);
+        }
+        revealOrders(request: RevealOrdersRequest): RevealOrdersResponse {
+            return this.callMethodSync('gamesService.revealOrders', request);
+        }
+        listMyGames(request: ListMyGamesRequest): ListMyGamesResponse {
+            return this.callMethodSync('gamesService.listMyGames', request);
+        }
+        exportGame(request: ExportGameRequest): ExportGameResponse {
+            return this.callMethodSync('gamesService.exportGame', request);
+        }
+        importGame(request: ImportGameRequest): ImportGameResponse {
+            return this.callMethodSync('gamesService.importGame', request);
+        }
 }
@@ -0,0 +1,38 @@
+// Code generated by protoc-gen-go-wasmjs. DO NOT EDIT.
+// source: lilbattle/v1/services/jobs.proto
+
+import { ServiceClient } from '@protoc-gen-go-wasmjs/runtime';
+
+// Import TypeScript types for method signatures
+import {
+    GetJobRequest,
+    GetJobResponse,
+    ListJobsRequest,
+    ListJobsResponse,
+    RetryJobRequest,
+    RetryJobResponse,
+} from '../models/interfaces';
+/**
+ * JobsService service client interface
+ * Fully typed method signatures with TypeScript interfaces
+ */
+export interface JobsServiceMethods {
+    listJobs(request: ListJobsRequest): ListJobsResponse;
+    getJob(request: GetJobRequest): GetJobResponse;
+    retryJob(request: RetryJobRequest): RetryJobResponse;
+}
+/**
+ * JobsService service client implementation
+ * Lightweight facade that uses shared WASM bundle
+ */
+export class JobsServiceClient extends ServiceClient implements JobsServiceMethods {
+        listJobs(request: ListJobsRequest): ListJobsResponse {
+            return this.callMethodSync('jobsService.listJobs', request);
+        }
+        getJob(request: GetJobRequest): GetJobResponse {
+            return this.callMethodSync('jobsService.getJob', request);
+        }
+        retryJob(request: RetryJobRequest): RetryJobResponse {
+            return this.callMethodSync('jobsService.retryJob', request);
+        }
+}
@@ -13,8 +13,18 @@ import {
     GetWorldResponse,
     GetWorldsRequest,
     GetWorldsResponse,
+    ListDerivedWorldsRequest,
+    ListDerivedWorldsResponse,
+    ListWorldRevisionsRequest,
+    ListWorldRevisionsResponse,
     ListWorldsRequest,
     ListWorldsResponse,
+    MergeWorldUpstreamRequest,
+    MergeWorldUpstreamResponse,
+    RevertWorldRequest,
+    RevertWorldResponse,
+    SearchWorldsRequest,
+    SearchWorldsResponse,
     UpdateWorldRequest,
     UpdateWorldResponse,
 } from '../models/interfaces';
@@ -29,6 +39,11 @@ export interface WorldsServiceMethods {
     getWorld(request: GetWorldRequest): GetWorldResponse;
     deleteWorld(request: DeleteWorldRequest): DeleteWorldResponse;
     updateWorld(request: UpdateWorldRequest): UpdateWorldResponse;
+    listWorldRevisions(request: ListWorldRevisionsRequest): ListWorldRevisionsResponse;
+    revertWorld(request: RevertWorldRequest): RevertWorldResponse;
+    listDerivedWorlds(request: ListDerivedWorldsRequest): ListDerivedWorldsResponse;
+    searchWorlds(request: SearchWorldsRequest): SearchWorldsResponse;
+    mergeWorldUpstream(request: MergeWorldUpstreamRequest): MergeWorldUpstreamResponse;
 }
 /**
  * WorldsService service client implementation
@@ -53,4 +68,19 @@ export class WorldsServiceClient extends ServiceClient implements WorldsServiceM
         updateWorld(request: UpdateWorldRequest): UpdateWorldResponse {
             return this.callMethodSync('worldsService.updateWorld', request);
         }
+        listWorldRevisions(request: ListWorldRevisionsRequest): ListWorldRevisionsResponse {
+            return this.callMethodSync('worldsService.listWorldRevisions', request);
+        }
+        revertWorld(request: RevertWorldRequest): RevertWorldResponse {
+            return this.callMethodSync('worldsService.revertWorld', request);
+        }
+        listDerivedWorlds(request: ListDerivedWorldsRequest): ListDerivedWorldsResponse {
+            return this.callMethodSync('worldsService.listDerivedWorlds', request);
+        }
+        searchWorlds(request: SearchWorldsRequest): SearchWorldsResponse {
+            return this.callMethodSync('worldsService.searchWorlds', request);
+        }
+        mergeWorldUpstream(request: MergeWorldUpstreamRequest): MergeWorldUpstreamResponse {
+            return this.callMethodSync('worldsService.mergeWorldUpstream', request);
+        }
 }
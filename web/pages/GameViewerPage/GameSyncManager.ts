@@ -216,6 +216,16 @@ export class GameSyncManager {
     }
 
     private async handleUpdate(update: GameUpdate): Promise<void> {
+        // The initial state says where the server's sequence is - it restarts
+        // at 0 with the server, so track from there rather than our old one
+        if (update.initialState) {
+            this.lastSequence = Number(update.initialState.currentSequence);
+            if (update.initialState.reloadRequired) {
+                console.warn('[GameSyncManager] Missed updates are no longer available - reload required');
+                this.setState('error', 'Missed updates - reload required');
+            }
+        }
+
         // Track sequence for reconnection
         if (update.sequence > this.lastSequence) {
             this.lastSequence = update.sequence;
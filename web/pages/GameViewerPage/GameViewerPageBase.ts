@@ -37,6 +37,7 @@ import { ServerPersister } from './ServerPersister';
 import { TurnOptionsPanel } from './TurnOptionsPanel';
 import { BuildOptionsModal } from './BuildOptionsModal';
 import { GameEndedModal } from './GameEndedModal';
+import { TurnHandoverModal } from './TurnHandoverModal';
 import { GameStatePanel } from './GameStatePanel';
 import { RulesTable, TerrainStats } from '../common/RulesTable';
 import { GameSyncManager, SyncState } from './GameSyncManager';
@@ -86,6 +87,7 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
     protected turnOptionsPanel: TurnOptionsPanel;
     protected buildOptionsModal: BuildOptionsModal;
     protected gameEndedModal: GameEndedModal;
+    protected turnHandoverModal: TurnHandoverModal | null = null;
     protected gameStatePanel: GameStatePanel;
 
     // Tracks the backend's GameState.Finished flag. Set by setGameState (initial
@@ -94,6 +96,13 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
     // clicks — regardless of currentPlayer.
     private gameFinished: boolean = false;
 
+    // Hot-seat games (?mode=hotseat): every player shares this device, moves
+    // are persisted to browser local storage instead of the server, and the
+    // board is covered between turns.  The page itself is still served for a
+    // game the server knows - only play after loading is local.
+    protected readonly hotSeat: boolean = new URLSearchParams(window.location.search).get('mode') === 'hotseat';
+    private lastCurrentPlayer: number = 0;
+
     // =========================================================================
     // Abstract Methods - Must be implemented by child classes
     // =========================================================================
@@ -170,6 +179,9 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         // Create game-ended modal (always separate from layout)
         this.createGameEndedModal();
 
+        // Create the hot-seat turn handover modal (hot-seat games only)
+        this.createTurnHandoverModal();
+
         // Create game scene early if required by layout
         if (this.shouldCreateGameSceneEarly()) {
             this.createGameScene();
@@ -187,6 +199,7 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
             ...panels,
             this.buildOptionsModal,
             this.gameEndedModal,
+            this.turnHandoverModal,
         ].filter(c => c != null);
     }
 
@@ -354,6 +367,21 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         this.gameEndedModal = new GameEndedModal(modalElement, this.eventBus, true);
     }
 
+    /**
+     * Create the TurnHandoverModal for hot-seat games. Same top-level shell
+     * pattern as #game-ended-modal; not created at all for online games.
+     */
+    protected createTurnHandoverModal(): void {
+        if (!this.hotSeat) {
+            return;
+        }
+        const modalElement = document.getElementById('turn-handover-modal');
+        if (!modalElement) {
+            throw new Error('GameViewerPageBase: turn-handover-modal element not found');
+        }
+        this.turnHandoverModal = new TurnHandoverModal(modalElement, this.eventBus, true);
+    }
+
     /**
      * Create the Phaser game scene
      */
@@ -377,7 +405,26 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         this.singletonInitializerClient = new SingletonInitializerClient(this.wasmBundle);
         await this.wasmBundle.loadWasm((document.getElementById("wasmBundlePathField") as HTMLInputElement).value);
         await this.wasmBundle.waitUntilReady();
-        this.registerMovePersister();
+        if (this.hotSeat) {
+            this.useLocalPersister();
+        } else {
+            this.registerMovePersister();
+        }
+    }
+
+    /**
+     * Hot-seat games save moves to browser local storage from within WASM
+     * so no server round trip (or connection) is needed.
+     */
+    private useLocalPersister(): void {
+        const lb = (window as any).lilbattle;
+        if (!lb || typeof lb.useLocalPersister !== 'function') {
+            throw new Error('lilbattle.useLocalPersister missing — WASM bridge out of date');
+        }
+        const result = lb.useLocalPersister();
+        if (!result.success) {
+            throw new Error(`Local storage unavailable: ${result.error}`);
+        }
     }
 
     /**
@@ -425,18 +472,76 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         const viewerUserIdElement = document.getElementById('viewer-user-id') || document.getElementById('viewerUserIdInput');
         const viewerUserId = viewerUserIdElement?.textContent?.trim() || (viewerUserIdElement as HTMLInputElement)?.value || '';
 
+        let gameData = gameElement!.textContent;
+        let gameState = gameStateElement?.textContent || '{}';
+        let moveHistory = historyElement?.textContent || '{"gameId":"","groups":[]}';
+
+        // Hot-seat games resume from the locally saved copy when there is one
+        const lb = (window as any).lilbattle;
+        const local = this.hotSeat ? lb.loadLocalGame(this.currentGameId || "") : null;
+        if (local && !local.success) {
+            throw new Error(`Local game load failed: ${local.error}`);
+        }
+        if (local?.found) {
+            gameData = local.game;
+            gameState = local.state;
+            moveHistory = local.history;
+        }
+
         // Call presenter to initialize
         const response = await this.singletonInitializerClient.initializeSingleton({
             gameId: this.currentGameId || "",
-            gameData: gameElement!.textContent,
-            gameState: gameStateElement?.textContent || '{}',
-            moveHistory: historyElement?.textContent || '{"gameId":"","groups":[]}',
+            gameData: gameData,
+            gameState: gameState,
+            moveHistory: moveHistory,
             viewerUserId: viewerUserId,
         });
 
         if (!response.response!.success) {
             throw new Error(`WASM load failed: ${response.response!.error}`);
         }
+
+        // First time this game is played hot-seat: snapshot it locally so
+        // subsequent moves have a saved game to append to
+        if (local && !local.found) {
+            const saved = lb.saveLocalGame(this.currentGameId || "");
+            if (!saved.success) {
+                throw new Error(`Local game save failed: ${saved.error}`);
+            }
+        }
+    }
+
+    /**
+     * Download the locally saved hot-seat game as a save file that can be
+     * imported on another device or loaded with lib.LoadGame.
+     */
+    protected handleExportSaveClick(): void {
+        const gameId = this.currentGameId || "";
+        const result = (window as any).lilbattle.exportLocalGame(gameId);
+        if (!result.success) {
+            this.gameLogPanel?.logGameEvent(`Export failed: ${result.error}`, 'system');
+            return;
+        }
+        const blob = new Blob([result.data], { type: 'application/json' });
+        const url = URL.createObjectURL(blob);
+        const link = document.createElement('a');
+        link.href = url;
+        link.download = `${gameId}.lilbattle.json`;
+        link.click();
+        URL.revokeObjectURL(url);
+    }
+
+    /**
+     * Replace the locally saved hot-seat game with an exported save file and
+     * reload to play on from it.  Saves of another game open that game.
+     */
+    protected async handleImportSaveFile(file: File): Promise<void> {
+        const result = (window as any).lilbattle.importLocalGame(await file.text());
+        if (!result.success) {
+            this.gameLogPanel?.logGameEvent(`Import failed: ${result.error}`, 'system');
+            return;
+        }
+        window.location.href = `/games/${encodeURIComponent(result.gameId)}/view?mode=hotseat`;
     }
 
     /**
@@ -466,6 +571,30 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
             screenshotBtn.addEventListener('click', () => this.handleScreenshotClick());
         }
 
+        // Export Save button (hot-seat games only)
+        const exportSaveBtn = document.getElementById('export-save-btn');
+        if (exportSaveBtn && this.hotSeat) {
+            exportSaveBtn.classList.remove('hidden');
+            exportSaveBtn.classList.add('inline-flex');
+            exportSaveBtn.addEventListener('click', () => this.handleExportSaveClick());
+        }
+
+        // Import Save button (hot-seat games only)
+        const importSaveBtn = document.getElementById('import-save-btn');
+        const importSaveInput = document.getElementById('import-save-input') as HTMLInputElement | null;
+        if (importSaveBtn && importSaveInput && this.hotSeat) {
+            importSaveBtn.classList.remove('hidden');
+            importSaveBtn.classList.add('inline-flex');
+            importSaveBtn.addEventListener('click', () => importSaveInput.click());
+            importSaveInput.addEventListener('change', () => {
+                const file = importSaveInput.files?.[0];
+                importSaveInput.value = '';
+                if (file) {
+                    this.handleImportSaveFile(file);
+                }
+            });
+        }
+
         // Set up JoinGame callback on GameStatePanel
         if (this.gameStatePanel) {
             this.gameStatePanel.setJoinGameCallback((playerId: number) => {
@@ -571,7 +700,8 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         const endTurnBtn = document.getElementById('end-turn-btn') as HTMLButtonElement;
         if (endTurnBtn) {
             // TODO: Get the actual player ID from the game/user context
-            const isOurTurn = currentPlayer === 1;
+            // In hot-seat games every player is at this device
+            const isOurTurn = this.hotSeat || currentPlayer === 1;
             // gameFinished trumps turn — a finished game stays un-actionable
             // for every player, even the one whose turn it nominally is.
             const enabled = isOurTurn && !this.gameFinished;
@@ -852,6 +982,23 @@ export abstract class GameViewerPageBase extends BasePage implements LCMComponen
         this.applyGameEndedState(!!request.finished, request.winningPlayer || 0);
         this.updateTurnCounter(request.turnCounter);
         this.updateEndTurnButtonState(request.currentPlayer);
+        this.handOverTurn(request.currentPlayer);
         return {};
     }
+
+    /**
+     * In hot-seat games cover the board whenever the turn passes to another
+     * player so they don't see the previous player's view. The first status
+     * update only records the player since nobody is handing over yet.
+     */
+    protected handOverTurn(currentPlayer: number): void {
+        const previous = this.lastCurrentPlayer;
+        this.lastCurrentPlayer = currentPlayer;
+        if (!this.turnHandoverModal || this.gameFinished) {
+            return;
+        }
+        if (previous !== 0 && previous !== currentPlayer) {
+            this.turnHandoverModal.show(currentPlayer);
+        }
+    }
 }
@@ -0,0 +1,74 @@
+import { BaseComponent, EventBus, LCMComponent } from '@panyam/tsappkit';
+
+/**
+ * TurnHandoverModal covers the board between turns in hot-seat games, where
+ * all players share one device. GameViewerPageBase calls show(nextPlayer)
+ * when the current player changes; the overlay is opaque so the next player
+ * does not see the previous player's board until they choose to start.
+ *
+ * Unlike GameEndedModal there is no overlay-click or Escape dismissal — the
+ * only way out is the explicit "Start turn" button.
+ */
+export class TurnHandoverModal extends BaseComponent implements LCMComponent {
+    private modalOverlay: HTMLElement | null = null;
+    private modalBody: HTMLElement | null = null;
+
+    constructor(rootElement: HTMLElement, eventBus: EventBus, debugMode: boolean = false) {
+        super('turn-handover-modal', rootElement, eventBus, debugMode);
+    }
+
+    async performLocalInit(): Promise<LCMComponent[]> {
+        this.modalOverlay = this.rootElement;
+        this.modalBody = this.rootElement.querySelector('.modal-body');
+
+        if (!this.modalOverlay || !this.modalBody) {
+            throw new Error('TurnHandoverModal: required modal elements not found');
+        }
+        return [];
+    }
+
+    /**
+     * Cover the board and ask the device to be passed to the given player.
+     */
+    public show(playerId: number): void {
+        if (!this.modalBody || !this.modalOverlay) return;
+        this.renderBody(this.modalBody, playerId);
+        this.modalOverlay.classList.remove('hidden');
+        this.modalOverlay.classList.add('flex');
+    }
+
+    public hide(): void {
+        if (!this.modalOverlay) return;
+        this.modalOverlay.classList.add('hidden');
+        this.modalOverlay.classList.remove('flex');
+    }
+
+    public isVisible(): boolean {
+        return this.modalOverlay?.classList.contains('flex') ?? false;
+    }
+
+    private renderBody(target: HTMLElement, playerId: number): void {
+        target.textContent = '';
+
+        const wrap = document.createElement('div');
+        wrap.className = 'flex flex-col items-center text-center gap-4 py-6';
+
+        const h3 = document.createElement('h3');
+        h3.className = 'text-2xl font-bold text-gray-900 dark:text-white';
+        h3.textContent = `Pass the device to Player ${playerId}`;
+        wrap.appendChild(h3);
+
+        const p = document.createElement('p');
+        p.className = 'text-sm text-gray-600 dark:text-gray-300';
+        p.textContent = 'The board stays hidden until the next player is ready.';
+        wrap.appendChild(p);
+
+        const start = document.createElement('button');
+        start.className = 'mt-4 px-4 py-2 bg-green-600 text-white rounded-lg hover:bg-green-700 transition-colors';
+        start.textContent = `Start Player ${playerId}'s turn`;
+        start.addEventListener('click', () => this.hide());
+        wrap.appendChild(start);
+
+        target.appendChild(wrap);
+    }
+}
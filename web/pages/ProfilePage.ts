@@ -6,6 +6,7 @@ class ProfilePage extends BasePage {
     private nicknameForm: HTMLFormElement | null = null;
     private nicknameInput: HTMLInputElement | null = null;
     private useSuggestionBtn: HTMLButtonElement | null = null;
+    private notificationsForm: HTMLFormElement | null = null;
     private successMessage: HTMLElement | null = null;
     private errorMessage: HTMLElement | null = null;
 
@@ -16,6 +17,7 @@ class ProfilePage extends BasePage {
         this.nicknameForm = document.getElementById('nickname-form') as HTMLFormElement;
         this.nicknameInput = document.getElementById('nickname') as HTMLInputElement;
         this.useSuggestionBtn = document.getElementById('use-suggestion-btn') as HTMLButtonElement;
+        this.notificationsForm = document.getElementById('notifications-form') as HTMLFormElement;
         this.successMessage = document.querySelector('.bg-green-50, .dark\\:bg-green-900\\/20');
         this.errorMessage = document.querySelector('.bg-red-50, .dark\\:bg-red-900\\/20');
 
@@ -59,6 +61,14 @@ class ProfilePage extends BasePage {
             });
         }
 
+        // Handle notification preferences form submission
+        if (this.notificationsForm) {
+            this.notificationsForm.addEventListener('submit', async (e) => {
+                e.preventDefault();
+                await this.saveNotifications();
+            });
+        }
+
         // Handle use suggestion button
         if (this.useSuggestionBtn && this.nicknameInput) {
             this.useSuggestionBtn.addEventListener('click', () => {
@@ -187,6 +197,47 @@ class ProfilePage extends BasePage {
         }
     }
 
+    private async saveNotifications(): Promise<void> {
+        if (!this.notificationsForm) return;
+
+        const value = (id: string) => (document.getElementById(id) as HTMLInputElement | null)?.value.trim() || '';
+        const timezone = value('notify-timezone') || Intl.DateTimeFormat().resolvedOptions().timeZone;
+        const notifications = {
+            email_enabled: (document.getElementById('notify-email') as HTMLInputElement | null)?.checked || false,
+            webhook_url: value('notify-webhook'),
+            webhook_secret: value('notify-webhook-secret'),
+            digest_minutes: parseInt(value('notify-digest') || '0', 10) || 0,
+            quiet_hours_start: value('notify-quiet-start'),
+            quiet_hours_end: value('notify-quiet-end'),
+            timezone: timezone,
+            muted_games: value('notify-muted').split(',').map(g => g.trim()).filter(g => g.length > 0),
+        };
+
+        const submitButton = document.getElementById('save-notifications-btn') as HTMLButtonElement;
+        if (submitButton) submitButton.disabled = true;
+
+        try {
+            const response = await fetch('/profile', {
+                method: 'POST',
+                headers: {
+                    'Content-Type': 'application/json',
+                },
+                body: JSON.stringify({ action: 'notifications', notifications }),
+            });
+
+            if (response.ok) {
+                this.showToast('Notification preferences saved!', 'success');
+            } else {
+                const data = await response.json();
+                this.showToast(data.error || 'Failed to save notification preferences', 'error');
+            }
+        } catch (error) {
+            this.showToast('Failed to save notification preferences', 'error');
+        } finally {
+            if (submitButton) submitButton.disabled = false;
+        }
+    }
+
     public showToast(message: string, type: 'success' | 'error'): void {
         const toast = document.createElement('div');
         toast.className = `fixed bottom-4 right-4 z-50 px-6 py-4 rounded-lg shadow-lg transition-all duration-300 transform translate-y-0 ${
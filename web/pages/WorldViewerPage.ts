@@ -203,9 +203,8 @@ class WorldViewerPage extends BasePage implements LCMComponent {
             return;
         }
         
-        // Navigate to editor page with copy mode
-        const copyUrl = `/worlds/new?copy=${this.currentWorldId}`;
-        window.location.href = copyUrl;
+        // The copy records this world as its parent
+        window.location.href = `/worlds/${encodeURIComponent(this.currentWorldId)}/copy`;
     }
 }
 
@@ -31,6 +31,7 @@ func (p *GameListView) Load(r *http.Request, w http.ResponseWriter, app *goal.Ap
 		},
 		OwnerId: userId,
 	}
+	req.SortBy, req.SortOrder = listSort(p.Sort)
 	resp, err := client.ListGames(GrpcAuthContext(userId), &req)
 	if err != nil {
 		log.Println("error getting games: ", err)
@@ -7,6 +7,7 @@ import (
 
 	goal "github.com/panyam/goapplib"
 	protos "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/web/assets/themes"
 )
 
 // GamesGroup implements goal.PageGroup for /games routes.
@@ -28,6 +29,10 @@ func (g *GamesGroup) RegisterRoutes(app *goal.App[*LilBattleApp]) *http.ServeMux
 	mux.HandleFunc("/{gameId}/copy", gameCopyHandler)
 	// Screenshot handler delegates to LilBattleApp's ViewsRoot method
 	mux.HandleFunc("/{gameId}/screenshot/live", g.lilbattleApp.ViewsRoot.handleGameScreenshotLive)
+	mux.HandleFunc("/{gameId}/replay.gif", g.lilbattleApp.ViewsRoot.handleGameReplay(themes.ReplayFormatGIF))
+	mux.HandleFunc("/{gameId}/replay.png", g.lilbattleApp.ViewsRoot.handleGameReplay(themes.ReplayFormatAPNG))
+	mux.HandleFunc("/{gameId}/poster.pdf", g.lilbattleApp.ViewsRoot.handleGamePoster(themes.PosterFormatPDF))
+	mux.HandleFunc("/{gameId}/poster.svg", g.lilbattleApp.ViewsRoot.handleGamePoster(themes.PosterFormatSVG))
 	mux.HandleFunc("/{gameId}", gameActionsHandler(app))
 
 	return mux
@@ -8,6 +8,7 @@ import (
 
 	goal "github.com/panyam/goapplib"
 	"github.com/panyam/oneauth/accounts"
+	svc "github.com/turnforge/lilbattle/services"
 )
 
 // ProfilePage extends goapplib.SampleProfilePage with app-specific features.
@@ -28,6 +29,9 @@ type ProfilePage struct {
 	// Password availability
 	HasLocalAuth bool // True if user has an email identity (can potentially have password)
 	HasPassword  bool // True if user has a local channel with password set
+
+	// Turn notification preferences (stored under "notifications" in the profile)
+	Notifications svc.NotificationPrefs
 }
 
 func (p *ProfilePage) Load(r *http.Request, w http.ResponseWriter, app *goal.App[*LilBattleApp]) (err error, finished bool) {
@@ -68,6 +72,8 @@ func (p *ProfilePage) Load(r *http.Request, w http.ResponseWriter, app *goal.App
 			p.Nickname = nickname
 		}
 
+		p.Notifications = svc.NotificationPrefsFromProfile(p.Profile)
+
 		// Check if username/nickname need to be set (for highlighting)
 		p.UsernameNeeded = p.Username == ""
 		p.NicknameNeeded = p.Nickname == ""
@@ -113,9 +119,10 @@ func (p *ProfilePage) handlePost(r *http.Request, w http.ResponseWriter, app *go
 
 	// Parse request body
 	var req struct {
-		Nickname string `json:"nickname"`
-		Username string `json:"username"`
-		Action   string `json:"action"` // "nickname" or "username"
+		Nickname      string                 `json:"nickname"`
+		Username      string                 `json:"username"`
+		Notifications *svc.NotificationPrefs `json:"notifications"`
+		Action        string                 `json:"action"` // "nickname", "username" or "notifications"
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -144,6 +151,11 @@ func (p *ProfilePage) handlePost(r *http.Request, w http.ResponseWriter, app *go
 		return p.handleUsernameUpdate(r, ctx, userID, req.Username, user, profile, w)
 	}
 
+	// Handle notification preferences update
+	if req.Action == "notifications" {
+		return p.handleNotificationsUpdate(r, ctx, userID, req.Notifications, user, profile, w)
+	}
+
 	// Default: handle nickname update
 	return p.handleNicknameUpdate(r, ctx, userID, req.Nickname, user, profile, w)
 }
@@ -171,6 +183,34 @@ func (p *ProfilePage) handleNicknameUpdate(r *http.Request, ctx *LilBattleApp, u
 	return nil, true
 }
 
+// handleNotificationsUpdate saves turn notification preferences on the profile
+func (p *ProfilePage) handleNotificationsUpdate(r *http.Request, ctx *LilBattleApp, userID string, prefs *svc.NotificationPrefs, user accounts.User, profile map[string]any, w http.ResponseWriter) (error, bool) {
+	if prefs == nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Notification preferences are required"})
+		return nil, true
+	}
+	prefs.WebhookURL = strings.TrimSpace(prefs.WebhookURL)
+	if err := prefs.Validate(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return nil, true
+	}
+
+	profile[svc.NotificationProfileKey] = prefs.ToProfileValue()
+
+	if _, err := ctx.AuthService.SaveUser(r.Context(), &accounts.SaveUserRequest{User: user}); err != nil {
+		log.Printf("Error updating notification preferences for user %s: %v", userID, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Failed to save notification preferences"})
+		return nil, true
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{"success": true, "notifications": prefs})
+	return nil, true
+}
+
 // handleUsernameUpdate updates the user's username (login alias)
 // Username is stored both in profile and UsernameStore for login lookup
 func (p *ProfilePage) handleUsernameUpdate(r *http.Request, ctx *LilBattleApp, userID, newUsername string, user accounts.User, profile map[string]any, w http.ResponseWriter) (error, bool) {
@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 
 	goal "github.com/panyam/goapplib"
@@ -16,6 +17,10 @@ type WorldCreatePage struct {
 	SuggestedId  string
 	ErrorMessage string
 	WorldName    string
+
+	// World being copied (remixed), if any
+	CopyFrom    string
+	ParentWorld *protos.World
 }
 
 func (p *WorldCreatePage) Load(r *http.Request, w http.ResponseWriter, app *goal.App[*LilBattleApp]) (err error, finished bool) {
@@ -45,6 +50,7 @@ func (p *WorldCreatePage) Load(r *http.Request, w http.ResponseWriter, app *goal
 
 		worldId := r.FormValue("worldId")
 		worldName := r.FormValue("worldName")
+		copyFrom := r.FormValue("copyFrom")
 		if worldName == "" {
 			worldName = "Untitled World"
 		}
@@ -58,6 +64,8 @@ func (p *WorldCreatePage) Load(r *http.Request, w http.ResponseWriter, app *goal
 				Description: "",
 				CreatorId:   loggedInUserId,
 				Tags:        []string{},
+				// The parent's map is copied by CreateWorld
+				ParentWorldId: copyFrom,
 			},
 		}
 
@@ -66,6 +74,7 @@ func (p *WorldCreatePage) Load(r *http.Request, w http.ResponseWriter, app *goal
 			p.ErrorMessage = "Failed to create world: " + err.Error()
 			p.WorldName = worldName
 			p.SuggestedId = worldId
+			p.loadParent(r, app, copyFrom)
 			return nil, false
 		}
 
@@ -78,6 +87,7 @@ func (p *WorldCreatePage) Load(r *http.Request, w http.ResponseWriter, app *goal
 				p.ErrorMessage = "Validation error"
 			}
 			p.WorldName = worldName
+			p.loadParent(r, app, copyFrom)
 			return nil, false
 		}
 
@@ -91,9 +101,31 @@ func (p *WorldCreatePage) Load(r *http.Request, w http.ResponseWriter, app *goal
 	p.SuggestedId = r.URL.Query().Get("suggestedId")
 	p.ErrorMessage = r.URL.Query().Get("error")
 	p.WorldName = r.URL.Query().Get("worldName")
+	p.loadParent(r, app, r.URL.Query().Get("copyFrom"))
+	if p.WorldName == "" && p.ParentWorld != nil {
+		p.WorldName = p.ParentWorld.Name + " (Remix)"
+	}
 	if p.WorldName == "" {
 		p.WorldName = "Untitled World"
 	}
 
 	return nil, false
 }
+
+// loadParent loads the world being copied so the page can credit it
+func (p *WorldCreatePage) loadParent(r *http.Request, app *goal.App[*LilBattleApp], copyFrom string) {
+	if copyFrom == "" {
+		return
+	}
+	ctx := app.Context
+	client := ctx.ClientMgr.GetWorldsSvcClient()
+	resp, err := client.GetWorld(GrpcAuthContext(ctx.AuthMiddleware.GetLoggedInSubject(r)), &protos.GetWorldRequest{Id: copyFrom})
+	if err != nil {
+		log.Printf("Error fetching world %s to copy: %v", copyFrom, err)
+		p.ErrorMessage = "World to copy not found: " + copyFrom
+		return
+	}
+	p.CopyFrom = resp.World.Id
+	p.ParentWorld = resp.World
+	p.Title = "Remix " + resp.World.Name
+}
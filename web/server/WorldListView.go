@@ -3,6 +3,7 @@ package server
 import (
 	"log"
 	"net/http"
+	"strconv"
 
 	goal "github.com/panyam/goapplib"
 	protos "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
@@ -14,6 +15,17 @@ type WorldListView struct {
 
 	Worlds     []*protos.World
 	ActionMode string // "manage" or "select"
+
+	// Facets searched on (the tag, difficulty and players query params)
+	// and the facets of the results.  Searching lists every world rather
+	// than just the user's.
+	Searching    bool
+	Tag          string
+	Difficulty   string
+	Players      int
+	Tags         []*protos.SearchFacetCount
+	Difficulties []*protos.SearchFacetCount
+	PlayerCounts []*protos.SearchFacetCount
 }
 
 func (p *WorldListView) Load(r *http.Request, w http.ResponseWriter, app *goal.App[*LilBattleApp]) (err error, finished bool) {
@@ -21,6 +33,16 @@ func (p *WorldListView) Load(r *http.Request, w http.ResponseWriter, app *goal.A
 	p.WithPagination.Load(r, w, nil)
 	p.WithFiltering.Load(r, w, nil)
 
+	query := r.URL.Query()
+	p.Tag = query.Get("tag")
+	p.Difficulty = query.Get("difficulty")
+	p.Players, _ = strconv.Atoi(query.Get("players"))
+	p.Searching = p.Query != "" || p.Tag != "" || p.Difficulty != "" || p.Players > 0
+	if p.Searching {
+		// Results are by relevance (or popularity) unless a sort is picked
+		return p.search(r, w, app, query.Get("sort"))
+	}
+
 	// Override defaults for this view
 	if p.ViewMode == "" {
 		p.ViewMode = "grid"
@@ -43,6 +65,7 @@ func (p *WorldListView) Load(r *http.Request, w http.ResponseWriter, app *goal.A
 		},
 		OwnerId: userId,
 	}
+	req.SortBy, req.SortOrder = listSort(p.Sort)
 	resp, err := client.ListWorlds(GrpcAuthContext(userId), &req)
 	if err != nil {
 		log.Println("error getting worlds: ", err)
@@ -57,3 +80,48 @@ func (p *WorldListView) Load(r *http.Request, w http.ResponseWriter, app *goal.A
 	}
 	return nil, false
 }
+
+// search loads the worlds matching the query and facets with SearchWorlds
+func (p *WorldListView) search(r *http.Request, w http.ResponseWriter, app *goal.App[*LilBattleApp], sort string) (err error, finished bool) {
+	if p.ViewMode == "" {
+		p.ViewMode = "grid"
+	}
+	if p.ActionMode == "" {
+		p.ActionMode = "manage"
+	}
+
+	ctx := app.Context
+	userId := ctx.AuthMiddleware.GetLoggedInSubject(r)
+	client := ctx.ClientMgr.GetWorldsSvcClient()
+
+	req := protos.SearchWorldsRequest{
+		Query: p.Query,
+		Pagination: &protos.Pagination{
+			PageOffset: int32(p.Offset()),
+			PageSize:   int32(p.PageSize),
+		},
+		MinPlayers: int32(p.Players),
+		MaxPlayers: int32(p.Players),
+	}
+	if p.Tag != "" {
+		req.Tags = []string{p.Tag}
+	}
+	if p.Difficulty != "" {
+		req.Difficulties = []string{p.Difficulty}
+	}
+	req.SortBy, req.SortOrder = searchSort(sort)
+	resp, err := client.SearchWorlds(GrpcAuthContext(userId), &req)
+	if err != nil {
+		log.Println("error searching worlds: ", err)
+		return HandleGRPCError(err, w, r, app)
+	}
+	p.Worlds = resp.Items
+	p.Tags, p.Difficulties, p.PlayerCounts = resp.Tags, resp.Difficulties, resp.PlayerCounts
+	p.HasPrevPage = p.CurrentPage > 0
+	if resp.Pagination != nil {
+		p.HasNextPage = resp.Pagination.HasMore
+		p.TotalCount = int(resp.Pagination.TotalResults)
+		p.EvalPages()
+	}
+	return nil, false
+}
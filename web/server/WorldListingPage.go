@@ -30,7 +30,11 @@ func (m *WorldListingPage) Load(r *http.Request, w http.ResponseWriter, app *goa
 	}
 
 	// Build listing data for EntityListing template
-	m.ListingData = goal.NewEntityListingData[*protos.World]("My Worlds", "/worlds").
+	title := "My Worlds"
+	if m.WorldListView.Searching {
+		title = "Search Results"
+	}
+	m.ListingData = goal.NewEntityListingData[*protos.World](title, "/worlds").
 		WithCreate("/worlds/new", "Create New World").
 		WithView("/worlds/%s/view").
 		WithEdit("/worlds/%s/edit").
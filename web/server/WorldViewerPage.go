@@ -1,11 +1,13 @@
 package server
 
 import (
+	"context"
 	"log"
 	"net/http"
 
 	goal "github.com/panyam/goapplib"
 	protos "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	v1s "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/services"
 )
 
 type WorldViewerPage struct {
@@ -14,8 +16,17 @@ type WorldViewerPage struct {
 	World     *protos.World
 	WorldData *protos.WorldData
 	WorldId   string
+
+	// Attribution - the world this one was copied from (nil if it is gone
+	// or this is an original) and the first page of worlds copied from it
+	ParentWorld *protos.World
+	Remixes     []*protos.World
+	RemixCount  int
 }
 
+// remixesShown is how many remixes the viewer lists
+const remixesShown = 6
+
 func (p *WorldViewerPage) Load(r *http.Request, w http.ResponseWriter, app *goal.App[*LilBattleApp]) (err error, finished bool) {
 	p.WorldId = r.PathValue("worldId")
 	if p.WorldId == "" {
@@ -48,7 +59,27 @@ func (p *WorldViewerPage) Load(r *http.Request, w http.ResponseWriter, app *goal
 		} else {
 			p.MetaDescription = "View " + p.World.Name + " - a custom world for LilBattle."
 		}
+		p.loadLineage(GrpcAuthContext(loggedInUserId), client)
 	}
 
 	return nil, false
 }
+
+// loadLineage loads the worlds to credit.  Failures only hide attribution.
+func (p *WorldViewerPage) loadLineage(ctx context.Context, client v1s.WorldsServiceClient) {
+	if parentId := p.World.ParentWorldId; parentId != "" {
+		if parent, err := client.GetWorld(ctx, &protos.GetWorldRequest{Id: parentId}); err == nil {
+			p.ParentWorld = parent.World
+		}
+	}
+	derived, err := client.ListDerivedWorlds(ctx, &protos.ListDerivedWorldsRequest{
+		WorldId:    p.World.Id,
+		Pagination: &protos.Pagination{PageSize: remixesShown},
+	})
+	if err != nil {
+		log.Printf("Error listing remixes of world %s: %v", p.World.Id, err)
+		return
+	}
+	p.Remixes = derived.Items
+	p.RemixCount = max(int(derived.Pagination.GetTotalResults()), len(derived.Items))
+}
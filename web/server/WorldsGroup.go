@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 
 	goal "github.com/panyam/goapplib"
 	protos "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/web/assets/themes"
 )
 
 // Note: context is still used by deleteWorldHandler
@@ -35,6 +37,8 @@ func (g *WorldsGroup) RegisterRoutes(app *goal.App[*LilBattleApp]) *http.ServeMu
 	mux.HandleFunc("/{worldId}/copy", worldCopyHandler)
 	// Screenshot handler delegates to LilBattleApp's ViewsRoot method
 	mux.HandleFunc("/{worldId}/screenshot/live", g.lilbattleApp.ViewsRoot.handleWorldScreenshotLive)
+	mux.HandleFunc("/{worldId}/poster.pdf", g.lilbattleApp.ViewsRoot.handleWorldPoster(themes.PosterFormatPDF))
+	mux.HandleFunc("/{worldId}/poster.svg", g.lilbattleApp.ViewsRoot.handleWorldPoster(themes.PosterFormatSVG))
 	mux.HandleFunc("/{worldId}", worldActionsHandler(app))
 
 	return mux
@@ -53,9 +57,11 @@ func worldStartHandler(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, redirectURL, http.StatusFound)
 }
 
+// worldCopyHandler starts a remix of a world - the create page records the
+// world as the new world's parent
 func worldCopyHandler(w http.ResponseWriter, r *http.Request) {
 	worldId := r.PathValue("worldId")
-	http.Redirect(w, r, fmt.Sprintf("/worlds/new?copyFrom=%s", worldId), http.StatusFound)
+	http.Redirect(w, r, fmt.Sprintf("/worlds/create?copyFrom=%s", url.QueryEscape(worldId)), http.StatusFound)
 }
 
 func worldActionsHandler(app *goal.App[*LilBattleApp]) http.HandlerFunc {
@@ -74,6 +74,10 @@ func (a *ApiHandler) Init() error {
 	a.mux.HandleFunc("/ws/v1/sync/games/{game_id}/subscribe", gohttp.WSServe(wsHandler, nil))
 	log.Println("Registered GameSync WebSocket handler at /ws/v1/sync/games/{game_id}/subscribe")
 
+	// SSE endpoint for the same stream - for curl/bots and networks that block WebSockets
+	a.mux.HandleFunc("GET /sse/v1/sync/games/{game_id}/subscribe", a.serveGameSyncSSE)
+	log.Println("Registered GameSync SSE handler at /sse/v1/sync/games/{game_id}/subscribe")
+
 	return a.setupConnectHandlers()
 }
 
@@ -134,6 +138,13 @@ func (out *ApiHandler) setupConnectHandlers() error {
 	out.mux.Handle(gameSyncConnectPath, wrapWithAuth(gameSyncConnectHandler))
 	log.Printf("Registered GameSync Connect handler at: %s", gameSyncConnectPath)
 
+	// JobsService for operators - the gRPC server only serves it when the
+	// job runner is enabled
+	jobsAdapter := NewConnectJobsServiceAdapter(out.ClientMgr.GetJobsSvcClient())
+	jobsConnectPath, jobsConnectHandler := v1connect.NewJobsServiceHandler(jobsAdapter)
+	out.mux.Handle(jobsConnectPath, wrapWithAuth(jobsConnectHandler))
+	log.Printf("Registered Jobs Connect handler at: %s", jobsConnectPath)
+
 	return nil
 }
 
@@ -240,5 +251,11 @@ func (web *ApiHandler) createSvcMux(grpc_addr string) (*runtime.ServeMux, error)
 		return nil, err
 	}
 
+	err = v1s.RegisterJobsServiceHandlerFromEndpoint(ctx, svcMux, grpc_addr, opts)
+	if err != nil {
+		log.Fatal("Unable to register jobs service: ", err)
+		return nil, err
+	}
+
 	return svcMux, nil // Return nil error on success
 }
@@ -34,12 +34,27 @@ func newEmailSender() localauth.SendEmail {
 	return NewResendEmailSender(apiKey, fromAddr)
 }
 
-func setupAuthService(session *scs.SessionManager) (*goalservices.AuthService, accounts.UsernameStore, *httpauth.OneAuth) {
-	// Initialize authentication
+// UserStoragePath returns the directory where user accounts are stored.
+func UserStoragePath() string {
 	storagePath := os.Getenv("LILBATTLE_USER_STORAGE_PATH")
 	if storagePath == "" {
 		storagePath = filepath.Join(os.Getenv("HOME"), "dev-app-data", "lilbattle", "storage")
 	}
+	return storagePath
+}
+
+// BaseURL returns the public base URL used in emails and links.
+func BaseURL() string {
+	baseURL := os.Getenv("LILBATTLE_BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:8080"
+	}
+	return baseURL
+}
+
+func setupAuthService(session *scs.SessionManager) (*goalservices.AuthService, accounts.UsernameStore, *httpauth.OneAuth) {
+	// Initialize authentication
+	storagePath := UserStoragePath()
 	authService := goalservices.NewAuthService(storagePath)
 
 	// Create UsernameStore for username → userID mapping
@@ -63,10 +78,7 @@ func setupAuthService(session *scs.SessionManager) (*goalservices.AuthService, a
 	oneauth.AddAuth("/twitter", NewTwitterOAuth2("", "", "", bridge.SaveUserAndRedirect).Handler())
 
 	// Get base URL for verification/reset links
-	baseURL := os.Getenv("LILBATTLE_BASE_URL")
-	if baseURL == "" {
-		baseURL = "http://localhost:8080"
-	}
+	baseURL := BaseURL()
 
 	// Create credentials validator that supports email OR username login
 	// - If input contains "@", treats as email
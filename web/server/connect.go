@@ -193,6 +193,51 @@ func (a *ConnectGamesServiceAdapter) JoinGame(ctx context.Context, req *connect.
 	return connect.NewResponse(resp), nil
 }
 
+func (a *ConnectGamesServiceAdapter) CommitOrders(ctx context.Context, req *connect.Request[v1.CommitOrdersRequest]) (*connect.Response[v1.CommitOrdersResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.CommitOrders(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) RevealOrders(ctx context.Context, req *connect.Request[v1.RevealOrdersRequest]) (*connect.Response[v1.RevealOrdersResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.RevealOrders(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) ListMyGames(ctx context.Context, req *connect.Request[v1.ListMyGamesRequest]) (*connect.Response[v1.ListMyGamesResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.ListMyGames(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) ExportGame(ctx context.Context, req *connect.Request[v1.ExportGameRequest]) (*connect.Response[v1.ExportGameResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.ExportGame(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) ImportGame(ctx context.Context, req *connect.Request[v1.ImportGameRequest]) (*connect.Response[v1.ImportGameResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.ImportGame(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 /** If you had a streamer than you can use this to act as a bridge between websocket and grpc streams
 func (a *ConnectGameServiceAdapter) StreamSomeThing(ctx context.Context, req *connect.Request[v1.StreamSomeThingRequest], stream *connect.ServerStream[v1.StreamSomeThingResponse]) error {
 	// Bridge Connect's server-streaming handle to the gRPC ServerStreamingServer
@@ -267,6 +312,51 @@ func (a *ConnectWorldsServiceAdapter) UpdateWorld(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
+func (a *ConnectWorldsServiceAdapter) ListWorldRevisions(ctx context.Context, req *connect.Request[v1.ListWorldRevisionsRequest]) (*connect.Response[v1.ListWorldRevisionsResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.ListWorldRevisions(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectWorldsServiceAdapter) RevertWorld(ctx context.Context, req *connect.Request[v1.RevertWorldRequest]) (*connect.Response[v1.RevertWorldResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.RevertWorld(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectWorldsServiceAdapter) ListDerivedWorlds(ctx context.Context, req *connect.Request[v1.ListDerivedWorldsRequest]) (*connect.Response[v1.ListDerivedWorldsResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.ListDerivedWorlds(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectWorldsServiceAdapter) MergeWorldUpstream(ctx context.Context, req *connect.Request[v1.MergeWorldUpstreamRequest]) (*connect.Response[v1.MergeWorldUpstreamResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.MergeWorldUpstream(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectWorldsServiceAdapter) SearchWorlds(ctx context.Context, req *connect.Request[v1.SearchWorldsRequest]) (*connect.Response[v1.SearchWorldsResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.SearchWorlds(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // ConnectGameSyncServiceAdapter adapts the gRPC GameSyncService to Connect's interface
 // This enables multiplayer sync via HTTP/Connect for frontend clients
 type ConnectGameSyncServiceAdapter struct {
@@ -306,3 +396,39 @@ func (a *ConnectGameSyncServiceAdapter) Broadcast(ctx context.Context, req *conn
 	}
 	return connect.NewResponse(resp), nil
 }
+
+// ConnectJobsServiceAdapter adapts the gRPC JobsService to Connect's interface
+type ConnectJobsServiceAdapter struct {
+	client v1s.JobsServiceClient
+}
+
+func NewConnectJobsServiceAdapter(client v1s.JobsServiceClient) *ConnectJobsServiceAdapter {
+	return &ConnectJobsServiceAdapter{client: client}
+}
+
+func (a *ConnectJobsServiceAdapter) ListJobs(ctx context.Context, req *connect.Request[v1.ListJobsRequest]) (*connect.Response[v1.ListJobsResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.ListJobs(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectJobsServiceAdapter) GetJob(ctx context.Context, req *connect.Request[v1.GetJobRequest]) (*connect.Response[v1.GetJobResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.GetJob(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectJobsServiceAdapter) RetryJob(ctx context.Context, req *connect.Request[v1.RetryJobRequest]) (*connect.Response[v1.RetryJobResponse], error) {
+	ctx = injectAuthMetadata(ctx)
+	resp, err := a.client.RetryJob(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
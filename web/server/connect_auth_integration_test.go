@@ -74,6 +74,31 @@ func (r *recordingWorldsClient) UpdateWorld(ctx context.Context, in *v1.UpdateWo
 	return &v1.UpdateWorldResponse{}, nil
 }
 
+func (r *recordingWorldsClient) ListWorldRevisions(ctx context.Context, in *v1.ListWorldRevisionsRequest, opts ...grpc.CallOption) (*v1.ListWorldRevisionsResponse, error) {
+	r.recordUserID(ctx)
+	return &v1.ListWorldRevisionsResponse{}, nil
+}
+
+func (r *recordingWorldsClient) RevertWorld(ctx context.Context, in *v1.RevertWorldRequest, opts ...grpc.CallOption) (*v1.RevertWorldResponse, error) {
+	r.recordUserID(ctx)
+	return &v1.RevertWorldResponse{}, nil
+}
+
+func (r *recordingWorldsClient) ListDerivedWorlds(ctx context.Context, in *v1.ListDerivedWorldsRequest, opts ...grpc.CallOption) (*v1.ListDerivedWorldsResponse, error) {
+	r.recordUserID(ctx)
+	return &v1.ListDerivedWorldsResponse{}, nil
+}
+
+func (r *recordingWorldsClient) MergeWorldUpstream(ctx context.Context, in *v1.MergeWorldUpstreamRequest, opts ...grpc.CallOption) (*v1.MergeWorldUpstreamResponse, error) {
+	r.recordUserID(ctx)
+	return &v1.MergeWorldUpstreamResponse{}, nil
+}
+
+func (r *recordingWorldsClient) SearchWorlds(ctx context.Context, in *v1.SearchWorldsRequest, opts ...grpc.CallOption) (*v1.SearchWorldsResponse, error) {
+	r.recordUserID(ctx)
+	return &v1.SearchWorldsResponse{}, nil
+}
+
 // Compile-time check that recordingWorldsClient implements WorldsServiceClient.
 var _ v1s.WorldsServiceClient = (*recordingWorldsClient)(nil)
 
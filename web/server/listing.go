@@ -0,0 +1,37 @@
+package server
+
+// listSort maps the sort options of the list page filter panels to the
+// sort_by/sort_order of ListGames/ListWorlds requests.  Unknown options use
+// the service default.
+func listSort(sort string) (sortBy, sortOrder string) {
+	switch sort {
+	case "modified_desc":
+		return "updated_at", "desc"
+	case "modified_asc":
+		return "updated_at", "asc"
+	case "created_desc":
+		return "created_at", "desc"
+	case "created_asc":
+		return "created_at", "asc"
+	case "title_asc":
+		return "name", "asc"
+	case "title_desc":
+		return "name", "desc"
+	}
+	return "", ""
+}
+
+// searchSort maps the sort options of the world list page to the
+// sort_by/sort_order of SearchWorlds requests.  No option sorts by
+// relevance (or popularity without a query) as does an option search cannot
+// sort by.
+func searchSort(sort string) (sortBy, sortOrder string) {
+	if sort == "popular_desc" {
+		return "popularity", "desc"
+	}
+	sortBy, sortOrder = listSort(sort)
+	if sortBy == "created_at" {
+		return "", ""
+	}
+	return sortBy, sortOrder
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	goalservices "github.com/panyam/goapplib/services"
+	"github.com/panyam/oneauth/accounts"
+	svc "github.com/turnforge/lilbattle/services"
+)
+
+// authProfileProvider implements services.UserProfileProvider on top of the
+// auth service user store so notification preferences live on the profile.
+type authProfileProvider struct {
+	authService *goalservices.AuthService
+}
+
+func (p *authProfileProvider) GetUserProfile(ctx context.Context, userId string) (map[string]any, error) {
+	resp, err := p.authService.GetUserById(ctx, &accounts.GetUserByIDRequest{UserID: userId})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.User == nil {
+		return nil, fmt.Errorf("user %s not found", userId)
+	}
+	return resp.User.Profile(), nil
+}
+
+func newNotificationEmailSender() svc.NotificationEmailSender {
+	apiKey := os.Getenv("RESEND_API_KEY")
+	if apiKey == "" {
+		log.Println("RESEND_API_KEY not set, using console notification sender")
+		return &svc.ConsoleNotificationSender{}
+	}
+	fromAddr := os.Getenv("RESEND_FROM_EMAIL")
+	if fromAddr == "" {
+		fromAddr = "LilBattle <noreply@lilbattle.com>"
+	}
+	return NewResendEmailSender(apiKey, fromAddr)
+}
+
+// NewTurnNotifier creates and starts a turn notifier that reads preferences
+// from the user store and sends emails via Resend (or the console locally).
+// Set DISABLE_TURN_NOTIFICATIONS=true to turn notifications off.
+func NewTurnNotifier() *svc.TurnNotifier {
+	if os.Getenv("DISABLE_TURN_NOTIFICATIONS") == "true" {
+		return nil
+	}
+	profiles := &authProfileProvider{authService: goalservices.NewAuthService(UserStoragePath())}
+	notifier := svc.NewTurnNotifier(profiles, newNotificationEmailSender(), BaseURL())
+	notifier.Start()
+	return notifier
+}
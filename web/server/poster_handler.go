@@ -0,0 +1,127 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	protos "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/lib"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// handleWorldPoster renders a world as a printable poster
+// GET /worlds/{worldId}/poster.pdf?theme=default&paper=a4&hex=20&landscape=true
+// GET /worlds/{worldId}/poster.svg
+func (r *RootViewsHandler) handleWorldPoster(format string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		worldId := req.PathValue("worldId")
+		if worldId == "" {
+			http.Error(w, "World ID is required", http.StatusBadRequest)
+			return
+		}
+		options, err := posterOptions(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		loggedInUserId := r.LilBattleApp.AuthMiddleware.GetLoggedInSubject(req)
+		client := r.LilBattleApp.ClientMgr.GetWorldsSvcClient()
+		resp, err := client.GetWorld(GrpcAuthContext(loggedInUserId), &protos.GetWorldRequest{Id: worldId})
+		if err != nil {
+			log.Printf("Failed to get world %s: %v", worldId, err)
+			http.Error(w, "World not found", http.StatusNotFound)
+			return
+		}
+		if resp.World == nil || resp.WorldData == nil {
+			http.Error(w, "World has no map data", http.StatusNotFound)
+			return
+		}
+
+		if options.Title == "" {
+			options.Title = resp.World.Name
+		}
+		r.writePoster(w, resp.WorldData, worldId, format, req.URL.Query().Get("theme"), options)
+	}
+}
+
+// handleGamePoster renders a game's current position as a printable poster
+// GET /games/{gameId}/poster.pdf?theme=default&paper=a3
+// GET /games/{gameId}/poster.svg
+func (r *RootViewsHandler) handleGamePoster(format string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		gameId := req.PathValue("gameId")
+		if gameId == "" {
+			http.Error(w, "Game ID is required", http.StatusBadRequest)
+			return
+		}
+		options, err := posterOptions(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		loggedInUserId := r.LilBattleApp.AuthMiddleware.GetLoggedInSubject(req)
+		client := r.LilBattleApp.ClientMgr.GetGamesSvcClient()
+		resp, err := client.GetGame(GrpcAuthContext(loggedInUserId), &protos.GetGameRequest{Id: gameId})
+		if err != nil {
+			log.Printf("Failed to get game %s: %v", gameId, err)
+			http.Error(w, "Game not found", http.StatusNotFound)
+			return
+		}
+		if resp.Game == nil || resp.State == nil || resp.State.WorldData == nil {
+			http.Error(w, "Game has no state data", http.StatusNotFound)
+			return
+		}
+
+		// Show the position as it stands: crossings, health and who has moved
+		options.Render = lib.ProtoToRuntimeGame(resp.Game, resp.State).RenderOptions()
+		if options.Title == "" {
+			options.Title = fmt.Sprintf("%s - turn %d", resp.Game.Name, resp.State.TurnCounter)
+		}
+		r.writePoster(w, resp.State.WorldData, gameId, format, req.URL.Query().Get("theme"), options)
+	}
+}
+
+// posterOptions reads the paper, hex size (mm), landscape, title and legend
+// query parameters
+func posterOptions(req *http.Request) (*themes.PosterOptions, error) {
+	query := req.URL.Query()
+	options := themes.DefaultPosterOptions()
+	options.Title = query.Get("title")
+	if paper := query.Get("paper"); paper != "" {
+		if _, ok := themes.PaperSizes[paper]; !ok {
+			return nil, fmt.Errorf("unknown paper size: %s", paper)
+		}
+		options.Paper = paper
+	}
+	if hex := query.Get("hex"); hex != "" {
+		size, err := strconv.ParseFloat(hex, 64)
+		if err != nil || size < 5 || size > 100 {
+			return nil, fmt.Errorf("hex must be between 5 and 100 millimetres")
+		}
+		options.HexSize = size
+	}
+	options.Landscape = query.Get("landscape") == "true"
+	options.ShowLegend = query.Get("legend") != "false"
+	return options, nil
+}
+
+// writePoster renders and sends a poster as a download
+func (r *RootViewsHandler) writePoster(w http.ResponseWriter, worldData *protos.WorldData, id, format, themeName string, options *themes.PosterOptions) {
+	data, contentType, err := services.RenderWorldPoster(worldData, themeName, format, options)
+	if err != nil {
+		log.Printf("Failed to render poster of %s: %v", id, err)
+		http.Error(w, "Failed to render poster", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", id+"-poster."+format))
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	protos "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"github.com/turnforge/lilbattle/services"
+	"github.com/turnforge/lilbattle/web/assets/themes"
+)
+
+// handleGameReplay renders a game's move history as an animation
+// GET /games/{gameId}/replay.gif?theme=default&delay=800
+// GET /games/{gameId}/replay.png (APNG)
+func (r *RootViewsHandler) handleGameReplay(format string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		gameId := req.PathValue("gameId")
+		if gameId == "" {
+			http.Error(w, "Game ID is required", http.StatusBadRequest)
+			return
+		}
+
+		options := &themes.ReplayOptions{HoldLast: 2}
+		if delay := req.URL.Query().Get("delay"); delay != "" {
+			ms, err := strconv.Atoi(delay)
+			if err != nil || ms < 50 || ms > 10000 {
+				http.Error(w, "delay must be between 50 and 10000 milliseconds", http.StatusBadRequest)
+				return
+			}
+			options.FrameDelay = time.Duration(ms) * time.Millisecond
+		}
+
+		// Get the game and its history from the service
+		loggedInUserId := r.LilBattleApp.AuthMiddleware.GetLoggedInSubject(req)
+		client := r.LilBattleApp.ClientMgr.GetGamesSvcClient()
+		resp, err := client.GetGame(GrpcAuthContext(loggedInUserId), &protos.GetGameRequest{Id: gameId})
+		if err != nil {
+			log.Printf("Failed to get game %s: %v", gameId, err)
+			http.Error(w, "Game not found", http.StatusNotFound)
+			return
+		}
+
+		if resp.Game == nil || resp.State == nil || resp.State.WorldData == nil {
+			http.Error(w, "Game has no state data", http.StatusNotFound)
+			return
+		}
+
+		// A replay only changes when the game is saved, so it is cached and
+		// tagged by the state's version
+		themeName := req.URL.Query().Get("theme")
+		key := fmt.Sprintf("%s/%d/%s/%s/%d", gameId, resp.State.Version, format, themeName, options.FrameDelay)
+		sum := sha256.Sum256([]byte(key))
+		etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "private, no-cache") // Revalidated as replays grow with every move
+		if strings.Contains(req.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		replay, ok := gameReplays.get(key)
+		if !ok {
+			imageBytes, contentType, err := services.RenderGameReplay(resp.Game, resp.State, resp.History, themeName, format, options)
+			if err != nil {
+				log.Printf("Failed to render replay of game %s: %v", gameId, err)
+				http.Error(w, "Failed to render replay", http.StatusInternalServerError)
+				return
+			}
+			replay = &cachedReplay{data: imageBytes, contentType: contentType}
+			gameReplays.put(key, replay)
+		}
+
+		w.Header().Set("Content-Type", replay.contentType)
+		w.WriteHeader(http.StatusOK)
+		w.Write(replay.data)
+	}
+}
+
+// maxCachedReplayBytes bounds the rendered replays kept in memory
+const maxCachedReplayBytes = 64 << 20
+
+var gameReplays = &replayCache{entries: map[string]*cachedReplay{}}
+
+type cachedReplay struct {
+	data        []byte
+	contentType string
+}
+
+// replayCache keeps the most recently rendered replays, dropping the
+// oldest once they add up to more than maxCachedReplayBytes
+type replayCache struct {
+	mu      sync.Mutex
+	entries map[string]*cachedReplay
+	order   []string
+	size    int
+}
+
+func (c *replayCache) get(key string) (*cachedReplay, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	replay, ok := c.entries[key]
+	if ok {
+		c.order = append(slices.DeleteFunc(c.order, func(k string) bool { return k == key }), key)
+	}
+	return replay, ok
+}
+
+func (c *replayCache) put(key string, replay *cachedReplay) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if old, ok := c.entries[key]; ok {
+		c.size -= len(old.data)
+		c.order = slices.DeleteFunc(c.order, func(k string) bool { return k == key })
+	}
+	c.entries[key] = replay
+	c.order = append(c.order, key)
+	c.size += len(replay.data)
+	for c.size > maxCachedReplayBytes && len(c.order) > 1 {
+		oldest := c.order[0]
+		c.order = c.order[1:]
+		c.size -= len(c.entries[oldest].data)
+		delete(c.entries, oldest)
+	}
+}
@@ -66,3 +66,20 @@ func (r *ResendEmailSender) SendPasswordResetEmail(to string, resetLink string)
 	log.Printf("Password reset email sent to %s (id: %s)", to, sent.Id)
 	return nil
 }
+
+// SendNotificationEmail implements services.NotificationEmailSender for turn notifications
+func (r *ResendEmailSender) SendNotificationEmail(to string, subject string, htmlBody string) error {
+	params := &resend.SendEmailRequest{
+		From:    r.fromAddr,
+		To:      []string{to},
+		Subject: subject,
+		Html:    htmlBody,
+	}
+
+	sent, err := r.client.Emails.Send(params)
+	if err != nil {
+		return fmt.Errorf("resend: failed to send notification email to %s: %w", to, err)
+	}
+	log.Printf("Notification email sent to %s (id: %s)", to, sent.Id)
+	return nil
+}
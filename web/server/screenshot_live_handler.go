@@ -39,7 +39,9 @@ func (r *RootViewsHandler) handleWorldScreenshotLive(w http.ResponseWriter, req
 	}
 
 	// Render the screenshot
-	r.renderScreenshot(w, resp.WorldData.TilesMap, resp.WorldData.UnitsMap, themeName)
+	options := lib.DefaultRenderOptions()
+	options.Crossings = resp.WorldData.Crossings
+	r.renderScreenshot(w, resp.WorldData.TilesMap, resp.WorldData.UnitsMap, themeName, options)
 }
 
 // handleGameScreenshotLive dynamically renders a game screenshot using the specified theme
@@ -71,12 +73,13 @@ func (r *RootViewsHandler) handleGameScreenshotLive(w http.ResponseWriter, req *
 		return
 	}
 
-	// Render the screenshot
-	r.renderScreenshot(w, resp.State.WorldData.TilesMap, resp.State.WorldData.UnitsMap, themeName)
+	// Render the screenshot the way players see the game
+	options := lib.ProtoToRuntimeGame(resp.Game, resp.State).RenderOptions()
+	r.renderScreenshot(w, resp.State.WorldData.TilesMap, resp.State.WorldData.UnitsMap, themeName, options)
 }
 
 // renderScreenshot renders tiles and units using the specified theme
-func (r *RootViewsHandler) renderScreenshot(w http.ResponseWriter, tiles map[string]*protos.Tile, units map[string]*protos.Unit, themeName string) {
+func (r *RootViewsHandler) renderScreenshot(w http.ResponseWriter, tiles map[string]*protos.Tile, units map[string]*protos.Unit, themeName string, options *lib.RenderOptions) {
 	// Create theme
 	re := lib.DefaultRulesEngine()
 	theme, err := themes.CreateTheme(themeName, re.GetCityTerrains())
@@ -95,7 +98,7 @@ func (r *RootViewsHandler) renderScreenshot(w http.ResponseWriter, tiles map[str
 	}
 
 	// Render the image
-	imageBytes, contentType, err := renderer.Render(tiles, units, nil)
+	imageBytes, contentType, err := renderer.Render(tiles, units, options)
 	if err != nil {
 		log.Printf("Failed to render screenshot: %v", err)
 		http.Error(w, "Failed to render screenshot", http.StatusInternalServerError)
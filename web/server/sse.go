@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	oagrpc "github.com/panyam/oneauth/grpc"
+	models "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// sseHeartbeatInterval keeps idle connections alive through proxies that
+// drop quiet streams.
+const sseHeartbeatInterval = 15 * time.Second
+
+// sseRetryMillis is the reconnect delay suggested to EventSource clients.
+const sseRetryMillis = 3000
+
+// serveGameSyncSSE streams GameUpdates for a game as Server-Sent Events.
+//
+// This is the same stream as the WebSocket endpoint but over plain HTTP so
+// dashboards, bots and curl can follow a game where WebSockets are blocked:
+//
+//	curl -N http://localhost:8080/api/sse/v1/sync/games/{game_id}/subscribe
+//
+// Each update is sent with its sequence number as the event id. On reconnect
+// browsers send it back as Last-Event-ID, which maps to from_sequence so the
+// sync service replays anything missed. A from_sequence query param is also
+// accepted for clients that can't set headers.
+func (a *ApiHandler) serveGameSyncSSE(w http.ResponseWriter, r *http.Request) {
+	rc := http.NewResponseController(w)
+
+	req := &models.SubscribeRequest{
+		GameId:   r.PathValue("game_id"),
+		PlayerId: r.URL.Query().Get("player_id"),
+	}
+	fromSeq := r.Header.Get("Last-Event-ID")
+	if fromSeq == "" {
+		fromSeq = r.URL.Query().Get("from_sequence")
+	}
+	if fromSeq != "" {
+		seq, err := strconv.ParseInt(fromSeq, 10, 64)
+		if err != nil || seq < 0 {
+			http.Error(w, "Invalid Last-Event-ID / from_sequence", http.StatusBadRequest)
+			return
+		}
+		req.FromSequence = seq
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	if a.AuthMiddleware != nil {
+		if userID := a.AuthMiddleware.GetLoggedInSubject(r); userID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, oagrpc.DefaultMetadataKeySubject, userID)
+		}
+	}
+
+	stream, err := a.ClientMgr.GetGameSyncSvcClient().Subscribe(ctx, req)
+	if err != nil {
+		log.Printf("SSE subscribe failed for game %s: %v", req.GameId, err)
+		http.Error(w, "Failed to subscribe", http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // disable nginx buffering
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", sseRetryMillis)
+	if err := rc.Flush(); err != nil {
+		log.Printf("SSE streaming not supported: %v", err)
+		return
+	}
+
+	streamGameUpdatesSSE(ctx, w, rc, req.GameId, stream)
+}
+
+// gameUpdateStream is the receiving side of a Subscribe stream
+type gameUpdateStream interface {
+	Recv() (*models.GameUpdate, error)
+}
+
+// streamGameUpdatesSSE writes updates from a stream as events, with
+// heartbeats while it is quiet, until the stream ends, a write fails or ctx
+// is done
+func streamGameUpdatesSSE(ctx context.Context, w io.Writer, rc *http.ResponseController, gameId string, stream gameUpdateStream) {
+	// Recv blocks, so pump updates through a channel to interleave heartbeats.
+	// recvErr is closed before updates on every exit so reading it after
+	// updates closes never blocks.
+	updates := make(chan *models.GameUpdate)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(updates)
+		defer close(recvErr)
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	marshaler := protojson.MarshalOptions{}
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case update, ok := <-updates:
+			if !ok {
+				if err := <-recvErr; err != nil && err != io.EOF && ctx.Err() == nil {
+					log.Printf("SSE stream for game %s ended: %v", gameId, err)
+				}
+				return
+			}
+			data, err := marshaler.Marshal(update)
+			if err != nil {
+				log.Printf("SSE marshal failed: %v", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", update.Sequence, data); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
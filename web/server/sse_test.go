@@ -0,0 +1,97 @@
+//go:build !wasm
+// +build !wasm
+
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	models "github.com/turnforge/lilbattle/gen/go/lilbattle/v1/models"
+)
+
+// endlessUpdateStream returns a new update on every Recv, like a busy game
+type endlessUpdateStream struct {
+	seq int64
+}
+
+func (s *endlessUpdateStream) Recv() (*models.GameUpdate, error) {
+	s.seq++
+	return &models.GameUpdate{Sequence: s.seq}, nil
+}
+
+// finiteUpdateStream returns its updates then an error
+type finiteUpdateStream struct {
+	updates []*models.GameUpdate
+	err     error
+}
+
+func (s *finiteUpdateStream) Recv() (*models.GameUpdate, error) {
+	if len(s.updates) == 0 {
+		return nil, s.err
+	}
+	update := s.updates[0]
+	s.updates = s.updates[1:]
+	return update, nil
+}
+
+// cancellingRecorder cancels the request context once it has been written
+// to a number of times, as a client disconnecting mid-stream would.  The
+// write then stalls so the update pump sees the cancel and stops first.
+type cancellingRecorder struct {
+	*httptest.ResponseRecorder
+	writes int
+	after  int
+	cancel context.CancelFunc
+}
+
+func (c *cancellingRecorder) Write(p []byte) (int, error) {
+	c.writes++
+	if c.writes == c.after {
+		c.cancel()
+		time.Sleep(time.Millisecond)
+	}
+	return c.ResponseRecorder.Write(p)
+}
+
+// TestStreamGameUpdatesSSE_RequestCancelled tests that the handler returns
+// when the client goes away, whichever of the cancelled context or the
+// closed update channel it notices first
+func TestStreamGameUpdatesSSE_RequestCancelled(t *testing.T) {
+	for i := range 200 {
+		ctx, cancel := context.WithCancel(context.Background())
+		w := &cancellingRecorder{ResponseRecorder: httptest.NewRecorder(), after: 1 + i%5, cancel: cancel}
+		done := make(chan bool)
+		go func() {
+			streamGameUpdatesSSE(ctx, w, http.NewResponseController(w), "g1", &endlessUpdateStream{})
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Handler did not return after the request was cancelled (run %d)", i)
+		}
+		cancel()
+	}
+}
+
+// TestStreamGameUpdatesSSE_StreamEnds tests that updates are written as
+// events with their sequence as the id until the stream ends
+func TestStreamGameUpdatesSSE_StreamEnds(t *testing.T) {
+	w := httptest.NewRecorder()
+	stream := &finiteUpdateStream{
+		updates: []*models.GameUpdate{{Sequence: 4}, {Sequence: 5}},
+		err:     io.EOF,
+	}
+	streamGameUpdatesSSE(context.Background(), w, http.NewResponseController(w), "g1", stream)
+
+	body := w.Body.String()
+	if !strings.Contains(body, "id: 4\ndata: ") || !strings.Contains(body, "id: 5\ndata: ") {
+		t.Errorf("Expected both updates as events, got %q", body)
+	}
+}